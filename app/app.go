@@ -209,6 +209,7 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		pairingmoduleclient.PairingUnstakeProposal,
 		rewardsmoduleclient.SetIprpcDataProposalHandler,
 		protocolmoduleclient.SetProtocolVersionProposalHandler,
+		protocolmoduleclient.SetChainProtocolVersionProposalHandler,
 		// this line is used by starport scaffolding # stargate/app/govProposalHandler
 	)
 
@@ -275,6 +276,8 @@ var (
 		string(rewardsmoduletypes.ProvidersRewardsAllocationPool):        {authtypes.Minter, authtypes.Staking},
 		dualstakingmoduletypes.ModuleName:                                {authtypes.Burner, authtypes.Staking},
 		string(rewardsmoduletypes.IprpcPoolName):                         nil,
+		plansmoduletypes.ModuleName:                                      nil,
+		subscriptionmoduletypes.TrialPoolName:                            nil,
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
 )
@@ -453,6 +456,15 @@ func New(
 		appCodec, keys[ibcexported.StoreKey], app.GetSubspace(ibcexported.ModuleName), app.StakingKeeper, app.UpgradeKeeper, scopedIBCKeeper,
 	)
 
+	// timerstore keeper
+	app.TimerStoreKeeper = timerstorekeeper.NewKeeper(appCodec)
+
+	// fixation store keeper (the epochstorage keeper is created later, so its
+	// params are read lazily)
+	app.FixationStoreKeeper = fixationkeeper.NewKeeper(appCodec, app.TimerStoreKeeper, func(ctx sdk.Context) uint64 {
+		return app.EpochstorageKeeper.BlocksToSaveRaw(ctx)
+	})
+
 	// Initialize SpecKeeper prior to govRouter (order is critical)
 	app.SpecKeeper = *specmodulekeeper.NewKeeper(
 		appCodec,
@@ -460,6 +472,7 @@ func New(
 		keys[specmoduletypes.MemStoreKey],
 		app.GetSubspace(specmoduletypes.ModuleName),
 		app.StakingKeeper,
+		app.FixationStoreKeeper,
 	)
 	specModule := specmodule.NewAppModule(appCodec, app.SpecKeeper, app.AccountKeeper, app.BankKeeper)
 
@@ -480,18 +493,13 @@ func New(
 	app.DowntimeKeeper = downtimemodulekeeper.NewKeeper(appCodec, keys[downtimemoduletypes.StoreKey], app.GetSubspace(downtimemoduletypes.ModuleName), app.EpochstorageKeeper)
 	downtimeModule := downtimemodule.NewAppModule(app.DowntimeKeeper)
 
-	// timerstore keeper
-	app.TimerStoreKeeper = timerstorekeeper.NewKeeper(appCodec)
-
-	// fixation store keeper
-	app.FixationStoreKeeper = fixationkeeper.NewKeeper(appCodec, app.TimerStoreKeeper, app.EpochstorageKeeper.BlocksToSaveRaw)
-
 	// Initialize PlansKeeper prior to govRouter (order is critical)
 	app.PlansKeeper = *plansmodulekeeper.NewKeeper(
 		appCodec,
 		keys[plansmoduletypes.StoreKey],
 		keys[plansmoduletypes.MemStoreKey],
 		app.GetSubspace(plansmoduletypes.ModuleName),
+		app.BankKeeper,
 		app.EpochstorageKeeper,
 		app.SpecKeeper,
 		app.FixationStoreKeeper,
@@ -588,7 +596,7 @@ func New(
 	govRouter.AddRoute(govtypes.RouterKey, v1beta1.ProposalHandler).
 		//
 		// user defined
-		AddRoute(specmoduletypes.ProposalsRouterKey, specmodule.NewSpecProposalsHandler(app.SpecKeeper)).
+		AddRoute(specmoduletypes.ProposalsRouterKey, specmodule.NewSpecProposalsHandler(app.SpecKeeper, app.EpochstorageKeeper)).
 		// copied the code from param and changed the handler to enable functionality
 		AddRoute(paramproposal.RouterKey, specmodule.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		// user defined
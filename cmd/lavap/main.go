@@ -15,9 +15,12 @@ import (
 	"github.com/lavanet/lava/protocol/badgegenerator"
 	"github.com/lavanet/lava/protocol/badgeserver"
 	"github.com/lavanet/lava/protocol/monitoring"
+	"github.com/lavanet/lava/protocol/nodesimulator"
 	"github.com/lavanet/lava/protocol/performance/connection"
+	"github.com/lavanet/lava/protocol/remotesigner"
 	"github.com/lavanet/lava/protocol/rpcconsumer"
 	"github.com/lavanet/lava/protocol/rpcprovider"
+	"github.com/lavanet/lava/protocol/speccheck"
 	"github.com/lavanet/lava/protocol/statetracker"
 	"github.com/lavanet/lava/protocol/upgrade"
 	"github.com/spf13/cobra"
@@ -52,6 +55,8 @@ func main() {
 	rootCmd.AddCommand(badgeGenerator)
 	// Add Badge Generator Command
 	rootCmd.AddCommand(badgeServer)
+	// Add Remote Signer Command
+	rootCmd.AddCommand(remotesigner.CreateRemoteSignerCobraCommand())
 
 	testCmd := &cobra.Command{
 		Use:   "test",
@@ -64,6 +69,8 @@ func main() {
 	testCmd.AddCommand(connection.CreateTestConnectionServerCobraCommand())
 	testCmd.AddCommand(connection.CreateTestConnectionProbeCobraCommand())
 	testCmd.AddCommand(monitoring.CreateHealthCobraCommand())
+	testCmd.AddCommand(nodesimulator.CreateNodeSimulatorCobraCommand())
+	testCmd.AddCommand(speccheck.CreateSpecCheckCobraCommand())
 	rootCmd.AddCommand(cache.CreateCacheCobraCommand())
 
 	cmd.OverwriteFlagDefaults(rootCmd, map[string]string{
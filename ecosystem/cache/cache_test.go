@@ -516,3 +516,102 @@ func TestCacheSetGetJsonRPCWithID(t *testing.T) {
 		})
 	}
 }
+
+func TestCacheInvalidateForkedBlocks(t *testing.T) {
+	t.Parallel()
+	ctx, cacheServer := initTest()
+	hash := []byte{1, 2, 3}
+	type entry struct {
+		chainID   string
+		block     int64
+		finalized bool
+		evicted   bool
+	}
+	entries := []entry{
+		{chainID: StubChainID, block: 1230, finalized: false, evicted: false},
+		{chainID: StubChainID, block: 1231, finalized: false, evicted: true},
+		{chainID: StubChainID, block: 1232, finalized: false, evicted: true},
+		{chainID: StubChainID, block: 1231, finalized: true, evicted: false},
+		{chainID: "other-chain", block: 1231, finalized: false, evicted: false},
+	}
+	requestHash := func(e entry) []byte {
+		data := []byte(StubData + strconv.FormatBool(e.finalized))
+		return HashRequest(t, getRequest(e.block, data, StubApiInterface), e.chainID)
+	}
+	for _, e := range entries {
+		_, err := cacheServer.SetRelay(ctx, &pairingtypes.RelayCacheSet{
+			RequestHash:    requestHash(e),
+			BlockHash:      hash,
+			ChainId:        e.chainID,
+			Response:       &pairingtypes.RelayReply{},
+			Finalized:      e.finalized,
+			RequestedBlock: e.block,
+		})
+		require.NoError(t, err)
+	}
+	time.Sleep(3 * time.Millisecond)
+
+	_, err := cacheServer.InvalidateBlocks(ctx, &pairingtypes.RelayCacheInvalidate{ChainId: StubChainID, FromBlock: 1231})
+	require.NoError(t, err)
+	time.Sleep(3 * time.Millisecond)
+
+	for _, e := range entries {
+		_, err := cacheServer.GetRelay(ctx, &pairingtypes.RelayCacheGet{
+			RequestHash:    requestHash(e),
+			BlockHash:      hash,
+			ChainId:        e.chainID,
+			Finalized:      e.finalized,
+			RequestedBlock: e.block,
+		})
+		if e.evicted {
+			require.Error(t, err, "chain %s block %d should be evicted", e.chainID, e.block)
+		} else {
+			require.NoError(t, err, "chain %s block %d finalized %t should not be evicted", e.chainID, e.block, e.finalized)
+		}
+	}
+}
+
+func TestCacheEvictsForkedBlocksOnHashChange(t *testing.T) {
+	t.Parallel()
+	ctx, cacheServer := initTest()
+	requestHash := func(block int64, data string) []byte {
+		return HashRequest(t, getRequest(block, []byte(data), StubApiInterface), StubChainID)
+	}
+	setRelay := func(block int64, data string, blockHash []byte) {
+		_, err := cacheServer.SetRelay(ctx, &pairingtypes.RelayCacheSet{
+			RequestHash:    requestHash(block, data),
+			BlockHash:      blockHash,
+			ChainId:        StubChainID,
+			Response:       &pairingtypes.RelayReply{},
+			RequestedBlock: block,
+		})
+		require.NoError(t, err)
+		time.Sleep(3 * time.Millisecond)
+	}
+	getRelay := func(block int64, data string, blockHash []byte) error {
+		_, err := cacheServer.GetRelay(ctx, &pairingtypes.RelayCacheGet{
+			RequestHash:    requestHash(block, data),
+			BlockHash:      blockHash,
+			ChainId:        StubChainID,
+			RequestedBlock: block,
+		})
+		return err
+	}
+
+	setRelay(1230, "a", []byte("hash1230"))
+	setRelay(1231, "a", []byte("hash1231"))
+	setRelay(1232, "a", []byte("hash1232"))
+	setRelay(1231, "b", []byte("hash1231"))
+	for _, block := range []int64{1230, 1231, 1232} {
+		require.NoError(t, getRelay(block, "a", []byte("hash"+strconv.FormatInt(block, 10))))
+	}
+	require.NoError(t, getRelay(1231, "b", []byte("hash1231")))
+
+	// another reply shows block 1231 was replaced, so it and the blocks above it are evicted
+	setRelay(1231, "c", []byte("forked1231"))
+	require.NoError(t, getRelay(1230, "a", []byte("hash1230")))
+	require.ErrorIs(t, getRelay(1231, "a", []byte("forked1231")), cache.NotFoundError)
+	require.ErrorIs(t, getRelay(1231, "b", []byte("hash1231")), cache.NotFoundError)
+	require.ErrorIs(t, getRelay(1232, "a", []byte("hash1232")), cache.NotFoundError)
+	require.NoError(t, getRelay(1231, "c", []byte("forked1231")))
+}
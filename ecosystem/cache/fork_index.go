@@ -0,0 +1,107 @@
+package cache
+
+import (
+	"bytes"
+	"sync"
+)
+
+// MaxForkDepth is how many blocks behind a chain's latest block non finalized entries are indexed for fork invalidation.
+// deeper forks aren't evicted, but their entries are still rejected by the block hash check on lookup
+const MaxForkDepth = 256
+
+// forkIndex keeps the keys of non finalized entries per chain and block, so they can be evicted when the chain forks
+type forkIndex struct {
+	lock   sync.Mutex
+	chains map[string]*forkChain
+}
+
+type forkChain struct {
+	blocks map[int64]*forkBlock
+	// blocks below the floor are not tracked anymore
+	floor int64
+}
+
+type forkBlock struct {
+	// the block hash the entries were stored with, nil if they were stored without one
+	hash []byte
+	keys map[string]struct{}
+}
+
+func newForkIndex() *forkIndex {
+	return &forkIndex{chains: map[string]*forkChain{}}
+}
+
+// add indexes the key of an entry of the chain's block. if the block was already indexed with a different hash the chain forked,
+// so the keys of that block and above are returned for eviction and aren't tracked anymore
+func (fi *forkIndex) add(chainID string, block int64, blockHash []byte, cacheKey []byte, latestBlock int64) (forked [][]byte) {
+	fi.lock.Lock()
+	defer fi.lock.Unlock()
+	chain, ok := fi.chains[chainID]
+	if !ok {
+		chain = &forkChain{blocks: map[int64]*forkBlock{}}
+		fi.chains[chainID] = chain
+	}
+	chain.prune(latestBlock - MaxForkDepth)
+	if block < chain.floor {
+		// deeper than a fork can reach
+		return nil
+	}
+	indexed, ok := chain.blocks[block]
+	if ok && len(blockHash) > 0 && len(indexed.hash) > 0 && !bytes.Equal(indexed.hash, blockHash) {
+		forked = chain.remove(block)
+		ok = false
+	}
+	if !ok {
+		indexed = &forkBlock{keys: map[string]struct{}{}}
+		chain.blocks[block] = indexed
+	}
+	if len(blockHash) > 0 {
+		indexed.hash = blockHash
+	}
+	indexed.keys[string(cacheKey)] = struct{}{}
+	return forked
+}
+
+// remove returns the keys of the chain's entries from fromBlock and above and stops tracking them
+func (fi *forkIndex) remove(chainID string, fromBlock int64) [][]byte {
+	fi.lock.Lock()
+	defer fi.lock.Unlock()
+	chain, ok := fi.chains[chainID]
+	if !ok {
+		return [][]byte{}
+	}
+	return chain.remove(fromBlock)
+}
+
+func (fc *forkChain) remove(fromBlock int64) [][]byte {
+	removed := [][]byte{}
+	for block, indexed := range fc.blocks {
+		if block < fromBlock {
+			continue
+		}
+		for key := range indexed.keys {
+			removed = append(removed, []byte(key))
+		}
+		delete(fc.blocks, block)
+	}
+	return removed
+}
+
+// prune stops tracking the blocks below the new floor, it only visits the blocks the floor passed since the last prune
+func (fc *forkChain) prune(floor int64) {
+	if floor <= fc.floor {
+		return
+	}
+	if floor-fc.floor > int64(len(fc.blocks)) {
+		for block := range fc.blocks {
+			if block < floor {
+				delete(fc.blocks, block)
+			}
+		}
+	} else {
+		for block := fc.floor; block < floor; block++ {
+			delete(fc.blocks, block)
+		}
+	}
+	fc.floor = floor
+}
@@ -270,6 +270,11 @@ func (s *RelayerCacheServer) SetRelay(ctx context.Context, relayCacheSet *pairin
 		cache.SetWithTTL(cacheKey, cacheValue, cacheValue.Cost(), s.CacheServer.ExpirationFinalized)
 	} else {
 		cache := s.CacheServer.tempCache
+		// a different hash for an indexed block means the chain forked, entries of the replaced branch are evicted
+		forkedKeys := s.CacheServer.forkIndex.add(relayCacheSet.ChainId, relayCacheSet.RequestedBlock, relayCacheSet.BlockHash, cacheKey, latestKnownBlock)
+		for _, forkedKey := range forkedKeys {
+			cache.Del(forkedKey)
+		}
 		cache.SetWithTTL(cacheKey, cacheValue, cacheValue.Cost(), s.getExpirationForChain(time.Duration(relayCacheSet.AverageBlockTime), relayCacheSet.BlockHash))
 	}
 	// Setting the seen block for shared state.
@@ -278,6 +283,19 @@ func (s *RelayerCacheServer) SetRelay(ctx context.Context, relayCacheSet *pairin
 	return &emptypb.Empty{}, nil
 }
 
+// InvalidateBlocks evicts the non finalized entries of the forked blocks, finalized entries can't be affected by a fork
+func (s *RelayerCacheServer) InvalidateBlocks(ctx context.Context, relayCacheInvalidate *pairingtypes.RelayCacheInvalidate) (*emptypb.Empty, error) {
+	cacheKeys := s.CacheServer.forkIndex.remove(relayCacheInvalidate.ChainId, relayCacheInvalidate.FromBlock)
+	for _, cacheKey := range cacheKeys {
+		s.CacheServer.tempCache.Del(cacheKey)
+	}
+	utils.LavaFormatDebug("Got Cache Invalidate", utils.Attribute{Key: "chainID", Value: relayCacheInvalidate.ChainId},
+		utils.Attribute{Key: "fromBlock", Value: relayCacheInvalidate.FromBlock},
+		utils.Attribute{Key: "evicted", Value: len(cacheKeys)},
+	)
+	return &emptypb.Empty{}, nil
+}
+
 func (s *RelayerCacheServer) Health(ctx context.Context, req *emptypb.Empty) (*pairingtypes.CacheUsage, error) {
 	cacheHits := atomic.LoadUint64(&s.cacheHits)
 	cacheMisses := atomic.LoadUint64(&s.cacheMisses)
@@ -37,6 +37,7 @@ type CacheServer struct {
 	ExpirationNonFinalized time.Duration
 	CacheMetrics           *CacheMetrics
 	CacheMaxCost           int64
+	forkIndex              *forkIndex
 }
 
 func (cs *CacheServer) InitCache(ctx context.Context, expiration time.Duration, expirationNonFinalized time.Duration, metricsAddr string) {
@@ -53,6 +54,7 @@ func (cs *CacheServer) InitCache(ctx context.Context, expiration time.Duration,
 		utils.LavaFormatFatal("could not create finalized cache", err)
 	}
 	cs.finalizedCache = cache
+	cs.forkIndex = newForkIndex()
 
 	// initialize prometheus
 	cs.CacheMetrics = NewCacheMetricsServer(metricsAddr)
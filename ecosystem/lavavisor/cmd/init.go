@@ -46,6 +46,7 @@ func CreateLavaVisorInitCobraCommand() *cobra.Command {
 	cmdLavavisorInit.Flags().Bool("auto-download", false, "Automatically download missing binaries")
 	cmdLavavisorInit.Flags().Bool("auto-start", false, "Executes start cmd automatically after init is completed")
 	cmdLavavisorInit.Flags().String(flags.FlagChainID, app.Name, "network chain id")
+	addUpgradeSafetyFlags(cmdLavavisorInit)
 
 	return cmdLavavisorInit
 }
@@ -58,8 +59,12 @@ func LavavisorInit(cmd *cobra.Command) error {
 	if err != nil {
 		return err
 	}
+	upgradeSafety, err := getUpgradeSafetyConfig(cmd)
+	if err != nil {
+		return err
+	}
 	// Build path to ./lavavisor
-	lavavisorFetcher := &processmanager.ProtocolBinaryFetcher{AutoDownload: autoDownload}
+	lavavisorFetcher := &processmanager.ProtocolBinaryFetcher{AutoDownload: autoDownload, Verifier: upgradeSafety.Verifier}
 	err = lavavisorFetcher.SetupLavavisorDir(dir)
 	if err != nil {
 		return err
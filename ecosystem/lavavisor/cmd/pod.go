@@ -40,6 +40,8 @@ provider example:
 	cmdLavavisorPod.Flags().String(flags.FlagChainID, app.Name, "network chain id")
 	cmdLavavisorPod.Flags().String("cmd", "", "the command to execute")
 	cmdLavavisorPod.MarkFlagRequired("cmd")
+	addUpgradeSafetyFlags(cmdLavavisorPod)
+	addServedChainsFlag(cmdLavavisorPod)
 	return cmdLavavisorPod
 }
 
@@ -66,12 +68,22 @@ func LavavisorPod(cmd *cobra.Command) error {
 		utils.LavaFormatFatal("failed to create tx factory", err)
 	}
 
-	lavavisor := LavaVisor{}
-	err = lavavisor.PodStart(ctx, txFactory, clientCtx, runCommand, dir, keyRingPassword)
+	upgradeSafety, err := getUpgradeSafetyConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	servedChains, err := cmd.Flags().GetStringSlice(ServedChainsFlag)
+	if err != nil {
+		return err
+	}
+
+	lavavisor := LavaVisor{servedChains: servedChains}
+	err = lavavisor.PodStart(ctx, txFactory, clientCtx, runCommand, dir, keyRingPassword, upgradeSafety)
 	return err
 }
 
-func (lv *LavaVisor) PodStart(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, runCommand string, lavavisorDir string, keyRingPassword *processmanager.KeyRingPassword) (err error) {
+func (lv *LavaVisor) PodStart(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, runCommand string, lavavisorDir string, keyRingPassword *processmanager.KeyRingPassword, upgradeSafety processmanager.UpgradeSafetyConfig) (err error) {
 	ctx, cancel := context.WithCancel(ctx)
 	signalChan := make(chan os.Signal, 1)
 	signal.Notify(signalChan, os.Interrupt)
@@ -89,12 +101,12 @@ func (lv *LavaVisor) PodStart(ctx context.Context, txFactory tx.Factory, clientC
 	lv.lavavisorStateTracker = lavavisorStateTracker
 
 	// check version
-	version, err := lavavisorStateTracker.GetProtocolVersion(ctx)
+	version, err := lavavisorStateTracker.GetProtocolVersion(ctx, lv.servedChains...)
 	if err != nil {
 		utils.LavaFormatFatal("failed fetching protocol version from node", err)
 	}
 
-	binaryFetcher := processmanager.ProtocolBinaryFetcherWithoutBuild{}
+	binaryFetcher := processmanager.ProtocolBinaryFetcherWithoutBuild{Verifier: upgradeSafety.Verifier}
 	// Build path to ./lavavisor
 	lavavisorPath, err := binaryFetcher.ValidateLavavisorDir(lavavisorDir)
 	if err != nil {
@@ -110,9 +122,9 @@ func (lv *LavaVisor) PodStart(ctx context.Context, txFactory tx.Factory, clientC
 	}
 
 	// Initialize version monitor with selected most recent version
-	versionMonitor := processmanager.NewVersionMonitorProcessPodFlow(selectedVersion, lavavisorPath, runCommand)
+	versionMonitor := processmanager.NewVersionMonitorProcessPodFlow(selectedVersion, lavavisorPath, runCommand, upgradeSafety)
 
-	lavavisorStateTracker.RegisterForVersionUpdates(ctx, version.Version, versionMonitor)
+	lavavisorStateTracker.RegisterForVersionUpdates(ctx, version.Version, versionMonitor, lv.servedChains...)
 
 	defer func() {
 		if r := recover(); r != nil {
@@ -29,19 +29,20 @@ import (
 )
 
 type LavavisorStateTrackerInf interface {
-	RegisterForVersionUpdates(ctx context.Context, version *protocoltypes.Version, versionValidator updaters.VersionValidationInf)
-	GetProtocolVersion(ctx context.Context) (*updaters.ProtocolVersionResponse, error)
+	RegisterForVersionUpdates(ctx context.Context, version *protocoltypes.Version, versionValidator updaters.VersionValidationInf, chainIDs ...string)
+	GetProtocolVersion(ctx context.Context, chainIDs ...string) (*updaters.ProtocolVersionResponse, error)
 }
 
 type LavaVisor struct {
 	lavavisorStateTracker LavavisorStateTrackerInf
+	servedChains          []string // the minimum versions of these chains are applied on top of the global version
 }
 
 type Config struct {
 	Services []string `yaml:"services"`
 }
 
-func (lv *LavaVisor) Start(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, lavavisorPath string, autoDownload bool, services []string) (err error) {
+func (lv *LavaVisor) Start(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, lavavisorPath string, autoDownload bool, services []string, upgradeSafety processmanager.UpgradeSafetyConfig) (err error) {
 	ctx, cancel := context.WithCancel(ctx)
 	signalChan := make(chan os.Signal, 1)
 	signal.Notify(signalChan, os.Interrupt)
@@ -59,7 +60,7 @@ func (lv *LavaVisor) Start(ctx context.Context, txFactory tx.Factory, clientCtx
 	lv.lavavisorStateTracker = lavavisorStateTracker
 
 	// check version
-	version, err := lavavisorStateTracker.GetProtocolVersion(ctx)
+	version, err := lavavisorStateTracker.GetProtocolVersion(ctx, lv.servedChains...)
 	if err != nil {
 		utils.LavaFormatFatal("failed fetching protocol version from node", err)
 	}
@@ -73,9 +74,9 @@ func (lv *LavaVisor) Start(ctx context.Context, txFactory tx.Factory, clientCtx
 	}
 
 	// Initialize version monitor with selected most recent version
-	versionMonitor := processmanager.NewVersionMonitor(selectedVersion, lavavisorPath, services, autoDownload)
+	versionMonitor := processmanager.NewVersionMonitor(selectedVersion, lavavisorPath, services, autoDownload, upgradeSafety)
 
-	lavavisorStateTracker.RegisterForVersionUpdates(ctx, version.Version, versionMonitor)
+	lavavisorStateTracker.RegisterForVersionUpdates(ctx, version.Version, versionMonitor, lv.servedChains...)
 
 	// check whether lavavisor already started the services when downloading the binaries or not.
 	if !versionMonitor.LaunchedServices {
@@ -129,6 +130,8 @@ func CreateLavaVisorStartCobraCommand() *cobra.Command {
 	cmdLavavisorStart.Flags().String("directory", os.ExpandEnv("~/"), "Protocol Flags Directory")
 	cmdLavavisorStart.Flags().Bool("auto-download", false, "Automatically download missing binaries")
 	cmdLavavisorStart.Flags().String(flags.FlagChainID, app.Name, "network chain id")
+	addUpgradeSafetyFlags(cmdLavavisorStart)
+	addServedChainsFlag(cmdLavavisorStart)
 	return cmdLavavisorStart
 }
 
@@ -159,6 +162,11 @@ func LavavisorStart(cmd *cobra.Command) error {
 		return err
 	}
 
+	upgradeSafety, err := getUpgradeSafetyConfig(cmd)
+	if err != nil {
+		return err
+	}
+
 	// Read config.yml
 	configPath := filepath.Join(lavavisorPath, "/config.yml")
 	configData, err := os.ReadFile(configPath)
@@ -178,9 +186,14 @@ func LavavisorStart(cmd *cobra.Command) error {
 		return utils.LavaFormatError("[Lavavisor] directory does not exist", nil, utils.Attribute{Key: "lavavisorServicesDir", Value: lavavisorServicesDir})
 	}
 
+	servedChains, err := cmd.Flags().GetStringSlice(ServedChainsFlag)
+	if err != nil {
+		return err
+	}
+
 	// Start lavavisor version monitor process
-	lavavisor := LavaVisor{}
-	err = lavavisor.Start(ctx, txFactory, clientCtx, lavavisorPath, autoDownload, config.Services)
+	lavavisor := LavaVisor{servedChains: servedChains}
+	err = lavavisor.Start(ctx, txFactory, clientCtx, lavavisorPath, autoDownload, config.Services, upgradeSafety)
 	return err
 }
 
@@ -18,7 +18,14 @@ import (
 	"golang.org/x/term"
 )
 
-const KeyRingPasswordFlag = "enter-keyring-password"
+const (
+	KeyRingPasswordFlag         = "enter-keyring-password"
+	UpgradeGraceWindowFlag      = "upgrade-grace-window"
+	HealthProbeURLFlag          = "health-probe-url"
+	BinarySigningKeyFlag        = "binary-signing-key"
+	AllowUnverifiedBinariesFlag = "allow-unverified-binaries"
+	ServedChainsFlag            = "served-chains"
+)
 
 func CreateLavaVisorWrapCobraCommand() *cobra.Command {
 	cmdLavavisorWrap := &cobra.Command{
@@ -44,9 +51,49 @@ provider example:
 	cmdLavavisorWrap.Flags().String(flags.FlagChainID, app.Name, "network chain id")
 	cmdLavavisorWrap.Flags().String("cmd", "", "the command to execute")
 	cmdLavavisorWrap.MarkFlagRequired("cmd")
+	addUpgradeSafetyFlags(cmdLavavisorWrap)
+	addServedChainsFlag(cmdLavavisorWrap)
 	return cmdLavavisorWrap
 }
 
+func addUpgradeSafetyFlags(cmd *cobra.Command) {
+	cmd.Flags().Duration(UpgradeGraceWindowFlag, processmanager.DefaultUpgradeGraceWindow, "roll back to the previous binary if the upgraded process exits or fails the health probe within this window, 0 disables rollbacks")
+	cmd.Flags().String(HealthProbeURLFlag, "", "http endpoint of the upgraded process that must answer with a 2xx status at the end of the grace window, e.g. the provider's metrics endpoint")
+	cmd.Flags().String(BinarySigningKeyFlag, "", "file with a base64 or hex ed25519 public key, when set the release checksum manifest must be signed with it")
+	cmd.Flags().Bool(AllowUnverifiedBinariesFlag, false, "install downloaded files the release has no checksum for (releases without a checksum manifest)")
+}
+
+func addServedChainsFlag(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(ServedChainsFlag, []string{}, "chain IDs served by the lavap processes, e.g. ETH1,LAV1. lavavisor upgrades to the minimum versions governance set for these chains when they are above the global version")
+}
+
+func getUpgradeSafetyConfig(cmd *cobra.Command) (processmanager.UpgradeSafetyConfig, error) {
+	graceWindow, err := cmd.Flags().GetDuration(UpgradeGraceWindowFlag)
+	if err != nil {
+		return processmanager.UpgradeSafetyConfig{}, err
+	}
+	healthProbeURL, err := cmd.Flags().GetString(HealthProbeURLFlag)
+	if err != nil {
+		return processmanager.UpgradeSafetyConfig{}, err
+	}
+	signingKeyPath, err := cmd.Flags().GetString(BinarySigningKeyFlag)
+	if err != nil {
+		return processmanager.UpgradeSafetyConfig{}, err
+	}
+	allowUnverified, err := cmd.Flags().GetBool(AllowUnverifiedBinariesFlag)
+	if err != nil {
+		return processmanager.UpgradeSafetyConfig{}, err
+	}
+	verifier := &processmanager.BinaryVerifier{AllowUnverified: allowUnverified}
+	if signingKeyPath != "" {
+		verifier.SigningKey, err = processmanager.LoadSigningKey(signingKeyPath)
+		if err != nil {
+			return processmanager.UpgradeSafetyConfig{}, err
+		}
+	}
+	return processmanager.UpgradeSafetyConfig{Verifier: verifier, GraceWindow: graceWindow, HealthProbeURL: healthProbeURL}, nil
+}
+
 func getKeyringPassword(cmd *cobra.Command) *processmanager.KeyRingPassword {
 	password, err := cmd.Flags().GetBool(KeyRingPasswordFlag)
 	if err != nil {
@@ -103,12 +150,22 @@ func LavavisorWrap(cmd *cobra.Command) error {
 		return err
 	}
 
-	lavavisor := LavaVisor{}
-	err = lavavisor.Wrap(ctx, txFactory, clientCtx, lavavisorPath, autoDownload, runCommand, keyRingPassword)
+	upgradeSafety, err := getUpgradeSafetyConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	servedChains, err := cmd.Flags().GetStringSlice(ServedChainsFlag)
+	if err != nil {
+		return err
+	}
+
+	lavavisor := LavaVisor{servedChains: servedChains}
+	err = lavavisor.Wrap(ctx, txFactory, clientCtx, lavavisorPath, autoDownload, runCommand, keyRingPassword, upgradeSafety)
 	return err
 }
 
-func (lv *LavaVisor) Wrap(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, lavavisorPath string, autoDownload bool, runCommand string, keyringPassword *processmanager.KeyRingPassword) (err error) {
+func (lv *LavaVisor) Wrap(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, lavavisorPath string, autoDownload bool, runCommand string, keyringPassword *processmanager.KeyRingPassword, upgradeSafety processmanager.UpgradeSafetyConfig) (err error) {
 	ctx, cancel := context.WithCancel(ctx)
 	signalChan := make(chan os.Signal, 1)
 	signal.Notify(signalChan, os.Interrupt)
@@ -126,7 +183,7 @@ func (lv *LavaVisor) Wrap(ctx context.Context, txFactory tx.Factory, clientCtx c
 	lv.lavavisorStateTracker = lavavisorStateTracker
 
 	// check version
-	version, err := lavavisorStateTracker.GetProtocolVersion(ctx)
+	version, err := lavavisorStateTracker.GetProtocolVersion(ctx, lv.servedChains...)
 	if err != nil {
 		utils.LavaFormatFatal("failed fetching protocol version from node", err)
 	}
@@ -140,9 +197,9 @@ func (lv *LavaVisor) Wrap(ctx context.Context, txFactory tx.Factory, clientCtx c
 	}
 
 	// Initialize version monitor with selected most recent version
-	versionMonitor := processmanager.NewVersionMonitorProcessWrapFlow(selectedVersion, lavavisorPath, autoDownload, runCommand)
+	versionMonitor := processmanager.NewVersionMonitorProcessWrapFlow(selectedVersion, lavavisorPath, autoDownload, runCommand, upgradeSafety)
 
-	lavavisorStateTracker.RegisterForVersionUpdates(ctx, version.Version, versionMonitor)
+	lavavisorStateTracker.RegisterForVersionUpdates(ctx, version.Version, versionMonitor, lv.servedChains...)
 
 	defer func() {
 		if r := recover(); r != nil {
@@ -19,6 +19,7 @@ type ProtocolBinaryFetcher struct {
 	lavavisorPath         string
 	CurrentRunningVersion string
 	AutoDownload          bool
+	Verifier              *BinaryVerifier
 }
 
 func (pbf *ProtocolBinaryFetcher) SetCurrentRunningVersion(currentVersion string) {
@@ -195,8 +196,8 @@ func (pbf *ProtocolBinaryFetcher) downloadAndBuildFromGithub(version, versionDir
 	if err != nil {
 		return utils.LavaFormatError("[Lavavisor] failed to clean up binary directory", err)
 	}
-	// URL might need to be updated based on the actual GitHub repository
-	url := fmt.Sprintf("https://github.com/lavanet/lava/archive/refs/tags/v%s.zip", version)
+	// releases publish a checksummed source archive, older releases only have github's tag archive
+	url := fmt.Sprintf(releaseAssetURLFormat, version, releaseSourceAssetName(version))
 	utils.LavaFormatInfo("[Lavavisor] Fetching the source from: ", utils.Attribute{Key: "URL", Value: url})
 
 	// Send the request
@@ -204,6 +205,15 @@ func (pbf *ProtocolBinaryFetcher) downloadAndBuildFromGithub(version, versionDir
 	if err != nil {
 		return err
 	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		url = fmt.Sprintf("https://github.com/lavanet/lava/archive/refs/tags/v%s.zip", version)
+		utils.LavaFormatInfo("[Lavavisor] release has no source archive, fetching the tag archive from: ", utils.Attribute{Key: "URL", Value: url})
+		resp, err = http.Get(url)
+		if err != nil {
+			return err
+		}
+	}
 	defer resp.Body.Close()
 
 	// Check server response
@@ -230,6 +240,11 @@ func (pbf *ProtocolBinaryFetcher) downloadAndBuildFromGithub(version, versionDir
 	if err != nil {
 		return err
 	}
+	// Verify the source against the release checksum manifest before building it
+	err = pbf.Verifier.Verify(version, filepath.Base(url), zipPath)
+	if err != nil {
+		return err
+	}
 	// Unzip the source
 	_, err = lvutil.Unzip(zipPath, versionDir)
 	if err != nil {
@@ -15,6 +15,7 @@ import (
 type ProtocolBinaryFetcherWithoutBuild struct {
 	lavavisorPath         string
 	CurrentRunningVersion string
+	Verifier              *BinaryVerifier
 }
 
 func (pbf *ProtocolBinaryFetcherWithoutBuild) SetCurrentRunningVersion(currentVersion string) {
@@ -146,13 +147,14 @@ func (pbf *ProtocolBinaryFetcherWithoutBuild) handleMissingDir(versionDir string
 	utils.LavaFormatInfo("[Lavavisor] created " + versionDir + " successfully")
 
 	utils.LavaFormatInfo("[Lavavisor] Trying to download:", utils.Attribute{Key: "Version", Value: currentVersion})
-	if err := pbf.downloadBinaryFromGithub(lvutil.FormatFromSemanticVersion(currentVersion), versionDir); err == nil {
+	downloadErr := pbf.downloadBinaryFromGithub(lvutil.FormatFromSemanticVersion(currentVersion), versionDir)
+	if downloadErr == nil {
 		binaryPath = filepath.Join(versionDir, "lavap")
 		return binaryPath, nil
 	}
 
 	// upon failed operation, remove versionDir
-	utils.LavaFormatFatal("[Lavavisor] Failed downloading, deleting directory, retrying next block", err, utils.Attribute{Key: "Version", Value: currentVersion})
+	utils.LavaFormatError("[Lavavisor] Failed downloading, deleting directory, retrying next block", downloadErr, utils.Attribute{Key: "Version", Value: currentVersion})
 	err = os.RemoveAll(versionDir)
 	if err != nil {
 		return "", err
@@ -168,7 +170,7 @@ func (pbf *ProtocolBinaryFetcherWithoutBuild) downloadBinaryFromGithub(version,
 		return utils.LavaFormatError("[Lavavisor] failed to clean up binary directory", err)
 	}
 	// URL might need to be updated based on the actual GitHub repository
-	url := fmt.Sprintf("https://github.com/lavanet/lava/releases/download/v%s/lavap-v%s-linux-amd64", version, version)
+	url := fmt.Sprintf(releaseAssetURLFormat, version, releaseBinaryAssetName("lavap", version))
 	utils.LavaFormatInfo("[Lavavisor] Fetching the source from: ", utils.Attribute{Key: "URL", Value: url})
 	// Send the request
 	resp, err := http.Get(url)
@@ -207,6 +209,12 @@ func (pbf *ProtocolBinaryFetcherWithoutBuild) downloadBinaryFromGithub(version,
 		return err
 	}
 
+	// Verify the binary against the release checksum manifest before making it executable
+	err = pbf.Verifier.Verify(version, filepath.Base(url), lavapPath)
+	if err != nil {
+		return err
+	}
+
 	utils.LavaFormatInfo("[Lavavisor] Validating binary", utils.Attribute{Key: "path", Value: lavapPath})
 	binaryInfo, err := os.Stat(lavapPath)
 	if err != nil {
@@ -0,0 +1,175 @@
+package processmanager
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/lavanet/lava/utils"
+)
+
+// release asset names follow the checksum, source and archives sections of .goreleaser.yaml
+const ChecksumManifestName = "sha256sum.txt"
+
+const ChecksumSignatureName = ChecksumManifestName + ".sig"
+
+var releaseAssetURLFormat = "https://github.com/lavanet/lava/releases/download/v%s/%s"
+
+func releaseBinaryAssetName(binary string, version string) string {
+	return fmt.Sprintf("%s-v%s-linux-amd64", binary, version)
+}
+
+// the source archive holds the tree under lava-<version>/, same as github's tag archive
+func releaseSourceAssetName(version string) string {
+	return fmt.Sprintf("lava-v%s-source.zip", version)
+}
+
+// BinaryVerifier checks files downloaded for a release against the release's checksum manifest.
+// the manifest is in sha256sum format, when a signing key is set the manifest must also carry a valid ed25519 signature.
+// a nil verifier requires a checksum but no signature
+type BinaryVerifier struct {
+	SigningKey      ed25519.PublicKey
+	AllowUnverified bool // install files the release has no checksum for (older releases), a checksum mismatch always fails
+	fetchAsset      func(version string, assetName string) ([]byte, error)
+}
+
+func LoadSigningKey(path string) (ed25519.PublicKey, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, utils.LavaFormatError("[Lavavisor] failed reading signing key file", err, utils.Attribute{Key: "path", Value: path})
+	}
+	key, err := decodeKeyMaterial(content, ed25519.PublicKeySize)
+	if err != nil {
+		return nil, utils.LavaFormatError("[Lavavisor] invalid signing key, expected a base64 or hex encoded ed25519 public key", err, utils.Attribute{Key: "path", Value: path})
+	}
+	return ed25519.PublicKey(key), nil
+}
+
+// decodeKeyMaterial accepts raw, base64 or hex encoded bytes of the expected size
+func decodeKeyMaterial(content []byte, size int) ([]byte, error) {
+	if len(content) == size {
+		return content, nil
+	}
+	trimmed := strings.TrimSpace(string(content))
+	if decoded, err := base64.StdEncoding.DecodeString(trimmed); err == nil && len(decoded) == size {
+		return decoded, nil
+	}
+	if decoded, err := hex.DecodeString(trimmed); err == nil && len(decoded) == size {
+		return decoded, nil
+	}
+	return nil, fmt.Errorf("expected %d bytes", size)
+}
+
+// ParseChecksumManifest parses "<sha256 hex>  <file name>" lines into a file name to checksum map
+func ParseChecksumManifest(manifest []byte) (map[string]string, error) {
+	checksums := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(manifest))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid checksum manifest line %q", line)
+		}
+		checksum := strings.ToLower(fields[0])
+		if decoded, err := hex.DecodeString(checksum); err != nil || len(decoded) != sha256.Size {
+			return nil, fmt.Errorf("invalid sha256 checksum in manifest line %q", line)
+		}
+		// sha256sum marks files hashed in binary mode with a leading '*'
+		checksums[strings.TrimPrefix(fields[1], "*")] = checksum
+	}
+	return checksums, scanner.Err()
+}
+
+func FileSHA256(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// Verify checks the file at filePath, downloaded as assetName of the given release version
+func (bv *BinaryVerifier) Verify(version string, assetName string, filePath string) error {
+	if bv == nil {
+		bv = &BinaryVerifier{}
+	}
+	manifest, err := bv.fetch(version, ChecksumManifestName)
+	if err != nil {
+		return bv.unverified("[Lavavisor] failed fetching release checksum manifest", err, version, assetName)
+	}
+	if len(bv.SigningKey) > 0 {
+		signature, err := bv.fetch(version, ChecksumSignatureName)
+		if err != nil {
+			return utils.LavaFormatError("[Lavavisor] failed fetching release checksum manifest signature", err, utils.Attribute{Key: "version", Value: version})
+		}
+		signature, err = decodeKeyMaterial(signature, ed25519.SignatureSize)
+		if err != nil || !ed25519.Verify(bv.SigningKey, manifest, signature) {
+			return utils.LavaFormatError("[Lavavisor] release checksum manifest signature is invalid", err, utils.Attribute{Key: "version", Value: version})
+		}
+	}
+	checksums, err := ParseChecksumManifest(manifest)
+	if err != nil {
+		return utils.LavaFormatError("[Lavavisor] failed parsing release checksum manifest", err, utils.Attribute{Key: "version", Value: version})
+	}
+	expected, ok := checksums[assetName]
+	if !ok {
+		return bv.unverified("[Lavavisor] release checksum manifest has no entry for the downloaded file", nil, version, assetName)
+	}
+	actual, err := FileSHA256(filePath)
+	if err != nil {
+		return utils.LavaFormatError("[Lavavisor] failed hashing downloaded file", err, utils.Attribute{Key: "path", Value: filePath})
+	}
+	if actual != expected {
+		return utils.LavaFormatError("[Lavavisor] downloaded file checksum mismatch", nil,
+			utils.Attribute{Key: "file", Value: assetName},
+			utils.Attribute{Key: "expected", Value: expected},
+			utils.Attribute{Key: "actual", Value: actual},
+		)
+	}
+	utils.LavaFormatInfo("[Lavavisor] downloaded file checksum verified", utils.Attribute{Key: "file", Value: assetName}, utils.Attribute{Key: "signed", Value: len(bv.SigningKey) > 0})
+	return nil
+}
+
+func (bv *BinaryVerifier) unverified(message string, err error, version string, assetName string) error {
+	if bv.AllowUnverified && len(bv.SigningKey) == 0 {
+		utils.LavaFormatWarning(message+", installing unverified file", err, utils.Attribute{Key: "version", Value: version}, utils.Attribute{Key: "file", Value: assetName})
+		return nil
+	}
+	return utils.LavaFormatError(message, err, utils.Attribute{Key: "version", Value: version}, utils.Attribute{Key: "file", Value: assetName})
+}
+
+func (bv *BinaryVerifier) fetch(version string, assetName string) ([]byte, error) {
+	if bv.fetchAsset != nil {
+		return bv.fetchAsset(version, assetName)
+	}
+	return fetchReleaseAsset(version, assetName)
+}
+
+func fetchReleaseAsset(version string, assetName string) ([]byte, error) {
+	url := fmt.Sprintf(releaseAssetURLFormat, version, assetName)
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("bad HTTP status %s fetching %s", resp.Status, url)
+	}
+	return io.ReadAll(resp.Body)
+}
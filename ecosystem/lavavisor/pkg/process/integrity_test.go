@@ -0,0 +1,182 @@
+package processmanager
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func TestParseChecksumManifest(t *testing.T) {
+	checksum := hex.EncodeToString(make([]byte, sha256.Size))
+	checksums, err := ParseChecksumManifest([]byte(fmt.Sprintf("# lavap release\n%s  lavap-v1.0.0-linux-amd64\n\n%s *v1.0.0.zip\n", checksum, checksum)))
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"lavap-v1.0.0-linux-amd64": checksum, "v1.0.0.zip": checksum}, checksums)
+
+	_, err = ParseChecksumManifest([]byte("abcd lavap"))
+	require.Error(t, err)
+	_, err = ParseChecksumManifest([]byte(checksum))
+	require.Error(t, err)
+}
+
+func TestBinaryVerifier(t *testing.T) {
+	binaryPath := filepath.Join(t.TempDir(), "lavap")
+	require.NoError(t, os.WriteFile(binaryPath, []byte("lavap binary"), 0o644))
+	checksum := sha256.Sum256([]byte("lavap binary"))
+	manifest := []byte(hex.EncodeToString(checksum[:]) + "  lavap-v1.0.0-linux-amd64\n")
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	signature := []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, manifest)))
+	otherPublicKey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	assets := func(manifest []byte, signature []byte) func(string, string) ([]byte, error) {
+		return func(version string, assetName string) ([]byte, error) {
+			require.Equal(t, "1.0.0", version)
+			switch {
+			case assetName == ChecksumManifestName && manifest != nil:
+				return manifest, nil
+			case assetName == ChecksumSignatureName && signature != nil:
+				return signature, nil
+			}
+			return nil, fmt.Errorf("404 Not Found")
+		}
+	}
+
+	tests := []struct {
+		name     string
+		verifier BinaryVerifier
+		asset    string
+		valid    bool
+	}{
+		{name: "checksum match", verifier: BinaryVerifier{fetchAsset: assets(manifest, nil)}, asset: "lavap-v1.0.0-linux-amd64", valid: true},
+		{name: "checksum mismatch", verifier: BinaryVerifier{fetchAsset: assets([]byte(hex.EncodeToString(make([]byte, sha256.Size))+"  lavap-v1.0.0-linux-amd64"), nil)}, asset: "lavap-v1.0.0-linux-amd64", valid: false},
+		{name: "checksum mismatch allowing unverified", verifier: BinaryVerifier{AllowUnverified: true, fetchAsset: assets([]byte(hex.EncodeToString(make([]byte, sha256.Size))+"  lavap-v1.0.0-linux-amd64"), nil)}, asset: "lavap-v1.0.0-linux-amd64", valid: false},
+		{name: "missing entry", verifier: BinaryVerifier{fetchAsset: assets(manifest, nil)}, asset: "v1.0.0.zip", valid: false},
+		{name: "missing entry allowing unverified", verifier: BinaryVerifier{AllowUnverified: true, fetchAsset: assets(manifest, nil)}, asset: "v1.0.0.zip", valid: true},
+		{name: "missing manifest", verifier: BinaryVerifier{fetchAsset: assets(nil, nil)}, asset: "lavap-v1.0.0-linux-amd64", valid: false},
+		{name: "missing manifest allowing unverified", verifier: BinaryVerifier{AllowUnverified: true, fetchAsset: assets(nil, nil)}, asset: "lavap-v1.0.0-linux-amd64", valid: true},
+		{name: "valid signature", verifier: BinaryVerifier{SigningKey: publicKey, fetchAsset: assets(manifest, signature)}, asset: "lavap-v1.0.0-linux-amd64", valid: true},
+		{name: "signature of another key", verifier: BinaryVerifier{SigningKey: otherPublicKey, fetchAsset: assets(manifest, signature)}, asset: "lavap-v1.0.0-linux-amd64", valid: false},
+		{name: "missing signature", verifier: BinaryVerifier{SigningKey: publicKey, AllowUnverified: true, fetchAsset: assets(manifest, nil)}, asset: "lavap-v1.0.0-linux-amd64", valid: false},
+		{name: "missing manifest with signing key allowing unverified", verifier: BinaryVerifier{SigningKey: publicKey, AllowUnverified: true, fetchAsset: assets(nil, nil)}, asset: "lavap-v1.0.0-linux-amd64", valid: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.verifier.Verify("1.0.0", tt.asset, binaryPath)
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// renders the asset names of .goreleaser.yaml and verifies downloads against a release served with that layout
+func TestVerifyReleaseLayout(t *testing.T) {
+	content, err := os.ReadFile(filepath.Join("..", "..", "..", "..", ".goreleaser.yaml"))
+	require.NoError(t, err)
+	var config struct {
+		ProjectName string `yaml:"project_name"`
+		Archives    []struct {
+			ID           string `yaml:"id"`
+			NameTemplate string `yaml:"name_template"`
+		} `yaml:"archives"`
+		Source struct {
+			Enabled        bool   `yaml:"enabled"`
+			Format         string `yaml:"format"`
+			NameTemplate   string `yaml:"name_template"`
+			PrefixTemplate string `yaml:"prefix_template"`
+		} `yaml:"source"`
+		Checksum struct {
+			NameTemplate string `yaml:"name_template"`
+		} `yaml:"checksum"`
+	}
+	require.NoError(t, yaml.Unmarshal(content, &config))
+
+	version := "1.2.3"
+	render := func(nameTemplate string, binary string) string {
+		tmpl, err := template.New("name").Parse(nameTemplate)
+		require.NoError(t, err)
+		var name strings.Builder
+		require.NoError(t, tmpl.Execute(&name, map[string]string{
+			"ProjectName": config.ProjectName, "Binary": binary, "Version": version,
+			"Os": "linux", "Arch": "amd64", "Arm": "", "Mips": "", "Amd64": "v1",
+		}))
+		return name.String()
+	}
+	require.Equal(t, ChecksumManifestName, render(config.Checksum.NameTemplate, ""))
+	require.True(t, config.Source.Enabled)
+	require.Equal(t, releaseSourceAssetName(version), render(config.Source.NameTemplate, "")+"."+config.Source.Format)
+	// the build expects the source under the same directory as github's tag archive
+	require.Equal(t, "lava-"+version+"/", render(config.Source.PrefixTemplate, ""))
+	var lavapAsset string
+	for _, archive := range config.Archives {
+		if archive.ID == "lavap" {
+			lavapAsset = render(archive.NameTemplate, "lavap")
+		}
+	}
+	require.Equal(t, releaseBinaryAssetName("lavap", version), lavapAsset)
+
+	release := map[string][]byte{lavapAsset: []byte("lavap binary"), releaseSourceAssetName(version): []byte("lava source")}
+	manifest := ""
+	for name, file := range release {
+		checksum := sha256.Sum256(file)
+		manifest += hex.EncodeToString(checksum[:]) + "  " + name + "\n"
+	}
+	release[ChecksumManifestName] = []byte(manifest)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file, ok := release[strings.TrimPrefix(r.URL.Path, "/v"+version+"/")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(file)
+	}))
+	defer server.Close()
+	defaultURLFormat := releaseAssetURLFormat
+	releaseAssetURLFormat = server.URL + "/v%s/%s"
+	defer func() { releaseAssetURLFormat = defaultURLFormat }()
+
+	dir := t.TempDir()
+	verifier := &BinaryVerifier{}
+	for _, name := range []string{lavapAsset, releaseSourceAssetName(version)} {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, release[name], 0o644))
+		require.NoError(t, verifier.Verify(version, name, path))
+		require.NoError(t, os.WriteFile(path, []byte("tampered"), 0o644))
+		require.Error(t, verifier.Verify(version, name, path))
+	}
+}
+
+func TestLoadSigningKey(t *testing.T) {
+	publicKey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"base64": base64.StdEncoding.EncodeToString(publicKey) + "\n",
+		"hex":    hex.EncodeToString(publicKey),
+	} {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+		loaded, err := LoadSigningKey(path)
+		require.NoError(t, err)
+		require.Equal(t, publicKey, loaded)
+	}
+	path := filepath.Join(dir, "short")
+	require.NoError(t, os.WriteFile(path, []byte("abcd"), 0o644))
+	_, err = LoadSigningKey(path)
+	require.Error(t, err)
+}
@@ -47,6 +47,16 @@ func StartProcess(process string) error {
 	return nil
 }
 
+// CheckProcessActive returns an error if the systemd service is not active, e.g. crashed and waiting to be restarted
+func CheckProcessActive(process string) error {
+	cmd := exec.Command("systemctl", "is-active", process)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return utils.LavaFormatError("[Lavavisor] Process is not active", err, utils.Attribute{Key: "process", Value: process}, utils.Attribute{Key: "state", Value: strings.TrimSpace(string(output))})
+	}
+	return nil
+}
+
 func GetBinaryVersion(binaryPath string) (string, error) {
 	cmd := exec.Command(binaryPath, "version")
 	output, err := cmd.Output()
@@ -0,0 +1,135 @@
+package processmanager
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	DefaultUpgradeGraceWindow = 2 * time.Minute
+	healthProbeTimeout        = 10 * time.Second
+)
+
+// UpgradeSafetyConfig controls how lavavisor verifies downloaded binaries and whether it keeps an upgrade
+type UpgradeSafetyConfig struct {
+	Verifier       *BinaryVerifier
+	GraceWindow    time.Duration // a new binary that exits or fails the health probe within the window is rolled back, 0 disables rollbacks
+	HealthProbeURL string        // optional, must answer with a 2xx status at the end of the grace window
+}
+
+func versionFromBinaryPath(binaryPath string) string {
+	// binaries are kept in .lavavisor/upgrades/v<version>/lavap
+	return strings.TrimPrefix(filepath.Base(filepath.Dir(binaryPath)), "v")
+}
+
+func probeHealth(url string) error {
+	if url == "" {
+		return nil
+	}
+	client := http.Client{Timeout: healthProbeTimeout}
+	resp, err := client.Get(url)
+	if err != nil {
+		return fmt.Errorf("health probe failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("health probe returned status %s", resp.Status)
+	}
+	return nil
+}
+
+// watchUpgrade waits for the grace window of an upgrade and rolls it back if the new binary failed
+func (vm *VersionMonitor) watchUpgrade(record UpgradeRecord) {
+	defer vm.watchingUpgrade.Store(false)
+	failure := vm.waitUpgradeGraceWindow()
+	vm.lock.Lock()
+	defer vm.lock.Unlock()
+	if failure == nil {
+		utils.LavaFormatInfo("[Lavavisor] upgraded binary passed the grace window", utils.Attribute{Key: "version", Value: record.ToVersion})
+		record.Time = time.Time{}
+		record.Status = UpgradeStatusHealthy
+		vm.upgradeHistory.Record(record)
+		return
+	}
+	vm.rollback(record, failure)
+}
+
+func (vm *VersionMonitor) waitUpgradeGraceWindow() error {
+	timer := time.NewTimer(vm.upgradeSafety.GraceWindow)
+	defer timer.Stop()
+	select {
+	case err := <-vm.subprocessExited: // only set in the wrap flows
+		return fmt.Errorf("upgraded process exited during the grace window: %w", err)
+	case <-timer.C:
+	}
+	if !vm.isWrapProcess {
+		for _, process := range vm.processes {
+			if err := CheckProcessActive(process); err != nil {
+				return err
+			}
+		}
+	}
+	return probeHealth(vm.upgradeSafety.HealthProbeURL)
+}
+
+// rollback links the previous binary back and restarts the processes with it, the failed version won't be upgraded to again until lavavisor restarts
+func (vm *VersionMonitor) rollback(record UpgradeRecord, failure error) {
+	utils.LavaFormatError("[Lavavisor] upgraded binary failed, rolling back", failure,
+		utils.Attribute{Key: "version", Value: record.ToVersion},
+		utils.Attribute{Key: "previousBinary", Value: record.PreviousBinaryPath},
+	)
+	vm.rolledBackVersions[record.ToVersion] = struct{}{}
+	record.Time = time.Time{}
+	record.Reason = failure.Error()
+	if record.PreviousBinaryPath == "" {
+		record.Status = UpgradeStatusRollbackFailed
+		record.Reason += "; no previous binary to roll back to"
+		vm.upgradeHistory.Record(record)
+		return
+	}
+	vm.BinaryPath = record.PreviousBinaryPath
+	vm.drainSubprocessExits()
+	err := vm.createLink()
+	if err == nil {
+		err = vm.TriggerRestartProcess()
+	}
+	if err != nil {
+		utils.LavaFormatError("[Lavavisor] rollback failed", err, utils.Attribute{Key: "previousBinary", Value: record.PreviousBinaryPath})
+		record.Status = UpgradeStatusRollbackFailed
+		record.Reason += "; " + err.Error()
+		vm.upgradeHistory.Record(record)
+		return
+	}
+	utils.LavaFormatInfo("[Lavavisor] rolled back to the previous binary", utils.Attribute{Key: "version", Value: record.FromVersion})
+	record.Status = UpgradeStatusRolledBack
+	vm.upgradeHistory.Record(record)
+}
+
+func (vm *VersionMonitor) reportSubprocessExit(err error) {
+	if vm.subprocessExited == nil {
+		return
+	}
+	if err == nil {
+		err = fmt.Errorf("exited without error")
+	}
+	select {
+	case vm.subprocessExited <- err:
+	default:
+	}
+}
+
+// drainSubprocessExits discards exits of processes that are about to be replaced
+func (vm *VersionMonitor) drainSubprocessExits() {
+	for {
+		select {
+		case <-vm.subprocessExited:
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package processmanager
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradeRollback(t *testing.T) {
+	lavavisorPath := t.TempDir()
+	previousBinary := filepath.Join(lavavisorPath, "upgrades", "v1.0.0", "lavap")
+	newBinary := filepath.Join(lavavisorPath, "upgrades", "v1.1.0", "lavap")
+	newVersionMonitor := func() *VersionMonitor {
+		vm := NewVersionMonitorProcessPodFlow("1.1.0", lavavisorPath, "lavap rpcprovider", UpgradeSafetyConfig{GraceWindow: 50 * time.Millisecond})
+		require.Equal(t, newBinary, vm.BinaryPath)
+		vm.watchingUpgrade.Store(true)
+		return vm
+	}
+	record := UpgradeRecord{FromVersion: "1.0.0", ToVersion: versionFromBinaryPath(newBinary), BinaryPath: newBinary, PreviousBinaryPath: previousBinary}
+
+	// the upgraded process survives the grace window
+	vm := newVersionMonitor()
+	vm.watchUpgrade(record)
+	require.Equal(t, newBinary, vm.BinaryPath)
+	require.False(t, vm.watchingUpgrade.Load())
+
+	// the upgraded process exits during the grace window
+	vm = newVersionMonitor()
+	vm.reportSubprocessExit(fmt.Errorf("exit status 1"))
+	vm.watchUpgrade(record)
+	require.Equal(t, previousBinary, vm.BinaryPath)
+	require.Contains(t, vm.rolledBackVersions, "1.1.0")
+	require.False(t, vm.watchingUpgrade.Load())
+
+	// nothing to roll back to
+	vm = newVersionMonitor()
+	vm.reportSubprocessExit(nil)
+	noPreviousRecord := record
+	noPreviousRecord.PreviousBinaryPath = ""
+	vm.watchUpgrade(noPreviousRecord)
+	require.Equal(t, newBinary, vm.BinaryPath)
+
+	records, err := NewUpgradeHistory(lavavisorPath).Records()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	require.Equal(t, UpgradeStatusHealthy, records[0].Status)
+	require.Equal(t, UpgradeStatusRolledBack, records[1].Status)
+	require.Contains(t, records[1].Reason, "exit status 1")
+	require.Equal(t, "1.1.0", records[1].ToVersion)
+	require.Equal(t, UpgradeStatusRollbackFailed, records[2].Status)
+	require.False(t, records[2].Time.IsZero())
+}
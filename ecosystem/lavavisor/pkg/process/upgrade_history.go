@@ -0,0 +1,92 @@
+package processmanager
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+)
+
+const UpgradeHistoryFileName = "upgrade_history.jsonl"
+
+type UpgradeStatus string
+
+const (
+	UpgradeStatusUpgraded       UpgradeStatus = "upgraded"        // the new binary was linked and the processes restarted
+	UpgradeStatusHealthy        UpgradeStatus = "healthy"         // the new binary passed the grace window
+	UpgradeStatusRolledBack     UpgradeStatus = "rolled_back"     // the new binary failed and the previous one was restored
+	UpgradeStatusRollbackFailed UpgradeStatus = "rollback_failed" // the new binary failed and restoring the previous one failed too
+)
+
+// UpgradeRecord is a single line of the upgrade history file
+type UpgradeRecord struct {
+	Time               time.Time     `json:"time"`
+	Status             UpgradeStatus `json:"status"`
+	FromVersion        string        `json:"from_version"`
+	ToVersion          string        `json:"to_version"`
+	BinaryPath         string        `json:"binary_path"`
+	PreviousBinaryPath string        `json:"previous_binary_path"`
+	Reason             string        `json:"reason,omitempty"`
+}
+
+// UpgradeHistory appends upgrade records as json lines to a file in the lavavisor directory
+type UpgradeHistory struct {
+	path string
+	lock sync.Mutex
+}
+
+func NewUpgradeHistory(lavavisorPath string) *UpgradeHistory {
+	return &UpgradeHistory{path: filepath.Join(lavavisorPath, UpgradeHistoryFileName)}
+}
+
+func (uh *UpgradeHistory) Record(record UpgradeRecord) {
+	if uh == nil {
+		return
+	}
+	if record.Time.IsZero() {
+		record.Time = time.Now().UTC()
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		utils.LavaFormatError("[Lavavisor] failed marshaling upgrade record", err)
+		return
+	}
+	uh.lock.Lock()
+	defer uh.lock.Unlock()
+	file, err := os.OpenFile(uh.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		utils.LavaFormatError("[Lavavisor] failed opening upgrade history file", err, utils.Attribute{Key: "path", Value: uh.path})
+		return
+	}
+	defer file.Close()
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		utils.LavaFormatError("[Lavavisor] failed writing upgrade history file", err, utils.Attribute{Key: "path", Value: uh.path})
+	}
+}
+
+// Records reads the whole upgrade history, oldest first
+func (uh *UpgradeHistory) Records() ([]UpgradeRecord, error) {
+	uh.lock.Lock()
+	defer uh.lock.Unlock()
+	content, err := os.ReadFile(uh.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	records := []UpgradeRecord{}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	for decoder.More() {
+		var record UpgradeRecord
+		if err := decoder.Decode(&record); err != nil {
+			return records, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
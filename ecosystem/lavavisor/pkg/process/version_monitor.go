@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -43,9 +44,14 @@ type VersionMonitor struct {
 	LaunchedServices      bool // indicates whether version was matching or not so we can decide wether to launch services
 	onGoingCmd            *exec.Cmd
 	command               []string
+	upgradeSafety         UpgradeSafetyConfig
+	upgradeHistory        *UpgradeHistory
+	rolledBackVersions    map[string]struct{}
+	watchingUpgrade       atomic.Bool
+	subprocessExited      chan error // exits of the wrapped subprocess that lavavisor didn't kill
 }
 
-func NewVersionMonitor(initVersion string, lavavisorPath string, processes []string, autoDownload bool) *VersionMonitor {
+func NewVersionMonitor(initVersion string, lavavisorPath string, processes []string, autoDownload bool, upgradeSafety UpgradeSafetyConfig) *VersionMonitor {
 	var binaryPath string
 	if initVersion != "" { // handle case if not found valid lavap at all
 		versionDir := filepath.Join(lavavisorPath, "upgrades", "v"+initVersion)
@@ -54,6 +60,7 @@ func NewVersionMonitor(initVersion string, lavavisorPath string, processes []str
 	fetcher := &ProtocolBinaryFetcher{
 		lavavisorPath: lavavisorPath,
 		AutoDownload:  autoDownload,
+		Verifier:      upgradeSafety.Verifier,
 	}
 	return &VersionMonitor{
 		BinaryPath:            binaryPath,
@@ -63,6 +70,9 @@ func NewVersionMonitor(initVersion string, lavavisorPath string, processes []str
 		protocolBinaryFetcher: fetcher,
 		protocolBinaryLinker:  &ProtocolBinaryLinker{Fetcher: fetcher},
 		lock:                  sync.Mutex{},
+		upgradeSafety:         upgradeSafety,
+		upgradeHistory:        NewUpgradeHistory(lavavisorPath),
+		rolledBackVersions:    map[string]struct{}{},
 	}
 }
 
@@ -76,19 +86,40 @@ func (vm *VersionMonitor) handleUpdateTrigger(currentBinaryVersion string) error
 		utils.LavaFormatError("[Lavavisor] for some reason the vm.protocolBinaryFetcher is nil", nil)
 	}
 	// fetcher
-	_, err := vm.protocolBinaryFetcher.FetchProtocolBinary(vm.lastKnownVersion)
+	binaryPath, err := vm.protocolBinaryFetcher.FetchProtocolBinary(vm.lastKnownVersion)
 	if err != nil {
 		return utils.LavaFormatError("[Lavavisor] Lavavisor was not able to fetch updated version. Skipping.", err, utils.Attribute{Key: "Version", Value: vm.lastKnownVersion.ProviderTarget})
 	}
-	versionDir := filepath.Join(vm.LavavisorPath, "upgrades", "v"+vm.lastKnownVersion.ProviderTarget)
-	binaryPath := filepath.Join(versionDir, "lavap")
+	newVersion := versionFromBinaryPath(binaryPath)
+	if _, ok := vm.rolledBackVersions[newVersion]; ok {
+		return utils.LavaFormatWarning("[Lavavisor] Version was rolled back after failing, restart lavavisor to retry upgrading to it", nil, utils.Attribute{Key: "Version", Value: newVersion})
+	}
+	// the previous version directory is kept so the upgrade can be rolled back
+	record := UpgradeRecord{
+		FromVersion:        currentBinaryVersion,
+		ToVersion:          newVersion,
+		BinaryPath:         binaryPath,
+		PreviousBinaryPath: vm.BinaryPath,
+	}
 	vm.BinaryPath = binaryPath // updating new binary path for validating new binary
 
 	err = vm.createLink()
 	if err != nil {
+		vm.BinaryPath = record.PreviousBinaryPath
 		return err
 	}
-	return vm.TriggerRestartProcess()
+	vm.drainSubprocessExits()
+	err = vm.TriggerRestartProcess()
+	if err != nil {
+		return err
+	}
+	record.Status = UpgradeStatusUpgraded
+	vm.upgradeHistory.Record(record)
+	if vm.upgradeSafety.GraceWindow > 0 {
+		vm.watchingUpgrade.Store(true)
+		go vm.watchUpgrade(record)
+	}
+	return nil
 }
 
 // create link to the golang go env path of "lavap"
@@ -199,6 +230,10 @@ func (vm *VersionMonitor) ValidateProtocolVersion(incoming *updaters.ProtocolVer
 		return nil
 	}
 	defer vm.lock.Unlock()
+	if vm.watchingUpgrade.Load() {
+		utils.LavaFormatDebug("[Lavavisor] upgrade grace window is ongoing, skipping version validation")
+		return nil
+	}
 	currentBinaryVersion, _ := GetBinaryVersion(vm.BinaryPath)
 	vm.lastKnownVersion = incoming.Version
 
@@ -276,6 +311,7 @@ func (vm *VersionMonitor) startSubprocess(keyringPassword *KeyRingPassword) {
 	// vm.onGoingCmd.Stderr = os.Stderr
 
 	foundPasswordTrigger := make(chan struct{})
+	stderrClosed := make(chan struct{})
 	processStart := common.ProcessStartLogText
 	stderrPipe, err := vm.onGoingCmd.StderrPipe()
 	if err != nil {
@@ -291,32 +327,40 @@ func (vm *VersionMonitor) startSubprocess(keyringPassword *KeyRingPassword) {
 	}
 
 	go func() {
+		defer close(stderrClosed)
+		var foundOnce sync.Once
 		scanner := bufio.NewScanner(stderrPipe)
 		for scanner.Scan() {
 			line := scanner.Text()
 			fmt.Println(line)
 			if strings.Contains(line, processStart) {
-				foundPasswordTrigger <- struct{}{}
+				foundOnce.Do(func() { close(foundPasswordTrigger) })
 			}
 		}
 	}()
 
 	if err := vm.onGoingCmd.Start(); err != nil {
 		utils.LavaFormatError("[Lavavisor] Error starting subprocess:", err)
+		vm.reportSubprocessExit(err)
+		return
 	}
 
-	<-foundPasswordTrigger
-	// wait to make sure process is waiting for password
-	time.Sleep(time.Second * 3)
+	select {
+	case <-foundPasswordTrigger:
+		// wait to make sure process is waiting for password
+		time.Sleep(time.Second * 3)
 
-	if keyringPassword != nil && keyringPassword.Password {
-		// Send input to the command
-		_, err = stdin.Write([]byte(keyringPassword.Passphrase + "\n"))
-		if err != nil {
-			fmt.Println("Error writing to stdin:", err)
-			return
+		if keyringPassword != nil && keyringPassword.Password {
+			// Send input to the command
+			_, err = stdin.Write([]byte(keyringPassword.Passphrase + "\n"))
+			if err != nil {
+				fmt.Println("Error writing to stdin:", err)
+				return
+			}
+			utils.LavaFormatInfo("[Lavavisor] entered keyring-os password.")
 		}
-		utils.LavaFormatInfo("[Lavavisor] entered keyring-os password.")
+	case <-stderrClosed:
+		// the process closed stderr before it started, it exited during startup
 	}
 	stdin.Close() // Flush the input stream (this sends the input to the process)
 
@@ -325,13 +369,15 @@ func (vm *VersionMonitor) startSubprocess(keyringPassword *KeyRingPassword) {
 			utils.LavaFormatInfo("[Lavavisor] Subprocess stopped due to sig killed.")
 		} else {
 			utils.LavaFormatError("[Lavavisor] Subprocess exited with error", err)
+			vm.reportSubprocessExit(err)
 		}
 	} else {
 		utils.LavaFormatInfo("[Lavavisor] Subprocess exited without error.")
+		vm.reportSubprocessExit(nil)
 	}
 }
 
-func NewVersionMonitorProcessWrapFlow(initVersion string, lavavisorPath string, autoDownload bool, command string) *VersionMonitor {
+func NewVersionMonitorProcessWrapFlow(initVersion string, lavavisorPath string, autoDownload bool, command string, upgradeSafety UpgradeSafetyConfig) *VersionMonitor {
 	var binaryPath string
 	if initVersion != "" { // handle case if not found valid lavap at all
 		versionDir := filepath.Join(lavavisorPath, "upgrades", "v"+initVersion)
@@ -340,6 +386,7 @@ func NewVersionMonitorProcessWrapFlow(initVersion string, lavavisorPath string,
 	fetcher := &ProtocolBinaryFetcher{
 		lavavisorPath: lavavisorPath,
 		AutoDownload:  autoDownload,
+		Verifier:      upgradeSafety.Verifier,
 	}
 
 	// Check if the string starts with "lavap"
@@ -354,10 +401,14 @@ func NewVersionMonitorProcessWrapFlow(initVersion string, lavavisorPath string,
 		isWrapProcess:         true,
 		restart:               make(chan struct{}),
 		command:               strings.Fields(command),
+		upgradeSafety:         upgradeSafety,
+		upgradeHistory:        NewUpgradeHistory(lavavisorPath),
+		rolledBackVersions:    map[string]struct{}{},
+		subprocessExited:      make(chan error, 1),
 	}
 }
 
-func NewVersionMonitorProcessPodFlow(initVersion string, lavavisorPath string, command string) *VersionMonitor {
+func NewVersionMonitorProcessPodFlow(initVersion string, lavavisorPath string, command string, upgradeSafety UpgradeSafetyConfig) *VersionMonitor {
 	var binaryPath string
 	if initVersion != "" { // handle case if not found valid lavap at all
 		versionDir := filepath.Join(lavavisorPath, "upgrades", "v"+initVersion)
@@ -365,6 +416,7 @@ func NewVersionMonitorProcessPodFlow(initVersion string, lavavisorPath string, c
 	}
 	fetcher := &ProtocolBinaryFetcherWithoutBuild{
 		lavavisorPath: lavavisorPath,
+		Verifier:      upgradeSafety.Verifier,
 	}
 
 	// Check if the string starts with "lavap"
@@ -378,5 +430,9 @@ func NewVersionMonitorProcessPodFlow(initVersion string, lavavisorPath string, c
 		isWrapProcess:         true,
 		restart:               make(chan struct{}),
 		command:               strings.Fields(command),
+		upgradeSafety:         upgradeSafety,
+		upgradeHistory:        NewUpgradeHistory(lavavisorPath),
+		rolledBackVersions:    map[string]struct{}{},
+		subprocessExited:      make(chan error, 1),
 	}
 }
@@ -47,11 +47,12 @@ func NewLavaVisorStateTracker(ctx context.Context, txFactory tx.Factory, clientC
 	return lst, nil
 }
 
-func (lst *LavaVisorStateTracker) RegisterForVersionUpdates(ctx context.Context, version *protocoltypes.Version, versionValidator updaters.VersionValidationInf) {
+func (lst *LavaVisorStateTracker) RegisterForVersionUpdates(ctx context.Context, version *protocoltypes.Version, versionValidator updaters.VersionValidationInf, chainIDs ...string) {
 	lst.versionUpdater = &LavaVisorVersionUpdater{VersionUpdater: updaters.VersionUpdater{
 		VersionStateQuery:    lst.stateQuery,
 		LastKnownVersion:     &updaters.ProtocolVersionResponse{Version: version, BlockNumber: "uninitialized"},
 		VersionValidationInf: versionValidator,
+		ChainIDs:             chainIDs,
 	}}
 	lst.ticker = time.NewTicker(lst.averageBlockTime)
 	lst.versionUpdater.Update()
@@ -69,8 +70,9 @@ func (lst *LavaVisorStateTracker) RegisterForVersionUpdates(ctx context.Context,
 	}()
 }
 
-func (lst *LavaVisorStateTracker) GetProtocolVersion(ctx context.Context) (*updaters.ProtocolVersionResponse, error) {
-	return lst.stateQuery.GetProtocolVersion(ctx)
+// GetProtocolVersion returns the global protocol version, raised to the effective version of the given chains
+func (lst *LavaVisorStateTracker) GetProtocolVersion(ctx context.Context, chainIDs ...string) (*updaters.ProtocolVersionResponse, error) {
+	return updaters.FetchProtocolVersion(ctx, lst.stateQuery, chainIDs)
 }
 
 type LavaVisorVersionUpdater struct {
@@ -84,7 +86,7 @@ func (vu *LavaVisorVersionUpdater) Update() {
 	vu.Lock.Lock()
 	defer vu.Lock.Unlock()
 	// fetch updated version from consensus
-	version, err := vu.VersionStateQuery.GetProtocolVersion(context.Background())
+	version, err := updaters.FetchProtocolVersion(context.Background(), vu.VersionStateQuery, vu.ChainIDs)
 	if err != nil {
 		utils.LavaFormatError("[Lavavisor] could not get version from node, its possible the node is down", err)
 		return
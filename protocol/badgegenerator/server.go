@@ -271,7 +271,7 @@ func (s *Server) addPairingListToResponse(request *pairingtypes.GenerateBadgeReq
 func signTheResponse(privateKeyString string, response *pairingtypes.GenerateBadgeResponse) error {
 	privateKeyBytes, _ := hex.DecodeString(privateKeyString)
 	privateKey, _ := btcSecp256k1.PrivKeyFromBytes(btcSecp256k1.S256(), privateKeyBytes)
-	signature, err := sigs.Sign(privateKey, *response.Badge)
+	signature, err := sigs.NewPrivateKeySigner(privateKey).Sign(*response.Badge)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,128 @@
+package badgeserver
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/metadata"
+)
+
+// authenticatedKey is the api key a badge request was authenticated with, an empty id is an anonymous request
+type authenticatedKey struct {
+	id  string
+	key ApiKeyConfiguration
+}
+
+// SignBadgeToken creates a token a dApp backend hands to its users so they can get badges for badgeAddress
+// without holding the api key secret. the token is "<key id>.<expiry unix seconds>.<hex hmac-sha256>"
+func SignBadgeToken(keyId string, secret string, badgeAddress string, projectId string, expiry time.Time) string {
+	keyId = strings.ToLower(keyId)
+	expiryString := strconv.FormatInt(expiry.Unix(), 10)
+	return keyId + "." + expiryString + "." + badgeTokenSignature(keyId, secret, expiryString, badgeAddress, projectId)
+}
+
+func badgeTokenSignature(keyId string, secret string, expiry string, badgeAddress string, projectId string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strings.Join([]string{keyId, expiry, badgeAddress, strings.ToLower(projectId)}, ".")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *Server) authenticate(md metadata.MD, request *pairingtypes.GenerateBadgeRequest, now time.Time) (authenticatedKey, error) {
+	auth := authenticatedKey{}
+	if tokens := md.Get(BadgeTokenHeaderKey); len(tokens) > 0 {
+		keyId, key, err := s.verifyBadgeToken(tokens[0], request, now)
+		if err != nil {
+			return auth, err
+		}
+		auth = authenticatedKey{id: keyId, key: key}
+	} else if secrets := md.Get(ApiKeyHeaderKey); len(secrets) > 0 {
+		keyId, key, found := s.Registry.GetApiKeyBySecret(secrets[0])
+		if !found {
+			return auth, fmt.Errorf("unknown api key")
+		}
+		auth = authenticatedKey{id: keyId, key: key}
+	} else if s.Registry.AuthRequired() {
+		return auth, fmt.Errorf("missing %s or %s header", ApiKeyHeaderKey, BadgeTokenHeaderKey)
+	} else {
+		return auth, nil
+	}
+
+	if auth.key.Revoked {
+		return auth, fmt.Errorf("api key %s is revoked", auth.id)
+	}
+	if auth.key.ProjectId != "" && request.ProjectId != "" && !strings.EqualFold(request.ProjectId, auth.key.ProjectId) {
+		return auth, fmt.Errorf("api key %s is not allowed to get badges for project %s", auth.id, request.ProjectId)
+	}
+	if !s.rateLimiter.allow(auth.id, auth.key.BadgesPerMinute, now) {
+		return auth, fmt.Errorf("api key %s exceeded its limit of %d badges per minute", auth.id, auth.key.BadgesPerMinute)
+	}
+	return auth, nil
+}
+
+func (s *Server) verifyBadgeToken(token string, request *pairingtypes.GenerateBadgeRequest, now time.Time) (string, ApiKeyConfiguration, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", ApiKeyConfiguration{}, fmt.Errorf("malformed badge token")
+	}
+	keyId, expiryString, signature := parts[0], parts[1], parts[2]
+	expiry, err := strconv.ParseInt(expiryString, 10, 64)
+	if err != nil {
+		return "", ApiKeyConfiguration{}, fmt.Errorf("malformed badge token expiry")
+	}
+	expiryTime := time.Unix(expiry, 0)
+	if now.After(expiryTime) {
+		return "", ApiKeyConfiguration{}, fmt.Errorf("badge token expired")
+	}
+	if expiryTime.Sub(now) > MaxBadgeTokenTTL {
+		return "", ApiKeyConfiguration{}, fmt.Errorf("badge token expiry is more than %s away", MaxBadgeTokenTTL)
+	}
+	key, found := s.Registry.GetApiKey(keyId)
+	if !found {
+		return "", ApiKeyConfiguration{}, fmt.Errorf("unknown api key")
+	}
+	expected := badgeTokenSignature(strings.ToLower(keyId), key.Secret, expiryString, request.BadgeAddress, request.ProjectId)
+	if !hmac.Equal([]byte(expected), []byte(strings.ToLower(signature))) {
+		return "", ApiKeyConfiguration{}, fmt.Errorf("invalid badge token signature")
+	}
+	return strings.ToLower(keyId), key, nil
+}
+
+// keyRateLimiter counts the badges each api key got in the current minute
+type keyRateLimiter struct {
+	lock    sync.Mutex
+	windows map[string]*rateWindow
+}
+
+type rateWindow struct {
+	start time.Time
+	count int64
+}
+
+func newKeyRateLimiter() *keyRateLimiter {
+	return &keyRateLimiter{windows: map[string]*rateWindow{}}
+}
+
+func (krl *keyRateLimiter) allow(keyId string, perMinute int64, now time.Time) bool {
+	if keyId == "" || perMinute <= 0 {
+		return true
+	}
+	krl.lock.Lock()
+	defer krl.lock.Unlock()
+	window, ok := krl.windows[keyId]
+	if !ok || now.Sub(window.start) >= time.Minute {
+		window = &rateWindow{start: now}
+		krl.windows[keyId] = window
+	}
+	if window.count >= perMinute {
+		return false
+	}
+	window.count++
+	return true
+}
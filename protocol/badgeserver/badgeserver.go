@@ -13,10 +13,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server/grpc/gogoreflection"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"github.com/lavanet/lava/app"
 	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/remotesigner"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/rand"
 	"github.com/lavanet/lava/utils/sigs"
@@ -84,6 +85,9 @@ func CreateBadgeServerCobraCommand() *cobra.Command {
 	cmd.Flags().String(flags.FlagFrom, "", "Name or address of private key with which to sign")
 	cmd.Flags().String(flags.FlagChainID, app.Name, "The network chain ID")
 	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
+	cmd.Flags().String(common.RemoteSignerFlag, "", "unix socket of a remote signer (lavap signer) to sign badges with, instead of loading the key from the keyring. The --from key must be the signer's key")
+	cmd.Flags().String(ProjectsFileFieldName, "", "yml file with the projects-data, api-keys and revoked-badges, reloaded on changes instead of reading them from the config")
+	cmd.Flags().Duration(ProjectsReloadFieldName, DefaultProjectsReloadInterval, "how often to check the projects file for changes")
 
 	return cmd
 }
@@ -122,13 +126,8 @@ func RunBadgeServer(cmd *cobra.Command, v *viper.Viper) {
 	}
 	chainId := v.GetString(LavaChainIDFieldName)
 
-	projectsData := make(GelocationToProjectsConfiguration)
-	err = v.UnmarshalKey(ProjectDataFieldName, &projectsData)
-	if err != nil {
-		utils.LavaFormatFatal("Error in unmarshalling projects data", err)
-	}
-
 	ctx := context.Background()
+	registry := loadProjectRegistry(ctx, cmd, v)
 	clientCtx, err := client.GetClientTxContext(cmd)
 	if err != nil {
 		utils.LavaFormatFatal("Error initiating client to lava", err)
@@ -139,21 +138,16 @@ func RunBadgeServer(cmd *cobra.Command, v *viper.Viper) {
 		utils.LavaFormatFatal("failed getting key name from clientCtx", err)
 	}
 
-	clientKey, _ := clientCtx.Keyring.Key(keyName)
-	pubKey, err := clientKey.GetPubKey()
+	remoteSignerSocket, err := cmd.Flags().GetString(common.RemoteSignerFlag)
 	if err != nil {
-		utils.LavaFormatFatal("failed getting public key from key name", err)
+		utils.LavaFormatFatal("failed to read remote signer flag", err)
 	}
-
-	var pubKeyAddr sdk.AccAddress
-	err = pubKeyAddr.Unmarshal(pubKey.Address())
+	signer, err := remotesigner.GetSigner(ctx, clientCtx, keyName, remoteSignerSocket)
 	if err != nil {
-		utils.LavaFormatFatal("failed unmarshalling public address", err)
+		utils.LavaFormatFatal("failed getting signer", err, utils.Attribute{Key: "keyName", Value: keyName})
 	}
-
-	privKey, err := sigs.GetPrivKey(clientCtx, keyName)
-	if err != nil {
-		utils.LavaFormatFatal("failed getting private key from key name", err, utils.Attribute{Key: "keyName", Value: keyName})
+	if !signer.Address().Equals(clientCtx.GetFromAddress()) {
+		utils.LavaFormatFatal("signer address does not match the badge server address", nil, utils.Attribute{Key: "signer", Value: signer.Address().String()}, utils.Attribute{Key: "address", Value: clientCtx.GetFromAddress().String()})
 	}
 
 	lavaChainFetcher := chainlib.NewLavaChainFetcher(ctx, clientCtx)
@@ -162,7 +156,7 @@ func RunBadgeServer(cmd *cobra.Command, v *viper.Viper) {
 		utils.LavaFormatFatal("Error initiating state tracker", err)
 	}
 	// setting stateTracker in server so we can register for spec updates.
-	server, err := NewServer(ipService, chainId, projectsData, lavaChainFetcher, clientCtx, pubKeyAddr.String(), privKey)
+	server, err := NewServer(ipService, chainId, registry, lavaChainFetcher, clientCtx, signer.Address().String(), signer)
 	if err != nil {
 		utils.LavaFormatFatal("Error in server creation", err)
 	}
@@ -180,7 +174,7 @@ func RunBadgeServer(cmd *cobra.Command, v *viper.Viper) {
 	handler := func(resp http.ResponseWriter, req *http.Request) {
 		// Set CORS headers
 		resp.Header().Set("Access-Control-Allow-Origin", "*")
-		resp.Header().Set("Access-Control-Allow-Headers", "Content-Type,x-grpc-web")
+		resp.Header().Set("Access-Control-Allow-Headers", "Content-Type,x-grpc-web,"+ApiKeyHeaderKey+","+BadgeTokenHeaderKey)
 
 		wrappedServer.ServeHTTP(resp, req)
 	}
@@ -190,6 +184,7 @@ func RunBadgeServer(cmd *cobra.Command, v *viper.Viper) {
 	go func() {
 		metricsPort := v.GetString(MetricsPortFieldName)
 		http.Handle("/metrics", promhttp.Handler())
+		http.HandleFunc(RevocationListPath, server.ServeRevocationList)
 		http.ListenAndServe(":"+metricsPort, nil)
 	}()
 
@@ -198,3 +193,43 @@ func RunBadgeServer(cmd *cobra.Command, v *viper.Viper) {
 		utils.LavaFormatFatal("Http Server failed to start", err)
 	}
 }
+
+// loadProjectRegistry loads the projects from the projects file when one is configured, and from the badge server config otherwise
+func loadProjectRegistry(ctx context.Context, cmd *cobra.Command, v *viper.Viper) *ProjectRegistry {
+	v.BindPFlag(ProjectsFileFieldName, cmd.Flags().Lookup(ProjectsFileFieldName))
+	v.BindPFlag(ProjectsReloadFieldName, cmd.Flags().Lookup(ProjectsReloadFieldName))
+
+	var registry *ProjectRegistry
+	projectsFile := v.GetString(ProjectsFileFieldName)
+	if projectsFile != "" {
+		var err error
+		registry, err = NewProjectRegistryFromFile(projectsFile)
+		if err != nil {
+			utils.LavaFormatFatal("Error loading projects file", err)
+		}
+		go registry.WatchFile(ctx, v.GetDuration(ProjectsReloadFieldName))
+	} else {
+		registryConfig := RegistryConfiguration{ProjectsData: make(GelocationToProjectsConfiguration)}
+		err := v.UnmarshalKey(ProjectDataFieldName, &registryConfig.ProjectsData)
+		if err != nil {
+			utils.LavaFormatFatal("Error in unmarshalling projects data", err)
+		}
+		err = v.UnmarshalKey(ApiKeysFieldName, &registryConfig.ApiKeys)
+		if err != nil {
+			utils.LavaFormatFatal("Error in unmarshalling api keys", err)
+		}
+		err = v.UnmarshalKey(RevokedBadgesFieldName, &registryConfig.RevokedBadges)
+		if err != nil {
+			utils.LavaFormatFatal("Error in unmarshalling revoked badges", err)
+		}
+		err = registryConfig.Validate()
+		if err != nil {
+			utils.LavaFormatFatal("Invalid api keys configuration", err)
+		}
+		registry = NewStaticProjectRegistry(registryConfig)
+	}
+	if !registry.AuthRequired() {
+		utils.LavaFormatWarning("no api keys are configured, the badge server gives badges to anyone", nil)
+	}
+	return registry
+}
@@ -1,5 +1,7 @@
 package badgeserver
 
+import "time"
+
 const (
 	PortFieldName               = "port"
 	MetricsPortFieldName        = "metrics-port"
@@ -8,8 +10,23 @@ const (
 	DefaultGeolocationFieldName = "default-geolocation"
 	CountriesFilePathFieldName  = "countries-file-path"
 	IpFilePathFieldName         = "ip-file-path"
+	ApiKeysFieldName            = "api-keys"
+	RevokedBadgesFieldName      = "revoked-badges"
+	ProjectsFileFieldName       = "projects-file"
+	ProjectsReloadFieldName     = "projects-reload-interval"
 )
 
 const DefaultProjectId = "default"
 
-const RefererHeaderKey = "Referer"
+const (
+	RefererHeaderKey    = "Referer"
+	ApiKeyHeaderKey     = "x-api-key"
+	BadgeTokenHeaderKey = "x-badge-token"
+)
+
+const (
+	RevocationListPath             = "/revoked-badges"
+	DefaultProjectsReloadInterval  = 30 * time.Second
+	DefaultRevocationListsInterval = 10 * time.Second
+	MaxBadgeTokenTTL               = time.Hour
+)
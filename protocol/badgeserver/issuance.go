@@ -0,0 +1,89 @@
+package badgeserver
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// badgeIssuance tracks the badges issued in the latest epochs, for the projects cu quotas and for revoking the badges issued with revoked keys.
+// badges are only valid in their own epoch so older epochs are dropped
+type badgeIssuance struct {
+	lock   sync.Mutex
+	epochs map[uint64]*epochIssuance
+}
+
+type epochIssuance struct {
+	projectsCu map[string]uint64                // project id -> cu allocated in badges
+	badges     map[badgeKey]map[string]struct{} // issued badge -> api key ids it was issued with
+}
+
+type badgeKey struct {
+	address   string
+	projectId string
+}
+
+const issuanceEpochsToKeep = 2
+
+func newBadgeIssuance() *badgeIssuance {
+	return &badgeIssuance{epochs: map[uint64]*epochIssuance{}}
+}
+
+// reserve records a badge, a badge that was already issued in the epoch doesn't use more of the project's quota
+func (bi *badgeIssuance) reserve(epoch uint64, projectId string, keyId string, address string, cu uint64, quota int64) error {
+	bi.lock.Lock()
+	defer bi.lock.Unlock()
+	issuance := bi.getOrCreateEpochUnsafe(epoch)
+	badge := badgeKey{address: address, projectId: projectId}
+	keys, issued := issuance.badges[badge]
+	if !issued {
+		if quota > 0 && issuance.projectsCu[projectId]+cu > uint64(quota) {
+			return fmt.Errorf("project %s exhausted its quota of %d cu for epoch %d", projectId, quota, epoch)
+		}
+		issuance.projectsCu[projectId] += cu
+		keys = map[string]struct{}{}
+		issuance.badges[badge] = keys
+	}
+	if keyId != "" {
+		keys[keyId] = struct{}{}
+	}
+	return nil
+}
+
+func (bi *badgeIssuance) getOrCreateEpochUnsafe(epoch uint64) *epochIssuance {
+	issuance, ok := bi.epochs[epoch]
+	if ok {
+		return issuance
+	}
+	issuance = &epochIssuance{projectsCu: map[string]uint64{}, badges: map[badgeKey]map[string]struct{}{}}
+	bi.epochs[epoch] = issuance
+	if len(bi.epochs) > issuanceEpochsToKeep {
+		epochs := make([]uint64, 0, len(bi.epochs))
+		for epoch := range bi.epochs {
+			epochs = append(epochs, epoch)
+		}
+		sort.Slice(epochs, func(i, j int) bool { return epochs[i] > epochs[j] })
+		for _, oldEpoch := range epochs[issuanceEpochsToKeep:] {
+			delete(bi.epochs, oldEpoch)
+		}
+	}
+	return issuance
+}
+
+// revokedBadges returns the tracked badges issued with a revoked key
+func (bi *badgeIssuance) revokedBadges(isKeyRevoked func(keyId string) bool) []RevokedBadge {
+	bi.lock.Lock()
+	defer bi.lock.Unlock()
+	revoked := []RevokedBadge{}
+	for epoch, issuance := range bi.epochs {
+		for badge, keys := range issuance.badges {
+			for keyId := range keys {
+				if isKeyRevoked(keyId) {
+					revoked = append(revoked, RevokedBadge{Address: badge.address, Epoch: epoch})
+					break
+				}
+			}
+		}
+	}
+	return revoked
+}
@@ -6,10 +6,26 @@ type GelocationToProjectsConfiguration map[string]map[string]*ProjectConfigurati
 
 type ProjectConfiguration struct {
 	EpochsMaxCu  int64                                     `yaml:"epochs-max-cu,omitempty" json:"epochs-max-cu,omitempty" mapstructure:"epochs-max-cu,omitempty"`
+	EpochCuQuota int64                                     `yaml:"epoch-cu-quota,omitempty" json:"epoch-cu-quota,omitempty" mapstructure:"epoch-cu-quota,omitempty"` // total cu of the badges issued for the project per epoch, 0 is unlimited
 	UpdatedEpoch map[string]uint64                         `yaml:"update-epoch,omitempty" json:"update-epoch,omitempty" mapstructure:"update-epoch,omitempty"`
 	PairingList  map[string]*types.QueryGetPairingResponse `yaml:"pairing-list,omitempty" json:"pairing-list,omitempty" mapstructure:"pairing-list,omitempty"`
 }
 
+// ApiKeyConfiguration is a key a dApp authenticates with, either by sending the secret or a token signed with it
+type ApiKeyConfiguration struct {
+	ProjectId       string `yaml:"project-id" json:"project-id" mapstructure:"project-id"`
+	Secret          string `yaml:"secret" json:"secret" mapstructure:"secret"`
+	BadgesPerMinute int64  `yaml:"badges-per-minute,omitempty" json:"badges-per-minute,omitempty" mapstructure:"badges-per-minute,omitempty"` // 0 is unlimited
+	Revoked         bool   `yaml:"revoked,omitempty" json:"revoked,omitempty" mapstructure:"revoked,omitempty"`                               // rejects the key and revokes the badges issued with it
+}
+
+// RegistryConfiguration is the content of the projects registry, either from the badge server config or from a reloadable projects file
+type RegistryConfiguration struct {
+	ProjectsData  GelocationToProjectsConfiguration `yaml:"projects-data" json:"projects-data" mapstructure:"projects-data"`
+	ApiKeys       map[string]*ApiKeyConfiguration   `yaml:"api-keys,omitempty" json:"api-keys,omitempty" mapstructure:"api-keys,omitempty"`
+	RevokedBadges []string                          `yaml:"revoked-badges,omitempty" json:"revoked-badges,omitempty" mapstructure:"revoked-badges,omitempty"` // badge addresses whose badges are revoked
+}
+
 type UserBadgeItem struct {
 	AllowedCu int64
 	Epoch     uint64
@@ -0,0 +1,205 @@
+package badgeserver
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+	"github.com/spf13/viper"
+)
+
+// ProjectRegistry holds the projects, api keys and revoked badges the badge server serves.
+// when created from a projects file it can be reloaded without restarting the badge server
+type ProjectRegistry struct {
+	lock          sync.RWMutex
+	filePath      string
+	modTime       time.Time
+	projects      GelocationToProjectsConfiguration
+	apiKeys       map[string]*ApiKeyConfiguration // key id -> key
+	keysBySecret  map[string]string               // secret -> key id
+	revokedBadges map[string]struct{}             // badge addresses
+}
+
+func NewStaticProjectRegistry(config RegistryConfiguration) *ProjectRegistry {
+	registry := &ProjectRegistry{}
+	registry.set(config)
+	return registry
+}
+
+func NewProjectRegistryFromFile(filePath string) (*ProjectRegistry, error) {
+	registry := &ProjectRegistry{filePath: filePath}
+	_, err := registry.Reload()
+	if err != nil {
+		return nil, err
+	}
+	return registry, nil
+}
+
+// Reload reads the projects file again if it was modified since it was last read
+func (pr *ProjectRegistry) Reload() (reloaded bool, err error) {
+	if pr.filePath == "" {
+		return false, nil
+	}
+	fileInfo, err := os.Stat(pr.filePath)
+	if err != nil {
+		return false, utils.LavaFormatError("failed reading projects file", err, utils.LogAttr("path", pr.filePath))
+	}
+	pr.lock.RLock()
+	unchanged := fileInfo.ModTime().Equal(pr.modTime)
+	pr.lock.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	v := viper.New()
+	v.SetConfigFile(pr.filePath)
+	err = v.ReadInConfig()
+	if err != nil {
+		return false, utils.LavaFormatError("failed reading projects file", err, utils.LogAttr("path", pr.filePath))
+	}
+	config := RegistryConfiguration{}
+	err = v.Unmarshal(&config)
+	if err != nil {
+		return false, utils.LavaFormatError("failed unmarshalling projects file", err, utils.LogAttr("path", pr.filePath))
+	}
+	err = config.Validate()
+	if err != nil {
+		return false, utils.LavaFormatError("invalid projects file", err, utils.LogAttr("path", pr.filePath))
+	}
+	pr.set(config)
+	pr.lock.Lock()
+	pr.modTime = fileInfo.ModTime()
+	pr.lock.Unlock()
+	utils.LavaFormatInfo("loaded projects file",
+		utils.LogAttr("path", pr.filePath),
+		utils.LogAttr("geolocations", len(config.ProjectsData)),
+		utils.LogAttr("apiKeys", len(config.ApiKeys)),
+		utils.LogAttr("revokedBadges", len(config.RevokedBadges)),
+	)
+	return true, nil
+}
+
+// WatchFile reloads the projects file on every interval, a file that fails loading keeps the previous registry
+func (pr *ProjectRegistry) WatchFile(ctx context.Context, interval time.Duration) {
+	if pr.filePath == "" {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			pr.Reload()
+		}
+	}
+}
+
+func (config *RegistryConfiguration) Validate() error {
+	secrets := map[string]string{}
+	for keyId, key := range config.ApiKeys {
+		if key == nil || key.Secret == "" {
+			return fmt.Errorf("api key %s has no secret", keyId)
+		}
+		if strings.Contains(keyId, ".") {
+			return fmt.Errorf("api key id %s can't contain '.'", keyId)
+		}
+		if otherKeyId, ok := secrets[key.Secret]; ok {
+			return fmt.Errorf("api keys %s and %s have the same secret", keyId, otherKeyId)
+		}
+		secrets[key.Secret] = keyId
+	}
+	return nil
+}
+
+func (pr *ProjectRegistry) set(config RegistryConfiguration) {
+	apiKeys := map[string]*ApiKeyConfiguration{}
+	keysBySecret := map[string]string{}
+	for keyId, key := range config.ApiKeys {
+		if key == nil {
+			continue
+		}
+		// keys are lower-cased like the project ids when loading the YAML configuration
+		keyId = strings.ToLower(keyId)
+		key.ProjectId = strings.ToLower(key.ProjectId)
+		apiKeys[keyId] = key
+		keysBySecret[key.Secret] = keyId
+	}
+	revokedBadges := map[string]struct{}{}
+	for _, address := range config.RevokedBadges {
+		revokedBadges[address] = struct{}{}
+	}
+	projects := config.ProjectsData
+	if projects == nil {
+		projects = GelocationToProjectsConfiguration{}
+	}
+
+	pr.lock.Lock()
+	defer pr.lock.Unlock()
+	pr.projects = projects
+	pr.apiKeys = apiKeys
+	pr.keysBySecret = keysBySecret
+	pr.revokedBadges = revokedBadges
+}
+
+func (pr *ProjectRegistry) GetGeolocationProjects(geolocation string) (map[string]*ProjectConfiguration, bool) {
+	pr.lock.RLock()
+	defer pr.lock.RUnlock()
+	projects, ok := pr.projects[geolocation]
+	return projects, ok
+}
+
+// AuthRequired is true once api keys are configured, a registry without keys serves anyone like before
+func (pr *ProjectRegistry) AuthRequired() bool {
+	pr.lock.RLock()
+	defer pr.lock.RUnlock()
+	return len(pr.apiKeys) > 0
+}
+
+func (pr *ProjectRegistry) GetApiKey(keyId string) (ApiKeyConfiguration, bool) {
+	pr.lock.RLock()
+	defer pr.lock.RUnlock()
+	key, ok := pr.apiKeys[strings.ToLower(keyId)]
+	if !ok {
+		return ApiKeyConfiguration{}, false
+	}
+	return *key, true
+}
+
+func (pr *ProjectRegistry) GetApiKeyBySecret(secret string) (keyId string, key ApiKeyConfiguration, found bool) {
+	pr.lock.RLock()
+	defer pr.lock.RUnlock()
+	keyId, ok := pr.keysBySecret[secret]
+	if !ok {
+		return "", ApiKeyConfiguration{}, false
+	}
+	return keyId, *pr.apiKeys[keyId], true
+}
+
+func (pr *ProjectRegistry) IsBadgeAddressRevoked(address string) bool {
+	pr.lock.RLock()
+	defer pr.lock.RUnlock()
+	_, ok := pr.revokedBadges[address]
+	return ok
+}
+
+func (pr *ProjectRegistry) RevokedBadgeAddresses() []string {
+	pr.lock.RLock()
+	defer pr.lock.RUnlock()
+	addresses := make([]string, 0, len(pr.revokedBadges))
+	for address := range pr.revokedBadges {
+		addresses = append(addresses, address)
+	}
+	return addresses
+}
+
+// IsApiKeyRevoked is true for keys marked revoked and for keys that were removed from the registry
+func (pr *ProjectRegistry) IsApiKeyRevoked(keyId string) bool {
+	key, ok := pr.GetApiKey(keyId)
+	return !ok || key.Revoked
+}
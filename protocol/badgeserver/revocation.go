@@ -0,0 +1,196 @@
+package badgeserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+// RevocationList is served by the badge server for providers to reject revoked badges before they expire,
+// it is signed with the badges signer key so only the badge server that issued a badge can revoke it
+type RevocationList struct {
+	Signer    string         `json:"signer"` // the badge server's address, the badges signer
+	Revoked   []RevokedBadge `json:"revoked"`
+	Signature []byte         `json:"signature,omitempty"`
+}
+
+func (rl RevocationList) GetSignature() []byte {
+	return rl.Signature
+}
+
+func (rl RevocationList) DataToSign() []byte {
+	rl.Signature = nil
+	data, err := json.Marshal(rl)
+	if err != nil {
+		utils.LavaFormatError("failed encoding revocation list to sign", err)
+	}
+	return data
+}
+
+func (rl RevocationList) HashRounds() int {
+	return 1
+}
+
+// verify checks the list is signed by the badge server it names as the signer
+func (rl RevocationList) verify() error {
+	if len(rl.Signature) == 0 {
+		return fmt.Errorf("revocation list of %s is not signed", rl.Signer)
+	}
+	signer, err := sigs.ExtractSignerAddress(rl)
+	if err != nil {
+		return err
+	}
+	if signer.String() != rl.Signer {
+		return fmt.Errorf("revocation list of %s is signed by %s", rl.Signer, signer.String())
+	}
+	return nil
+}
+
+type RevokedBadge struct {
+	Address string `json:"address"`
+	Epoch   uint64 `json:"epoch,omitempty"` // 0 revokes the address badges of every epoch
+}
+
+func (s *Server) GetRevocationList() (RevocationList, error) {
+	revoked := s.issuance.revokedBadges(s.Registry.IsApiKeyRevoked)
+	for _, address := range s.Registry.RevokedBadgeAddresses() {
+		revoked = append(revoked, RevokedBadge{Address: address})
+	}
+	list := RevocationList{Signer: s.projectPublicKey, Revoked: revoked}
+	signature, err := s.projectSigner.Sign(list)
+	if err != nil {
+		return list, err
+	}
+	list.Signature = signature
+	return list, nil
+}
+
+func (s *Server) ServeRevocationList(resp http.ResponseWriter, req *http.Request) {
+	list, err := s.GetRevocationList()
+	if err != nil {
+		utils.LavaFormatError("failed signing revocation list", err)
+		http.Error(resp, "failed signing revocation list", http.StatusInternalServerError)
+		return
+	}
+	resp.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(resp).Encode(list)
+	if err != nil {
+		utils.LavaFormatError("failed encoding revocation list", err)
+	}
+}
+
+// BadgeRevocationTracker polls the revocation lists of badge servers, providers use it to reject revoked badges
+type BadgeRevocationTracker struct {
+	urls   []string
+	client http.Client
+	lock   sync.RWMutex
+	lists  map[string]RevocationList     // url -> last fetched list
+	byUser map[string][]revocationSource // badge address -> revocations
+}
+
+type revocationSource struct {
+	signer string
+	epoch  uint64
+}
+
+func NewBadgeRevocationTracker(urls []string) *BadgeRevocationTracker {
+	return &BadgeRevocationTracker{
+		urls:   urls,
+		client: http.Client{Timeout: DefaultRevocationListsInterval},
+		lists:  map[string]RevocationList{},
+		byUser: map[string][]revocationSource{},
+	}
+}
+
+// Start fetches the revocation lists and keeps them updated, a list that fails fetching or verification keeps its previous content
+func (brt *BadgeRevocationTracker) Start(ctx context.Context, interval time.Duration) {
+	if brt == nil || len(brt.urls) == 0 {
+		return
+	}
+	brt.update(ctx)
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				brt.update(ctx)
+			}
+		}
+	}()
+}
+
+func (brt *BadgeRevocationTracker) update(ctx context.Context) {
+	for _, url := range brt.urls {
+		list, err := brt.fetch(ctx, url)
+		if err != nil {
+			utils.LavaFormatWarning("failed fetching badge revocation list", err, utils.LogAttr("url", url))
+			continue
+		}
+		brt.lock.Lock()
+		brt.lists[url] = list
+		brt.lock.Unlock()
+	}
+	byUser := map[string][]revocationSource{}
+	brt.lock.Lock()
+	defer brt.lock.Unlock()
+	for _, list := range brt.lists {
+		for _, revoked := range list.Revoked {
+			byUser[revoked.Address] = append(byUser[revoked.Address], revocationSource{signer: list.Signer, epoch: revoked.Epoch})
+		}
+	}
+	brt.byUser = byUser
+}
+
+func (brt *BadgeRevocationTracker) fetch(ctx context.Context, url string) (RevocationList, error) {
+	list := RevocationList{}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return list, err
+	}
+	resp, err := brt.client.Do(req)
+	if err != nil {
+		return list, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return list, fmt.Errorf("bad HTTP status %s", resp.Status)
+	}
+	err = json.NewDecoder(resp.Body).Decode(&list)
+	if err != nil {
+		return list, err
+	}
+	return list, list.verify()
+}
+
+// IsRevoked checks a badge against the revocation lists, the badge signer is only extracted for listed badge users
+func (brt *BadgeRevocationTracker) IsRevoked(badge *pairingtypes.Badge) bool {
+	if brt == nil || badge == nil {
+		return false
+	}
+	brt.lock.RLock()
+	sources := brt.byUser[badge.Address]
+	brt.lock.RUnlock()
+	if len(sources) == 0 {
+		return false
+	}
+	signer, err := sigs.ExtractSignerAddress(*badge)
+	if err != nil {
+		return false
+	}
+	for _, source := range sources {
+		if source.signer == signer.String() && (source.epoch == 0 || source.epoch == badge.Epoch) {
+			return true
+		}
+	}
+	return false
+}
@@ -6,10 +6,10 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"google.golang.org/grpc/metadata"
 
-	btcSecp256k1 "github.com/btcsuite/btcd/btcec"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/lavanet/lava/protocol/chainlib"
 	"github.com/lavanet/lava/protocol/lavasession"
@@ -23,33 +23,36 @@ const dummyApiInterface = "badgeApiInterface"
 
 type Server struct {
 	pairingtypes.UnimplementedBadgeGeneratorServer
-	ProjectsConfiguration GelocationToProjectsConfiguration // geolocation/project_id/project_data
-	epoch                 uint64
-	chainFetcher          *chainlib.LavaChainFetcher
-	ChainId               string
-	IpService             *IpService
-	metrics               *MetricsService
-	stateTracker          *BadgeStateTracker
-	specs                 map[string]spectypes.Spec // holding the specs for all chains
-	specLock              sync.RWMutex
-	clientCtx             client.Context
-	projectPublicKey      string
-	projectPrivateKey     *btcSecp256k1.PrivateKey
+	Registry         *ProjectRegistry
+	epoch            uint64
+	chainFetcher     *chainlib.LavaChainFetcher
+	ChainId          string
+	IpService        *IpService
+	metrics          *MetricsService
+	stateTracker     *BadgeStateTracker
+	specs            map[string]spectypes.Spec // holding the specs for all chains
+	specLock         sync.RWMutex
+	clientCtx        client.Context
+	projectPublicKey string
+	projectSigner    sigs.Signer
+	issuance         *badgeIssuance
+	rateLimiter      *keyRateLimiter
 }
 
-func NewServer(ipService *IpService, chainId string, projectsData GelocationToProjectsConfiguration, chainFetcher *chainlib.LavaChainFetcher, clientCtx client.Context, projectPublicKey string, projectPrivateKey *btcSecp256k1.PrivateKey) (*Server, error) {
+func NewServer(ipService *IpService, chainId string, registry *ProjectRegistry, chainFetcher *chainlib.LavaChainFetcher, clientCtx client.Context, projectPublicKey string, projectSigner sigs.Signer) (*Server, error) {
 	server := &Server{
-		ProjectsConfiguration: GelocationToProjectsConfiguration{},
-		ChainId:               chainId,
-		IpService:             ipService,
-		specs:                 map[string]spectypes.Spec{},
-		chainFetcher:          chainFetcher,
-		clientCtx:             clientCtx,
-		projectPublicKey:      projectPublicKey,
-		projectPrivateKey:     projectPrivateKey,
+		Registry:         registry,
+		ChainId:          chainId,
+		IpService:        ipService,
+		specs:            map[string]spectypes.Spec{},
+		chainFetcher:     chainFetcher,
+		clientCtx:        clientCtx,
+		projectPublicKey: projectPublicKey,
+		projectSigner:    projectSigner,
+		issuance:         newBadgeIssuance(),
+		rateLimiter:      newKeyRateLimiter(),
 	}
 
-	server.ProjectsConfiguration = projectsData
 	server.metrics = InitMetrics()
 	return server, nil
 }
@@ -109,19 +112,36 @@ func (s *Server) Active() bool {
 }
 
 func (s *Server) GenerateBadge(ctx context.Context, req *pairingtypes.GenerateBadgeRequest) (*pairingtypes.GenerateBadgeResponse, error) {
+	if req == nil {
+		return nil, utils.LavaFormatError("Validation failed", fmt.Errorf("invalid request, no input data provided"))
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	auth, err := s.authenticate(md, req, time.Now())
+	if err != nil {
+		s.metrics.AddRequest(false)
+		return nil, utils.LavaFormatWarning("badge request authentication failed", err, utils.LogAttr("BadgeAddress", req.BadgeAddress), utils.LogAttr("ProjectId", req.ProjectId))
+	}
+	if req.ProjectId == "" {
+		// keys are bound to a project so the key's project is used
+		req.ProjectId = auth.key.ProjectId
+	}
+	if s.Registry.IsBadgeAddressRevoked(req.BadgeAddress) {
+		s.metrics.AddRequest(false)
+		return nil, utils.LavaFormatWarning("badges of this address are revoked", nil, utils.LogAttr("BadgeAddress", req.BadgeAddress))
+	}
+
 	spec, err := s.getSpec(ctx, req.SpecId)
 	if err != nil {
 		return nil, utils.LavaFormatError("badge server failed fetching spec", err)
 	}
 
-	metadata, _ := metadata.FromIncomingContext(ctx)
-	clientAddress := metadata.Get(RefererHeaderKey)
+	clientAddress := md.Get(RefererHeaderKey)
 	ipAddress := ""
 	if len(clientAddress) > 0 {
 		ipAddress = clientAddress[0]
 	}
 
-	projectData, err := s.validateRequestAndGetProjectData(ipAddress, req)
+	projectData, projectId, err := s.validateRequestAndGetProjectData(ipAddress, req)
 	if err != nil {
 		s.metrics.AddRequest(false)
 		return nil, err
@@ -135,6 +155,12 @@ func (s *Server) GenerateBadge(ctx context.Context, req *pairingtypes.GenerateBa
 		VirtualEpoch: s.stateTracker.GetLatestVirtualEpoch(),
 	}
 
+	err = s.issuance.reserve(badge.Epoch, projectId, auth.id, badge.Address, badge.CuAllocation, projectData.EpochCuQuota)
+	if err != nil {
+		s.metrics.AddRequest(false)
+		return nil, utils.LavaFormatWarning("badge quota exceeded", err, utils.LogAttr("BadgeAddress", req.BadgeAddress), utils.LogAttr("apiKey", auth.id))
+	}
+
 	result := pairingtypes.GenerateBadgeResponse{
 		Badge:              &badge,
 		BadgeSignerAddress: s.projectPublicKey,
@@ -147,7 +173,7 @@ func (s *Server) GenerateBadge(ctx context.Context, req *pairingtypes.GenerateBa
 		return nil, err
 	}
 
-	err = signTheResponse(s.projectPrivateKey, &result)
+	err = signTheResponse(s.projectSigner, &result)
 	if err != nil {
 		s.metrics.AddRequest(false)
 		return nil, err
@@ -157,19 +183,20 @@ func (s *Server) GenerateBadge(ctx context.Context, req *pairingtypes.GenerateBa
 	return &result, nil
 }
 
-func (s *Server) validateRequestAndGetProjectData(clientIPAddress string, request *pairingtypes.GenerateBadgeRequest) (*ProjectConfiguration, error) {
+// validateRequestAndGetProjectData returns the project's data and id, the id is the default project's if the requested project isn't configured
+func (s *Server) validateRequestAndGetProjectData(clientIPAddress string, request *pairingtypes.GenerateBadgeRequest) (*ProjectConfiguration, string, error) {
 	if request == nil {
-		return nil, utils.LavaFormatError("Validation failed", fmt.Errorf("invalid request, no input data provided"))
+		return nil, "", utils.LavaFormatError("Validation failed", fmt.Errorf("invalid request, no input data provided"))
 	}
 
 	if request.BadgeAddress == "" || request.ProjectId == "" {
-		return nil, utils.LavaFormatError("Validation failed", fmt.Errorf("bad request, no valid input data provided"), utils.LogAttr("request", request))
+		return nil, "", utils.LavaFormatError("Validation failed", fmt.Errorf("bad request, no valid input data provided"), utils.LogAttr("request", request))
 	}
 
 	geolocation := s.getClientGeolocationOrDefault(clientIPAddress)
-	geolocationData, exist := s.ProjectsConfiguration[geolocation]
+	geolocationData, exist := s.Registry.GetGeolocationProjects(geolocation)
 	if !exist {
-		return nil, utils.LavaFormatError(
+		return nil, "", utils.LavaFormatError(
 			"Validation failed",
 			fmt.Errorf("geolocation not found in configuration"),
 			utils.LogAttr("BadgeAddress", request.BadgeAddress),
@@ -188,9 +215,10 @@ func (s *Server) validateRequestAndGetProjectData(clientIPAddress string, reques
 			utils.LogAttr("defaultProjectId", DefaultProjectId),
 		)
 
+		projectIdLower = DefaultProjectId
 		projectData, exist = geolocationData[DefaultProjectId]
 		if !exist {
-			return nil, utils.LavaFormatError(
+			return nil, "", utils.LavaFormatError(
 				"Validation failed",
 				fmt.Errorf("default project not found"),
 				utils.LogAttr("BadgeAddress", request.BadgeAddress),
@@ -199,7 +227,7 @@ func (s *Server) validateRequestAndGetProjectData(clientIPAddress string, reques
 			)
 		}
 	}
-	return projectData, nil
+	return projectData, projectIdLower, nil
 }
 
 func (s *Server) getClientGeolocationOrDefault(clientIpAddress string) string {
@@ -259,8 +287,8 @@ func (s *Server) addPairingListToResponse(ctx context.Context, request *pairingt
 }
 
 // note this update the signature of the response
-func signTheResponse(privateKey *btcSecp256k1.PrivateKey, response *pairingtypes.GenerateBadgeResponse) error {
-	signature, err := sigs.Sign(privateKey, *response.Badge)
+func signTheResponse(signer sigs.Signer, response *pairingtypes.GenerateBadgeResponse) error {
+	signature, err := signer.Sign(*response.Badge)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,203 @@
+package badgeserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	btcSecp256k1 "github.com/btcsuite/btcd/btcec"
+	"github.com/lavanet/lava/utils/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func newTestServer(registry *ProjectRegistry) *Server {
+	signerKey, _ := sigs.GenerateFloatingKey()
+	signer := sigs.NewPrivateKeySigner(signerKey)
+	return &Server{
+		Registry:         registry,
+		projectPublicKey: signer.Address().String(),
+		projectSigner:    signer,
+		issuance:         newBadgeIssuance(),
+		rateLimiter:      newKeyRateLimiter(),
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	now := time.Now()
+	request := &pairingtypes.GenerateBadgeRequest{BadgeAddress: "lava@user", ProjectId: "MyProject"}
+
+	// without api keys anyone is served
+	server := newTestServer(NewStaticProjectRegistry(RegistryConfiguration{}))
+	auth, err := server.authenticate(metadata.MD{}, request, now)
+	require.NoError(t, err)
+	require.Empty(t, auth.id)
+
+	server = newTestServer(NewStaticProjectRegistry(RegistryConfiguration{ApiKeys: map[string]*ApiKeyConfiguration{
+		"web":     {ProjectId: "myproject", Secret: "web-secret", BadgesPerMinute: 2},
+		"other":   {ProjectId: "otherproject", Secret: "other-secret"},
+		"revoked": {ProjectId: "myproject", Secret: "revoked-secret", Revoked: true},
+	}}))
+	tests := []struct {
+		name  string
+		md    metadata.MD
+		keyId string
+		valid bool
+	}{
+		{name: "anonymous", md: metadata.MD{}, valid: false},
+		{name: "api key", md: metadata.Pairs(ApiKeyHeaderKey, "web-secret"), keyId: "web", valid: true},
+		{name: "unknown api key", md: metadata.Pairs(ApiKeyHeaderKey, "bad-secret"), valid: false},
+		{name: "revoked api key", md: metadata.Pairs(ApiKeyHeaderKey, "revoked-secret"), valid: false},
+		{name: "api key of another project", md: metadata.Pairs(ApiKeyHeaderKey, "other-secret"), valid: false},
+		{name: "token", md: metadata.Pairs(BadgeTokenHeaderKey, SignBadgeToken("WEB", "web-secret", request.BadgeAddress, request.ProjectId, now.Add(time.Minute))), keyId: "web", valid: true},
+		{name: "token of another badge address", md: metadata.Pairs(BadgeTokenHeaderKey, SignBadgeToken("web", "web-secret", "lava@other", request.ProjectId, now.Add(time.Minute))), valid: false},
+		{name: "token with a wrong secret", md: metadata.Pairs(BadgeTokenHeaderKey, SignBadgeToken("web", "other-secret", request.BadgeAddress, request.ProjectId, now.Add(time.Minute))), valid: false},
+		{name: "expired token", md: metadata.Pairs(BadgeTokenHeaderKey, SignBadgeToken("web", "web-secret", request.BadgeAddress, request.ProjectId, now.Add(-time.Second))), valid: false},
+		{name: "long lived token", md: metadata.Pairs(BadgeTokenHeaderKey, SignBadgeToken("web", "web-secret", request.BadgeAddress, request.ProjectId, now.Add(2*MaxBadgeTokenTTL))), valid: false},
+		{name: "malformed token", md: metadata.Pairs(BadgeTokenHeaderKey, "web.123"), valid: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auth, err := server.authenticate(tt.md, request, now)
+			if tt.valid {
+				require.NoError(t, err)
+				require.Equal(t, tt.keyId, auth.id)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	// the web key got its 2 badges this minute
+	_, err = server.authenticate(metadata.Pairs(ApiKeyHeaderKey, "web-secret"), request, now)
+	require.Error(t, err)
+	_, err = server.authenticate(metadata.Pairs(ApiKeyHeaderKey, "web-secret"), request, now.Add(time.Minute))
+	require.NoError(t, err)
+}
+
+func TestBadgeIssuanceQuotaAndRevocation(t *testing.T) {
+	registry := NewStaticProjectRegistry(RegistryConfiguration{
+		ApiKeys:       map[string]*ApiKeyConfiguration{"web": {ProjectId: "myproject", Secret: "web-secret"}},
+		RevokedBadges: []string{"lava@stolen"},
+	})
+	server := newTestServer(registry)
+
+	require.NoError(t, server.issuance.reserve(10, "myproject", "web", "lava@user1", 100, 200))
+	// the same badge again doesn't use the quota
+	require.NoError(t, server.issuance.reserve(10, "myproject", "web", "lava@user1", 100, 200))
+	require.NoError(t, server.issuance.reserve(10, "myproject", "", "lava@user2", 100, 200))
+	require.Error(t, server.issuance.reserve(10, "myproject", "web", "lava@user3", 100, 200))
+	// a new epoch has a new quota
+	require.NoError(t, server.issuance.reserve(20, "myproject", "web", "lava@user3", 100, 200))
+	require.NoError(t, server.issuance.reserve(30, "myproject", "web", "lava@user4", 100, 0))
+
+	list, err := server.GetRevocationList()
+	require.NoError(t, err)
+	require.Equal(t, server.projectPublicKey, list.Signer)
+	require.NoError(t, list.verify())
+	require.ElementsMatch(t, []RevokedBadge{{Address: "lava@stolen"}}, list.Revoked)
+
+	// removing the key revokes the badges issued with it in the tracked epochs
+	registry.set(RegistryConfiguration{})
+	list, err = server.GetRevocationList()
+	require.NoError(t, err)
+	require.NoError(t, list.verify())
+	require.ElementsMatch(t, []RevokedBadge{{Address: "lava@user3", Epoch: 20}, {Address: "lava@user4", Epoch: 30}}, list.Revoked)
+}
+
+func TestProjectRegistryReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "projects.yml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+projects-data:
+  2:
+    MyProject:
+      epochs-max-cu: 1000
+      epoch-cu-quota: 5000
+api-keys:
+  Web:
+    project-id: MyProject
+    secret: web-secret
+    badges-per-minute: 10
+`), 0o644))
+	registry, err := NewProjectRegistryFromFile(path)
+	require.NoError(t, err)
+	projects, ok := registry.GetGeolocationProjects("2")
+	require.True(t, ok)
+	require.Equal(t, int64(5000), projects["myproject"].EpochCuQuota)
+	keyId, key, ok := registry.GetApiKeyBySecret("web-secret")
+	require.True(t, ok)
+	require.Equal(t, "web", keyId)
+	require.Equal(t, ApiKeyConfiguration{ProjectId: "myproject", Secret: "web-secret", BadgesPerMinute: 10}, key)
+
+	reloaded, err := registry.Reload()
+	require.NoError(t, err)
+	require.False(t, reloaded)
+
+	// an invalid file keeps the previous registry
+	require.NoError(t, os.WriteFile(path, []byte("api-keys:\n  web:\n    project-id: myproject\n"), 0o644))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Second)))
+	_, err = registry.Reload()
+	require.Error(t, err)
+	require.False(t, registry.IsApiKeyRevoked("web"))
+
+	require.NoError(t, os.WriteFile(path, []byte("api-keys:\n  web:\n    project-id: myproject\n    secret: web-secret\n    revoked: true\nrevoked-badges:\n  - lava@stolen\n"), 0o644))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(2*time.Second)))
+	reloaded, err = registry.Reload()
+	require.NoError(t, err)
+	require.True(t, reloaded)
+	require.True(t, registry.IsApiKeyRevoked("web"))
+	require.True(t, registry.IsBadgeAddressRevoked("lava@stolen"))
+	_, ok = registry.GetGeolocationProjects("2")
+	require.False(t, ok)
+}
+
+func TestBadgeRevocationTracker(t *testing.T) {
+	signerKey, signerAddress := sigs.GenerateFloatingKey()
+	otherSignerKey, otherSignerAddress := sigs.GenerateFloatingKey()
+	signBadge := func(address string, epoch uint64) *pairingtypes.Badge {
+		badge := &pairingtypes.Badge{Address: address, Epoch: epoch, CuAllocation: 100, LavaChainId: "lava"}
+		signature, err := sigs.Sign(signerKey, *badge)
+		require.NoError(t, err)
+		badge.ProjectSig = signature
+		return badge
+	}
+
+	signList := func(key *btcSecp256k1.PrivateKey, list RevocationList) RevocationList {
+		signature, err := sigs.Sign(key, list)
+		require.NoError(t, err)
+		list.Signature = signature
+		return list
+	}
+
+	lists := map[string]RevocationList{
+		"/signer": signList(signerKey, RevocationList{Signer: signerAddress.String(), Revoked: []RevokedBadge{{Address: "lava@user1", Epoch: 10}, {Address: "lava@user2"}}}),
+		"/other":  signList(otherSignerKey, RevocationList{Signer: otherSignerAddress.String(), Revoked: []RevokedBadge{{Address: "lava@user3"}}}),
+		// lists that claim to be the signer's without its signature are ignored
+		"/forged":   signList(otherSignerKey, RevocationList{Signer: signerAddress.String(), Revoked: []RevokedBadge{{Address: "lava@user4"}}}),
+		"/unsigned": {Signer: signerAddress.String(), Revoked: []RevokedBadge{{Address: "lava@user5"}}},
+	}
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(lists[r.URL.Path])
+	}))
+	defer httpServer.Close()
+
+	var tracker *BadgeRevocationTracker
+	require.False(t, tracker.IsRevoked(signBadge("lava@user1", 10)))
+
+	tracker = NewBadgeRevocationTracker([]string{httpServer.URL + "/signer", httpServer.URL + "/other", httpServer.URL + "/forged", httpServer.URL + "/unsigned", "http://127.0.0.1:0/unreachable"})
+	tracker.update(context.Background())
+	require.True(t, tracker.IsRevoked(signBadge("lava@user1", 10)))
+	require.False(t, tracker.IsRevoked(signBadge("lava@user1", 20)))
+	require.True(t, tracker.IsRevoked(signBadge("lava@user2", 20)))
+	// revoked by a different badge server
+	require.False(t, tracker.IsRevoked(signBadge("lava@user3", 10)))
+	require.False(t, tracker.IsRevoked(signBadge("lava@user4", 10)))
+	require.False(t, tracker.IsRevoked(signBadge("lava@user5", 10)))
+	require.False(t, tracker.IsRevoked(signBadge("lava@user6", 10)))
+}
@@ -342,6 +342,19 @@ func (apip *BaseChainParser) getApiCollection(connectionType, internalPath, addo
 	return api, nil
 }
 
+func newApiContainer(api *spectypes.Api, collectionKey CollectionKey) ApiContainer {
+	responseSchema, err := api.ResponseSchema.Compile()
+	if err != nil {
+		// specs are validated on chain so this isn't expected, the api is served without checking its replies
+		utils.LavaFormatError("failed compiling api response schema", err, utils.LogAttr("api", api.Name))
+	}
+	return ApiContainer{
+		api:            api,
+		collectionKey:  collectionKey,
+		responseSchema: responseSchema,
+	}
+}
+
 func getServiceApis(spec spectypes.Spec, rpcInterface string) (retServerApis map[ApiKey]ApiContainer, retTaggedApis map[spectypes.FUNCTION_TAG]TaggedContainer, retApiCollections map[CollectionKey]*spectypes.ApiCollection, retHeaders map[ApiKey]*spectypes.Header, retVerifications map[VerificationKey][]VerificationContainer) {
 	serverApis := map[ApiKey]ApiContainer{}
 	taggedApis := map[spectypes.FUNCTION_TAG]TaggedContainer{}
@@ -382,18 +395,12 @@ func getServiceApis(spec spectypes.Spec, rpcInterface string) (retServerApis map
 					serverApis[ApiKey{
 						Name:           processedName,
 						ConnectionType: collectionKey.ConnectionType,
-					}] = ApiContainer{
-						api:           api,
-						collectionKey: collectionKey,
-					}
+					}] = newApiContainer(api, collectionKey)
 				} else {
 					serverApis[ApiKey{
 						Name:           api.Name,
 						ConnectionType: collectionKey.ConnectionType,
-					}] = ApiContainer{
-						api:           api,
-						collectionKey: collectionKey,
-					}
+					}] = newApiContainer(api, collectionKey)
 				}
 			}
 			for _, header := range apiCollection.Headers {
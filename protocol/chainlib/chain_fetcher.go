@@ -2,8 +2,10 @@ package chainlib
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -11,6 +13,9 @@ import (
 	"github.com/golang/protobuf/proto"
 	formatter "github.com/lavanet/lava/ecosystem/cache/format"
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
+	"github.com/lavanet/lava/protocol/chaintracker"
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/protocol/parser"
@@ -361,6 +366,152 @@ func (cf *ChainFetcher) FetchBlockHashByNum(ctx context.Context, blockNum int64)
 	return res, nil
 }
 
+// SampleNodes queries every node configured for the chain separately for its latest block and its hash of blockNum, so the nodes can be cross checked
+func (cf *ChainFetcher) SampleNodes(ctx context.Context, blockNum int64) []chaintracker.NodeBlockSample {
+	nodesChainRouter, ok := cf.chainRouter.(NodesChainRouter)
+	if !ok {
+		return nil
+	}
+	latestParsing, latestCollectionData, ok := cf.chainParser.GetParsingByTag(spectypes.FUNCTION_TAG_GET_BLOCKNUM)
+	if !ok {
+		return nil
+	}
+	hashParsing, hashCollectionData, ok := cf.chainParser.GetParsingByTag(spectypes.FUNCTION_TAG_GET_BLOCK_BY_NUM)
+	if !ok || hashParsing.FunctionTemplate == "" {
+		return nil
+	}
+	var latestCraftData *CraftData
+	if latestParsing.FunctionTemplate != "" {
+		latestCraftData = &CraftData{Path: latestParsing.ApiName, Data: []byte(latestParsing.FunctionTemplate), ConnectionType: latestCollectionData.Type}
+	}
+	latestMessage, err := CraftChainMessage(latestParsing, latestCollectionData.Type, cf.chainParser, latestCraftData, cf.ChainFetcherMetadata())
+	if err != nil {
+		return nil
+	}
+	hashCraftData := &CraftData{Path: hashParsing.ApiName, Data: []byte(fmt.Sprintf(hashParsing.FunctionTemplate, blockNum)), ConnectionType: hashCollectionData.Type}
+	hashMessage, err := CraftChainMessage(hashParsing, hashCollectionData.Type, cf.chainParser, hashCraftData, cf.ChainFetcherMetadata())
+	if err != nil {
+		return nil
+	}
+	latestReplies, err := nodesChainRouter.SendNodeMsgToAllNodes(ctx, latestMessage, nil)
+	if err != nil {
+		return nil
+	}
+	// both messages are answered by the same nodes in the same order
+	hashReplies, err := nodesChainRouter.SendNodeMsgToAllNodes(ctx, hashMessage, nil)
+	if err != nil {
+		return nil
+	}
+	samples := make([]chaintracker.NodeBlockSample, len(latestReplies))
+	for idx, latestReply := range latestReplies {
+		sample := chaintracker.NodeBlockSample{Node: latestReply.ProxyUrl.UrlStr(), Err: latestReply.Err}
+		if sample.Err == nil {
+			var parserInput parser.RPCInput
+			parserInput, sample.Err = FormatResponseForParsing(latestReply.RelayReply, latestMessage)
+			if sample.Err == nil {
+				sample.LatestBlock, sample.Err = parser.ParseBlockFromReply(parserInput, latestParsing.ResultParsing)
+			}
+		}
+		// a node that is behind blockNum can't answer its hash, it's still measured for lag
+		if idx < len(hashReplies) && hashReplies[idx].Err == nil {
+			parserInput, err := FormatResponseForParsing(hashReplies[idx].RelayReply, hashMessage)
+			if err == nil {
+				sample.Hash, _ = parser.ParseFromReplyAndDecode(parserInput, hashParsing.ResultParsing)
+			}
+		}
+		samples[idx] = sample
+	}
+	return samples
+}
+
+// SubscribeNewHeads subscribes to the node's new blocks, newHeads for jsonrpc and NewBlock events for tendermintrpc.
+// the returned channel receives the new latest blocks and is closed when the subscription ends
+func (cf *ChainFetcher) SubscribeNewHeads(ctx context.Context) (<-chan int64, error) {
+	var data string
+	switch cf.endpoint.ApiInterface {
+	case spectypes.APIInterfaceJsonRPC:
+		data = `{"jsonrpc":"2.0","id":1,"method":"eth_subscribe","params":["newHeads"]}`
+	case spectypes.APIInterfaceTendermintRPC:
+		data = `{"jsonrpc":"2.0","id":1,"method":"subscribe","params":{"query":"tm.event='NewBlock'"}}`
+	default:
+		return nil, utils.LavaFormatDebug("new heads subscription is not supported for api interface", utils.Attribute{Key: "APIInterface", Value: cf.endpoint.ApiInterface})
+	}
+	chainMessage, err := cf.chainParser.ParseMsg("", []byte(data), "", cf.ChainFetcherMetadata(), extensionslib.ExtensionInfo{LatestBlock: 0})
+	if err != nil {
+		return nil, utils.LavaFormatDebug("failed creating new heads subscription chainMessage", utils.Attribute{Key: "chainID", Value: cf.endpoint.ChainID}, utils.Attribute{Key: "error", Value: err})
+	}
+	repliesChan := make(chan interface{})
+	_, _, clientSub, _, _, err := cf.chainRouter.SendNodeMsg(ctx, repliesChan, chainMessage, nil)
+	if err != nil {
+		return nil, err
+	}
+	if clientSub == nil {
+		return nil, utils.LavaFormatDebug("node rejected new heads subscription", utils.Attribute{Key: "chainID", Value: cf.endpoint.ChainID})
+	}
+	newHeads := make(chan int64)
+	go func() {
+		defer close(newHeads)
+		defer clientSub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case err := <-clientSub.Err():
+				utils.LavaFormatDebug("new heads subscription ended", utils.Attribute{Key: "chainID", Value: cf.endpoint.ChainID}, utils.Attribute{Key: "error", Value: err})
+				return
+			case reply := <-repliesChan:
+				blockNum, err := parseNewHeadBlock(cf.endpoint.ApiInterface, reply)
+				if err != nil {
+					utils.LavaFormatDebug("failed parsing new head", utils.Attribute{Key: "chainID", Value: cf.endpoint.ChainID}, utils.Attribute{Key: "error", Value: err})
+					continue
+				}
+				select {
+				case newHeads <- blockNum:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return newHeads, nil
+}
+
+func parseNewHeadBlock(apiInterface string, reply interface{}) (int64, error) {
+	message, ok := reply.(*rpcclient.JsonrpcMessage)
+	if !ok || message == nil {
+		return spectypes.NOT_APPLICABLE, fmt.Errorf("unexpected subscription reply type %T", reply)
+	}
+	switch apiInterface {
+	case spectypes.APIInterfaceJsonRPC:
+		var notification struct {
+			Result struct {
+				Number string `json:"number"`
+			} `json:"result"`
+		}
+		if err := json.Unmarshal(message.Params, &notification); err != nil {
+			return spectypes.NOT_APPLICABLE, err
+		}
+		return strconv.ParseInt(strings.TrimPrefix(notification.Result.Number, "0x"), 16, 64)
+	case spectypes.APIInterfaceTendermintRPC:
+		var event struct {
+			Data struct {
+				Value struct {
+					Block struct {
+						Header struct {
+							Height string `json:"height"`
+						} `json:"header"`
+					} `json:"block"`
+				} `json:"value"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(message.Result, &event); err != nil {
+			return spectypes.NOT_APPLICABLE, err
+		}
+		return strconv.ParseInt(event.Data.Value.Block.Header.Height, 10, 64)
+	}
+	return spectypes.NOT_APPLICABLE, fmt.Errorf("new heads are not supported for api interface %s", apiInterface)
+}
+
 type ChainFetcherOptions struct {
 	ChainRouter ChainRouter
 	ChainParser ChainParser
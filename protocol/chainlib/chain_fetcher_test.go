@@ -0,0 +1,64 @@
+package chainlib
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNewHeadBlock(t *testing.T) {
+	playBook := []struct {
+		name         string
+		apiInterface string
+		reply        interface{}
+		block        int64
+		valid        bool
+	}{
+		{
+			name:         "jsonrpc new head",
+			apiInterface: spectypes.APIInterfaceJsonRPC,
+			reply:        &rpcclient.JsonrpcMessage{Method: "eth_subscription", Params: json.RawMessage(`{"subscription":"0x9ce59a13059e417087c02d3236a0b1cc","result":{"number":"0x1b4","hash":"0xdc0818cf"}}`)},
+			block:        436,
+			valid:        true,
+		},
+		{
+			name:         "tendermint new block",
+			apiInterface: spectypes.APIInterfaceTendermintRPC,
+			reply:        &rpcclient.JsonrpcMessage{Result: json.RawMessage(`{"query":"tm.event='NewBlock'","data":{"type":"tendermint/event/NewBlock","value":{"block":{"header":{"chain_id":"lava","height":"1234"}}}}}`)},
+			block:        1234,
+			valid:        true,
+		},
+		{
+			name:         "malformed jsonrpc number",
+			apiInterface: spectypes.APIInterfaceJsonRPC,
+			reply:        &rpcclient.JsonrpcMessage{Params: json.RawMessage(`{"result":{"number":"latest"}}`)},
+			valid:        false,
+		},
+		{
+			name:         "unexpected reply type",
+			apiInterface: spectypes.APIInterfaceJsonRPC,
+			reply:        "0x1b4",
+			valid:        false,
+		},
+		{
+			name:         "unsupported interface",
+			apiInterface: spectypes.APIInterfaceRest,
+			reply:        &rpcclient.JsonrpcMessage{},
+			valid:        false,
+		},
+	}
+	for _, play := range playBook {
+		t.Run(play.name, func(t *testing.T) {
+			block, err := parseNewHeadBlock(play.apiInterface, play.reply)
+			if play.valid {
+				require.NoError(t, err)
+				require.Equal(t, play.block, block)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
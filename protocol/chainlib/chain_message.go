@@ -26,6 +26,7 @@ type baseChainMessageContainer struct {
 	extensions             []*spectypes.Extension
 	timeoutOverride        time.Duration
 	forceCacheRefresh      bool
+	responseSchema         *spectypes.CompiledResponseSchema
 	// resultErrorParsingMethod passed by each api interface message to parse the result of the message
 	// and validate it doesn't contain a node error
 	resultErrorParsingMethod func(data []byte, httpStatusCode int) (hasError bool, errorMessage string)
@@ -68,6 +69,11 @@ func (pm baseChainMessageContainer) GetApi() *spectypes.Api {
 	return pm.api
 }
 
+// GetResponseSchema returns the compiled response schema of the api, nil when replies aren't checked
+func (pm baseChainMessageContainer) GetResponseSchema() *spectypes.CompiledResponseSchema {
+	return pm.responseSchema
+}
+
 func (pm baseChainMessageContainer) GetApiCollection() *spectypes.ApiCollection {
 	return pm.apiCollection
 }
@@ -12,7 +12,7 @@ import (
 )
 
 type chainRouterEntry struct {
-	ChainProxy
+	*routerNodes
 	addonsSupported map[string]struct{}
 }
 
@@ -31,14 +31,14 @@ type chainRouterImpl struct {
 	chainProxyRouter map[lavasession.RouterKey][]chainRouterEntry
 }
 
-func (cri *chainRouterImpl) getChainProxySupporting(addon string, extensions []string) (ChainProxy, error) {
+func (cri *chainRouterImpl) getRouterNodesSupporting(addon string, extensions []string) (*routerNodes, error) {
 	cri.lock.RLock()
 	defer cri.lock.RUnlock()
 	wantedRouterKey := lavasession.NewRouterKey(extensions)
 	if chainProxyEntries, ok := cri.chainProxyRouter[wantedRouterKey]; ok {
 		for _, chainRouterEntry := range chainProxyEntries {
 			if chainRouterEntry.isSupporting(addon) {
-				return chainRouterEntry.ChainProxy, nil
+				return chainRouterEntry.routerNodes, nil
 			}
 			if debug {
 				utils.LavaFormatDebug("chainProxy supporting extensions but not supporting addon", utils.Attribute{Key: "addon", Value: addon}, utils.Attribute{Key: "wantedRouterKey", Value: wantedRouterKey})
@@ -60,13 +60,22 @@ func (cri chainRouterImpl) ExtensionsSupported(extensions []string) bool {
 func (cri chainRouterImpl) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend, extensions []string) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, proxyUrl common.NodeUrl, chainId string, err error) {
 	// add the parsed addon from the apiCollection
 	addon := chainMessage.GetApiCollection().CollectionData.AddOn
-	selectedChainProxy, err := cri.getChainProxySupporting(addon, extensions)
+	selectedRouterNodes, err := cri.getRouterNodesSupporting(addon, extensions)
 	if err != nil {
 		return nil, "", nil, common.NodeUrl{}, "", err
 	}
-	relayReply, subscriptionID, relayReplyServer, err = selectedChainProxy.SendNodeMsg(ctx, ch, chainMessage)
-	proxyUrl, chainId = selectedChainProxy.GetChainProxyInformation()
-	return relayReply, subscriptionID, relayReplyServer, proxyUrl, chainId, err
+	// picks the least loaded healthy node and fails over to the others if it errors
+	return selectedRouterNodes.sendNodeMsg(ctx, ch, chainMessage)
+}
+
+// SendNodeMsgToAllNodes sends the message to each of the nodes supporting it separately, used to compare the nodes with each other
+func (cri chainRouterImpl) SendNodeMsgToAllNodes(ctx context.Context, chainMessage ChainMessageForSend, extensions []string) ([]NodeReply, error) {
+	addon := chainMessage.GetApiCollection().CollectionData.AddOn
+	selectedRouterNodes, err := cri.getRouterNodesSupporting(addon, extensions)
+	if err != nil {
+		return nil, err
+	}
+	return selectedRouterNodes.sendNodeMsgToAllNodes(ctx, chainMessage), nil
 }
 
 // batch nodeUrls with the same addons together in a copy
@@ -89,7 +98,7 @@ func batchNodeUrlsByServices(rpcProviderEndpoint lavasession.RPCProviderEndpoint
 	return returnedBatch
 }
 
-func newChainRouter(ctx context.Context, nConns uint, rpcProviderEndpoint lavasession.RPCProviderEndpoint, chainParser ChainParser, proxyConstructor func(context.Context, uint, lavasession.RPCProviderEndpoint, ChainParser) (ChainProxy, error)) (ChainRouter, error) {
+func newChainRouter(ctx context.Context, nConns uint, rpcProviderEndpoint lavasession.RPCProviderEndpoint, chainParser ChainParser, proxyConstructor proxyConstructorFunc) (ChainRouter, error) {
 	chainProxyRouter := map[lavasession.RouterKey][]chainRouterEntry{}
 
 	requiredMap := map[requirementSt]struct{}{}
@@ -117,13 +126,13 @@ func newChainRouter(ctx context.Context, nConns uint, rpcProviderEndpoint lavase
 			return allExtensionsRouterKey
 		}
 		routerKey := updateRouteCombinations(extensions, addons)
-		chainProxy, err := proxyConstructor(ctx, nConns, rpcProviderEndpointEntry, chainParser)
+		// nodes that are down are reconnected in the background, as long as one of them is up
+		routerNodes, err := newRouterNodes(ctx, nConns, rpcProviderEndpointEntry, chainParser, proxyConstructor)
 		if err != nil {
-			// TODO: allow some urls to be down
 			return nil, err
 		}
 		chainRouterEntryInst := chainRouterEntry{
-			ChainProxy:      chainProxy,
+			routerNodes:     routerNodes,
 			addonsSupported: addonsSupportedMap,
 		}
 		if chainRouterEntries, ok := chainProxyRouter[routerKey]; !ok {
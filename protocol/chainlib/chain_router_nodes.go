@@ -0,0 +1,337 @@
+package chainlib
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/parser"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+const (
+	// MaxConsecutiveNodeErrors is the number of consecutive failed requests after which a node stops receiving relays
+	MaxConsecutiveNodeErrors = 3
+	// NodeUnhealthyBackoff is how long an unhealthy node is skipped before it is tried again
+	NodeUnhealthyBackoff = 10 * time.Second
+	// NodeHealthCheckInterval is how often nodes are reconnected and checked for block lag
+	NodeHealthCheckInterval = 5 * time.Second
+)
+
+type proxyConstructorFunc func(context.Context, uint, lavasession.RPCProviderEndpoint, ChainParser) (ChainProxy, error)
+
+// routerNode is a single node (or a set of urls that serve together, like a tendermint ws and http pair) the router can relay to
+type routerNode struct {
+	endpoint          lavasession.RPCProviderEndpoint
+	lock              sync.RWMutex
+	chainProxy        ChainProxy // nil while the node is disconnected
+	inFlight          int64
+	consecutiveErrors uint64
+	lastErrorTime     time.Time
+	latestBlock       int64
+	lagging           bool
+}
+
+func (rn *routerNode) getChainProxy() ChainProxy {
+	rn.lock.RLock()
+	defer rn.lock.RUnlock()
+	return rn.chainProxy
+}
+
+func (rn *routerNode) isHealthy(now time.Time) bool {
+	rn.lock.RLock()
+	defer rn.lock.RUnlock()
+	if rn.lagging {
+		return false
+	}
+	// after the backoff the node gets another chance, a success resets its errors
+	return rn.consecutiveErrors < MaxConsecutiveNodeErrors || now.Sub(rn.lastErrorTime) > NodeUnhealthyBackoff
+}
+
+func (rn *routerNode) recordResult(err error) {
+	rn.lock.Lock()
+	defer rn.lock.Unlock()
+	if err == nil {
+		rn.consecutiveErrors = 0
+		return
+	}
+	rn.consecutiveErrors++
+	rn.lastErrorTime = time.Now()
+	if rn.consecutiveErrors == MaxConsecutiveNodeErrors {
+		utils.LavaFormatWarning("node marked unhealthy after consecutive errors", err, utils.Attribute{Key: "nodeUrls", Value: rn.endpoint.NodeUrls}, utils.Attribute{Key: "chainID", Value: rn.endpoint.ChainID})
+	}
+}
+
+func (rn *routerNode) sendNodeMsg(ctx context.Context, chainProxy ChainProxy, ch chan interface{}, chainMessage ChainMessageForSend) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
+	atomic.AddInt64(&rn.inFlight, 1)
+	defer atomic.AddInt64(&rn.inFlight, -1)
+	relayReply, subscriptionID, relayReplyServer, err = chainProxy.SendNodeMsg(ctx, ch, chainMessage)
+	if err != nil && (ctx.Err() != nil || ch != nil) {
+		// the caller gave up, or a subscription failed (e.g. on an http url), this says nothing about the node
+		return relayReply, subscriptionID, relayReplyServer, err
+	}
+	rn.recordResult(err)
+	return relayReply, subscriptionID, relayReplyServer, err
+}
+
+// routerNodes load balances and fails over between the nodes serving the same addons and extensions
+type routerNodes struct {
+	nodes            []*routerNode
+	next             uint64
+	nConns           uint
+	chainParser      ChainParser
+	proxyConstructor proxyConstructorFunc
+}
+
+func newRouterNodes(ctx context.Context, nConns uint, rpcProviderEndpoint lavasession.RPCProviderEndpoint, chainParser ChainParser, proxyConstructor proxyConstructorFunc) (*routerNodes, error) {
+	rns := &routerNodes{
+		nConns:           nConns,
+		chainParser:      chainParser,
+		proxyConstructor: proxyConstructor,
+	}
+	var firstErr error
+	connected := 0
+	for _, nodeEndpoint := range splitNodeUrlsToNodes(rpcProviderEndpoint) {
+		node := &routerNode{endpoint: nodeEndpoint}
+		chainProxy, err := proxyConstructor(ctx, nConns, nodeEndpoint, chainParser)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			utils.LavaFormatWarning("failed connecting to node, retrying in the background", err, utils.Attribute{Key: "nodeUrls", Value: nodeEndpoint.NodeUrls}, utils.Attribute{Key: "chainID", Value: nodeEndpoint.ChainID})
+		} else {
+			node.chainProxy = chainProxy
+			connected++
+		}
+		rns.nodes = append(rns.nodes, node)
+	}
+	if connected == 0 {
+		// none of the nodes are up, this is most likely a misconfiguration
+		return nil, firstErr
+	}
+	if len(rns.nodes) > 1 {
+		go rns.healthLoop(ctx)
+	}
+	return rns, nil
+}
+
+// splitNodeUrlsToNodes separates the urls of an endpoint to nodes. urls with different roles (a websocket and an http url,
+// or urls for different internal paths) are grouped to the same node, and a repeated role starts a new node
+func splitNodeUrlsToNodes(rpcProviderEndpoint lavasession.RPCProviderEndpoint) []lavasession.RPCProviderEndpoint {
+	type nodeUrlRole struct {
+		internalPath string
+		websocket    bool
+	}
+	nodes := []lavasession.RPCProviderEndpoint{}
+	nodesRoles := []map[nodeUrlRole]struct{}{}
+	for _, nodeUrl := range rpcProviderEndpoint.NodeUrls {
+		role := nodeUrlRole{internalPath: nodeUrl.InternalPath, websocket: strings.HasPrefix(strings.ToLower(nodeUrl.Url), "ws")}
+		added := false
+		for idx := range nodes {
+			if _, ok := nodesRoles[idx][role]; !ok {
+				nodesRoles[idx][role] = struct{}{}
+				nodes[idx].NodeUrls = append(nodes[idx].NodeUrls, nodeUrl)
+				added = true
+				break
+			}
+		}
+		if !added {
+			nodeEndpoint := rpcProviderEndpoint
+			nodeEndpoint.NodeUrls = []common.NodeUrl{nodeUrl}
+			nodes = append(nodes, nodeEndpoint)
+			nodesRoles = append(nodesRoles, map[nodeUrlRole]struct{}{role: {}})
+		}
+	}
+	return nodes
+}
+
+// candidates returns the connected nodes in the order they should be tried: healthy nodes with the least requests in flight first,
+// rotating between equal nodes, and unhealthy nodes last so a relay is still attempted when all nodes are failing
+func (rns *routerNodes) candidates() []*routerNode {
+	now := time.Now()
+	start := int(atomic.AddUint64(&rns.next, 1) % uint64(len(rns.nodes)))
+	healthy := []*routerNode{}
+	unhealthy := []*routerNode{}
+	for idx := range rns.nodes {
+		node := rns.nodes[(start+idx)%len(rns.nodes)]
+		if node.getChainProxy() == nil {
+			continue
+		}
+		if node.isHealthy(now) {
+			healthy = append(healthy, node)
+		} else {
+			unhealthy = append(unhealthy, node)
+		}
+	}
+	sort.SliceStable(healthy, func(i, j int) bool {
+		return atomic.LoadInt64(&healthy[i].inFlight) < atomic.LoadInt64(&healthy[j].inFlight)
+	})
+	return append(healthy, unhealthy...)
+}
+
+func (rns *routerNodes) sendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, proxyUrl common.NodeUrl, chainId string, err error) {
+	candidates := rns.candidates()
+	if len(candidates) == 0 {
+		return nil, "", nil, common.NodeUrl{}, "", utils.LavaFormatError("no connected node supporting requested services", nil, utils.Attribute{Key: "nodes", Value: len(rns.nodes)})
+	}
+	for idx, node := range candidates {
+		chainProxy := node.getChainProxy()
+		relayReply, subscriptionID, relayReplyServer, err = node.sendNodeMsg(ctx, chainProxy, ch, chainMessage)
+		proxyUrl, chainId = chainProxy.GetChainProxyInformation()
+		if err == nil || ctx.Err() != nil || !canFailover(chainMessage, err) {
+			return relayReply, subscriptionID, relayReplyServer, proxyUrl, chainId, err
+		}
+		if idx < len(candidates)-1 {
+			utils.LavaFormatDebug("node failed sending message, failing over to the next node", utils.Attribute{Key: "nodeUrl", Value: proxyUrl.String()}, utils.Attribute{Key: "error", Value: err})
+		}
+	}
+	return relayReply, subscriptionID, relayReplyServer, proxyUrl, chainId, err
+}
+
+// canFailover returns true if a message that failed on a node can be sent to another node. a node that couldn't be reached never
+// got the message, otherwise only messages that don't change the chain's state are resent, since the failed node might have
+// executed it (e.g. broadcasting a transaction twice)
+func canFailover(chainMessage ChainMessageForSend, err error) bool {
+	if errors.Is(err, common.NodeUnreachableError) {
+		return true
+	}
+	if chainMessage == nil || chainMessage.GetApi() == nil {
+		return true
+	}
+	return chainMessage.GetApi().Category.Stateful == 0
+}
+
+// NodeReply is the reply of a single node to a message sent to all the nodes
+type NodeReply struct {
+	RelayReply *pairingtypes.RelayReply
+	ProxyUrl   common.NodeUrl
+	Err        error
+}
+
+// sendNodeMsgToAllNodes sends the message to every connected node, healthy or not, and returns each node's reply
+func (rns *routerNodes) sendNodeMsgToAllNodes(ctx context.Context, chainMessage ChainMessageForSend) []NodeReply {
+	replies := make([]NodeReply, len(rns.nodes))
+	var wg sync.WaitGroup
+	for idx, node := range rns.nodes {
+		chainProxy := node.getChainProxy()
+		if chainProxy == nil {
+			replies[idx] = NodeReply{ProxyUrl: node.endpoint.NodeUrls[0], Err: utils.LavaFormatDebug("node is disconnected")}
+			continue
+		}
+		wg.Add(1)
+		go func(idx int, node *routerNode, chainProxy ChainProxy) {
+			defer wg.Done()
+			relayReply, _, _, err := node.sendNodeMsg(ctx, chainProxy, nil, chainMessage)
+			proxyUrl, _ := chainProxy.GetChainProxyInformation()
+			replies[idx] = NodeReply{RelayReply: relayReply, ProxyUrl: proxyUrl, Err: err}
+		}(idx, node, chainProxy)
+	}
+	wg.Wait()
+	return replies
+}
+
+func (rns *routerNodes) healthLoop(ctx context.Context) {
+	ticker := time.NewTicker(NodeHealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			rns.healthCheck(ctx)
+		}
+	}
+}
+
+// healthCheck reconnects disconnected nodes and marks the nodes that fell behind the most advanced node as lagging
+func (rns *routerNodes) healthCheck(ctx context.Context) {
+	latestBlocks := make([]int64, len(rns.nodes))
+	var wg sync.WaitGroup
+	for idx, node := range rns.nodes {
+		wg.Add(1)
+		go func(idx int, node *routerNode) {
+			defer wg.Done()
+			latestBlocks[idx] = spectypes.NOT_APPLICABLE
+			chainProxy := node.getChainProxy()
+			if chainProxy == nil {
+				var err error
+				chainProxy, err = rns.proxyConstructor(ctx, rns.nConns, node.endpoint, rns.chainParser)
+				if err != nil {
+					utils.LavaFormatDebug("failed reconnecting to node", utils.Attribute{Key: "nodeUrls", Value: node.endpoint.NodeUrls}, utils.Attribute{Key: "error", Value: err})
+					return
+				}
+				node.lock.Lock()
+				node.chainProxy = chainProxy
+				node.consecutiveErrors = 0
+				node.lock.Unlock()
+				utils.LavaFormatInfo("reconnected to node", utils.Attribute{Key: "nodeUrls", Value: node.endpoint.NodeUrls}, utils.Attribute{Key: "chainID", Value: node.endpoint.ChainID})
+			}
+			latestBlock, err := rns.fetchLatestBlock(ctx, node, chainProxy)
+			if err == nil {
+				latestBlocks[idx] = latestBlock
+			}
+		}(idx, node)
+	}
+	wg.Wait()
+	rns.updateLagging(latestBlocks)
+}
+
+func (rns *routerNodes) updateLagging(latestBlocks []int64) {
+	maxLatestBlock := int64(spectypes.NOT_APPLICABLE)
+	for _, latestBlock := range latestBlocks {
+		if latestBlock > maxLatestBlock {
+			maxLatestBlock = latestBlock
+		}
+	}
+	allowedBlockLag, _, _, _ := rns.chainParser.ChainBlockStats()
+	if allowedBlockLag < 1 {
+		allowedBlockLag = 1
+	}
+	for idx, node := range rns.nodes {
+		if latestBlocks[idx] == spectypes.NOT_APPLICABLE {
+			// unknown, the node's errors are tracked separately
+			continue
+		}
+		lagging := maxLatestBlock-latestBlocks[idx] > allowedBlockLag
+		node.lock.Lock()
+		if lagging && !node.lagging {
+			utils.LavaFormatWarning("node marked unhealthy, falling behind other nodes", nil, utils.Attribute{Key: "nodeUrls", Value: node.endpoint.NodeUrls}, utils.Attribute{Key: "latestBlock", Value: latestBlocks[idx]}, utils.Attribute{Key: "maxLatestBlock", Value: maxLatestBlock})
+		}
+		node.latestBlock = latestBlocks[idx]
+		node.lagging = lagging
+		node.lock.Unlock()
+	}
+}
+
+func (rns *routerNodes) fetchLatestBlock(ctx context.Context, node *routerNode, chainProxy ChainProxy) (int64, error) {
+	parsing, collectionData, ok := rns.chainParser.GetParsingByTag(spectypes.FUNCTION_TAG_GET_BLOCKNUM)
+	if !ok {
+		return spectypes.NOT_APPLICABLE, utils.LavaFormatDebug(spectypes.FUNCTION_TAG_GET_BLOCKNUM.String() + " tag function not found")
+	}
+	var craftData *CraftData
+	if parsing.FunctionTemplate != "" {
+		craftData = &CraftData{Path: parsing.ApiName, Data: []byte(parsing.FunctionTemplate), ConnectionType: collectionData.Type}
+	}
+	chainMessage, err := CraftChainMessage(parsing, collectionData.Type, rns.chainParser, craftData, nil)
+	if err != nil {
+		return spectypes.NOT_APPLICABLE, err
+	}
+	reply, _, _, err := node.sendNodeMsg(ctx, chainProxy, nil, chainMessage)
+	if err != nil {
+		return spectypes.NOT_APPLICABLE, err
+	}
+	parserInput, err := FormatResponseForParsing(reply, chainMessage)
+	if err != nil {
+		return spectypes.NOT_APPLICABLE, err
+	}
+	return parser.ParseBlockFromReply(parserInput, parsing.ResultParsing)
+}
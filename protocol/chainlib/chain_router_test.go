@@ -2,11 +2,16 @@ package chainlib
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/lavasession"
 	testcommon "github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
 )
@@ -341,3 +346,237 @@ func TestChainRouter(t *testing.T) {
 		})
 	}
 }
+
+type mockChainProxy struct {
+	nodeUrl common.NodeUrl
+	fail    bool
+	failErr error
+	calls   int
+}
+
+func (mcp *mockChainProxy) GetChainProxyInformation() (common.NodeUrl, string) {
+	return mcp.nodeUrl, "mock"
+}
+
+func (mcp *mockChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
+	mcp.calls++
+	if mcp.fail {
+		if mcp.failErr != nil {
+			return nil, "", nil, mcp.failErr
+		}
+		return nil, "", nil, fmt.Errorf("node down")
+	}
+	return &pairingtypes.RelayReply{Data: []byte(mcp.nodeUrl.Url)}, "", nil, nil
+}
+
+func TestSplitNodeUrlsToNodes(t *testing.T) {
+	playBook := []struct {
+		name     string
+		urls     []common.NodeUrl
+		expected [][]string
+	}{
+		{
+			name:     "single url",
+			urls:     []common.NodeUrl{{Url: "http://a"}},
+			expected: [][]string{{"http://a"}},
+		},
+		{
+			name:     "replicas",
+			urls:     []common.NodeUrl{{Url: "http://a"}, {Url: "http://b"}, {Url: "http://c"}},
+			expected: [][]string{{"http://a"}, {"http://b"}, {"http://c"}},
+		},
+		{
+			name:     "websocket and http pairs",
+			urls:     []common.NodeUrl{{Url: "ws://a"}, {Url: "http://a"}, {Url: "wss://b"}, {Url: "https://b"}},
+			expected: [][]string{{"ws://a", "http://a"}, {"wss://b", "https://b"}},
+		},
+		{
+			name:     "internal paths",
+			urls:     []common.NodeUrl{{Url: "http://a", InternalPath: ""}, {Url: "http://a/x", InternalPath: "/x"}, {Url: "http://b", InternalPath: ""}, {Url: "http://b/x", InternalPath: "/x"}},
+			expected: [][]string{{"http://a", "http://a/x"}, {"http://b", "http://b/x"}},
+		},
+	}
+	for _, play := range playBook {
+		t.Run(play.name, func(t *testing.T) {
+			nodes := splitNodeUrlsToNodes(lavasession.RPCProviderEndpoint{ChainID: "LAV1", NodeUrls: play.urls})
+			require.Len(t, nodes, len(play.expected))
+			for idx, node := range nodes {
+				require.Equal(t, "LAV1", node.ChainID)
+				urls := []string{}
+				for _, nodeUrl := range node.NodeUrls {
+					urls = append(urls, nodeUrl.Url)
+				}
+				require.Equal(t, play.expected[idx], urls)
+			}
+		})
+	}
+}
+
+func TestChainRouterNodesDown(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	chainParser, err := NewChainParser(spectypes.APIInterfaceRest)
+	require.NoError(t, err)
+	chainParser.SetSpec(testcommon.CreateMockSpec())
+
+	down := map[string]bool{"http://b": true}
+	proxyConstructor := func(ctx context.Context, nConns uint, endpoint lavasession.RPCProviderEndpoint, chainParser ChainParser) (ChainProxy, error) {
+		if down[endpoint.NodeUrls[0].Url] {
+			return nil, fmt.Errorf("connection refused")
+		}
+		return &mockChainProxy{nodeUrl: endpoint.NodeUrls[0]}, nil
+	}
+	endpoint := lavasession.RPCProviderEndpoint{ChainID: "LAV1", ApiInterface: spectypes.APIInterfaceRest, NodeUrls: []common.NodeUrl{{Url: "http://a"}, {Url: "http://b"}}}
+
+	// one node up is enough to start
+	_, err = newChainRouter(ctx, 1, endpoint, chainParser, proxyConstructor)
+	require.NoError(t, err)
+	rns, err := newRouterNodes(ctx, 1, endpoint, chainParser, proxyConstructor)
+	require.NoError(t, err)
+	require.Len(t, rns.nodes, 2)
+	require.Len(t, rns.candidates(), 1)
+
+	// the node is reconnected once it's back
+	down["http://b"] = false
+	rns.healthCheck(ctx)
+	require.Len(t, rns.candidates(), 2)
+
+	// all nodes down fails
+	down["http://a"] = true
+	down["http://b"] = true
+	_, err = newChainRouter(ctx, 1, endpoint, chainParser, proxyConstructor)
+	require.Error(t, err)
+}
+
+func TestChainRouterNodesLoadBalanceAndFailover(t *testing.T) {
+	ctx := context.Background()
+	chainParser, err := NewChainParser(spectypes.APIInterfaceRest)
+	require.NoError(t, err)
+	chainParser.SetSpec(testcommon.CreateMockSpec())
+
+	first := &mockChainProxy{nodeUrl: common.NodeUrl{Url: "http://a"}}
+	second := &mockChainProxy{nodeUrl: common.NodeUrl{Url: "http://b"}}
+	rns := &routerNodes{
+		nodes:       []*routerNode{{chainProxy: first}, {chainProxy: second}},
+		chainParser: chainParser,
+	}
+
+	// healthy nodes share the load
+	for i := 0; i < 10; i++ {
+		_, _, _, _, _, err := rns.sendNodeMsg(ctx, nil, nil)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 5, first.calls)
+	require.Equal(t, 5, second.calls)
+
+	// a failing node is failed over within the same relay
+	first.fail = true
+	for first.calls < 5+MaxConsecutiveNodeErrors {
+		reply, _, _, proxyUrl, _, err := rns.sendNodeMsg(ctx, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, "http://b", proxyUrl.Url)
+		require.Equal(t, []byte("http://b"), reply.Data)
+	}
+	require.False(t, rns.nodes[0].isHealthy(time.Now()))
+
+	// an unhealthy node stops receiving relays
+	firstCalls := first.calls
+	for i := 0; i < 10; i++ {
+		_, _, _, _, _, err := rns.sendNodeMsg(ctx, nil, nil)
+		require.NoError(t, err)
+	}
+	require.Equal(t, firstCalls, first.calls)
+
+	// when all nodes fail the relay fails
+	second.fail = true
+	_, _, _, _, _, err = rns.sendNodeMsg(ctx, nil, nil)
+	require.Error(t, err)
+
+	// after the backoff a recovered node is used again
+	first.fail = false
+	require.True(t, rns.nodes[0].isHealthy(time.Now().Add(NodeUnhealthyBackoff+time.Second)))
+	rns.nodes[0].recordResult(nil)
+	require.True(t, rns.nodes[0].isHealthy(time.Now()))
+}
+
+func TestChainRouterNodesFailoverStatefulMessages(t *testing.T) {
+	ctx := context.Background()
+	chainParser, err := NewChainParser(spectypes.APIInterfaceRest)
+	require.NoError(t, err)
+	chainParser.SetSpec(testcommon.CreateMockSpec())
+
+	first := &mockChainProxy{nodeUrl: common.NodeUrl{Url: "http://a"}, fail: true}
+	second := &mockChainProxy{nodeUrl: common.NodeUrl{Url: "http://b"}, fail: true}
+	rns := &routerNodes{
+		nodes:       []*routerNode{{chainProxy: first}, {chainProxy: second}},
+		chainParser: chainParser,
+	}
+	sendTx := &baseChainMessageContainer{api: &spectypes.Api{Name: "send_tx", Category: spectypes.SpecCategory{Stateful: common.CONSISTENCY_SELECT_ALL_PROVIDERS}}}
+	query := &baseChainMessageContainer{api: &spectypes.Api{Name: "query"}}
+
+	// the failed node might have executed the transaction, so it isn't sent again
+	_, _, _, _, _, err = rns.sendNodeMsg(ctx, nil, sendTx)
+	require.Error(t, err)
+	require.Equal(t, 1, first.calls+second.calls)
+
+	// queries are sent to the next node
+	_, _, _, _, _, err = rns.sendNodeMsg(ctx, nil, query)
+	require.Error(t, err)
+	require.Equal(t, 3, first.calls+second.calls)
+
+	// a node that couldn't be reached never got the transaction
+	first.failErr = nodeUnreachableError{utils.LavaFormatProduction("Provider Side Failed Sending Message, Reason: Connection refused", nil)}
+	second.failErr = first.failErr
+	_, _, _, _, _, err = rns.sendNodeMsg(ctx, nil, sendTx)
+	require.Error(t, err)
+	require.Equal(t, 5, first.calls+second.calls)
+}
+
+func TestChainRouterNodesLagging(t *testing.T) {
+	chainParser, err := NewChainParser(spectypes.APIInterfaceRest)
+	require.NoError(t, err)
+	spec := testcommon.CreateMockSpec()
+	spec.AllowedBlockLagForQosSync = 2
+	chainParser.SetSpec(spec)
+
+	rns := &routerNodes{
+		nodes:       []*routerNode{{chainProxy: &mockChainProxy{}}, {chainProxy: &mockChainProxy{}}, {chainProxy: &mockChainProxy{}}},
+		chainParser: chainParser,
+	}
+	rns.updateLagging([]int64{100, 98, 97})
+	require.True(t, rns.nodes[0].isHealthy(time.Now()))
+	require.True(t, rns.nodes[1].isHealthy(time.Now()))
+	require.False(t, rns.nodes[2].isHealthy(time.Now()))
+	require.Len(t, rns.candidates(), 3)
+	require.Equal(t, rns.nodes[2], rns.candidates()[2])
+
+	// unknown latest blocks don't change the node's state, catching up does
+	rns.updateLagging([]int64{101, spectypes.NOT_APPLICABLE, 100})
+	require.True(t, rns.nodes[1].isHealthy(time.Now()))
+	require.True(t, rns.nodes[2].isHealthy(time.Now()))
+}
+
+func TestChainRouterNodesSendToAllNodes(t *testing.T) {
+	ctx := context.Background()
+	chainParser, err := NewChainParser(spectypes.APIInterfaceRest)
+	require.NoError(t, err)
+	chainParser.SetSpec(testcommon.CreateMockSpec())
+
+	rns := &routerNodes{
+		nodes: []*routerNode{
+			{chainProxy: &mockChainProxy{nodeUrl: common.NodeUrl{Url: "http://a"}}},
+			{chainProxy: &mockChainProxy{nodeUrl: common.NodeUrl{Url: "http://b"}, fail: true}},
+			{endpoint: lavasession.RPCProviderEndpoint{NodeUrls: []common.NodeUrl{{Url: "http://c"}}}},
+		},
+		chainParser: chainParser,
+	}
+	// every node is asked, in the order of the nodes, including failing and disconnected ones
+	replies := rns.sendNodeMsgToAllNodes(ctx, nil)
+	require.Len(t, replies, 3)
+	require.NoError(t, replies[0].Err)
+	require.Equal(t, []byte("http://a"), replies[0].RelayReply.Data)
+	require.Error(t, replies[1].Err)
+	require.Equal(t, "http://b", replies[1].ProxyUrl.Url)
+	require.Error(t, replies[2].Err)
+	require.Equal(t, "http://c", replies[2].ProxyUrl.Url)
+}
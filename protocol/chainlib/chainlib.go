@@ -80,6 +80,7 @@ type ChainMessage interface {
 	GetForceCacheRefresh() bool
 	SetForceCacheRefresh(force bool) bool
 	CheckResponseError(data []byte, httpStatusCode int) (hasError bool, errorMessage string)
+	GetResponseSchema() *spectypes.CompiledResponseSchema
 
 	ChainMessageForSend
 }
@@ -117,13 +118,18 @@ type ChainRouter interface {
 	ExtensionsSupported([]string) bool
 }
 
+// NodesChainRouter is implemented by chain routers that can send a message to each of their nodes separately
+type NodesChainRouter interface {
+	SendNodeMsgToAllNodes(ctx context.Context, chainMessage ChainMessageForSend, extensions []string) ([]NodeReply, error)
+}
+
 type ChainProxy interface {
 	GetChainProxyInformation() (common.NodeUrl, string)
 	SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) // has to be thread safe, reuse code within ParseMsg as common functionality
 }
 
 func GetChainRouter(ctx context.Context, nConns uint, rpcProviderEndpoint *lavasession.RPCProviderEndpoint, chainParser ChainParser) (ChainRouter, error) {
-	var proxyConstructor func(context.Context, uint, lavasession.RPCProviderEndpoint, ChainParser) (ChainProxy, error)
+	var proxyConstructor proxyConstructorFunc
 	switch rpcProviderEndpoint.ApiInterface {
 	case spectypes.APIInterfaceJsonRPC:
 		proxyConstructor = NewJrpcChainProxy
@@ -1,6 +1,7 @@
 package chainlib
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -60,8 +61,9 @@ type TaggedContainer struct {
 }
 
 type ApiContainer struct {
-	api           *spectypes.Api
-	collectionKey CollectionKey
+	api            *spectypes.Api
+	collectionKey  CollectionKey
+	responseSchema *spectypes.CompiledResponseSchema // compiled from api.ResponseSchema when the spec is set
 }
 
 type ApiKey struct {
@@ -157,6 +159,22 @@ func constructFiberCallbackWithHeaderAndParameterExtractionAndReferer(callbackTo
 	return handler
 }
 
+// listeners that can't forward partial replies wait for streamed replies to complete
+func waitForStreamedReply(relayResult *common.RelayResult) error {
+	replyStream := relayResult.GetReplyStream()
+	if replyStream == nil {
+		return nil
+	}
+	var data bytes.Buffer
+	_, err := replyStream.WriteTo(&data)
+	if err != nil {
+		return err
+	}
+	relayResult.Reply.Data = data.Bytes()
+	relayResult.ReplyStream = nil
+	return nil
+}
+
 func convertToJsonError(errorMsg string) string {
 	jsonResponse, err := json.Marshal(fiber.Map{
 		"error": errorMsg,
@@ -99,6 +99,7 @@ func (apip *JsonRPCChainParser) ParseMsg(url string, data []byte, connectionType
 		return nil, errors.New("empty unmarshaled json")
 	}
 	var api *spectypes.Api
+	var responseSchema *spectypes.CompiledResponseSchema // batches have no response schema
 	var apiCollection *spectypes.ApiCollection
 	var latestRequestedBlock, earliestRequestedBlock int64 = 0, 0
 	for idx, msg := range msgs {
@@ -141,6 +142,7 @@ func (apip *JsonRPCChainParser) ParseMsg(url string, data []byte, connectionType
 		if idx == 0 {
 			// on the first entry store them
 			api = apiCont.api
+			responseSchema = apiCont.responseSchema
 			apiCollection = apiCollectionForMessage
 			latestRequestedBlock = requestedBlockForMessage
 		} else {
@@ -183,6 +185,7 @@ func (apip *JsonRPCChainParser) ParseMsg(url string, data []byte, connectionType
 	var nodeMsg *baseChainMessageContainer
 	if len(msgs) == 1 {
 		nodeMsg = apip.newChainMessage(api, latestRequestedBlock, &msgs[0], apiCollection)
+		nodeMsg.responseSchema = responseSchema
 	} else {
 		nodeMsg, err = apip.newBatchChainMessage(api, latestRequestedBlock, earliestRequestedBlock, msgs, apiCollection)
 		if err != nil {
@@ -364,6 +367,9 @@ func (apil *JsonRPCChainListener) Serve(ctx context.Context, cmdFlags common.Con
 			)
 			metricsData := metrics.NewRelayAnalytics(dappID, chainID, apiInterface)
 			relayResult, err := apil.relaySender.SendRelay(ctx, "", string(msg), http.MethodPost, dappID, websockConn.RemoteAddr().String(), metricsData, nil)
+			if err == nil {
+				err = waitForStreamedReply(relayResult)
+			}
 			if ok && refererMatch != "" && apil.refererData != nil && err == nil {
 				go apil.refererData.SendReferer(refererMatch, chainID, string(msg), nil, websockConn)
 			}
@@ -497,7 +503,7 @@ func (apil *JsonRPCChainListener) Serve(ctx context.Context, cmdFlags common.Con
 			fiberCtx.Status(relayResult.StatusCode)
 		}
 		// Return json response
-		return addHeadersAndSendString(fiberCtx, reply.GetMetadata(), response)
+		return addHeadersAndSendRelayResult(fiberCtx, relayResult)
 	}
 	if apil.refererData != nil && apil.refererData.Marker != "" {
 		app.Use("/"+apil.refererData.Marker+":"+refererMatchString+"/ws", func(c *fiber.Ctx) error {
@@ -3,6 +3,7 @@ package chainlib
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -21,6 +22,15 @@ import (
 
 type genericErrorHandler struct{}
 
+// nodeUnreachableError keeps the error's message, and marks that the request never reached the node
+type nodeUnreachableError struct {
+	error
+}
+
+func (nue nodeUnreachableError) Unwrap() error {
+	return common.NodeUnreachableError
+}
+
 func (geh *genericErrorHandler) handleConnectionError(err error) error {
 	if err == net.ErrWriteToConnected {
 		return utils.LavaFormatProduction("Provider Side Failed Sending Message, Reason: Write to connected connection", nil)
@@ -30,11 +40,12 @@ func (geh *genericErrorHandler) handleConnectionError(err error) error {
 		return utils.LavaFormatProduction("Provider Side Failed Sending Message, Reason: End of input stream reached", nil)
 	} else if opErr, ok := err.(*net.OpError); ok && opErr.Timeout() {
 		return utils.LavaFormatProduction("Provider Side Failed Sending Message, Reason: Network operation timed out", nil)
-	} else if _, ok := err.(*net.DNSError); ok {
-		return utils.LavaFormatProduction("Provider Side Failed Sending Message, Reason: DNS resolution failed", nil)
-	} else if opErr, ok := err.(*net.OpError); ok {
+	} else if dnsErr := new(net.DNSError); errors.As(err, &dnsErr) {
+		// the request never reached the node, so it can be sent to another node
+		return nodeUnreachableError{utils.LavaFormatProduction("Provider Side Failed Sending Message, Reason: DNS resolution failed", nil)}
+	} else if opErr := new(net.OpError); errors.As(err, &opErr) {
 		if sysErr, ok := opErr.Err.(*os.SyscallError); ok && sysErr.Err == syscall.ECONNREFUSED {
-			return utils.LavaFormatProduction("Provider Side Failed Sending Message, Reason: Connection refused", nil)
+			return nodeUnreachableError{utils.LavaFormatProduction("Provider Side Failed Sending Message, Reason: Connection refused", nil)}
 		}
 	} else if strings.Contains(err.Error(), "http: server gave HTTP response to HTTPS client") {
 		return utils.LavaFormatProduction("Provider Side Failed Sending Message, Reason: misconfigured http endpoint as https", nil)
@@ -5,10 +5,12 @@ import (
 	"errors"
 	"io"
 	"net"
+	"net/url"
 	"os"
 	"syscall"
 	"testing"
 
+	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/utils"
 	"github.com/stretchr/testify/require"
 )
@@ -73,6 +75,10 @@ func TestNodeErrorHandlerGenericErrors(t *testing.T) {
 	err = neh.handleGenericErrors(ctx, opErr)
 	expectedError = utils.LavaFormatError("Provider Side Failed Sending Message, Reason: Connection refused", nil)
 	require.Equal(t, err.Error(), expectedError.Error())
+	// the request never reached the node, it's also detected when wrapped by the http client
+	require.ErrorIs(t, err, common.NodeUnreachableError)
+	err = neh.handleGenericErrors(ctx, &url.Error{Op: "Post", URL: "http://node", Err: opErr})
+	require.ErrorIs(t, err, common.NodeUnreachableError)
 
 	// Test non-matching error
 	err = neh.handleGenericErrors(ctx, errors.New("dummy error"))
@@ -135,6 +135,7 @@ func (apip *RestChainParser) ParseMsg(urlPath string, data []byte, connectionTyp
 	}
 
 	nodeMsg := apip.newChainMessage(apiCont.api, requestedBlock, &restMessage, apiCollection)
+	nodeMsg.responseSchema = apiCont.responseSchema
 	apip.BaseChainParser.ExtensionParsing(apiCollection.CollectionData.AddOn, nodeMsg, extensionInfo)
 	return nodeMsg, apip.BaseChainParser.Validate(nodeMsg)
 }
@@ -342,7 +343,7 @@ func (apil *RestChainListener) Serve(ctx context.Context, cmdFlags common.Consum
 			fiberCtx.Status(relayResult.StatusCode)
 		}
 		// Return json response
-		return addHeadersAndSendString(fiberCtx, reply.GetMetadata(), string(reply.Data))
+		return addHeadersAndSendRelayResult(fiberCtx, relayResult)
 	}
 
 	handlerUse := func(fiberCtx *fiber.Ctx) error {
@@ -412,7 +413,7 @@ func (apil *RestChainListener) Serve(ctx context.Context, cmdFlags common.Consum
 		apil.logger.LogRequestAndResponse("http in/out", false, http.MethodGet, path, "", string(reply.Data), msgSeed, time.Since(startTime), nil)
 
 		// Return json response
-		return addHeadersAndSendString(fiberCtx, reply.GetMetadata(), string(reply.Data))
+		return addHeadersAndSendRelayResult(fiberCtx, relayResult)
 	}
 
 	if apil.refererData != nil && apil.refererData.Marker != "" {
@@ -436,6 +437,22 @@ func addHeadersAndSendString(c *fiber.Ctx, metaData []pairingtypes.Metadata, dat
 	return c.SendString(data)
 }
 
+// sends the reply of a successful relay, streamed replies are written to the client as their chunks arrive
+func addHeadersAndSendRelayResult(c *fiber.Ctx, relayResult *common.RelayResult) error {
+	reply := relayResult.GetReply()
+	replyStream := relayResult.GetReplyStream()
+	if replyStream == nil {
+		return addHeadersAndSendString(c, reply.GetMetadata(), string(reply.GetData()))
+	}
+	for _, value := range reply.GetMetadata() {
+		c.Set(value.Name, value.Value)
+	}
+	// the status and headers are sent before the reply is verified, if verification fails the response is aborted before its last chunk
+	// so the client gets an error instead of a truncated reply
+	c.Context().SetBodyStream(replyStream.NewReader(), -1)
+	return nil
+}
+
 type RestChainProxy struct {
 	BaseChainProxy
 	httpClient *http.Client
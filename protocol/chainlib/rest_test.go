@@ -3,11 +3,14 @@ package chainlib
 import (
 	"context"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
 	"strconv"
 	"testing"
 	"time"
 
+	"github.com/gofiber/fiber/v2"
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy"
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
 	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
@@ -294,3 +297,31 @@ func TestSettingRequestedBlocksHeadersRest(t *testing.T) {
 		})
 	}
 }
+
+func TestAddHeadersAndSendRelayResultStream(t *testing.T) {
+	replyStream := common.NewRelayReplyStream()
+	relayResult := &common.RelayResult{
+		Reply:       &pairingtypes.RelayReply{Metadata: []pairingtypes.Metadata{{Name: "Provider-Header", Value: "value"}}},
+		ReplyStream: replyStream,
+	}
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	app.Get("/", func(c *fiber.Ctx) error {
+		return addHeadersAndSendRelayResult(c, relayResult)
+	})
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go app.Listener(listener)
+	defer app.Shutdown()
+
+	go func() {
+		replyStream.Append([]byte("partial"))
+		replyStream.Close(fmt.Errorf("reply failed verification"))
+	}()
+	resp, err := http.Get("http://" + listener.Addr().String())
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, "value", resp.Header.Get("Provider-Header"))
+	// the reply failed verification after the status was sent, so the body can't be read to completion
+	_, err = io.ReadAll(resp.Body)
+	require.Error(t, err)
+}
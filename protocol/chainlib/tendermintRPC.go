@@ -130,6 +130,7 @@ func (apip *TendermintChainParser) ParseMsg(urlPath string, data []byte, connect
 	}
 
 	var api *spectypes.Api
+	var responseSchema *spectypes.CompiledResponseSchema // batches have no response schema
 	var apiCollection *spectypes.ApiCollection
 	var latestRequestedBlock, earliestRequestedBlock int64 = 0, 0
 	for idx, msg := range msgs {
@@ -172,6 +173,7 @@ func (apip *TendermintChainParser) ParseMsg(urlPath string, data []byte, connect
 		if idx == 0 {
 			// on the first entry store them
 			api = apiCont.api
+			responseSchema = apiCont.responseSchema
 			apiCollection = apiCollectionForMessage
 			latestRequestedBlock = requestedBlockForMessage
 		} else {
@@ -219,6 +221,7 @@ func (apip *TendermintChainParser) ParseMsg(urlPath string, data []byte, connect
 			tenderMsg.Path = urlPath // add path
 		}
 		nodeMsg = apip.newChainMessage(api, latestRequestedBlock, &tenderMsg, apiCollection)
+		nodeMsg.responseSchema = responseSchema
 	} else {
 		var err error
 		nodeMsg, err = apip.newBatchChainMessage(api, latestRequestedBlock, earliestRequestedBlock, msgs, apiCollection)
@@ -393,6 +396,9 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context, cmdFlags comm
 			refererMatch, ok := websocketConn.Locals(refererMatchString).(string)
 			metricsData := metrics.NewRelayAnalytics(dappID, chainID, apiInterface)
 			relayResult, err := apil.relaySender.SendRelay(ctx, "", string(msg), "", dappID, websocketConn.RemoteAddr().String(), metricsData, nil)
+			if err == nil {
+				err = waitForStreamedReply(relayResult)
+			}
 			if ok && refererMatch != "" && apil.refererData != nil && err == nil {
 				go apil.refererData.SendReferer(refererMatch, chainID, string(msg), nil, websocketConn)
 			}
@@ -510,9 +516,8 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context, cmdFlags comm
 		if relayResult.GetStatusCode() != 0 {
 			fiberCtx.Status(relayResult.StatusCode)
 		}
-		response := string(reply.Data)
 		// Return json response
-		return addHeadersAndSendString(fiberCtx, reply.GetMetadata(), response)
+		return addHeadersAndSendRelayResult(fiberCtx, relayResult)
 	}
 
 	handlerGet := func(fiberCtx *fiber.Ctx) error {
@@ -580,7 +585,7 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context, cmdFlags comm
 			fiberCtx.Status(relayResult.StatusCode)
 		}
 		// Return json response
-		return addHeadersAndSendString(fiberCtx, reply.GetMetadata(), response)
+		return addHeadersAndSendRelayResult(fiberCtx, relayResult)
 	}
 
 	if apil.refererData != nil && apil.refererData.Marker != "" {
@@ -36,9 +36,19 @@ const (
 	PollingUpdateLength           = 10
 	MostFrequentPollingMultiplier = 16
 	PollingMultiplierFlagName     = "polling-multiplier"
+	PushModeFlagName              = "chain-tracker-push-mode"
+	NewHeadsResubscribeInterval   = 10 * time.Second
+	CrossCheckFlagName            = "chain-tracker-cross-check"
+	WithholdOnDisagreementFlag    = "chain-tracker-withhold-on-disagreement"
+	CrossCheckBlocksInterval      = 10 // cross check the nodes once every this many average block times
 )
 
-var PollingMultiplier = uint64(1)
+var (
+	PollingMultiplier      = uint64(1)
+	PushMode               = false
+	CrossCheckNodes        = false
+	WithholdOnDisagreement = false
+)
 
 type ChainFetcher interface {
 	FetchLatestBlockNum(ctx context.Context) (int64, error)
@@ -46,6 +56,12 @@ type ChainFetcher interface {
 	FetchEndpoint() lavasession.RPCProviderEndpoint
 }
 
+// NewHeadsSubscriber is implemented by chain fetchers that can push the node's new blocks instead of being polled for them.
+// the returned channel receives the new latest blocks and is closed when the subscription ends
+type NewHeadsSubscriber interface {
+	SubscribeNewHeads(ctx context.Context) (<-chan int64, error)
+}
+
 type blockTimeUpdatable interface {
 	UpdateBlockTime(time.Duration)
 }
@@ -53,10 +69,11 @@ type blockTimeUpdatable interface {
 type ChainTracker struct {
 	chainFetcher            ChainFetcher // used to communicate with the node
 	blocksToSave            uint64       // how many finalized blocks to keep
+	blocksToFinalization    uint64       // how many blocks behind the latest block a block is finalized
 	latestBlockNum          int64
 	blockQueueMu            sync.RWMutex
 	blocksQueue             []BlockStore                    // holds all past hashes up until latest block
-	forkCallback            func(int64)                     // a function to be called when a fork is detected
+	forkCallback            func(int64)                     // a function to be called when a fork is detected, with the first block that was replaced
 	newLatestCallback       func(int64, int64, string)      // a function to be called when a new block is detected, from what block to what block including gaps
 	oldBlockCallback        func(latestBlockTime time.Time) // a function to be called when an old block is detected
 	consistencyCallback     func(oldBlock int64, block int64)
@@ -70,6 +87,11 @@ type ChainTracker struct {
 	blockEventsGap          []time.Duration
 	blockTimeUpdatables     map[blockTimeUpdatable]struct{}
 	pmetrics                *metrics.ProviderMetricsManager
+	pushMode                bool
+	pushActive              atomic.Bool // set while new blocks are pushed by a subscription, polling is only a fallback then
+	crossCheckNodes         bool
+	withholdOnDisagreement  bool
+	nodesDisagree           atomic.Bool // set while the last cross check found the nodes disagreeing
 }
 
 // this function returns block hashes of the blocks: [from block - to block] inclusive. an additional specific block hash can be provided. order is sorted ascending
@@ -81,6 +103,10 @@ func (cs *ChainTracker) GetLatestBlockData(fromBlock, toBlock, specificBlock int
 	defer cs.blockQueueMu.RUnlock()
 
 	latestBlock = cs.GetAtomicLatestBlockNum()
+	if cs.withholdOnDisagreement && cs.nodesDisagree.Load() {
+		// we can't tell which hashes are right, so we don't sign any. the latest block is still returned so relays are served
+		return latestBlock, nil, cs.latestChangeTime, nil
+	}
 	if len(cs.blocksQueue) == 0 {
 		return latestBlock, nil, time.Time{}, utils.LavaFormatError("ChainTracker GetLatestBlockData had no blocks", nil, utils.Attribute{Key: "latestBlock", Value: latestBlock})
 	}
@@ -139,6 +165,13 @@ func (cs *ChainTracker) GetLatestBlockNum() (int64, time.Time) {
 	return atomic.LoadInt64(&cs.latestBlockNum), cs.latestChangeTime
 }
 
+// latestChangeTime is only set by the tracker routine, but it is read under blockQueueMu by the relays
+func (cs *ChainTracker) setLatestChangeTime(changeTime time.Time) {
+	cs.blockQueueMu.Lock()
+	defer cs.blockQueueMu.Unlock()
+	cs.latestChangeTime = changeTime
+}
+
 func (cs *ChainTracker) GetAtomicLatestBlockNum() int64 {
 	return atomic.LoadInt64(&cs.latestBlockNum)
 }
@@ -283,6 +316,32 @@ func (cs *ChainTracker) forkChanged(ctx context.Context, newLatestBlock int64) (
 	return latestBlockSaved.Hash != prevHash, nil
 }
 
+func (cs *ChainTracker) copyBlocksQueue() []BlockStore {
+	cs.blockQueueMu.RLock()
+	defer cs.blockQueueMu.RUnlock()
+	return append([]BlockStore{}, cs.blocksQueue...)
+}
+
+// findForkBlock returns the first block of the previous blocks whose hash is different in the current blocks queue.
+// if none of them can be compared, the fork can be as deep as the earliest block we had in memory
+func (cs *ChainTracker) findForkBlock(previousBlocks []BlockStore, previousLatest int64) int64 {
+	cs.blockQueueMu.RLock()
+	defer cs.blockQueueMu.RUnlock()
+	currentHashes := make(map[int64]string, len(cs.blocksQueue))
+	for _, blockStore := range cs.blocksQueue {
+		currentHashes[blockStore.Block] = blockStore.Hash
+	}
+	for _, blockStore := range previousBlocks {
+		if hash, ok := currentHashes[blockStore.Block]; ok && hash != blockStore.Hash {
+			return blockStore.Block
+		}
+	}
+	if len(previousBlocks) > 0 {
+		return previousBlocks[0].Block
+	}
+	return previousLatest
+}
+
 func (cs *ChainTracker) gotNewBlock(ctx context.Context, newLatestBlock int64) (gotNewBlock bool) {
 	return newLatestBlock > cs.GetAtomicLatestBlockNum()
 }
@@ -305,6 +364,11 @@ func (cs *ChainTracker) fetchAllPreviousBlocksIfNecessary(ctx context.Context) (
 		return err
 	}
 	cs.pmetrics.SetLatestBlockFetchSuccess(cs.endpoint.ChainID)
+	return cs.updateLatestBlock(ctx, newLatestBlock)
+}
+
+// updateLatestBlock checks a latest block reported by the node, polled or pushed, for a new block or a fork and fetches all the necessary previous data
+func (cs *ChainTracker) updateLatestBlock(ctx context.Context, newLatestBlock int64) (err error) {
 	gotNewBlock := cs.gotNewBlock(ctx, newLatestBlock)
 	forked, err := cs.forkChanged(ctx, newLatestBlock)
 	if err != nil {
@@ -314,6 +378,11 @@ func (cs *ChainTracker) fetchAllPreviousBlocksIfNecessary(ctx context.Context) (
 	prev_latest := cs.GetAtomicLatestBlockNum()
 	cs.pmetrics.SetSpecificBlockFetchSuccess(cs.endpoint.ChainID)
 	if gotNewBlock || forked {
+		var previousBlocks []BlockStore
+		if forked {
+			// keep the replaced branch so we can tell where it forked
+			previousBlocks = cs.copyBlocksQueue()
+		}
 		latestHash, err := cs.fetchAllPreviousBlocks(ctx, newLatestBlock)
 		if err != nil {
 			return err
@@ -327,11 +396,13 @@ func (cs *ChainTracker) fetchAllPreviousBlocksIfNecessary(ctx context.Context) (
 			if !cs.latestChangeTime.IsZero() {
 				cs.AddBlockGap(time.Since(cs.latestChangeTime), blocksUpdated)
 			}
-			cs.latestChangeTime = time.Now()
+			cs.setLatestChangeTime(time.Now())
 		}
 		if forked {
+			forkBlock := cs.findForkBlock(previousBlocks, prev_latest)
+			utils.LavaFormatDebug("Chain Tracker detected a fork", utils.Attribute{Key: "forkBlock", Value: forkBlock}, utils.Attribute{Key: "latest_block", Value: newLatestBlock}, utils.Attribute{Key: "ChainID", Value: cs.endpoint.ChainID})
 			if cs.forkCallback != nil {
-				cs.forkCallback(newLatestBlock)
+				cs.forkCallback(forkBlock)
 			}
 		}
 	} else if prev_latest > newLatestBlock {
@@ -362,13 +433,30 @@ func (cs *ChainTracker) start(ctx context.Context, pollingTime time.Duration) er
 	// so polling at averageBlockTime/4,averageBlockTime/2,averageBlockTime*5/8,averageBlockTime*3/4,averageBlockTime*13/16,,averageBlockTime*14/16,,averageBlockTime*15/16,averageBlockTime*16/16,averageBlockTime*17/16
 	// initial polling = averageBlockTime/16
 	initialPollingTime := pollingTime / MostFrequentPollingMultiplier // on boot we need to query often to catch changes
-	cs.latestChangeTime = time.Time{}                                 // we will discard the first change time, so this is uninitialized
+	cs.setLatestChangeTime(time.Time{})                               // we will discard the first change time, so this is uninitialized
 	cs.timer = time.NewTimer(initialPollingTime)
 	err := cs.fetchInitDataWithRetry(ctx)
 	if err != nil {
 		return err
 	}
 	blockGapTicker := time.NewTicker(pollingTime) // initially every block we check for a polling time
+	// stays nil and is never selected when not pushing
+	var pushedBlocks chan int64
+	if cs.pushMode {
+		if subscriber, ok := cs.chainFetcher.(NewHeadsSubscriber); ok {
+			pushedBlocks = make(chan int64)
+			go cs.newHeadsLoop(ctx, subscriber, pushedBlocks)
+		} else {
+			utils.LavaFormatInfo("chain fetcher doesn't support new heads subscriptions, chain tracker is polling", utils.Attribute{Key: "endpoint", Value: cs.endpoint.String()})
+		}
+	}
+	if cs.crossCheckNodes {
+		if sampler, ok := cs.chainFetcher.(NodesSampler); ok {
+			go cs.crossCheckLoop(ctx, sampler, pollingTime*CrossCheckBlocksInterval)
+		} else {
+			utils.LavaFormatInfo("chain fetcher doesn't support sampling nodes, chain tracker is not cross checking", utils.Attribute{Key: "endpoint", Value: cs.endpoint.String()})
+		}
+	}
 	// Polls blocks and keeps a queue of them
 	go func() {
 		fetchFails := uint64(0)
@@ -393,6 +481,17 @@ func (cs *ChainTracker) start(ctx context.Context, pollingTime time.Duration) er
 					cs.updateTimer(pollingTime, 0)
 					fetchFails = 0
 				}
+			case newLatestBlock := <-pushedBlocks:
+				if newLatestBlock <= cs.GetAtomicLatestBlockNum() {
+					// a late notification, polling keeps checking for forks on the same block
+					continue
+				}
+				fetchCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
+				err := cs.updateLatestBlock(fetchCtx, newLatestBlock)
+				cancel()
+				if err != nil {
+					utils.LavaFormatDebug("failed to update pushed latest block", utils.Attribute{Key: "error", Value: err}, utils.Attribute{Key: "block", Value: newLatestBlock}, utils.Attribute{Key: "endpoint", Value: cs.endpoint.String()})
+				}
 			case <-blockGapTicker.C:
 				var enoughSamples bool
 				pollingTime, enoughSamples = cs.updatePollingTimeBasedOnBlockGap(pollingTime)
@@ -408,7 +507,44 @@ func (cs *ChainTracker) start(ctx context.Context, pollingTime time.Duration) er
 	return nil
 }
 
+// newHeadsLoop keeps a new heads subscription to the node and forwards its blocks, resubscribing when it drops.
+// while there is no subscription the chain tracker keeps polling as usual
+func (cs *ChainTracker) newHeadsLoop(ctx context.Context, subscriber NewHeadsSubscriber, pushedBlocks chan<- int64) {
+	fails := uint64(0)
+	for {
+		newHeads, err := subscriber.SubscribeNewHeads(ctx)
+		if err != nil {
+			fails++
+			utils.LavaFormatDebug("failed subscribing to new heads, chain tracker is polling", utils.Attribute{Key: "error", Value: err}, utils.Attribute{Key: "fails", Value: fails}, utils.Attribute{Key: "endpoint", Value: cs.endpoint.String()})
+		} else {
+			fails = 0
+			cs.pushActive.Store(true)
+			utils.LavaFormatInfo("chain tracker subscribed to new heads", utils.Attribute{Key: "endpoint", Value: cs.endpoint.String()})
+			for newLatestBlock := range newHeads {
+				select {
+				case pushedBlocks <- newLatestBlock:
+				case <-ctx.Done():
+					cs.pushActive.Store(false)
+					return
+				}
+			}
+			cs.pushActive.Store(false)
+			utils.LavaFormatWarning("chain tracker new heads subscription ended, falling back to polling", nil, utils.Attribute{Key: "endpoint", Value: cs.endpoint.String()})
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(exponentialBackoff(NewHeadsResubscribeInterval, fails)):
+		}
+	}
+}
+
 func (cs *ChainTracker) updateTimer(tickerBaseTime time.Duration, fetchFails uint64) {
+	if cs.pushActive.Load() {
+		// new blocks are pushed, polling once a block is enough to catch missed notifications and forks
+		cs.timer = time.NewTimer(exponentialBackoff(tickerBaseTime, fetchFails))
+		return
+	}
 	blockGap := cs.smallestBlockGap()
 	timeSinceLastUpdate := time.Since(cs.latestChangeTime)
 	var newPollingTime time.Duration
@@ -582,6 +718,7 @@ func NewChainTracker(ctx context.Context, chainFetcher ChainFetcher, config Chai
 		newLatestCallback:       config.NewLatestCallback,
 		oldBlockCallback:        config.OldBlockCallback,
 		blocksToSave:            config.BlocksToSave,
+		blocksToFinalization:    config.BlocksToFinalization,
 		chainFetcher:            chainFetcher,
 		latestBlockNum:          0,
 		serverBlockMemory:       config.ServerBlockMemory,
@@ -590,6 +727,9 @@ func NewChainTracker(ctx context.Context, chainFetcher ChainFetcher, config Chai
 		blockTimeUpdatables:     map[blockTimeUpdatable]struct{}{},
 		startupTime:             time.Now(),
 		pmetrics:                config.Pmetrics,
+		pushMode:                config.PushMode,
+		crossCheckNodes:         config.CrossCheckNodes,
+		withholdOnDisagreement:  config.WithholdOnDisagreement,
 	}
 	if chainFetcher == nil {
 		return nil, utils.LavaFormatError("can't start chainTracker with nil chainFetcher argument", nil)
@@ -6,6 +6,7 @@ import (
 	"os"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -104,6 +105,17 @@ func (mcf *MockChainFetcher) Fork(fork string) {
 	}
 }
 
+// ForkFrom replaces the hashes of the blocks from fromBlock and above, leaving older blocks on the same branch
+func (mcf *MockChainFetcher) ForkFrom(fork string, fromBlock int64) {
+	mcf.mutex.Lock()
+	defer mcf.mutex.Unlock()
+	for _, blockStore := range mcf.blockHashes {
+		if blockStore.Block >= fromBlock {
+			blockStore.Hash += fork
+		}
+	}
+}
+
 func (mcf *MockChainFetcher) Shrink(newSize int) {
 	mcf.mutex.Lock()
 	defer mcf.mutex.Unlock()
@@ -115,6 +127,44 @@ func (mcf *MockChainFetcher) Shrink(newSize int) {
 	copy(newHashes, mcf.blockHashes[currentSize-newSize:])
 }
 
+// MockPushChainFetcher pushes the blocks sent on newHeads instead of waiting to be polled
+type MockPushChainFetcher struct {
+	*MockChainFetcher
+	newHeads      chan int64
+	subscriptions int32
+}
+
+func (mpcf *MockPushChainFetcher) SubscribeNewHeads(ctx context.Context) (<-chan int64, error) {
+	atomic.AddInt32(&mpcf.subscriptions, 1)
+	return mpcf.newHeads, nil
+}
+
+// MockSamplerChainFetcher samples several nodes, the nodes listed in badNodes answer with a different hash
+type MockSamplerChainFetcher struct {
+	*MockChainFetcher
+	nodes        int
+	badNodes     sync.Map
+	sampledBlock atomic.Int64
+}
+
+func (mscf *MockSamplerChainFetcher) SampleNodes(ctx context.Context, blockNum int64) []chaintracker.NodeBlockSample {
+	mscf.sampledBlock.Store(blockNum)
+	hash, err := mscf.FetchBlockHashByNum(ctx, blockNum)
+	if err != nil {
+		return nil
+	}
+	latestBlock, _ := mscf.FetchLatestBlockNum(ctx)
+	samples := []chaintracker.NodeBlockSample{}
+	for i := 0; i < mscf.nodes; i++ {
+		sample := chaintracker.NodeBlockSample{Node: "node" + strconv.Itoa(i), LatestBlock: latestBlock, Hash: hash}
+		if _, ok := mscf.badNodes.Load(i); ok {
+			sample.Hash = "minority-fork"
+		}
+		samples = append(samples, sample)
+	}
+	return samples
+}
+
 func NewMockChainFetcher(startBlock, blocksToSave int64, callback func()) *MockChainFetcher {
 	mockCHainFetcher := MockChainFetcher{callBack: callback}
 	for i := int64(0); i < blocksToSave; i++ {
@@ -350,6 +400,141 @@ func TestChainTrackerCallbacks(t *testing.T) {
 	})
 }
 
+func TestChainTrackerForkBlock(t *testing.T) {
+	mockBlocks := int64(100)
+	fetcherBlocks := 10
+	mockChainFetcher := NewMockChainFetcher(1000, mockBlocks, nil)
+	currentLatestBlockInMock := mockChainFetcher.AdvanceBlock()
+
+	forkBlocks := make(chan int64, 10)
+	forkCallback := func(forkBlock int64) {
+		forkBlocks <- forkBlock
+	}
+	chainTrackerConfig := chaintracker.ChainTrackerConfig{BlocksToSave: uint64(fetcherBlocks), AverageBlockTime: TimeForPollingMock, ServerBlockMemory: uint64(mockBlocks), ForkCallback: forkCallback}
+	chainTracker, err := chaintracker.NewChainTracker(context.Background(), mockChainFetcher, chainTrackerConfig)
+	require.NoError(t, err)
+
+	for _, depth := range []int64{0, 3, 1} {
+		// a shallow reorg replaces only the latest blocks
+		mockChainFetcher.ForkFrom("-reorg", currentLatestBlockInMock-depth)
+		select {
+		case forkBlock := <-forkBlocks:
+			require.Equal(t, currentLatestBlockInMock-depth, forkBlock)
+		case <-time.After(SleepTime * SleepChunks * 10):
+			t.Fatalf("fork callback wasn't called for a reorg of depth %d", depth)
+		}
+		currentLatestBlockInMock = mockChainFetcher.AdvanceBlock()
+		for sleepChunk := 0; sleepChunk < SleepChunks; sleepChunk++ {
+			time.Sleep(SleepTime)
+			if chainTracker.GetAtomicLatestBlockNum() >= currentLatestBlockInMock {
+				break
+			}
+		}
+		require.Equal(t, currentLatestBlockInMock, chainTracker.GetAtomicLatestBlockNum())
+	}
+}
+
+func TestChainTrackerPushMode(t *testing.T) {
+	mockBlocks := int64(100)
+	fetcherBlocks := 10
+	mockChainFetcher := &MockPushChainFetcher{MockChainFetcher: NewMockChainFetcher(1000, mockBlocks, nil), newHeads: make(chan int64)}
+	currentLatestBlockInMock := mockChainFetcher.AdvanceBlock()
+
+	forkBlocks := make(chan int64, 10)
+	forkCallback := func(forkBlock int64) {
+		forkBlocks <- forkBlock
+	}
+	// polling is too slow to catch up during the test, blocks can only arrive by being pushed
+	chainTrackerConfig := chaintracker.ChainTrackerConfig{BlocksToSave: uint64(fetcherBlocks), AverageBlockTime: time.Minute, ServerBlockMemory: uint64(mockBlocks), ForkCallback: forkCallback, PushMode: true}
+	chainTracker, err := chaintracker.NewChainTracker(context.Background(), mockChainFetcher, chainTrackerConfig)
+	require.NoError(t, err)
+	require.Equal(t, currentLatestBlockInMock, chainTracker.GetAtomicLatestBlockNum())
+
+	waitForLatest := func(expected int64) {
+		for sleepChunk := 0; sleepChunk < SleepChunks*10; sleepChunk++ {
+			if chainTracker.GetAtomicLatestBlockNum() >= expected {
+				break
+			}
+			time.Sleep(SleepTime)
+		}
+		require.Equal(t, expected, chainTracker.GetAtomicLatestBlockNum())
+	}
+
+	for i := 0; i < 3; i++ {
+		currentLatestBlockInMock = mockChainFetcher.AdvanceBlock()
+		mockChainFetcher.newHeads <- currentLatestBlockInMock
+		waitForLatest(currentLatestBlockInMock)
+	}
+	require.Equal(t, int32(1), atomic.LoadInt32(&mockChainFetcher.subscriptions))
+
+	// a late notification is ignored
+	mockChainFetcher.newHeads <- currentLatestBlockInMock - 1
+	waitForLatest(currentLatestBlockInMock)
+
+	// pushed blocks go through the same fork detection
+	mockChainFetcher.ForkFrom("-reorg", currentLatestBlockInMock-2)
+	currentLatestBlockInMock = mockChainFetcher.AdvanceBlock()
+	mockChainFetcher.newHeads <- currentLatestBlockInMock
+	select {
+	case forkBlock := <-forkBlocks:
+		require.Equal(t, currentLatestBlockInMock-3, forkBlock)
+	case <-time.After(SleepTime * SleepChunks * 10):
+		t.Fatalf("fork callback wasn't called for a pushed block")
+	}
+	waitForLatest(currentLatestBlockInMock)
+}
+
+func TestChainTrackerCrossCheckNodes(t *testing.T) {
+	mockBlocks := int64(100)
+	fetcherBlocks := 10
+	mockChainFetcher := &MockSamplerChainFetcher{MockChainFetcher: NewMockChainFetcher(1000, mockBlocks, nil), nodes: 3}
+	mockChainFetcher.AdvanceBlock()
+
+	blocksToFinalization := int64(3)
+	chainTrackerConfig := chaintracker.ChainTrackerConfig{BlocksToSave: uint64(fetcherBlocks), BlocksToFinalization: uint64(blocksToFinalization), AverageBlockTime: TimeForPollingMock, ServerBlockMemory: uint64(mockBlocks), CrossCheckNodes: true, WithholdOnDisagreement: true}
+	chainTracker, err := chaintracker.NewChainTracker(context.Background(), mockChainFetcher, chainTrackerConfig)
+	require.NoError(t, err)
+
+	waitForDisagreement := func(expected bool) {
+		for i := 0; i < SleepChunks*20; i++ {
+			if chainTracker.NodesDisagree() == expected {
+				break
+			}
+			time.Sleep(SleepTime)
+		}
+		require.Equal(t, expected, chainTracker.NodesDisagree())
+	}
+
+	// all nodes agree
+	time.Sleep(TimeForPollingMock * chaintracker.CrossCheckBlocksInterval * 2)
+	waitForDisagreement(false)
+	_, requestedHashes, _, err := chainTracker.GetLatestBlockData(spectypes.LATEST_BLOCK, spectypes.LATEST_BLOCK, spectypes.NOT_APPLICABLE)
+	require.NoError(t, err)
+	require.Len(t, requestedHashes, 1)
+	// the nodes are compared on a finalized block, not on the tip that changes on every shallow reorg
+	require.Equal(t, chainTracker.GetAtomicLatestBlockNum()-blocksToFinalization, mockChainFetcher.sampledBlock.Load())
+
+	// a single node on a minority fork is detected, and block hashes are withheld while the latest block is still served
+	mockChainFetcher.badNodes.Store(1, struct{}{})
+	waitForDisagreement(true)
+	latestBlock, requestedHashes, _, err := chainTracker.GetLatestBlockData(spectypes.LATEST_BLOCK, spectypes.LATEST_BLOCK, spectypes.NOT_APPLICABLE)
+	require.NoError(t, err)
+	require.Empty(t, requestedHashes)
+	require.Greater(t, latestBlock, int64(0))
+
+	// the majority disagreeing with the tracked hash is also detected
+	mockChainFetcher.badNodes.Store(2, struct{}{})
+	waitForDisagreement(true)
+
+	// once the nodes agree again finalization data is back
+	mockChainFetcher.badNodes.Delete(1)
+	mockChainFetcher.badNodes.Delete(2)
+	waitForDisagreement(false)
+	_, requestedHashes, _, err = chainTracker.GetLatestBlockData(spectypes.LATEST_BLOCK, spectypes.LATEST_BLOCK, spectypes.NOT_APPLICABLE)
+	require.NoError(t, err)
+	require.Len(t, requestedHashes, 1)
+}
+
 func TestChainTrackerFetchSpreadAcrossPollingTime(t *testing.T) {
 	t.Run("one long test", func(t *testing.T) {
 		mockBlocks := int64(50)
@@ -12,16 +12,20 @@ const (
 )
 
 type ChainTrackerConfig struct {
-	ForkCallback             func(block int64)                                 // a function to be called when a fork is detected
+	ForkCallback             func(block int64)                                 // a function to be called when a fork is detected, with the first block that was replaced
 	NewLatestCallback        func(blockFrom int64, blockTo int64, hash string) // a function to be called when a new block is detected
 	ConsistencyCallback      func(oldBlock int64, block int64)
 	OldBlockCallback         func(latestBlockTime time.Time)
 	ServerAddress            string // if not empty will open up a grpc server for that address
 	BlocksToSave             uint64
+	BlocksToFinalization     uint64        // the cross check compares the nodes' hashes at this depth, so shallow reorgs don't count as a disagreement
 	AverageBlockTime         time.Duration // how often to query latest block
 	ServerBlockMemory        uint64
 	BlocksCheckpointDistance uint64 // this causes the chainTracker to trigger it's checkpoint every X blocks
 	Pmetrics                 *metrics.ProviderMetricsManager
+	PushMode                 bool // if the chain fetcher supports it, new blocks are pushed by a node subscription and polling is kept as a fallback
+	CrossCheckNodes          bool // if the chain fetcher supports it, every node of the chain is sampled periodically and compared
+	WithholdOnDisagreement   bool // when cross checking, block hashes aren't returned while the nodes disagree, the latest block still is
 }
 
 func (cnf *ChainTrackerConfig) validate() error {
@@ -0,0 +1,145 @@
+package chaintracker
+
+import (
+	"context"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+)
+
+// NodeBlockSample is what a single node configured for the chain reported in a cross check
+type NodeBlockSample struct {
+	Node        string
+	LatestBlock int64
+	Hash        string // the node's hash for the cross checked block
+	Err         error
+}
+
+// NodesSampler is implemented by chain fetchers that can query every node configured for the chain separately
+type NodesSampler interface {
+	SampleNodes(ctx context.Context, blockNum int64) []NodeBlockSample
+}
+
+// NodeCrossCheck is the cross check result of a single node
+type NodeCrossCheck struct {
+	Node      string
+	BlockLag  int64 // blocks behind the most advanced node
+	Disagrees bool  // the node's hash is different than the majority hash
+}
+
+// CrossCheckResult is the consensus view of all the nodes sampled for a block
+type CrossCheckResult struct {
+	Block         int64
+	ConsensusHash string // empty if there is no majority
+	Nodes         []NodeCrossCheck
+	Disagreement  bool // the nodes disagree with each other or with the chain tracker's hash
+}
+
+// crossCheck computes the consensus of the nodes samples for a block the chain tracker holds with trackedHash.
+// nodes that failed answering are only measured for lag, they don't count towards the majority
+func crossCheck(block int64, trackedHash string, samples []NodeBlockSample) CrossCheckResult {
+	result := CrossCheckResult{Block: block}
+	hashVotes := map[string]int{}
+	maxLatestBlock := int64(0)
+	for _, sample := range samples {
+		if sample.Err != nil {
+			continue
+		}
+		if sample.LatestBlock > maxLatestBlock {
+			maxLatestBlock = sample.LatestBlock
+		}
+		if sample.Hash != "" {
+			hashVotes[sample.Hash]++
+		}
+	}
+	votes := 0
+	for hash, hashVotesCount := range hashVotes {
+		if hashVotesCount > votes {
+			result.ConsensusHash = hash
+			votes = hashVotesCount
+		} else if hashVotesCount == votes {
+			// a tie has no majority
+			result.ConsensusHash = ""
+		}
+	}
+	for _, sample := range samples {
+		nodeCrossCheck := NodeCrossCheck{Node: sample.Node}
+		if sample.Err == nil {
+			nodeCrossCheck.BlockLag = maxLatestBlock - sample.LatestBlock
+			nodeCrossCheck.Disagrees = sample.Hash != "" && sample.Hash != result.ConsensusHash
+		}
+		result.Disagreement = result.Disagreement || nodeCrossCheck.Disagrees
+		result.Nodes = append(result.Nodes, nodeCrossCheck)
+	}
+	if len(hashVotes) > 0 && trackedHash != result.ConsensusHash {
+		result.Disagreement = true
+	}
+	return result
+}
+
+// NodesDisagree returns true if the last cross check found the chain's nodes disagreeing
+func (cs *ChainTracker) NodesDisagree() bool {
+	return cs.nodesDisagree.Load()
+}
+
+func (cs *ChainTracker) runCrossCheck(ctx context.Context, sampler NodesSampler) {
+	cs.blockQueueMu.RLock()
+	if len(cs.blocksQueue) == 0 {
+		cs.blockQueueMu.RUnlock()
+		return
+	}
+	// finalization data is built from the tracked finalized blocks, so that is what the nodes need to agree on.
+	// the tip can differ between nodes on every shallow reorg, a finalized block can't
+	trackedBlock := cs.getFinalizedBlockUnsafe()
+	cs.blockQueueMu.RUnlock()
+
+	samples := sampler.SampleNodes(ctx, trackedBlock.Block)
+	if len(samples) < 2 {
+		// nothing to compare against
+		return
+	}
+	result := crossCheck(trackedBlock.Block, trackedBlock.Hash, samples)
+	for _, node := range result.Nodes {
+		cs.pmetrics.SetNodeCrossCheck(cs.endpoint.ChainID, node.Node, node.BlockLag, node.Disagrees)
+	}
+	previouslyDisagreed := cs.nodesDisagree.Swap(result.Disagreement)
+	if result.Disagreement && !previouslyDisagreed {
+		utils.LavaFormatWarning("chain tracker nodes disagree on block hash", nil,
+			utils.Attribute{Key: "block", Value: result.Block},
+			utils.Attribute{Key: "trackedHash", Value: trackedBlock.Hash},
+			utils.Attribute{Key: "consensusHash", Value: result.ConsensusHash},
+			utils.Attribute{Key: "nodes", Value: result.Nodes},
+			utils.Attribute{Key: "withholdingFinalization", Value: cs.withholdOnDisagreement},
+			utils.Attribute{Key: "ChainID", Value: cs.endpoint.ChainID},
+		)
+	} else if !result.Disagreement && previouslyDisagreed {
+		utils.LavaFormatInfo("chain tracker nodes agree on block hash again", utils.Attribute{Key: "block", Value: result.Block}, utils.Attribute{Key: "ChainID", Value: cs.endpoint.ChainID})
+	}
+}
+
+// getFinalizedBlockUnsafe returns the newest tracked block that is finalized, or the earliest tracked block if it isn't tracked.
+// blockQueueMu must be locked
+func (cs *ChainTracker) getFinalizedBlockUnsafe() BlockStore {
+	finalizedBlock := cs.getLatestBlockUnsafe().Block - int64(cs.blocksToFinalization)
+	for idx := len(cs.blocksQueue) - 1; idx >= 0; idx-- {
+		if cs.blocksQueue[idx].Block <= finalizedBlock {
+			return cs.blocksQueue[idx]
+		}
+	}
+	return cs.getEarliestBlockUnsafe()
+}
+
+func (cs *ChainTracker) crossCheckLoop(ctx context.Context, sampler NodesSampler, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			crossCheckCtx, cancel := context.WithTimeout(ctx, interval)
+			cs.runCrossCheck(crossCheckCtx, sampler)
+			cancel()
+		}
+	}
+}
@@ -29,6 +29,16 @@ const (
 	RelayHealthIntervalFlag         = "relays-health-interval" // interval between each relay health check, default 5m
 	SharedStateFlag                 = "shared-state"
 	DisableConflictTransactionsFlag = "disable-conflict-transactions" // disable conflict transactions, this will hard the network's data reliability and therefore will harm the service.
+	RelayStreamingFlag              = "relay-streaming"               // receive big replies from providers in chunks and stream them to the client as they arrive
+	RemoteSignerFlag                = "remote-signer"                 // unix socket of a remote signer, relays are signed by it instead of a key loaded from the keyring
+	SecureFlag                      = "secure"                        // send relays to several providers and answer only on a quorum of matching responses
+	SecureProvidersFlag             = "secure-providers"              // distinct providers every secure relay is sent to
+	SecureQuorumFlag                = "secure-quorum"                 // matching responses a secure relay needs, defaults to a majority of the providers
+	SecureIgnoredFieldsFlag         = "secure-ignored-fields"         // jsonpaths ignored when comparing the responses of secure relays
+	HedgeRelaysFlag                 = "hedge-relays"                  // send a relay to a second provider when the first one is slower than the hedge percentile
+	HedgePercentileFlag             = "hedge-percentile"              // latency percentile of relays with the same compute units after which a relay is hedged
+	HedgeMaxPerSecondFlag           = "hedge-max-per-second"          // hedged relays allowed every second
+	HedgeCuBudgetPercentageFlag     = "hedge-cu-budget-percentage"    // percentage of the epoch compute units hedged relays can spend
 )
 
 const (
@@ -42,15 +52,18 @@ const (
 
 // helper struct to propagate flags deeper into the code in an organized manner
 type ConsumerCmdFlags struct {
-	HeadersFlag                 string        // comma separated list of headers, or * for all, default simple cors specification headers
-	CredentialsFlag             string        // access-control-allow-credentials, defaults to "true"
-	OriginFlag                  string        // comma separated list of origins, or * for all, default enabled completely
-	MethodsFlag                 string        // whether to allow access control headers *, most proxies have their own access control so its not required
-	CDNCacheDuration            string        // how long to cache the preflight response defaults 24 hours (in seconds) "86400"
-	RelaysHealthEnableFlag      bool          // enables relay health check
-	RelaysHealthIntervalFlag    time.Duration // interval for relay health check
-	DebugRelays                 bool          // enables debug mode for relays
-	DisableConflictTransactions bool          // disable conflict transactions
+	HeadersFlag                 string            // comma separated list of headers, or * for all, default simple cors specification headers
+	CredentialsFlag             string            // access-control-allow-credentials, defaults to "true"
+	OriginFlag                  string            // comma separated list of origins, or * for all, default enabled completely
+	MethodsFlag                 string            // whether to allow access control headers *, most proxies have their own access control so its not required
+	CDNCacheDuration            string            // how long to cache the preflight response defaults 24 hours (in seconds) "86400"
+	RelaysHealthEnableFlag      bool              // enables relay health check
+	RelaysHealthIntervalFlag    time.Duration     // interval for relay health check
+	DebugRelays                 bool              // enables debug mode for relays
+	DisableConflictTransactions bool              // disable conflict transactions
+	RelayStreaming              bool              // use streamed relays with providers that support them
+	SecureRelay                 SecureRelayConfig // default secure relay config, endpoints can override it per api
+	Hedge                       HedgeConfig       // sending slow relays to a second provider
 }
 
 // default rolling logs behavior (if enabled) will store 3 files each 100MB for up to 1 day every time.
@@ -22,7 +22,9 @@ const (
 	IP_FORWARDING_HEADER_NAME                       = "X-Forwarded-For"
 	PROVIDER_ADDRESS_HEADER_NAME                    = "Lava-Provider-Address"
 	RETRY_COUNT_HEADER_NAME                         = "Lava-Retries"
+	HEDGE_COUNT_HEADER_NAME                         = "Lava-Hedges"
 	PROVIDER_LATEST_BLOCK_HEADER_NAME               = "Provider-Latest-Block"
+	PROVIDER_BLOCK_HASH_HEADER_NAME                 = "Provider-Block-Hash" // the hash of a non finalized requested block, used to tag cache entries
 	GUID_HEADER_NAME                                = "Lava-Guid"
 	// these headers need to be lowercase
 	BLOCK_PROVIDERS_ADDRESSES_HEADER_NAME = "lava-providers-block"
@@ -207,10 +209,12 @@ type RelayResult struct {
 	Reply           *pairingtypes.RelayReply
 	ProviderInfo    ProviderInfo
 	ReplyServer     *pairingtypes.Relayer_RelaySubscribeClient
+	ReplyStream     *RelayReplyStream // set when the reply data is streamed in chunks and written as they arrive
 	Finalized       bool
 	ConflictHandler ConflictHandlerInterface
 	StatusCode      int
 	Quorum          int
+	BlockHash       []byte // the hash of the non finalized requested block, from the provider's signed reply
 }
 
 func (rr *RelayResult) GetReplyServer() *pairingtypes.Relayer_RelaySubscribeClient {
@@ -220,6 +224,13 @@ func (rr *RelayResult) GetReplyServer() *pairingtypes.Relayer_RelaySubscribeClie
 	return rr.ReplyServer
 }
 
+func (rr *RelayResult) GetReplyStream() *RelayReplyStream {
+	if rr == nil {
+		return nil
+	}
+	return rr.ReplyStream
+}
+
 func (rr *RelayResult) GetReply() *pairingtypes.RelayReply {
 	if rr == nil {
 		return nil
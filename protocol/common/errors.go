@@ -8,4 +8,5 @@ var (
 	StatusCodeError429           = sdkerrors.New("Disallowed StatusCode Error", 429, "Disallowed status code error")
 	StatusCodeErrorStrict        = sdkerrors.New("Disallowed StatusCode Error", 800, "Disallowed status code error")
 	APINotSupportedError         = sdkerrors.New("APINotSupported Error", 900, "api not supported")
+	NodeUnreachableError         = sdkerrors.New("NodeUnreachable Error", 901, "could not connect to the node, the request was not sent")
 )
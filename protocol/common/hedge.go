@@ -0,0 +1,30 @@
+package common
+
+import "fmt"
+
+const (
+	DefaultHedgePercentile         = 0.95
+	DefaultHedgeMaxPerSecond       = 20
+	DefaultHedgeCuBudgetPercentage = 10
+)
+
+// HedgeConfig makes the consumer send a relay to a second provider when the first one is slower than most relays
+type HedgeConfig struct {
+	Enabled            bool
+	Percentile         float64 // latency percentile of relays with the same compute units after which a relay is hedged
+	MaxPerSecond       uint    // hedged relays allowed every second
+	CuBudgetPercentage float64 // percentage of the epoch compute units of the subscription hedged relays can spend
+}
+
+func (hc HedgeConfig) Validate() error {
+	if !hc.Enabled {
+		return nil
+	}
+	if hc.Percentile <= 0 || hc.Percentile >= 1 {
+		return fmt.Errorf("hedge percentile must be between 0 and 1, got %f", hc.Percentile)
+	}
+	if hc.CuBudgetPercentage <= 0 || hc.CuBudgetPercentage > 100 {
+		return fmt.Errorf("hedge cu budget percentage must be between 0 and 100, got %f", hc.CuBudgetPercentage)
+	}
+	return nil
+}
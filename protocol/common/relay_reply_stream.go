@@ -0,0 +1,104 @@
+package common
+
+import (
+	"io"
+	"sync"
+)
+
+// RelayReplyStream holds the chunks of a relay reply that is streamed from a provider.
+// chunks are appended by the relay routine without blocking, and written by the listener as they arrive
+type RelayReplyStream struct {
+	lock   sync.Mutex
+	cond   *sync.Cond
+	chunks [][]byte
+	closed bool
+	err    error
+}
+
+func NewRelayReplyStream() *RelayReplyStream {
+	rrs := &RelayReplyStream{}
+	rrs.cond = sync.NewCond(&rrs.lock)
+	return rrs
+}
+
+// Append adds a verified chunk to the stream
+func (rrs *RelayReplyStream) Append(data []byte) {
+	rrs.lock.Lock()
+	defer rrs.lock.Unlock()
+	if rrs.closed {
+		return
+	}
+	rrs.chunks = append(rrs.chunks, data)
+	rrs.cond.Broadcast()
+}
+
+// Close ends the stream, a non nil error means the reply failed verification after it started streaming
+func (rrs *RelayReplyStream) Close(err error) {
+	rrs.lock.Lock()
+	defer rrs.lock.Unlock()
+	if rrs.closed {
+		return
+	}
+	rrs.closed = true
+	rrs.err = err
+	rrs.cond.Broadcast()
+}
+
+// WriteTo writes the chunks to w as they arrive, until the stream is closed
+func (rrs *RelayReplyStream) WriteTo(w io.Writer) (written int64, err error) {
+	for idx := 0; ; idx++ {
+		rrs.lock.Lock()
+		for idx >= len(rrs.chunks) && !rrs.closed {
+			rrs.cond.Wait()
+		}
+		if idx >= len(rrs.chunks) {
+			err = rrs.err
+			rrs.lock.Unlock()
+			return written, err
+		}
+		chunk := rrs.chunks[idx]
+		rrs.lock.Unlock()
+		n, err := w.Write(chunk)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+}
+
+// NewReader returns a reader of the chunks as they arrive, reading fails with the stream's error instead of ending
+// so an http response that is already streamed to the client is aborted rather than completed with a truncated body
+func (rrs *RelayReplyStream) NewReader() io.Reader {
+	return &relayReplyStreamReader{stream: rrs}
+}
+
+type relayReplyStreamReader struct {
+	stream *RelayReplyStream
+	idx    int
+	offset int
+}
+
+func (rr *relayReplyStreamReader) Read(p []byte) (int, error) {
+	rrs := rr.stream
+	rrs.lock.Lock()
+	for rr.idx >= len(rrs.chunks) && !rrs.closed {
+		rrs.cond.Wait()
+	}
+	if rr.idx >= len(rrs.chunks) {
+		err := rrs.err
+		rrs.lock.Unlock()
+		if err == nil {
+			err = io.EOF
+		}
+		return 0, err
+	}
+	chunk := rrs.chunks[rr.idx]
+	rrs.lock.Unlock()
+	n := copy(p, chunk[rr.offset:])
+	rr.offset += n
+	if rr.offset >= len(chunk) {
+		rr.idx++
+		rr.offset = 0
+	}
+	return n, nil
+}
@@ -0,0 +1,68 @@
+package common
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayReplyStream(t *testing.T) {
+	rrs := NewRelayReplyStream()
+	done := make(chan struct{})
+	var buf bytes.Buffer
+	var err error
+	go func() {
+		_, err = rrs.WriteTo(&buf)
+		close(done)
+	}()
+	rrs.Append([]byte("hello "))
+	rrs.Append([]byte("world"))
+	select {
+	case <-done:
+		t.Fatal("WriteTo returned before the stream was closed")
+	case <-time.After(10 * time.Millisecond):
+	}
+	rrs.Close(nil)
+	<-done
+	require.NoError(t, err)
+	require.Equal(t, "hello world", buf.String())
+
+	// chunks appended after close are dropped
+	rrs.Append([]byte("!"))
+	buf.Reset()
+	_, err = rrs.WriteTo(&buf)
+	require.NoError(t, err)
+	require.Equal(t, "hello world", buf.String())
+}
+
+func TestRelayReplyStreamError(t *testing.T) {
+	rrs := NewRelayReplyStream()
+	rrs.Append([]byte("partial"))
+	rrs.Close(fmt.Errorf("verification failed"))
+	var buf bytes.Buffer
+	_, err := rrs.WriteTo(&buf)
+	require.Error(t, err)
+	require.Equal(t, "partial", buf.String())
+}
+
+func TestRelayReplyStreamReader(t *testing.T) {
+	rrs := NewRelayReplyStream()
+	rrs.Append([]byte("hello "))
+	rrs.Append([]byte("world"))
+	rrs.Close(nil)
+	data, err := io.ReadAll(rrs.NewReader())
+	require.NoError(t, err)
+	require.Equal(t, "hello world", string(data))
+
+	// a failed stream is not read to a clean end
+	rrs = NewRelayReplyStream()
+	rrs.Append([]byte("partial"))
+	rrs.Close(fmt.Errorf("verification failed"))
+	data, err = io.ReadAll(rrs.NewReader())
+	require.Error(t, err)
+	require.Equal(t, "partial", string(data))
+}
@@ -0,0 +1,62 @@
+package common
+
+import (
+	"fmt"
+
+	"github.com/lavanet/lava/utils/jsonpath"
+)
+
+const (
+	DefaultSecureProviders = 3
+)
+
+// SecureRelayConfig makes the consumer send a relay to several distinct providers,
+// and answer only when a quorum of them returned matching responses
+type SecureRelayConfig struct {
+	Api           string   `yaml:"api,omitempty" json:"api,omitempty" mapstructure:"api"`                                  // api name the config applies to, empty for the consumer default
+	Providers     int      `yaml:"providers,omitempty" json:"providers,omitempty" mapstructure:"providers"`                // distinct providers every relay is sent to, 1 disables secure relays
+	Quorum        int      `yaml:"quorum,omitempty" json:"quorum,omitempty" mapstructure:"quorum"`                         // matching responses needed to answer, defaults to a majority of providers
+	IgnoredFields []string `yaml:"ignored-fields,omitempty" json:"ignored-fields,omitempty" mapstructure:"ignored-fields"` // jsonpaths removed from responses before they are compared
+}
+
+func (src SecureRelayConfig) Enabled() bool {
+	return src.Providers > 1
+}
+
+// RequiredQuorum returns the amount of matching responses needed to answer the client
+func (src SecureRelayConfig) RequiredQuorum() int {
+	if !src.Enabled() {
+		return 1
+	}
+	if src.Quorum > 0 {
+		return src.Quorum
+	}
+	return src.Providers/2 + 1
+}
+
+func (src SecureRelayConfig) Validate() error {
+	if src.Providers < 0 || src.Quorum < 0 {
+		return fmt.Errorf("secure relay config of %q can't have negative providers %d or quorum %d", src.Api, src.Providers, src.Quorum)
+	}
+	if src.Enabled() && src.Quorum > src.Providers {
+		return fmt.Errorf("secure relay config of %q has a quorum %d larger than its providers %d", src.Api, src.Quorum, src.Providers)
+	}
+	// a quorum that isn't a majority lets two disagreeing groups of providers both reach it
+	if src.Enabled() && src.Quorum > 0 && src.Quorum <= src.Providers/2 {
+		return fmt.Errorf("secure relay config of %q has a quorum %d that isn't a majority of its providers %d", src.Api, src.Quorum, src.Providers)
+	}
+	_, err := src.IgnoredPaths()
+	return err
+}
+
+func (src SecureRelayConfig) IgnoredPaths() ([]jsonpath.Path, error) {
+	paths := make([]jsonpath.Path, 0, len(src.IgnoredFields))
+	for _, field := range src.IgnoredFields {
+		path, err := jsonpath.Compile(field)
+		if err != nil {
+			return nil, fmt.Errorf("secure relay config of %q has an invalid ignored field: %w", src.Api, err)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
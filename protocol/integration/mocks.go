@@ -44,6 +44,10 @@ func (m *mockConsumerStateTracker) TxConflictDetection(ctx context.Context, fina
 	return nil
 }
 
+func (m *mockConsumerStateTracker) TxLatestBlockConflictDetection(ctx context.Context, latestBlockConflict *conflicttypes.LatestBlockConflict, conflictHandler common.ConflictHandlerInterface) error {
+	return nil
+}
+
 func (m *mockConsumerStateTracker) GetConsumerPolicy(ctx context.Context, consumerAddress, chainID string) (*plantypes.Policy, error) {
 	return &plantypes.Policy{
 		ChainPolicies:         []plantypes.ChainPolicy{},
@@ -75,7 +79,7 @@ type mockProviderStateTracker struct {
 	averageBlockTime          time.Duration
 }
 
-func (m *mockProviderStateTracker) RegisterForVersionUpdates(ctx context.Context, version *protocoltypes.Version, versionValidator updaters.VersionValidationInf) {
+func (m *mockProviderStateTracker) RegisterForVersionUpdates(ctx context.Context, version *protocoltypes.Version, versionValidator updaters.VersionValidationInf, chainIDs ...string) {
 }
 
 func (m *mockProviderStateTracker) RegisterForSpecUpdates(ctx context.Context, specUpdatable updaters.SpecUpdatable, endpoint lavasession.RPCEndpoint) error {
@@ -139,7 +143,7 @@ func (m *mockProviderStateTracker) GetEpochSizeMultipliedByRecommendedEpochNumTo
 	return 30000, nil
 }
 
-func (m *mockProviderStateTracker) GetProtocolVersion(ctx context.Context) (*updaters.ProtocolVersionResponse, error) {
+func (m *mockProviderStateTracker) GetProtocolVersion(ctx context.Context, chainIDs ...string) (*updaters.ProtocolVersionResponse, error) {
 	return &updaters.ProtocolVersionResponse{
 		Version:     &protocoltypes.Version{},
 		BlockNumber: "",
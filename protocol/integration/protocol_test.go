@@ -7,6 +7,8 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -130,7 +132,7 @@ func createInMemoryRewardDb(specs []string) (*rewardserver.RewardDB, error) {
 	return rewardDB, nil
 }
 
-func createRpcConsumer(t *testing.T, ctx context.Context, specId string, apiInterface string, account sigs.Account, consumerListenAddress string, epoch uint64, pairingList map[uint64]*lavasession.ConsumerSessionsWithProvider, requiredResponses int, lavaChainID string) *rpcconsumer.RPCConsumerServer {
+func createRpcConsumer(t *testing.T, ctx context.Context, specId string, apiInterface string, account sigs.Account, consumerListenAddress string, epoch uint64, pairingList map[uint64]*lavasession.ConsumerSessionsWithProvider, requiredResponses int, lavaChainID string, consumerCmdFlags common.ConsumerCmdFlags) *rpcconsumer.RPCConsumerServer {
 	serverHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Handle the incoming request and provide the desired response
 		w.WriteHeader(http.StatusOK)
@@ -158,10 +160,9 @@ func createRpcConsumer(t *testing.T, ctx context.Context, specId string, apiInte
 	consumerSessionManager.UpdateAllProviders(epoch, pairingList)
 
 	consumerConsistency := rpcconsumer.NewConsumerConsistency(specId)
-	consumerCmdFlags := common.ConsumerCmdFlags{}
 	rpcsonumerLogs, err := metrics.NewRPCConsumerLogs(nil, nil)
 	require.NoError(t, err)
-	err = rpcConsumerServer.ServeRPCRequests(ctx, rpcEndpoint, consumerStateTracker, chainParser, finalizationConsensus, consumerSessionManager, requiredResponses, account.SK, lavaChainID, nil, rpcsonumerLogs, account.Addr, consumerConsistency, nil, consumerCmdFlags, false, nil, nil)
+	err = rpcConsumerServer.ServeRPCRequests(ctx, rpcEndpoint, consumerStateTracker, chainParser, finalizationConsensus, consumerSessionManager, requiredResponses, sigs.NewPrivateKeySigner(account.SK), lavaChainID, nil, rpcsonumerLogs, account.Addr, consumerConsistency, nil, consumerCmdFlags, false, nil, nil)
 	require.NoError(t, err)
 	// wait for consumer server to be up
 	consumerUp := checkServerStatusWithTimeout("http://"+consumerListenAddress, time.Millisecond*61)
@@ -244,7 +245,7 @@ func createRpcProvider(t *testing.T, ctx context.Context, consumerAddress string
 	chainTracker, err := chaintracker.NewChainTracker(ctx, mockChainFetcher, chainTrackerConfig)
 	require.NoError(t, err)
 	reliabilityManager := reliabilitymanager.NewReliabilityManager(chainTracker, &mockProviderStateTracker, account.Addr.String(), chainRouter, chainParser)
-	rpcProviderServer.ServeRPCRequests(ctx, rpcProviderEndpoint, chainParser, rws, providerSessionManager, reliabilityManager, account.SK, nil, chainRouter, &mockProviderStateTracker, account.Addr, lavaChainID, rpcprovider.DEFAULT_ALLOWED_MISSING_CU, nil, nil)
+	rpcProviderServer.ServeRPCRequests(ctx, rpcProviderEndpoint, chainParser, rws, providerSessionManager, reliabilityManager, sigs.NewPrivateKeySigner(account.SK), nil, chainRouter, &mockProviderStateTracker, account.Addr, lavaChainID, rpcprovider.DEFAULT_ALLOWED_MISSING_CU, nil, nil)
 	listener := rpcprovider.NewProviderListener(ctx, rpcProviderEndpoint.NetworkAddress, "/health")
 	err = listener.RegisterReceiver(rpcProviderServer, rpcProviderEndpoint)
 	require.NoError(t, err)
@@ -306,7 +307,7 @@ func TestConsumerProviderBasic(t *testing.T) {
 			PairingEpoch:     epoch,
 		}
 	}
-	rpcconsumerServer := createRpcConsumer(t, ctx, specId, apiInterface, consumerAccount, consumerListenAddress, epoch, pairingList, requiredResponses, lavaChainID)
+	rpcconsumerServer := createRpcConsumer(t, ctx, specId, apiInterface, consumerAccount, consumerListenAddress, epoch, pairingList, requiredResponses, lavaChainID, common.ConsumerCmdFlags{})
 	require.NotNil(t, rpcconsumerServer)
 	client := http.Client{}
 	resp, err := client.Get("http://" + consumerListenAddress + "/status")
@@ -318,6 +319,63 @@ func TestConsumerProviderBasic(t *testing.T) {
 	resp.Body.Close()
 }
 
+func TestConsumerProviderStreamedReply(t *testing.T) {
+	ctx := context.Background()
+	specId := "LAV1"
+	apiInterface := spectypes.APIInterfaceTendermintRPC
+	epoch := uint64(100)
+	requiredResponses := 1
+	lavaChainID := "lava"
+
+	originalChunkSize := rpcprovider.RelayStreamChunkSize
+	rpcprovider.RelayStreamChunkSize = 16
+	defer func() { rpcprovider.RelayStreamChunkSize = originalChunkSize }()
+
+	consumerListenAddress := addressGen.GetAddress()
+	providerAccount := sigs.GenerateDeterministicFloatingKey(randomizer)
+	consumerAccount := sigs.GenerateDeterministicFloatingKey(randomizer)
+	_, endpoint, replySetter, _ := createRpcProvider(t, ctx, consumerAccount.Addr.String(), specId, apiInterface, addressGen.GetAddress(), providerAccount, lavaChainID, []string(nil))
+	replySetter.replyDataBuf = []byte(fmt.Sprintf(`{"reply": "%s"}`, strings.Repeat("STREAMED-REPLY-", 10)))
+	pairingList := map[uint64]*lavasession.ConsumerSessionsWithProvider{
+		0: {
+			PublicLavaAddress: providerAccount.Addr.String(),
+			Endpoints: []*lavasession.Endpoint{
+				{
+					NetworkAddress: endpoint.NetworkAddress.Address,
+					Enabled:        true,
+					Geolocation:    1,
+				},
+			},
+			Sessions:         map[int64]*lavasession.SingleConsumerSession{},
+			MaxComputeUnits:  10000,
+			UsedComputeUnits: 0,
+			PairingEpoch:     epoch,
+		},
+	}
+	rpcconsumerServer := createRpcConsumer(t, ctx, specId, apiInterface, consumerAccount, consumerListenAddress, epoch, pairingList, requiredResponses, lavaChainID, common.ConsumerCmdFlags{RelayStreaming: true})
+	require.NotNil(t, rpcconsumerServer)
+	client := http.Client{}
+	for i := 0; i < 3; i++ {
+		resp, err := client.Get("http://" + consumerListenAddress + "/status")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		bodyBytes, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.Equal(t, replySetter.replyDataBuf, bodyBytes)
+		resp.Body.Close()
+	}
+
+	// the node's status is sent with the first chunk, so the client gets it before the streamed reply
+	replySetter.status = http.StatusAccepted
+	resp, err := client.Get("http://" + consumerListenAddress + "/status")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	bodyBytes, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, replySetter.replyDataBuf, bodyBytes)
+	resp.Body.Close()
+}
+
 func TestConsumerProviderWithProviders(t *testing.T) {
 	playbook := []struct {
 		name     string
@@ -384,7 +442,7 @@ func TestConsumerProviderWithProviders(t *testing.T) {
 					PairingEpoch:     epoch,
 				}
 			}
-			rpcconsumerServer := createRpcConsumer(t, ctx, specId, apiInterface, consumerAccount, consumerListenAddress, epoch, pairingList, requiredResponses, lavaChainID)
+			rpcconsumerServer := createRpcConsumer(t, ctx, specId, apiInterface, consumerAccount, consumerListenAddress, epoch, pairingList, requiredResponses, lavaChainID, common.ConsumerCmdFlags{})
 			require.NotNil(t, rpcconsumerServer)
 			if play.scenario != 1 {
 				counter := map[int]int{}
@@ -459,6 +517,156 @@ func TestConsumerProviderWithProviders(t *testing.T) {
 	}
 }
 
+func TestConsumerProviderSecureRelay(t *testing.T) {
+	playbook := []struct {
+		name    string
+		config  common.SecureRelayConfig
+		success bool
+	}{
+		{
+			name:    "majority",
+			config:  common.SecureRelayConfig{Providers: 3},
+			success: true,
+		},
+		{
+			name:    "all must agree",
+			config:  common.SecureRelayConfig{Providers: 3, Quorum: 3},
+			success: false,
+		},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			ctx := context.Background()
+			specId := "LAV1"
+			apiInterface := spectypes.APIInterfaceTendermintRPC
+			epoch := uint64(100)
+			lavaChainID := "lava"
+			numProviders := 3
+
+			consumerListenAddress := addressGen.GetAddress()
+			pairingList := map[uint64]*lavasession.ConsumerSessionsWithProvider{}
+			consumerAccount := sigs.GenerateDeterministicFloatingKey(randomizer)
+			for i := 0; i < numProviders; i++ {
+				account := sigs.GenerateDeterministicFloatingKey(randomizer)
+				_, endpoint, replySetter, _ := createRpcProvider(t, ctx, consumerAccount.Addr.String(), specId, apiInterface, addressGen.GetAddress(), account, lavaChainID, []string(nil))
+				replySetter.replyDataBuf = []byte(`{"balance": 100}`)
+				if i == numProviders-1 {
+					// a lying provider
+					replySetter.replyDataBuf = []byte(`{"balance": 999}`)
+				}
+				pairingList[uint64(i)] = &lavasession.ConsumerSessionsWithProvider{
+					PublicLavaAddress: account.Addr.String(),
+					Endpoints: []*lavasession.Endpoint{
+						{
+							NetworkAddress: endpoint.NetworkAddress.Address,
+							Enabled:        true,
+							Geolocation:    1,
+						},
+					},
+					Sessions:         map[int64]*lavasession.SingleConsumerSession{},
+					MaxComputeUnits:  10000,
+					UsedComputeUnits: 0,
+					PairingEpoch:     epoch,
+				}
+			}
+			rpcconsumerServer := createRpcConsumer(t, ctx, specId, apiInterface, consumerAccount, consumerListenAddress, epoch, pairingList, 1, lavaChainID, common.ConsumerCmdFlags{SecureRelay: play.config})
+			require.NotNil(t, rpcconsumerServer)
+			for i := 0; i < 10; i++ {
+				client := http.Client{Timeout: 2 * time.Second}
+				resp, err := client.Get("http://" + consumerListenAddress + "/status")
+				require.NoError(t, err)
+				bodyBytes, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				resp.Body.Close()
+				if !play.success {
+					require.NotEqual(t, http.StatusOK, resp.StatusCode, string(bodyBytes))
+					continue
+				}
+				require.Equal(t, http.StatusOK, resp.StatusCode, string(bodyBytes))
+				require.Equal(t, `{"balance": 100}`, string(bodyBytes))
+			}
+		})
+	}
+}
+
+func TestConsumerProviderHedgedRelay(t *testing.T) {
+	ctx := context.Background()
+	specId := "LAV1"
+	apiInterface := spectypes.APIInterfaceTendermintRPC
+	epoch := uint64(100)
+	lavaChainID := "lava"
+	numProviders := 2
+
+	consumerListenAddress := addressGen.GetAddress()
+	pairingList := map[uint64]*lavasession.ConsumerSessionsWithProvider{}
+	consumerAccount := sigs.GenerateDeterministicFloatingKey(randomizer)
+	slowNextRelay := atomic.Bool{}
+	for i := 0; i < numProviders; i++ {
+		account := sigs.GenerateDeterministicFloatingKey(randomizer)
+		_, endpoint, replySetter, _ := createRpcProvider(t, ctx, consumerAccount.Addr.String(), specId, apiInterface, addressGen.GetAddress(), account, lavaChainID, []string(nil))
+		replyData := []byte(fmt.Sprintf(`{"reply": %d}`, i))
+		replySetter.handler = func(req []byte, header http.Header) (data []byte, status int) {
+			// the provider's own chain tracker queries are jsonrpc posts, only the consumer's uri relays are delayed
+			if len(req) == 0 {
+				if slowNextRelay.CompareAndSwap(true, false) {
+					// only the provider that got the relay first is slow
+					time.Sleep(time.Second)
+				} else {
+					// a steady latency keeps the hedge deadline well above the scheduling jitter
+					time.Sleep(50 * time.Millisecond)
+				}
+			}
+			return replyData, http.StatusOK
+		}
+		pairingList[uint64(i)] = &lavasession.ConsumerSessionsWithProvider{
+			PublicLavaAddress: account.Addr.String(),
+			Endpoints: []*lavasession.Endpoint{
+				{
+					NetworkAddress: endpoint.NetworkAddress.Address,
+					Enabled:        true,
+					Geolocation:    1,
+				},
+			},
+			Sessions:         map[int64]*lavasession.SingleConsumerSession{},
+			MaxComputeUnits:  10000,
+			UsedComputeUnits: 0,
+			PairingEpoch:     epoch,
+		}
+	}
+	hedge := common.HedgeConfig{Enabled: true, Percentile: 0.9, MaxPerSecond: 10, CuBudgetPercentage: 10}
+	rpcconsumerServer := createRpcConsumer(t, ctx, specId, apiInterface, consumerAccount, consumerListenAddress, epoch, pairingList, 1, lavaChainID, common.ConsumerCmdFlags{Hedge: hedge})
+	require.NotNil(t, rpcconsumerServer)
+	sendRelay := func() *http.Response {
+		client := http.Client{Timeout: 2 * time.Second}
+		resp, err := client.Get("http://" + consumerListenAddress + "/status")
+		require.NoError(t, err)
+		_, err = io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		return resp
+	}
+	// relays are hedged only once the consumer knows their latency percentiles
+	for i := 0; i < provideroptimizer.MinLatencySamplesForStat+5; i++ {
+		sendRelay()
+	}
+
+	// the optimizer sometimes explores by sending a relay to both providers at once, such a relay is never hedged so it is sent again
+	for attempt := 0; attempt < 3; attempt++ {
+		// let the previous slow reply and the data reliability relays reach the nodes, so the slow reply is the next relay's
+		time.Sleep(time.Second)
+		slowNextRelay.Store(true)
+		start := time.Now()
+		resp := sendRelay()
+		require.Less(t, time.Since(start), time.Second)
+		require.Empty(t, resp.Header.Get(common.RETRY_COUNT_HEADER_NAME)) // the canceled relay is not a retry
+		if resp.Header.Get(common.HEDGE_COUNT_HEADER_NAME) == "1" {
+			return
+		}
+	}
+	require.Fail(t, "the slow relay was never hedged")
+}
+
 func TestConsumerProviderTx(t *testing.T) {
 	playbook := []struct {
 		name string
@@ -519,7 +727,7 @@ func TestConsumerProviderTx(t *testing.T) {
 					PairingEpoch:     epoch,
 				}
 			}
-			rpcconsumerServer := createRpcConsumer(t, ctx, specId, apiInterface, consumerAccount, consumerListenAddress, epoch, pairingList, requiredResponses, lavaChainID)
+			rpcconsumerServer := createRpcConsumer(t, ctx, specId, apiInterface, consumerAccount, consumerListenAddress, epoch, pairingList, requiredResponses, lavaChainID, common.ConsumerCmdFlags{})
 			require.NotNil(t, rpcconsumerServer)
 
 			for i := 0; i < numProviders; i++ {
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"sort"
 
-	btcSecp256k1 "github.com/btcsuite/btcd/btcec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/lavaslices"
@@ -15,13 +14,13 @@ import (
 	spectypes "github.com/lavanet/lava/x/spec/types"
 )
 
-func SignRelayResponse(consumerAddress sdk.AccAddress, request pairingtypes.RelayRequest, pkey *btcSecp256k1.PrivateKey, reply *pairingtypes.RelayReply, signDataReliability bool) (*pairingtypes.RelayReply, error) {
+func SignRelayResponse(consumerAddress sdk.AccAddress, request pairingtypes.RelayRequest, signer sigs.Signer, reply *pairingtypes.RelayReply, signDataReliability bool) (*pairingtypes.RelayReply, error) {
 	// request is a copy of the original request, but won't modify it
 	// update relay request requestedBlock to the provided one in case it was arbitrary
 	UpdateRequestedBlock(request.RelayData, reply)
 	// Update signature,
 	relayExchange := pairingtypes.NewRelayExchange(request, *reply)
-	sig, err := sigs.Sign(pkey, relayExchange)
+	sig, err := signer.Sign(relayExchange)
 	if err != nil {
 		return nil, utils.LavaFormatError("failed signing relay response", err,
 			utils.Attribute{Key: "request", Value: request}, utils.Attribute{Key: "reply", Value: reply})
@@ -31,7 +30,7 @@ func SignRelayResponse(consumerAddress sdk.AccAddress, request pairingtypes.Rela
 	if signDataReliability {
 		// update sig blocks signature
 		relayFinalization := pairingtypes.NewRelayFinalization(pairingtypes.NewRelayExchange(request, *reply), consumerAddress)
-		sigBlocks, err := sigs.Sign(pkey, relayFinalization)
+		sigBlocks, err := signer.Sign(relayFinalization)
 		if err != nil {
 			return nil, utils.LavaFormatError("failed signing finalization data", err,
 				utils.Attribute{Key: "request", Value: request}, utils.Attribute{Key: "reply", Value: reply}, utils.Attribute{Key: "userAddr", Value: consumerAddress})
@@ -58,6 +57,34 @@ func VerifyRelayReply(ctx context.Context, reply *pairingtypes.RelayReply, relay
 	return nil
 }
 
+// SignRelayChunks splits the reply data to chunks of at most chunkSize bytes and signs each one of them,
+// so a consumer can verify the chunks as they arrive, before the complete reply is received
+func SignRelayChunks(request pairingtypes.RelayRequest, signer sigs.Signer, data []byte, chunkSize int) ([]*pairingtypes.RelayChunk, error) {
+	chunks := pairingtypes.SplitToChunks(data, chunkSize)
+	for _, chunk := range chunks {
+		sig, err := signer.Sign(pairingtypes.NewRelayChunkExchange(request, *chunk))
+		if err != nil {
+			return nil, utils.LavaFormatError("failed signing relay chunk", err, utils.Attribute{Key: "chunkIndex", Value: chunk.Index})
+		}
+		chunk.Sig = sig
+	}
+	return chunks, nil
+}
+
+func VerifyRelayChunk(ctx context.Context, chunk *pairingtypes.RelayChunk, expectedIndex uint64, relayRequest *pairingtypes.RelayRequest, addr string) error {
+	if chunk.Index != expectedIndex {
+		return utils.LavaFormatError("relay chunk arrived out of order", ProviderChunkedReplyError, utils.LogAttr("GUID", ctx), utils.Attribute{Key: "chunkIndex", Value: chunk.Index}, utils.Attribute{Key: "expectedIndex", Value: expectedIndex})
+	}
+	serverAddr, err := sigs.ExtractSignerAddress(pairingtypes.NewRelayChunkExchange(*relayRequest, *chunk))
+	if err != nil {
+		return err
+	}
+	if serverAddr.String() != addr {
+		return utils.LavaFormatError("relay chunk server address mismatch", ProviderChunkedReplyError, utils.LogAttr("GUID", ctx), utils.Attribute{Key: "parsed Address", Value: serverAddr.String()}, utils.Attribute{Key: "expected address", Value: addr}, utils.Attribute{Key: "chunkIndex", Value: chunk.Index})
+	}
+	return nil
+}
+
 func VerifyFinalizationData(reply *pairingtypes.RelayReply, relayRequest *pairingtypes.RelayRequest, providerAddr string, consumerAcc sdk.AccAddress, latestSessionBlock int64, blockDistanceForfinalization uint32) (finalizedBlocks map[int64]string, finalizationConflict *conflicttypes.FinalizationConflict, errRet error) {
 	relayFinalization := pairingtypes.NewRelayFinalization(pairingtypes.NewRelayExchange(*relayRequest, *reply), consumerAcc)
 	serverKey, err := sigs.RecoverPubKey(relayFinalization)
@@ -143,3 +170,44 @@ func verifyFinalizationDataIntegrity(reply *pairingtypes.RelayReply, latestSessi
 
 	return finalizationConflict, nil
 }
+
+// ConstructFinalizationProof keeps the provider's signed finalization data of a verified reply, so a later reply of the same session
+// can be proven to contradict it. the request is copied since it is modified after the relay
+func ConstructFinalizationProof(relayRequest *pairingtypes.RelayRequest, reply *pairingtypes.RelayReply) *conflicttypes.ConflictRelayData {
+	requestBytes, err := relayRequest.Marshal()
+	if err != nil {
+		return nil
+	}
+	requestCopy := &pairingtypes.RelayRequest{}
+	err = requestCopy.Unmarshal(requestBytes)
+	if err != nil {
+		return nil
+	}
+	return &conflicttypes.ConflictRelayData{
+		Request: requestCopy,
+		Reply: &conflicttypes.ReplyMetadata{
+			LatestBlock:           reply.LatestBlock,
+			FinalizedBlocksHashes: reply.FinalizedBlocksHashes,
+			SigBlocks:             reply.SigBlocks,
+		},
+	}
+}
+
+// FindLatestBlockConflict returns a proof that the current relay contradicts the previous relay of the same provider session, or nil if it doesn't
+func FindLatestBlockConflict(previousRelay, currentRelay *conflicttypes.ConflictRelayData) *conflicttypes.LatestBlockConflict {
+	if previousRelay == nil || currentRelay == nil {
+		return nil
+	}
+	previousSession := previousRelay.Request.RelaySession
+	currentSession := currentRelay.Request.RelaySession
+	if previousSession.Epoch != currentSession.Epoch || previousSession.SessionId != currentSession.SessionId ||
+		previousSession.Provider != currentSession.Provider || previousSession.RelayNum >= currentSession.RelayNum {
+		// only relays of the same session can prove a contradiction
+		return nil
+	}
+	latestBlockConflict := &conflicttypes.LatestBlockConflict{PreviousRelay: previousRelay, CurrentRelay: currentRelay}
+	if latestBlockConflict.Contradiction() == "" {
+		return nil
+	}
+	return latestBlockConflict
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/utils/sigs"
+	conflicttypes "github.com/lavanet/lava/x/conflict/types"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
@@ -43,7 +44,7 @@ func TestSignAndExtractResponse(t *testing.T) {
 	}
 	relayRequestData := NewRelayData(ctx, "GET", "stub_url", []byte("stub_data"), 0, 55, "tendermintrpc", metadataValue, "test", nil)
 	require.Equal(t, relayRequestData.Metadata, metadataValue)
-	relay, err := ConstructRelayRequest(ctx, consumer_sk, "lava", specId, relayRequestData, provider_address.String(), singleConsumerSession, epoch, unresponsiveProviderStub())
+	relay, err := ConstructRelayRequest(ctx, sigs.NewPrivateKeySigner(consumer_sk), "lava", specId, relayRequestData, provider_address.String(), singleConsumerSession, epoch, unresponsiveProviderStub())
 	require.NoError(t, err)
 
 	// check signature
@@ -57,7 +58,7 @@ func TestSignAndExtractResponse(t *testing.T) {
 	require.NoError(t, err)
 	reply.FinalizedBlocksHashes = jsonStr
 	reply.LatestBlock = 123
-	reply, err = SignRelayResponse(extractedConsumerAddress, *relay, provider_sk, reply, true)
+	reply, err = SignRelayResponse(extractedConsumerAddress, *relay, sigs.NewPrivateKeySigner(provider_sk), reply, true)
 	require.NoError(t, err)
 	err = VerifyRelayReply(ctx, reply, relay, provider_address.String())
 	require.NoError(t, err)
@@ -91,7 +92,7 @@ func TestSignAndExtractResponseLatest(t *testing.T) {
 	}
 	relayRequestData := NewRelayData(ctx, "GET", "stub_url", []byte("stub_data"), 0, spectypes.LATEST_BLOCK, "tendermintrpc", metadataValue, "test", nil)
 	require.Equal(t, relayRequestData.Metadata, metadataValue)
-	relay, err := ConstructRelayRequest(ctx, consumer_sk, "lava", testSpecId, relayRequestData, provider_address.String(), singleConsumerSession, epoch, unresponsiveProviderStub())
+	relay, err := ConstructRelayRequest(ctx, sigs.NewPrivateKeySigner(consumer_sk), "lava", testSpecId, relayRequestData, provider_address.String(), singleConsumerSession, epoch, unresponsiveProviderStub())
 	require.NoError(t, err)
 
 	// provider checks
@@ -107,10 +108,98 @@ func TestSignAndExtractResponseLatest(t *testing.T) {
 	require.NoError(t, err)
 	reply.FinalizedBlocksHashes = jsonStr
 	reply.LatestBlock = latestBlock
-	reply, err = SignRelayResponse(extractedConsumerAddress, *relay, provider_sk, reply, true)
+	reply, err = SignRelayResponse(extractedConsumerAddress, *relay, sigs.NewPrivateKeySigner(provider_sk), reply, true)
 	require.NoError(t, err)
 	err = VerifyRelayReply(ctx, reply, relay, provider_address.String())
 	require.NoError(t, err)
 	_, _, err = VerifyFinalizationData(reply, relay, provider_address.String(), consumer_address, int64(0), 0)
 	require.NoError(t, err)
 }
+
+func TestSignAndVerifyRelayChunks(t *testing.T) {
+	ctx := context.Background()
+	consumer_sk, _ := sigs.GenerateFloatingKey()
+	provider_sk, provider_address := sigs.GenerateFloatingKey()
+	_, other_address := sigs.GenerateFloatingKey()
+	epoch := int64(100)
+	singleConsumerSession := &lavasession.SingleConsumerSession{
+		CuSum:         20,
+		LatestRelayCu: 10,
+		QoSInfo:       lavasession.QoSReport{LastQoSReport: &pairingtypes.QualityOfServiceReport{}},
+		SessionId:     123,
+		RelayNum:      1,
+		LatestBlock:   epoch,
+	}
+	relayRequestData := NewRelayData(ctx, "GET", "stub_url", []byte("stub_data"), 0, 55, "tendermintrpc", nil, "test", nil)
+	relay, err := ConstructRelayRequest(ctx, sigs.NewPrivateKeySigner(consumer_sk), "lava", "LAV1", relayRequestData, provider_address.String(), singleConsumerSession, epoch, unresponsiveProviderStub())
+	require.NoError(t, err)
+
+	data := bytes.Repeat([]byte("0123456789"), 25)
+	chunks, err := SignRelayChunks(*relay, sigs.NewPrivateKeySigner(provider_sk), data, 100)
+	require.NoError(t, err)
+	require.Len(t, chunks, 3)
+
+	reassembled := []byte{}
+	for idx, chunk := range chunks {
+		require.NoError(t, VerifyRelayChunk(ctx, chunk, uint64(idx), relay, provider_address.String()))
+		reassembled = append(reassembled, chunk.Data...)
+	}
+	require.Equal(t, data, reassembled)
+
+	// out of order chunk
+	require.Error(t, VerifyRelayChunk(ctx, chunks[1], 0, relay, provider_address.String()))
+	// wrong signer
+	require.Error(t, VerifyRelayChunk(ctx, chunks[0], 0, relay, other_address.String()))
+	// tampered data
+	tampered := *chunks[0]
+	tampered.Data = []byte("tampered")
+	require.Error(t, VerifyRelayChunk(ctx, &tampered, 0, relay, provider_address.String()))
+}
+
+func TestFindLatestBlockConflict(t *testing.T) {
+	ctx := context.Background()
+	consumer_sk, consumer_address := sigs.GenerateFloatingKey()
+	provider_sk, provider_address := sigs.GenerateFloatingKey()
+	epoch := int64(100)
+	singleConsumerSession := &lavasession.SingleConsumerSession{
+		CuSum:         20,
+		LatestRelayCu: 10,
+		QoSInfo:       lavasession.QoSReport{LastQoSReport: &pairingtypes.QualityOfServiceReport{}},
+		SessionId:     123,
+		RelayNum:      1,
+		LatestBlock:   epoch,
+	}
+	proof := func(latestBlock int64, finalizedBlockHashes map[int64]string) *conflicttypes.ConflictRelayData {
+		relayRequestData := NewRelayData(ctx, "GET", "stub_url", []byte("stub_data"), 0, 55, "tendermintrpc", nil, "test", nil)
+		relay, err := ConstructRelayRequest(ctx, sigs.NewPrivateKeySigner(consumer_sk), "lava", "LAV1", relayRequestData, provider_address.String(), singleConsumerSession, epoch, unresponsiveProviderStub())
+		require.NoError(t, err)
+		singleConsumerSession.RelayNum++
+		jsonStr, err := json.Marshal(finalizedBlockHashes)
+		require.NoError(t, err)
+		reply, err := SignRelayResponse(consumer_address, *relay, sigs.NewPrivateKeySigner(provider_sk), &pairingtypes.RelayReply{LatestBlock: latestBlock, FinalizedBlocksHashes: jsonStr}, true)
+		require.NoError(t, err)
+		return ConstructFinalizationProof(relay, reply)
+	}
+
+	first := proof(100, map[int64]string{98: "a", 99: "b"})
+	require.Nil(t, FindLatestBlockConflict(nil, first))
+	second := proof(101, map[int64]string{99: "b", 100: "c"})
+	require.Nil(t, FindLatestBlockConflict(first, second))
+	// relays are only compared in order
+	require.Nil(t, FindLatestBlockConflict(second, first))
+
+	older := proof(99, map[int64]string{97: "z", 98: "a"})
+	conflict := FindLatestBlockConflict(second, older)
+	require.NotNil(t, conflict)
+	require.Contains(t, conflict.Contradiction(), "older")
+
+	changedHash := proof(102, map[int64]string{100: "x", 101: "d"})
+	conflict = FindLatestBlockConflict(second, changedHash)
+	require.NotNil(t, conflict)
+	require.Contains(t, conflict.Contradiction(), "hash")
+
+	// a relay of another session can't prove a contradiction
+	singleConsumerSession.SessionId++
+	otherSession := proof(90, map[int64]string{})
+	require.Nil(t, FindLatestBlockConflict(second, otherSession))
+}
@@ -33,7 +33,7 @@ func TestSignAndExtract(t *testing.T) {
 	}
 	relayRequestData := NewRelayData(ctx, "GET", "stub_url", []byte("stub_data"), 0, 10, "tendermintrpc", metadataValue, "test", nil)
 	require.Equal(t, relayRequestData.Metadata, metadataValue)
-	relay, err := ConstructRelayRequest(ctx, sk, "lava", specId, relayRequestData, "lava@stubProviderAddress", singleConsumerSession, epoch, unresponsiveProviderStub())
+	relay, err := ConstructRelayRequest(ctx, sigs.NewPrivateKeySigner(sk), "lava", specId, relayRequestData, "lava@stubProviderAddress", singleConsumerSession, epoch, unresponsiveProviderStub())
 	require.NoError(t, err)
 
 	// check signature
@@ -15,6 +15,7 @@ import (
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/rand"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	protocoltypes "github.com/lavanet/lava/x/protocol/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -53,6 +54,11 @@ type ConsumerSessionManager struct {
 	pairingPurge           map[string]*ConsumerSessionsWithProvider
 	providerOptimizer      ProviderOptimizer
 	consumerMetricsManager *metrics.ConsumerMetricsManager
+
+	// chainVersion holds the effective protocol version of the chain (nil if unknown)
+	chainVersion *protocoltypes.Version
+	// outdatedProviders contains providers whose probed version is below the chain's provider minimum, they are excluded from the valid addresses
+	outdatedProviders map[string]struct{}
 }
 
 // this is being read in multiple locations and but never changes so no need to lock.
@@ -60,6 +66,20 @@ func (csm *ConsumerSessionManager) RPCEndpoint() RPCEndpoint {
 	return *csm.rpcEndpoint
 }
 
+// SetChainVersion updates the effective protocol version of the chain, providers are checked against it on the next probe
+func (csm *ConsumerSessionManager) SetChainVersion(version *protocoltypes.Version) {
+	csm.lock.Lock()
+	defer csm.lock.Unlock()
+	csm.chainVersion = version
+}
+
+// GetChainVersion returns the effective protocol version of the chain, nil if it wasn't fetched yet
+func (csm *ConsumerSessionManager) GetChainVersion() *protocoltypes.Version {
+	csm.lock.RLock()
+	defer csm.lock.RUnlock()
+	return csm.chainVersion
+}
+
 func (csm *ConsumerSessionManager) UpdateAllProviders(epoch uint64, pairingList map[uint64]*ConsumerSessionsWithProvider) error {
 	pairingListLength := len(pairingList)
 	// TODO: we can block updating until some of the probing is done, this can prevent failed attempts on epoch change when we have no information on the providers,
@@ -96,6 +116,12 @@ func (csm *ConsumerSessionManager) UpdateAllProviders(epoch uint64, pairingList
 		csm.pairingAddresses[idx] = provider.PublicLavaAddress
 		csm.pairing[provider.PublicLavaAddress] = provider
 	}
+	// forget outdated providers that left the pairing, the ones that stay are checked again by the probe
+	for provider := range csm.outdatedProviders {
+		if _, inPairing := csm.pairing[provider]; !inPairing {
+			delete(csm.outdatedProviders, provider)
+		}
+	}
 	csm.setValidAddressesToDefaultValue("", nil) // the starting point is that valid addresses are equal to pairing addresses.
 	csm.resetMetricsManager()
 	utils.LavaFormatDebug("updated providers", utils.Attribute{Key: "epoch", Value: epoch}, utils.Attribute{Key: "spec", Value: csm.rpcEndpoint.Key()})
@@ -236,27 +262,80 @@ func (csm *ConsumerSessionManager) probeProvider(ctx context.Context, consumerSe
 	if probeResp.LatestBlock == 0 {
 		return 0, providerAddress, utils.LavaFormatWarning("provider returned 0 latest block", nil, utils.Attribute{Key: "provider", Value: providerAddress}, utils.Attribute{Key: "sent guid", Value: guid})
 	}
+	providerVersion := strings.Join(versions, ",")
+	if err := csm.validateProviderVersion(providerAddress, providerVersion, epoch); err != nil {
+		return 0, providerAddress, err
+	}
 	// public lava address is a value that is not changing, so it's thread safe
 	if DebugProbes {
-		utils.LavaFormatDebug("Probed provider successfully", utils.Attribute{Key: "latency", Value: relayLatency}, utils.Attribute{Key: "provider", Value: consumerSessionsWithProvider.PublicLavaAddress}, utils.LogAttr("version", strings.Join(versions, ",")))
+		utils.LavaFormatDebug("Probed provider successfully", utils.Attribute{Key: "latency", Value: relayLatency}, utils.Attribute{Key: "provider", Value: consumerSessionsWithProvider.PublicLavaAddress}, utils.LogAttr("version", providerVersion))
 	}
 	return relayLatency, providerAddress, nil
 }
 
+// validateProviderVersion excludes a provider whose version is below the chain's provider minimum, and restores it once it upgrades
+func (csm *ConsumerSessionManager) validateProviderVersion(providerAddress string, providerVersion string, epoch uint64) error {
+	csm.lock.Lock()
+	defer csm.lock.Unlock()
+	if csm.chainVersion == nil || !protocoltypes.IsVersionLower(providerVersion, csm.chainVersion.ProviderMin) {
+		if _, ok := csm.outdatedProviders[providerAddress]; ok {
+			delete(csm.outdatedProviders, providerAddress)
+			if _, inPairing := csm.pairing[providerAddress]; inPairing && epoch == csm.atomicReadCurrentEpoch() {
+				csm.validAddresses = append(csm.validAddresses, providerAddress)
+				csm.RemoveAddonAddresses("", nil)
+			}
+		}
+		return nil
+	}
+	csm.outdatedProviders[providerAddress] = struct{}{}
+	if epoch == csm.atomicReadCurrentEpoch() {
+		csm.removeOutdatedFromValidAddresses()
+	}
+	return utils.LavaFormatWarning("provider version is lower than the chain's minimum provider version", ProviderVersionTooLowError,
+		utils.LogAttr("provider", providerAddress),
+		utils.LogAttr("version", providerVersion),
+		utils.LogAttr("minVersion", csm.chainVersion.ProviderMin),
+		utils.LogAttr("chainID", csm.rpcEndpoint.ChainID),
+	)
+}
+
+// csm needs to be locked here
+func (csm *ConsumerSessionManager) removeOutdatedFromValidAddresses() {
+	validAddresses := make([]string, 0, len(csm.validAddresses))
+	for _, address := range csm.validAddresses {
+		if _, ok := csm.outdatedProviders[address]; !ok {
+			validAddresses = append(validAddresses, address)
+		}
+	}
+	blockedAddresses := make([]string, 0, len(csm.currentlyBlockedProviderAddresses))
+	for _, address := range csm.currentlyBlockedProviderAddresses {
+		if _, ok := csm.outdatedProviders[address]; !ok {
+			blockedAddresses = append(blockedAddresses, address)
+		}
+	}
+	csm.validAddresses = validAddresses
+	csm.currentlyBlockedProviderAddresses = blockedAddresses
+	csm.RemoveAddonAddresses("", nil)
+}
+
 // csm needs to be locked here
 func (csm *ConsumerSessionManager) setValidAddressesToDefaultValue(addon string, extensions []string) {
 	csm.currentlyBlockedProviderAddresses = make([]string, 0) // reset currently blocked provider addresses
 	if addon == "" && len(extensions) == 0 {
-		csm.validAddresses = make([]string, len(csm.pairingAddresses))
-		index := 0
+		csm.validAddresses = make([]string, 0, len(csm.pairingAddresses))
 		for _, provider := range csm.pairingAddresses {
-			csm.validAddresses[index] = provider
-			index++
+			if _, outdated := csm.outdatedProviders[provider]; outdated {
+				continue
+			}
+			csm.validAddresses = append(csm.validAddresses, provider)
 		}
 	} else {
 		// check if one of the pairing addresses supports the addon
 	addingToValidAddresses:
 		for _, provider := range csm.pairingAddresses {
+			if _, outdated := csm.outdatedProviders[provider]; outdated {
+				continue
+			}
 			if csm.pairing[provider].IsSupportingAddon(addon) && csm.pairing[provider].IsSupportingExtensions(extensions) {
 				for _, validAddress := range csm.validAddresses {
 					if validAddress == provider {
@@ -322,6 +401,31 @@ func (csm *ConsumerSessionManager) validatePairingListNotEmpty(addon string, ext
 	return numberOfResets
 }
 
+// GetSessionsFromProviders gets sessions from wantedProviders distinct providers, for relays that compare the responses of several providers.
+// it returns fewer sessions when there are not enough valid providers in the pairing
+func (csm *ConsumerSessionManager) GetSessionsFromProviders(ctx context.Context, wantedProviders int, cuNeededForSession uint64, usedProviders UsedProvidersInf, requestedBlock int64, addon string, extensions []*spectypes.Extension, stateful uint32, virtualEpoch uint64) (
+	consumerSessionMap ConsumerSessionsMap, errRet error,
+) {
+	consumerSessionMap, errRet = csm.GetSessions(ctx, cuNeededForSession, usedProviders, requestedBlock, addon, extensions, stateful, virtualEpoch)
+	if errRet != nil || len(consumerSessionMap) >= wantedProviders {
+		return consumerSessionMap, errRet
+	}
+	for len(consumerSessionMap) < wantedProviders {
+		// providers that were already selected are unwanted, so every call returns new ones
+		moreSessions, err := csm.GetSessions(ctx, cuNeededForSession, usedProviders, requestedBlock, addon, extensions, stateful, virtualEpoch)
+		if err != nil {
+			utils.LavaFormatDebug("not enough providers for the wanted sessions", utils.LogAttr("wantedProviders", wantedProviders), utils.LogAttr("sessions", len(consumerSessionMap)), utils.LogAttr("error", err))
+			break
+		}
+		for providerAddress, sessionInfo := range moreSessions {
+			consumerSessionMap[providerAddress] = sessionInfo
+		}
+	}
+	// every selection replaced the latest batch, it has to include all of the sessions
+	usedProviders.AddUsed(consumerSessionMap, nil)
+	return consumerSessionMap, nil
+}
+
 // GetSessions will return a ConsumerSession, given cu needed for that session.
 // The user can also request specific providers to not be included in the search for a session.
 func (csm *ConsumerSessionManager) GetSessions(ctx context.Context, cuNeededForSession uint64, usedProviders UsedProvidersInf, requestedBlock int64, addon string, extensions []*spectypes.Extension, stateful uint32, virtualEpoch uint64) (
@@ -946,6 +1050,33 @@ func (csm *ConsumerSessionManager) GetAtomicPairingAddressesLength() uint64 {
 	return atomic.LoadUint64(&csm.pairingAddressesLength)
 }
 
+// Release a session whose relay was canceled by the consumer after another provider answered first, the provider is not penalized for it
+func (csm *ConsumerSessionManager) OnSessionCanceled(consumerSession *SingleConsumerSession) error {
+	if err := consumerSession.VerifyLock(); err != nil {
+		return sdkerrors.Wrapf(err, "OnSessionCanceled, consumerSession.lock must be locked before accessing this method")
+	}
+	cuToDecrease := consumerSession.LatestRelayCu
+	consumerSession.LatestRelayCu = 0                            // making sure no one uses it in a wrong way
+	parentConsumerSessionsWithProvider := consumerSession.Parent // must read this pointer before unlocking
+	consumerSession.Free(nil)
+	return parentConsumerSessionsWithProvider.decreaseUsedComputeUnits(cuToDecrease)
+}
+
+// LatencyPercentile returns the latency of relays with the given compute units at the percentile, and false when there's not enough data
+func (csm *ConsumerSessionManager) LatencyPercentile(cu uint64, percentile float64) (time.Duration, bool) {
+	return csm.providerOptimizer.LatencyPercentile(cu, percentile)
+}
+
+// EpochComputeUnits returns the current epoch and the compute units the consumer can spend in it on all of the paired providers
+func (csm *ConsumerSessionManager) EpochComputeUnits() (epoch uint64, maxComputeUnits uint64) {
+	csm.lock.RLock()
+	defer csm.lock.RUnlock()
+	for _, consumerSessionsWithProvider := range csm.pairing {
+		maxComputeUnits += consumerSessionsWithProvider.MaxComputeUnits
+	}
+	return csm.atomicReadCurrentEpoch(), maxComputeUnits
+}
+
 // On a successful Subscribe relay
 func (csm *ConsumerSessionManager) OnSessionDoneIncreaseCUOnly(consumerSession *SingleConsumerSession) error {
 	if err := consumerSession.VerifyLock(); err != nil {
@@ -975,6 +1106,7 @@ func NewConsumerSessionManager(rpcEndpoint *RPCEndpoint, providerOptimizer Provi
 	csm := &ConsumerSessionManager{
 		reportedProviders:      NewReportedProviders(reporter),
 		consumerMetricsManager: consumerMetricsManager,
+		outdatedProviders:      map[string]struct{}{},
 	}
 	csm.rpcEndpoint = rpcEndpoint
 	csm.providerOptimizer = providerOptimizer
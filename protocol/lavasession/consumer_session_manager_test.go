@@ -17,6 +17,7 @@ import (
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/rand"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	protocoltypes "github.com/lavanet/lava/x/protocol/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -47,7 +48,7 @@ func CreateConsumerSessionManager() *ConsumerSessionManager {
 	AllowInsecureConnectionToProviders = true // set to allow insecure for tests purposes
 	rand.InitRandomSeed()
 	baseLatency := common.AverageWorldLatency / 2 // we want performance to be half our timeout or better
-	return NewConsumerSessionManager(&RPCEndpoint{"stub", "stub", "stub", false, "/", 0}, provideroptimizer.NewProviderOptimizer(provideroptimizer.STRATEGY_BALANCED, 0, baseLatency, 1), nil, nil)
+	return NewConsumerSessionManager(&RPCEndpoint{"stub", "stub", "stub", false, "/", 0, nil}, provideroptimizer.NewProviderOptimizer(provideroptimizer.STRATEGY_BALANCED, 0, baseLatency, 1), nil, nil)
 }
 
 var grpcServer *grpc.Server
@@ -692,6 +693,57 @@ func TestUpdateAllProvidersWithSameEpoch(t *testing.T) {
 	}
 }
 
+func TestOutdatedProviderVersion(t *testing.T) {
+	csm := CreateConsumerSessionManager()
+	pairingList := createPairingList("", true)
+	err := csm.UpdateAllProviders(firstEpochHeight, pairingList)
+	require.NoError(t, err)
+	outdatedProvider := providerStr + "0"
+
+	// no chain version - nothing is enforced
+	err = csm.validateProviderVersion(outdatedProvider, "0.1.0", firstEpochHeight)
+	require.NoError(t, err)
+	require.Len(t, csm.validAddresses, numberOfProviders)
+
+	csm.SetChainVersion(&protocoltypes.Version{ProviderTarget: "1.2.0", ProviderMin: "1.1.0", ConsumerTarget: "1.2.0", ConsumerMin: "1.1.0"})
+	err = csm.validateProviderVersion(providerStr+"1", "1.1.0", firstEpochHeight)
+	require.NoError(t, err)
+	err = csm.validateProviderVersion(outdatedProvider, "1.0.9", firstEpochHeight)
+	require.True(t, ProviderVersionTooLowError.Is(err))
+	require.Len(t, csm.validAddresses, numberOfProviders-1)
+	require.NotContains(t, csm.validAddresses, outdatedProvider)
+	require.NotContains(t, csm.currentlyBlockedProviderAddresses, outdatedProvider)
+
+	// outdated providers stay excluded after a reset and on the next epoch
+	csm.lock.Lock()
+	csm.setValidAddressesToDefaultValue("", nil)
+	csm.lock.Unlock()
+	require.NotContains(t, csm.validAddresses, outdatedProvider)
+	err = csm.UpdateAllProviders(secondEpochHeight, pairingList)
+	require.NoError(t, err)
+	require.NotContains(t, csm.validAddresses, outdatedProvider)
+
+	// the provider returns once it upgrades
+	err = csm.validateProviderVersion(outdatedProvider, "1.2.0", secondEpochHeight)
+	require.NoError(t, err)
+	require.Len(t, csm.validAddresses, numberOfProviders)
+	require.Contains(t, csm.validAddresses, outdatedProvider)
+
+	// outdated providers are forgotten once they leave the pairing
+	err = csm.validateProviderVersion(outdatedProvider, "1.0.9", secondEpochHeight)
+	require.True(t, ProviderVersionTooLowError.Is(err))
+	require.Contains(t, csm.outdatedProviders, outdatedProvider)
+	nextPairingList := map[uint64]*ConsumerSessionsWithProvider{}
+	for idx, provider := range pairingList {
+		if provider.PublicLavaAddress != outdatedProvider {
+			nextPairingList[idx] = provider
+		}
+	}
+	err = csm.UpdateAllProviders(secondEpochHeight+20, nextPairingList)
+	require.NoError(t, err)
+	require.NotContains(t, csm.outdatedProviders, outdatedProvider)
+}
+
 func TestGetSession(t *testing.T) {
 	ctx := context.Background()
 	csm := CreateConsumerSessionManager()
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/provideroptimizer"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/rand"
@@ -46,6 +47,7 @@ type ProviderOptimizer interface {
 	ChooseProvider(allAddresses []string, ignoredProviders map[string]struct{}, cu uint64, requestedBlock int64, perturbationPercentage float64) (addresses []string)
 	GetExcellenceQoSReportForProvider(string) *pairingtypes.QualityOfServiceReport
 	Strategy() provideroptimizer.Strategy
+	LatencyPercentile(cu uint64, percentile float64) (time.Duration, bool)
 }
 
 type ignoredProviders struct {
@@ -71,14 +73,23 @@ type DataReliabilitySession struct {
 }
 
 type Endpoint struct {
-	NetworkAddress     string // change at the end to NetworkAddress
-	Enabled            bool
-	Client             *pairingtypes.RelayerClient
-	connection         *grpc.ClientConn
-	ConnectionRefusals uint64
-	Addons             map[string]struct{}
-	Extensions         map[string]struct{}
-	Geolocation        planstypes.Geolocation
+	NetworkAddress       string // change at the end to NetworkAddress
+	Enabled              bool
+	Client               *pairingtypes.RelayerClient
+	connection           *grpc.ClientConn
+	ConnectionRefusals   uint64
+	Addons               map[string]struct{}
+	Extensions           map[string]struct{}
+	Geolocation          planstypes.Geolocation
+	streamingUnsupported uint32 // set atomically when the provider does not implement RelayStream
+}
+
+func (e *Endpoint) SupportsRelayStream() bool {
+	return atomic.LoadUint32(&e.streamingUnsupported) == 0
+}
+
+func (e *Endpoint) SetRelayStreamUnsupported() {
+	atomic.StoreUint32(&e.streamingUnsupported, 1)
 }
 
 type SessionWithProvider struct {
@@ -95,6 +106,8 @@ type RPCEndpoint struct {
 	TLSEnabled      bool   `yaml:"tls-enabled,omitempty" json:"tls-enabled,omitempty" mapstructure:"tls-enabled"`
 	HealthCheckPath string `yaml:"health-check-path,omitempty" json:"health-check-path,omitempty" mapstructure:"health-check-path"` // health check status code 200 path, default is "/"
 	Geolocation     uint64 `yaml:"geolocation,omitempty" json:"geolocation,omitempty" mapstructure:"geolocation"`
+	// per api overrides of the consumer secure relay flags
+	SecureApis []common.SecureRelayConfig `yaml:"secure-apis,omitempty" json:"secure-apis,omitempty" mapstructure:"secure-apis"`
 }
 
 func (endpoint *RPCEndpoint) String() (retStr string) {
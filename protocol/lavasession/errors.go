@@ -26,6 +26,8 @@ var ( // Consumer Side Errors
 	FailedToConnectToEndPointForDataReliabilityError     = sdkerrors.New("FailedToConnectToEndPointForDataReliability Error", 683, "Failed to connect to a providers endpoints")
 	DataReliabilityEpochMismatchError                    = sdkerrors.New("DataReliabilityEpochMismatch Error", 684, "Data reliability epoch mismatch original session epoch.")
 	NoDataReliabilitySessionWasCreatedError              = sdkerrors.New("NoDataReliabilitySessionWasCreated Error", 685, "No Data reliability session was created")
+	ProviderVersionTooLowError                           = sdkerrors.New("ProviderVersionTooLow Error", 686, "Provider version is lower than the chain's minimum provider version")
+	ConsumerVersionTooLowError                           = sdkerrors.New("ConsumerVersionTooLow Error", 687, "Consumer version is lower than the chain's minimum consumer version")
 )
 
 var ( // Provider Side Errors
@@ -8,6 +8,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/utils"
+	conflicttypes "github.com/lavanet/lava/x/conflict/types"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 )
 
@@ -20,6 +21,7 @@ type SingleConsumerSession struct {
 	lock              utils.LavaMutex
 	RelayNum          uint64
 	LatestBlock       int64
+	LatestRelay       *conflicttypes.ConflictRelayData // the provider's last signed finalization data in this session, used to prove contradictions
 	Endpoint          *Endpoint
 	BlockListed       bool // if session lost sync we blacklist it.
 	ConsecutiveErrors []error
@@ -36,6 +36,8 @@ type ProviderMetricsManager struct {
 	fetchBlockSuccessMetric       *prometheus.CounterVec
 	protocolVersionMetric         *prometheus.GaugeVec
 	virtualEpochMetric            *prometheus.GaugeVec
+	nodeBlockLagMetric            *prometheus.GaugeVec
+	nodeDisagreementMetric        *prometheus.GaugeVec
 	endpointsHealthChecksOkMetric prometheus.Gauge
 	endpointsHealthChecksOk       uint64
 	relaysMonitors                map[string]*RelaysMonitor
@@ -114,6 +116,14 @@ func NewProviderMetricsManager(networkAddress string) *ProviderMetricsManager {
 		Name: "virtual_epoch",
 		Help: "The current virtual epoch measured",
 	}, []string{"spec"})
+	nodeBlockLagMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "lava_provider_node_block_lag",
+		Help: "How many blocks a node is behind the most advanced node of the chain in the last cross check",
+	}, []string{"spec", "node"})
+	nodeDisagreementMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "lava_provider_node_hash_disagreement",
+		Help: "value of 1 if a node's block hash was different than the majority of the chain's nodes in the last cross check",
+	}, []string{"spec", "node"})
 	endpointsHealthChecksOkMetric := prometheus.NewGauge(prometheus.GaugeOpts{
 		Name: "lava_provider_overall_health",
 		Help: "At least one endpoint is healthy",
@@ -138,6 +148,8 @@ func NewProviderMetricsManager(networkAddress string) *ProviderMetricsManager {
 	prometheus.MustRegister(fetchLatestSuccessMetric)
 	prometheus.MustRegister(fetchBlockSuccessMetric)
 	prometheus.MustRegister(virtualEpochMetric)
+	prometheus.MustRegister(nodeBlockLagMetric)
+	prometheus.MustRegister(nodeDisagreementMetric)
 	prometheus.MustRegister(endpointsHealthChecksOkMetric)
 	prometheus.MustRegister(protocolVersionMetric)
 
@@ -156,6 +168,8 @@ func NewProviderMetricsManager(networkAddress string) *ProviderMetricsManager {
 		fetchLatestSuccessMetric:      fetchLatestSuccessMetric,
 		fetchBlockSuccessMetric:       fetchBlockSuccessMetric,
 		virtualEpochMetric:            virtualEpochMetric,
+		nodeBlockLagMetric:            nodeBlockLagMetric,
+		nodeDisagreementMetric:        nodeDisagreementMetric,
 		endpointsHealthChecksOkMetric: endpointsHealthChecksOkMetric,
 		endpointsHealthChecksOk:       1,
 		protocolVersionMetric:         protocolVersionMetric,
@@ -326,6 +340,18 @@ func (pme *ProviderMetricsManager) SetSpecificBlockFetchSuccess(specID string) {
 	pme.fetchBlockSuccessMetric.WithLabelValues(specID).Add(1)
 }
 
+func (pme *ProviderMetricsManager) SetNodeCrossCheck(specID string, node string, blockLag int64, disagrees bool) {
+	if pme == nil {
+		return
+	}
+	pme.nodeBlockLagMetric.WithLabelValues(specID, node).Set(float64(blockLag))
+	disagreement := 0.0
+	if disagrees {
+		disagreement = 1
+	}
+	pme.nodeDisagreementMetric.WithLabelValues(specID, node).Set(disagreement)
+}
+
 func (pme *ProviderMetricsManager) SetVirtualEpoch(virtualEpoch uint64) {
 	if pme == nil {
 		return
@@ -80,14 +80,15 @@ func RunHealth(ctx context.Context,
 ) (*HealthResults, error) {
 	specQuerier := spectypes.NewQueryClient(clientCtx)
 	healthResults := &HealthResults{
-		LatestBlocks:       map[string]int64{},
-		ProviderData:       map[LavaEntity]ReplyData{},
-		ConsumerBlocks:     map[LavaEntity]int64{},
-		SubscriptionsData:  map[string]SubscriptionData{},
-		FrozenProviders:    map[LavaEntity]struct{}{},
-		UnhealthyProviders: map[LavaEntity]string{},
-		UnhealthyConsumers: map[LavaEntity]string{},
-		Specs:              map[string]*spectypes.Spec{},
+		LatestBlocks:         map[string]int64{},
+		ProviderData:         map[LavaEntity]ReplyData{},
+		ConsumerBlocks:       map[LavaEntity]int64{},
+		SubscriptionsData:    map[string]SubscriptionData{},
+		FrozenProviders:      map[LavaEntity]struct{}{},
+		MaintenanceProviders: map[LavaEntity]uint64{},
+		UnhealthyProviders:   map[LavaEntity]string{},
+		UnhealthyConsumers:   map[LavaEntity]string{},
+		Specs:                map[string]*spectypes.Spec{},
 	}
 	currentBlock := int64(0)
 	for i := 0; i < BasicQueryRetries; i++ {
@@ -245,6 +246,27 @@ func RunHealth(ctx context.Context,
 		return nil, utils.LavaFormatWarning("[-] populating specs", <-errCh)
 	}
 	pairingQuerier := pairingtypes.NewQueryClient(clientCtx)
+	utils.LavaFormatDebug("[+] getting provider maintenance windows")
+	// providers in an ongoing maintenance window are planned-offline, not frozen or unhealthy
+	maintenanceProviders := map[LavaEntity]uint64{}
+	for i := 0; i < BasicQueryRetries; i++ {
+		queryCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+		response, err := pairingQuerier.UpcomingMaintenance(queryCtx, &pairingtypes.QueryUpcomingMaintenanceRequest{})
+		cancel()
+		if err != nil || response == nil {
+			time.Sleep(QuerySleepTime)
+			continue
+		}
+		for _, maintenance := range response.Maintenances {
+			if maintenance.StartBlock > uint64(currentBlock) {
+				continue
+			}
+			for _, chainID := range maintenance.ChainIds {
+				maintenanceProviders[LavaEntity{Address: maintenance.Provider, SpecId: chainID}] = maintenance.EndBlock
+			}
+		}
+		break
+	}
 	utils.LavaFormatDebug("[+] getting provider entries")
 	stakeEntries := map[LavaEntity]epochstoragetypes.StakeEntry{}
 	var mutex sync.Mutex // Mutex to protect concurrent access to stakeEntries
@@ -285,7 +307,9 @@ func RunHealth(ctx context.Context,
 
 				mutex.Lock() // Lock before updating stakeEntries
 				if _, ok := healthResults.getProviderData(lookupKey); ok || getAllProviders {
-					if providerEntry.StakeAppliedBlock > uint64(currentBlock) {
+					if endBlock, ok := maintenanceProviders[providerKey]; ok {
+						healthResults.SetMaintenanceProvider(providerKey, endBlock)
+					} else if providerEntry.StakeAppliedBlock > uint64(currentBlock) {
 						healthResults.FreezeProvider(providerKey)
 					} else {
 						stakeEntries[providerKey] = providerEntry
@@ -10,15 +10,16 @@ import (
 )
 
 type HealthResults struct {
-	LatestBlocks       map[string]int64            `json:"latestBlocks,omitempty"`
-	ConsumerBlocks     map[LavaEntity]int64        `json:"consumerBlocks,omitempty"`
-	ProviderData       map[LavaEntity]ReplyData    `json:"providerData,omitempty"`
-	SubscriptionsData  map[string]SubscriptionData `json:"subscriptionsData,omitempty"`
-	FrozenProviders    map[LavaEntity]struct{}     `json:"frozenProviders,omitempty"`
-	UnhealthyProviders map[LavaEntity]string       `json:"unhealthyProviders,omitempty"`
-	UnhealthyConsumers map[LavaEntity]string       `json:"unhealthyConsumers,omitempty"`
-	Specs              map[string]*spectypes.Spec  `json:"specs,omitempty"`
-	Lock               sync.RWMutex                `json:"-"`
+	LatestBlocks         map[string]int64            `json:"latestBlocks,omitempty"`
+	ConsumerBlocks       map[LavaEntity]int64        `json:"consumerBlocks,omitempty"`
+	ProviderData         map[LavaEntity]ReplyData    `json:"providerData,omitempty"`
+	SubscriptionsData    map[string]SubscriptionData `json:"subscriptionsData,omitempty"`
+	FrozenProviders      map[LavaEntity]struct{}     `json:"frozenProviders,omitempty"`
+	MaintenanceProviders map[LavaEntity]uint64       `json:"maintenanceProviders,omitempty"`
+	UnhealthyProviders   map[LavaEntity]string       `json:"unhealthyProviders,omitempty"`
+	UnhealthyConsumers   map[LavaEntity]string       `json:"unhealthyConsumers,omitempty"`
+	Specs                map[string]*spectypes.Spec  `json:"specs,omitempty"`
+	Lock                 sync.RWMutex                `json:"-"`
 }
 
 func (healthResults *HealthResults) FormatForLatestBlock() map[string]uint64 {
@@ -47,6 +48,9 @@ func (healthResults *HealthResults) GetAllEntities() map[LavaEntity]struct{} {
 	for entity := range healthResults.FrozenProviders {
 		entities[entity] = struct{}{}
 	}
+	for entity := range healthResults.MaintenanceProviders {
+		entities[entity] = struct{}{}
+	}
 	for entity := range healthResults.UnhealthyProviders {
 		entities[entity] = struct{}{}
 	}
@@ -75,6 +79,13 @@ func (healthResults *HealthResults) FreezeProvider(providerKey LavaEntity) {
 	healthResults.FrozenProviders[providerKey] = struct{}{}
 }
 
+// SetMaintenanceProvider marks a provider as planned-offline until the end of its maintenance window
+func (healthResults *HealthResults) SetMaintenanceProvider(providerKey LavaEntity, endBlock uint64) {
+	healthResults.Lock.Lock()
+	defer healthResults.Lock.Unlock()
+	healthResults.MaintenanceProviders[providerKey] = endBlock
+}
+
 func (healthResults *HealthResults) setSpec(spec *spectypes.Spec) {
 	healthResults.Lock.Lock()
 	defer healthResults.Lock.Unlock()
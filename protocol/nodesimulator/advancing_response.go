@@ -0,0 +1,171 @@
+package nodesimulator
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type advancingFieldFormat int
+
+const (
+	advancingNumber advancingFieldFormat = iota
+	advancingDecimalString
+	advancingHexString
+)
+
+// advancingField is a number in a json response that grew over the recording, like a block height
+type advancingField struct {
+	path   []interface{} // map keys and array indices from the root of the response
+	first  int64
+	last   int64
+	format advancingFieldFormat
+}
+
+// advancingResponse keeps advancing a response that advanced over the recording after the recording ends,
+// so the latest block keeps growing at the recorded pace instead of freezing on the last recorded block
+type advancingResponse struct {
+	last   *ReplayEntry
+	span   time.Duration
+	fields []*advancingField
+}
+
+// newAdvancingResponse compares the first and last recorded responses of a request, it returns nil if none of their numbers grew
+func newAdvancingResponse(entries []*ReplayEntry) *advancingResponse {
+	if len(entries) < 2 {
+		return nil
+	}
+	first, last := entries[0], entries[len(entries)-1]
+	if first.StatusCode != last.StatusCode || last.Offset <= first.Offset {
+		return nil
+	}
+	firstParsed, ok := parseJsonNumbers(first.Response)
+	if !ok {
+		return nil
+	}
+	lastParsed, ok := parseJsonNumbers(last.Response)
+	if !ok {
+		return nil
+	}
+	fields := []*advancingField{}
+	findAdvancingFields(firstParsed, lastParsed, []interface{}{}, &fields)
+	if len(fields) == 0 {
+		return nil
+	}
+	return &advancingResponse{last: last, span: last.Offset - first.Offset, fields: fields}
+}
+
+// response returns the last recorded response with its advancing fields extrapolated to the replay offset
+func (ar *advancingResponse) response(offset time.Duration) string {
+	elapsed := offset - ar.last.Offset
+	if elapsed <= 0 {
+		return ar.last.Response
+	}
+	parsed, ok := parseJsonNumbers(ar.last.Response)
+	if !ok {
+		return ar.last.Response
+	}
+	for _, field := range ar.fields {
+		advanced := int64(math.Floor(float64(field.last-field.first) * float64(elapsed) / float64(ar.span)))
+		parsed = setJsonPath(parsed, field.path, field.formatValue(field.last+advanced))
+	}
+	data, err := json.Marshal(parsed)
+	if err != nil {
+		return ar.last.Response
+	}
+	return string(data)
+}
+
+func (af *advancingField) formatValue(value int64) interface{} {
+	switch af.format {
+	case advancingHexString:
+		return "0x" + strconv.FormatInt(value, 16)
+	case advancingDecimalString:
+		return strconv.FormatInt(value, 10)
+	default:
+		return json.Number(strconv.FormatInt(value, 10))
+	}
+}
+
+func parseJsonNumbers(data string) (interface{}, bool) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(data)))
+	decoder.UseNumber()
+	var parsed interface{}
+	if decoder.Decode(&parsed) != nil {
+		return nil, false
+	}
+	return parsed, true
+}
+
+func findAdvancingFields(first interface{}, last interface{}, path []interface{}, fields *[]*advancingField) {
+	switch lastValue := last.(type) {
+	case map[string]interface{}:
+		firstValue, ok := first.(map[string]interface{})
+		if !ok {
+			return
+		}
+		for key, value := range lastValue {
+			if key == "id" {
+				// json-rpc ids change between requests, they are set from the replayed request
+				continue
+			}
+			if firstChild, ok := firstValue[key]; ok {
+				findAdvancingFields(firstChild, value, append(append([]interface{}{}, path...), key), fields)
+			}
+		}
+	case []interface{}:
+		firstValue, ok := first.([]interface{})
+		if !ok || len(firstValue) != len(lastValue) {
+			return
+		}
+		for idx := range lastValue {
+			findAdvancingFields(firstValue[idx], lastValue[idx], append(append([]interface{}{}, path...), idx), fields)
+		}
+	default:
+		firstNumber, firstFormat, ok := parseAdvancingNumber(first)
+		if !ok {
+			return
+		}
+		lastNumber, lastFormat, ok := parseAdvancingNumber(last)
+		if !ok || firstFormat != lastFormat || lastNumber <= firstNumber {
+			return
+		}
+		*fields = append(*fields, &advancingField{path: path, first: firstNumber, last: lastNumber, format: lastFormat})
+	}
+}
+
+func parseAdvancingNumber(value interface{}) (int64, advancingFieldFormat, bool) {
+	switch typedValue := value.(type) {
+	case json.Number:
+		number, err := typedValue.Int64()
+		return number, advancingNumber, err == nil
+	case string:
+		if strings.HasPrefix(typedValue, "0x") {
+			number, err := strconv.ParseInt(typedValue[2:], 16, 64)
+			return number, advancingHexString, err == nil
+		}
+		number, err := strconv.ParseInt(typedValue, 10, 64)
+		return number, advancingDecimalString, err == nil
+	}
+	return 0, advancingNumber, false
+}
+
+func setJsonPath(parsed interface{}, path []interface{}, value interface{}) interface{} {
+	if len(path) == 0 {
+		return value
+	}
+	switch step := path[0].(type) {
+	case string:
+		if parsedMap, ok := parsed.(map[string]interface{}); ok {
+			parsedMap[step] = setJsonPath(parsedMap[step], path[1:], value)
+		}
+	case int:
+		if parsedArray, ok := parsed.([]interface{}); ok && step < len(parsedArray) {
+			parsedArray[step] = setJsonPath(parsedArray[step], path[1:], value)
+		}
+	}
+	return parsed
+}
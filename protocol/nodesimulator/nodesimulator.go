@@ -0,0 +1,217 @@
+package nodesimulator
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib/grpcproxy"
+	"github.com/lavanet/lava/utils"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	apiInterfaceFlagName    = "api-interface"
+	saveIntervalFlagName    = "save-interval"
+	speedFlagName           = "speed"
+	latencyFlagName         = "latency"
+	recordedLatencyFlagName = "recorded-latency"
+	errorRateFlagName       = "error-rate"
+	seedFlagName            = "seed"
+	maxGrpcMessageSize      = 1024 * 1024 * 512
+	defaultSaveInterval     = 10 * time.Second
+	shutdownTimeout         = 5 * time.Second
+	grpcNodeConnectTimeout  = 10 * time.Second
+	defaultApiInterface     = spectypes.APIInterfaceJsonRPC
+)
+
+func CreateNodeSimulatorCobraCommand() *cobra.Command {
+	cmdNodeSimulator := &cobra.Command{
+		Use:   "node-simulator",
+		Short: "record the traffic between a provider and its node, and replay it as a fake node",
+		Long: `node-simulator records the traffic between an rpcprovider and its node into a replay file, and serves a replay file back as a deterministic fake node.
+point the rpcprovider node-url at the recorder to record, and at the replayer to run the provider without the node`,
+	}
+	cmdNodeSimulator.AddCommand(createRecordCobraCommand())
+	cmdNodeSimulator.AddCommand(createReplayCobraCommand())
+	return cmdNodeSimulator
+}
+
+func createRecordCobraCommand() *cobra.Command {
+	cmdRecord := &cobra.Command{
+		Use:   `record [node-url] [listen-address] [replay-file] --api-interface [jsonrpc|rest|tendermintrpc|grpc]`,
+		Short: `forward requests to a node and record them with their responses`,
+		Example: `record https://eth-node.com:443 127.0.0.1:2222 eth_replay.json --api-interface jsonrpc
+record grpc-node.com:443 127.0.0.1:2223 lava_grpc_replay.json --api-interface grpc`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			nodeUrl, listenAddress, replayFilePath := args[0], args[1], args[2]
+			apiInterface, err := cmd.Flags().GetString(apiInterfaceFlagName)
+			if err != nil {
+				return err
+			}
+			saveInterval, err := cmd.Flags().GetDuration(saveIntervalFlagName)
+			if err != nil {
+				return err
+			}
+			ctx, cancel := signalContext()
+			defer cancel()
+
+			recorder := NewRecorder(apiInterface, nodeUrl)
+			go func() {
+				ticker := time.NewTicker(saveInterval)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+						if err := recorder.Save(replayFilePath); err != nil {
+							utils.LavaFormatError("failed saving recording", err, utils.LogAttr("path", replayFilePath))
+						}
+					}
+				}
+			}()
+
+			utils.LavaFormatInfo("node simulator recording", utils.LogAttr("node", nodeUrl), utils.LogAttr("listen", listenAddress), utils.LogAttr("apiInterface", apiInterface))
+			if apiInterface == spectypes.APIInterfaceGrpc {
+				grpcConn, err := dialGrpcNode(ctx, nodeUrl)
+				if err != nil {
+					return err
+				}
+				defer grpcConn.Close()
+				err = serveGrpc(ctx, listenAddress, recorder.GrpcHandler(grpcConn))
+				if err != nil {
+					return err
+				}
+			} else {
+				err = serveHTTP(ctx, listenAddress, recorder)
+				if err != nil {
+					return err
+				}
+			}
+			return recorder.Save(replayFilePath)
+		},
+	}
+	cmdRecord.Flags().String(apiInterfaceFlagName, defaultApiInterface, "the api interface of the node, grpc nodes are recorded with a grpc proxy and the rest with an http proxy")
+	cmdRecord.Flags().Duration(saveIntervalFlagName, defaultSaveInterval, "how often to save the recording, it is saved on exit too")
+	return cmdRecord
+}
+
+func createReplayCobraCommand() *cobra.Command {
+	cmdReplay := &cobra.Command{
+		Use:   `replay [replay-file] [listen-address]`,
+		Short: `serve a recording as a fake node`,
+		Long: `replay serves the responses of a recording. a request is answered with the latest response recorded for it by the replay time,
+so the latest block advances as it did during the recording, and after the recording ends numbers that advanced in json responses keep advancing at the recorded pace.
+websocket messages are answered the same way, followed by the notifications the node sent after them. requests that were not recorded are answered with not found`,
+		Example: `replay eth_replay.json 127.0.0.1:2222
+replay eth_replay.json 127.0.0.1:2222 --speed 2 --latency 50ms --error-rate 0.1 --seed 7`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			replayFilePath, listenAddress := args[0], args[1]
+			replayFile, err := LoadReplayFile(replayFilePath)
+			if err != nil {
+				return err
+			}
+			options := ReplayOptions{}
+			if options.Speed, err = cmd.Flags().GetFloat64(speedFlagName); err != nil {
+				return err
+			}
+			if options.Latency, err = cmd.Flags().GetDuration(latencyFlagName); err != nil {
+				return err
+			}
+			if options.UseRecordedLatency, err = cmd.Flags().GetBool(recordedLatencyFlagName); err != nil {
+				return err
+			}
+			if options.ErrorRate, err = cmd.Flags().GetFloat64(errorRateFlagName); err != nil {
+				return err
+			}
+			if options.Seed, err = cmd.Flags().GetInt64(seedFlagName); err != nil {
+				return err
+			}
+			ctx, cancel := signalContext()
+			defer cancel()
+
+			replayer := NewReplayer(replayFile, options)
+			utils.LavaFormatInfo("node simulator replaying", utils.LogAttr("file", replayFilePath), utils.LogAttr("entries", len(replayFile.Entries)), utils.LogAttr("listen", listenAddress), utils.LogAttr("apiInterface", replayFile.ApiInterface))
+			if replayFile.ApiInterface == spectypes.APIInterfaceGrpc {
+				return serveGrpc(ctx, listenAddress, replayer.GrpcHandler())
+			}
+			return serveHTTP(ctx, listenAddress, replayer)
+		},
+	}
+	cmdReplay.Flags().Float64(speedFlagName, 1, "how fast the recording advances, 2 replays it at twice the recorded pace")
+	cmdReplay.Flags().Duration(latencyFlagName, 0, "latency to add to every response")
+	cmdReplay.Flags().Bool(recordedLatencyFlagName, false, "delay responses by the latency the node had when recorded")
+	cmdReplay.Flags().Float64(errorRateFlagName, 0, "the fraction of requests to answer with an error, between 0 and 1")
+	cmdReplay.Flags().Int64(seedFlagName, 1, "seed for the error injection, the same seed injects errors to the same requests")
+	return cmdReplay
+}
+
+func signalContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	signalChan := make(chan os.Signal, 1)
+	signal.Notify(signalChan, os.Interrupt)
+	go func() {
+		select {
+		case <-signalChan:
+			cancel()
+		case <-ctx.Done():
+		}
+		signal.Stop(signalChan)
+	}()
+	return ctx, cancel
+}
+
+func serveHTTP(ctx context.Context, listenAddress string, handler http.Handler) error {
+	server := &http.Server{Addr: listenAddress, Handler: handler}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+	err := server.ListenAndServe()
+	if err == http.ErrServerClosed {
+		return nil
+	}
+	return err
+}
+
+func serveGrpc(ctx context.Context, listenAddress string, handler grpc.StreamHandler) error {
+	listener, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		return err
+	}
+	server := grpc.NewServer(grpc.UnknownServiceHandler(handler), grpc.ForceServerCodec(grpcproxy.RawBytesCodec{}), grpc.MaxRecvMsgSize(maxGrpcMessageSize))
+	go func() {
+		<-ctx.Done()
+		server.GracefulStop()
+	}()
+	return server.Serve(listener)
+}
+
+// dialGrpcNode connects with tls unless the node url is explicitly http
+func dialGrpcNode(ctx context.Context, nodeUrl string) (*grpc.ClientConn, error) {
+	var creds credentials.TransportCredentials = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	address := nodeUrl
+	if strings.HasPrefix(nodeUrl, "http://") {
+		creds = insecure.NewCredentials()
+	}
+	for _, scheme := range []string{"https://", "http://"} {
+		address = strings.TrimPrefix(address, scheme)
+	}
+	connectCtx, cancel := context.WithTimeout(ctx, grpcNodeConnectTimeout)
+	defer cancel()
+	return grpc.DialContext(connectCtx, address, grpc.WithTransportCredentials(creds), grpc.WithBlock(), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxGrpcMessageSize)))
+}
@@ -0,0 +1,321 @@
+package nodesimulator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/lavanet/lava/protocol/chainlib/grpcproxy"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func postJsonRPC(t *testing.T, url string, id int, method string) (*http.Response, map[string]interface{}) {
+	body := fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"method":"%s","params":[]}`, id, method)
+	resp, err := http.Post(url, "application/json", strings.NewReader(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	parsed := map[string]interface{}{}
+	if resp.StatusCode == http.StatusOK {
+		require.NoError(t, json.Unmarshal(data, &parsed), string(data))
+	}
+	return resp, parsed
+}
+
+// a node whose latest block advances on every call
+func createJsonRPCNode(t *testing.T) *httptest.Server {
+	latestBlock := int64(100)
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Header().Set("X-Node", "rest")
+			w.Write([]byte(`{"block":{"height":"100"}}`))
+			return
+		}
+		request := map[string]json.RawMessage{}
+		body, _ := io.ReadAll(r.Body)
+		require.NoError(t, json.Unmarshal(body, &request))
+		block := atomic.AddInt64(&latestBlock, 1)
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"%d"}`, request["id"], block)
+	}))
+	t.Cleanup(node.Close)
+	return node
+}
+
+func TestRecordAndReplayHTTP(t *testing.T) {
+	node := createJsonRPCNode(t)
+	recorder := NewRecorder("jsonrpc", node.URL)
+	recorderServer := httptest.NewServer(recorder)
+	defer recorderServer.Close()
+
+	for i := 0; i < 3; i++ {
+		_, reply := postJsonRPC(t, recorderServer.URL, 10+i, "eth_blockNumber")
+		require.Equal(t, fmt.Sprintf("%d", 101+i), reply["result"])
+		require.Equal(t, float64(10+i), reply["id"])
+		time.Sleep(5 * time.Millisecond)
+	}
+	resp, err := http.Get(recorderServer.URL + "/cosmos/base/tendermint/v1beta1/blocks/latest")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	replayFilePath := filepath.Join(t.TempDir(), "replay.json")
+	require.NoError(t, recorder.Save(replayFilePath))
+	replayFile, err := LoadReplayFile(replayFilePath)
+	require.NoError(t, err)
+	require.Len(t, replayFile.Entries, 4)
+
+	replayer := NewReplayer(replayFile, ReplayOptions{})
+	replayOffset := time.Duration(0)
+	replayer.now = func() time.Time { return replayer.start.Add(replayOffset) }
+	replayerServer := httptest.NewServer(replayer)
+	defer replayerServer.Close()
+
+	// the block advances with the replay time, and the reply gets the id of the request
+	for idx, entry := range replayFile.Entries[:3] {
+		replayOffset = entry.Offset
+		_, reply := postJsonRPC(t, replayerServer.URL, 500+idx, "eth_blockNumber")
+		require.Equal(t, fmt.Sprintf("%d", 101+idx), reply["result"])
+		require.Equal(t, float64(500+idx), reply["id"])
+	}
+	// after the recording ends the block keeps advancing at the recorded pace
+	first, last := replayFile.Entries[0], replayFile.Entries[2]
+	replayOffset = last.Offset + (last.Offset - first.Offset)
+	_, reply := postJsonRPC(t, replayerServer.URL, 1, "eth_blockNumber")
+	require.Equal(t, "105", reply["result"])
+	require.Equal(t, float64(1), reply["id"])
+
+	resp, err = http.Get(replayerServer.URL + "/cosmos/base/tendermint/v1beta1/blocks/latest")
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, `{"block":{"height":"100"}}`, string(body))
+	require.Equal(t, "rest", resp.Header.Get("X-Node"))
+
+	resp, _ = postJsonRPC(t, replayerServer.URL, 1, "eth_chainId")
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestReplayErrorInjection(t *testing.T) {
+	replayFile := &ReplayFile{Version: ReplayFileVersion, ApiInterface: "rest", Entries: []*ReplayEntry{
+		{Method: http.MethodGet, Path: "/status", Response: "ok", StatusCode: http.StatusOK},
+	}}
+	injectedErrors := func(seed int64) []bool {
+		replayer := NewReplayer(replayFile, ReplayOptions{ErrorRate: 0.5, Seed: seed})
+		server := httptest.NewServer(replayer)
+		defer server.Close()
+		results := []bool{}
+		for i := 0; i < 20; i++ {
+			resp, err := http.Get(server.URL + "/status")
+			require.NoError(t, err)
+			resp.Body.Close()
+			results = append(results, resp.StatusCode == http.StatusServiceUnavailable)
+		}
+		return results
+	}
+	first := injectedErrors(3)
+	require.Equal(t, first, injectedErrors(3))
+	require.Contains(t, first, true)
+	require.Contains(t, first, false)
+}
+
+func serveTestGrpc(t *testing.T, server *grpc.Server) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+	return listener.Addr().String()
+}
+
+func TestRecordAndReplayGrpc(t *testing.T) {
+	ctx := context.Background()
+	nodeServer := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("lava", grpc_health_v1.HealthCheckResponse_SERVING)
+	grpc_health_v1.RegisterHealthServer(nodeServer, healthServer)
+	nodeAddress := serveTestGrpc(t, nodeServer)
+
+	recorder := NewRecorder("grpc", nodeAddress)
+	nodeConn, err := dialGrpcNode(ctx, "http://"+nodeAddress)
+	require.NoError(t, err)
+	defer nodeConn.Close()
+	recorderAddress := serveTestGrpc(t, grpc.NewServer(grpc.UnknownServiceHandler(recorder.GrpcHandler(nodeConn)), grpc.ForceServerCodec(grpcproxy.RawBytesCodec{})))
+
+	check := func(address string, service string) (*grpc_health_v1.HealthCheckResponse, error) {
+		conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+		defer conn.Close()
+		return grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: service})
+	}
+	res, err := check(recorderAddress, "lava")
+	require.NoError(t, err)
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, res.Status)
+	_, err = check(recorderAddress, "unknown")
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Len(t, recorder.replayFile.Entries, 2)
+
+	replayer := NewReplayer(recorder.replayFile, ReplayOptions{})
+	replayerAddress := serveTestGrpc(t, grpc.NewServer(grpc.UnknownServiceHandler(replayer.GrpcHandler()), grpc.ForceServerCodec(grpcproxy.RawBytesCodec{})))
+	nodeServer.Stop()
+
+	res, err = check(replayerAddress, "lava")
+	require.NoError(t, err)
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, res.Status)
+	// recorded errors are replayed too
+	_, err = check(replayerAddress, "unknown")
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = check(replayerAddress, "not-recorded")
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestRecordAndReplayGrpcServerStream(t *testing.T) {
+	ctx := context.Background()
+	nodeServer := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("lava", grpc_health_v1.HealthCheckResponse_SERVING)
+	grpc_health_v1.RegisterHealthServer(nodeServer, healthServer)
+	nodeAddress := serveTestGrpc(t, nodeServer)
+
+	recorder := NewRecorder("grpc", nodeAddress)
+	nodeConn, err := dialGrpcNode(ctx, "http://"+nodeAddress)
+	require.NoError(t, err)
+	defer nodeConn.Close()
+	recorderAddress := serveTestGrpc(t, grpc.NewServer(grpc.UnknownServiceHandler(recorder.GrpcHandler(nodeConn)), grpc.ForceServerCodec(grpcproxy.RawBytesCodec{})))
+
+	watch := func(ctx context.Context, address string) grpc_health_v1.Health_WatchClient {
+		conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+		watchClient, err := grpc_health_v1.NewHealthClient(conn).Watch(ctx, &grpc_health_v1.HealthCheckRequest{Service: "lava"})
+		require.NoError(t, err)
+		return watchClient
+	}
+	// the node keeps streaming responses after the request
+	watchCtx, cancel := context.WithCancel(ctx)
+	watchClient := watch(watchCtx, recorderAddress)
+	res, err := watchClient.Recv()
+	require.NoError(t, err)
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, res.Status)
+	healthServer.SetServingStatus("lava", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	res, err = watchClient.Recv()
+	require.NoError(t, err)
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, res.Status)
+	cancel()
+	require.Eventually(t, func() bool {
+		recorder.lock.Lock()
+		defer recorder.lock.Unlock()
+		return len(recorder.replayFile.Entries) == 1
+	}, time.Second, 10*time.Millisecond)
+	require.Len(t, recorder.replayFile.Entries[0].StreamResponses, 1)
+
+	replayer := NewReplayer(recorder.replayFile, ReplayOptions{})
+	replayerAddress := serveTestGrpc(t, grpc.NewServer(grpc.UnknownServiceHandler(replayer.GrpcHandler()), grpc.ForceServerCodec(grpcproxy.RawBytesCodec{})))
+	nodeServer.Stop()
+
+	watchClient = watch(ctx, replayerAddress)
+	for _, expected := range []grpc_health_v1.HealthCheckResponse_ServingStatus{grpc_health_v1.HealthCheckResponse_SERVING, grpc_health_v1.HealthCheckResponse_NOT_SERVING} {
+		res, err = watchClient.Recv()
+		require.NoError(t, err)
+		require.Equal(t, expected, res.Status)
+	}
+	_, err = watchClient.Recv()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+// a websocket node that answers subscriptions with two events
+func createWebsocketNode(t *testing.T) *httptest.Server {
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocketUpgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			request := map[string]json.RawMessage{}
+			require.NoError(t, json.Unmarshal(message, &request))
+			if string(request["method"]) != `"eth_subscribe"` {
+				conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"result":"0x64"}`, request["id"])))
+				continue
+			}
+			conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"result":"0xsub"}`, request["id"])))
+			for block := 101; block <= 102; block++ {
+				time.Sleep(5 * time.Millisecond)
+				conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"0xsub","result":%d}}`, block)))
+			}
+		}
+	}))
+	t.Cleanup(node.Close)
+	return node
+}
+
+func TestRecordAndReplayWebsocket(t *testing.T) {
+	node := createWebsocketNode(t)
+	recorder := NewRecorder("jsonrpc", node.URL)
+	recorderServer := httptest.NewServer(recorder)
+	defer recorderServer.Close()
+
+	readMessages := func(conn *websocket.Conn, count int) []map[string]interface{} {
+		messages := []map[string]interface{}{}
+		for len(messages) < count {
+			conn.SetReadDeadline(time.Now().Add(time.Second))
+			_, message, err := conn.ReadMessage()
+			require.NoError(t, err)
+			parsed := map[string]interface{}{}
+			require.NoError(t, json.Unmarshal(message, &parsed))
+			messages = append(messages, parsed)
+		}
+		return messages
+	}
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(recorderServer.URL, "http")+"/ws", nil)
+	require.NoError(t, err)
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`)))
+	require.Equal(t, "0x64", readMessages(conn, 1)[0]["result"])
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":2,"method":"eth_subscribe","params":["newHeads"]}`)))
+	readMessages(conn, 3)
+	conn.Close()
+
+	require.Eventually(t, func() bool {
+		recorder.lock.Lock()
+		defer recorder.lock.Unlock()
+		return len(recorder.replayFile.Entries) == 2 && len(recorder.replayFile.Entries[1].Notifications) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	replayer := NewReplayer(recorder.replayFile, ReplayOptions{})
+	replayerServer := httptest.NewServer(replayer)
+	defer replayerServer.Close()
+	node.Close()
+
+	conn, _, err = websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(replayerServer.URL, "http")+"/ws", nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":7,"method":"eth_subscribe","params":["newHeads"]}`)))
+	messages := readMessages(conn, 3)
+	require.Equal(t, "0xsub", messages[0]["result"])
+	require.Equal(t, float64(7), messages[0]["id"])
+	for idx, notification := range messages[1:] {
+		require.Equal(t, "eth_subscription", notification["method"])
+		require.Equal(t, float64(101+idx), notification["params"].(map[string]interface{})["result"])
+	}
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":8,"method":"eth_chainId","params":[]}`)))
+	require.Contains(t, readMessages(conn, 1)[0], "error")
+}
@@ -0,0 +1,404 @@
+package nodesimulator
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/lavanet/lava/protocol/chainlib/grpcproxy"
+	"github.com/lavanet/lava/utils"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// headers that describe the connection and not the response, they are not recorded
+var skippedHeaders = map[string]struct{}{
+	"Connection":        {},
+	"Content-Length":    {},
+	"Content-Encoding":  {},
+	"Date":              {},
+	"Keep-Alive":        {},
+	"Transfer-Encoding": {},
+	"Accept-Encoding":   {},
+	"Upgrade":           {},
+}
+
+// websocket handshake headers are set by the node connection
+var websocketHandshakeHeaders = map[string]struct{}{
+	"Sec-Websocket-Key":        {},
+	"Sec-Websocket-Version":    {},
+	"Sec-Websocket-Extensions": {},
+	"Sec-Websocket-Protocol":   {},
+}
+
+var websocketUpgrader = websocket.Upgrader{CheckOrigin: func(*http.Request) bool { return true }}
+
+// Recorder forwards requests to a node and records them with their responses
+type Recorder struct {
+	lock       sync.Mutex
+	replayFile *ReplayFile
+	start      time.Time
+	lastByKey  map[string]*ReplayEntry
+	changed    bool
+	nodeUrl    string
+	httpClient *http.Client
+}
+
+func NewRecorder(apiInterface string, nodeUrl string) *Recorder {
+	now := time.Now()
+	return &Recorder{
+		replayFile: &ReplayFile{
+			Version:      ReplayFileVersion,
+			ApiInterface: apiInterface,
+			Node:         nodeUrl,
+			RecordedAt:   now.UTC(),
+			Entries:      []*ReplayEntry{},
+		},
+		start:      now,
+		lastByKey:  map[string]*ReplayEntry{},
+		nodeUrl:    strings.TrimSuffix(nodeUrl, "/"),
+		httpClient: &http.Client{Timeout: 5 * time.Minute},
+	}
+}
+
+// record adds an entry, unless the last response to the same request was identical
+func (r *Recorder) record(entry *ReplayEntry) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	key := entry.Key()
+	if last, ok := r.lastByKey[key]; ok && last.Response == entry.Response && last.StatusCode == entry.StatusCode && slices.Equal(last.StreamResponses, entry.StreamResponses) {
+		return
+	}
+	r.lastByKey[key] = entry
+	r.replayFile.Entries = append(r.replayFile.Entries, entry)
+	r.changed = true
+}
+
+// Save writes the recording to path if anything was recorded since the last save
+func (r *Recorder) Save(path string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if !r.changed {
+		return nil
+	}
+	err := r.replayFile.Save(path)
+	if err != nil {
+		return err
+	}
+	r.changed = false
+	utils.LavaFormatInfo("saved recording", utils.LogAttr("path", path), utils.LogAttr("entries", len(r.replayFile.Entries)))
+	return nil
+}
+
+// recordWebsocket adds a websocket entry, they aren't deduplicated since their notifications are added after they are recorded
+func (r *Recorder) recordWebsocket(entry *ReplayEntry) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.replayFile.Entries = append(r.replayFile.Entries, entry)
+	r.changed = true
+}
+
+func (r *Recorder) addNotification(entry *ReplayEntry, message []byte) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	entry.Notifications = append(entry.Notifications, &ReplayNotification{Offset: time.Since(r.start) - entry.Offset, Message: string(message)})
+	r.changed = true
+}
+
+func (r *Recorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if websocket.IsWebSocketUpgrade(req) {
+		r.serveWebsocket(w, req)
+		return
+	}
+	requestBody, err := io.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	nodeRequest, err := http.NewRequestWithContext(req.Context(), req.Method, r.nodeUrl+req.URL.RequestURI(), bytes.NewReader(requestBody))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	for name, values := range req.Header {
+		// the response is recorded decompressed, so compression is left to the transport
+		if _, skip := skippedHeaders[name]; !skip {
+			nodeRequest.Header[name] = values
+		}
+	}
+	offset := time.Since(r.start)
+	nodeResponse, err := r.httpClient.Do(nodeRequest)
+	if err != nil {
+		utils.LavaFormatWarning("failed sending request to node", err, utils.LogAttr("path", req.URL.RequestURI()))
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer nodeResponse.Body.Close()
+	responseBody, err := io.ReadAll(nodeResponse.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	latency := time.Since(r.start) - offset
+
+	headers := map[string][]string{}
+	for name, values := range nodeResponse.Header {
+		if _, skip := skippedHeaders[name]; !skip {
+			headers[name] = values
+			w.Header()[name] = values
+		}
+	}
+	r.record(&ReplayEntry{
+		Offset:     offset,
+		Latency:    latency,
+		Method:     req.Method,
+		Path:       req.URL.RequestURI(),
+		Request:    string(requestBody),
+		Response:   string(responseBody),
+		StatusCode: nodeResponse.StatusCode,
+		Headers:    headers,
+	})
+	w.WriteHeader(nodeResponse.StatusCode)
+	w.Write(responseBody)
+}
+
+// GrpcHandler forwards every grpc method to the node, streams are forwarded message by message so reflection works too
+func (r *Recorder) GrpcHandler(grpcConn *grpc.ClientConn) grpc.StreamHandler {
+	return func(srv interface{}, stream grpc.ServerStream) error {
+		method, ok := grpc.MethodFromServerStream(stream)
+		if !ok {
+			return status.Error(codes.Unavailable, "unable to get method name")
+		}
+		ctx, cancel := context.WithCancel(stream.Context())
+		defer cancel()
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			md = md.Copy()
+			// transport headers are set by the node connection
+			for _, name := range []string{":authority", "content-type", "user-agent"} {
+				md.Delete(name)
+			}
+			ctx = metadata.NewOutgoingContext(ctx, md)
+		}
+		nodeStream, err := grpcConn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true, ClientStreams: true}, method, grpc.ForceCodec(grpcproxy.RawBytesCodec{}))
+		if err != nil {
+			return err
+		}
+		headerSent := false
+		// the entry of the last request is recorded once all of its responses were received
+		var entry *ReplayEntry
+		defer func() {
+			if entry != nil {
+				r.record(entry)
+			}
+		}()
+		for {
+			var request []byte
+			err := stream.RecvMsg(&request)
+			if errors.Is(err, io.EOF) {
+				err = nodeStream.CloseSend()
+				if err != nil {
+					return err
+				}
+				// server streaming methods keep sending responses to the last request until the node ends the stream
+				for {
+					var response []byte
+					err = nodeStream.RecvMsg(&response)
+					if errors.Is(err, io.EOF) {
+						return nil
+					}
+					if err != nil {
+						return err
+					}
+					if entry != nil {
+						entry.StreamResponses = append(entry.StreamResponses, base64.StdEncoding.EncodeToString(response))
+					}
+					err = stream.SendMsg(response)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			if err != nil {
+				return err
+			}
+			if entry != nil {
+				r.record(entry)
+				entry = nil
+			}
+			offset := time.Since(r.start)
+			err = nodeStream.SendMsg(request)
+			if err != nil {
+				return err
+			}
+			var response []byte
+			err = nodeStream.RecvMsg(&response)
+			latency := time.Since(r.start) - offset
+			entry = &ReplayEntry{
+				Offset:  offset,
+				Latency: latency,
+				Method:  method,
+				Request: base64.StdEncoding.EncodeToString(request),
+			}
+			if err != nil {
+				grpcStatus := status.Convert(err)
+				entry.StatusCode = int(grpcStatus.Code())
+				entry.Response = grpcStatus.Message()
+				return err
+			}
+			header, _ := nodeStream.Header()
+			header = header.Copy()
+			header.Delete("content-type")
+			entry.Response = base64.StdEncoding.EncodeToString(response)
+			entry.Headers = header
+			if !headerSent {
+				stream.SetHeader(header)
+				headerSent = true
+			}
+			err = stream.SendMsg(response)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// serveWebsocket forwards a websocket connection to the node. every message is recorded with the node message that answers it,
+// node messages that aren't answers (like subscription events) are recorded as notifications of the request they belong to
+func (r *Recorder) serveWebsocket(w http.ResponseWriter, req *http.Request) {
+	nodeUrl := r.nodeUrl + req.URL.RequestURI()
+	nodeUrl = strings.Replace(nodeUrl, "http://", "ws://", 1)
+	nodeUrl = strings.Replace(nodeUrl, "https://", "wss://", 1)
+	headers := http.Header{}
+	for name, values := range req.Header {
+		_, skip := skippedHeaders[name]
+		_, handshake := websocketHandshakeHeaders[name]
+		if !skip && !handshake {
+			headers[name] = values
+		}
+	}
+	nodeConn, _, err := websocket.DefaultDialer.DialContext(req.Context(), nodeUrl, headers)
+	if err != nil {
+		utils.LavaFormatWarning("failed connecting to node websocket", err, utils.LogAttr("url", nodeUrl))
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer nodeConn.Close()
+	clientConn, err := websocketUpgrader.Upgrade(w, req, nil)
+	if err != nil {
+		return
+	}
+	defer clientConn.Close()
+
+	recording := &websocketRecording{
+		recorder:      r,
+		path:          req.URL.RequestURI(),
+		pending:       map[string]*ReplayEntry{},
+		answered:      map[string]*ReplayEntry{},
+		subscriptions: map[string]*ReplayEntry{},
+	}
+	go func() {
+		// closing the client connection ends the loop reading from it
+		defer clientConn.Close()
+		for {
+			messageType, message, err := nodeConn.ReadMessage()
+			if err != nil {
+				return
+			}
+			recording.nodeMessage(message)
+			err = clientConn.WriteMessage(messageType, message)
+			if err != nil {
+				return
+			}
+		}
+	}()
+	for {
+		messageType, message, err := clientConn.ReadMessage()
+		if err != nil {
+			return
+		}
+		recording.clientMessage(message)
+		err = nodeConn.WriteMessage(messageType, message)
+		if err != nil {
+			return
+		}
+	}
+}
+
+// websocketRecording matches the messages of a websocket connection to the requests they answer
+type websocketRecording struct {
+	recorder      *Recorder
+	path          string
+	lock          sync.Mutex
+	pending       map[string]*ReplayEntry // requests waiting for an answer by their json-rpc id
+	answered      map[string]*ReplayEntry // tendermint events have the id of the subscription request
+	subscriptions map[string]*ReplayEntry // ethereum events name the subscription id returned by the request
+	last          *ReplayEntry
+}
+
+func (wr *websocketRecording) clientMessage(message []byte) {
+	wr.lock.Lock()
+	defer wr.lock.Unlock()
+	id := ""
+	if ids := jsonRPCIDs(message); len(ids) == 1 {
+		id = string(ids[0])
+	}
+	wr.pending[id] = &ReplayEntry{
+		Offset:  time.Since(wr.recorder.start),
+		Method:  WebsocketMethod,
+		Path:    wr.path,
+		Request: string(message),
+	}
+}
+
+func (wr *websocketRecording) nodeMessage(message []byte) {
+	wr.lock.Lock()
+	defer wr.lock.Unlock()
+	parsed := map[string]json.RawMessage{}
+	if json.Unmarshal(message, &parsed) != nil {
+		parsed = nil
+	}
+	id := string(parsed["id"])
+	if entry, ok := wr.pending[id]; ok {
+		delete(wr.pending, id)
+		entry.Latency = time.Since(wr.recorder.start) - entry.Offset
+		entry.Response = string(message)
+		entry.StatusCode = http.StatusOK
+		wr.answered[id] = entry
+		var subscriptionID string
+		if json.Unmarshal(parsed["result"], &subscriptionID) == nil {
+			wr.subscriptions[subscriptionID] = entry
+		}
+		wr.last = entry
+		wr.recorder.recordWebsocket(entry)
+		return
+	}
+	if entry, ok := wr.answered[id]; ok && id != "" {
+		wr.recorder.addNotification(entry, message)
+		return
+	}
+	var params struct {
+		Subscription string `json:"subscription"`
+	}
+	if json.Unmarshal(parsed["params"], &params) == nil {
+		if entry, ok := wr.subscriptions[params.Subscription]; ok {
+			wr.recorder.addNotification(entry, message)
+			return
+		}
+	}
+	if wr.last != nil {
+		wr.recorder.addNotification(wr.last, message)
+		return
+	}
+	utils.LavaFormatDebug("websocket message from node without a request, not recorded", utils.LogAttr("path", wr.path))
+}
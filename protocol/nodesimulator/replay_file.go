@@ -0,0 +1,185 @@
+package nodesimulator
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	ReplayFileVersion = 1
+	grpcMethodPrefix  = "grpc:"
+	WebsocketMethod   = "WS" // the method of messages sent on a websocket connection
+)
+
+// ReplayFile holds the traffic recorded between a provider and its node
+type ReplayFile struct {
+	Version      int            `json:"version"`
+	ApiInterface string         `json:"api_interface"`
+	Node         string         `json:"node"`
+	RecordedAt   time.Time      `json:"recorded_at"`
+	Entries      []*ReplayEntry `json:"entries"`
+}
+
+// ReplayEntry is a single request and its response. entries of the same request are replayed by their offset,
+// so responses that change over time (like the latest block) advance when replayed
+type ReplayEntry struct {
+	Offset     time.Duration       `json:"offset"`  // time since the recording started
+	Latency    time.Duration       `json:"latency"` // time the node took to respond
+	Method     string              `json:"method"`  // http method, or grpc full method name
+	Path       string              `json:"path,omitempty"`
+	Request    string              `json:"request,omitempty"`  // grpc messages are base64 encoded
+	Response   string              `json:"response,omitempty"` // grpc messages are base64 encoded, grpc errors hold the status message
+	StatusCode int                 `json:"status_code"`        // http status, or grpc status code
+	Headers    map[string][]string `json:"headers,omitempty"`
+	// the responses a server streaming grpc method sent after the first one
+	StreamResponses []string `json:"stream_responses,omitempty"`
+	// the messages a node sent on a websocket after it answered the request, like subscription events
+	Notifications []*ReplayNotification `json:"notifications,omitempty"`
+}
+
+// ReplayNotification is a websocket message the node sent without a request for it
+type ReplayNotification struct {
+	Offset  time.Duration `json:"offset"` // time since the request it belongs to
+	Message string        `json:"message"`
+}
+
+func (re *ReplayEntry) IsGrpc() bool {
+	return len(re.Method) > 0 && re.Method[0] == '/'
+}
+
+// Key identifies the request of the entry, json-rpc ids are ignored so replayed requests match regardless of their id
+func (re *ReplayEntry) Key() string {
+	if re.IsGrpc() {
+		return grpcMethodPrefix + re.Method + " " + re.Request
+	}
+	return re.Method + " " + re.Path + " " + string(normalizeJsonRPC([]byte(re.Request)))
+}
+
+func LoadReplayFile(path string) (*ReplayFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	replayFile := &ReplayFile{}
+	err = json.Unmarshal(data, replayFile)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed parsing replay file", err, utils.LogAttr("path", path))
+	}
+	if replayFile.Version != ReplayFileVersion {
+		return nil, utils.LavaFormatError("unsupported replay file version", nil, utils.LogAttr("path", path), utils.LogAttr("version", replayFile.Version))
+	}
+	sort.SliceStable(replayFile.Entries, func(i, j int) bool {
+		return replayFile.Entries[i].Offset < replayFile.Entries[j].Offset
+	})
+	return replayFile, nil
+}
+
+func (rf *ReplayFile) Save(path string) error {
+	data, err := json.MarshalIndent(rf, "", "  ")
+	if err != nil {
+		return err
+	}
+	// write to a temporary file first so an interrupted save doesn't corrupt an existing recording
+	tmpPath := path + ".tmp"
+	err = os.WriteFile(tmpPath, data, 0o644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// normalizeJsonRPC removes the ids of a json-rpc request (or batch) and sorts its keys, other bodies are returned as is
+func normalizeJsonRPC(body []byte) []byte {
+	parsed, ok := parseJsonRPC(body)
+	if !ok {
+		return body
+	}
+	for _, msg := range parsed {
+		delete(msg, "id")
+	}
+	var normalized []byte
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(body), []byte("[")) {
+		normalized, err = json.Marshal(parsed)
+	} else {
+		normalized, err = json.Marshal(parsed[0])
+	}
+	if err != nil {
+		return body
+	}
+	return normalized
+}
+
+// jsonRPCIDs gets the ids of a json-rpc request or batch, in order
+func jsonRPCIDs(body []byte) []json.RawMessage {
+	parsed, ok := parseJsonRPC(body)
+	if !ok {
+		return nil
+	}
+	ids := make([]json.RawMessage, len(parsed))
+	for idx, msg := range parsed {
+		ids[idx] = msg["id"]
+	}
+	return ids
+}
+
+// replaceJsonRPCIDs sets the ids of the recorded request in the recorded response to the ids of the replayed request
+func replaceJsonRPCIDs(response []byte, recordedIDs, requestIDs []json.RawMessage) []byte {
+	if len(recordedIDs) == 0 || len(recordedIDs) != len(requestIDs) {
+		return response
+	}
+	idsMap := map[string]json.RawMessage{}
+	for idx, recordedID := range recordedIDs {
+		idsMap[string(recordedID)] = requestIDs[idx]
+	}
+	parsed, ok := parseJsonRPC(response)
+	if !ok {
+		return response
+	}
+	for _, msg := range parsed {
+		if requestID, ok := idsMap[string(msg["id"])]; ok {
+			msg["id"] = requestID
+		}
+	}
+	var replaced []byte
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(response), []byte("[")) {
+		replaced, err = json.Marshal(parsed)
+	} else {
+		replaced, err = json.Marshal(parsed[0])
+	}
+	if err != nil {
+		return response
+	}
+	return replaced
+}
+
+func parseJsonRPC(body []byte) ([]map[string]json.RawMessage, bool) {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 {
+		return nil, false
+	}
+	var parsed []map[string]json.RawMessage
+	if trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &parsed); err != nil || len(parsed) == 0 {
+			return nil, false
+		}
+	} else {
+		single := map[string]json.RawMessage{}
+		if err := json.Unmarshal(trimmed, &single); err != nil {
+			return nil, false
+		}
+		parsed = append(parsed, single)
+	}
+	for _, msg := range parsed {
+		if _, ok := msg["jsonrpc"]; !ok {
+			return nil, false
+		}
+	}
+	return parsed, true
+}
@@ -0,0 +1,277 @@
+package nodesimulator
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/lavanet/lava/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const InjectedErrorMessage = "node simulator injected error"
+
+type ReplayOptions struct {
+	Speed              float64       // how fast the recording timeline advances, 1 replays at the recorded pace
+	Latency            time.Duration // added to every response
+	UseRecordedLatency bool          // delay responses by the time the node took to respond when recorded
+	ErrorRate          float64       // the fraction of requests answered with an error
+	Seed               int64         // seeds the error injection so runs are reproducible
+}
+
+// Replayer serves a recording as a fake node. every request is answered with the latest recorded response
+// to it by the replay time, so responses that advanced over the recording (like the latest block) advance during replay.
+// after the recording ends the numbers that advanced in json responses keep advancing at the recorded pace
+type Replayer struct {
+	entriesByKey map[string][]*ReplayEntry
+	advancing    map[string]*advancingResponse
+	options      ReplayOptions
+	start        time.Time
+	randLock     sync.Mutex
+	rand         *rand.Rand
+	now          func() time.Time
+}
+
+func NewReplayer(replayFile *ReplayFile, options ReplayOptions) *Replayer {
+	if options.Speed <= 0 {
+		options.Speed = 1
+	}
+	entriesByKey := map[string][]*ReplayEntry{}
+	// entries are sorted by offset when loaded
+	for _, entry := range replayFile.Entries {
+		key := entry.Key()
+		entriesByKey[key] = append(entriesByKey[key], entry)
+	}
+	advancing := map[string]*advancingResponse{}
+	for key, entries := range entriesByKey {
+		if advancingResponse := newAdvancingResponse(entries); advancingResponse != nil {
+			advancing[key] = advancingResponse
+		}
+	}
+	return &Replayer{
+		entriesByKey: entriesByKey,
+		advancing:    advancing,
+		options:      options,
+		start:        time.Now(),
+		rand:         rand.New(rand.NewSource(options.Seed)),
+		now:          time.Now,
+	}
+}
+
+// offset is the current time in the recording timeline
+func (r *Replayer) offset() time.Duration {
+	return time.Duration(float64(r.now().Sub(r.start)) * r.options.Speed)
+}
+
+// findEntry returns the last entry recorded before the current replay offset, or the first entry if none was
+func (r *Replayer) findEntry(key string) *ReplayEntry {
+	entries, ok := r.entriesByKey[key]
+	if !ok {
+		return nil
+	}
+	offset := r.offset()
+	found := entries[0]
+	for _, entry := range entries[1:] {
+		if entry.Offset > offset {
+			break
+		}
+		found = entry
+	}
+	return found
+}
+
+func (r *Replayer) shouldInjectError() bool {
+	if r.options.ErrorRate <= 0 {
+		return false
+	}
+	r.randLock.Lock()
+	defer r.randLock.Unlock()
+	return r.rand.Float64() < r.options.ErrorRate
+}
+
+func (r *Replayer) delay(entry *ReplayEntry) {
+	delay := r.options.Latency
+	if r.options.UseRecordedLatency && entry != nil {
+		delay += entry.Latency
+	}
+	if delay > 0 {
+		time.Sleep(delay)
+	}
+}
+
+// response returns the recorded response of an entry, advanced if the recording ended before the replay offset
+func (r *Replayer) response(key string, entry *ReplayEntry) string {
+	if advancing, ok := r.advancing[key]; ok && advancing.last == entry {
+		return advancing.response(r.offset())
+	}
+	return entry.Response
+}
+
+func (r *Replayer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if websocket.IsWebSocketUpgrade(req) {
+		r.serveWebsocket(w, req)
+		return
+	}
+	requestBody, err := io.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	lookup := &ReplayEntry{Method: req.Method, Path: req.URL.RequestURI(), Request: string(requestBody)}
+	entry := r.findEntry(lookup.Key())
+	r.delay(entry)
+	if r.shouldInjectError() {
+		http.Error(w, InjectedErrorMessage, http.StatusServiceUnavailable)
+		return
+	}
+	if entry == nil {
+		utils.LavaFormatWarning("no recorded response for request", nil, utils.LogAttr("method", req.Method), utils.LogAttr("path", lookup.Path), utils.LogAttr("request", lookup.Request))
+		http.Error(w, "no recorded response for request", http.StatusNotFound)
+		return
+	}
+	for name, values := range entry.Headers {
+		w.Header()[name] = values
+	}
+	response := replaceJsonRPCIDs([]byte(r.response(lookup.Key(), entry)), jsonRPCIDs([]byte(entry.Request)), jsonRPCIDs(requestBody))
+	w.WriteHeader(entry.StatusCode)
+	w.Write(response)
+}
+
+func (r *Replayer) GrpcHandler() grpc.StreamHandler {
+	return func(srv interface{}, stream grpc.ServerStream) error {
+		method, ok := grpc.MethodFromServerStream(stream)
+		if !ok {
+			return status.Error(codes.Unavailable, "unable to get method name")
+		}
+		headerSent := false
+		for {
+			var request []byte
+			err := stream.RecvMsg(&request)
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			lookup := &ReplayEntry{Method: method, Request: base64.StdEncoding.EncodeToString(request)}
+			entry := r.findEntry(lookup.Key())
+			r.delay(entry)
+			if r.shouldInjectError() {
+				return status.Error(codes.Unavailable, InjectedErrorMessage)
+			}
+			if entry == nil {
+				utils.LavaFormatWarning("no recorded response for grpc request", nil, utils.LogAttr("method", method))
+				return status.Error(codes.NotFound, "no recorded response for request")
+			}
+			if codes.Code(entry.StatusCode) != codes.OK {
+				return status.Error(codes.Code(entry.StatusCode), entry.Response)
+			}
+			response, err := base64.StdEncoding.DecodeString(entry.Response)
+			if err != nil {
+				return status.Error(codes.Internal, "invalid recorded response")
+			}
+			if !headerSent {
+				stream.SetHeader(metadata.MD(entry.Headers))
+				headerSent = true
+			}
+			err = stream.SendMsg(response)
+			if err != nil {
+				return err
+			}
+			for _, streamResponse := range entry.StreamResponses {
+				response, err := base64.StdEncoding.DecodeString(streamResponse)
+				if err != nil {
+					return status.Error(codes.Internal, "invalid recorded response")
+				}
+				err = stream.SendMsg(response)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// serveWebsocket answers every message with the recorded answer, and sends the notifications that followed it
+// with the delays they were recorded with
+func (r *Replayer) serveWebsocket(w http.ResponseWriter, req *http.Request) {
+	conn, err := websocketUpgrader.Upgrade(w, req, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithCancel(req.Context())
+	defer cancel()
+	writeLock := sync.Mutex{}
+	write := func(message []byte) error {
+		writeLock.Lock()
+		defer writeLock.Unlock()
+		return conn.WriteMessage(websocket.TextMessage, message)
+	}
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		lookup := &ReplayEntry{Method: WebsocketMethod, Path: req.URL.RequestURI(), Request: string(message)}
+		entry := r.findEntry(lookup.Key())
+		r.delay(entry)
+		requestIDs := jsonRPCIDs(message)
+		if r.shouldInjectError() {
+			err = write(websocketErrorMessage(requestIDs, InjectedErrorMessage))
+		} else if entry == nil {
+			utils.LavaFormatWarning("no recorded response for websocket message", nil, utils.LogAttr("path", lookup.Path), utils.LogAttr("request", lookup.Request))
+			err = write(websocketErrorMessage(requestIDs, "no recorded response for request"))
+		} else {
+			recordedIDs := jsonRPCIDs([]byte(entry.Request))
+			err = write(replaceJsonRPCIDs([]byte(r.response(lookup.Key(), entry)), recordedIDs, requestIDs))
+			if err == nil && len(entry.Notifications) > 0 {
+				go r.sendNotifications(ctx, entry.Notifications, recordedIDs, requestIDs, write)
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (r *Replayer) sendNotifications(ctx context.Context, notifications []*ReplayNotification, recordedIDs, requestIDs []json.RawMessage, write func([]byte) error) {
+	requestTime := time.Now()
+	for _, notification := range notifications {
+		wait := time.Until(requestTime.Add(time.Duration(float64(notification.Offset) / r.options.Speed)))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+		if write(replaceJsonRPCIDs([]byte(notification.Message), recordedIDs, requestIDs)) != nil {
+			return
+		}
+	}
+}
+
+// websocketErrorMessage is a json-rpc error for json-rpc requests, and the error text for other messages
+func websocketErrorMessage(requestIDs []json.RawMessage, message string) []byte {
+	if len(requestIDs) != 1 {
+		return []byte(message)
+	}
+	errorMessage, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      requestIDs[0],
+		"error":   map[string]interface{}{"code": -32000, "message": message},
+	})
+	if err != nil {
+		return []byte(message)
+	}
+	return errorMessage
+}
@@ -5,11 +5,14 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 
 	sdkerrors "cosmossdk.io/errors"
+	"github.com/dgraph-io/ristretto"
 	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/jsonpath"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 )
@@ -18,10 +21,54 @@ const (
 	PARSE_PARAMS = 0
 	PARSE_RESULT = 1
 	debug        = false
+
+	CompiledPatternsCacheMaxCost     = 2000  // each item cost would be 1
+	CompiledPatternsCacheNumCounters = 20000 // expect 2000 items
 )
 
 var ValueNotSetError = sdkerrors.New("Value Not Set ", 6662, "when trying to parse, the value that we attempted to parse did not exist")
 
+// compiledPatterns holds the jsonpaths and regexes of the specs' parse directives so they aren't compiled on every relay
+var compiledPatterns = newCompiledPatternsCache()
+
+func newCompiledPatternsCache() *ristretto.Cache {
+	cache, err := ristretto.NewCache(&ristretto.Config{NumCounters: CompiledPatternsCacheNumCounters, MaxCost: CompiledPatternsCacheMaxCost, BufferItems: 64, IgnoreInternalCost: true})
+	if err != nil {
+		utils.LavaFormatFatal("failed setting up cache for compiled parser patterns", err)
+	}
+	return cache
+}
+
+func compileJsonPath(expression string) (jsonpath.Path, error) {
+	key := "jsonpath:" + expression
+	if cached, ok := compiledPatterns.Get(key); ok {
+		if path, ok := cached.(jsonpath.Path); ok {
+			return path, nil
+		}
+	}
+	path, err := jsonpath.Compile(expression)
+	if err != nil {
+		return jsonpath.Path{}, err
+	}
+	compiledPatterns.Set(key, path, 1)
+	return path, nil
+}
+
+func compileRegex(expression string) (*regexp.Regexp, error) {
+	key := "regex:" + expression
+	if cached, ok := compiledPatterns.Get(key); ok {
+		if re, ok := cached.(*regexp.Regexp); ok {
+			return re, nil
+		}
+	}
+	re, err := regexp.Compile(expression)
+	if err != nil {
+		return nil, err
+	}
+	compiledPatterns.Set(key, re, 1)
+	return re, nil
+}
+
 type RPCInput interface {
 	GetParams() interface{}
 	GetResult() json.RawMessage
@@ -139,6 +186,10 @@ func parse(rpcInput RPCInput, blockParser spectypes.BlockParser, dataSource int)
 		retval, err = parseDictionary(rpcInput, blockParser.ParserArg, dataSource)
 	case spectypes.PARSER_FUNC_PARSE_DICTIONARY_OR_ORDERED:
 		retval, err = parseDictionaryOrOrdered(rpcInput, blockParser.ParserArg, dataSource)
+	case spectypes.PARSER_FUNC_PARSE_JSONPATH:
+		retval, err = parseJsonPath(rpcInput, blockParser.ParserArg, dataSource)
+	case spectypes.PARSER_FUNC_PARSE_REGEX:
+		retval, err = parseRegex(rpcInput, blockParser.ParserArg, dataSource)
 	case spectypes.PARSER_FUNC_DEFAULT:
 		retval = parseDefault(blockParser.ParserArg)
 	default:
@@ -443,6 +494,93 @@ func parseDictionaryOrOrdered(rpcInput RPCInput, input []string, dataSource int)
 	}
 }
 
+// parseJsonPath returns the value selected by the jsonpath in args, results are not wrapped in a list
+// so "$.number" selects the number field of a result object
+func parseJsonPath(rpcInput RPCInput, input []string, dataSource int) ([]interface{}, error) {
+	// [jsonpath]
+	if len(input) != 1 {
+		return nil, fmt.Errorf("invalid input format, input length: %d and needs to be 1", len(input))
+	}
+	path, err := compileJsonPath(input[0])
+	if err != nil {
+		return nil, err
+	}
+	data, err := getDataToParseUnwrapped(rpcInput, dataSource)
+	if err != nil {
+		return nil, fmt.Errorf("invalid input format, data is not json: %s, error: %s", data, err)
+	}
+	value, found := path.Get(data)
+	if !found || value == nil {
+		return nil, ValueNotSetError
+	}
+	return appendInterfaceToInterfaceArrayWithError(blockInterfaceToString(value))
+}
+
+// parseRegex returns the first capture group of the regex in args, matched against the string selected by the optional jsonpath
+// or against the data encoded as json when it is not set
+func parseRegex(rpcInput RPCInput, input []string, dataSource int) ([]interface{}, error) {
+	// [regex,optional jsonpath]
+	if len(input) != 1 && len(input) != 2 {
+		return nil, fmt.Errorf("invalid input format, input length: %d and needs to be 1 or 2", len(input))
+	}
+	re, err := compileRegex(input[0])
+	if err != nil {
+		return nil, err
+	}
+	data, err := getDataToParseUnwrapped(rpcInput, dataSource)
+	if err != nil {
+		return nil, fmt.Errorf("invalid input format, data is not json: %s, error: %s", data, err)
+	}
+	if len(input) == 2 && input[1] != "" {
+		path, err := compileJsonPath(input[1])
+		if err != nil {
+			return nil, err
+		}
+		var found bool
+		data, found = path.Get(data)
+		if !found || data == nil {
+			return nil, ValueNotSetError
+		}
+	}
+	var text string
+	switch typedData := data.(type) {
+	case string:
+		text = typedData
+	case json.RawMessage:
+		text = string(typedData)
+	default:
+		encoded, err := json.Marshal(typedData)
+		if err != nil {
+			return nil, err
+		}
+		text = string(encoded)
+	}
+	match := re.FindStringSubmatch(text)
+	if len(match) < 2 {
+		return nil, ValueNotSetError
+	}
+	return appendInterfaceToInterfaceArrayWithError(match[1])
+}
+
+// getDataToParseUnwrapped returns the data to parse as any json value, results are not wrapped in a list like in getDataToParse
+// and results that are not json objects are unmarshalled too
+func getDataToParseUnwrapped(rpcInput RPCInput, dataSource int) (interface{}, error) {
+	if dataSource != PARSE_RESULT {
+		return getDataToParse(rpcInput, dataSource)
+	}
+	unmarshalled := rpcInput.GetResult()
+	if len(unmarshalled) == 0 {
+		return nil, fmt.Errorf("GetDataToParse failure Get.Result is empty")
+	}
+	var data interface{}
+	err := json.Unmarshal(unmarshalled, &data)
+	if err != nil {
+		// not json, the raw result can still be matched by a regex
+		return unmarshalled, nil
+	}
+	return data, nil
+}
+
 // parseArrayOfInterfaces returns value of item with specified prop name
 // If it doesn't exist return nil
 func parseArrayOfInterfaces(data []interface{}, propName, innerSeparator string) []interface{} {
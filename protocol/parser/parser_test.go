@@ -338,6 +338,60 @@ func TestParseBlockFromParamsHappyFlow(t *testing.T) {
 			},
 			expectedBlock: 103,
 		},
+		{
+			name: "ParseJsonPath__NestedObject__Case",
+			message: RPCInputTest{
+				Params: []interface{}{
+					"address",
+					map[string]interface{}{"filter": map[string]interface{}{"block": map[string]interface{}{"height": "105"}}},
+				},
+			},
+			blockParser: spectypes.BlockParser{
+				ParserArg:  []string{"$[1].filter.block.height"},
+				ParserFunc: spectypes.PARSER_FUNC_PARSE_JSONPATH,
+			},
+			expectedBlock: 105,
+		},
+		{
+			name: "ParseJsonPath__ArrayIndex__Case",
+			message: RPCInputTest{
+				Params: map[string]interface{}{
+					"heights": []interface{}{float64(1), float64(107)},
+				},
+			},
+			blockParser: spectypes.BlockParser{
+				ParserArg:  []string{"$['heights'][-1]"},
+				ParserFunc: spectypes.PARSER_FUNC_PARSE_JSONPATH,
+			},
+			expectedBlock: 107,
+		},
+		{
+			name: "ParseRegex__Cursor__Case",
+			message: RPCInputTest{
+				Params: map[string]interface{}{
+					"cursor": "block_109_tx_3",
+					"limit":  "10",
+				},
+			},
+			blockParser: spectypes.BlockParser{
+				ParserArg:  []string{`block_(\d+)_`, "$.cursor"},
+				ParserFunc: spectypes.PARSER_FUNC_PARSE_REGEX,
+			},
+			expectedBlock: 109,
+		},
+		{
+			name: "ParseRegex__WholeData__Case",
+			message: RPCInputTest{
+				Params: map[string]interface{}{
+					"height": "111",
+				},
+			},
+			blockParser: spectypes.BlockParser{
+				ParserArg:  []string{`"height":"(\d+)"`},
+				ParserFunc: spectypes.PARSER_FUNC_PARSE_REGEX,
+			},
+			expectedBlock: 111,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -398,6 +452,50 @@ func TestParseBlockFromReplyHappyFlow(t *testing.T) {
 			},
 			expectedBlock: 25,
 		},
+		{
+			name: "ParseJsonPath",
+			message: RPCInputTest{
+				Result: []byte(`{"block":{"header":{"height":"27"}}}`),
+			},
+			blockParser: spectypes.BlockParser{
+				ParserArg:  []string{"$.block.header.height"},
+				ParserFunc: spectypes.PARSER_FUNC_PARSE_JSONPATH,
+			},
+			expectedBlock: 27,
+		},
+		{
+			name: "ParseJsonPathArrayResult",
+			message: RPCInputTest{
+				Result: []byte(`[{"height":28},{"height":29}]`),
+			},
+			blockParser: spectypes.BlockParser{
+				ParserArg:  []string{"$[-1].height"},
+				ParserFunc: spectypes.PARSER_FUNC_PARSE_JSONPATH,
+			},
+			expectedBlock: 29,
+		},
+		{
+			name: "ParseRegex",
+			message: RPCInputTest{
+				Result: []byte(`{"next":"/blocks/31/txs?page=2"}`),
+			},
+			blockParser: spectypes.BlockParser{
+				ParserArg:  []string{`^/blocks/(\d+)/`, "$.next"},
+				ParserFunc: spectypes.PARSER_FUNC_PARSE_REGEX,
+			},
+			expectedBlock: 31,
+		},
+		{
+			name: "ParseRegexNotJson",
+			message: RPCInputTest{
+				Result: []byte(`height=33`),
+			},
+			blockParser: spectypes.BlockParser{
+				ParserArg:  []string{`height=(\d+)`},
+				ParserFunc: spectypes.PARSER_FUNC_PARSE_REGEX,
+			},
+			expectedBlock: 33,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -408,3 +506,56 @@ func TestParseBlockFromReplyHappyFlow(t *testing.T) {
 		})
 	}
 }
+
+func TestParseJsonPathAndRegexNotSet(t *testing.T) {
+	message := &RPCInputTest{
+		Params: map[string]interface{}{
+			"cursor": "start",
+		},
+	}
+	blockParsers := []spectypes.BlockParser{
+		{ParserArg: []string{"$.block.height"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_JSONPATH},
+		{ParserArg: []string{`block_(\d+)`, "$.cursor"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_REGEX},
+		{ParserArg: []string{`block_(\d+)`, "$.missing"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_REGEX},
+	}
+	for _, blockParser := range blockParsers {
+		_, err := parse(message, blockParser, PARSE_PARAMS)
+		require.True(t, ValueNotSetError.Is(err), blockParser.ParserArg)
+		// optional params fall back to the default value
+		blockParser.DefaultValue = "latest"
+		block, err := ParseBlockFromParams(message, blockParser)
+		require.NoError(t, err)
+		require.Equal(t, spectypes.LATEST_BLOCK, block)
+	}
+
+	// invalid expressions are errors and not missing values
+	_, err := parse(message, spectypes.BlockParser{ParserArg: []string{"cursor"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_JSONPATH}, PARSE_PARAMS)
+	require.Error(t, err)
+	require.False(t, ValueNotSetError.Is(err))
+	_, err = parse(message, spectypes.BlockParser{ParserArg: []string{"block_(\\d+"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_REGEX}, PARSE_PARAMS)
+	require.Error(t, err)
+	require.False(t, ValueNotSetError.Is(err))
+}
+
+func TestCompiledPatternsAreCached(t *testing.T) {
+	re, err := compileRegex(`height_(\d+)`)
+	require.NoError(t, err)
+	path, err := compileJsonPath("$.result.height")
+	require.NoError(t, err)
+	compiledPatterns.Wait()
+
+	cachedRe, err := compileRegex(`height_(\d+)`)
+	require.NoError(t, err)
+	require.Same(t, re, cachedRe)
+	cachedPath, err := compileJsonPath("$.result.height")
+	require.NoError(t, err)
+	require.Equal(t, path.String(), cachedPath.String())
+
+	// the same expression is cached separately per pattern type
+	_, err = compileRegex("$.result.height")
+	require.NoError(t, err)
+	compiledPatterns.Wait()
+	cachedPath, err = compileJsonPath("$.result.height")
+	require.NoError(t, err)
+	require.Equal(t, path.String(), cachedPath.String())
+}
@@ -65,3 +65,15 @@ func (cache *Cache) SetEntry(ctx context.Context, cacheSet *pairingtypes.RelayCa
 	_, err := cache.client.SetRelay(ctx, cacheSet)
 	return err
 }
+
+// InvalidateBlocks evicts the non finalized entries of the chain from fromBlock and above, used when the chain forked
+func (cache *Cache) InvalidateBlocks(ctx context.Context, chainID string, fromBlock int64) error {
+	if cache == nil {
+		return NotInitialisedError
+	}
+	if cache.client == nil {
+		return NotConnectedError.Wrapf("No client connected to address: %s", cache.address)
+	}
+	_, err := cache.client.InvalidateBlocks(ctx, &pairingtypes.RelayCacheInvalidate{ChainId: chainID, FromBlock: fromBlock})
+	return err
+}
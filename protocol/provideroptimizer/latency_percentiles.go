@@ -0,0 +1,73 @@
+package provideroptimizer
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	LatencySamplesWindow     = 200 // latest relay latencies kept per compute units
+	MinLatencySamplesForStat = 20  // percentiles of fewer samples are not returned
+)
+
+// latencyWindow keeps the latest relay latencies of apis with the same compute units, of all providers
+type latencyWindow struct {
+	samples []time.Duration
+	next    int
+}
+
+func (lw *latencyWindow) add(latency time.Duration) {
+	if len(lw.samples) < LatencySamplesWindow {
+		lw.samples = append(lw.samples, latency)
+		return
+	}
+	lw.samples[lw.next] = latency
+	lw.next = (lw.next + 1) % LatencySamplesWindow
+}
+
+type latencyPercentiles struct {
+	lock    sync.RWMutex
+	windows map[uint64]*latencyWindow
+}
+
+func (lp *latencyPercentiles) add(cu uint64, latency time.Duration) {
+	lp.lock.Lock()
+	defer lp.lock.Unlock()
+	if lp.windows == nil {
+		lp.windows = map[uint64]*latencyWindow{}
+	}
+	window, ok := lp.windows[cu]
+	if !ok {
+		window = &latencyWindow{}
+		lp.windows[cu] = window
+	}
+	window.add(latency)
+}
+
+func (lp *latencyPercentiles) percentile(cu uint64, percentile float64) (time.Duration, bool) {
+	lp.lock.RLock()
+	window, ok := lp.windows[cu]
+	if !ok || len(window.samples) < MinLatencySamplesForStat {
+		lp.lock.RUnlock()
+		return 0, false
+	}
+	samples := make([]time.Duration, len(window.samples))
+	copy(samples, window.samples)
+	lp.lock.RUnlock()
+	sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+	idx := int(percentile * float64(len(samples)))
+	if idx >= len(samples) {
+		idx = len(samples) - 1
+	}
+	if idx < 0 {
+		idx = 0
+	}
+	return samples[idx], true
+}
+
+// LatencyPercentile returns the latency of successful relays with the given compute units at the percentile (0 to 1),
+// and false when there are not enough relays to tell
+func (po *ProviderOptimizer) LatencyPercentile(cu uint64, percentile float64) (time.Duration, bool) {
+	return po.latencyPercentiles.percentile(cu, percentile)
+}
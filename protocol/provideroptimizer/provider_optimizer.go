@@ -50,6 +50,7 @@ type ProviderOptimizer struct {
 	baseWorldLatency                time.Duration
 	wantedNumProvidersInConcurrency uint
 	latestSyncData                  ConcurrentBlockStore
+	latencyPercentiles              latencyPercentiles
 }
 
 type ProviderData struct {
@@ -91,6 +92,9 @@ func (po *ProviderOptimizer) appendRelayData(providerAddress string, latency tim
 				baseLatency += po.averageBlockTime / 2 // hanging apis take longer
 			}
 			providerData = po.updateProbeEntryLatency(providerData, latency, baseLatency, RELAY_UPDATE_WEIGHT, halfTime, sampleTime)
+			if !isHangingApi {
+				po.latencyPercentiles.add(cu, latency)
+			}
 		}
 		if syncBlock > providerData.SyncBlock {
 			// do not allow providers to go back
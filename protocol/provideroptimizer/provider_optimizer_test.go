@@ -572,3 +572,35 @@ func TestPerturbationWithNormalGaussianOnConcurrentComputation(t *testing.T) {
 	wg.Wait()
 	fmt.Println("Test completed successfully")
 }
+
+func TestProviderOptimizerLatencyPercentile(t *testing.T) {
+	providerOptimizer := setupProviderOptimizer(1)
+	providerAddress := "lava@test_0"
+	cu := uint64(10)
+	_, ok := providerOptimizer.LatencyPercentile(cu, 0.9)
+	require.False(t, ok)
+	for i := 1; i <= 100; i++ {
+		providerOptimizer.AppendRelayData(providerAddress, time.Duration(i)*time.Millisecond, false, cu, 1)
+	}
+	// hanging apis take longer on purpose, they don't change the percentiles
+	providerOptimizer.AppendRelayData(providerAddress, time.Minute, true, cu, 1)
+	p50, ok := providerOptimizer.LatencyPercentile(cu, 0.5)
+	require.True(t, ok)
+	require.Equal(t, 51*time.Millisecond, p50)
+	p90, ok := providerOptimizer.LatencyPercentile(cu, 0.9)
+	require.True(t, ok)
+	require.Equal(t, 91*time.Millisecond, p90)
+	p100, ok := providerOptimizer.LatencyPercentile(cu, 1)
+	require.True(t, ok)
+	require.Equal(t, 100*time.Millisecond, p100)
+	// percentiles are per compute units
+	_, ok = providerOptimizer.LatencyPercentile(cu+1, 0.9)
+	require.False(t, ok)
+	// the window keeps only the latest samples
+	for i := 0; i < LatencySamplesWindow; i++ {
+		providerOptimizer.AppendRelayData(providerAddress, time.Second, false, cu, 1)
+	}
+	p50, ok = providerOptimizer.LatencyPercentile(cu, 0.5)
+	require.True(t, ok)
+	require.Equal(t, time.Second, p50)
+}
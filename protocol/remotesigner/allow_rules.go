@@ -0,0 +1,59 @@
+package remotesigner
+
+import (
+	"fmt"
+
+	"github.com/lavanet/lava/utils/lavaslices"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+// AllowRule allows signing a single type of signable objects, optionally only for some chains
+type AllowRule struct {
+	Type   string   `yaml:"type" json:"type" mapstructure:"type"`
+	Chains []string `yaml:"chains,omitempty" json:"chains,omitempty" mapstructure:"chains"` // relay spec ids, empty allows all chains
+}
+
+type AllowRules []AllowRule
+
+// DefaultAllowRules allows the relay signatures a provider and a consumer make, badges must be allowed explicitly
+var DefaultAllowRules = AllowRules{
+	{Type: pairingtypes.SignableTypeRelaySession},
+	{Type: pairingtypes.SignableTypeRelayExchange},
+	{Type: pairingtypes.SignableTypeRelayFinalization},
+	{Type: pairingtypes.SignableTypeRelayChunk},
+}
+
+var signableTypes = []string{
+	pairingtypes.SignableTypeRelaySession,
+	pairingtypes.SignableTypeRelayExchange,
+	pairingtypes.SignableTypeRelayFinalization,
+	pairingtypes.SignableTypeRelayChunk,
+	pairingtypes.SignableTypeBadge,
+}
+
+func (ar AllowRules) Validate() error {
+	for _, rule := range ar {
+		if !lavaslices.Contains(signableTypes, rule.Type) {
+			return fmt.Errorf("invalid allow rule type %q, valid types: %v", rule.Type, signableTypes)
+		}
+		if rule.Type == pairingtypes.SignableTypeBadge && len(rule.Chains) > 0 {
+			return fmt.Errorf("badges are not signed per chain, remove the chains from the badge allow rule")
+		}
+	}
+	return nil
+}
+
+// Check returns an error if no rule allows signing the request
+func (ar AllowRules) Check(request *pairingtypes.SignRequest) error {
+	signableType := request.SignableType()
+	specId := request.GetRelaySessionData().GetSpecId()
+	for _, rule := range ar {
+		if rule.Type != signableType {
+			continue
+		}
+		if len(rule.Chains) == 0 || lavaslices.Contains(rule.Chains, specId) {
+			return nil
+		}
+	}
+	return fmt.Errorf("signing %q for chain %q is not allowed", signableType, specId)
+}
@@ -0,0 +1,70 @@
+package remotesigner
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+// AuditEntry records a single sign request, including denied requests
+type AuditEntry struct {
+	Time      time.Time `json:"time"`
+	Type      string    `json:"type"`
+	SpecId    string    `json:"spec_id,omitempty"`
+	Provider  string    `json:"provider,omitempty"`
+	Epoch     int64     `json:"epoch,omitempty"`
+	SessionId uint64    `json:"session_id,omitempty"`
+	RelayNum  uint64    `json:"relay_num,omitempty"`
+	CuSum     uint64    `json:"cu_sum,omitempty"`
+	DataHash  string    `json:"data_hash,omitempty"` // hash of the signed data, can be matched against a signature
+	Allowed   bool      `json:"allowed"`
+	Error     string    `json:"error,omitempty"`
+}
+
+func NewAuditEntry(request *pairingtypes.SignRequest, signable sigs.Signable, err error) AuditEntry {
+	entry := AuditEntry{
+		Time:    time.Now().UTC(),
+		Type:    request.SignableType(),
+		Allowed: err == nil,
+	}
+	if relaySession := request.GetRelaySessionData(); relaySession != nil {
+		entry.SpecId = relaySession.SpecId
+		entry.Provider = relaySession.Provider
+		entry.Epoch = relaySession.Epoch
+		entry.SessionId = relaySession.SessionId
+		entry.RelayNum = relaySession.RelayNum
+		entry.CuSum = relaySession.CuSum
+	}
+	if signable != nil {
+		entry.DataHash = hex.EncodeToString(sigs.HashMsg(signable.DataToSign()))
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	return entry
+}
+
+// AuditLog writes an entry per sign request as a json line
+type AuditLog struct {
+	lock    sync.Mutex
+	encoder *json.Encoder
+}
+
+func NewAuditLog(writer io.Writer) *AuditLog {
+	return &AuditLog{encoder: json.NewEncoder(writer)}
+}
+
+func (al *AuditLog) Record(entry AuditEntry) {
+	al.lock.Lock()
+	defer al.lock.Unlock()
+	err := al.encoder.Encode(entry)
+	if err != nil {
+		utils.LavaFormatError("failed writing remote signer audit log", err, utils.LogAttr("entry", entry))
+	}
+}
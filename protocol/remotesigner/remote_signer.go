@@ -0,0 +1,105 @@
+package remotesigner
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	SignTimeout    = 5 * time.Second
+	ConnectTimeout = 10 * time.Second
+	// replies are sent to the signer in full, so the limit must fit the biggest relay reply
+	MaxSignMessageSize = 1024 * 1024 * 512
+)
+
+// RemoteSigner is a sigs.Signer that delegates the signing to a signer process listening on a unix socket
+type RemoteSigner struct {
+	conn   *grpc.ClientConn
+	client pairingtypes.RemoteSignerClient
+	addr   sdk.AccAddress
+}
+
+func NewRemoteSigner(ctx context.Context, socketPath string) (*RemoteSigner, error) {
+	connectCtx, cancel := context.WithTimeout(ctx, ConnectTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(connectCtx, "unix://"+socketPath,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock(),
+		grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(MaxSignMessageSize)),
+	)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed connecting to remote signer", err, utils.LogAttr("socket", socketPath))
+	}
+	client := pairingtypes.NewRemoteSignerClient(conn)
+	res, err := client.SignerAddress(connectCtx, &pairingtypes.SignerAddressRequest{})
+	if err != nil {
+		conn.Close()
+		return nil, utils.LavaFormatError("failed getting remote signer address", err, utils.LogAttr("socket", socketPath))
+	}
+	addr, err := sdk.AccAddressFromBech32(res.Address)
+	if err != nil {
+		conn.Close()
+		return nil, utils.LavaFormatError("remote signer returned an invalid address", err, utils.LogAttr("address", res.Address))
+	}
+	return &RemoteSigner{conn: conn, client: client, addr: addr}, nil
+}
+
+func (rs *RemoteSigner) Sign(data sigs.Signable) ([]byte, error) {
+	request, err := pairingtypes.NewSignRequest(data)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), SignTimeout)
+	defer cancel()
+	res, err := rs.client.Sign(ctx, request)
+	if err != nil {
+		return nil, utils.LavaFormatWarning("remote signer failed signing", err, utils.LogAttr("type", request.SignableType()))
+	}
+	return res.Sig, nil
+}
+
+func (rs *RemoteSigner) Address() sdk.AccAddress {
+	return rs.addr
+}
+
+func (rs *RemoteSigner) Close() error {
+	return rs.conn.Close()
+}
+
+// GetSigner returns a remote signer if a socket path was configured, otherwise it loads the key from the keyring and signs in process
+func GetSigner(ctx context.Context, clientCtx client.Context, keyName string, remoteSignerSocket string) (sigs.Signer, error) {
+	if remoteSignerSocket != "" {
+		utils.LavaFormatInfo("using remote signer", utils.LogAttr("socket", remoteSignerSocket))
+		return NewRemoteSigner(ctx, remoteSignerSocket)
+	}
+	privKey, err := sigs.GetPrivKey(clientCtx, keyName)
+	if err != nil {
+		return nil, err
+	}
+	return sigs.NewPrivateKeySigner(privKey), nil
+}
+
+// GetSignerAddress returns the address of the remote signer if a socket path was configured, otherwise the address of the --from key,
+// without loading its private key
+func GetSignerAddress(ctx context.Context, clientCtx client.Context, remoteSignerSocket string) (sdk.AccAddress, error) {
+	if remoteSignerSocket != "" {
+		remoteSigner, err := NewRemoteSigner(ctx, remoteSignerSocket)
+		if err != nil {
+			return nil, err
+		}
+		defer remoteSigner.Close()
+		return remoteSigner.Address(), nil
+	}
+	if clientCtx.GetFromAddress().Empty() {
+		return nil, utils.LavaFormatError("no --from key or remote signer to get the address from", nil)
+	}
+	return clientCtx.GetFromAddress(), nil
+}
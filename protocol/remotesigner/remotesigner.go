@@ -0,0 +1,147 @@
+package remotesigner
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"net"
+	"os"
+	"os/signal"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+)
+
+const (
+	DefaultSocketPath = "/tmp/lava-signer.sock"
+	RulesFileFlag     = "rules"
+	AuditLogFlag      = "audit-log"
+	allowRulesKey     = "allow"
+)
+
+func CreateRemoteSignerCobraCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   `signer [socket-path] --from [key-name] --rules [rules-file] --audit-log [audit-log-path]`,
+		Short: `signer signs relays for rpcprovider and rpcconsumer processes, so they don't need to hold the signing key`,
+		Long: `signer listens on a unix socket and signs relays, replies, finalization data and badges for processes started with --remote-signer.
+the rules file is a yml file with a list of allow rules, each allowing a signable type (relay_session, relay_exchange, relay_finalization, relay_chunk, badge) optionally only for some chains:
+allow:
+  - type: relay_exchange
+    chains: [ETH1, LAV1]
+  - type: relay_finalization
+if no rules file is given relay signatures are allowed for all chains and badges are denied.
+every sign request is written to the audit log as a json line`,
+		Example: `signer --from alice
+signer /var/run/lava-signer.sock --from alice --rules signer_rules.yml --audit-log signer_audit.log`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			logLevel, err := cmd.Flags().GetString(flags.FlagLogLevel)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read log level flag", err)
+			}
+			utils.SetGlobalLoggingLevel(logLevel)
+
+			socketPath := DefaultSocketPath
+			if len(args) == 1 {
+				socketPath = args[0]
+			}
+
+			allowRules := DefaultAllowRules
+			rulesFile := viper.GetString(RulesFileFlag)
+			if rulesFile != "" {
+				rulesViper := viper.New()
+				rulesViper.SetConfigFile(rulesFile)
+				rulesViper.SetConfigType("yml")
+				if err := rulesViper.ReadInConfig(); err != nil {
+					return utils.LavaFormatError("failed reading rules file", err, utils.LogAttr("path", rulesFile))
+				}
+				allowRules = AllowRules{}
+				if err := rulesViper.UnmarshalKey(allowRulesKey, &allowRules); err != nil {
+					return utils.LavaFormatError("failed parsing rules file", err, utils.LogAttr("path", rulesFile))
+				}
+			}
+
+			auditLogWriter := os.Stdout
+			auditLogPath := viper.GetString(AuditLogFlag)
+			if auditLogPath != "" {
+				auditLogWriter, err = os.OpenFile(auditLogPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+				if err != nil {
+					return utils.LavaFormatError("failed opening audit log", err, utils.LogAttr("path", auditLogPath))
+				}
+				defer auditLogWriter.Close()
+			}
+
+			keyName, err := sigs.GetKeyName(clientCtx)
+			if err != nil {
+				utils.LavaFormatFatal("failed getting key name from clientCtx", err)
+			}
+			privKey, err := sigs.GetPrivKey(clientCtx, keyName)
+			if err != nil {
+				utils.LavaFormatFatal("failed getting private key from key name", err, utils.Attribute{Key: "keyName", Value: keyName})
+			}
+			server, err := NewServer(sigs.NewPrivateKeySigner(privKey), allowRules, NewAuditLog(auditLogWriter))
+			if err != nil {
+				return err
+			}
+			return server.Serve(cmd.Context(), socketPath)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.MarkFlagRequired(flags.FlagFrom)
+	cmd.Flags().String(RulesFileFlag, "", "yml file with the allow rules of the signer, defaults to allowing relay signatures")
+	cmd.Flags().String(AuditLogFlag, "", "file to append the audit log to, defaults to stdout")
+	cmd.Flags().String(flags.FlagLogLevel, "info", "log level")
+	viper.BindPFlag(RulesFileFlag, cmd.Flags().Lookup(RulesFileFlag))
+	viper.BindPFlag(AuditLogFlag, cmd.Flags().Lookup(AuditLogFlag))
+	return cmd
+}
+
+// Serve listens on a unix socket that only the current user can access, and serves sign requests until ctx is done or the process is interrupted
+func (s *Server) Serve(ctx context.Context, socketPath string) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	signalChan := make(chan os.Signal, 1)
+	signal.Notify(signalChan, os.Interrupt)
+	defer func() {
+		signal.Stop(signalChan)
+		cancel()
+	}()
+
+	// a socket left behind by a previous run blocks listening
+	if err := os.Remove(socketPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return utils.LavaFormatError("failed removing existing socket", err, utils.LogAttr("socket", socketPath))
+	}
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return utils.LavaFormatError("failed listening on socket", err, utils.LogAttr("socket", socketPath))
+	}
+	if err := os.Chmod(socketPath, 0o600); err != nil {
+		listener.Close()
+		return utils.LavaFormatError("failed setting socket permissions", err, utils.LogAttr("socket", socketPath))
+	}
+
+	grpcServer := grpc.NewServer(grpc.MaxRecvMsgSize(MaxSignMessageSize))
+	pairingtypes.RegisterRemoteSignerServer(grpcServer, s)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-signalChan:
+		}
+		grpcServer.GracefulStop()
+	}()
+
+	utils.LavaFormatInfo("remote signer listening", utils.LogAttr("socket", socketPath), utils.LogAttr("address", s.signer.Address().String()), utils.LogAttr("allowRules", s.allowRules))
+	return grpcServer.Serve(listener)
+}
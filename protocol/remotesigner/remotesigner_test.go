@@ -0,0 +1,164 @@
+package remotesigner
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/lavanet/lava/utils/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+type syncBuffer struct {
+	lock sync.Mutex
+	buf  bytes.Buffer
+}
+
+func (sb *syncBuffer) Write(p []byte) (int, error) {
+	sb.lock.Lock()
+	defer sb.lock.Unlock()
+	return sb.buf.Write(p)
+}
+
+func (sb *syncBuffer) entries(t *testing.T) []AuditEntry {
+	sb.lock.Lock()
+	defer sb.lock.Unlock()
+	entries := []AuditEntry{}
+	scanner := bufio.NewScanner(bytes.NewReader(sb.buf.Bytes()))
+	for scanner.Scan() {
+		entry := AuditEntry{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func startRemoteSigner(t *testing.T, allowRules AllowRules) (*RemoteSigner, *sigs.PrivateKeySigner, *syncBuffer) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	sk, _ := sigs.GenerateFloatingKey()
+	localSigner := sigs.NewPrivateKeySigner(sk)
+	auditBuffer := &syncBuffer{}
+	server, err := NewServer(localSigner, allowRules, NewAuditLog(auditBuffer))
+	require.NoError(t, err)
+	socketPath := filepath.Join(t.TempDir(), "signer.sock")
+	go server.Serve(ctx, socketPath)
+
+	remoteSigner, err := NewRemoteSigner(ctx, socketPath)
+	require.NoError(t, err)
+	t.Cleanup(func() { remoteSigner.Close() })
+	return remoteSigner, localSigner, auditBuffer
+}
+
+func testRelayRequest(specId string) pairingtypes.RelayRequest {
+	return pairingtypes.RelayRequest{
+		RelaySession: &pairingtypes.RelaySession{SpecId: specId, SessionId: 7, RelayNum: 2, Epoch: 20, CuSum: 10, Provider: "provider"},
+		RelayData:    &pairingtypes.RelayPrivateData{Data: []byte("data"), ApiInterface: "jsonrpc"},
+	}
+}
+
+func TestRemoteSigner(t *testing.T) {
+	remoteSigner, localSigner, auditBuffer := startRemoteSigner(t, DefaultAllowRules)
+	require.Equal(t, localSigner.Address(), remoteSigner.Address())
+
+	request := testRelayRequest("LAV1")
+	reply := pairingtypes.RelayReply{Data: []byte("reply"), LatestBlock: 100}
+	signables := []sigs.Signable{
+		*request.RelaySession,
+		pairingtypes.NewRelayExchange(request, reply),
+		pairingtypes.NewRelayFinalization(pairingtypes.NewRelayExchange(request, reply), localSigner.Address()),
+		pairingtypes.NewRelayChunkExchange(request, pairingtypes.RelayChunk{Index: 1, Data: []byte("chunk")}),
+	}
+	for _, signable := range signables {
+		remoteSig, err := remoteSigner.Sign(signable)
+		require.NoError(t, err)
+		localSig, err := localSigner.Sign(signable)
+		require.NoError(t, err)
+		require.Equal(t, localSig, remoteSig)
+	}
+
+	// badges are not allowed by default
+	_, err := remoteSigner.Sign(pairingtypes.Badge{Address: "badge", Epoch: 20})
+	require.Error(t, err)
+
+	entries := auditBuffer.entries(t)
+	require.Len(t, entries, 5)
+	for _, entry := range entries[:4] {
+		require.True(t, entry.Allowed)
+		require.Equal(t, "LAV1", entry.SpecId)
+		require.Equal(t, uint64(7), entry.SessionId)
+		require.NotEmpty(t, entry.DataHash)
+	}
+	require.Equal(t, pairingtypes.SignableTypeBadge, entries[4].Type)
+	require.False(t, entries[4].Allowed)
+	require.NotEmpty(t, entries[4].Error)
+}
+
+func TestRemoteSignerChainRules(t *testing.T) {
+	remoteSigner, _, auditBuffer := startRemoteSigner(t, AllowRules{
+		{Type: pairingtypes.SignableTypeRelayExchange, Chains: []string{"ETH1"}},
+		{Type: pairingtypes.SignableTypeBadge},
+	})
+
+	reply := pairingtypes.RelayReply{Data: []byte("reply")}
+	_, err := remoteSigner.Sign(pairingtypes.NewRelayExchange(testRelayRequest("ETH1"), reply))
+	require.NoError(t, err)
+	_, err = remoteSigner.Sign(pairingtypes.NewRelayExchange(testRelayRequest("LAV1"), reply))
+	require.Error(t, err)
+	// the type is not allowed for any chain
+	_, err = remoteSigner.Sign(*testRelayRequest("ETH1").RelaySession)
+	require.Error(t, err)
+	_, err = remoteSigner.Sign(pairingtypes.Badge{Address: "badge"})
+	require.NoError(t, err)
+
+	allowed := []bool{}
+	for _, entry := range auditBuffer.entries(t) {
+		allowed = append(allowed, entry.Allowed)
+	}
+	require.Equal(t, []bool{true, false, false, true}, allowed)
+}
+
+func TestAllowRulesValidate(t *testing.T) {
+	require.NoError(t, DefaultAllowRules.Validate())
+	require.Error(t, AllowRules{{Type: "transaction"}}.Validate())
+	require.Error(t, AllowRules{{Type: pairingtypes.SignableTypeBadge, Chains: []string{"LAV1"}}}.Validate())
+}
+
+func TestSignRequestMissingFields(t *testing.T) {
+	_, err := (&pairingtypes.SignRequest{}).ToSignable()
+	require.Error(t, err)
+	request := &pairingtypes.SignRequest{Signable: &pairingtypes.SignRequest_RelayExchange{RelayExchange: &pairingtypes.SignRelayExchange{Reply: &pairingtypes.RelayReply{}}}}
+	_, err = request.ToSignable()
+	require.Error(t, err)
+}
+
+func TestGetSignerAddress(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sk, _ := sigs.GenerateFloatingKey()
+	localSigner := sigs.NewPrivateKeySigner(sk)
+	server, err := NewServer(localSigner, DefaultAllowRules, NewAuditLog(&syncBuffer{}))
+	require.NoError(t, err)
+	socketPath := filepath.Join(t.TempDir(), "signer.sock")
+	go server.Serve(ctx, socketPath)
+
+	// the remote signer's address is used even when a --from key is set
+	_, fromAddress := sigs.GenerateFloatingKey()
+	clientCtx := client.Context{}.WithFromAddress(fromAddress)
+	addr, err := GetSignerAddress(ctx, clientCtx, socketPath)
+	require.NoError(t, err)
+	require.Equal(t, localSigner.Address(), addr)
+
+	addr, err = GetSignerAddress(ctx, clientCtx, "")
+	require.NoError(t, err)
+	require.Equal(t, fromAddress, addr)
+
+	_, err = GetSignerAddress(ctx, client.Context{}, "")
+	require.Error(t, err)
+}
@@ -0,0 +1,50 @@
+package remotesigner
+
+import (
+	"context"
+
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server signs the requests of relay processes with a key they don't have access to
+type Server struct {
+	pairingtypes.UnimplementedRemoteSignerServer
+	signer     sigs.Signer
+	allowRules AllowRules
+	auditLog   *AuditLog
+}
+
+func NewServer(signer sigs.Signer, allowRules AllowRules, auditLog *AuditLog) (*Server, error) {
+	err := allowRules.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return &Server{signer: signer, allowRules: allowRules, auditLog: auditLog}, nil
+}
+
+func (s *Server) Sign(ctx context.Context, request *pairingtypes.SignRequest) (*pairingtypes.SignResponse, error) {
+	signable, err := request.ToSignable()
+	if err != nil {
+		s.auditLog.Record(NewAuditEntry(request, nil, err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = s.allowRules.Check(request)
+	s.auditLog.Record(NewAuditEntry(request, signable, err))
+	if err != nil {
+		utils.LavaFormatWarning("denied sign request", err)
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	sig, err := s.signer.Sign(signable)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pairingtypes.SignResponse{Sig: sig}, nil
+}
+
+func (s *Server) SignerAddress(ctx context.Context, request *pairingtypes.SignerAddressRequest) (*pairingtypes.SignerAddressResponse, error) {
+	return &pairingtypes.SignerAddressResponse{Address: s.signer.Address().String()}, nil
+}
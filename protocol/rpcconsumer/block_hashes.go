@@ -0,0 +1,67 @@
+package rpcconsumer
+
+import (
+	"sync"
+
+	"github.com/lavanet/lava/protocol/common"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+// blockHashesDepth is how many blocks behind the newest one the reported block hashes are kept
+const blockHashesDepth = 256
+
+// blockHashes keeps the latest hash providers reported for non finalized blocks. cache entries of these blocks are
+// tagged with the hash, so a lookup returns only entries of the branch the providers currently report, and the cache
+// evicts the entries of a replaced branch when a reply with a different hash is stored
+type blockHashes struct {
+	lock   sync.RWMutex
+	hashes map[int64][]byte
+	newest int64
+}
+
+func newBlockHashes() *blockHashes {
+	return &blockHashes{hashes: map[int64][]byte{}}
+}
+
+// set stores the hash a provider reported for the block
+func (bh *blockHashes) set(block int64, hash []byte) {
+	if bh == nil || block < 0 || len(hash) == 0 {
+		return
+	}
+	bh.lock.Lock()
+	defer bh.lock.Unlock()
+	bh.hashes[block] = hash
+	if block > bh.newest {
+		bh.newest = block
+		for storedBlock := range bh.hashes {
+			if storedBlock < bh.newest-blockHashesDepth {
+				delete(bh.hashes, storedBlock)
+			}
+		}
+	}
+}
+
+// get returns the latest hash reported for the block, nil if there isn't one
+func (bh *blockHashes) get(block int64) []byte {
+	if bh == nil || block < 0 {
+		return nil
+	}
+	bh.lock.RLock()
+	defer bh.lock.RUnlock()
+	return bh.hashes[block]
+}
+
+// splitProviderBlockHash separates the block hash header the provider adds to the signed reply metadata. it isn't a node
+// header, so the spec's header filtering drops it, and it has to be added back after the filtered headers to verify the
+// reply's signature. the hash is only used once the signature is verified
+func splitProviderBlockHash(metadata []pairingtypes.Metadata) (blockHashHeader *pairingtypes.Metadata, nodeHeaders []pairingtypes.Metadata) {
+	nodeHeaders = make([]pairingtypes.Metadata, 0, len(metadata))
+	for idx := range metadata {
+		if metadata[idx].Name == common.PROVIDER_BLOCK_HASH_HEADER_NAME && blockHashHeader == nil {
+			blockHashHeader = &metadata[idx]
+			continue
+		}
+		nodeHeaders = append(nodeHeaders, metadata[idx])
+	}
+	return blockHashHeader, nodeHeaders
+}
@@ -0,0 +1,62 @@
+package rpcconsumer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavaprotocol"
+	"github.com/lavanet/lava/utils/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockHashes(t *testing.T) {
+	hashes := newBlockHashes()
+	require.Nil(t, hashes.get(100))
+
+	hashes.set(100, []byte("a"))
+	require.Equal(t, []byte("a"), hashes.get(100))
+	// a provider on another branch replaces the hash
+	hashes.set(100, []byte("b"))
+	require.Equal(t, []byte("b"), hashes.get(100))
+	// latest and other relative blocks aren't tagged
+	hashes.set(-2, []byte("c"))
+	require.Nil(t, hashes.get(-2))
+
+	// old blocks are dropped
+	hashes.set(100+blockHashesDepth+1, []byte("d"))
+	require.Nil(t, hashes.get(100))
+	require.Equal(t, []byte("d"), hashes.get(100+blockHashesDepth+1))
+
+}
+
+func TestSplitProviderBlockHash(t *testing.T) {
+	sk, provider := sigs.GenerateFloatingKey()
+	_, consumer := sigs.GenerateFloatingKey()
+	request := pairingtypes.RelayRequest{
+		RelaySession: &pairingtypes.RelaySession{SpecId: "LAV1", SessionId: 1, Provider: provider.String()},
+		RelayData:    &pairingtypes.RelayPrivateData{RequestBlock: 100, Data: []byte("data")},
+	}
+	// the provider signs the hash after the node headers, unsigned ignored headers are appended after signing
+	reply := &pairingtypes.RelayReply{Data: []byte("reply"), Metadata: []pairingtypes.Metadata{{Name: "node", Value: "x"}, {Name: common.PROVIDER_BLOCK_HASH_HEADER_NAME, Value: "0xab"}}}
+	reply, err := lavaprotocol.SignRelayResponse(consumer, request, sigs.NewPrivateKeySigner(sk), reply, false)
+	require.NoError(t, err)
+	reply.Metadata = append(reply.Metadata, pairingtypes.Metadata{Name: "ignored", Value: "y"})
+
+	blockHashHeader, nodeHeaders := splitProviderBlockHash(reply.Metadata)
+	require.NotNil(t, blockHashHeader)
+	require.Equal(t, "0xab", blockHashHeader.Value)
+	require.Equal(t, []pairingtypes.Metadata{{Name: "node", Value: "x"}, {Name: "ignored", Value: "y"}}, nodeHeaders)
+
+	// the hash is covered by the signature together with the filtered node headers
+	reply.Metadata = append(nodeHeaders[:1:1], *blockHashHeader)
+	require.NoError(t, lavaprotocol.VerifyRelayReply(context.Background(), reply, &request, provider.String()))
+	// a different hash doesn't verify
+	reply.Metadata = []pairingtypes.Metadata{nodeHeaders[0], {Name: common.PROVIDER_BLOCK_HASH_HEADER_NAME, Value: "0xcd"}}
+	require.Error(t, lavaprotocol.VerifyRelayReply(context.Background(), reply, &request, provider.String()))
+
+	blockHashHeader, nodeHeaders = splitProviderBlockHash(nodeHeaders)
+	require.Nil(t, blockHashHeader)
+	require.Len(t, nodeHeaders, 2)
+}
@@ -0,0 +1,52 @@
+package rpcconsumer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/protocol/common"
+)
+
+// hedgeLimiter caps the hedged relays sent every second and the compute units they spend in an epoch,
+// so slow providers can't make the consumer double its spending
+type hedgeLimiter struct {
+	lock              sync.Mutex
+	config            common.HedgeConfig
+	epochComputeUnits func() (epoch uint64, maxComputeUnits uint64)
+	secondStart       time.Time
+	secondHedges      uint
+	epoch             uint64
+	epochHedgedCu     uint64
+}
+
+func newHedgeLimiter(config common.HedgeConfig, epochComputeUnits func() (epoch uint64, maxComputeUnits uint64)) *hedgeLimiter {
+	return &hedgeLimiter{config: config, epochComputeUnits: epochComputeUnits}
+}
+
+// tryHedge returns true and counts the hedge if a relay with cu compute units can be hedged now
+func (hl *hedgeLimiter) tryHedge(cu uint64, now time.Time) bool {
+	if hl == nil {
+		return false
+	}
+	epoch, maxComputeUnits := hl.epochComputeUnits()
+	hl.lock.Lock()
+	defer hl.lock.Unlock()
+	if now.Sub(hl.secondStart) >= time.Second {
+		hl.secondStart = now
+		hl.secondHedges = 0
+	}
+	if epoch != hl.epoch {
+		hl.epoch = epoch
+		hl.epochHedgedCu = 0
+	}
+	if hl.config.MaxPerSecond > 0 && hl.secondHedges >= hl.config.MaxPerSecond {
+		return false
+	}
+	cuBudget := uint64(float64(maxComputeUnits) * hl.config.CuBudgetPercentage / 100)
+	if hl.epochHedgedCu+cu > cuBudget {
+		return false
+	}
+	hl.secondHedges++
+	hl.epochHedgedCu += cu
+	return true
+}
@@ -0,0 +1,35 @@
+package rpcconsumer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHedgeLimiter(t *testing.T) {
+	epoch := uint64(10)
+	epochComputeUnits := func() (uint64, uint64) { return epoch, 1000 }
+	limiter := newHedgeLimiter(common.HedgeConfig{Enabled: true, Percentile: 0.9, MaxPerSecond: 2, CuBudgetPercentage: 10}, epochComputeUnits)
+	now := time.Now()
+
+	// capped per second
+	require.True(t, limiter.tryHedge(10, now))
+	require.True(t, limiter.tryHedge(10, now.Add(100*time.Millisecond)))
+	require.False(t, limiter.tryHedge(10, now.Add(200*time.Millisecond)))
+	now = now.Add(time.Second)
+	require.True(t, limiter.tryHedge(10, now))
+
+	// capped by the epoch cu budget, 100 out of 1000
+	require.True(t, limiter.tryHedge(60, now.Add(time.Second)))
+	require.False(t, limiter.tryHedge(20, now.Add(2*time.Second)))
+	require.True(t, limiter.tryHedge(10, now.Add(3*time.Second)))
+
+	// a new epoch resets the budget
+	epoch++
+	require.True(t, limiter.tryHedge(90, now.Add(4*time.Second)))
+
+	var nilLimiter *hedgeLimiter
+	require.False(t, nilLimiter.tryHedge(1, now))
+}
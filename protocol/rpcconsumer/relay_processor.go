@@ -1,10 +1,13 @@
 package rpcconsumer
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 	"sync"
 
@@ -13,6 +16,7 @@ import (
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/jsonpath"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 )
 
@@ -51,6 +55,19 @@ func NewRelayProcessor(ctx context.Context, usedProviders *lavasession.UsedProvi
 	}
 }
 
+// NewSecureRelayProcessor creates a processor that answers only when a quorum of the providers returned matching responses
+func NewSecureRelayProcessor(ctx context.Context, usedProviders *lavasession.UsedProviders, secureRelay common.SecureRelayConfig, chainMessage chainlib.ChainMessage, consumerConsistency *ConsumerConsistency, dappID string, consumerIp string) *RelayProcessor {
+	relayProcessor := NewRelayProcessor(ctx, usedProviders, secureRelay.RequiredQuorum(), chainMessage, consumerConsistency, dappID, consumerIp)
+	ignoredPaths, err := secureRelay.IgnoredPaths()
+	if err != nil {
+		// configs are validated when the consumer starts
+		utils.LavaFormatError("invalid secure relay ignored fields", err, utils.LogAttr("api", chainMessage.GetApi().Name))
+	}
+	relayProcessor.secureRelay = secureRelay
+	relayProcessor.ignoredPaths = ignoredPaths
+	return relayProcessor
+}
+
 type RelayProcessor struct {
 	usedProviders          *lavasession.UsedProviders
 	responses              chan *relayResponse
@@ -65,6 +82,105 @@ type RelayProcessor struct {
 	consumerConsistency    *ConsumerConsistency
 	dappID                 string
 	consumerIp             string
+	secureRelay            common.SecureRelayConfig
+	ignoredPaths           []jsonpath.Path
+	hedges                 uint64
+	relayCancels           map[string]context.CancelFunc // pending relays by provider, so a hedged relay can drop the slower one
+	canceledRelays         map[string]struct{}
+}
+
+func (rp *RelayProcessor) IsSecure() bool {
+	if rp == nil {
+		return false
+	}
+	return rp.secureRelay.Enabled()
+}
+
+// WantedProviders returns how many distinct providers the next batch is sent to,
+// a secure relay keeps the amount of providers that answered or are still answering at its configured providers
+func (rp *RelayProcessor) WantedProviders() int {
+	if !rp.IsSecure() {
+		return 1
+	}
+	rp.lock.RLock()
+	nodeResults := len(rp.successResults) + len(rp.nodeResponseErrors.relayErrors)
+	rp.lock.RUnlock()
+	wanted := rp.secureRelay.Providers - nodeResults - rp.usedProviders.CurrentlyUsed()
+	if wanted < 1 {
+		// the providers didn't agree, ask one more
+		return 1
+	}
+	return wanted
+}
+
+// secureResponseKey returns the response of a result as it is compared, with ignored fields removed and keys sorted
+func (rp *RelayProcessor) secureResponseKey(result common.RelayResult) string {
+	if result.Reply == nil {
+		return ""
+	}
+	decoder := json.NewDecoder(bytes.NewReader(result.Reply.Data))
+	decoder.UseNumber() // big numbers must not lose precision
+	var parsed interface{}
+	if err := decoder.Decode(&parsed); err != nil {
+		return string(result.Reply.Data)
+	}
+	for _, path := range rp.ignoredPaths {
+		parsed, _ = path.Delete(parsed)
+	}
+	normalized, err := json.Marshal(parsed)
+	if err != nil {
+		return string(result.Reply.Data)
+	}
+	return string(normalized)
+}
+
+// only when locked, returns the node results grouped by matching responses, the largest group first
+func (rp *RelayProcessor) secureResultGroups() [][]common.RelayResult {
+	groupIndexes := map[string]int{}
+	groups := [][]common.RelayResult{}
+	for _, result := range rp.nodeResultsInner() {
+		key := rp.secureResponseKey(result)
+		idx, ok := groupIndexes[key]
+		if !ok {
+			idx = len(groups)
+			groupIndexes[key] = idx
+			groups = append(groups, []common.RelayResult{})
+		}
+		groups[idx] = append(groups[idx], result)
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		return len(groups[i]) > len(groups[j])
+	})
+	return groups
+}
+
+// only when locked
+func (rp *RelayProcessor) hasSecureQuorum() bool {
+	groups := rp.secureResultGroups()
+	return len(groups) > 0 && len(groups[0]) >= rp.requiredSuccesses
+}
+
+// SecureRelayDisagreements returns the result the quorum agreed on and the successful results of providers that disagreed with it
+func (rp *RelayProcessor) SecureRelayDisagreements() (quorumResult *common.RelayResult, disagreeing []common.RelayResult) {
+	if !rp.IsSecure() {
+		return nil, nil
+	}
+	rp.lock.RLock()
+	defer rp.lock.RUnlock()
+	groups := rp.secureResultGroups()
+	if len(groups) == 0 || len(groups[0]) < rp.requiredSuccesses {
+		return nil, nil
+	}
+	quorumResult = &groups[0][0]
+	quorumResult.Quorum = len(groups[0])
+	quorumKey := rp.secureResponseKey(*quorumResult)
+	for _, result := range rp.successResults {
+		// node errors can be transient, only different successful responses are disagreements
+		if result.Reply != nil && rp.secureResponseKey(result) != quorumKey {
+			disagreeing = append(disagreeing, result)
+		}
+	}
+	return quorumResult, disagreeing
 }
 
 func (rp *RelayProcessor) String() string {
@@ -130,6 +246,60 @@ func (rp *RelayProcessor) ProtocolErrors() uint64 {
 	return uint64(len(rp.protocolResponseErrors.relayErrors))
 }
 
+// AddHedge counts a relay sent to another provider because the first one was slow
+func (rp *RelayProcessor) AddHedge() {
+	rp.lock.Lock()
+	defer rp.lock.Unlock()
+	rp.hedges++
+}
+
+func (rp *RelayProcessor) Hedges() uint64 {
+	if rp == nil {
+		return 0
+	}
+	rp.lock.RLock()
+	defer rp.lock.RUnlock()
+	return rp.hedges
+}
+
+// SetRelayCancel registers the cancel of a relay sent to the provider until RemoveRelayCancel is called
+func (rp *RelayProcessor) SetRelayCancel(provider string, cancel context.CancelFunc) {
+	rp.lock.Lock()
+	defer rp.lock.Unlock()
+	if rp.relayCancels == nil {
+		rp.relayCancels = map[string]context.CancelFunc{}
+	}
+	rp.relayCancels[provider] = cancel
+}
+
+func (rp *RelayProcessor) RemoveRelayCancel(provider string) {
+	rp.lock.Lock()
+	defer rp.lock.Unlock()
+	delete(rp.relayCancels, provider)
+}
+
+// CancelPendingRelays cancels the relays that are still waiting for a reply, used once a hedged relay got its answer
+func (rp *RelayProcessor) CancelPendingRelays() {
+	rp.lock.Lock()
+	defer rp.lock.Unlock()
+	if rp.canceledRelays == nil {
+		rp.canceledRelays = map[string]struct{}{}
+	}
+	for provider, cancel := range rp.relayCancels {
+		rp.canceledRelays[provider] = struct{}{}
+		cancel()
+	}
+	rp.relayCancels = nil
+}
+
+// IsRelayCanceled returns true if the relay sent to the provider was canceled by CancelPendingRelays, its failure is not the provider's fault
+func (rp *RelayProcessor) IsRelayCanceled(provider string) bool {
+	rp.lock.RLock()
+	defer rp.lock.RUnlock()
+	_, ok := rp.canceledRelays[provider]
+	return ok
+}
+
 func (rp *RelayProcessor) SetResponse(response *relayResponse) {
 	if rp == nil {
 		return
@@ -160,6 +330,12 @@ func (rp *RelayProcessor) setValidResponse(response *relayResponse) {
 		// nil safe
 		rp.consumerConsistency.SetSeenBlock(blockSeen, rp.dappID, rp.consumerIp)
 	}
+	if response.relayResult.ReplyStream != nil {
+		// streamed replies are verified chunk by chunk while they are written to the client. the data isn't complete yet, so
+		// node errors are checked on the first chunk's status, and on the complete reply, aborting the stream if it is one
+		rp.successResults = append(rp.successResults, response.relayResult)
+		return
+	}
 	foundError, errorMessage := rp.chainMessage.CheckResponseError(response.relayResult.Reply.Data, response.relayResult.StatusCode)
 	if foundError {
 		// this is a node error, meaning we still didn't get a good response.
@@ -183,6 +359,13 @@ func (rp *RelayProcessor) setErrorResponse(response *relayResponse) {
 func (rp *RelayProcessor) checkEndProcessing(responsesCount int) bool {
 	rp.lock.RLock()
 	defer rp.lock.RUnlock()
+	if rp.secureRelay.Enabled() {
+		// a secure relay needs matching responses, not just enough of them
+		if rp.hasSecureQuorum() {
+			return true
+		}
+		return responsesCount >= rp.usedProviders.SessionsLatestBatch()
+	}
 	resultsCount := len(rp.successResults)
 	if resultsCount >= rp.requiredSuccesses {
 		// we have enough successes, we can return
@@ -224,6 +407,9 @@ func (rp *RelayProcessor) HasRequiredNodeResults() bool {
 	}
 	rp.lock.RLock()
 	defer rp.lock.RUnlock()
+	if rp.secureRelay.Enabled() {
+		return rp.hasSecureQuorum()
+	}
 	resultsCount := len(rp.successResults)
 	if resultsCount >= rp.requiredSuccesses {
 		return true
@@ -360,6 +546,20 @@ func (rp *RelayProcessor) ProcessingResult() (returnedResult *common.RelayResult
 
 	rp.lock.RLock()
 	defer rp.lock.RUnlock()
+	if rp.secureRelay.Enabled() {
+		groups := rp.secureResultGroups()
+		if len(groups) > 0 && len(groups[0]) >= rp.requiredSuccesses {
+			quorumResult := groups[0][0]
+			quorumResult.Quorum = len(groups[0])
+			return &quorumResult, nil
+		}
+		if len(groups) > 0 {
+			// providers answered but didn't agree, no answer can be trusted
+			largestGroup := len(groups[0])
+			return &common.RelayResult{StatusCode: http.StatusInternalServerError, ProviderInfo: common.ProviderInfo{ProviderAddress: strings.Join(allProvidersAddresses, ",")}},
+				utils.LavaFormatError("failed secure relay, providers did not reach a quorum", nil, utils.LogAttr("GUID", rp.guid), utils.LogAttr("quorum", rp.requiredSuccesses), utils.LogAttr("largestAgreement", largestGroup), utils.LogAttr("differentResponses", len(groups)))
+		}
+	}
 	// there are enough successes
 	successResultsCount := len(rp.successResults)
 	if successResultsCount >= rp.requiredSuccesses {
@@ -387,3 +387,186 @@ func TestRelayProcessorLatest(t *testing.T) {
 		// require.NotEqual(t, spectypes.LATEST_BLOCK, reqBlock) // disabled until we enable requested block modification again
 	})
 }
+
+func TestRelayProcessorHedge(t *testing.T) {
+	ctx := context.Background()
+	serverHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	specId := "LAV1"
+	chainParser, _, _, closeServer, _, err := chainlib.CreateChainLibMocks(ctx, specId, spectypes.APIInterfaceRest, serverHandler, "../../", nil)
+	if closeServer != nil {
+		defer closeServer()
+	}
+	require.NoError(t, err)
+	chainMsg, err := chainParser.ParseMsg("/cosmos/base/tendermint/v1beta1/blocks/17", nil, http.MethodGet, nil, extensionslib.ExtensionInfo{LatestBlock: 0})
+	require.NoError(t, err)
+	relayProcessor := NewRelayProcessor(ctx, lavasession.NewUsedProviders(nil), 1, chainMsg, nil, "", "")
+
+	// the slow provider got the relay first, then it was hedged to the fast one
+	slowCtx, slowCancel := context.WithCancel(context.Background())
+	relayProcessor.SetRelayCancel("lava@slow", slowCancel)
+	relayProcessor.AddHedge()
+	_, fastCancel := context.WithCancel(context.Background())
+	relayProcessor.SetRelayCancel("lava@fast", fastCancel)
+	usedProviders := relayProcessor.GetUsedProviders()
+	usedProviders.AddUsed(lavasession.ConsumerSessionsMap{"lava@slow": &lavasession.SessionInfo{}}, nil)
+	usedProviders.AddUsed(lavasession.ConsumerSessionsMap{"lava@fast": &lavasession.SessionInfo{}}, nil)
+
+	// the fast provider answered and its relay is done
+	relayProcessor.RemoveRelayCancel("lava@fast")
+	go sendSuccessResp(relayProcessor, "lava@fast", 0)
+	waitCtx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+	require.NoError(t, relayProcessor.WaitForResults(waitCtx))
+	require.True(t, relayProcessor.HasRequiredNodeResults())
+
+	relayProcessor.CancelPendingRelays()
+	require.ErrorIs(t, slowCtx.Err(), context.Canceled)
+	require.True(t, relayProcessor.IsRelayCanceled("lava@slow"))
+	require.False(t, relayProcessor.IsRelayCanceled("lava@fast"))
+	require.Equal(t, uint64(1), relayProcessor.Hedges())
+	require.Zero(t, relayProcessor.ProtocolErrors())
+	returnedResult, err := relayProcessor.ProcessingResult()
+	require.NoError(t, err)
+	require.Equal(t, "lava@fast", returnedResult.ProviderInfo.ProviderAddress)
+}
+
+func sendSuccessRespWithData(relayProcessor *RelayProcessor, provider string, delay time.Duration, data string) {
+	time.Sleep(delay)
+	relayProcessor.GetUsedProviders().RemoveUsed(provider, nil)
+	response := &relayResponse{
+		relayResult: common.RelayResult{
+			Request: &pairingtypes.RelayRequest{
+				RelaySession: &pairingtypes.RelaySession{},
+				RelayData:    &pairingtypes.RelayPrivateData{},
+			},
+			Reply:        &pairingtypes.RelayReply{Data: []byte(data)},
+			ProviderInfo: common.ProviderInfo{ProviderAddress: provider},
+			StatusCode:   http.StatusOK,
+		},
+		err: nil,
+	}
+	relayProcessor.SetResponse(response)
+}
+
+func TestRelayProcessorSecure(t *testing.T) {
+	ctx := context.Background()
+	serverHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	chainParser, _, _, closeServer, _, err := chainlib.CreateChainLibMocks(ctx, "LAV1", spectypes.APIInterfaceRest, serverHandler, "../../", nil)
+	if closeServer != nil {
+		defer closeServer()
+	}
+	require.NoError(t, err)
+	chainMsg, err := chainParser.ParseMsg("/cosmos/base/tendermint/v1beta1/blocks/17", nil, http.MethodGet, nil, extensionslib.ExtensionInfo{LatestBlock: 0})
+	require.NoError(t, err)
+	providers := []string{"lava@test", "lava@test2", "lava@test3"}
+
+	playbook := []struct {
+		name          string
+		config        common.SecureRelayConfig
+		responses     []string
+		success       bool
+		expected      string
+		quorum        int
+		disagreements []string
+	}{
+		{
+			name:      "all agree",
+			config:    common.SecureRelayConfig{Providers: 3, Quorum: 3},
+			responses: []string{`{"a":1,"b":2}`, `{"b":2,"a":1}`, `{"a":1,"b":2}`},
+			success:   true,
+			expected:  `{"a":1,"b":2}`,
+			quorum:    3,
+		},
+		{
+			name:          "majority agrees",
+			config:        common.SecureRelayConfig{Providers: 3},
+			responses:     []string{`{"balance":"100"}`, `{"balance":"999"}`, `{"balance":"100"}`},
+			success:       true,
+			expected:      `{"balance":"100"}`,
+			quorum:        2,
+			disagreements: []string{"lava@test2"},
+		},
+		{
+			name:      "no quorum",
+			config:    common.SecureRelayConfig{Providers: 3, Quorum: 3},
+			responses: []string{`{"balance":"100"}`, `{"balance":"999"}`, `{"balance":"100"}`},
+			success:   false,
+		},
+		{
+			name:      "ignored fields",
+			config:    common.SecureRelayConfig{Providers: 3, Quorum: 3, IgnoredFields: []string{"$.timestamp"}},
+			responses: []string{`{"balance":"100","timestamp":1}`, `{"balance":"100","timestamp":2}`, `{"balance":"100","timestamp":3}`},
+			success:   true,
+			expected:  `{"balance":"100","timestamp":1}`,
+			quorum:    3,
+		},
+		{
+			name:      "big numbers are compared exactly",
+			config:    common.SecureRelayConfig{Providers: 2},
+			responses: []string{`{"nonce":12345678901234567890}`, `{"nonce":12345678901234567891}`},
+			success:   false,
+		},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			require.NoError(t, play.config.Validate())
+			relayProcessor := NewSecureRelayProcessor(ctx, lavasession.NewUsedProviders(nil), play.config, chainMsg, nil, "", "")
+			require.True(t, relayProcessor.IsSecure())
+			require.Equal(t, play.config.Providers, relayProcessor.WantedProviders())
+			usedProviders := relayProcessor.GetUsedProviders()
+			consumerSessionsMap := lavasession.ConsumerSessionsMap{}
+			for _, provider := range providers[:len(play.responses)] {
+				consumerSessionsMap[provider] = &lavasession.SessionInfo{}
+			}
+			usedProviders.AddUsed(consumerSessionsMap, nil)
+			// all providers are in use, a retry asks one more
+			require.Equal(t, 1, relayProcessor.WantedProviders())
+			for idx, response := range play.responses {
+				go sendSuccessRespWithData(relayProcessor, providers[idx], time.Millisecond*time.Duration(5*(idx+1)), response)
+			}
+			waitCtx, cancel := context.WithTimeout(ctx, time.Millisecond*200)
+			defer cancel()
+			require.NoError(t, relayProcessor.WaitForResults(waitCtx))
+			relayProcessor.readExistingResponses()
+			require.Equal(t, play.success, relayProcessor.HasRequiredNodeResults())
+			returnedResult, err := relayProcessor.ProcessingResult()
+			if !play.success {
+				require.Error(t, err)
+				quorumResult, disagreeing := relayProcessor.SecureRelayDisagreements()
+				require.Nil(t, quorumResult)
+				require.Empty(t, disagreeing)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, play.expected, string(returnedResult.Reply.Data))
+			require.Equal(t, play.quorum, returnedResult.Quorum)
+			quorumResult, disagreeing := relayProcessor.SecureRelayDisagreements()
+			require.NotNil(t, quorumResult)
+			disagreeingProviders := []string{}
+			for _, result := range disagreeing {
+				disagreeingProviders = append(disagreeingProviders, result.ProviderInfo.ProviderAddress)
+			}
+			require.ElementsMatch(t, play.disagreements, disagreeingProviders)
+		})
+	}
+}
+
+func TestSecureRelayConfig(t *testing.T) {
+	require.False(t, common.SecureRelayConfig{}.Enabled())
+	require.Equal(t, 1, common.SecureRelayConfig{Providers: 1}.RequiredQuorum())
+	require.Equal(t, 2, common.SecureRelayConfig{Providers: 3}.RequiredQuorum())
+	require.Equal(t, 3, common.SecureRelayConfig{Providers: 4}.RequiredQuorum())
+	require.Equal(t, 4, common.SecureRelayConfig{Providers: 4, Quorum: 4}.RequiredQuorum())
+	require.Error(t, common.SecureRelayConfig{Providers: 3, Quorum: 4}.Validate())
+	require.Error(t, common.SecureRelayConfig{Providers: 3, Quorum: 1}.Validate())
+	require.Error(t, common.SecureRelayConfig{Providers: 4, Quorum: 2}.Validate())
+	require.NoError(t, common.SecureRelayConfig{Providers: 4, Quorum: 3}.Validate())
+	require.NoError(t, common.SecureRelayConfig{Providers: 3, Quorum: 2}.Validate())
+	require.Error(t, common.SecureRelayConfig{Providers: -1}.Validate())
+	require.Error(t, common.SecureRelayConfig{Providers: 3, IgnoredFields: []string{"timestamp"}}.Validate())
+	require.NoError(t, common.SecureRelayConfig{Providers: 3, IgnoredFields: []string{"$.result.timestamp"}}.Validate())
+}
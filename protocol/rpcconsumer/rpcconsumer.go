@@ -14,7 +14,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/config"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/app"
 	"github.com/lavanet/lava/protocol/chainlib"
 	"github.com/lavanet/lava/protocol/common"
@@ -23,6 +22,7 @@ import (
 	"github.com/lavanet/lava/protocol/metrics"
 	"github.com/lavanet/lava/protocol/performance"
 	"github.com/lavanet/lava/protocol/provideroptimizer"
+	"github.com/lavanet/lava/protocol/remotesigner"
 	"github.com/lavanet/lava/protocol/statetracker"
 	"github.com/lavanet/lava/protocol/statetracker/updaters"
 	"github.com/lavanet/lava/protocol/upgrade"
@@ -92,6 +92,7 @@ type ConsumerStateTrackerInf interface {
 	RegisterFinalizationConsensusForUpdates(context.Context, *lavaprotocol.FinalizationConsensus)
 	RegisterForDowntimeParamsUpdates(ctx context.Context, downtimeParamsUpdatable updaters.DowntimeParamsUpdatable) error
 	TxConflictDetection(ctx context.Context, finalizationConflict *conflicttypes.FinalizationConflict, responseConflict *conflicttypes.ResponseConflict, sameProviderConflict *conflicttypes.FinalizationConflict, conflictHandler common.ConflictHandlerInterface) error
+	TxLatestBlockConflictDetection(ctx context.Context, latestBlockConflict *conflicttypes.LatestBlockConflict, conflictHandler common.ConflictHandlerInterface) error
 	GetConsumerPolicy(ctx context.Context, consumerAddress, chainID string) (*plantypes.Policy, error)
 	GetProtocolVersion(ctx context.Context) (*updaters.ProtocolVersionResponse, error)
 	GetLatestVirtualEpoch() uint64
@@ -118,6 +119,7 @@ type rpcConsumerStartOptions struct {
 	cmdFlags                  common.ConsumerCmdFlags
 	stateShare                bool
 	refererData               *chainlib.RefererData
+	remoteSignerSocket        string
 }
 
 // spawns a new RPCConsumer server with all it's processes and internals ready for communications
@@ -148,21 +150,14 @@ func (rpcc *RPCConsumer) Start(ctx context.Context, options *rpcConsumerStartOpt
 	if err != nil {
 		utils.LavaFormatFatal("failed getting key name from clientCtx", err)
 	}
-	privKey, err := sigs.GetPrivKey(options.clientCtx, keyName)
+	signer, err := remotesigner.GetSigner(ctx, options.clientCtx, keyName, options.remoteSignerSocket)
 	if err != nil {
-		utils.LavaFormatFatal("failed getting private key from key name", err, utils.Attribute{Key: "keyName", Value: keyName})
+		utils.LavaFormatFatal("failed getting signer", err, utils.Attribute{Key: "keyName", Value: keyName})
 	}
-	clientKey, _ := options.clientCtx.Keyring.Key(keyName)
-
-	pubkey, err := clientKey.GetPubKey()
-	if err != nil {
-		utils.LavaFormatFatal("failed getting public key from key name", err, utils.Attribute{Key: "keyName", Value: keyName})
-	}
-
-	var consumerAddr sdk.AccAddress
-	err = consumerAddr.Unmarshal(pubkey.Address())
-	if err != nil {
-		utils.LavaFormatFatal("failed unmarshaling public address", err, utils.Attribute{Key: "keyName", Value: keyName}, utils.Attribute{Key: "pubkey", Value: pubkey.Address()})
+	// conflict txs are sent from the --from key, so it must be the signer's key
+	consumerAddr := options.clientCtx.GetFromAddress()
+	if !signer.Address().Equals(consumerAddr) {
+		utils.LavaFormatFatal("signer address does not match the consumer address", nil, utils.Attribute{Key: "signer", Value: signer.Address().String()}, utils.Attribute{Key: "consumer", Value: consumerAddr.String()})
 	}
 	// we want one provider optimizer per chain so we will store them for reuse across rpcEndpoints
 	chainMutexes := map[string]*sync.Mutex{}
@@ -291,7 +286,7 @@ func (rpcc *RPCConsumer) Start(ctx context.Context, options *rpcConsumerStartOpt
 			}
 			rpcConsumerServer := &RPCConsumerServer{}
 			utils.LavaFormatInfo("RPCConsumer Listening", utils.Attribute{Key: "endpoints", Value: rpcEndpoint.String()})
-			err = rpcConsumerServer.ServeRPCRequests(ctx, rpcEndpoint, rpcc.consumerStateTracker, chainParser, finalizationConsensus, consumerSessionManager, options.requiredResponses, privKey, lavaChainID, options.cache, rpcConsumerMetrics, consumerAddr, consumerConsistency, relaysMonitor, options.cmdFlags, options.stateShare, options.refererData, consumerReportsManager)
+			err = rpcConsumerServer.ServeRPCRequests(ctx, rpcEndpoint, rpcc.consumerStateTracker, chainParser, finalizationConsensus, consumerSessionManager, options.requiredResponses, signer, lavaChainID, options.cache, rpcConsumerMetrics, consumerAddr, consumerConsistency, relaysMonitor, options.cmdFlags, options.stateShare, options.refererData, consumerReportsManager)
 			if err != nil {
 				err = utils.LavaFormatError("failed serving rpc requests", err, utils.Attribute{Key: "endpoint", Value: rpcEndpoint})
 				errCh <- err
@@ -338,6 +333,14 @@ func ParseEndpoints(viper_endpoints *viper.Viper, geolocation uint64) (endpoints
 		if endpoint.HealthCheckPath == "" {
 			endpoint.HealthCheckPath = common.DEFAULT_HEALTH_PATH
 		}
+		for _, secureApi := range endpoint.SecureApis {
+			if secureApi.Api == "" {
+				return nil, utils.LavaFormatError("secure api config is missing the api name", nil, utils.LogAttr("endpoint", endpoint.Key()))
+			}
+			if err = secureApi.Validate(); err != nil {
+				return nil, utils.LavaFormatError("invalid secure api config", err, utils.LogAttr("endpoint", endpoint.Key()))
+			}
+		}
 	}
 	return
 }
@@ -481,7 +484,28 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 			txFactory = txFactory.WithGasAdjustment(viper.GetFloat64(flags.FlagGasAdjustment))
 
 			rpcConsumer := RPCConsumer{}
-			requiredResponses := 1 // TODO: handle secure flag, for a majority between providers
+			requiredResponses := 1 // secure relays set their own quorum, see common.SecureRelayConfig
+			secureRelay := common.SecureRelayConfig{}
+			if viper.GetBool(common.SecureFlag) {
+				secureRelay = common.SecureRelayConfig{
+					Providers:     viper.GetInt(common.SecureProvidersFlag),
+					Quorum:        viper.GetInt(common.SecureQuorumFlag),
+					IgnoredFields: viper.GetStringSlice(common.SecureIgnoredFieldsFlag),
+				}
+				if err := secureRelay.Validate(); err != nil {
+					utils.LavaFormatFatal("invalid secure relay flags", err)
+				}
+				utils.LavaFormatInfo("secure relays enabled", utils.LogAttr("providers", secureRelay.Providers), utils.LogAttr("quorum", secureRelay.RequiredQuorum()))
+			}
+			hedge := common.HedgeConfig{
+				Enabled:            viper.GetBool(common.HedgeRelaysFlag),
+				Percentile:         viper.GetFloat64(common.HedgePercentileFlag),
+				MaxPerSecond:       viper.GetUint(common.HedgeMaxPerSecondFlag),
+				CuBudgetPercentage: viper.GetFloat64(common.HedgeCuBudgetPercentageFlag),
+			}
+			if err := hedge.Validate(); err != nil {
+				utils.LavaFormatFatal("invalid hedge relay flags", err)
+			}
 			utils.LavaFormatInfo("lavap Binary Version: " + upgrade.GetCurrentVersion().ConsumerVersion)
 			rand.InitRandomSeed()
 
@@ -527,10 +551,13 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 				RelaysHealthIntervalFlag:    viper.GetDuration(common.RelayHealthIntervalFlag),
 				DebugRelays:                 viper.GetBool(DebugRelaysFlagName),
 				DisableConflictTransactions: viper.GetBool(common.DisableConflictTransactionsFlag),
+				RelayStreaming:              viper.GetBool(common.RelayStreamingFlag),
+				SecureRelay:                 secureRelay,
+				Hedge:                       hedge,
 			}
 
 			rpcConsumerSharedState := viper.GetBool(common.SharedStateFlag)
-			err = rpcConsumer.Start(ctx, &rpcConsumerStartOptions{txFactory, clientCtx, rpcEndpoints, requiredResponses, cache, strategyFlag.Strategy, maxConcurrentProviders, analyticsServerAddressess, consumerPropagatedFlags, rpcConsumerSharedState, refererData})
+			err = rpcConsumer.Start(ctx, &rpcConsumerStartOptions{txFactory, clientCtx, rpcEndpoints, requiredResponses, cache, strategyFlag.Strategy, maxConcurrentProviders, analyticsServerAddressess, consumerPropagatedFlags, rpcConsumerSharedState, refererData, viper.GetString(common.RemoteSignerFlag)})
 			return err
 		},
 	}
@@ -541,7 +568,14 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 	cmdRPCConsumer.Flags().Uint64(common.GeolocationFlag, 0, "geolocation to run from")
 	cmdRPCConsumer.Flags().Uint(common.MaximumConcurrentProvidersFlagName, 3, "max number of concurrent providers to communicate with")
 	cmdRPCConsumer.MarkFlagRequired(common.GeolocationFlag)
-	cmdRPCConsumer.Flags().Bool("secure", false, "secure sends reliability on every message")
+	cmdRPCConsumer.Flags().Bool(common.SecureFlag, false, "send every relay to several providers and answer only when a quorum of them return matching responses, apis can override it with secure-apis in the endpoints config")
+	cmdRPCConsumer.Flags().Int(common.SecureProvidersFlag, common.DefaultSecureProviders, "distinct providers every secure relay is sent to")
+	cmdRPCConsumer.Flags().Int(common.SecureQuorumFlag, 0, "matching responses a secure relay needs to answer, defaults to a majority of the providers")
+	cmdRPCConsumer.Flags().StringSlice(common.SecureIgnoredFieldsFlag, []string{}, "jsonpaths of response fields that are ignored when comparing the responses of secure relays")
+	cmdRPCConsumer.Flags().Bool(common.HedgeRelaysFlag, false, "send a relay to a second provider when the first one didn't answer within the hedge percentile latency, the first answer is used")
+	cmdRPCConsumer.Flags().Float64(common.HedgePercentileFlag, common.DefaultHedgePercentile, "latency percentile (0 to 1) of relays with the same compute units after which a relay is hedged")
+	cmdRPCConsumer.Flags().Uint(common.HedgeMaxPerSecondFlag, common.DefaultHedgeMaxPerSecond, "maximum hedged relays every second, 0 for no limit")
+	cmdRPCConsumer.Flags().Float64(common.HedgeCuBudgetPercentageFlag, common.DefaultHedgeCuBudgetPercentage, "percentage of the subscription compute units of an epoch that hedged relays can spend")
 	cmdRPCConsumer.Flags().Bool(lavasession.AllowInsecureConnectionToProvidersFlag, false, "allow insecure provider-dialing. used for development and testing")
 	cmdRPCConsumer.Flags().Bool(common.TestModeFlagName, false, "test mode causes rpcconsumer to send dummy data and print all of the metadata in it's listeners")
 	cmdRPCConsumer.Flags().String(performance.PprofAddressFlagName, "", "pprof server address, used for code profiling")
@@ -550,6 +584,7 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 	cmdRPCConsumer.Flags().String(metrics.MetricsListenFlagName, metrics.DisabledFlagOption, "the address to expose prometheus metrics (such as localhost:7779)")
 	cmdRPCConsumer.Flags().String(metrics.RelayServerFlagName, metrics.DisabledFlagOption, "the http address of the relay usage server api endpoint (example http://127.0.0.1:8080)")
 	cmdRPCConsumer.Flags().Bool(DebugRelaysFlagName, false, "adding debug information to relays")
+	cmdRPCConsumer.Flags().String(common.RemoteSignerFlag, "", "unix socket of a remote signer (lavap signer) to sign relays with, instead of loading the key from the keyring. The --from key must be the signer's key, since txs are sent from it")
 	// CORS related flags
 	cmdRPCConsumer.Flags().String(common.CorsCredentialsFlag, "true", "Set up CORS allowed credentials,default \"true\"")
 	cmdRPCConsumer.Flags().String(common.CorsHeadersFlag, "", "Set up CORS allowed headers, * for all, default simple cors specification headers")
@@ -565,6 +600,7 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 	cmdRPCConsumer.Flags().String(reportsSendBEAddress, "", "address to send reports to")
 	cmdRPCConsumer.Flags().BoolVar(&lavasession.DebugProbes, DebugProbesFlagName, false, "adding information to probes")
 	cmdRPCConsumer.Flags().Bool(common.DisableConflictTransactionsFlag, false, "disabling conflict transactions, this flag should not be used as it harms the network's data reliability and therefore the service.")
+	cmdRPCConsumer.Flags().Bool(common.RelayStreamingFlag, true, "receive big replies from providers in chunks and stream them to the client as they arrive, falls back to regular relays for providers that don't support it")
 	cmdRPCConsumer.Flags().DurationVar(&updaters.TimeOutForFetchingLavaBlocks, common.TimeOutForFetchingLavaBlocksFlag, time.Second*5, "setting the timeout for fetching lava blocks")
 
 	common.AddRollingLogConfig(cmdRPCConsumer)
@@ -5,10 +5,10 @@ import (
 	"errors"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	sdkerrors "cosmossdk.io/errors"
-	"github.com/btcsuite/btcd/btcec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/protocol/chainlib"
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
@@ -18,15 +18,20 @@ import (
 	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/protocol/metrics"
 	"github.com/lavanet/lava/protocol/performance"
+	"github.com/lavanet/lava/protocol/upgrade"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/protocopy"
 	"github.com/lavanet/lava/utils/rand"
+	"github.com/lavanet/lava/utils/sigs"
 	conflicttypes "github.com/lavanet/lava/x/conflict/types"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	plantypes "github.com/lavanet/lava/x/plans/types"
+	protocoltypes "github.com/lavanet/lava/x/protocol/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -35,7 +40,10 @@ const (
 	numberOfTimesToCheckCurrentlyUsedIsEmpty = 3
 )
 
-var NoResponseTimeout = sdkerrors.New("NoResponseTimeout Error", 685, "timeout occurred while waiting for providers responses")
+var (
+	NoResponseTimeout           = sdkerrors.New("NoResponseTimeout Error", 685, "timeout occurred while waiting for providers responses")
+	ResponseSchemaMismatchError = sdkerrors.New("ResponseSchemaMismatch Error", 686, "provider reply doesn't match the api response schema")
+)
 
 // implements Relay Sender interfaced and uses an ChainListener to get it called
 type RPCConsumerServer struct {
@@ -44,7 +52,7 @@ type RPCConsumerServer struct {
 	listenEndpoint         *lavasession.RPCEndpoint
 	rpcConsumerLogs        *metrics.RPCConsumerLogs
 	cache                  *performance.Cache
-	privKey                *btcec.PrivateKey
+	signer                 sigs.Signer
 	consumerTxSender       ConsumerTxSender
 	requiredResponses      int
 	finalizationConsensus  *lavaprotocol.FinalizationConsensus
@@ -55,6 +63,12 @@ type RPCConsumerServer struct {
 	relaysMonitor          *metrics.RelaysMonitor
 	reporter               metrics.Reporter
 	debugRelays            bool
+	relayStreaming         bool
+	secureRelay            common.SecureRelayConfig
+	hedge                  common.HedgeConfig
+	hedgeLimiter           *hedgeLimiter
+	blockHashes            *blockHashes
+	outdatedVersionLogged  atomic.Value // the chain's minimum consumer version the consumer was last found to be below
 }
 
 type relayResponse struct {
@@ -64,6 +78,7 @@ type relayResponse struct {
 
 type ConsumerTxSender interface {
 	TxConflictDetection(ctx context.Context, finalizationConflict *conflicttypes.FinalizationConflict, responseConflict *conflicttypes.ResponseConflict, sameProviderConflict *conflicttypes.FinalizationConflict, conflictHandler common.ConflictHandlerInterface) error
+	TxLatestBlockConflictDetection(ctx context.Context, latestBlockConflict *conflicttypes.LatestBlockConflict, conflictHandler common.ConflictHandlerInterface) error
 	GetConsumerPolicy(ctx context.Context, consumerAddress, chainID string) (*plantypes.Policy, error)
 	GetLatestVirtualEpoch() uint64
 }
@@ -74,7 +89,7 @@ func (rpccs *RPCConsumerServer) ServeRPCRequests(ctx context.Context, listenEndp
 	finalizationConsensus *lavaprotocol.FinalizationConsensus,
 	consumerSessionManager *lavasession.ConsumerSessionManager,
 	requiredResponses int,
-	privKey *btcec.PrivateKey,
+	signer sigs.Signer,
 	lavaChainID string,
 	cache *performance.Cache, // optional
 	rpcConsumerLogs *metrics.RPCConsumerLogs,
@@ -93,7 +108,7 @@ func (rpccs *RPCConsumerServer) ServeRPCRequests(ctx context.Context, listenEndp
 	rpccs.requiredResponses = requiredResponses
 	rpccs.lavaChainID = lavaChainID
 	rpccs.rpcConsumerLogs = rpcConsumerLogs
-	rpccs.privKey = privKey
+	rpccs.signer = signer
 	rpccs.chainParser = chainParser
 	rpccs.finalizationConsensus = finalizationConsensus
 	rpccs.ConsumerAddress = consumerAddress
@@ -101,6 +116,13 @@ func (rpccs *RPCConsumerServer) ServeRPCRequests(ctx context.Context, listenEndp
 	rpccs.sharedState = sharedState
 	rpccs.reporter = reporter
 	rpccs.debugRelays = cmdFlags.DebugRelays
+	rpccs.relayStreaming = cmdFlags.RelayStreaming
+	rpccs.secureRelay = cmdFlags.SecureRelay
+	rpccs.hedge = cmdFlags.Hedge
+	rpccs.blockHashes = newBlockHashes()
+	if rpccs.hedge.Enabled {
+		rpccs.hedgeLimiter = newHedgeLimiter(rpccs.hedge, consumerSessionManager.EpochComputeUnits)
+	}
 	chainListener, err := chainlib.NewChainListener(ctx, listenEndpoint, rpccs, rpccs, rpcConsumerLogs, chainParser, refererData)
 	if err != nil {
 		return err
@@ -271,6 +293,9 @@ func (rpccs *RPCConsumerServer) SendRelay(
 	// compares the response with other consumer wallets if defined so
 	// asynchronously sends data reliability if necessary
 
+	if err := rpccs.validateConsumerVersion(); err != nil {
+		return nil, err
+	}
 	// remove lava directive headers
 	metadata, directiveHeaders := rpccs.LavaDirectiveHeaders(metadata)
 	relaySentTime := time.Now()
@@ -312,10 +337,19 @@ func (rpccs *RPCConsumerServer) SendRelay(
 	}
 
 	returnedResult, err := relayProcessor.ProcessingResult()
-	rpccs.appendHeadersToRelayResult(ctx, returnedResult, relayProcessor.ProtocolErrors())
+	rpccs.appendHeadersToRelayResult(ctx, returnedResult, relayProcessor.ProtocolErrors(), relayProcessor.Hedges())
 	if err != nil {
 		return returnedResult, utils.LavaFormatError("failed processing responses from providers", err, utils.Attribute{Key: "GUID", Value: ctx}, utils.LogAttr("endpoint", rpccs.listenEndpoint.Key()))
 	}
+	if relayProcessor.IsSecure() {
+		// same as data reliability, the client context might be cancelled when the relay returns
+		guid, found := utils.GetUniqueIdentifier(ctx)
+		reportContext := context.Background()
+		if found {
+			reportContext = utils.WithUniqueIdentifier(reportContext, guid)
+		}
+		go rpccs.reportSecureRelayDisagreements(reportContext, chainMessage, relayProcessor)
+	}
 	if analytics != nil {
 		currentLatency := time.Since(relaySentTime)
 		analytics.Latency = currentLatency.Milliseconds()
@@ -325,11 +359,37 @@ func (rpccs *RPCConsumerServer) SendRelay(
 	return returnedResult, nil
 }
 
+// validateConsumerVersion refuses relays when the consumer's version is below the chain's minimum consumer version.
+// The required upgrade is logged once per minimum version, not on every relay
+func (rpccs *RPCConsumerServer) validateConsumerVersion() error {
+	chainVersion := rpccs.consumerSessionManager.GetChainVersion()
+	if chainVersion == nil {
+		return nil
+	}
+	currentVersion := upgrade.GetCurrentVersion().ConsumerVersion
+	if !protocoltypes.IsVersionLower(currentVersion, chainVersion.ConsumerMin) {
+		return nil
+	}
+	if rpccs.outdatedVersionLogged.Swap(chainVersion.ConsumerMin) != chainVersion.ConsumerMin {
+		utils.LavaFormatError("consumer version is lower than the chain's minimum consumer version, upgrade is required", lavasession.ConsumerVersionTooLowError,
+			utils.LogAttr("chainID", rpccs.listenEndpoint.ChainID),
+			utils.LogAttr("version", currentVersion),
+			utils.LogAttr("minVersion", chainVersion.ConsumerMin),
+		)
+	}
+	return sdkerrors.Wrapf(lavasession.ConsumerVersionTooLowError, "chain %s requires consumer version %s, running %s", rpccs.listenEndpoint.ChainID, chainVersion.ConsumerMin, currentVersion)
+}
+
 func (rpccs *RPCConsumerServer) ProcessRelaySend(ctx context.Context, directiveHeaders map[string]string, chainMessage chainlib.ChainMessage, relayRequestData *pairingtypes.RelayPrivateData, dappID string, consumerIp string) (*RelayProcessor, error) {
 	// make sure all of the child contexts are cancelled when we exit
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
-	relayProcessor := NewRelayProcessor(ctx, lavasession.NewUsedProviders(directiveHeaders), rpccs.requiredResponses, chainMessage, rpccs.consumerConsistency, dappID, consumerIp)
+	var relayProcessor *RelayProcessor
+	if secureRelay := rpccs.secureRelayConfig(chainMessage); secureRelay.Enabled() {
+		relayProcessor = NewSecureRelayProcessor(ctx, lavasession.NewUsedProviders(directiveHeaders), secureRelay, chainMessage, rpccs.consumerConsistency, dappID, consumerIp)
+	} else {
+		relayProcessor = NewRelayProcessor(ctx, lavasession.NewUsedProviders(directiveHeaders), rpccs.requiredResponses, chainMessage, rpccs.consumerConsistency, dappID, consumerIp)
+	}
 	var err error
 	// try sending a relay 3 times. if failed return the error
 	for retryFirstRelayAttempt := 0; retryFirstRelayAttempt < SendRelayAttempts; retryFirstRelayAttempt++ {
@@ -346,6 +406,13 @@ func (rpccs *RPCConsumerServer) ProcessRelaySend(ctx context.Context, directiveH
 	// create the processing timeout prior to entering the method so it wont reset every time
 	processingCtx, cancel := context.WithTimeout(ctx, processingTimeout)
 	defer cancel()
+	// a relay slower than most relays of its compute units is sent once more to another provider, nil channels never fire
+	var hedgeTimerChan <-chan time.Time
+	if hedgeDeadline, ok := rpccs.hedgeDeadline(chainMessage, relayProcessor, relayTimeout); ok {
+		hedgeTimer := time.NewTimer(hedgeDeadline)
+		defer hedgeTimer.Stop()
+		hedgeTimerChan = hedgeTimer.C
+	}
 
 	readResultsFromProcessor := func() {
 		// ProcessResults is reading responses while blocking until the conditions are met
@@ -382,6 +449,10 @@ func (rpccs *RPCConsumerServer) ProcessRelaySend(ctx context.Context, directiveH
 		select {
 		case success := <-gotResults:
 			if success {
+				if relayProcessor.Hedges() > 0 {
+					// we have the fastest answer, the other provider doesn't need to keep working on it
+					relayProcessor.CancelPendingRelays()
+				}
 				return relayProcessor, nil
 			}
 			err := rpccs.sendRelayToProvider(ctx, chainMessage, relayRequestData, dappID, consumerIp, relayProcessor)
@@ -393,6 +464,17 @@ func (rpccs *RPCConsumerServer) ProcessRelaySend(ctx context.Context, directiveH
 				err := rpccs.sendRelayToProvider(ctx, chainMessage, relayRequestData, dappID, consumerIp, relayProcessor)
 				go validateReturnCondition(err)
 			}
+		case <-hedgeTimerChan:
+			hedgeTimerChan = nil // a relay is hedged once
+			if relayProcessor.HasResults() || !rpccs.hedgeLimiter.tryHedge(chainlib.GetComputeUnits(chainMessage), time.Now()) {
+				continue
+			}
+			err := rpccs.sendRelayToProvider(ctx, chainMessage, relayRequestData, dappID, consumerIp, relayProcessor)
+			if err != nil {
+				utils.LavaFormatDebug("failed sending hedged relay", utils.LogAttr("error", err), utils.LogAttr("GUID", ctx))
+				continue
+			}
+			relayProcessor.AddHedge()
 		case returnErr := <-returnCondition:
 			// we use this channel because there could be a race condition between us releasing the provider and about to send the return
 			// to an error happening on another relay processor's routine. this can cause an error that returns to the user
@@ -442,16 +524,19 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 		sharedStateId = rpccs.consumerConsistency.Key(dappID, consumerIp) // use same key as we use for consistency, (for better consistency :-D)
 	}
 
-	privKey := rpccs.privKey
+	signer := rpccs.signer
 	chainID := rpccs.listenEndpoint.ChainID
 	lavaChainID := rpccs.lavaChainID
 
 	// Get Session. we get session here so we can use the epoch in the callbacks
 	reqBlock, _ := chainMessage.RequestedBlock()
 
+	// secure relays are answered only by a quorum of providers, never by a single cached response
+	useCache := rpccs.cache.CacheActive() && !relayProcessor.IsSecure()
+
 	// try using cache before sending relay
 	var cacheError error
-	if rpccs.cache.CacheActive() { // use cache only if its defined.
+	if useCache { // use cache only if its defined.
 		if reqBlock != spectypes.NOT_APPLICABLE || !chainMessage.GetForceCacheRefresh() {
 			var cacheReply *pairingtypes.CacheRelayReply
 			hashKey, outputFormatter, err := chainlib.HashCacheRequest(relayRequestData, chainID)
@@ -463,11 +548,11 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 					RequestHash:    hashKey,
 					RequestedBlock: relayRequestData.RequestBlock,
 					ChainId:        chainID,
-					BlockHash:      nil,
+					BlockHash:      rpccs.blockHashes.get(relayRequestData.RequestBlock),
 					Finalized:      false,
 					SharedStateId:  sharedStateId,
 					SeenBlock:      relayRequestData.SeenBlock,
-				}) // caching in the portal doesn't care about hashes, and we don't have data on finalization yet
+				}) // the block hash is the latest one providers reported, and we don't have data on finalization yet
 				cancel()
 				reply := cacheReply.GetReply()
 
@@ -521,7 +606,7 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 	addon := chainlib.GetAddon(chainMessage)
 	extensions := chainMessage.GetExtensions()
 	usedProviders := relayProcessor.GetUsedProviders()
-	sessions, err := rpccs.consumerSessionManager.GetSessions(ctx, chainlib.GetComputeUnits(chainMessage), usedProviders, reqBlock, addon, extensions, chainlib.GetStateful(chainMessage), virtualEpoch)
+	sessions, err := rpccs.consumerSessionManager.GetSessionsFromProviders(ctx, relayProcessor.WantedProviders(), chainlib.GetComputeUnits(chainMessage), usedProviders, reqBlock, addon, extensions, chainlib.GetStateful(chainMessage), virtualEpoch)
 	if err != nil {
 		if lavasession.PairingListEmptyError.Is(err) && (addon != "" || len(extensions) > 0) {
 			// if we have no providers for a specific addon or extension, return an indicative error
@@ -544,17 +629,23 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 				ConflictHandler: sessionInfo.Session.Parent,
 			}
 			var errResponse error
+			// a streamed reply is returned to the relay processor when it starts, so the client gets the chunks as they arrive
+			streamStarted := false
 			goroutineCtx, goroutineCtxCancel := context.WithCancel(context.Background())
 			guid, found := utils.GetUniqueIdentifier(ctx)
 			if found {
 				goroutineCtx = utils.WithUniqueIdentifier(goroutineCtx, guid)
 			}
+			relayProcessor.SetRelayCancel(providerPublicAddress, goroutineCtxCancel)
 			defer func() {
-				// Return response
-				relayProcessor.SetResponse(&relayResponse{
-					relayResult: *localRelayResult,
-					err:         errResponse,
-				})
+				relayProcessor.RemoveRelayCancel(providerPublicAddress)
+				// Return response, a canceled relay was dropped by us and its error is not a result
+				if !streamStarted && (errResponse == nil || !relayProcessor.IsRelayCanceled(providerPublicAddress)) {
+					relayProcessor.SetResponse(&relayResponse{
+						relayResult: *localRelayResult,
+						err:         errResponse,
+					})
+				}
 
 				// Close context
 				goroutineCtxCancel()
@@ -567,7 +658,7 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 			epoch := sessionInfo.Epoch
 			reportedProviders := sessionInfo.ReportedProviders
 
-			relayRequest, errResponse := lavaprotocol.ConstructRelayRequest(goroutineCtx, privKey, lavaChainID, chainID, &localRelayRequestData, providerPublicAddress, singleConsumerSession, int64(epoch), reportedProviders)
+			relayRequest, errResponse := lavaprotocol.ConstructRelayRequest(goroutineCtx, signer, lavaChainID, chainID, &localRelayRequestData, providerPublicAddress, singleConsumerSession, int64(epoch), reportedProviders)
 			if errResponse != nil {
 				utils.LavaFormatError("Failed ConstructRelayRequest", errResponse, utils.LogAttr("Request data", localRelayRequestData))
 				return
@@ -586,7 +677,26 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 			// unique per dappId and ip
 			consumerToken := common.GetUniqueToken(dappID, consumerIp)
 			processingTimeout, relayTimeout := rpccs.getProcessingTimeout(chainMessage)
-			relayLatency, errResponse, backoff := rpccs.relayInner(goroutineCtx, singleConsumerSession, localRelayResult, processingTimeout, chainMessage, consumerToken)
+			var onStreamStart func()
+			if rpccs.requiredResponses == 1 && !relayProcessor.IsSecure() {
+				// with more required responses the replies are compared, so they are handed over only once complete
+				onStreamStart = func() {
+					streamStarted = true
+					relayProcessor.RemoveRelayCancel(providerPublicAddress) // a started stream is the answer, it must not be canceled
+					relayProcessor.SetResponse(&relayResponse{
+						relayResult: *localRelayResult,
+						err:         nil,
+					})
+				}
+			}
+			relayLatency, errResponse, backoff := rpccs.relayInner(goroutineCtx, singleConsumerSession, localRelayResult, processingTimeout, chainMessage, consumerToken, onStreamStart)
+			if errResponse != nil && relayProcessor.IsRelayCanceled(providerPublicAddress) {
+				errReport := rpccs.consumerSessionManager.OnSessionCanceled(singleConsumerSession)
+				if errReport != nil {
+					utils.LavaFormatError("failed relay onSessionCanceled errored", errReport, utils.Attribute{Key: "GUID", Value: goroutineCtx}, utils.Attribute{Key: "original error", Value: errResponse.Error()})
+				}
+				return
+			}
 			if errResponse != nil {
 				failRelaySession := func(origErr error, backoff_ bool) {
 					backOffDuration := 0 * time.Second
@@ -634,7 +744,7 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 
 			errResponse = rpccs.consumerSessionManager.OnSessionDone(singleConsumerSession, latestBlock, chainlib.GetComputeUnits(chainMessage), relayLatency, singleConsumerSession.CalculateExpectedLatency(relayTimeout), expectedBH, numOfProviders, pairingAddressesLen, chainMessage.GetApi().Category.HangingApi) // session done successfully
 
-			if rpccs.cache.CacheActive() && rpcclient.ValidateStatusCodes(localRelayResult.StatusCode, true) == nil {
+			if useCache && rpcclient.ValidateStatusCodes(localRelayResult.StatusCode, true) == nil {
 				// copy reply data so if it changes it doesn't panic mid async send
 				copyReply := &pairingtypes.RelayReply{}
 				copyReplyErr := protocopy.DeepCopyProtoObject(localRelayResult.Reply, copyReply)
@@ -662,13 +772,18 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 					new_ctx, cancel := context.WithTimeout(new_ctx, common.DataReliabilityTimeoutIncrease)
 					defer cancel()
 					_, averageBlockTime, _, _ := rpccs.chainParser.ChainBlockStats()
+					var blockHash []byte
+					if !localRelayResult.Finalized && requestedBlock >= 0 {
+						blockHash = localRelayResult.BlockHash
+						rpccs.blockHashes.set(requestedBlock, blockHash)
+					}
 
 					err2 := rpccs.cache.SetEntry(new_ctx, &pairingtypes.RelayCacheSet{
 						RequestHash:      hashKey,
 						ChainId:          chainID,
 						RequestedBlock:   requestedBlock,
 						SeenBlock:        seenBlock,
-						BlockHash:        nil, // consumer cache doesn't care about block hashes
+						BlockHash:        blockHash, // lets the cache evict the non finalized entry when another reply shows the chain forked
 						Response:         copyReply,
 						Finalized:        localRelayResult.Finalized,
 						OptionalMetadata: nil,
@@ -687,11 +802,18 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 	return nil
 }
 
-func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSession *lavasession.SingleConsumerSession, relayResult *common.RelayResult, relayTimeout time.Duration, chainMessage chainlib.ChainMessage, consumerToken string) (relayLatency time.Duration, err error, needsBackoff bool) {
+func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSession *lavasession.SingleConsumerSession, relayResult *common.RelayResult, relayTimeout time.Duration, chainMessage chainlib.ChainMessage, consumerToken string, onStreamStart func()) (relayLatency time.Duration, err error, needsBackoff bool) {
 	existingSessionLatestBlock := singleConsumerSession.LatestBlock // we read it now because singleConsumerSession is locked, and later it's not
-	endpointClient := *singleConsumerSession.Endpoint.Client
+	endpoint := singleConsumerSession.Endpoint
+	endpointClient := *endpoint.Client
 	providerPublicAddress := relayResult.ProviderInfo.ProviderAddress
 	relayRequest := relayResult.Request
+	defer func() {
+		// a streamed reply is complete only after the whole reply was verified
+		if relayResult.ReplyStream != nil {
+			relayResult.ReplyStream.Close(err)
+		}
+	}()
 	callRelay := func() (reply *pairingtypes.RelayReply, relayLatency time.Duration, err error, backoff bool) {
 		relaySentTime := time.Now()
 		connectCtx, connectCtxCancel := context.WithTimeout(ctx, relayTimeout)
@@ -699,7 +821,16 @@ func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSe
 		connectCtx = metadata.NewOutgoingContext(connectCtx, metadataAdd)
 		defer connectCtxCancel()
 		var trailer metadata.MD
-		reply, err = endpointClient.Relay(connectCtx, relayRequest, grpc.Trailer(&trailer))
+		if rpccs.relayStreaming && endpoint.SupportsRelayStream() {
+			reply, err = rpccs.relayStream(connectCtx, endpointClient, relayResult, chainMessage, onStreamStart, grpc.Trailer(&trailer))
+			if status.Code(err) == codes.Unimplemented {
+				// provider runs a version without relay streaming
+				endpoint.SetRelayStreamUnsupported()
+				reply, err = endpointClient.Relay(connectCtx, relayRequest, grpc.Trailer(&trailer))
+			}
+		} else {
+			reply, err = endpointClient.Relay(connectCtx, relayRequest, grpc.Trailer(&trailer))
+		}
 		statuses := trailer.Get(common.StatusCodeMetadataKey)
 		if len(statuses) > 0 {
 			codeNum, errStatus := strconv.Atoi(statuses[0])
@@ -744,18 +875,48 @@ func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSe
 	lavaprotocol.UpdateRequestedBlock(relayRequest.RelayData, reply) // update relay request requestedBlock to the provided one in case it was arbitrary
 	_, _, blockDistanceForFinalizedData, _ := rpccs.chainParser.ChainBlockStats()
 	finalized := spectypes.IsFinalizedBlock(relayRequest.RelayData.RequestBlock, reply.LatestBlock, blockDistanceForFinalizedData)
-	filteredHeaders, _, ignoredHeaders := rpccs.chainParser.HandleHeaders(reply.Metadata, chainMessage.GetApiCollection(), spectypes.Header_pass_reply)
+	blockHashHeader, nodeHeaders := splitProviderBlockHash(reply.Metadata)
+	filteredHeaders, _, ignoredHeaders := rpccs.chainParser.HandleHeaders(nodeHeaders, chainMessage.GetApiCollection(), spectypes.Header_pass_reply)
 	reply.Metadata = filteredHeaders
+	if blockHashHeader != nil {
+		// the provider signs the block hash after the filtered headers
+		reply.Metadata = append(filteredHeaders[:len(filteredHeaders):len(filteredHeaders)], *blockHashHeader)
+	}
 	err = lavaprotocol.VerifyRelayReply(ctx, reply, relayRequest, providerPublicAddress)
 	if err != nil {
 		return 0, err, false
 	}
-	reply.Metadata = append(reply.Metadata, ignoredHeaders...)
-	// TODO: response data sanity, check its under an expected format add that format to spec
+	if blockHashHeader != nil && !finalized {
+		relayResult.BlockHash = []byte(blockHashHeader.Value)
+	}
+	reply.Metadata = append(filteredHeaders, ignoredHeaders...)
+	err = rpccs.verifyResponseSchema(chainMessage, reply, relayResult.StatusCode)
+	if err != nil {
+		return 0, err, false
+	}
+	if relayResult.ReplyStream != nil {
+		// the client already got the status and the first chunks of a streamed reply, so a node error aborts it
+		if foundError, errorMessage := chainMessage.CheckResponseError(reply.Data, relayResult.StatusCode); foundError {
+			return 0, utils.LavaFormatWarning("streamed reply is a node error", lavaprotocol.ProviderChunkedReplyError, utils.LogAttr("GUID", ctx), utils.LogAttr("provider", providerPublicAddress), utils.LogAttr("error", errorMessage)), false
+		}
+	}
 	enabled, _ := rpccs.chainParser.DataReliabilityParams()
 	if enabled {
-		// TODO: DETECTION instead of existingSessionLatestBlock, we need proof of last reply to send the previous reply and the current reply
 		finalizedBlocks, finalizationConflict, err := lavaprotocol.VerifyFinalizationData(reply, relayRequest, providerPublicAddress, rpccs.ConsumerAddress, existingSessionLatestBlock, blockDistanceForFinalizedData)
+		if err == nil || lavaprotocol.ProviderFinzalizationDataAccountabilityError.Is(err) {
+			// the finalization data is signed by the provider, so together with its previous reply in this session it can prove a contradiction
+			currentRelay := lavaprotocol.ConstructFinalizationProof(relayRequest, reply)
+			if latestBlockConflict := lavaprotocol.FindLatestBlockConflict(singleConsumerSession.LatestRelay, currentRelay); latestBlockConflict != nil {
+				go rpccs.consumerTxSender.TxLatestBlockConflictDetection(ctx, latestBlockConflict, singleConsumerSession.Parent)
+				return 0, utils.LavaFormatError("Simulation: provider contradicted its previous reply in the session", lavaprotocol.ProviderFinzalizationDataAccountabilityError,
+					utils.LogAttr("contradiction", latestBlockConflict.Contradiction()),
+					utils.LogAttr("provider", providerPublicAddress),
+				), false
+			}
+			if err == nil {
+				singleConsumerSession.LatestRelay = currentRelay
+			}
+		}
 		if err != nil {
 			if lavaprotocol.ProviderFinzalizationDataAccountabilityError.Is(err) && finalizationConflict != nil {
 				go rpccs.consumerTxSender.TxConflictDetection(ctx, finalizationConflict, nil, nil, singleConsumerSession.Parent)
@@ -773,6 +934,81 @@ func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSe
 	return relayLatency, nil, false
 }
 
+// relayStream sends the relay on a RelayStream call, verifying the reply chunks as they arrive and appending them to the relay result's reply stream.
+// returns the final reply with the reassembled data, so it can be verified like a regular reply
+func (rpccs *RPCConsumerServer) relayStream(ctx context.Context, endpointClient pairingtypes.RelayerClient, relayResult *common.RelayResult, chainMessage chainlib.ChainMessage, onStreamStart func(), opts ...grpc.CallOption) (*pairingtypes.RelayReply, error) {
+	relayRequest := relayResult.Request
+	providerPublicAddress := relayResult.ProviderInfo.ProviderAddress
+	streamClient, err := endpointClient.RelayStream(ctx, relayRequest, opts...)
+	if err != nil {
+		return nil, err
+	}
+	var data []byte
+	var streamedMetadata []pairingtypes.Metadata
+	chunksCount := uint64(0)
+	for {
+		streamReply, err := streamClient.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if chunk := streamReply.GetChunk(); chunk != nil {
+			err = lavaprotocol.VerifyRelayChunk(ctx, chunk, chunksCount, relayRequest, providerPublicAddress)
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, chunk.Data...)
+			chunksCount++
+			if chunksCount == 1 {
+				streamedMetadata = streamReply.Metadata
+				if streamReply.StatusCode != 0 {
+					relayResult.StatusCode = int(streamReply.StatusCode)
+				}
+				// a node error status isn't streamed, it's handled like a regular reply once it's complete
+				if onStreamStart != nil && rpcclient.ValidateStatusCodes(relayResult.StatusCode, true) == nil {
+					// the reply is set once the stream is verified to completion, until then the client gets the chunks and the headers
+					// the spec allows to pass, with the same filtering as a regular reply
+					_, nodeHeaders := splitProviderBlockHash(streamedMetadata)
+					filteredHeaders, _, ignoredHeaders := rpccs.chainParser.HandleHeaders(nodeHeaders, chainMessage.GetApiCollection(), spectypes.Header_pass_reply)
+					relayResult.Reply = &pairingtypes.RelayReply{Metadata: append(filteredHeaders, ignoredHeaders...)}
+					relayResult.ReplyStream = common.NewRelayReplyStream()
+					onStreamStart()
+				}
+			}
+			if relayResult.ReplyStream != nil {
+				relayResult.ReplyStream.Append(chunk.Data)
+			}
+			continue
+		}
+		reply := streamReply.GetReply()
+		if reply == nil {
+			return nil, utils.LavaFormatError("relay stream message has no chunk and no reply", lavaprotocol.ProviderChunkedReplyError, utils.LogAttr("GUID", ctx), utils.LogAttr("provider", providerPublicAddress))
+		}
+		if streamReply.TotalChunks != chunksCount {
+			return nil, utils.LavaFormatError("relay stream chunks count mismatch", lavaprotocol.ProviderChunkedReplyError, utils.LogAttr("GUID", ctx), utils.LogAttr("provider", providerPublicAddress), utils.LogAttr("totalChunks", streamReply.TotalChunks), utils.LogAttr("receivedChunks", chunksCount))
+		}
+		if chunksCount > 0 {
+			if !metadataEqual(streamedMetadata, reply.Metadata) {
+				// the headers were forwarded before the reply, so they have to be the ones its signature covers
+				return nil, utils.LavaFormatError("relay stream metadata mismatch", lavaprotocol.ProviderChunkedReplyError, utils.LogAttr("GUID", ctx), utils.LogAttr("provider", providerPublicAddress), utils.LogAttr("streamedMetadata", streamedMetadata), utils.LogAttr("replyMetadata", reply.Metadata))
+			}
+			reply.Data = data
+		}
+		return reply, nil
+	}
+}
+
+func metadataEqual(first []pairingtypes.Metadata, second []pairingtypes.Metadata) bool {
+	if len(first) != len(second) {
+		return false
+	}
+	for idx := range first {
+		if first[idx] != second[idx] {
+			return false
+		}
+	}
+	return true
+}
+
 func (rpccs *RPCConsumerServer) relaySubscriptionInner(ctx context.Context, endpointClient pairingtypes.RelayerClient, singleConsumerSession *lavasession.SingleConsumerSession, relayResult *common.RelayResult) (err error) {
 	// relaySentTime := time.Now()
 	replyServer, err := endpointClient.RelaySubscribe(ctx, relayResult.Request)
@@ -875,6 +1111,82 @@ func (rpccs *RPCConsumerServer) sendDataReliabilityRelayIfApplicable(ctx context
 	return nil
 }
 
+// secureRelayConfig returns the secure relay config of the api, the endpoint config overrides the consumer flags
+func (rpccs *RPCConsumerServer) secureRelayConfig(chainMessage chainlib.ChainMessage) common.SecureRelayConfig {
+	if chainlib.GetStateful(chainMessage) == common.CONSISTENCY_SELECT_ALL_PROVIDERS {
+		// stateful apis are already sent to all providers, and their responses differ
+		return common.SecureRelayConfig{}
+	}
+	apiName := chainMessage.GetApi().Name
+	for _, secureApi := range rpccs.listenEndpoint.SecureApis {
+		if secureApi.Api == apiName {
+			return secureApi
+		}
+	}
+	return rpccs.secureRelay
+}
+
+// reportSecureRelayDisagreements reports the providers whose responses differ from the response the quorum agreed on
+func (rpccs *RPCConsumerServer) reportSecureRelayDisagreements(ctx context.Context, chainMessage chainlib.ChainMessage, relayProcessor *RelayProcessor) {
+	quorumResult, disagreeing := relayProcessor.SecureRelayDisagreements()
+	for idx := range disagreeing {
+		disagreeingResult := disagreeing[idx]
+		utils.LavaFormatWarning("provider response disagrees with the secure relay quorum", nil,
+			utils.LogAttr("GUID", ctx),
+			utils.LogAttr("api", chainMessage.GetApi().Name),
+			utils.LogAttr("provider", disagreeingResult.ProviderInfo.ProviderAddress),
+			utils.LogAttr("quorum", quorumResult.Quorum),
+		)
+		if rpccs.reporter != nil {
+			rpccs.reporter.AppendConflict(metrics.NewConflictRequest(quorumResult.Request, quorumResult.Reply, disagreeingResult.Request, disagreeingResult.Reply))
+		}
+		// only responses on finalized blocks can be proven on chain
+		// TODO: remove the extensions check when we fix the missing extensions information on conflict detection transaction
+		if !quorumResult.Finalized || !disagreeingResult.Finalized || len(chainMessage.GetExtensions()) > 0 {
+			continue
+		}
+		conflict := lavaprotocol.VerifyReliabilityResults(ctx, quorumResult, &disagreeingResult, chainMessage.GetApiCollection(), rpccs.chainParser)
+		if conflict == nil {
+			continue
+		}
+		err := rpccs.consumerTxSender.TxConflictDetection(ctx, nil, conflict, nil, disagreeingResult.ConflictHandler)
+		if err != nil {
+			utils.LavaFormatError("could not send detection Transaction", err, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "conflict", Value: conflict})
+		}
+	}
+}
+
+// verifyResponseSchema makes sure a reply holds what the spec says the api returns, so garbage isn't answered or cached.
+// node errors have their own format and are handled by the relay processor
+func (rpccs *RPCConsumerServer) verifyResponseSchema(chainMessage chainlib.ChainMessage, reply *pairingtypes.RelayReply, statusCode int) error {
+	responseSchema := chainMessage.GetResponseSchema()
+	if responseSchema == nil {
+		return nil
+	}
+	if foundError, _ := chainMessage.CheckResponseError(reply.Data, statusCode); foundError {
+		return nil
+	}
+	err := responseSchema.CheckResponse(reply.Data)
+	if err != nil {
+		return ResponseSchemaMismatchError.Wrapf("api %s: %s", chainMessage.GetApi().Name, err.Error())
+	}
+	return nil
+}
+
+// hedgeDeadline returns how long to wait for the first provider before hedging the relay, and false if it shouldn't be hedged
+func (rpccs *RPCConsumerServer) hedgeDeadline(chainMessage chainlib.ChainMessage, relayProcessor *RelayProcessor, relayTimeout time.Duration) (time.Duration, bool) {
+	if !rpccs.hedge.Enabled || rpccs.requiredResponses != 1 || relayProcessor.IsSecure() || relayProcessor.selection == BestResult || chainlib.IsSubscription(chainMessage) {
+		// relays that need several providers already use them, and stateful relays are already sent to all providers
+		return 0, false
+	}
+	deadline, ok := rpccs.consumerSessionManager.LatencyPercentile(chainlib.GetComputeUnits(chainMessage), rpccs.hedge.Percentile)
+	if !ok || deadline >= relayTimeout {
+		// the next batch is sent on the relay timeout anyway
+		return 0, false
+	}
+	return deadline, true
+}
+
 func (rpccs *RPCConsumerServer) getProcessingTimeout(chainMessage chainlib.ChainMessage) (processingTimeout time.Duration, relayTimeout time.Duration) {
 	_, averageBlockTime, _, _ := rpccs.chainParser.ChainBlockStats()
 	relayTimeout = chainlib.GetRelayTimeout(chainMessage, averageBlockTime)
@@ -933,7 +1245,7 @@ func (rpccs *RPCConsumerServer) HandleDirectiveHeadersForMessage(chainMessage ch
 	chainMessage.SetForceCacheRefresh(ok)
 }
 
-func (rpccs *RPCConsumerServer) appendHeadersToRelayResult(ctx context.Context, relayResult *common.RelayResult, protocolErrors uint64) {
+func (rpccs *RPCConsumerServer) appendHeadersToRelayResult(ctx context.Context, relayResult *common.RelayResult, protocolErrors uint64, hedges uint64) {
 	if relayResult == nil {
 		return
 	}
@@ -954,6 +1266,14 @@ func (rpccs *RPCConsumerServer) appendHeadersToRelayResult(ctx context.Context,
 				Value: strconv.FormatUint(protocolErrors, 10),
 			})
 	}
+	// add the count of relays sent to another provider because the first was slow
+	if hedges > 0 {
+		metadataReply = append(metadataReply,
+			pairingtypes.Metadata{
+				Name:  common.HEDGE_COUNT_HEADER_NAME,
+				Value: strconv.FormatUint(hedges, 10),
+			})
+	}
 	if relayResult.Reply == nil {
 		relayResult.Reply = &pairingtypes.RelayReply{}
 	}
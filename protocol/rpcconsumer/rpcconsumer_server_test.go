@@ -0,0 +1,84 @@
+package rpcconsumer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/upgrade"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	protocoltypes "github.com/lavanet/lava/x/protocol/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyResponseSchema(t *testing.T) {
+	ctx := context.Background()
+	serverHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	chainParser, _, _, closeServer, _, err := chainlib.CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandler, "../../", nil)
+	if closeServer != nil {
+		defer closeServer()
+	}
+	require.NoError(t, err)
+	rpccs := &RPCConsumerServer{chainParser: chainParser}
+
+	// eth_getBlockByNumber declares a response schema in the spec
+	chainMsg, err := chainParser.ParseMsg("", []byte(`{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["0x10", false],"id":1}`), http.MethodPost, nil, extensionslib.ExtensionInfo{LatestBlock: 100})
+	require.NoError(t, err)
+	require.NotNil(t, chainMsg.GetResponseSchema())
+	playbook := []struct {
+		name       string
+		data       string
+		statusCode int
+		valid      bool
+	}{
+		{name: "block", data: `{"jsonrpc":"2.0","id":1,"result":{"hash":"0xab","number":"0x10"}}`, statusCode: http.StatusOK, valid: true},
+		// nodes answer null for blocks they don't have yet, the spec allows an empty $.result for eth_getBlockByNumber
+		{name: "unknown block", data: `{"jsonrpc":"2.0","id":1,"result":null}`, statusCode: http.StatusOK, valid: true},
+		{name: "missing hash", data: `{"jsonrpc":"2.0","id":1,"result":{"number":"0x10"}}`, statusCode: http.StatusOK, valid: false},
+		{name: "wrong shape", data: `{"jsonrpc":"2.0","id":1,"result":"0x10"}`, statusCode: http.StatusOK, valid: false},
+		{name: "html page", data: `<html>502 Bad Gateway</html>`, statusCode: http.StatusOK, valid: false},
+		{name: "node error", data: `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"header not found"}}`, statusCode: http.StatusOK, valid: true},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			err := rpccs.verifyResponseSchema(chainMsg, &pairingtypes.RelayReply{Data: []byte(play.data)}, play.statusCode)
+			if play.valid {
+				require.NoError(t, err)
+			} else {
+				require.True(t, ResponseSchemaMismatchError.Is(err))
+			}
+		})
+	}
+
+	// apis without a schema accept any reply
+	chainMsg, err = chainParser.ParseMsg("", []byte(`{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`), http.MethodPost, nil, extensionslib.ExtensionInfo{LatestBlock: 100})
+	require.NoError(t, err)
+	require.NoError(t, rpccs.verifyResponseSchema(chainMsg, &pairingtypes.RelayReply{Data: []byte(`garbage`)}, http.StatusOK))
+}
+
+func TestValidateConsumerVersion(t *testing.T) {
+	rpcEndpoint := &lavasession.RPCEndpoint{ChainID: "LAV1", ApiInterface: spectypes.APIInterfaceRest}
+	csm := lavasession.NewConsumerSessionManager(rpcEndpoint, nil, nil, nil)
+	rpccs := &RPCConsumerServer{consumerSessionManager: csm, listenEndpoint: rpcEndpoint}
+
+	// no chain version yet, or the consumer meets the chain's minimum
+	require.NoError(t, rpccs.validateConsumerVersion())
+	currentVersion := upgrade.GetCurrentVersion().ConsumerVersion
+	csm.SetChainVersion(&protocoltypes.Version{ConsumerMin: currentVersion, ConsumerTarget: currentVersion, ProviderMin: currentVersion, ProviderTarget: currentVersion})
+	require.NoError(t, rpccs.validateConsumerVersion())
+	require.Nil(t, rpccs.outdatedVersionLogged.Load())
+
+	// every relay is refused below the minimum, the upgrade is logged once per minimum version
+	csm.SetChainVersion(&protocoltypes.Version{ConsumerMin: "999.0.0", ConsumerTarget: "999.0.0", ProviderMin: "999.0.0", ProviderTarget: "999.0.0"})
+	for i := 0; i < 2; i++ {
+		err := rpccs.validateConsumerVersion()
+		require.ErrorIs(t, err, lavasession.ConsumerVersionTooLowError)
+		require.Equal(t, "999.0.0", rpccs.outdatedVersionLogged.Load())
+	}
+}
@@ -124,6 +124,7 @@ type RelayReceiver interface {
 	Relay(ctx context.Context, request *pairingtypes.RelayRequest) (*pairingtypes.RelayReply, error)
 	RelaySubscribe(request *pairingtypes.RelayRequest, srv pairingtypes.Relayer_RelaySubscribeServer) error
 	Probe(ctx context.Context, probeReq *pairingtypes.ProbeRequest) (*pairingtypes.ProbeReply, error)
+	RelayStream(request *pairingtypes.RelayRequest, srv pairingtypes.Relayer_RelayStreamServer) error
 }
 
 func (rs *relayServer) Relay(ctx context.Context, request *pairingtypes.RelayRequest) (*pairingtypes.RelayReply, error) {
@@ -153,6 +154,17 @@ func (rs *relayServer) RelaySubscribe(request *pairingtypes.RelayRequest, srv pa
 	return relayReceiver.RelaySubscribe(request, srv)
 }
 
+func (rs *relayServer) RelayStream(request *pairingtypes.RelayRequest, srv pairingtypes.Relayer_RelayStreamServer) error {
+	if request.RelayData == nil || request.RelaySession == nil {
+		return utils.LavaFormatError("invalid relay stream request, internal fields are nil", nil)
+	}
+	relayReceiver, err := rs.findReceiver(request.RelayData.ApiInterface, request.RelaySession.SpecId)
+	if err != nil {
+		return err
+	}
+	return relayReceiver.RelayStream(request, srv)
+}
+
 func (rs *relayServer) findReceiver(apiInterface string, specID string) (RelayReceiver, error) {
 	endpoint := lavasession.RPCEndpoint{ChainID: specID, ApiInterface: apiInterface}
 	rs.lock.RLock()
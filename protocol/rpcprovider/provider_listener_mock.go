@@ -69,6 +69,20 @@ func (mr *MockRelayReceiverMockRecorder) Relay(ctx, request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relay", reflect.TypeOf((*MockRelayReceiver)(nil).Relay), ctx, request)
 }
 
+// RelayStream mocks base method.
+func (m *MockRelayReceiver) RelayStream(request *types.RelayRequest, srv types.Relayer_RelayStreamServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelayStream", request, srv)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RelayStream indicates an expected call of RelayStream.
+func (mr *MockRelayReceiverMockRecorder) RelayStream(request, srv any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayStream", reflect.TypeOf((*MockRelayReceiver)(nil).RelayStream), request, srv)
+}
+
 // RelaySubscribe mocks base method.
 func (m *MockRelayReceiver) RelaySubscribe(request *types.RelayRequest, srv types.Relayer_RelaySubscribeServer) error {
 	m.ctrl.T.Helper()
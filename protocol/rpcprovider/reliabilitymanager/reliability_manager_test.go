@@ -72,7 +72,7 @@ func TestFullFlowReliabilityCompare(t *testing.T) {
 		}
 		relayRequestData := lavaprotocol.NewRelayData(ctx, "GET", "stub_url", []byte("stub_data"), 0, spectypes.LATEST_BLOCK, "tendermintrpc", metadataValue, "", nil)
 		require.Equal(t, relayRequestData.Metadata, metadataValue)
-		relay, err := lavaprotocol.ConstructRelayRequest(ctx, consumer_sk, "lava", specId, relayRequestData, provider_address.String(), singleConsumerSession, epoch, []*pairingtypes.ReportedProvider{{Address: "stub"}})
+		relay, err := lavaprotocol.ConstructRelayRequest(ctx, sigs.NewPrivateKeySigner(consumer_sk), "lava", specId, relayRequestData, provider_address.String(), singleConsumerSession, epoch, []*pairingtypes.ReportedProvider{{Address: "stub"}})
 		require.NoError(t, err)
 
 		// provider checks
@@ -89,7 +89,7 @@ func TestFullFlowReliabilityCompare(t *testing.T) {
 		require.NoError(t, err)
 		reply.FinalizedBlocksHashes = jsonStr
 		reply.LatestBlock = latestBlock
-		reply, err = lavaprotocol.SignRelayResponse(extractedConsumerAddress, *relay, provider_sk, reply, true)
+		reply, err = lavaprotocol.SignRelayResponse(extractedConsumerAddress, *relay, sigs.NewPrivateKeySigner(provider_sk), reply, true)
 		require.NoError(t, err)
 		err = lavaprotocol.VerifyRelayReply(ctx, reply, relay, provider_address.String())
 		require.NoError(t, err)
@@ -106,7 +106,7 @@ func TestFullFlowReliabilityCompare(t *testing.T) {
 
 		// now send this to another provider
 		relayRequestDataDR := lavaprotocol.NewRelayData(ctx, relay.RelayData.ConnectionType, relay.RelayData.ApiUrl, relay.RelayData.Data, 0, relay.RelayData.RequestBlock, relay.RelayData.ApiInterface, relay.RelayData.Metadata, "", nil)
-		relayDR, err := lavaprotocol.ConstructRelayRequest(ctx, consumer_sk, "lava", specId, relayRequestDataDR, providerDR_address.String(), singleConsumerSession2, epoch, []*pairingtypes.ReportedProvider{{Address: "stub"}})
+		relayDR, err := lavaprotocol.ConstructRelayRequest(ctx, sigs.NewPrivateKeySigner(consumer_sk), "lava", specId, relayRequestDataDR, providerDR_address.String(), singleConsumerSession2, epoch, []*pairingtypes.ReportedProvider{{Address: "stub"}})
 		require.NoError(t, err)
 
 		// provider checks
@@ -122,7 +122,7 @@ func TestFullFlowReliabilityCompare(t *testing.T) {
 		require.NoError(t, err)
 		replyDR.FinalizedBlocksHashes = jsonStr
 		replyDR.LatestBlock = latestBlock
-		replyDR, err = lavaprotocol.SignRelayResponse(extractedConsumerAddress, *relayDR, providerDR_sk, replyDR, true)
+		replyDR, err = lavaprotocol.SignRelayResponse(extractedConsumerAddress, *relayDR, sigs.NewPrivateKeySigner(providerDR_sk), replyDR, true)
 		require.NoError(t, err)
 		err = lavaprotocol.VerifyRelayReply(ctx, replyDR, relayDR, providerDR_address.String())
 		require.NoError(t, err)
@@ -167,6 +167,13 @@ func (tsm *txSenderMock) TxSenderConflictDetection(ctx context.Context, finaliza
 	return tsm.cb()
 }
 
+func (tsm *txSenderMock) TxSenderLatestBlockConflictDetection(ctx context.Context, latestBlockConflict *conflicttypes.LatestBlockConflict) error {
+	if tsm.cb == nil {
+		return fmt.Errorf("No cb")
+	}
+	return tsm.cb()
+}
+
 func TestFullFlowReliabilityConflict(t *testing.T) {
 	t.Run("test", func(t *testing.T) {
 		specId := "LAV1"
@@ -227,7 +234,7 @@ func TestFullFlowReliabilityConflict(t *testing.T) {
 		reqBlock, _ := chainMessage.RequestedBlock()
 		relayRequestData := lavaprotocol.NewRelayData(ts.Ctx, "GET", "/cosmos/base/tendermint/v1beta1/blocks/latest", []byte{}, 0, reqBlock, spectypes.APIInterfaceRest, chainMessage.GetRPCMessage().GetHeaders(), "", nil)
 
-		relay, err := lavaprotocol.ConstructRelayRequest(ts.Ctx, consumer_sk, "lava", specId, relayRequestData, provider_address.String(), singleConsumerSession, epoch, []*pairingtypes.ReportedProvider{{Address: "stub"}})
+		relay, err := lavaprotocol.ConstructRelayRequest(ts.Ctx, sigs.NewPrivateKeySigner(consumer_sk), "lava", specId, relayRequestData, provider_address.String(), singleConsumerSession, epoch, []*pairingtypes.ReportedProvider{{Address: "stub"}})
 		require.NoError(t, err)
 
 		// provider checks
@@ -244,7 +251,7 @@ func TestFullFlowReliabilityConflict(t *testing.T) {
 		require.NoError(t, err)
 		reply.FinalizedBlocksHashes = jsonStr
 		reply.LatestBlock = latestBlock
-		reply, err = lavaprotocol.SignRelayResponse(extractedConsumerAddress, *relay, provider_sk, reply, true)
+		reply, err = lavaprotocol.SignRelayResponse(extractedConsumerAddress, *relay, sigs.NewPrivateKeySigner(provider_sk), reply, true)
 		require.NoError(t, err)
 		err = lavaprotocol.VerifyRelayReply(ts.Ctx, reply, relay, provider_address.String())
 		require.NoError(t, err)
@@ -265,7 +272,7 @@ func TestFullFlowReliabilityConflict(t *testing.T) {
 
 		// now send this to another provider
 		relayRequestDataDR := lavaprotocol.NewRelayData(ts.Ctx, relay.RelayData.ConnectionType, relay.RelayData.ApiUrl, relay.RelayData.Data, 0, relay.RelayData.RequestBlock, relay.RelayData.ApiInterface, relay.RelayData.Metadata, "", nil)
-		relayDR, err := lavaprotocol.ConstructRelayRequest(ts.Ctx, consumer_sk, "lava", specId, relayRequestDataDR, providerDR_address.String(), singleConsumerSession2, epoch, []*pairingtypes.ReportedProvider{{Address: "stub"}})
+		relayDR, err := lavaprotocol.ConstructRelayRequest(ts.Ctx, sigs.NewPrivateKeySigner(consumer_sk), "lava", specId, relayRequestDataDR, providerDR_address.String(), singleConsumerSession2, epoch, []*pairingtypes.ReportedProvider{{Address: "stub"}})
 		require.NoError(t, err)
 
 		// provider checks
@@ -282,7 +289,7 @@ func TestFullFlowReliabilityConflict(t *testing.T) {
 		require.NoError(t, err)
 		replyDR.FinalizedBlocksHashes = jsonStr
 		replyDR.LatestBlock = latestBlock
-		replyDR, err = lavaprotocol.SignRelayResponse(extractedConsumerAddress, *relayDR, providerDR_sk, replyDR, true)
+		replyDR, err = lavaprotocol.SignRelayResponse(extractedConsumerAddress, *relayDR, sigs.NewPrivateKeySigner(providerDR_sk), replyDR, true)
 		require.NoError(t, err)
 		err = lavaprotocol.VerifyRelayReply(ts.Ctx, replyDR, relayDR, providerDR_address.String())
 		require.NoError(t, err)
@@ -10,13 +10,13 @@ import (
 	"sync"
 	"time"
 
-	"github.com/btcsuite/btcd/btcec"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/config"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/app"
+	"github.com/lavanet/lava/protocol/badgeserver"
 	"github.com/lavanet/lava/protocol/chainlib"
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy"
 	"github.com/lavanet/lava/protocol/chaintracker"
@@ -24,6 +24,7 @@ import (
 	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/protocol/metrics"
 	"github.com/lavanet/lava/protocol/performance"
+	"github.com/lavanet/lava/protocol/remotesigner"
 	"github.com/lavanet/lava/protocol/rpcprovider/reliabilitymanager"
 	"github.com/lavanet/lava/protocol/rpcprovider/rewardserver"
 	"github.com/lavanet/lava/protocol/statetracker"
@@ -37,15 +38,17 @@ import (
 	protocoltypes "github.com/lavanet/lava/x/protocol/types"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"golang.org/x/exp/slices"
 )
 
 const (
 	ChainTrackerDefaultMemory  = 100
 	DEFAULT_ALLOWED_MISSING_CU = 0.2
 
-	ShardIDFlagName           = "shard-id"
-	StickinessHeaderName      = "sticky-header"
-	DefaultShardID       uint = 0
+	ShardIDFlagName               = "shard-id"
+	StickinessHeaderName          = "sticky-header"
+	BadgeRevocationListsFlag      = "badge-revocation-lists"
+	DefaultShardID           uint = 0
 )
 
 var (
@@ -71,7 +74,7 @@ func (pp *ProviderPolicy) GetSupportedExtensions(specID string) (extensions []ep
 }
 
 type ProviderStateTrackerInf interface {
-	RegisterForVersionUpdates(ctx context.Context, version *protocoltypes.Version, versionValidator updaters.VersionValidationInf)
+	RegisterForVersionUpdates(ctx context.Context, version *protocoltypes.Version, versionValidator updaters.VersionValidationInf, chainIDs ...string)
 	RegisterForSpecUpdates(ctx context.Context, specUpdatable updaters.SpecUpdatable, endpoint lavasession.RPCEndpoint) error
 	RegisterForSpecVerifications(ctx context.Context, specVerifier updaters.SpecVerifier, chainId string) error
 	RegisterReliabilityManagerForVoteUpdates(ctx context.Context, voteUpdatable updaters.VoteUpdatable, endpointP *lavasession.RPCProviderEndpoint)
@@ -88,7 +91,7 @@ type ProviderStateTrackerInf interface {
 	RegisterPaymentUpdatableForPayments(ctx context.Context, paymentUpdatable updaters.PaymentUpdatable)
 	GetRecommendedEpochNumToCollectPayment(ctx context.Context) (uint64, error)
 	GetEpochSizeMultipliedByRecommendedEpochNumToCollectPayment(ctx context.Context) (uint64, error)
-	GetProtocolVersion(ctx context.Context) (*updaters.ProtocolVersionResponse, error)
+	GetProtocolVersion(ctx context.Context, chainIDs ...string) (*updaters.ProtocolVersionResponse, error)
 	GetVirtualEpoch(epoch uint64) uint64
 	GetAverageBlockTime() time.Duration
 }
@@ -107,6 +110,8 @@ type rpcProviderStartOptions struct {
 	rewardsSnapshotThreshold  uint
 	rewardsSnapshotTimeoutSec uint
 	healthCheckMetricsOptions *rpcProviderHealthCheckMetricsOptions
+	remoteSignerSocket        string
+	badgeRevocationLists      []string
 }
 
 type rpcProviderHealthCheckMetricsOptions struct {
@@ -122,7 +127,7 @@ type RPCProvider struct {
 	// all of the following members need to be concurrency proof
 	providerMetricsManager    *metrics.ProviderMetricsManager
 	rewardServer              *rewardserver.RewardServer
-	privKey                   *btcec.PrivateKey
+	signer                    sigs.Signer
 	lavaChainID               string
 	addr                      sdk.AccAddress
 	blockMemorySize           uint64
@@ -135,6 +140,7 @@ type RPCProvider struct {
 	relaysHealthCheckEnabled  bool
 	relaysHealthCheckInterval time.Duration
 	grpcHealthCheckEndpoint   string
+	badgeRevocations          *badgeserver.BadgeRevocationTracker
 }
 
 func (rpcp *RPCProvider) Start(options *rpcProviderStartOptions) (err error) {
@@ -156,6 +162,8 @@ func (rpcp *RPCProvider) Start(options *rpcProviderStartOptions) (err error) {
 	rpcp.relaysHealthCheckInterval = options.healthCheckMetricsOptions.relaysHealthIntervalFlag
 	rpcp.relaysMonitorAggregator = metrics.NewRelaysMonitorAggregator(rpcp.relaysHealthCheckInterval, rpcp.providerMetricsManager)
 	rpcp.grpcHealthCheckEndpoint = options.healthCheckMetricsOptions.grpcHealthCheckEndpoint
+	rpcp.badgeRevocations = badgeserver.NewBadgeRevocationTracker(options.badgeRevocationLists)
+	rpcp.badgeRevocations.Start(ctx, badgeserver.DefaultRevocationListsInterval)
 	// single state tracker
 	lavaChainFetcher := chainlib.NewLavaChainFetcher(ctx, options.clientCtx)
 	providerStateTracker, err := statetracker.NewProviderStateTracker(ctx, options.txFactory, options.clientCtx, lavaChainFetcher, rpcp.providerMetricsManager)
@@ -165,12 +173,18 @@ func (rpcp *RPCProvider) Start(options *rpcProviderStartOptions) (err error) {
 
 	rpcp.providerStateTracker = providerStateTracker
 	providerStateTracker.RegisterForUpdates(ctx, updaters.NewMetricsUpdater(rpcp.providerMetricsManager))
-	// check version
-	version, err := rpcp.providerStateTracker.GetProtocolVersion(ctx)
+	// check version, the minimum versions of the served chains apply on top of the global one
+	chainIDs := []string{}
+	for _, endpoint := range options.rpcProviderEndpoints {
+		if !slices.Contains(chainIDs, endpoint.ChainID) {
+			chainIDs = append(chainIDs, endpoint.ChainID)
+		}
+	}
+	version, err := rpcp.providerStateTracker.GetProtocolVersion(ctx, chainIDs...)
 	if err != nil {
 		utils.LavaFormatFatal("failed fetching protocol version from node", err)
 	}
-	rpcp.providerStateTracker.RegisterForVersionUpdates(ctx, version.Version, &upgrade.ProtocolVersion{})
+	rpcp.providerStateTracker.RegisterForVersionUpdates(ctx, version.Version, &upgrade.ProtocolVersion{}, chainIDs...)
 
 	// single reward server
 	rewardDB := rewardserver.NewRewardDBWithTTL(options.rewardTTL)
@@ -181,22 +195,16 @@ func (rpcp *RPCProvider) Start(options *rpcProviderStartOptions) (err error) {
 	if err != nil {
 		utils.LavaFormatFatal("failed getting key name from clientCtx", err)
 	}
-	privKey, err := sigs.GetPrivKey(options.clientCtx, keyName)
+	signer, err := remotesigner.GetSigner(ctx, options.clientCtx, keyName, options.remoteSignerSocket)
 	if err != nil {
-		utils.LavaFormatFatal("failed getting private key from key name", err, utils.Attribute{Key: "keyName", Value: keyName})
+		utils.LavaFormatFatal("failed getting signer", err, utils.Attribute{Key: "keyName", Value: keyName})
 	}
-	rpcp.privKey = privKey
-	clientKey, _ := options.clientCtx.Keyring.Key(keyName)
+	rpcp.signer = signer
 	rpcp.lavaChainID = options.clientCtx.ChainID
-
-	pubKey, err := clientKey.GetPubKey()
-	if err != nil {
-		return err
-	}
-
-	err = rpcp.addr.Unmarshal(pubKey.Address())
-	if err != nil {
-		utils.LavaFormatFatal("failed unmarshaling public address", err, utils.Attribute{Key: "keyName", Value: keyName}, utils.Attribute{Key: "pubkey", Value: pubKey.Address()})
+	// relay payments and conflict txs are sent from the --from key, so it must be the signer's key
+	rpcp.addr = options.clientCtx.GetFromAddress()
+	if !rpcp.signer.Address().Equals(rpcp.addr) {
+		utils.LavaFormatFatal("signer address does not match the provider address", nil, utils.Attribute{Key: "signer", Value: rpcp.signer.Address().String()}, utils.Attribute{Key: "provider", Value: rpcp.addr.String()})
 	}
 	utils.LavaFormatInfo("RPCProvider pubkey: " + rpcp.addr.String())
 	utils.LavaFormatInfo("RPCProvider setting up endpoints", utils.Attribute{Key: "count", Value: strconv.Itoa(len(options.rpcProviderEndpoints))})
@@ -399,14 +407,36 @@ func (rpcp *RPCProvider) SetupEndpoint(ctx context.Context, rpcProviderEndpoint
 					utils.Attribute{Key: "apiInterface", Value: apiInterface},
 				)
 			}
+			// cached responses of non finalized blocks on the replaced branch are no longer valid
+			forkCallback := func(forkBlock int64) {
+				if !rpcp.cache.CacheActive() {
+					return
+				}
+				go func() {
+					invalidateCtx, cancel := context.WithTimeout(context.Background(), common.DataReliabilityTimeoutIncrease)
+					defer cancel()
+					err := rpcp.cache.InvalidateBlocks(invalidateCtx, chainID, forkBlock)
+					if err != nil {
+						utils.LavaFormatWarning("failed invalidating forked blocks in cache", err,
+							utils.Attribute{Key: "forkBlock", Value: forkBlock},
+							utils.Attribute{Key: "Chain", Value: chainID},
+						)
+					}
+				}()
+			}
 			blocksToSaveChainTracker := uint64(blocksToFinalization + blocksInFinalizationData)
 			chainTrackerConfig := chaintracker.ChainTrackerConfig{
-				BlocksToSave:        blocksToSaveChainTracker,
-				AverageBlockTime:    averageBlockTime,
-				ServerBlockMemory:   ChainTrackerDefaultMemory + blocksToSaveChainTracker,
-				NewLatestCallback:   recordMetricsOnNewBlock,
-				ConsistencyCallback: consistencyErrorCallback,
-				Pmetrics:            rpcp.providerMetricsManager,
+				BlocksToSave:           blocksToSaveChainTracker,
+				BlocksToFinalization:   uint64(blocksToFinalization),
+				AverageBlockTime:       averageBlockTime,
+				ServerBlockMemory:      ChainTrackerDefaultMemory + blocksToSaveChainTracker,
+				NewLatestCallback:      recordMetricsOnNewBlock,
+				ConsistencyCallback:    consistencyErrorCallback,
+				ForkCallback:           forkCallback,
+				Pmetrics:               rpcp.providerMetricsManager,
+				PushMode:               chaintracker.PushMode,
+				CrossCheckNodes:        chaintracker.CrossCheckNodes,
+				WithholdOnDisagreement: chaintracker.WithholdOnDisagreement,
 			}
 
 			chainTracker, err = chaintracker.NewChainTracker(ctx, chainFetcher, chainTrackerConfig)
@@ -454,8 +484,8 @@ func (rpcp *RPCProvider) SetupEndpoint(ctx context.Context, rpcProviderEndpoint
 		rpcp.providerMetricsManager.RegisterRelaysMonitor(chainID, apiInterface, relaysMonitor)
 	}
 
-	rpcProviderServer := &RPCProviderServer{}
-	rpcProviderServer.ServeRPCRequests(ctx, rpcProviderEndpoint, chainParser, rpcp.rewardServer, providerSessionManager, reliabilityManager, rpcp.privKey, rpcp.cache, chainRouter, rpcp.providerStateTracker, rpcp.addr, rpcp.lavaChainID, DEFAULT_ALLOWED_MISSING_CU, providerMetrics, relaysMonitor)
+	rpcProviderServer := &RPCProviderServer{badgeRevocations: rpcp.badgeRevocations}
+	rpcProviderServer.ServeRPCRequests(ctx, rpcProviderEndpoint, chainParser, rpcp.rewardServer, providerSessionManager, reliabilityManager, rpcp.signer, rpcp.cache, chainRouter, rpcp.providerStateTracker, rpcp.addr, rpcp.lavaChainID, DEFAULT_ALLOWED_MISSING_CU, providerMetrics, relaysMonitor)
 	// set up grpc listener
 	var listener *ProviderListener
 	func() {
@@ -669,6 +699,8 @@ rpcprovider 127.0.0.1:3333 OSMOSIS tendermintrpc "wss://www.node-path.com:80,htt
 			enableRelaysHealth := viper.GetBool(common.RelaysHealthEnableFlag)
 			relaysHealthInterval := viper.GetDuration(common.RelayHealthIntervalFlag)
 			healthCheckURLPath := viper.GetString(HealthCheckURLPathFlagName)
+			remoteSignerSocket := viper.GetString(common.RemoteSignerFlag)
+			badgeRevocationLists := viper.GetStringSlice(BadgeRevocationListsFlag)
 
 			rpcProviderHealthCheckMetricsOptions := rpcProviderHealthCheckMetricsOptions{
 				enableRelaysHealth,
@@ -690,6 +722,8 @@ rpcprovider 127.0.0.1:3333 OSMOSIS tendermintrpc "wss://www.node-path.com:80,htt
 				rewardsSnapshotThreshold,
 				rewardsSnapshotTimeoutSec,
 				&rpcProviderHealthCheckMetricsOptions,
+				remoteSignerSocket,
+				badgeRevocationLists,
 			}
 
 			rpcProvider := RPCProvider{}
@@ -716,11 +750,16 @@ rpcprovider 127.0.0.1:3333 OSMOSIS tendermintrpc "wss://www.node-path.com:80,htt
 	cmdRPCProvider.Flags().Uint(rewardserver.RewardsSnapshotTimeoutSecFlagName, rewardserver.DefaultRewardsSnapshotTimeoutSec, "the seconds to wait until making snapshot of the rewards memory")
 	cmdRPCProvider.Flags().String(StickinessHeaderName, RPCProviderStickinessHeaderName, "the name of the header to be attacked to requests for stickiness by consumer, used for consistency")
 	cmdRPCProvider.Flags().Uint64Var(&chaintracker.PollingMultiplier, chaintracker.PollingMultiplierFlagName, 1, "when set, forces the chain tracker to poll more often, improving the sync at the cost of more queries")
+	cmdRPCProvider.Flags().BoolVar(&chaintracker.PushMode, chaintracker.PushModeFlagName, chaintracker.PushMode, "when set, the chain tracker subscribes to new blocks over the node's websocket and only polls as a fallback")
+	cmdRPCProvider.Flags().BoolVar(&chaintracker.CrossCheckNodes, chaintracker.CrossCheckFlagName, chaintracker.CrossCheckNodes, "when set, the chain tracker periodically compares the latest block and block hashes of all the node urls configured for a chain")
+	cmdRPCProvider.Flags().BoolVar(&chaintracker.WithholdOnDisagreement, chaintracker.WithholdOnDisagreementFlag, chaintracker.WithholdOnDisagreement, "when cross checking, leave the finalization data (block hashes) out of replies while the chain's nodes disagree on finalized block hashes, relays are still served")
 	cmdRPCProvider.Flags().DurationVar(&SpecValidationInterval, SpecValidationIntervalFlagName, SpecValidationInterval, "determines the interval of which to run validation on the spec for all connected chains")
 	cmdRPCProvider.Flags().DurationVar(&SpecValidationIntervalDisabledChains, SpecValidationIntervalDisabledChainsFlagName, SpecValidationIntervalDisabledChains, "determines the interval of which to run validation on the spec for all disabled chains, determines recovery time")
 	cmdRPCProvider.Flags().Bool(common.RelaysHealthEnableFlag, true, "enables relays health check")
 	cmdRPCProvider.Flags().Duration(common.RelayHealthIntervalFlag, RelayHealthIntervalFlagDefault, "interval between relay health checks")
 	cmdRPCProvider.Flags().String(HealthCheckURLPathFlagName, HealthCheckURLPathFlagDefault, "the url path for the provider's grpc health check")
+	cmdRPCProvider.Flags().String(common.RemoteSignerFlag, "", "unix socket of a remote signer (lavap signer) to sign relays with, instead of loading the key from the keyring. The --from key must be the signer's key, since txs are sent from it")
+	cmdRPCProvider.Flags().StringSlice(BadgeRevocationListsFlag, nil, "urls of badge servers revocation lists (http://<badge server metrics address>/revoked-badges), badges they revoke are rejected")
 	cmdRPCProvider.Flags().DurationVar(&updaters.TimeOutForFetchingLavaBlocks, common.TimeOutForFetchingLavaBlocksFlag, time.Second*5, "setting the timeout for fetching lava blocks")
 
 	common.AddRollingLogConfig(cmdRPCProvider)
@@ -6,12 +6,13 @@ import (
 	"encoding/json"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	sdkerrors "cosmossdk.io/errors"
-	"github.com/btcsuite/btcd/btcec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gogo/status"
+	"github.com/lavanet/lava/protocol/badgeserver"
 	"github.com/lavanet/lava/protocol/chainlib"
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
@@ -40,12 +41,15 @@ const (
 	debugLatency     = false
 )
 
-var RPCProviderStickinessHeaderName = "X-Node-Sticky"
+var (
+	RPCProviderStickinessHeaderName = "X-Node-Sticky"
+	RelayStreamChunkSize            = 1024 * 1024 // replies bigger than this are streamed to the consumer in chunks
+)
 
 type RPCProviderServer struct {
 	cache                     *performance.Cache
 	chainRouter               chainlib.ChainRouter
-	privKey                   *btcec.PrivateKey
+	signer                    sigs.Signer
 	reliabilityManager        ReliabilityManagerInf
 	providerSessionManager    *lavasession.ProviderSessionManager
 	rewardServer              RewardServerInf
@@ -57,6 +61,7 @@ type RPCProviderServer struct {
 	allowedMissingCUThreshold float64
 	metrics                   *metrics.ProviderMetrics
 	relaysMonitor             *metrics.RelaysMonitor
+	badgeRevocations          *badgeserver.BadgeRevocationTracker
 }
 
 type ReliabilityManagerInf interface {
@@ -83,7 +88,7 @@ func (rpcps *RPCProviderServer) ServeRPCRequests(
 	rewardServer RewardServerInf,
 	providerSessionManager *lavasession.ProviderSessionManager,
 	reliabilityManager ReliabilityManagerInf,
-	privKey *btcec.PrivateKey,
+	signer sigs.Signer,
 	cache *performance.Cache,
 	chainRouter chainlib.ChainRouter,
 	stateTracker StateTrackerInf,
@@ -95,7 +100,7 @@ func (rpcps *RPCProviderServer) ServeRPCRequests(
 ) {
 	rpcps.cache = cache
 	rpcps.chainRouter = chainRouter
-	rpcps.privKey = privKey
+	rpcps.signer = signer
 	rpcps.providerSessionManager = providerSessionManager
 	rpcps.reliabilityManager = reliabilityManager
 	rpcps.rewardServer = rewardServer
@@ -242,6 +247,86 @@ func (rpcps *RPCProviderServer) Relay(ctx context.Context, request *pairingtypes
 	return reply, rpcps.handleRelayErrorStatus(err)
 }
 
+// function used to handle streamed relay requests from a consumer, replies bigger than RelayStreamChunkSize are sent in signed chunks
+// followed by the reply with its signatures and finalization data, smaller replies are sent in a single message
+func (rpcps *RPCProviderServer) RelayStream(request *pairingtypes.RelayRequest, srv pairingtypes.Relayer_RelayStreamServer) error {
+	// the node's status code is set in the trailer, which reaches the consumer only after the last chunk
+	trailerStream := &trailerCapturingStream{ServerTransportStream: grpc.ServerTransportStreamFromContext(srv.Context())}
+	ctx := grpc.NewContextWithServerTransportStream(srv.Context(), trailerStream)
+	reply, err := rpcps.Relay(ctx, request)
+	if err != nil {
+		return err
+	}
+	if len(reply.Data) <= RelayStreamChunkSize || rpcps.isNodeError(request, reply, trailerStream.statusCode()) {
+		// node errors are not streamed, so the consumer can handle them like a regular reply
+		return srv.Send(&pairingtypes.RelayStreamReply{Reply: reply})
+	}
+	chunks, err := lavaprotocol.SignRelayChunks(*request, rpcps.signer, reply.Data, RelayStreamChunkSize)
+	if err != nil {
+		return err
+	}
+	for idx, chunk := range chunks {
+		streamReply := &pairingtypes.RelayStreamReply{Chunk: chunk}
+		if idx == 0 {
+			// the consumer forwards the headers and the status to its client before the reply arrives
+			streamReply.Metadata = reply.Metadata
+			streamReply.StatusCode = int64(trailerStream.statusCode())
+		}
+		err = srv.Send(streamReply)
+		if err != nil {
+			return utils.LavaFormatWarning("failed sending relay chunk", err, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "chunkIndex", Value: chunk.Index})
+		}
+	}
+	// the data was already sent in the chunks, the signatures in the reply cover all of it
+	reply.Data = nil
+	return srv.Send(&pairingtypes.RelayStreamReply{Reply: reply, TotalChunks: uint64(len(chunks))})
+}
+
+// isNodeError checks whether the node replied with an error, with the error parsing of the requested api
+func (rpcps *RPCProviderServer) isNodeError(request *pairingtypes.RelayRequest, reply *pairingtypes.RelayReply, statusCode int) bool {
+	if rpcclient.ValidateStatusCodes(statusCode, true) != nil {
+		return true
+	}
+	extensionInfo := extensionslib.ExtensionInfo{LatestBlock: 0, ExtensionOverride: request.RelayData.Extensions}
+	chainMessage, err := rpcps.chainParser.ParseMsg(request.RelayData.ApiUrl, request.RelayData.Data, request.RelayData.ConnectionType, request.RelayData.GetMetadata(), extensionInfo)
+	if err != nil {
+		return false
+	}
+	foundError, _ := chainMessage.CheckResponseError(reply.Data, statusCode)
+	return foundError
+}
+
+// trailerCapturingStream keeps the trailers set while serving a streamed relay, so they can be sent with the first chunk
+type trailerCapturingStream struct {
+	grpc.ServerTransportStream
+	lock    sync.Mutex
+	trailer metadata.MD
+}
+
+func (tcs *trailerCapturingStream) SetTrailer(md metadata.MD) error {
+	tcs.lock.Lock()
+	tcs.trailer = metadata.Join(tcs.trailer, md)
+	tcs.lock.Unlock()
+	if tcs.ServerTransportStream == nil {
+		return nil
+	}
+	return tcs.ServerTransportStream.SetTrailer(md)
+}
+
+func (tcs *trailerCapturingStream) statusCode() int {
+	tcs.lock.Lock()
+	defer tcs.lock.Unlock()
+	statuses := tcs.trailer.Get(common.StatusCodeMetadataKey)
+	if len(statuses) == 0 {
+		return 0
+	}
+	statusCode, err := strconv.Atoi(statuses[0])
+	if err != nil {
+		return 0
+	}
+	return statusCode
+}
+
 func (rpcps *RPCProviderServer) initRelay(ctx context.Context, request *pairingtypes.RelayRequest) (relaySession *lavasession.SingleProviderSession, consumerAddress sdk.AccAddress, chainMessage chainlib.ChainMessage, err error) {
 	relaySession, consumerAddress, err = rpcps.verifyRelaySession(ctx, request)
 	if err != nil {
@@ -547,6 +632,10 @@ func (rpcps *RPCProviderServer) validateBadgeSession(ctx context.Context, relayS
 	if int64(relaySession.Badge.Epoch) != relaySession.Epoch {
 		return utils.LavaFormatWarning("Badge epoch validation failed", nil, utils.LogAttr("badge_epoch", relaySession.Badge.Epoch), utils.LogAttr("relay_epoch", relaySession.Epoch))
 	}
+
+	if rpcps.badgeRevocations.IsRevoked(relaySession.Badge) {
+		return utils.LavaFormatWarning("badge was revoked by its badge server", nil, utils.LogAttr("GUID", ctx), utils.LogAttr("badgeUser", relaySession.Badge.Address))
+	}
 	return nil
 }
 
@@ -715,9 +804,20 @@ func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingty
 			// avoid using cache, but can still service
 			utils.LavaFormatWarning("no hash data for requested block", nil, utils.Attribute{Key: "specID", Value: rpcps.rpcProviderEndpoint.ChainID}, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "requestedBlock", Value: request.RelayData.RequestBlock}, utils.Attribute{Key: "latestBlock", Value: latestBlock}, utils.Attribute{Key: "modifiedReqBlock", Value: modifiedReqBlock}, utils.Attribute{Key: "specificBlock", Value: specificBlock})
 		}
+	} else {
+		// without data reliability the request is not modified, but a request for a specific block is still cached:
+		// finalized blocks can't change, and non finalized blocks are tagged with the block hash from the chain tracker
+		latestBlock, _ = rpcps.reliabilityManager.GetLatestBlockNum()
+		finalized = spectypes.IsFinalizedBlock(request.RelayData.RequestBlock, latestBlock, blockDistanceToFinalization)
+		if !finalized && request.RelayData.RequestBlock >= 0 {
+			_, specificRequestedHashes, _, err := rpcps.reliabilityManager.GetLatestBlockData(spectypes.NOT_APPLICABLE, spectypes.NOT_APPLICABLE, request.RelayData.RequestBlock)
+			if err == nil && len(specificRequestedHashes) == 1 {
+				requestedBlockHash = []byte(specificRequestedHashes[0].Hash)
+			}
+		}
 	}
 	cache := rpcps.cache
-	// TODO: handle cache on fork for dataReliability = false
+	// non finalized entries are tagged with the block hash, so they are evicted by the chain tracker's fork callback
 	var reply *pairingtypes.RelayReply = nil
 	var err error = nil
 	ignoredMetadata := []pairingtypes.Metadata{}
@@ -841,8 +941,12 @@ func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingty
 		reply.FinalizedBlocksHashes = jsonStr
 		reply.LatestBlock = proofBlock
 	}
+	if requestedBlockHash != nil && !finalized {
+		// the consumer's cache tags the non finalized entry with the hash, so it can evict it when the chain forks
+		reply.Metadata = append(reply.Metadata, pairingtypes.Metadata{Name: common.PROVIDER_BLOCK_HASH_HEADER_NAME, Value: string(requestedBlockHash)})
+	}
 	// utils.LavaFormatDebug("response signing", utils.LogAttr("request block", request.RelayData.RequestBlock), utils.LogAttr("GUID", ctx), utils.LogAttr("latestBlock", reply.LatestBlock))
-	reply, err = lavaprotocol.SignRelayResponse(consumerAddr, *request, rpcps.privKey, reply, dataReliabilityEnabled)
+	reply, err = lavaprotocol.SignRelayResponse(consumerAddr, *request, rpcps.signer, reply, dataReliabilityEnabled)
 	if err != nil {
 		return nil, err
 	}
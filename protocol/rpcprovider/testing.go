@@ -18,9 +18,9 @@ import (
 	lvutil "github.com/lavanet/lava/ecosystem/lavavisor/pkg/util"
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/remotesigner"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/rand"
-	"github.com/lavanet/lava/utils/sigs"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 	pairingcli "github.com/lavanet/lava/x/pairing/client/cli"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
@@ -251,17 +251,13 @@ rpcprovider --from providerWallet --endpoints "provider-public-grpc:port,jsonrpc
 
 			var address string
 			if len(args) == 0 {
-				keyName, err := sigs.GetKeyName(clientCtx)
+				remoteSignerSocket, err := cmd.Flags().GetString(common.RemoteSignerFlag)
 				if err != nil {
-					utils.LavaFormatFatal("failed getting key name from clientCtx, either provide the address in an argument or verify the --from wallet exists", err)
+					utils.LavaFormatFatal("failed to read remote signer flag", err)
 				}
-				clientKey, err := clientCtx.Keyring.Key(keyName)
+				tmpAddr, err := remotesigner.GetSignerAddress(ctx, clientCtx, remoteSignerSocket)
 				if err != nil {
-					return err
-				}
-				tmpAddr, err := clientKey.GetAddress()
-				if err != nil {
-					return err
+					utils.LavaFormatFatal("failed getting the address, either provide the address in an argument or verify the --from wallet exists", err)
 				}
 				address = tmpAddr.String()
 			} else {
@@ -358,6 +354,7 @@ rpcprovider --from providerWallet --endpoints "provider-public-grpc:port,jsonrpc
 	// RPCConsumer command flags
 	flags.AddTxFlagsToCmd(cmdTestRPCProvider)
 	cmdTestRPCProvider.Flags().Bool(lavasession.AllowInsecureConnectionToProvidersFlag, false, "allow insecure provider-dialing. used for development and testing")
+	cmdTestRPCProvider.Flags().String(common.RemoteSignerFlag, "", "unix socket of a remote signer (lavap signer) whose address is tested, instead of the --from wallet")
 	cmdTestRPCProvider.Flags().String(common.EndpointsConfigName, "", "endpoints to check, overwrites reading it from the blockchain")
 	return cmdTestRPCProvider
 }
@@ -0,0 +1,291 @@
+package speccheck
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/utils"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	StatusOK               = "ok"
+	StatusSkipped          = "skipped"
+	StatusUnsupported      = "unsupported"
+	StatusNodeError        = "node_error"
+	StatusParserFailure    = "parser_failure"
+	StatusNonDeterministic = "non_deterministic"
+	StatusSlow             = "slow"
+)
+
+// node errors that mean the api doesn't exist on the node, and not that the sample was wrong
+var unsupportedErrorRegex = regexp.MustCompile(`(?i)method not found|not implemented|unimplemented|unknown method|unknown service|does not exist|not supported|\b404\b|\b501\b`)
+
+// ApiSample is the request used to call an api, apis without a sample are called with empty parameters when possible
+type ApiSample struct {
+	Path string `yaml:"path" json:"path"` // rest path with the parameters filled, defaults to the api name
+	Data string `yaml:"data" json:"data"` // json-rpc and tendermint params, grpc message as json or rest body
+}
+
+type SamplesFile struct {
+	Samples map[string]ApiSample `yaml:"samples" json:"samples"`
+}
+
+func LoadSamples(path string) (map[string]ApiSample, error) {
+	if path == "" {
+		return map[string]ApiSample{}, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	samplesFile := SamplesFile{}
+	err = yaml.Unmarshal(data, &samplesFile)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed parsing samples file", err, utils.LogAttr("path", path))
+	}
+	if samplesFile.Samples == nil {
+		samplesFile.Samples = map[string]ApiSample{}
+	}
+	return samplesFile.Samples, nil
+}
+
+type ApiResult struct {
+	Api            string
+	Addon          string
+	ComputeUnits   uint64
+	Status         string
+	RequestedBlock int64
+	Latency        time.Duration
+	Details        string
+}
+
+type Report struct {
+	ChainID      string
+	ApiInterface string
+	Results      []*ApiResult
+}
+
+// Failed returns the results that need fixing in the spec
+func (r *Report) Failed() []*ApiResult {
+	failed := []*ApiResult{}
+	for _, result := range r.Results {
+		if result.Status != StatusOK && result.Status != StatusSkipped {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+func (r *Report) Count(status string) int {
+	count := 0
+	for _, result := range r.Results {
+		if result.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+type CheckerOptions struct {
+	Repeat      int           // times to call every api, responses of deterministic apis are compared between calls
+	NodeTimeout time.Duration // timeout of a single node call
+	Addons      []string      // addons and extensions the node supports, apis of other addons are not checked
+}
+
+// Checker calls every api of a spec on a node and checks the spec describes it correctly
+type Checker struct {
+	spec        spectypes.Spec
+	chainParser chainlib.ChainParser
+	chainRouter chainlib.ChainRouter
+	samples     map[string]ApiSample
+	options     CheckerOptions
+}
+
+func NewChecker(spec spectypes.Spec, chainParser chainlib.ChainParser, chainRouter chainlib.ChainRouter, samples map[string]ApiSample, options CheckerOptions) *Checker {
+	if options.Repeat <= 0 {
+		options.Repeat = 1
+	}
+	if options.NodeTimeout <= 0 {
+		options.NodeTimeout = common.DefaultTimeout
+	}
+	return &Checker{spec: spec, chainParser: chainParser, chainRouter: chainRouter, samples: samples, options: options}
+}
+
+func (c *Checker) Run(ctx context.Context, apiInterface string) *Report {
+	report := &Report{ChainID: c.spec.Index, ApiInterface: apiInterface}
+	checked := map[string]struct{}{}
+	for _, apiCollection := range c.spec.ApiCollections {
+		if !apiCollection.Enabled || apiCollection.CollectionData.ApiInterface != apiInterface {
+			continue
+		}
+		if addon := apiCollection.CollectionData.AddOn; addon != "" && !slices.Contains(c.options.Addons, addon) {
+			continue
+		}
+		for _, api := range apiCollection.Apis {
+			key := apiCollection.CollectionData.Type + " " + api.Name
+			if _, ok := checked[key]; ok || !api.Enabled {
+				continue
+			}
+			checked[key] = struct{}{}
+			result := c.checkApi(ctx, apiCollection, api)
+			utils.LavaFormatDebug("checked api", utils.LogAttr("api", result.Api), utils.LogAttr("status", result.Status), utils.LogAttr("details", result.Details))
+			report.Results = append(report.Results, result)
+		}
+	}
+	sort.SliceStable(report.Results, func(i, j int) bool {
+		return report.Results[i].Api < report.Results[j].Api
+	})
+	return report
+}
+
+// craftRequest returns the url and data to parse for the api, and false if the api can't be called without a sample
+func (c *Checker) craftRequest(apiInterface string, api *spectypes.Api) (url string, data []byte, ok bool) {
+	sample, hasSample := c.samples[api.Name]
+	// apis with a block in their parameters fail without one, so they need a sample
+	needsParams := api.BlockParsing.ParserFunc != spectypes.PARSER_FUNC_EMPTY && api.BlockParsing.ParserFunc != spectypes.PARSER_FUNC_DEFAULT
+	switch apiInterface {
+	case spectypes.APIInterfaceJsonRPC, spectypes.APIInterfaceTendermintRPC:
+		params := sample.Data
+		if !hasSample {
+			if needsParams {
+				return "", nil, false
+			}
+			params = "[]"
+			if apiInterface == spectypes.APIInterfaceTendermintRPC {
+				params = "{}"
+			}
+		}
+		method, _ := json.Marshal(api.Name)
+		return "", []byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":%s,"params":%s}`, method, params)), true
+	case spectypes.APIInterfaceRest:
+		if !hasSample {
+			if needsParams || strings.Contains(api.Name, "{") {
+				return "", nil, false
+			}
+			return api.Name, nil, true
+		}
+		url = sample.Path
+		if url == "" {
+			url = api.Name
+		}
+		return url, []byte(sample.Data), true
+	default:
+		if !hasSample {
+			if needsParams {
+				return "", nil, false
+			}
+			return api.Name, []byte("{}"), true
+		}
+		return api.Name, []byte(sample.Data), true
+	}
+}
+
+func (c *Checker) checkApi(ctx context.Context, apiCollection *spectypes.ApiCollection, api *spectypes.Api) *ApiResult {
+	result := &ApiResult{Api: api.Name, Addon: apiCollection.CollectionData.AddOn, ComputeUnits: api.ComputeUnits, RequestedBlock: spectypes.NOT_APPLICABLE}
+	if api.Category.Subscription || api.Category.HangingApi {
+		result.Status = StatusSkipped
+		result.Details = "subscription and hanging apis are not checked"
+		return result
+	}
+	url, data, ok := c.craftRequest(apiCollection.CollectionData.ApiInterface, api)
+	if !ok {
+		result.Status = StatusSkipped
+		result.Details = "no sample for an api with parameters"
+		return result
+	}
+	chainMessage, err := c.chainParser.ParseMsg(url, data, apiCollection.CollectionData.Type, nil, extensionslib.ExtensionInfo{LatestBlock: 0})
+	if err != nil {
+		result.Status = StatusParserFailure
+		result.Details = fmt.Sprintf("failed parsing the request: %s", err)
+		return result
+	}
+	result.RequestedBlock, _ = chainMessage.RequestedBlock()
+	if api.BlockParsing.ParserFunc != spectypes.PARSER_FUNC_EMPTY && result.RequestedBlock == spectypes.NOT_APPLICABLE {
+		result.Status = StatusParserFailure
+		result.Details = fmt.Sprintf("block parsing %s %v failed on the request", api.BlockParsing.ParserFunc, api.BlockParsing.ParserArg)
+		return result
+	}
+	extensions := []string{}
+	for _, extension := range chainMessage.GetExtensions() {
+		extensions = append(extensions, extension.Name)
+	}
+
+	var firstResponse []byte
+	for i := 0; i < c.options.Repeat; i++ {
+		sendCtx, cancel := context.WithTimeout(ctx, c.options.NodeTimeout)
+		start := time.Now()
+		reply, _, _, _, _, err := c.chainRouter.SendNodeMsg(sendCtx, nil, chainMessage, extensions)
+		latency := time.Since(start)
+		cancel()
+		if err != nil {
+			result.Status, result.Details = classifyNodeError(err.Error())
+			return result
+		}
+		if hasError, errorMessage := chainMessage.CheckResponseError(reply.Data, 0); hasError {
+			result.Status, result.Details = classifyNodeError(errorMessage)
+			return result
+		}
+		if i == 0 || latency < result.Latency {
+			result.Latency = latency
+		}
+		response := normalizeResponse(reply.Data)
+		if i == 0 {
+			firstResponse = response
+			continue
+		}
+		// responses to the latest block can change between calls, so only requests for a specific block are compared
+		if api.Category.Deterministic && result.RequestedBlock >= 0 && !bytes.Equal(firstResponse, response) {
+			result.Status = StatusNonDeterministic
+			result.Details = "deterministic api returned different responses for the same request"
+			return result
+		}
+	}
+
+	budget := common.LocalNodeTimePerCu(api.ComputeUnits)
+	if api.TimeoutMs > 0 {
+		budget = time.Duration(api.TimeoutMs) * time.Millisecond
+	}
+	if result.Latency > budget {
+		result.Status = StatusSlow
+		result.Details = fmt.Sprintf("latency %s is over the %s the protocol allows for %d compute units", result.Latency, budget, api.ComputeUnits)
+		return result
+	}
+	result.Status = StatusOK
+	return result
+}
+
+func classifyNodeError(errorMessage string) (status string, details string) {
+	if unsupportedErrorRegex.MatchString(errorMessage) {
+		return StatusUnsupported, errorMessage
+	}
+	return StatusNodeError, errorMessage
+}
+
+// normalizeResponse removes the json-rpc id and orders keys so responses can be compared
+func normalizeResponse(data []byte) []byte {
+	var parsed interface{}
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return data
+	}
+	if parsedMap, ok := parsed.(map[string]interface{}); ok {
+		delete(parsedMap, "id")
+	}
+	normalized, err := json.Marshal(parsed)
+	if err != nil {
+		return data
+	}
+	return normalized
+}
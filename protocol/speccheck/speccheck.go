@@ -0,0 +1,162 @@
+package speccheck
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	keepertest "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/utils"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	samplesFlagName     = "samples"
+	repeatFlagName      = "repeat"
+	nodeTimeoutFlagName = "node-timeout"
+	addonsFlagName      = "addons"
+	defaultRepeat       = 2
+	defaultNodeTimeout  = 10 * time.Second
+)
+
+type checkPolicy struct {
+	addons []string
+}
+
+func (cp *checkPolicy) GetSupportedAddons(specID string) (addons []string, err error) {
+	return cp.addons, nil
+}
+
+func (cp *checkPolicy) GetSupportedExtensions(specID string) (extensions []epochstoragetypes.EndpointService, err error) {
+	return []epochstoragetypes.EndpointService{}, nil
+}
+
+func CreateSpecCheckCobraCommand() *cobra.Command {
+	cmdSpecCheck := &cobra.Command{
+		Use:   `spec [spec-path] [chain-id] [api-interface] [node-url] --samples samples.yml --addons [addons,extensions]`,
+		Short: `check a spec against a node, calling every api in it`,
+		Long: `spec loads the specs in spec-path (a comma separated list of proposal files and directories, so imports can be resolved),
+expands chain-id and calls every enabled api of api-interface on the node. it reports apis the node doesn't support,
+block parsing that fails on the request, deterministic apis that return different responses and apis slower than their compute units allow.
+apis with parameters are called with the request in the samples file, and skipped without one. a samples file looks like:
+samples:
+  eth_getBlockByNumber:
+    data: '["0x100", false]'
+  /cosmos/base/tendermint/v1beta1/blocks/{height}:
+    path: /cosmos/base/tendermint/v1beta1/blocks/100`,
+		Example: `spec ./cookbook/specs/ ETH1 jsonrpc https://eth-node.com:443 --samples eth_samples.yml
+spec ./cookbook/specs/spec_add_cosmossdk.json,./cookbook/specs/spec_add_lava.json LAV1 rest http://127.0.0.1:1317 --addons archive`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			specPath, chainID, apiInterface, nodeUrl := args[0], args[1], args[2], args[3]
+			logLevel, err := cmd.Flags().GetString(flags.FlagLogLevel)
+			if err != nil {
+				return err
+			}
+			utils.SetGlobalLoggingLevel(logLevel)
+			samplesPath, err := cmd.Flags().GetString(samplesFlagName)
+			if err != nil {
+				return err
+			}
+			options := CheckerOptions{}
+			if options.Addons, err = cmd.Flags().GetStringSlice(addonsFlagName); err != nil {
+				return err
+			}
+			if options.Repeat, err = cmd.Flags().GetInt(repeatFlagName); err != nil {
+				return err
+			}
+			if options.NodeTimeout, err = cmd.Flags().GetDuration(nodeTimeoutFlagName); err != nil {
+				return err
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			signalChan := make(chan os.Signal, 1)
+			signal.Notify(signalChan, os.Interrupt)
+			defer signal.Stop(signalChan)
+			go func() {
+				select {
+				case <-signalChan:
+					cancel()
+				case <-ctx.Done():
+				}
+			}()
+
+			spec, err := keepertest.GetSpecsFromPath(specPath, chainID, nil, nil)
+			if err != nil {
+				return utils.LavaFormatError("failed loading spec", err, utils.LogAttr("path", specPath), utils.LogAttr("chainID", chainID))
+			}
+			if _, err := spec.ValidateSpec(spectypes.DefaultMaxCU); err != nil {
+				return utils.LavaFormatError("spec failed validation", err, utils.LogAttr("chainID", chainID))
+			}
+			samples, err := LoadSamples(samplesPath)
+			if err != nil {
+				return err
+			}
+			checker, err := NewCheckerForNode(ctx, spec, apiInterface, nodeUrl, samples, options)
+			if err != nil {
+				return err
+			}
+			report := checker.Run(ctx, apiInterface)
+			report.Print(cmd.OutOrStdout())
+			if failed := len(report.Failed()); failed > 0 {
+				return fmt.Errorf("%d apis of %s %s failed the check", failed, chainID, apiInterface)
+			}
+			return nil
+		},
+	}
+	cmdSpecCheck.Flags().String(samplesFlagName, "", "yaml file with the requests to call apis with, by api name")
+	cmdSpecCheck.Flags().Int(repeatFlagName, defaultRepeat, "times to call every api, responses of deterministic apis for a specific block must be the same in all calls")
+	cmdSpecCheck.Flags().Duration(nodeTimeoutFlagName, defaultNodeTimeout, "timeout of a single call to the node")
+	cmdSpecCheck.Flags().StringSlice(addonsFlagName, []string{}, "addons and extensions the node supports, apis of other addons are not checked")
+	cmdSpecCheck.Flags().String(flags.FlagLogLevel, "warn", "log level")
+	return cmdSpecCheck
+}
+
+// NewCheckerForNode creates a checker that sends the spec apis to the node at nodeUrl
+func NewCheckerForNode(ctx context.Context, spec spectypes.Spec, apiInterface string, nodeUrl string, samples map[string]ApiSample, options CheckerOptions) (*Checker, error) {
+	chainParser, err := chainlib.NewChainParser(apiInterface)
+	if err != nil {
+		return nil, err
+	}
+	chainParser.SetSpec(spec)
+	chainParser.SetPolicy(&checkPolicy{addons: options.Addons}, spec.Index, apiInterface)
+	endpoint := &lavasession.RPCProviderEndpoint{
+		ChainID:      spec.Index,
+		ApiInterface: apiInterface,
+		NodeUrls:     []common.NodeUrl{{Url: nodeUrl, Addons: options.Addons}},
+	}
+	chainRouter, err := chainlib.GetChainRouter(ctx, 1, endpoint, chainParser)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed connecting to the node", err, utils.LogAttr("nodeUrl", nodeUrl))
+	}
+	return NewChecker(spec, chainParser, chainRouter, samples, options), nil
+}
+
+func (r *Report) Print(out io.Writer) {
+	writer := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "API\tADDON\tCU\tSTATUS\tLATENCY\tDETAILS")
+	for _, result := range r.Results {
+		latency := ""
+		if result.Latency > 0 {
+			latency = result.Latency.Round(time.Millisecond).String()
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%d\t%s\t%s\t%s\n", result.Api, result.Addon, result.ComputeUnits, result.Status, latency, strings.ReplaceAll(result.Details, "\n", " "))
+	}
+	writer.Flush()
+	summary := []string{}
+	for _, status := range []string{StatusOK, StatusSkipped, StatusUnsupported, StatusNodeError, StatusParserFailure, StatusNonDeterministic, StatusSlow} {
+		summary = append(summary, fmt.Sprintf("%s: %d", status, r.Count(status)))
+	}
+	fmt.Fprintf(out, "\n%s %s checked %d apis, %s\n", r.ChainID, r.ApiInterface, len(r.Results), strings.Join(summary, ", "))
+}
@@ -0,0 +1,187 @@
+package speccheck
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/x/spec/client/utils"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNode(t *testing.T) *httptest.Server {
+	randomCounter := int64(0)
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		request := struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}{}
+		require.NoError(t, json.Unmarshal(body, &request))
+		result := ""
+		switch request.Method {
+		case "eth_blockNumber":
+			result = `"0x20"`
+		case "eth_getBlockByNumber":
+			result = `{"number":"0x10","hash":"0xabc"}`
+		case "eth_random":
+			result = fmt.Sprintf(`"%d"`, atomic.AddInt64(&randomCounter, 1))
+		case "eth_slow":
+			time.Sleep(100 * time.Millisecond)
+			result = `"slow"`
+		default:
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"error":{"code":-32601,"message":"the method %s does not exist/is not available"}}`, request.ID, request.Method)
+			return
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":%s}`, request.ID, result)
+	}))
+	t.Cleanup(node.Close)
+	return node
+}
+
+func createApi(name string, parserFunc spectypes.PARSER_FUNC, parserArg []string, deterministic bool) *spectypes.Api {
+	return &spectypes.Api{
+		Enabled:      true,
+		Name:         name,
+		ComputeUnits: 10,
+		Category:     spectypes.SpecCategory{Deterministic: deterministic},
+		BlockParsing: spectypes.BlockParser{ParserFunc: parserFunc, ParserArg: parserArg},
+	}
+}
+
+func writeSpecs(t *testing.T) string {
+	base := spectypes.Spec{
+		Index:                         "BASE",
+		Name:                          "base",
+		Enabled:                       false,
+		ReliabilityThreshold:          268435455,
+		AverageBlockTime:              1000,
+		AllowedBlockLagForQosSync:     2,
+		BlocksInFinalizationProof:     1,
+		BlockDistanceForFinalizedData: 1,
+		MinStakeProvider:              sdk.NewCoin("ulava", sdk.NewInt(1000)),
+		ApiCollections: []*spectypes.ApiCollection{
+			{
+				Enabled:        true,
+				CollectionData: spectypes.CollectionData{ApiInterface: spectypes.APIInterfaceJsonRPC, Type: http.MethodPost},
+				Apis: []*spectypes.Api{
+					createApi("eth_blockNumber", spectypes.PARSER_FUNC_DEFAULT, []string{"latest"}, false),
+					createApi("eth_getBlockByNumber", spectypes.PARSER_FUNC_PARSE_BY_ARG, []string{"0"}, true),
+					createApi("eth_chainId", spectypes.PARSER_FUNC_DEFAULT, []string{"latest"}, true),
+				},
+				ParseDirectives: []*spectypes.ParseDirective{
+					{FunctionTag: spectypes.FUNCTION_TAG_GET_BLOCKNUM, FunctionTemplate: `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`, ApiName: "eth_blockNumber", ResultParsing: spectypes.BlockParser{ParserFunc: spectypes.PARSER_FUNC_PARSE_BY_ARG, ParserArg: []string{"0"}}},
+				},
+			},
+		},
+	}
+	slowApi := createApi("eth_slow", spectypes.PARSER_FUNC_DEFAULT, []string{"latest"}, false)
+	slowApi.TimeoutMs = 50
+	subscriptionApi := createApi("eth_subscribe", spectypes.PARSER_FUNC_EMPTY, nil, false)
+	subscriptionApi.Category.Subscription = true
+	chain := base
+	chain.Index, chain.Name, chain.Enabled, chain.Imports = "TEST", "test", true, []string{"BASE"}
+	chain.ApiCollections = []*spectypes.ApiCollection{
+		{
+			Enabled:        true,
+			CollectionData: spectypes.CollectionData{ApiInterface: spectypes.APIInterfaceJsonRPC, Type: http.MethodPost},
+			Apis: []*spectypes.Api{
+				createApi("eth_getBalance", spectypes.PARSER_FUNC_PARSE_BY_ARG, []string{"1"}, true),
+				createApi("eth_random", spectypes.PARSER_FUNC_PARSE_BY_ARG, []string{"0"}, true),
+				createApi("eth_getLogs", spectypes.PARSER_FUNC_PARSE_CANONICAL, []string{"0", "toBlock"}, true),
+				slowApi,
+				subscriptionApi,
+			},
+		},
+		{
+			Enabled:        true,
+			CollectionData: spectypes.CollectionData{ApiInterface: spectypes.APIInterfaceJsonRPC, Type: http.MethodPost, AddOn: "debug"},
+			Apis: []*spectypes.Api{
+				createApi("debug_traceBlockByNumber", spectypes.PARSER_FUNC_PARSE_BY_ARG, []string{"0"}, true),
+			},
+		},
+	}
+
+	dir := t.TempDir()
+	// the chain is written first, imports are resolved after all files are loaded
+	for idx, spec := range []spectypes.Spec{chain, base} {
+		proposal := utils.SpecAddProposalJSON{Proposal: spectypes.SpecAddProposal{Title: "add", Description: "add", Specs: []spectypes.Spec{spec}}, Deposit: "10000000ulava"}
+		data, err := json.Marshal(proposal)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, fmt.Sprintf("spec_%d.json", idx)), data, 0o644))
+	}
+	return dir
+}
+
+func TestSpecCheck(t *testing.T) {
+	ctx := context.Background()
+	node := createNode(t)
+	spec, err := keepertest.GetSpecsFromPath(writeSpecs(t), "TEST", nil, nil)
+	require.NoError(t, err)
+	_, err = spec.ValidateSpec(spectypes.DefaultMaxCU)
+	require.NoError(t, err)
+
+	samplesPath := filepath.Join(t.TempDir(), "samples.yml")
+	require.NoError(t, os.WriteFile(samplesPath, []byte(`samples:
+  eth_getBlockByNumber:
+    data: '["0x10", false]'
+  eth_getBalance:
+    data: '["0xabc"]'
+  eth_random:
+    data: '["0x5"]'
+`), 0o644))
+	samples, err := LoadSamples(samplesPath)
+	require.NoError(t, err)
+
+	checker, err := NewCheckerForNode(ctx, spec, spectypes.APIInterfaceJsonRPC, node.URL, samples, CheckerOptions{Repeat: 2})
+	require.NoError(t, err)
+	report := checker.Run(ctx, spectypes.APIInterfaceJsonRPC)
+
+	statuses := map[string]string{}
+	for _, result := range report.Results {
+		statuses[result.Api] = result.Status
+	}
+	require.Equal(t, map[string]string{
+		"eth_blockNumber":      StatusOK,
+		"eth_getBlockByNumber": StatusOK,
+		"eth_chainId":          StatusUnsupported,
+		"eth_getBalance":       StatusParserFailure,
+		"eth_random":           StatusNonDeterministic,
+		"eth_getLogs":          StatusSkipped,
+		"eth_slow":             StatusSlow,
+		"eth_subscribe":        StatusSkipped,
+	}, statuses)
+	require.Len(t, report.Failed(), 4)
+
+	out := &bytes.Buffer{}
+	report.Print(out)
+	require.Contains(t, out.String(), "TEST jsonrpc checked 8 apis")
+	require.True(t, strings.Contains(out.String(), "eth_getBalance") && strings.Contains(out.String(), StatusParserFailure))
+
+	// apis of addons are checked only when the node supports them
+	checker, err = NewCheckerForNode(ctx, spec, spectypes.APIInterfaceJsonRPC, node.URL, map[string]ApiSample{"debug_traceBlockByNumber": {Data: `["0x10"]`}}, CheckerOptions{Addons: []string{"debug"}})
+	require.NoError(t, err)
+	report = checker.Run(ctx, spectypes.APIInterfaceJsonRPC)
+	found := false
+	for _, result := range report.Results {
+		if result.Api == "debug_traceBlockByNumber" {
+			found = true
+			require.Equal(t, "debug", result.Addon)
+			require.Equal(t, StatusUnsupported, result.Status)
+		}
+	}
+	require.True(t, found)
+}
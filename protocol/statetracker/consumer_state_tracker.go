@@ -19,6 +19,7 @@ import (
 
 type ConsumerTxSenderInf interface {
 	TxSenderConflictDetection(ctx context.Context, finalizationConflict *conflicttypes.FinalizationConflict, responseConflict *conflicttypes.ResponseConflict, sameProviderConflict *conflicttypes.FinalizationConflict) error
+	TxSenderLatestBlockConflictDetection(ctx context.Context, latestBlockConflict *conflicttypes.LatestBlockConflict) error
 }
 
 // ConsumerStateTracker CSTis a class for tracking consumer data from the lava blockchain, such as epoch changes.
@@ -106,6 +107,21 @@ func (cst *ConsumerStateTracker) TxConflictDetection(ctx context.Context, finali
 	return err
 }
 
+func (cst *ConsumerStateTracker) TxLatestBlockConflictDetection(ctx context.Context, latestBlockConflict *conflicttypes.LatestBlockConflict, conflictHandler common.ConflictHandlerInterface) error {
+	if cst.disableConflictTransactions {
+		utils.LavaFormatInfo("found latest block conflict, but transactions are disabled, returning")
+		return nil
+	}
+	if conflictHandler.ConflictAlreadyReported() {
+		return nil // already reported
+	}
+	err := cst.TxSenderLatestBlockConflictDetection(ctx, latestBlockConflict)
+	if err == nil {
+		conflictHandler.StoreConflictReported()
+	}
+	return err
+}
+
 func (cst *ConsumerStateTracker) RegisterForSpecUpdates(ctx context.Context, specUpdatable updaters.SpecUpdatable, endpoint lavasession.RPCEndpoint) error {
 	// register for spec updates sets spec and updates when a spec has been modified
 	specUpdater := updaters.NewSpecUpdater(endpoint.ChainID, cst.stateQuery, cst.EventTracker)
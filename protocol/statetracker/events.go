@@ -18,10 +18,11 @@ import (
 	"github.com/lavanet/lava/app"
 	"github.com/lavanet/lava/protocol/chainlib"
 	"github.com/lavanet/lava/protocol/chaintracker"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/remotesigner"
 	updaters "github.com/lavanet/lava/protocol/statetracker/updaters"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/rand"
-	"github.com/lavanet/lava/utils/sigs"
 	"github.com/spf13/cobra"
 )
 
@@ -213,23 +214,19 @@ lavad test events 100 5000 --value banana // show all events from 5000-5100 and
 				utils.LavaFormatFatal("failed to read value flag", err)
 			}
 			if value == "" {
-				// look for a value that is from the --from flag
+				// look for a value that is the address of the --from flag or the remote signer
 				from, err := cmd.Flags().GetString(flags.FlagFrom)
 				if err != nil {
 					utils.LavaFormatFatal("failed to read from flag", err)
 				}
-				if from != "" {
-					keyName, err := sigs.GetKeyName(clientCtx)
-					if err != nil {
-						utils.LavaFormatFatal("failed getting key name from clientCtx, either provider the address in an argument or verify the --from wallet exists", err)
-					}
-					clientKey, err := clientCtx.Keyring.Key(keyName)
-					if err != nil {
-						return err
-					}
-					addr, err := clientKey.GetAddress()
+				remoteSignerSocket, err := cmd.Flags().GetString(common.RemoteSignerFlag)
+				if err != nil {
+					utils.LavaFormatFatal("failed to read remote signer flag", err)
+				}
+				if from != "" || remoteSignerSocket != "" {
+					addr, err := remotesigner.GetSignerAddress(ctx, clientCtx, remoteSignerSocket)
 					if err != nil {
-						return err
+						utils.LavaFormatFatal("failed getting the address, either provide the value in a flag or verify the --from wallet exists", err)
 					}
 					value = addr.String()
 				}
@@ -293,6 +290,7 @@ lavad test events 100 5000 --value banana // show all events from 5000-5100 and
 	flags.AddQueryFlagsToCmd(cmdEvents)
 	flags.AddKeyringFlags(cmdEvents.Flags())
 	cmdEvents.Flags().String(flags.FlagFrom, "", "Name or address of wallet from which to read address, and look for it in value")
+	cmdEvents.Flags().String(common.RemoteSignerFlag, "", "unix socket of a remote signer (lavap signer) whose address is looked for in value, instead of the --from wallet")
 	cmdEvents.Flags().Duration(FlagTimeout, 5*time.Minute, "the time to listen for events, defaults to 5m")
 	cmdEvents.Flags().String(FlagValue, "", "used to show only events that has this value in one of the attributes")
 	cmdEvents.Flags().Bool(FlagBreak, false, "if true will break after reading the specified amount of blocks instead of listening forward")
@@ -79,8 +79,8 @@ func (pst *ProviderStateTracker) RegisterForSpecVerifications(ctx context.Contex
 	return specUpdater.RegisterSpecVerifier(ctx, &specVerifier, chainId)
 }
 
-func (pst *ProviderStateTracker) RegisterForVersionUpdates(ctx context.Context, version *protocoltypes.Version, versionValidator updaters.VersionValidationInf) {
-	versionUpdater := updaters.NewVersionUpdater(pst.stateQuery, pst.EventTracker, version, versionValidator)
+func (pst *ProviderStateTracker) RegisterForVersionUpdates(ctx context.Context, version *protocoltypes.Version, versionValidator updaters.VersionValidationInf, chainIDs ...string) {
+	versionUpdater := updaters.NewVersionUpdater(pst.stateQuery, pst.EventTracker, version, versionValidator, chainIDs...)
 	versionUpdaterRaw := pst.StateTracker.RegisterForUpdates(ctx, versionUpdater)
 	versionUpdater, ok := versionUpdaterRaw.(*updaters.VersionUpdater)
 	if !ok {
@@ -163,8 +163,9 @@ func (pst *ProviderStateTracker) GetEpochSizeMultipliedByRecommendedEpochNumToCo
 	return pst.stateQuery.GetEpochSizeMultipliedByRecommendedEpochNumToCollectPayment(ctx)
 }
 
-func (pst *ProviderStateTracker) GetProtocolVersion(ctx context.Context) (*updaters.ProtocolVersionResponse, error) {
-	return pst.stateQuery.GetProtocolVersion(ctx)
+// GetProtocolVersion returns the global protocol version, raised to the effective version of the given chains
+func (pst *ProviderStateTracker) GetProtocolVersion(ctx context.Context, chainIDs ...string) (*updaters.ProtocolVersionResponse, error) {
+	return updaters.FetchProtocolVersion(ctx, pst.stateQuery, chainIDs)
 }
 
 func (pst *ProviderStateTracker) GetAverageBlockTime() time.Duration {
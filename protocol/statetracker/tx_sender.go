@@ -308,6 +308,15 @@ func (ts *ConsumerTxSender) TxSenderConflictDetection(ctx context.Context, final
 	return nil
 }
 
+func (ts *ConsumerTxSender) TxSenderLatestBlockConflictDetection(ctx context.Context, latestBlockConflict *conflicttypes.LatestBlockConflict) error {
+	msg := conflicttypes.NewMsgLatestBlockConflictDetection(ts.clientCtx.FromAddress.String(), latestBlockConflict)
+	err := ts.SimulateAndBroadCastTxWithRetryOnSeqMismatch(msg, false)
+	if err != nil {
+		return utils.LavaFormatError("latestBlockConflict - SimulateAndBroadCastTx Failed", err)
+	}
+	return nil
+}
+
 type ProviderTxSender struct {
 	*TxSender
 }
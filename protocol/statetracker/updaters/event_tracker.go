@@ -3,6 +3,7 @@ package updaters
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/lavanet/lava/utils"
 	conflicttypes "github.com/lavanet/lava/x/conflict/types"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	protocoltypes "github.com/lavanet/lava/x/protocol/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 )
 
@@ -116,6 +118,10 @@ func (et *EventTracker) getLatestVersionEvents(latestBlock int64) (updated bool,
 				}
 			}
 		}
+		// chain versions are set by governance proposals as well
+		if event.Type == utils.EventPrefix+protocoltypes.SetChainVersionEventName {
+			return true, nil
+		}
 	}
 	return false, nil
 }
@@ -139,24 +145,40 @@ func (et *EventTracker) getLatestDowntimeParamsUpdateEvents(latestBlock int64) (
 	return false, nil
 }
 
-func (et *EventTracker) getLatestSpecModifyEvents(latestBlock int64) (updated bool, err error) {
+// getLatestSpecModifyEvents returns whether specs were modified in the latest block, and
+// the (latest) block in which the modifications take effect
+func (et *EventTracker) getLatestSpecModifyEvents(latestBlock int64) (updated bool, activationBlock uint64, err error) {
 	// SpecModifyEventName
 	et.lock.RLock()
 	defer et.lock.RUnlock()
 	if et.latestUpdatedBlock != latestBlock {
-		return false, utils.LavaFormatWarning("event results are different than expected", nil, utils.Attribute{Key: "requested latestBlock", Value: latestBlock}, utils.Attribute{Key: "current latestBlock", Value: et.latestUpdatedBlock})
+		return false, 0, utils.LavaFormatWarning("event results are different than expected", nil, utils.Attribute{Key: "requested latestBlock", Value: latestBlock}, utils.Attribute{Key: "current latestBlock", Value: et.latestUpdatedBlock})
 	}
 	eventsListToListenTo := []string{
 		utils.EventPrefix + spectypes.SpecModifyEventName,
 		utils.EventPrefix + spectypes.SpecRefreshEventName,
 	}
+	activationBlock = uint64(latestBlock)
 	for _, event := range et.blockResults.EndBlockEvents {
 		if slices.Contains(eventsListToListenTo, event.Type) {
 			utils.LavaFormatInfo("Spec update event identified", utils.LogAttr("Event", event.Type))
-			return true, nil
+			updated = true
+			for _, attribute := range event.Attributes {
+				if attribute.Key != "activationBlock" {
+					continue
+				}
+				block, err := strconv.ParseUint(attribute.Value, 10, 64)
+				if err != nil {
+					utils.LavaFormatWarning("failed parsing spec activation block", err, utils.LogAttr("Event", event.Type), utils.LogAttr("value", attribute.Value))
+					continue
+				}
+				if block > activationBlock {
+					activationBlock = block
+				}
+			}
 		}
 	}
-	return false, nil
+	return updated, activationBlock, nil
 }
 
 func (et *EventTracker) getLatestVoteEvents(latestBlock int64) (votes []*reliabilitymanager.VoteParams, err error) {
@@ -7,6 +7,7 @@ import (
 	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/utils"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	planstypes "github.com/lavanet/lava/x/plans/types"
 	"golang.org/x/net/context"
 )
@@ -132,18 +133,33 @@ func (pu *PairingUpdater) Update(latestBlock int64) {
 }
 
 func (pu *PairingUpdater) updateConsummerSessionManager(ctx context.Context, pairingList []epochstoragetypes.StakeEntry, consumerSessionManager *lavasession.ConsumerSessionManager, epoch uint64) (err error) {
-	pairingListForThisCSM, err := pu.filterPairingListByEndpoint(ctx, planstypes.Geolocation(consumerSessionManager.RPCEndpoint().Geolocation), pairingList, consumerSessionManager.RPCEndpoint(), epoch)
+	maintenances, err := pu.stateQuery.GetUpcomingMaintenance(ctx, consumerSessionManager.RPCEndpoint().ChainID)
+	if err != nil {
+		utils.LavaFormatWarning("failed fetching providers maintenance, not excluding providers in maintenance", err, utils.LogAttr("chainID", consumerSessionManager.RPCEndpoint().ChainID))
+	}
+	pairingListForThisCSM, err := pu.filterPairingListByEndpoint(ctx, planstypes.Geolocation(consumerSessionManager.RPCEndpoint().Geolocation), pairingList, consumerSessionManager.RPCEndpoint(), epoch, providersInMaintenance(maintenances, epoch))
 	if err != nil {
 		return err
 	}
+	chainVersion, err := pu.stateQuery.GetChainProtocolVersion(ctx, consumerSessionManager.RPCEndpoint().ChainID)
+	if err != nil {
+		utils.LavaFormatWarning("failed fetching chain protocol version, keeping the previous one", err, utils.LogAttr("chainID", consumerSessionManager.RPCEndpoint().ChainID))
+	} else {
+		consumerSessionManager.SetChainVersion(chainVersion)
+	}
 	err = consumerSessionManager.UpdateAllProviders(epoch, pairingListForThisCSM)
 	return
 }
 
-func (pu *PairingUpdater) filterPairingListByEndpoint(ctx context.Context, currentGeo planstypes.Geolocation, pairingList []epochstoragetypes.StakeEntry, rpcEndpoint lavasession.RPCEndpoint, epoch uint64) (filteredList map[uint64]*lavasession.ConsumerSessionsWithProvider, err error) {
+func (pu *PairingUpdater) filterPairingListByEndpoint(ctx context.Context, currentGeo planstypes.Geolocation, pairingList []epochstoragetypes.StakeEntry, rpcEndpoint lavasession.RPCEndpoint, epoch uint64, maintenanceProviders map[string]struct{}) (filteredList map[uint64]*lavasession.ConsumerSessionsWithProvider, err error) {
 	// go over stake entries, and filter endpoints that match geolocation and api interface
 	pairing := map[uint64]*lavasession.ConsumerSessionsWithProvider{}
 	for providerIdx, provider := range pairingList {
+		// providers in a maintenance window are planned-offline, even while they are still in the epoch's pairing
+		if _, inMaintenance := maintenanceProviders[provider.Address]; inMaintenance {
+			utils.LavaFormatDebug("skipping provider in maintenance", utils.Attribute{Key: "Address", Value: provider.Address}, utils.Attribute{Key: "ChainID", Value: provider.Chain}, utils.Attribute{Key: "epoch", Value: epoch})
+			continue
+		}
 		//
 		// Sanity
 		providerEndpoints := provider.GetEndpoints()
@@ -201,3 +217,14 @@ func (pu *PairingUpdater) filterPairingListByEndpoint(ctx context.Context, curre
 	// replace previous pairing with new providers
 	return pairing, nil
 }
+
+// providersInMaintenance returns the providers whose maintenance window includes the epoch
+func providersInMaintenance(maintenances []pairingtypes.ProviderMaintenance, epoch uint64) map[string]struct{} {
+	providers := map[string]struct{}{}
+	for _, maintenance := range maintenances {
+		if maintenance.StartBlock <= epoch && epoch < maintenance.EndBlock {
+			providers[maintenance.Provider] = struct{}{}
+		}
+	}
+	return providers
+}
@@ -0,0 +1,24 @@
+package updaters
+
+import (
+	"testing"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvidersInMaintenance(t *testing.T) {
+	maintenances := []pairingtypes.ProviderMaintenance{
+		{Provider: "ongoing", StartBlock: 20, EndBlock: 40},
+		{Provider: "starting", StartBlock: 30, EndBlock: 50},
+		{Provider: "upcoming", StartBlock: 40, EndBlock: 60},
+		{Provider: "ending", StartBlock: 10, EndBlock: 30},
+	}
+
+	providers := providersInMaintenance(maintenances, 30)
+	require.Len(t, providers, 2)
+	require.Contains(t, providers, "ongoing")
+	require.Contains(t, providers, "starting")
+
+	require.Empty(t, providersInMaintenance(nil, 30))
+}
@@ -18,6 +18,7 @@ const (
 
 type SpecGetter interface {
 	GetSpec(ctx context.Context, chainID string) (*spectypes.Spec, error)
+	GetSpecAtBlock(ctx context.Context, chainID string, block uint64) (*spectypes.Spec, uint64, error)
 }
 
 type SpecUpdatable interface {
@@ -41,6 +42,7 @@ type SpecUpdater struct {
 	specVerifiers    map[string]*SpecVerifier
 	spec             *spectypes.Spec
 	shouldUpdate     bool
+	pendingBlock     uint64 // activation block of a scheduled spec update (0 if none)
 }
 
 func NewSpecUpdater(chainId string, specGetter SpecGetter, eventTracker *EventTracker) *SpecUpdater {
@@ -142,6 +144,42 @@ func (su *SpecUpdater) updateInner(latestBlock int64) {
 	su.shouldUpdate = false // update was successful
 }
 
+// only call when locked
+func (su *SpecUpdater) checkPendingSpec(latestBlock int64, activationBlock uint64) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	spec, specActivationBlock, err := su.specGetter.GetSpecAtBlock(timeoutCtx, su.chainId, activationBlock)
+	if err != nil {
+		// cannot tell whether our spec is affected: refresh it when the update takes effect
+		utils.LavaFormatWarning("could not get pending spec, will update specs at activation block", err,
+			utils.LogAttr("chainId", su.chainId),
+			utils.LogAttr("activationBlock", activationBlock),
+		)
+		su.setPendingBlock(activationBlock)
+		return
+	}
+	if specActivationBlock != activationBlock {
+		// the scheduled spec update does not affect this chain
+		return
+	}
+	utils.LavaFormatInfo("SpecUpdater: spec update scheduled for chainId",
+		utils.LogAttr("chainId", su.chainId),
+		utils.LogAttr("activationBlock", activationBlock),
+		utils.LogAttr("blocksLeft", activationBlock-uint64(latestBlock)),
+	)
+	for _, specVerifier := range su.specVerifiers {
+		go (*specVerifier).VerifySpec(*spec)
+	}
+	su.setPendingBlock(activationBlock)
+}
+
+// only call when locked
+func (su *SpecUpdater) setPendingBlock(activationBlock uint64) {
+	if su.pendingBlock == 0 || activationBlock < su.pendingBlock {
+		su.pendingBlock = activationBlock
+	}
+}
+
 func (su *SpecUpdater) Reset(latestBlock int64) {
 	utils.LavaFormatDebug("Reset state called on Spec Updater", utils.LogAttr("block", latestBlock))
 	su.lock.Lock()
@@ -153,13 +191,22 @@ func (su *SpecUpdater) Reset(latestBlock int64) {
 func (su *SpecUpdater) Update(latestBlock int64) {
 	su.lock.Lock()
 	defer su.lock.Unlock()
+	if su.pendingBlock != 0 && uint64(latestBlock) >= su.pendingBlock {
+		// a scheduled spec update took effect
+		su.pendingBlock = 0
+		su.shouldUpdate = true
+	}
 	if su.shouldUpdate {
 		su.updateInner(latestBlock)
 	} else {
-		specUpdated, err := su.eventTracker.getLatestSpecModifyEvents(latestBlock)
-		if specUpdated || err != nil {
+		specUpdated, activationBlock, err := su.eventTracker.getLatestSpecModifyEvents(latestBlock)
+		if err != nil || (specUpdated && activationBlock <= uint64(latestBlock)) {
 			su.shouldUpdate = true
 			su.updateInner(latestBlock)
+		} else if specUpdated {
+			// spec modifications take effect in a future block: notify in
+			// advance, and update the specs once they become effective
+			su.checkPendingSpec(latestBlock, activationBlock)
 		}
 	}
 }
@@ -75,6 +75,14 @@ func (csq *StateQuery) GetProtocolVersion(ctx context.Context) (*ProtocolVersion
 	return &ProtocolVersionResponse{BlockNumber: blockHeight, Version: &param.Params.Version}, nil
 }
 
+func (csq *StateQuery) GetChainProtocolVersion(ctx context.Context, chainID string) (*protocoltypes.Version, error) {
+	res, err := csq.ProtocolClient.ChainVersion(ctx, &protocoltypes.QueryChainVersionRequest{ChainId: chainID})
+	if err != nil {
+		return nil, err
+	}
+	return &res.Version, nil
+}
+
 func (csq *StateQuery) GetSpec(ctx context.Context, chainID string) (*spectypes.Spec, error) {
 	spec, err := csq.SpecQueryClient.Spec(ctx, &spectypes.QueryGetSpecRequest{
 		ChainID: chainID,
@@ -85,6 +93,22 @@ func (csq *StateQuery) GetSpec(ctx context.Context, chainID string) (*spectypes.
 	return &spec.Spec, nil
 }
 
+// GetSpecAtBlock returns the spec version that is in effect at a specific block
+// (which may be a future block), and the block in which that version took effect
+func (csq *StateQuery) GetSpecAtBlock(ctx context.Context, chainID string, block uint64) (*spectypes.Spec, uint64, error) {
+	res, err := csq.SpecQueryClient.SpecAtBlock(ctx, &spectypes.QuerySpecAtBlockRequest{
+		ChainID: chainID,
+		Block:   block,
+	})
+	if err != nil {
+		return nil, 0, utils.LavaFormatError("Failed Querying spec at block for chain", err,
+			utils.Attribute{Key: "ChainID", Value: chainID},
+			utils.Attribute{Key: "block", Value: block},
+		)
+	}
+	return &res.Spec, res.ActivationBlock, nil
+}
+
 func (csq *StateQuery) GetDowntimeParams(ctx context.Context) (*downtimev1.Params, error) {
 	res, err := csq.DowntimeClient.QueryParams(ctx, &downtimev1.QueryParamsRequest{})
 	if err != nil {
@@ -159,6 +183,15 @@ func (csq *ConsumerStateQuery) GetPairing(ctx context.Context, chainID string, l
 	return pairingResp.Providers, pairingResp.CurrentEpoch, pairingResp.BlockOfNextPairing, nil
 }
 
+// GetUpcomingMaintenance returns the ongoing and upcoming maintenance windows of the chain's providers
+func (csq *ConsumerStateQuery) GetUpcomingMaintenance(ctx context.Context, chainID string) ([]pairingtypes.ProviderMaintenance, error) {
+	res, err := csq.PairingQueryClient.UpcomingMaintenance(ctx, &pairingtypes.QueryUpcomingMaintenanceRequest{ChainId: chainID})
+	if err != nil {
+		return nil, err
+	}
+	return res.Maintenances, nil
+}
+
 func (csq *ConsumerStateQuery) GetMaxCUForUser(ctx context.Context, chainID string, epoch uint64) (maxCu uint64, err error) {
 	address := csq.clientCtx.FromAddress.String()
 	UserEntryRes, err := csq.PairingQueryClient.UserEntry(ctx, &pairingtypes.QueryUserEntryRequest{ChainID: chainID, Address: address, Block: epoch})
@@ -15,6 +15,7 @@ const (
 
 type VersionStateQuery interface {
 	GetProtocolVersion(ctx context.Context) (*ProtocolVersionResponse, error)
+	GetChainProtocolVersion(ctx context.Context, chainID string) (*protocoltypes.Version, error)
 }
 
 type VersionValidationInf interface {
@@ -28,10 +29,30 @@ type VersionUpdater struct {
 	LastKnownVersion     *ProtocolVersionResponse
 	VersionValidationInf // embedding the interface, this tells: VersionUpdater has ValidateProtocolVersion method
 	shouldUpdate         bool
+	// the chains served by the process, their minimum versions raise the global version
+	ChainIDs []string
 }
 
-func NewVersionUpdater(versionStateQuery VersionStateQuery, eventTracker *EventTracker, version *protocoltypes.Version, versionValidator VersionValidationInf) *VersionUpdater {
-	return &VersionUpdater{VersionStateQuery: versionStateQuery, eventTracker: eventTracker, LastKnownVersion: &ProtocolVersionResponse{Version: version, BlockNumber: "uninitialized"}, VersionValidationInf: versionValidator}
+func NewVersionUpdater(versionStateQuery VersionStateQuery, eventTracker *EventTracker, version *protocoltypes.Version, versionValidator VersionValidationInf, chainIDs ...string) *VersionUpdater {
+	return &VersionUpdater{VersionStateQuery: versionStateQuery, eventTracker: eventTracker, LastKnownVersion: &ProtocolVersionResponse{Version: version, BlockNumber: "uninitialized"}, VersionValidationInf: versionValidator, ChainIDs: chainIDs}
+}
+
+// FetchProtocolVersion returns the global protocol version, raised to the effective version of each of the given chains
+func FetchProtocolVersion(ctx context.Context, versionStateQuery VersionStateQuery, chainIDs []string) (*ProtocolVersionResponse, error) {
+	version, err := versionStateQuery.GetProtocolVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	effectiveVersion := *version.Version
+	for _, chainID := range chainIDs {
+		chainVersion, err := versionStateQuery.GetChainProtocolVersion(ctx, chainID)
+		if err != nil {
+			return nil, utils.LavaFormatWarning("failed fetching chain protocol version", err, utils.LogAttr("chainID", chainID))
+		}
+		effectiveVersion = effectiveVersion.Max(*chainVersion)
+	}
+	version.Version = &effectiveVersion
+	return version, nil
 }
 
 func (vu *VersionUpdater) UpdaterKey() string {
@@ -52,7 +73,7 @@ func (vu *VersionUpdater) updateInner(latestBlock int64) {
 	// fetch updated version from consensus
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), time.Second*3)
 	defer cancel()
-	version, err := vu.VersionStateQuery.GetProtocolVersion(timeoutCtx)
+	version, err := FetchProtocolVersion(timeoutCtx, vu.VersionStateQuery, vu.ChainIDs)
 	if err != nil {
 		utils.LavaFormatError("could not get version when updated, did not update protocol version and needed to", err)
 		return
@@ -0,0 +1,51 @@
+package updaters
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	protocoltypes "github.com/lavanet/lava/x/protocol/types"
+	"github.com/stretchr/testify/require"
+)
+
+type versionStateQueryTest struct {
+	global protocoltypes.Version
+	chains map[string]protocoltypes.Version
+}
+
+func (vsqt *versionStateQueryTest) GetProtocolVersion(ctx context.Context) (*ProtocolVersionResponse, error) {
+	version := vsqt.global
+	return &ProtocolVersionResponse{Version: &version, BlockNumber: "10"}, nil
+}
+
+func (vsqt *versionStateQueryTest) GetChainProtocolVersion(ctx context.Context, chainID string) (*protocoltypes.Version, error) {
+	version, ok := vsqt.chains[chainID]
+	if !ok {
+		return nil, fmt.Errorf("chain %s not found", chainID)
+	}
+	return &version, nil
+}
+
+func TestFetchProtocolVersion(t *testing.T) {
+	global := protocoltypes.Version{ProviderTarget: "1.2.0", ProviderMin: "1.1.0", ConsumerTarget: "1.2.0", ConsumerMin: "1.1.0"}
+	query := &versionStateQueryTest{global: global, chains: map[string]protocoltypes.Version{
+		"LAV1": global,
+		"ETH1": global.ApplyChainVersion(protocoltypes.ChainVersion{ChainId: "ETH1", ProviderMin: "1.3.0"}),
+		"BTC":  global.ApplyChainVersion(protocoltypes.ChainVersion{ChainId: "BTC", ConsumerMin: "1.1.5"}),
+	}}
+
+	// no chains: the global version
+	version, err := FetchProtocolVersion(context.Background(), query, nil)
+	require.NoError(t, err)
+	require.Equal(t, global, *version.Version)
+	require.Equal(t, "10", version.BlockNumber)
+
+	// the served chains raise the global version
+	version, err = FetchProtocolVersion(context.Background(), query, []string{"LAV1", "ETH1", "BTC"})
+	require.NoError(t, err)
+	require.Equal(t, protocoltypes.Version{ProviderTarget: "1.3.0", ProviderMin: "1.3.0", ConsumerTarget: "1.2.0", ConsumerMin: "1.1.5"}, *version.Version)
+
+	_, err = FetchProtocolVersion(context.Background(), query, []string{"LAV1", "COS3"})
+	require.Error(t, err)
+}
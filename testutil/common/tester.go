@@ -355,7 +355,7 @@ func (ts *Tester) TxProposalDelPlans(indices ...string) error {
 }
 
 func (ts *Tester) TxProposalAddSpecs(specs ...spectypes.Spec) error {
-	return testkeeper.SimulateSpecAddProposal(ts.Ctx, ts.Keepers.Spec, specs)
+	return testkeeper.SimulateSpecAddProposal(ts.Ctx, ts.Keepers.Spec, ts.Keepers.Epochstorage, specs)
 }
 
 // TxDualstakingDelegate: implement 'tx dualstaking delegate'
@@ -460,6 +460,24 @@ func (ts *Tester) TxDualstakingClaimRewards(
 	return ts.Servers.DualstakingServer.ClaimRewards(ts.GoCtx, msg)
 }
 
+// TxPlansCreatePlan: implement 'tx plan create-plan'
+func (ts *Tester) TxPlansCreatePlan(creator string, plan planstypes.Plan) (*planstypes.MsgCreatePlanResponse, error) {
+	msg := planstypes.NewMsgCreatePlan(creator, plan)
+	return ts.Servers.PlansServer.CreatePlan(ts.GoCtx, msg)
+}
+
+// TxPlansUpdatePlan: implement 'tx plan update-plan'
+func (ts *Tester) TxPlansUpdatePlan(creator string, plan planstypes.Plan) (*planstypes.MsgUpdatePlanResponse, error) {
+	msg := planstypes.NewMsgUpdatePlan(creator, plan)
+	return ts.Servers.PlansServer.UpdatePlan(ts.GoCtx, msg)
+}
+
+// TxPlansDeprecatePlan: implement 'tx plan deprecate-plan'
+func (ts *Tester) TxPlansDeprecatePlan(creator string, index string) (*planstypes.MsgDeprecatePlanResponse, error) {
+	msg := planstypes.NewMsgDeprecatePlan(creator, index)
+	return ts.Servers.PlansServer.DeprecatePlan(ts.GoCtx, msg)
+}
+
 // TxSubscriptionBuy: implement 'tx subscription buy'
 func (ts *Tester) TxSubscriptionBuy(creator, consumer, plan string, months int, autoRenewal, advancePurchase bool) (*subscriptiontypes.MsgBuyResponse, error) {
 	msg := &subscriptiontypes.MsgBuy{
@@ -505,6 +523,13 @@ func (ts *Tester) TxSubscriptionAutoRenewal(creator, consumer, planIndex string,
 	return err
 }
 
+// TxSubscriptionFundTrialPool: implement 'tx subscription fund-trial-pool'
+func (ts *Tester) TxSubscriptionFundTrialPool(creator string, amount sdk.Coin) error {
+	msg := subscriptiontypes.NewMsgFundTrialPool(creator, amount)
+	_, err := ts.Servers.SubscriptionServer.FundTrialPool(ts.GoCtx, msg)
+	return err
+}
+
 // TxProjectAddKeys: implement 'tx project add-keys'
 func (ts *Tester) TxProjectAddKeys(projectID, creator string, projectKeys ...projectstypes.ProjectKey) error {
 	msg := projectstypes.MsgAddKeys{
@@ -547,6 +572,17 @@ func (ts *Tester) TxProjectSetPolicy(projectID, subkey string, policy *planstype
 	return ts.Servers.ProjectServer.SetPolicy(ts.GoCtx, msg)
 }
 
+// TxProjectSetDeveloperKeyPolicy: implement 'tx project set-developer-key-policy'
+func (ts *Tester) TxProjectSetDeveloperKeyPolicy(projectID, admin, developerKey string, policy *planstypes.Policy) (*projectstypes.MsgSetDeveloperKeyPolicyResponse, error) {
+	msg := &projectstypes.MsgSetDeveloperKeyPolicy{
+		Creator: admin,
+		Project: projectID,
+		Key:     developerKey,
+		Policy:  policy,
+	}
+	return ts.Servers.ProjectServer.SetDeveloperKeyPolicy(ts.GoCtx, msg)
+}
+
 // TxPairingStakeProvider: implement 'tx pairing stake-provider'
 func (ts *Tester) TxPairingStakeProvider(
 	addr string,
@@ -663,6 +699,12 @@ func (ts *Tester) TxPairingUnfreezeProvider(addr, chainID string) (*pairingtypes
 	return ts.Servers.PairingServer.UnfreezeProvider(ts.GoCtx, msg)
 }
 
+// TxPairingScheduleMaintenance: implement 'tx pairing schedule-maintenance'
+func (ts *Tester) TxPairingScheduleMaintenance(addr, chainID string, startInEpochs, duration uint64) (*pairingtypes.MsgScheduleMaintenanceResponse, error) {
+	msg := pairingtypes.NewMsgScheduleMaintenance(addr, lavaslices.Slice(chainID), startInEpochs, duration, "test")
+	return ts.Servers.PairingServer.ScheduleMaintenance(ts.GoCtx, msg)
+}
+
 func (ts *Tester) TxRewardsSetIprpcDataProposal(authority string, cost sdk.Coin, subs []string) (*rewardstypes.MsgSetIprpcDataResponse, error) {
 	msg := rewardstypes.NewMsgSetIprpcData(authority, cost, subs)
 	return ts.Servers.RewardsServer.SetIprpcData(ts.GoCtx, msg)
@@ -673,6 +715,11 @@ func (ts *Tester) TxRewardsFundIprpc(creator string, spec string, duration uint6
 	return ts.Servers.RewardsServer.FundIprpc(ts.GoCtx, msg)
 }
 
+func (ts *Tester) TxRewardsWithdrawIprpcFund(creator string, spec string) (*rewardstypes.MsgWithdrawIprpcFundResponse, error) {
+	msg := rewardstypes.NewMsgWithdrawIprpcFund(creator, spec)
+	return ts.Servers.RewardsServer.WithdrawIprpcFund(ts.GoCtx, msg)
+}
+
 // TxCreateValidator: implement 'tx staking createvalidator' and bond its tokens
 func (ts *Tester) TxCreateValidator(validator sigs.Account, amount math.Int) {
 	consensusPowerTokens := ts.Keepers.StakingKeeper.TokensFromConsensusPower(ts.Ctx, 1)
@@ -767,6 +814,12 @@ func (ts *Tester) QuerySubscriptionNextToMonthExpiry() (*subscriptiontypes.Query
 	return ts.Keepers.Subscription.NextToMonthExpiry(ts.GoCtx, msg)
 }
 
+// QuerySubscriptionTrialBudget: implement 'q subscription trial-budget'
+func (ts *Tester) QuerySubscriptionTrialBudget() (*subscriptiontypes.QueryTrialBudgetResponse, error) {
+	msg := &subscriptiontypes.QueryTrialBudgetRequest{}
+	return ts.Keepers.Subscription.TrialBudget(ts.GoCtx, msg)
+}
+
 // QueryProjectInfo implements 'q project info'
 func (ts *Tester) QueryProjectInfo(projectID string) (*projectstypes.QueryInfoResponse, error) {
 	msg := &projectstypes.QueryInfoRequest{Project: projectID}
@@ -839,6 +892,15 @@ func (ts *Tester) QueryPairingSubscriptionMonthlyPayout(consumer string) (*pairi
 	return ts.Keepers.Pairing.SubscriptionMonthlyPayout(ts.GoCtx, msg)
 }
 
+// QueryPairingUpcomingMaintenance implements 'q pairing upcoming-maintenance'
+func (ts *Tester) QueryPairingUpcomingMaintenance(chainID string, provider string) (*pairingtypes.QueryUpcomingMaintenanceResponse, error) {
+	msg := &pairingtypes.QueryUpcomingMaintenanceRequest{
+		ChainId:  chainID,
+		Provider: provider,
+	}
+	return ts.Keepers.Pairing.UpcomingMaintenance(ts.GoCtx, msg)
+}
+
 // QueryPairingVerifyPairing implements 'q dualstaking delegator-providers'
 func (ts *Tester) QueryDualstakingDelegatorProviders(delegator string, withPending bool) (*dualstakingtypes.QueryDelegatorProvidersResponse, error) {
 	msg := &dualstakingtypes.QueryDelegatorProvidersRequest{
@@ -937,6 +999,15 @@ func (ts *Tester) QueryRewardsIprpcSpecReward(spec string) (*rewardstypes.QueryI
 	return ts.Keepers.Rewards.IprpcSpecReward(ts.GoCtx, msg)
 }
 
+// QueryRewardsIprpcFunderSchedule implements 'q rewards iprpc-funder-schedule'
+func (ts *Tester) QueryRewardsIprpcFunderSchedule(funder string, spec string) (*rewardstypes.QueryIprpcFunderScheduleResponse, error) {
+	msg := &rewardstypes.QueryIprpcFunderScheduleRequest{
+		Funder: funder,
+		Spec:   spec,
+	}
+	return ts.Keepers.Rewards.IprpcFunderSchedule(ts.GoCtx, msg)
+}
+
 // block/epoch helpers
 // QueryRewardsProviderReward implements 'q rewards provider-reward'
 func (ts *Tester) QueryRewardsProviderReward(chainID string, provider string) (*rewardstypes.QueryProviderRewardResponse, error) {
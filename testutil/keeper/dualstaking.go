@@ -57,6 +57,7 @@ func DualstakingKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 
 	tsKeeper := timerstorekeeper.NewKeeper(cdc)
 	epochstorageKeeper := epochstoragekeeper.NewKeeper(cdc, nil, nil, paramsSubspaceEpochstorage, nil, nil, nil, nil)
+	fsKeeper := fixationkeeper.NewKeeper(cdc, tsKeeper, epochstorageKeeper.BlocksToSaveRaw)
 
 	k := keeper.NewKeeper(
 		cdc,
@@ -67,8 +68,8 @@ func DualstakingKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		nil,
 		&mockAccountKeeper{},
 		epochstorageKeeper,
-		speckeeper.NewKeeper(cdc, nil, nil, paramsSubspaceSpec, nil),
-		fixationkeeper.NewKeeper(cdc, tsKeeper, epochstorageKeeper.BlocksToSaveRaw),
+		speckeeper.NewKeeper(cdc, nil, nil, paramsSubspaceSpec, nil, fsKeeper),
+		fsKeeper,
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
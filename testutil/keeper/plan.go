@@ -56,15 +56,17 @@ func PlanKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	)
 
 	epochstorageKeeper := epochstoragekeeper.NewKeeper(cdc, nil, nil, paramsSubspaceEpochstorage, nil, nil, nil, nil)
+	fsKeeper := fixationkeeper.NewKeeper(cdc, timerstorekeeper.NewKeeper(cdc), epochstorageKeeper.BlocksToSaveRaw)
 
 	k := keeper.NewKeeper(
 		cdc,
 		storeKey,
 		memStoreKey,
 		paramsSubspace,
+		mockBankKeeper{},
 		epochstorageKeeper,
-		speckeeper.NewKeeper(cdc, nil, nil, paramsSubspaceSpec, nil),
-		fixationkeeper.NewKeeper(cdc, timerstorekeeper.NewKeeper(cdc), epochstorageKeeper.BlocksToSaveRaw),
+		speckeeper.NewKeeper(cdc, nil, nil, paramsSubspaceSpec, nil, fsKeeper),
+		fsKeeper,
 		nil,
 	)
 
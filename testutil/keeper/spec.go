@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -17,9 +18,11 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
+	fixationkeeper "github.com/lavanet/lava/x/fixationstore/keeper"
 	"github.com/lavanet/lava/x/spec/client/utils"
 	"github.com/lavanet/lava/x/spec/keeper"
 	spectypes "github.com/lavanet/lava/x/spec/types"
+	timerstorekeeper "github.com/lavanet/lava/x/timerstore/keeper"
 	"github.com/stretchr/testify/require"
 )
 
@@ -51,12 +54,15 @@ func specKeeper() (*keeper.Keeper, sdk.Context, error) {
 		memStoreKey,
 		"SpecParams",
 	)
+	tsKeeper := timerstorekeeper.NewKeeper(cdc)
+	fsKeeper := fixationkeeper.NewKeeper(cdc, tsKeeper, func(ctx sdk.Context) uint64 { return 0 })
 	k := keeper.NewKeeper(
 		cdc,
 		storeKey,
 		memStoreKey,
 		paramsSubspace,
 		nil,
+		fsKeeper,
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
@@ -67,7 +73,24 @@ func specKeeper() (*keeper.Keeper, sdk.Context, error) {
 	return k, ctx, nil
 }
 
-func GetASpec(specIndex, getToTopMostPath string, ctxArg *sdk.Context, keeper *keeper.Keeper) (specRet spectypes.Spec, err error) {
+func decodeProposal(path string) (utils.SpecAddProposalJSON, error) {
+	proposal := utils.SpecAddProposalJSON{}
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return proposal, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(contents))
+	decoder.DisallowUnknownFields() // This will make the unmarshal fail if there are unused fields
+
+	if err := decoder.Decode(&proposal); err != nil {
+		return proposal, fmt.Errorf("failed decoding %s: %w", path, err)
+	}
+	return proposal, nil
+}
+
+// GetSpecsFromPath loads the specs of all proposal files in path, a comma separated list of files and directories,
+// and returns the expanded spec of specIndex. all specs are loaded before expanding so imports can be in any of the files
+func GetSpecsFromPath(path string, specIndex string, ctxArg *sdk.Context, keeper *keeper.Keeper) (specRet spectypes.Spec, err error) {
 	var ctx sdk.Context
 	if keeper == nil || ctxArg == nil {
 		keeper, ctx, err = specKeeper()
@@ -77,18 +100,61 @@ func GetASpec(specIndex, getToTopMostPath string, ctxArg *sdk.Context, keeper *k
 	} else {
 		ctx = *ctxArg
 	}
-	proposalFile := "./cookbook/specs/spec_add_ibc.json,./cookbook/specs/spec_add_cosmoswasm.json,./cookbook/specs/spec_add_cosmossdk.json,./cookbook/specs/spec_add_cosmossdk_full.json,./cookbook/specs/spec_add_ethereum.json,./cookbook/specs/spec_add_cosmoshub.json,./cookbook/specs/spec_add_lava.json,./cookbook/specs/spec_add_osmosis.json,./cookbook/specs/spec_add_fantom.json,./cookbook/specs/spec_add_celo.json,./cookbook/specs/spec_add_optimism.json,./cookbook/specs/spec_add_arbitrum.json,./cookbook/specs/spec_add_starknet.json,./cookbook/specs/spec_add_aptos.json,./cookbook/specs/spec_add_juno.json,./cookbook/specs/spec_add_polygon.json,./cookbook/specs/spec_add_evmos.json,./cookbook/specs/spec_add_base.json,./cookbook/specs/spec_add_canto.json,./cookbook/specs/spec_add_sui.json,./cookbook/specs/spec_add_solana.json,./cookbook/specs/spec_add_bsc.json,./cookbook/specs/spec_add_axelar.json,./cookbook/specs/spec_add_avalanche.json,./cookbook/specs/spec_add_fvm.json"
-	for _, fileName := range strings.Split(proposalFile, ",") {
-		proposal := utils.SpecAddProposalJSON{}
 
-		contents, err := os.ReadFile(getToTopMostPath + fileName)
+	fileNames := []string{}
+	for _, entry := range strings.Split(path, ",") {
+		info, err := os.Stat(entry)
+		if err != nil {
+			return spectypes.Spec{}, err
+		}
+		if !info.IsDir() {
+			fileNames = append(fileNames, entry)
+			continue
+		}
+		dirEntries, err := os.ReadDir(entry)
 		if err != nil {
 			return spectypes.Spec{}, err
 		}
-		decoder := json.NewDecoder(bytes.NewReader(contents))
-		decoder.DisallowUnknownFields() // This will make the unmarshal fail if there are unused fields
+		for _, dirEntry := range dirEntries {
+			if !dirEntry.IsDir() && strings.HasSuffix(dirEntry.Name(), ".json") {
+				fileNames = append(fileNames, filepath.Join(entry, dirEntry.Name()))
+			}
+		}
+	}
 
-		if err := decoder.Decode(&proposal); err != nil {
+	var found *spectypes.Spec
+	for _, fileName := range fileNames {
+		proposal, err := decodeProposal(fileName)
+		if err != nil {
+			return spectypes.Spec{}, err
+		}
+		for idx, spec := range proposal.Proposal.Specs {
+			keeper.SetSpec(ctx, spec)
+			if spec.Index == specIndex {
+				found = &proposal.Proposal.Specs[idx]
+			}
+		}
+	}
+	if found == nil {
+		return spectypes.Spec{}, fmt.Errorf("spec not found %s", specIndex)
+	}
+	return keeper.ExpandSpec(ctx, *found)
+}
+
+func GetASpec(specIndex, getToTopMostPath string, ctxArg *sdk.Context, keeper *keeper.Keeper) (specRet spectypes.Spec, err error) {
+	var ctx sdk.Context
+	if keeper == nil || ctxArg == nil {
+		keeper, ctx, err = specKeeper()
+		if err != nil {
+			return spectypes.Spec{}, err
+		}
+	} else {
+		ctx = *ctxArg
+	}
+	proposalFile := "./cookbook/specs/spec_add_ibc.json,./cookbook/specs/spec_add_cosmoswasm.json,./cookbook/specs/spec_add_cosmossdk.json,./cookbook/specs/spec_add_cosmossdk_full.json,./cookbook/specs/spec_add_ethereum.json,./cookbook/specs/spec_add_cosmoshub.json,./cookbook/specs/spec_add_lava.json,./cookbook/specs/spec_add_osmosis.json,./cookbook/specs/spec_add_fantom.json,./cookbook/specs/spec_add_celo.json,./cookbook/specs/spec_add_optimism.json,./cookbook/specs/spec_add_arbitrum.json,./cookbook/specs/spec_add_starknet.json,./cookbook/specs/spec_add_aptos.json,./cookbook/specs/spec_add_juno.json,./cookbook/specs/spec_add_polygon.json,./cookbook/specs/spec_add_evmos.json,./cookbook/specs/spec_add_base.json,./cookbook/specs/spec_add_canto.json,./cookbook/specs/spec_add_sui.json,./cookbook/specs/spec_add_solana.json,./cookbook/specs/spec_add_bsc.json,./cookbook/specs/spec_add_axelar.json,./cookbook/specs/spec_add_avalanche.json,./cookbook/specs/spec_add_fvm.json"
+	for _, fileName := range strings.Split(proposalFile, ",") {
+		proposal, err := decodeProposal(getToTopMostPath + fileName)
+		if err != nil {
 			return spectypes.Spec{}, err
 		}
 
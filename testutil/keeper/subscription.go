@@ -63,6 +63,13 @@ func SubscriptionKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		memStoreKey,
 		"PlansParams",
 	)
+
+	paramsSubspaceDualstaking := typesparams.NewSubspace(cdc,
+		types.Amino,
+		storeKey,
+		memStoreKey,
+		"DualstakingParams",
+	)
 	epochstorageKeeper := epochstoragekeeper.NewKeeper(cdc, nil, nil, paramsSubspaceEpochstorage, nil, nil, nil, nil)
 	tsKeeper := timerstorekeeper.NewKeeper(cdc)
 	fsKeeper := fixationkeeper.NewKeeper(cdc, tsKeeper, epochstorageKeeper.BlocksToSaveRaw)
@@ -76,8 +83,8 @@ func SubscriptionKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		nil,
 		epochstorageKeeper,
 		projectskeeper.NewKeeper(cdc, nil, nil, paramsSubspaceProjects, nil, fsKeeper),
-		planskeeper.NewKeeper(cdc, nil, nil, paramsSubspacePlans, nil, nil, fsKeeper, nil),
-		dualstakingkeeper.NewKeeper(cdc, nil, nil, paramsSubspace, nil, nil, mockAccountKeeper{}, nil, nil, fsKeeper),
+		planskeeper.NewKeeper(cdc, nil, nil, paramsSubspacePlans, nil, nil, nil, fsKeeper, nil),
+		dualstakingkeeper.NewKeeper(cdc, nil, nil, paramsSubspaceDualstaking, nil, nil, mockAccountKeeper{}, nil, nil, fsKeeper),
 		nil,
 		fsKeeper,
 		tsKeeper,
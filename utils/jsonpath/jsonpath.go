@@ -0,0 +1,155 @@
+package jsonpath
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Path is a compiled JSONPath expression that selects a single value.
+// the supported subset is the root ($), dot children ($.a.b), bracket children ($['a'] or $["a"])
+// and array indexes ($[0], $.a[-1] counts from the end), wildcards and filters are not supported
+// since a block parser must resolve to exactly one value
+type Path struct {
+	expression string
+	steps      []step
+}
+
+type step struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+func (p Path) String() string {
+	return p.expression
+}
+
+func Compile(expression string) (Path, error) {
+	path := Path{expression: expression}
+	rest, found := strings.CutPrefix(strings.TrimSpace(expression), "$")
+	if !found {
+		return path, fmt.Errorf("jsonpath %q must start with $", expression)
+	}
+	for len(rest) > 0 {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+			key := rest[:end]
+			if key == "" || key == "*" {
+				return path, fmt.Errorf("jsonpath %q has an invalid child name", expression)
+			}
+			path.steps = append(path.steps, step{key: key})
+			rest = rest[end:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end == -1 {
+				return path, fmt.Errorf("jsonpath %q has an unclosed bracket", expression)
+			}
+			selector := strings.TrimSpace(rest[1:end])
+			rest = rest[end+1:]
+			if len(selector) >= 2 && (selector[0] == '\'' || selector[0] == '"') && selector[len(selector)-1] == selector[0] {
+				path.steps = append(path.steps, step{key: selector[1 : len(selector)-1]})
+				continue
+			}
+			index, err := strconv.Atoi(selector)
+			if err != nil {
+				return path, fmt.Errorf("jsonpath %q has an unsupported selector [%s]", expression, selector)
+			}
+			path.steps = append(path.steps, step{index: index, isIndex: true})
+		default:
+			return path, fmt.Errorf("jsonpath %q has an unexpected character %q", expression, rest[0])
+		}
+	}
+	return path, nil
+}
+
+// Get returns the value selected by the path in data unmarshalled by encoding/json, and false if it doesn't exist
+func (p Path) Get(data interface{}) (interface{}, bool) {
+	current := data
+	for _, step := range p.steps {
+		if step.isIndex {
+			array, ok := current.([]interface{})
+			if !ok {
+				return nil, false
+			}
+			index := step.index
+			if index < 0 {
+				index += len(array)
+			}
+			if index < 0 || index >= len(array) {
+				return nil, false
+			}
+			current = array[index]
+			continue
+		}
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		current, ok = object[step.key]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// Delete removes the value selected by the path from its parent in data, and returns false if it doesn't exist.
+// the root can't be deleted, array elements are removed in place and shift the elements after them
+func (p Path) Delete(data interface{}) (interface{}, bool) {
+	if len(p.steps) == 0 {
+		return data, false
+	}
+	parentPath := Path{expression: p.expression, steps: p.steps[:len(p.steps)-1]}
+	parent, found := parentPath.Get(data)
+	if !found {
+		return data, false
+	}
+	last := p.steps[len(p.steps)-1]
+	if !last.isIndex {
+		object, ok := parent.(map[string]interface{})
+		if !ok {
+			return data, false
+		}
+		if _, ok := object[last.key]; !ok {
+			return data, false
+		}
+		delete(object, last.key)
+		return data, true
+	}
+	array, ok := parent.([]interface{})
+	if !ok {
+		return data, false
+	}
+	index := last.index
+	if index < 0 {
+		index += len(array)
+	}
+	if index < 0 || index >= len(array) {
+		return data, false
+	}
+	array = append(array[:index], array[index+1:]...)
+	if len(parentPath.steps) == 0 {
+		return array, true
+	}
+	// the shorter array has to be set in its own parent
+	grandParentPath := Path{expression: p.expression, steps: parentPath.steps[:len(parentPath.steps)-1]}
+	grandParent, _ := grandParentPath.Get(data)
+	parentStep := parentPath.steps[len(parentPath.steps)-1]
+	if parentStep.isIndex {
+		grandParentArray := grandParent.([]interface{})
+		parentIndex := parentStep.index
+		if parentIndex < 0 {
+			parentIndex += len(grandParentArray)
+		}
+		grandParentArray[parentIndex] = array
+	} else {
+		grandParent.(map[string]interface{})[parentStep.key] = array
+	}
+	return data, true
+}
@@ -0,0 +1,88 @@
+package jsonpath
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompile(t *testing.T) {
+	valid := []string{"$", "$.a", "$.a.b", "$[0]", "$[-1].height", "$.params[1]['block height']", `$["a"].b[2]`}
+	for _, expression := range valid {
+		_, err := Compile(expression)
+		require.NoError(t, err, expression)
+	}
+	invalid := []string{"", "a.b", "$.", "$..a", "$.a[", "$[*]", "$.*", "$[?(@.a)]", "$a", "$[0:2]"}
+	for _, expression := range invalid {
+		_, err := Compile(expression)
+		require.Error(t, err, expression)
+	}
+}
+
+func TestGet(t *testing.T) {
+	var data interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{"block":{"header":{"height":"15"}},"txs":[{"height":1},{"height":2}],"a b":true}`), &data))
+	playbook := []struct {
+		expression string
+		expected   interface{}
+		found      bool
+	}{
+		{expression: "$.block.header.height", expected: "15", found: true},
+		{expression: "$['block'][\"header\"].height", expected: "15", found: true},
+		{expression: "$.txs[0].height", expected: float64(1), found: true},
+		{expression: "$.txs[-1].height", expected: float64(2), found: true},
+		{expression: "$['a b']", expected: true, found: true},
+		{expression: "$.txs[2]", found: false},
+		{expression: "$.txs[-3]", found: false},
+		{expression: "$.block.missing", found: false},
+		{expression: "$.block[0]", found: false},
+		{expression: "$.txs.height", found: false},
+	}
+	for _, play := range playbook {
+		t.Run(play.expression, func(t *testing.T) {
+			path, err := Compile(play.expression)
+			require.NoError(t, err)
+			value, found := path.Get(data)
+			require.Equal(t, play.found, found)
+			require.Equal(t, play.expected, value)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	playbook := []struct {
+		expression string
+		expected   string
+		found      bool
+	}{
+		{expression: "$.block.header.height", expected: `{"block":{"header":{}},"txs":[{"height":1},{"height":2}]}`, found: true},
+		{expression: "$.txs[0]", expected: `{"block":{"header":{"height":"15"}},"txs":[{"height":2}]}`, found: true},
+		{expression: "$.txs[-1].height", expected: `{"block":{"header":{"height":"15"}},"txs":[{"height":1},{}]}`, found: true},
+		{expression: "$.txs[2]", expected: `{"block":{"header":{"height":"15"}},"txs":[{"height":1},{"height":2}]}`, found: false},
+		{expression: "$.missing", expected: `{"block":{"header":{"height":"15"}},"txs":[{"height":1},{"height":2}]}`, found: false},
+		{expression: "$", expected: `{"block":{"header":{"height":"15"}},"txs":[{"height":1},{"height":2}]}`, found: false},
+	}
+	for _, play := range playbook {
+		t.Run(play.expression, func(t *testing.T) {
+			var data interface{}
+			require.NoError(t, json.Unmarshal([]byte(`{"block":{"header":{"height":"15"}},"txs":[{"height":1},{"height":2}]}`), &data))
+			path, err := Compile(play.expression)
+			require.NoError(t, err)
+			data, found := path.Delete(data)
+			require.Equal(t, play.found, found)
+			result, err := json.Marshal(data)
+			require.NoError(t, err)
+			require.JSONEq(t, play.expected, string(result))
+		})
+	}
+	var data interface{}
+	require.NoError(t, json.Unmarshal([]byte(`[[1,2],[3]]`), &data))
+	path, err := Compile("$[0][-1]")
+	require.NoError(t, err)
+	data, found := path.Delete(data)
+	require.True(t, found)
+	result, err := json.Marshal(data)
+	require.NoError(t, err)
+	require.JSONEq(t, `[[1],[3]]`, string(result))
+}
@@ -0,0 +1,36 @@
+package sigs
+
+import (
+	btcSecp256k1 "github.com/btcsuite/btcd/btcec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Signer creates signatures for Signable objects without exposing the key that signs them.
+// relays, replies, finalization data and badges are all signed through a Signer, so the key
+// can be kept in the process (PrivateKeySigner) or in a separate signing process
+type Signer interface {
+	// Sign creates a signature for data, it is verifiable with ExtractSignerAddress
+	Sign(data Signable) ([]byte, error)
+	// Address gets the account address of the signing key
+	Address() sdk.AccAddress
+}
+
+// PrivateKeySigner signs with a private key held in memory
+type PrivateKeySigner struct {
+	privKey *btcSecp256k1.PrivateKey
+	addr    sdk.AccAddress
+}
+
+func NewPrivateKeySigner(privKey *btcSecp256k1.PrivateKey) *PrivateKeySigner {
+	pubKey := secp256k1.PubKey{Key: privKey.PubKey().SerializeCompressed()}
+	return &PrivateKeySigner{privKey: privKey, addr: sdk.AccAddress(pubKey.Address())}
+}
+
+func (pks *PrivateKeySigner) Sign(data Signable) ([]byte, error) {
+	return Sign(pks.privKey, data)
+}
+
+func (pks *PrivateKeySigner) Address() sdk.AccAddress {
+	return pks.addr
+}
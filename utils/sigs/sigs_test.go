@@ -62,3 +62,17 @@ func TestHashRounds(t *testing.T) {
 	require.Equal(t, sig0, sig1)
 	require.Equal(t, sig0, sig2)
 }
+
+func TestPrivateKeySigner(t *testing.T) {
+	sk, addr := GenerateFloatingKey()
+	signer := NewPrivateKeySigner(sk)
+	require.Equal(t, addr, signer.Address())
+
+	mock := NewMockSignable("hello", 2)
+	sig, err := signer.Sign(mock)
+	require.NoError(t, err)
+	mock.sig = sig
+	extractedAddr, err := ExtractSignerAddress(mock)
+	require.NoError(t, err)
+	require.Equal(t, addr, extractedAddr)
+}
@@ -160,3 +160,121 @@ func (k Keeper) ValidateResponseConflict(ctx sdk.Context, conflictData *types.Re
 func (k Keeper) ValidateSameProviderConflict(ctx sdk.Context, conflictData *types.FinalizationConflict, clientAddr sdk.AccAddress) error {
 	return nil
 }
+
+// ValidateLatestBlockConflict verifies both relays belong to the same provider session, were signed by the client and the provider,
+// and that the later relay contradicts the finalization data of the earlier one. returns the provider address
+func (k Keeper) ValidateLatestBlockConflict(ctx sdk.Context, conflictData *types.LatestBlockConflict, clientAddr sdk.AccAddress) (string, error) {
+	// 1. validate the relays are of the same session
+	for _, relay := range []*types.ConflictRelayData{conflictData.PreviousRelay, conflictData.CurrentRelay} {
+		if relay == nil || relay.Request == nil || relay.Request.RelaySession == nil || relay.Request.RelayData == nil || relay.Reply == nil {
+			return "", fmt.Errorf("latest block conflict is missing relay data")
+		}
+	}
+	previousSession := conflictData.PreviousRelay.Request.RelaySession
+	currentSession := conflictData.CurrentRelay.Request.RelaySession
+	chainID := previousSession.SpecId
+	if chainID != currentSession.SpecId {
+		return "", fmt.Errorf("mismatching chainID between relays %s, %s", chainID, currentSession.SpecId)
+	}
+	block := previousSession.Epoch
+	if block != currentSession.Epoch {
+		return "", fmt.Errorf("mismatching epoch between relays %d, %d", block, currentSession.Epoch)
+	}
+	if previousSession.SessionId != currentSession.SessionId {
+		return "", fmt.Errorf("mismatching session between relays %d, %d", previousSession.SessionId, currentSession.SessionId)
+	}
+	if previousSession.Provider != currentSession.Provider {
+		return "", fmt.Errorf("mismatching provider between relays %s, %s", previousSession.Provider, currentSession.Provider)
+	}
+	if previousSession.RelayNum >= currentSession.RelayNum {
+		return "", fmt.Errorf("previous relay number %d is not lower than the current relay number %d", previousSession.RelayNum, currentSession.RelayNum)
+	}
+
+	// 2. validate params
+	epochStart, _, err := k.epochstorageKeeper.GetEpochStartForBlock(ctx, uint64(block))
+	if err != nil {
+		return "", fmt.Errorf("could not find epoch for block %d", block)
+	}
+	epochBlocks, err := k.epochstorageKeeper.EpochBlocks(ctx, uint64(block))
+	if err != nil {
+		return "", fmt.Errorf("could not get EpochBlocks param")
+	}
+	span := k.VoteStartSpan(ctx) * epochBlocks
+	if uint64(ctx.BlockHeight())-epochStart >= span {
+		return "", fmt.Errorf("conflict was received outside of the allowed span, current: %d, span %d - %d", ctx.BlockHeight(), epochStart, epochStart+span)
+	}
+	_, _, err = k.pairingKeeper.VerifyPairingData(ctx, chainID, epochStart)
+	if err != nil {
+		return "", err
+	}
+	_, err = k.pairingKeeper.GetProjectData(ctx, clientAddr, chainID, epochStart)
+	if err != nil {
+		return "", fmt.Errorf("did not find a project for %s on epoch %d, chainID %s error: %s", clientAddr, epochStart, chainID, err.Error())
+	}
+	providerAddr, err := sdk.AccAddressFromBech32(previousSession.Provider)
+	if err != nil {
+		return "", fmt.Errorf("invalid provider address %s: %w", previousSession.Provider, err)
+	}
+	_, err = k.epochstorageKeeper.GetStakeEntryForProviderEpoch(ctx, chainID, providerAddr, epochStart)
+	if err != nil {
+		return "", fmt.Errorf("did not find a stake entry for provider %s on epoch %d, chainID %s error: %s", providerAddr, epochStart, chainID, err.Error())
+	}
+
+	// 3. validate the client and provider signatures of both relays
+	for i, relay := range []*types.ConflictRelayData{conflictData.PreviousRelay, conflictData.CurrentRelay} {
+		pubKey, err := sigs.RecoverPubKey(*relay.Request.RelaySession)
+		if err != nil {
+			return "", fmt.Errorf("relay %d: invalid consumer signature in relay request, error: %s", i, err.Error())
+		}
+		derivedClientAddr, err := sdk.AccAddressFromHexUnsafe(pubKey.Address().String())
+		if err != nil {
+			return "", fmt.Errorf("relay %d: invalid consumer address from signature in relay request, error: %s", i, err.Error())
+		}
+		if !derivedClientAddr.Equals(clientAddr) {
+			return "", fmt.Errorf("relay %d: mismatching consumer address signature and msg.Creator in relay request %s , %s", i, derivedClientAddr, clientAddr)
+		}
+
+		metaData := types.NewRelayFinalizationMetaData(*relay.Reply, *relay.Request, clientAddr)
+		pubKey, err = sigs.RecoverPubKey(metaData)
+		if err != nil {
+			return "", fmt.Errorf("relay %d: RecoverPubKey provider finalization data: %w", i, err)
+		}
+		derivedProviderAddr, err := sdk.AccAddressFromHexUnsafe(pubKey.Address().String())
+		if err != nil {
+			return "", fmt.Errorf("relay %d: AccAddressFromHex provider finalization data: %w", i, err)
+		}
+		if !derivedProviderAddr.Equals(providerAddr) {
+			return "", fmt.Errorf("relay %d: mismatching provider address signature and finalization data %s , %s", i, derivedProviderAddr, providerAddr)
+		}
+	}
+
+	// 4. validate the contradiction
+	contradiction := conflictData.Contradiction()
+	if contradiction == "" {
+		return "", fmt.Errorf("no contradiction between the provider's relays")
+	}
+	return providerAddr.String(), nil
+}
+
+// punishLatestBlockConflictProvider jails and slashes a provider that contradicted its own latest block, with the same
+// bail and slash percentage as a provider that didn't vote in a conflict vote
+func (k Keeper) punishLatestBlockConflictProvider(ctx sdk.Context, provider, chainID string, epochStart uint64) (sdk.Coin, error) {
+	providerAddr, err := sdk.AccAddressFromBech32(provider)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	stakeEntry, found, _ := k.epochstorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, providerAddr)
+	if !found {
+		return sdk.Coin{}, fmt.Errorf("provider stake entry not found")
+	}
+	blocksToSave, err := k.epochstorageKeeper.BlocksToSave(ctx, epochStart)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	bail := stakeEntry.EffectiveStake().Quo(sdk.NewIntFromUint64(BailStakeDiv))
+	err = k.pairingKeeper.JailEntry(ctx, providerAddr, chainID, epochStart, blocksToSave, sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), bail))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return k.pairingKeeper.SlashEntry(ctx, providerAddr, chainID, SlashStakePercent)
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/conflict/types"
+)
+
+// SetLatestBlockConflictProof marks a latest block conflict proof as processed, epochStart is the epoch of its relays
+func (k Keeper) SetLatestBlockConflictProof(ctx sdk.Context, hash []byte, epochStart uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LatestBlockConflictProofKeyPrefix))
+	store.Set(types.LatestBlockConflictProofKey(hash), binary.BigEndian.AppendUint64(nil, epochStart))
+}
+
+// IsLatestBlockConflictProofProcessed returns true if the latest block conflict proof was already processed
+func (k Keeper) IsLatestBlockConflictProofProcessed(ctx sdk.Context, hash []byte) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LatestBlockConflictProofKeyPrefix))
+	return store.Has(types.LatestBlockConflictProofKey(hash))
+}
+
+// RemoveExpiredLatestBlockConflictProofs removes the processed proofs that can't be submitted anymore since their
+// epoch is outside of the vote start span
+func (k Keeper) RemoveExpiredLatestBlockConflictProofs(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LatestBlockConflictProofKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	expired := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		epochStart := binary.BigEndian.Uint64(iterator.Value())
+		epochBlocks, err := k.epochstorageKeeper.EpochBlocks(ctx, epochStart)
+		if err != nil {
+			continue
+		}
+		if uint64(ctx.BlockHeight())-epochStart >= k.VoteStartSpan(ctx)*epochBlocks {
+			expired = append(expired, iterator.Key())
+		}
+	}
+
+	for _, key := range expired {
+		store.Delete(key)
+	}
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -26,21 +27,75 @@ func (k msgServer) Detection(goCtx context.Context, msg *types.MsgDetection) (*t
 			utils.Attribute{Key: "client", Value: msg.Creator},
 		)
 	}
-	if msg.FinalizationConflict != nil && msg.ResponseConflict == nil && msg.SameProviderConflict == nil {
+	if msg.FinalizationConflict != nil && msg.ResponseConflict == nil && msg.SameProviderConflict == nil && msg.LatestBlockConflict == nil {
 		err := k.Keeper.ValidateFinalizationConflict(ctx, msg.FinalizationConflict, clientAddr)
 		if err != nil {
 			return nil, utils.LavaFormatWarning("Simulation: invalid finalization conflict detection", err,
 				utils.Attribute{Key: "client", Value: msg.Creator},
 			)
 		}
-	} else if msg.FinalizationConflict == nil && msg.ResponseConflict == nil && msg.SameProviderConflict != nil {
+	} else if msg.FinalizationConflict == nil && msg.ResponseConflict == nil && msg.SameProviderConflict != nil && msg.LatestBlockConflict == nil {
 		err := k.Keeper.ValidateSameProviderConflict(ctx, msg.SameProviderConflict, clientAddr)
 		if err != nil {
 			return nil, utils.LavaFormatWarning("Simulation: invalid same provider conflict detection", err,
 				utils.Attribute{Key: "client", Value: msg.Creator},
 			)
 		}
-	} else if msg.FinalizationConflict == nil && msg.ResponseConflict != nil && msg.SameProviderConflict == nil {
+	} else if msg.FinalizationConflict == nil && msg.ResponseConflict == nil && msg.SameProviderConflict == nil && msg.LatestBlockConflict != nil {
+		provider, err := k.Keeper.ValidateLatestBlockConflict(ctx, msg.LatestBlockConflict, clientAddr)
+		if err != nil {
+			return nil, utils.LavaFormatWarning("Simulation: invalid latest block conflict detection", err,
+				utils.Attribute{Key: "client", Value: msg.Creator},
+			)
+		}
+
+		// the proof is self contained, no vote is needed. the provider is frozen on the chain it lied on, so it leaves the
+		// pairing at the next epoch, and is jailed and slashed like a provider that lost a conflict vote (a freeze alone
+		// can be reversed by the provider). every proof is processed once, so it can't be replayed to punish the provider again
+		proofHash := msg.LatestBlockConflict.ProofHash()
+		if k.Keeper.IsLatestBlockConflictProofProcessed(ctx, proofHash) {
+			return nil, utils.LavaFormatWarning("Simulation: latest block conflict was already processed", fmt.Errorf("duplicate latest block conflict"),
+				utils.Attribute{Key: "client", Value: msg.Creator},
+				utils.Attribute{Key: "provider", Value: provider},
+			)
+		}
+		epochStart, _, err := k.epochstorageKeeper.GetEpochStartForBlock(ctx, uint64(msg.LatestBlockConflict.CurrentRelay.Request.RelaySession.Epoch))
+		if err != nil {
+			return nil, utils.LavaFormatWarning("Simulation: could not get EpochStart for specific block", err,
+				utils.Attribute{Key: "client", Value: msg.Creator},
+				utils.Attribute{Key: "provider", Value: provider},
+			)
+		}
+		chainID := msg.LatestBlockConflict.CurrentRelay.Request.RelaySession.SpecId
+		err = k.pairingKeeper.FreezeProvider(ctx, provider, []string{chainID}, types.FreezeReasonLatestBlockConflict)
+		if err != nil {
+			return nil, utils.LavaFormatWarning("Simulation: could not freeze provider of latest block conflict", err,
+				utils.Attribute{Key: "client", Value: msg.Creator},
+				utils.Attribute{Key: "provider", Value: provider},
+				utils.Attribute{Key: "chainID", Value: chainID},
+			)
+		}
+		slashed, err := k.Keeper.punishLatestBlockConflictProvider(ctx, provider, chainID, epochStart)
+		if err != nil {
+			return nil, utils.LavaFormatWarning("Simulation: could not punish provider of latest block conflict", err,
+				utils.Attribute{Key: "client", Value: msg.Creator},
+				utils.Attribute{Key: "provider", Value: provider},
+				utils.Attribute{Key: "chainID", Value: chainID},
+			)
+		}
+
+		k.Keeper.SetLatestBlockConflictProof(ctx, proofHash, epochStart)
+
+		eventData := map[string]string{"client": msg.Creator}
+		eventData["provider"] = provider
+		eventData["chainID"] = chainID
+		eventData["epoch"] = strconv.FormatInt(msg.LatestBlockConflict.CurrentRelay.Request.RelaySession.Epoch, 10)
+		eventData["sessionID"] = strconv.FormatUint(msg.LatestBlockConflict.CurrentRelay.Request.RelaySession.SessionId, 10)
+		eventData["contradiction"] = msg.LatestBlockConflict.Contradiction()
+		eventData["slashed"] = slashed.String()
+		utils.LogLavaEvent(ctx, logger, types.LatestBlockConflictEventName, eventData, "Simulation: Got a new valid latest block conflict detection from consumer, provider frozen, jailed and slashed")
+		return &types.MsgDetectionResponse{}, nil
+	} else if msg.FinalizationConflict == nil && msg.ResponseConflict != nil && msg.SameProviderConflict == nil && msg.LatestBlockConflict == nil {
 		err := k.Keeper.ValidateResponseConflict(ctx, msg.ResponseConflict, clientAddr)
 		if err != nil {
 			return nil, utils.LavaFormatWarning("Simulation: invalid response conflict detection", err,
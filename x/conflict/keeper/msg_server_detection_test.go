@@ -184,3 +184,130 @@ func TestFrozenProviderDetection(t *testing.T) {
 	// the frozen provider should not be part of the voters list
 	require.False(t, lavaslices.Contains(votersList, frozenProvider))
 }
+
+// createLatestBlockRelay builds a relay of the consumer's session with the provider, signed by both, reporting the given finalization data
+func (ts *tester) createLatestBlockRelay(provider sigs.Account, relayNum uint64, latestBlock int64, finalizedBlocksHashes string) *conflicttypes.ConflictRelayData {
+	request := &types.RelayRequest{
+		RelayData: &types.RelayPrivateData{
+			Data:         []byte("DUMMYREQUEST"),
+			RequestBlock: 100,
+			Salt:         []byte{1},
+		},
+	}
+	request.RelaySession = &types.RelaySession{
+		Provider:    provider.Addr.String(),
+		ContentHash: sigs.HashMsg(request.RelayData.GetContentHashData()),
+		SessionId:   uint64(1),
+		SpecId:      ts.spec.Index,
+		CuSum:       10 * relayNum,
+		Epoch:       int64(ts.BlockHeight()),
+		RelayNum:    relayNum,
+	}
+	sig, err := sigs.Sign(ts.consumer.SK, *request.RelaySession)
+	require.NoError(ts.T, err)
+	request.RelaySession.Sig = sig
+
+	reply := &types.RelayReply{
+		Data:                  []byte("DUMMYREPLY"),
+		LatestBlock:           latestBlock,
+		FinalizedBlocksHashes: []byte(finalizedBlocksHashes),
+	}
+	reply.Sig, err = sigs.Sign(provider.SK, types.NewRelayExchange(*request, *reply))
+	require.NoError(ts.T, err)
+	reply.SigBlocks, err = sigs.Sign(provider.SK, types.NewRelayFinalization(types.NewRelayExchange(*request, *reply), ts.consumer.Addr))
+	require.NoError(ts.T, err)
+	return conflictconstruct.ConstructConflictRelayData(reply, request)
+}
+
+func TestLatestBlockConflictDetection(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForConflict(3)
+	provider := ts.providers[0]
+
+	tests := []struct {
+		name     string
+		previous *conflicttypes.ConflictRelayData
+		current  *conflicttypes.ConflictRelayData
+		creator  sigs.Account
+		valid    bool
+	}{
+		{
+			name:     "consistent replies",
+			previous: ts.createLatestBlockRelay(provider, 1, 100, `{"90":"a","91":"b"}`),
+			current:  ts.createLatestBlockRelay(provider, 2, 101, `{"91":"b","92":"c"}`),
+			creator:  ts.consumer,
+			valid:    false,
+		},
+		{
+			name:     "wrong order",
+			previous: ts.createLatestBlockRelay(provider, 2, 101, `{}`),
+			current:  ts.createLatestBlockRelay(provider, 1, 100, `{}`),
+			creator:  ts.consumer,
+			valid:    false,
+		},
+		{
+			name:     "different providers",
+			previous: ts.createLatestBlockRelay(provider, 1, 101, `{}`),
+			current:  ts.createLatestBlockRelay(ts.providers[1], 2, 100, `{}`),
+			creator:  ts.consumer,
+			valid:    false,
+		},
+		{
+			name:     "bad creator",
+			previous: ts.createLatestBlockRelay(provider, 1, 101, `{}`),
+			current:  ts.createLatestBlockRelay(provider, 2, 100, `{}`),
+			creator:  ts.providers[2],
+			valid:    false,
+		},
+		{
+			name:     "changed finalized hash",
+			previous: ts.createLatestBlockRelay(ts.providers[1], 1, 100, `{"90":"a","91":"b"}`),
+			current:  ts.createLatestBlockRelay(ts.providers[1], 2, 101, `{"91":"x","92":"c"}`),
+			creator:  ts.consumer,
+			valid:    true,
+		},
+		{
+			name:     "older latest block",
+			previous: ts.createLatestBlockRelay(provider, 1, 101, `{}`),
+			current:  ts.createLatestBlockRelay(provider, 2, 100, `{}`),
+			creator:  ts.consumer,
+			valid:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conflict := &conflicttypes.LatestBlockConflict{PreviousRelay: tt.previous, CurrentRelay: tt.current}
+			msg := conflicttypes.NewMsgLatestBlockConflictDetection(tt.creator.Addr.String(), conflict)
+			_, err := ts.txConflictDetection(msg)
+			if !tt.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			events := ts.Ctx.EventManager().Events()
+			require.Equal(t, utils.EventPrefix+conflicttypes.LatestBlockConflictEventName, events[len(events)-1].Type)
+			slashed := false
+			for _, attr := range events[len(events)-1].Attributes {
+				slashed = slashed || attr.Key == "slashed"
+			}
+			require.True(t, slashed)
+
+			providerAddr := tt.current.Request.RelaySession.Provider
+			stakeEntry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, sdk.MustAccAddressFromBech32(providerAddr))
+			require.True(t, found)
+			require.True(t, stakeEntry.IsFrozen())
+
+			// the same proof can't be used again
+			require.True(t, ts.Keepers.Conflict.IsLatestBlockConflictProofProcessed(ts.Ctx, conflict.ProofHash()))
+			_, err = ts.txConflictDetection(msg)
+			require.Error(t, err)
+		})
+	}
+
+	// processed proofs are removed once they can't be submitted anymore
+	proof := &conflicttypes.LatestBlockConflict{PreviousRelay: tests[len(tests)-1].previous, CurrentRelay: tests[len(tests)-1].current}
+	require.True(t, ts.Keepers.Conflict.IsLatestBlockConflictProofProcessed(ts.Ctx, proof.ProofHash()))
+	ts.AdvanceEpochs(ts.Keepers.Conflict.VoteStartSpan(ts.Ctx) + 1)
+	require.False(t, ts.Keepers.Conflict.IsLatestBlockConflictProofProcessed(ts.Ctx, proof.ProofHash()))
+}
@@ -256,11 +256,66 @@ func (m *FinalizationConflict) GetRelayReply1() *types.RelayReply {
 	return nil
 }
 
+// LatestBlockConflict proves a provider reported an older latest block, or different finalized block hashes,
+// in a later relay of the same session than it did in an earlier one. both replies are signed by the provider
+type LatestBlockConflict struct {
+	PreviousRelay *ConflictRelayData `protobuf:"bytes,1,opt,name=previousRelay,proto3" json:"previousRelay,omitempty"`
+	CurrentRelay  *ConflictRelayData `protobuf:"bytes,2,opt,name=currentRelay,proto3" json:"currentRelay,omitempty"`
+}
+
+func (m *LatestBlockConflict) Reset()         { *m = LatestBlockConflict{} }
+func (m *LatestBlockConflict) String() string { return proto.CompactTextString(m) }
+func (*LatestBlockConflict) ProtoMessage()    {}
+func (*LatestBlockConflict) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db493e54bcd78171, []int{4}
+}
+func (m *LatestBlockConflict) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LatestBlockConflict) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LatestBlockConflict.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LatestBlockConflict) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LatestBlockConflict.Merge(m, src)
+}
+func (m *LatestBlockConflict) XXX_Size() int {
+	return m.Size()
+}
+func (m *LatestBlockConflict) XXX_DiscardUnknown() {
+	xxx_messageInfo_LatestBlockConflict.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LatestBlockConflict proto.InternalMessageInfo
+
+func (m *LatestBlockConflict) GetPreviousRelay() *ConflictRelayData {
+	if m != nil {
+		return m.PreviousRelay
+	}
+	return nil
+}
+
+func (m *LatestBlockConflict) GetCurrentRelay() *ConflictRelayData {
+	if m != nil {
+		return m.CurrentRelay
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ResponseConflict)(nil), "lavanet.lava.conflict.ResponseConflict")
 	proto.RegisterType((*ConflictRelayData)(nil), "lavanet.lava.conflict.ConflictRelayData")
 	proto.RegisterType((*ReplyMetadata)(nil), "lavanet.lava.conflict.ReplyMetadata")
 	proto.RegisterType((*FinalizationConflict)(nil), "lavanet.lava.conflict.FinalizationConflict")
+	proto.RegisterType((*LatestBlockConflict)(nil), "lavanet.lava.conflict.LatestBlockConflict")
 }
 
 func init() {
@@ -268,35 +323,38 @@ func init() {
 }
 
 var fileDescriptor_db493e54bcd78171 = []byte{
-	// 444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4f, 0xcb, 0xd3, 0x30,
-	0x1c, 0xc7, 0x97, 0xa7, 0xcf, 0xfc, 0x93, 0x4d, 0x9c, 0x61, 0xc3, 0x32, 0xb0, 0xcc, 0xe2, 0x61,
-	0x22, 0xb4, 0x4e, 0xc1, 0x83, 0x78, 0xb1, 0x13, 0x19, 0x82, 0x97, 0x9c, 0xc4, 0x4b, 0xc9, 0xba,
-	0xac, 0x0d, 0xc6, 0xa6, 0x36, 0x99, 0x58, 0x5f, 0x85, 0xe0, 0xdd, 0x97, 0x23, 0x3b, 0xee, 0xe8,
-	0x51, 0xb6, 0x37, 0x22, 0x49, 0xda, 0x69, 0x75, 0x0a, 0xf2, 0x9c, 0xfa, 0x6b, 0xf2, 0xf9, 0x7e,
-	0xf3, 0xe5, 0x97, 0xfc, 0xe0, 0x5d, 0x4e, 0xde, 0x93, 0x9c, 0xaa, 0x50, 0x7f, 0xc3, 0x44, 0xe4,
-	0x6b, 0xce, 0x12, 0x75, 0x2c, 0xe2, 0x15, 0x51, 0x24, 0x28, 0x4a, 0xa1, 0x04, 0x1a, 0xd5, 0x68,
-	0xa0, 0xbf, 0x41, 0x43, 0x8c, 0x87, 0xa9, 0x48, 0x85, 0x21, 0x42, 0x5d, 0x59, 0x78, 0x3c, 0x69,
-	0xf9, 0x16, 0x84, 0x95, 0x2c, 0x4f, 0xc3, 0x92, 0x72, 0x52, 0x59, 0xc2, 0xff, 0x0a, 0xe0, 0x00,
-	0x53, 0x59, 0x88, 0x5c, 0xd2, 0x79, 0x6d, 0x86, 0x5e, 0x41, 0xd4, 0x18, 0x63, 0xcd, 0x3e, 0x23,
-	0x8a, 0xdc, 0x77, 0xc1, 0x04, 0x4c, 0x7b, 0x0f, 0xa6, 0xc1, 0xc9, 0x00, 0xc1, 0xfc, 0x77, 0x01,
-	0x3e, 0xe1, 0x71, 0xd2, 0x79, 0xe6, 0x9e, 0x5d, 0xd8, 0x79, 0xe6, 0x7f, 0x06, 0xf0, 0xc6, 0x1f,
-	0x24, 0x7a, 0x02, 0x2f, 0x97, 0xf4, 0xdd, 0x86, 0x4a, 0x55, 0xc7, 0xf7, 0xdb, 0x87, 0xd4, 0x2d,
-	0x09, 0x8c, 0x02, 0x5b, 0x12, 0x37, 0x12, 0xf4, 0x18, 0x76, 0x4b, 0x5a, 0xf0, 0xca, 0x75, 0x8c,
-	0xf6, 0xce, 0x5f, 0x02, 0x62, 0xcd, 0xbc, 0xa4, 0x8a, 0xe8, 0x6b, 0xc2, 0x56, 0xf2, 0xe2, 0xfc,
-	0xca, 0xd9, 0xc0, 0xf1, 0xb7, 0x00, 0x5e, 0x6b, 0x6d, 0xa3, 0x7b, 0x10, 0x65, 0x44, 0x66, 0x31,
-	0xe1, 0xdc, 0x5c, 0x6b, 0xac, 0xff, 0x4c, 0xb8, 0x3e, 0xbe, 0xae, 0xeb, 0xa7, 0x9c, 0xeb, 0xe8,
-	0x0b, 0x22, 0x33, 0x34, 0x80, 0x8e, 0x64, 0xa9, 0xe9, 0x4f, 0x1f, 0xeb, 0x12, 0xdd, 0x86, 0x7d,
-	0x4e, 0x14, 0x95, 0x2a, 0x5e, 0x72, 0x91, 0xbc, 0x31, 0xc9, 0x1c, 0xdc, 0xb3, 0x6b, 0x91, 0x5e,
-	0x42, 0x8f, 0xe0, 0xcd, 0x35, 0xcb, 0x09, 0x67, 0x1f, 0xe9, 0xca, 0x52, 0xd2, 0x1c, 0x42, 0xa5,
-	0x7b, 0x6e, 0x8c, 0x46, 0xc7, 0x6d, 0x23, 0x90, 0x0b, 0xb3, 0x89, 0x6e, 0x41, 0x28, 0x59, 0x5a,
-	0x2b, 0xdc, 0xae, 0x41, 0xaf, 0x4a, 0x96, 0x5a, 0xc8, 0xff, 0x02, 0xe0, 0xf0, 0xb9, 0x15, 0x12,
-	0xc5, 0x44, 0x7e, 0x7c, 0x2d, 0x11, 0xec, 0x95, 0xb6, 0x7d, 0x05, 0xaf, 0x9a, 0x67, 0x32, 0xf9,
-	0x67, 0x9f, 0x0b, 0x5e, 0xe1, 0x5f, 0x45, 0x6d, 0x8f, 0xe6, 0x41, 0xfc, 0x97, 0xc7, 0x2c, 0x8a,
-	0xb6, 0x7b, 0x0f, 0xec, 0xf6, 0x1e, 0xf8, 0xbe, 0xf7, 0xc0, 0xa7, 0x83, 0xd7, 0xd9, 0x1d, 0xbc,
-	0xce, 0xb7, 0x83, 0xd7, 0x79, 0x3d, 0x4d, 0x99, 0xca, 0x36, 0xcb, 0x20, 0x11, 0x6f, 0xc3, 0xd6,
-	0x44, 0x7c, 0xf8, 0x39, 0x6b, 0xaa, 0x2a, 0xa8, 0x5c, 0x5e, 0x32, 0x53, 0xf1, 0xf0, 0x47, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x08, 0x7d, 0x98, 0xa8, 0x91, 0x03, 0x00, 0x00,
+	// 484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x4f, 0x8b, 0x13, 0x31,
+	0x18, 0xc6, 0x9b, 0xed, 0xae, 0x7f, 0xde, 0x76, 0xb1, 0xc6, 0x5d, 0x1c, 0x16, 0x1c, 0xea, 0xe0,
+	0xa1, 0x22, 0xcc, 0x58, 0x05, 0x0f, 0xe2, 0xc5, 0xae, 0xc8, 0x22, 0xab, 0x87, 0x9c, 0xc4, 0x4b,
+	0x49, 0x67, 0xb3, 0xd3, 0x60, 0x9c, 0x8c, 0x49, 0xba, 0x38, 0x7e, 0x0a, 0xc1, 0xbb, 0x5f, 0xc2,
+	0xef, 0x20, 0x7b, 0xdc, 0xa3, 0x47, 0x69, 0xbf, 0x88, 0x24, 0x99, 0x69, 0x1d, 0xad, 0x42, 0xf1,
+	0x34, 0x6f, 0x93, 0xdf, 0xf3, 0xe4, 0xe1, 0xcd, 0x9b, 0xc2, 0x5d, 0x41, 0xcf, 0x68, 0xce, 0x4c,
+	0x62, 0xbf, 0x49, 0x2a, 0xf3, 0x53, 0xc1, 0x53, 0xb3, 0x2c, 0xc6, 0x27, 0xd4, 0xd0, 0xb8, 0x50,
+	0xd2, 0x48, 0xbc, 0x5f, 0xa1, 0xb1, 0xfd, 0xc6, 0x35, 0x71, 0xb0, 0x97, 0xc9, 0x4c, 0x3a, 0x22,
+	0xb1, 0x95, 0x87, 0x0f, 0xfa, 0x0d, 0xdf, 0x82, 0x72, 0xc5, 0xf3, 0x2c, 0x51, 0x4c, 0xd0, 0xd2,
+	0x13, 0xd1, 0x37, 0x04, 0x3d, 0xc2, 0x74, 0x21, 0x73, 0xcd, 0x0e, 0x2b, 0x33, 0xfc, 0x1a, 0x70,
+	0x6d, 0x4c, 0x2c, 0xfb, 0x8c, 0x1a, 0x7a, 0x3f, 0x40, 0x7d, 0x34, 0xe8, 0x3c, 0x18, 0xc4, 0x6b,
+	0x03, 0xc4, 0x87, 0xbf, 0x0b, 0xc8, 0x1a, 0x8f, 0xb5, 0xce, 0xc3, 0x60, 0xeb, 0xbf, 0x9d, 0x87,
+	0xd1, 0x67, 0x04, 0xd7, 0xff, 0x20, 0xf1, 0x13, 0xb8, 0xac, 0xd8, 0xfb, 0x19, 0xd3, 0xa6, 0x8a,
+	0x1f, 0x35, 0x0f, 0xa9, 0x5a, 0x12, 0x3b, 0x05, 0xf1, 0x24, 0xa9, 0x25, 0xf8, 0x31, 0xec, 0x28,
+	0x56, 0x88, 0x32, 0x68, 0x3b, 0xed, 0x9d, 0xbf, 0x04, 0x24, 0x96, 0x79, 0xc9, 0x0c, 0xb5, 0xd7,
+	0x44, 0xbc, 0xe4, 0xc5, 0xf6, 0x95, 0xad, 0x5e, 0x3b, 0x3a, 0x47, 0xb0, 0xdb, 0xd8, 0xc6, 0xf7,
+	0x00, 0x4f, 0xa9, 0x9e, 0x8e, 0xa9, 0x10, 0xee, 0x5a, 0xc7, 0xf6, 0x97, 0x0b, 0xd7, 0x25, 0xd7,
+	0x6c, 0xfd, 0x54, 0x08, 0x1b, 0xfd, 0x88, 0xea, 0x29, 0xee, 0x41, 0x5b, 0xf3, 0xcc, 0xf5, 0xa7,
+	0x4b, 0x6c, 0x89, 0x6f, 0x43, 0x57, 0x50, 0xc3, 0xb4, 0x19, 0x4f, 0x84, 0x4c, 0xdf, 0xba, 0x64,
+	0x6d, 0xd2, 0xf1, 0x6b, 0x23, 0xbb, 0x84, 0x1f, 0xc1, 0xcd, 0x53, 0x9e, 0x53, 0xc1, 0x3f, 0xb2,
+	0x13, 0x4f, 0x69, 0x77, 0x08, 0xd3, 0xc1, 0xb6, 0x33, 0xda, 0x5f, 0x6e, 0x3b, 0x81, 0x3e, 0x72,
+	0x9b, 0xf8, 0x16, 0x80, 0xe6, 0x59, 0xa5, 0x08, 0x76, 0x1c, 0x7a, 0x55, 0xf3, 0xcc, 0x43, 0xd1,
+	0x17, 0x04, 0x7b, 0xcf, 0xbd, 0x90, 0x1a, 0x2e, 0xf3, 0xe5, 0xb4, 0x8c, 0xa0, 0xa3, 0x7c, 0xfb,
+	0x0a, 0x51, 0xd6, 0x63, 0xd2, 0xff, 0x67, 0x9f, 0x0b, 0x51, 0x92, 0x5f, 0x45, 0x4d, 0x8f, 0x7a,
+	0x20, 0x36, 0xf2, 0x18, 0x46, 0x5f, 0x11, 0xdc, 0x38, 0x5e, 0xf5, 0x61, 0x99, 0xef, 0x15, 0xec,
+	0x16, 0x8a, 0x9d, 0x71, 0x39, 0xd3, 0x4e, 0xba, 0xf1, 0x20, 0x37, 0xe5, 0xf8, 0x18, 0xba, 0xe9,
+	0x4c, 0x29, 0x96, 0x7b, 0x64, 0xe3, 0xe9, 0x6d, 0xa8, 0x47, 0xa3, 0xf3, 0x79, 0x88, 0x2e, 0xe6,
+	0x21, 0xfa, 0x31, 0x0f, 0xd1, 0xa7, 0x45, 0xd8, 0xba, 0x58, 0x84, 0xad, 0xef, 0x8b, 0xb0, 0xf5,
+	0x66, 0x90, 0x71, 0x33, 0x9d, 0x4d, 0xe2, 0x54, 0xbe, 0x4b, 0x1a, 0xef, 0xf8, 0xc3, 0xea, 0x1f,
+	0xc2, 0x94, 0x05, 0xd3, 0x93, 0x4b, 0xee, 0x2d, 0x3f, 0xfc, 0x39, 0x00, 0x7b, 0x9f, 0x55, 0x8d,
+	0x47, 0x04, 0x00, 0x00,
 }
 
 func (m *ResponseConflict) Marshal() (dAtA []byte, err error) {
@@ -496,6 +554,53 @@ func (m *FinalizationConflict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LatestBlockConflict) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LatestBlockConflict) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LatestBlockConflict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentRelay != nil {
+		{
+			size, err := m.CurrentRelay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConflictData(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PreviousRelay != nil {
+		{
+			size, err := m.PreviousRelay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConflictData(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintConflictData(dAtA []byte, offset int, v uint64) int {
 	offset -= sovConflictData(v)
 	base := offset
@@ -586,6 +691,23 @@ func (m *FinalizationConflict) Size() (n int) {
 	return n
 }
 
+func (m *LatestBlockConflict) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousRelay != nil {
+		l = m.PreviousRelay.Size()
+		n += 1 + l + sovConflictData(uint64(l))
+	}
+	if m.CurrentRelay != nil {
+		l = m.CurrentRelay.Size()
+		n += 1 + l + sovConflictData(uint64(l))
+	}
+	return n
+}
+
 func sovConflictData(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1163,6 +1285,128 @@ func (m *FinalizationConflict) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LatestBlockConflict) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConflictData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatestBlockConflict: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatestBlockConflict: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousRelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConflictData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousRelay == nil {
+				m.PreviousRelay = &ConflictRelayData{}
+			}
+			if err := m.PreviousRelay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConflictData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CurrentRelay == nil {
+				m.CurrentRelay = &ConflictRelayData{}
+			}
+			if err := m.CurrentRelay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConflictData(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConflictData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipConflictData(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
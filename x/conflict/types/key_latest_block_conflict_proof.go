@@ -0,0 +1,18 @@
+package types
+
+const (
+	// LatestBlockConflictProofKeyPrefix is the prefix to retrieve all processed latest block conflict proofs
+	LatestBlockConflictProofKeyPrefix = "LatestBlockConflictProof/value/"
+)
+
+// LatestBlockConflictProofKey returns the store key of a processed latest block conflict proof from its hash
+func LatestBlockConflictProofKey(
+	hash []byte,
+) []byte {
+	var key []byte
+
+	key = append(key, hash...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
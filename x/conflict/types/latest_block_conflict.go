@@ -0,0 +1,68 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// Contradiction returns a description of how the current reply contradicts the previous reply the provider gave in the same session,
+// or an empty string if they are consistent. a provider's latest block can't go backwards, and a block it reported as finalized
+// can't change its hash. malformed finalized hashes are only compared by their latest block
+func (lbc *LatestBlockConflict) Contradiction() string {
+	if lbc == nil || lbc.PreviousRelay == nil || lbc.CurrentRelay == nil || lbc.PreviousRelay.Reply == nil || lbc.CurrentRelay.Reply == nil {
+		return ""
+	}
+	previous := lbc.PreviousRelay.Reply
+	current := lbc.CurrentRelay.Reply
+	if current.LatestBlock < previous.LatestBlock {
+		return fmt.Sprintf("latest block %d is older than previously reported latest block %d", current.LatestBlock, previous.LatestBlock)
+	}
+	previousHashes, err := finalizedBlocksHashes(previous.FinalizedBlocksHashes)
+	if err != nil {
+		return ""
+	}
+	currentHashes, err := finalizedBlocksHashes(current.FinalizedBlocksHashes)
+	if err != nil {
+		return ""
+	}
+	// go over the blocks in order, so the reported contradiction is deterministic
+	blockNums := maps.Keys(currentHashes)
+	slices.Sort(blockNums)
+	for _, blockNum := range blockNums {
+		hash := currentHashes[blockNum]
+		if previousHash, ok := previousHashes[blockNum]; ok && previousHash != hash {
+			return fmt.Sprintf("finalized block %d hash %s is different than previously reported hash %s", blockNum, hash, previousHash)
+		}
+	}
+	return ""
+}
+
+func finalizedBlocksHashes(data []byte) (map[int64]string, error) {
+	hashes := map[int64]string{}
+	if len(data) == 0 {
+		return hashes, nil
+	}
+	err := json.Unmarshal(data, &hashes)
+	return hashes, err
+}
+
+// ProofHash identifies the proof by the provider's session and the relay numbers of both relays,
+// so the same pair of relays can't be submitted again
+func (lbc *LatestBlockConflict) ProofHash() []byte {
+	previousSession := lbc.PreviousRelay.Request.RelaySession
+	currentSession := lbc.CurrentRelay.Request.RelaySession
+	hash := sha256.New()
+	hash.Write([]byte(previousSession.Provider))
+	hash.Write([]byte{0})
+	hash.Write([]byte(previousSession.SpecId))
+	hash.Write([]byte{0})
+	for _, num := range []uint64{uint64(previousSession.Epoch), previousSession.SessionId, previousSession.RelayNum, currentSession.RelayNum} {
+		hash.Write(binary.BigEndian.AppendUint64(nil, num))
+	}
+	return hash.Sum(nil)
+}
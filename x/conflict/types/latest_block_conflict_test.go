@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatestBlockConflictContradiction(t *testing.T) {
+	relay := func(latestBlock int64, hashes string) *ConflictRelayData {
+		return &ConflictRelayData{Reply: &ReplyMetadata{LatestBlock: latestBlock, FinalizedBlocksHashes: []byte(hashes)}}
+	}
+
+	tests := []struct {
+		name          string
+		previous      *ConflictRelayData
+		current       *ConflictRelayData
+		contradiction string
+	}{
+		{
+			name:          "consistent",
+			previous:      relay(100, `{"90":"a","91":"b"}`),
+			current:       relay(101, `{"91":"b","92":"c"}`),
+			contradiction: "",
+		},
+		{
+			name:          "older latest block",
+			previous:      relay(101, `{}`),
+			current:       relay(100, `{}`),
+			contradiction: "latest block 100 is older than previously reported latest block 101",
+		},
+		{
+			name:          "malformed hashes",
+			previous:      relay(100, `{"90":"a"}`),
+			current:       relay(101, `not json`),
+			contradiction: "",
+		},
+		{
+			name:          "several changed hashes report the lowest block",
+			previous:      relay(100, `{"90":"a","91":"b","92":"c","93":"d"}`),
+			current:       relay(101, `{"93":"x","91":"y","92":"z"}`),
+			contradiction: "finalized block 91 hash y is different than previously reported hash b",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conflict := &LatestBlockConflict{PreviousRelay: tt.previous, CurrentRelay: tt.current}
+			// the contradiction must be deterministic, map iteration order must not affect it
+			for i := 0; i < 20; i++ {
+				require.Equal(t, tt.contradiction, conflict.Contradiction())
+			}
+		})
+	}
+}
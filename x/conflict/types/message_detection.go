@@ -19,6 +19,13 @@ func NewMsgDetection(creator string, finalizationConflict *FinalizationConflict,
 	}
 }
 
+func NewMsgLatestBlockConflictDetection(creator string, latestBlockConflict *LatestBlockConflict) *MsgDetection {
+	return &MsgDetection{
+		Creator:             creator,
+		LatestBlockConflict: latestBlockConflict,
+	}
+}
+
 func (msg *MsgDetection) Route() string {
 	return RouterKey
 }
@@ -35,6 +35,7 @@ type MsgDetection struct {
 	FinalizationConflict *FinalizationConflict `protobuf:"bytes,2,opt,name=finalizationConflict,proto3" json:"finalizationConflict,omitempty"`
 	ResponseConflict     *ResponseConflict     `protobuf:"bytes,3,opt,name=responseConflict,proto3" json:"responseConflict,omitempty"`
 	SameProviderConflict *FinalizationConflict `protobuf:"bytes,4,opt,name=sameProviderConflict,proto3" json:"sameProviderConflict,omitempty"`
+	LatestBlockConflict  *LatestBlockConflict  `protobuf:"bytes,5,opt,name=latestBlockConflict,proto3" json:"latestBlockConflict,omitempty"`
 }
 
 func (m *MsgDetection) Reset()         { *m = MsgDetection{} }
@@ -98,6 +99,13 @@ func (m *MsgDetection) GetSameProviderConflict() *FinalizationConflict {
 	return nil
 }
 
+func (m *MsgDetection) GetLatestBlockConflict() *LatestBlockConflict {
+	if m != nil {
+		return m.LatestBlockConflict
+	}
+	return nil
+}
+
 type MsgDetectionResponse struct {
 }
 
@@ -346,35 +354,37 @@ func init() {
 func init() { proto.RegisterFile("lavanet/lava/conflict/tx.proto", fileDescriptor_8d098f1e58e895a1) }
 
 var fileDescriptor_8d098f1e58e895a1 = []byte{
-	// 443 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6b, 0xdb, 0x40,
-	0x10, 0xb5, 0x6c, 0x37, 0xc5, 0xdb, 0x1c, 0xca, 0xe2, 0x04, 0x63, 0xe8, 0x36, 0xb8, 0x87, 0xba,
-	0xa4, 0x68, 0x49, 0xda, 0x5f, 0x90, 0x84, 0x42, 0x0f, 0x86, 0xb2, 0x85, 0x1e, 0x0a, 0x21, 0xac,
-	0x37, 0x13, 0x79, 0x41, 0xda, 0x31, 0xda, 0xad, 0x70, 0xfb, 0x2b, 0xfa, 0x8f, 0x7a, 0xed, 0x31,
-	0xc7, 0x1c, 0x8b, 0xfd, 0x47, 0x8a, 0x3e, 0xeb, 0xd4, 0x52, 0x70, 0x7c, 0xda, 0x19, 0xed, 0x9b,
-	0xf7, 0x9e, 0x9e, 0xc4, 0x10, 0x16, 0xca, 0x44, 0x1a, 0x70, 0x3c, 0x3d, 0xb9, 0x42, 0x73, 0x13,
-	0x6a, 0xe5, 0xb8, 0x5b, 0xf8, 0xf3, 0x18, 0x1d, 0xd2, 0x83, 0xe2, 0xde, 0x4f, 0x4f, 0xbf, 0xbc,
-	0x1f, 0x32, 0x85, 0x36, 0x42, 0xcb, 0xa7, 0xd2, 0x02, 0x4f, 0x4e, 0xa6, 0xe0, 0xe4, 0x09, 0x57,
-	0xa8, 0x4d, 0x3e, 0x36, 0xec, 0x07, 0x18, 0x60, 0x56, 0xf2, 0xb4, 0x2a, 0x9e, 0xbe, 0xa9, 0x17,
-	0x2b, 0x8b, 0xab, 0x6b, 0xe9, 0x64, 0x0e, 0x1d, 0xfd, 0x6a, 0x93, 0xfd, 0x89, 0x0d, 0x2e, 0xc0,
-	0x81, 0x72, 0x1a, 0x0d, 0x1d, 0x90, 0xa7, 0x2a, 0x06, 0xe9, 0x30, 0x1e, 0x78, 0x47, 0xde, 0xb8,
-	0x27, 0xca, 0x96, 0x5e, 0x91, 0xfe, 0x8d, 0x36, 0x32, 0xd4, 0x3f, 0x64, 0x8a, 0x3c, 0x2f, 0xd8,
-	0x06, 0xed, 0x23, 0x6f, 0xfc, 0xec, 0xf4, 0xd8, 0xaf, 0x7d, 0x03, 0xff, 0x43, 0xcd, 0x88, 0xa8,
-	0x25, 0xa2, 0x9f, 0xc9, 0xf3, 0x18, 0xec, 0x1c, 0x8d, 0x85, 0x8a, 0xbc, 0x93, 0x91, 0xbf, 0x6e,
-	0x20, 0x17, 0xff, 0xc1, 0xc5, 0x06, 0x41, 0xea, 0xda, 0xca, 0x08, 0x3e, 0xc5, 0x98, 0xe8, 0x6b,
-	0x88, 0x2b, 0xe2, 0xee, 0x0e, 0xae, 0xeb, 0x88, 0x46, 0x87, 0xa4, 0xbf, 0x1e, 0x60, 0x69, 0x69,
-	0x74, 0x49, 0x0e, 0x26, 0x36, 0x28, 0x61, 0x5f, 0xd0, 0xc1, 0x39, 0x46, 0x91, 0x76, 0x0f, 0x24,
-	0x7c, 0x48, 0xf6, 0x12, 0x74, 0xf0, 0xf1, 0x22, 0xcb, 0xb4, 0x27, 0x8a, 0x8e, 0x52, 0xd2, 0x9d,
-	0x49, 0x3b, 0xcb, 0xc2, 0xd8, 0x17, 0x59, 0x3d, 0x7a, 0x49, 0x5e, 0xd4, 0xd2, 0x57, 0xfa, 0x76,
-	0x43, 0x5f, 0x40, 0x02, 0x32, 0xdc, 0x41, 0xbf, 0x4f, 0x9e, 0x18, 0x34, 0x0a, 0x32, 0x03, 0x1d,
-	0x91, 0x37, 0x95, 0xab, 0xee, 0x83, 0xae, 0x72, 0xd1, 0xd2, 0xd5, 0xe9, 0x5d, 0x9b, 0x74, 0x26,
-	0x36, 0xa0, 0x97, 0xa4, 0xf7, 0xef, 0x9f, 0x7b, 0xd5, 0xf0, 0x15, 0xd6, 0x73, 0x1d, 0x1e, 0x6f,
-	0x01, 0x2a, 0x65, 0xe8, 0x82, 0xd0, 0x9a, 0xe4, 0xdf, 0x36, 0x53, 0x6c, 0xa2, 0x87, 0xef, 0x1f,
-	0x83, 0x6e, 0x52, 0x2e, 0x32, 0xdf, 0x52, 0x39, 0x47, 0x6f, 0xab, 0x7c, 0x3f, 0xda, 0xb3, 0xb3,
-	0xdf, 0x4b, 0xe6, 0xdd, 0x2e, 0x99, 0xf7, 0x67, 0xc9, 0xbc, 0x9f, 0x2b, 0xd6, 0xba, 0x5d, 0xb1,
-	0xd6, 0xdd, 0x8a, 0xb5, 0xbe, 0x8e, 0x03, 0xed, 0x66, 0xdf, 0xa6, 0xbe, 0xc2, 0x88, 0xdf, 0x5b,
-	0x0d, 0x8b, 0xb5, 0x4d, 0xf4, 0x7d, 0x0e, 0x76, 0xba, 0x97, 0x6d, 0x85, 0x77, 0x7f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x3b, 0x67, 0x8d, 0xb0, 0xaf, 0x04, 0x00, 0x00,
+	// 469 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xd1, 0x6e, 0xd3, 0x30,
+	0x14, 0x86, 0x9b, 0xb5, 0x1b, 0xaa, 0xd9, 0x05, 0x32, 0xdd, 0x54, 0x45, 0x22, 0x4c, 0xe5, 0x82,
+	0xc2, 0x50, 0xa2, 0x8d, 0x3d, 0x41, 0x37, 0x21, 0x21, 0x51, 0x09, 0x19, 0x89, 0x0b, 0xc4, 0x34,
+	0xb9, 0xde, 0x59, 0x1a, 0x91, 0xe4, 0x54, 0xb1, 0x89, 0x0a, 0x4f, 0xc1, 0x0b, 0xf0, 0x3e, 0x5c,
+	0xee, 0x72, 0x97, 0xa8, 0x7d, 0x11, 0x14, 0x27, 0x0e, 0x85, 0x3a, 0xd3, 0xd6, 0x2b, 0x1f, 0xc7,
+	0xbf, 0xbf, 0xff, 0xf8, 0x9c, 0xe8, 0x10, 0x2f, 0xe6, 0x39, 0x4f, 0x41, 0x05, 0xc5, 0x1a, 0x08,
+	0x4c, 0xaf, 0xe2, 0x48, 0xa8, 0x40, 0xcd, 0xfd, 0x59, 0x86, 0x0a, 0xe9, 0x5e, 0x75, 0xee, 0x17,
+	0xab, 0x6f, 0xce, 0x5d, 0x4f, 0xa0, 0x4c, 0x50, 0x06, 0x13, 0x2e, 0x21, 0xc8, 0x8f, 0x26, 0xa0,
+	0xf8, 0x51, 0x20, 0x30, 0x4a, 0xcb, 0x6b, 0x6e, 0x2f, 0xc4, 0x10, 0x75, 0x18, 0x14, 0x51, 0xf5,
+	0xf5, 0x85, 0xdd, 0xcc, 0x04, 0x17, 0x97, 0x5c, 0xf1, 0x52, 0x3a, 0xf8, 0xd9, 0x26, 0xbb, 0x63,
+	0x19, 0x9e, 0x81, 0x02, 0xa1, 0x22, 0x4c, 0x69, 0x9f, 0x3c, 0x10, 0x19, 0x70, 0x85, 0x59, 0xdf,
+	0x39, 0x70, 0x86, 0x5d, 0x66, 0xb6, 0xf4, 0x82, 0xf4, 0xae, 0xa2, 0x94, 0xc7, 0xd1, 0x77, 0x5e,
+	0x28, 0x4f, 0x2b, 0x5a, 0x7f, 0xeb, 0xc0, 0x19, 0x3e, 0x3c, 0x3e, 0xf4, 0xad, 0x2f, 0xf0, 0xdf,
+	0x58, 0xae, 0x30, 0x2b, 0x88, 0x7e, 0x20, 0x8f, 0x32, 0x90, 0x33, 0x4c, 0x25, 0xd4, 0xf0, 0xb6,
+	0x86, 0x3f, 0x6f, 0x80, 0xb3, 0xff, 0xe4, 0x6c, 0x0d, 0x50, 0x64, 0x2d, 0x79, 0x02, 0xef, 0x33,
+	0xcc, 0xa3, 0x4b, 0xc8, 0x6a, 0x70, 0x67, 0x83, 0xac, 0x6d, 0x20, 0xfa, 0x99, 0x3c, 0x8e, 0xb9,
+	0x02, 0xa9, 0x46, 0x31, 0x8a, 0x2f, 0x35, 0x7f, 0x5b, 0xf3, 0x5f, 0x36, 0xf0, 0xdf, 0xad, 0xdf,
+	0x60, 0x36, 0xcc, 0x60, 0x9f, 0xf4, 0x56, 0xdb, 0x63, 0x1e, 0x3c, 0x38, 0x27, 0x7b, 0x63, 0x19,
+	0x1a, 0xd9, 0x47, 0x54, 0x70, 0x8a, 0x49, 0x12, 0xa9, 0x5b, 0xfa, 0xb7, 0x4f, 0x76, 0x72, 0x54,
+	0xf0, 0xf6, 0x4c, 0x77, 0xac, 0xcb, 0xaa, 0x1d, 0xa5, 0xa4, 0x33, 0xe5, 0x72, 0xaa, 0x4b, 0xbd,
+	0xcb, 0x74, 0x3c, 0x78, 0x4a, 0x9e, 0x58, 0xf1, 0xb5, 0xbf, 0x5c, 0xf3, 0x67, 0x90, 0x03, 0x8f,
+	0x37, 0xf0, 0xef, 0x91, 0xed, 0x14, 0x53, 0x01, 0x3a, 0x81, 0x36, 0x2b, 0x37, 0x75, 0x56, 0x9d,
+	0x5b, 0xb3, 0x2a, 0x4d, 0x4d, 0x56, 0xc7, 0x37, 0x5b, 0xa4, 0x3d, 0x96, 0x21, 0x3d, 0x27, 0xdd,
+	0xbf, 0x7f, 0xf4, 0xb3, 0x86, 0x1e, 0xac, 0xd6, 0xd5, 0x3d, 0xbc, 0x83, 0xc8, 0xd8, 0xd0, 0x39,
+	0xa1, 0x96, 0xca, 0xbf, 0x6a, 0x46, 0xac, 0xab, 0xdd, 0x93, 0xfb, 0xa8, 0x9b, 0x9c, 0xab, 0x9a,
+	0xdf, 0xd1, 0xb9, 0x54, 0xbb, 0x27, 0xf7, 0x51, 0x1b, 0xe7, 0xd1, 0xe8, 0xd7, 0xc2, 0x73, 0xae,
+	0x17, 0x9e, 0xf3, 0x7b, 0xe1, 0x39, 0x3f, 0x96, 0x5e, 0xeb, 0x7a, 0xe9, 0xb5, 0x6e, 0x96, 0x5e,
+	0xeb, 0xd3, 0x30, 0x8c, 0xd4, 0xf4, 0xeb, 0xc4, 0x17, 0x98, 0x04, 0xff, 0x0c, 0x9e, 0xf9, 0xca,
+	0x9c, 0xfb, 0x36, 0x03, 0x39, 0xd9, 0xd1, 0x33, 0xe7, 0xf5, 0x9f, 0x01, 0x00, 0x82, 0xd4, 0x9f,
+	0xe3, 0x0d, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -549,6 +559,18 @@ func (m *MsgDetection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LatestBlockConflict != nil {
+		{
+			size, err := m.LatestBlockConflict.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.SameProviderConflict != nil {
 		{
 			size, err := m.SameProviderConflict.MarshalToSizedBuffer(dAtA[:i])
@@ -790,6 +812,10 @@ func (m *MsgDetection) Size() (n int) {
 		l = m.SameProviderConflict.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.LatestBlockConflict != nil {
+		l = m.LatestBlockConflict.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1040,6 +1066,42 @@ func (m *MsgDetection) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestBlockConflict", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestBlockConflict == nil {
+				m.LatestBlockConflict = &LatestBlockConflict{}
+			}
+			if err := m.LatestBlockConflict.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
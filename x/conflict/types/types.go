@@ -28,6 +28,7 @@ const (
 	ConflictVoteGotCommitEventName     = "conflict_vote_got_commit"
 	ConflictVoteGotRevealEventName     = "conflict_vote_got_reveal"
 	ConflictUnstakeFraudVoterEventName = "conflict_unstake_fraud_voter"
+	LatestBlockConflictEventName       = "latest_block_conflict_detection"
 )
 
 // unstake description
@@ -35,6 +36,11 @@ const (
 	UnstakeDescriptionFraudVote = "fraud provider found in conflict detection"
 )
 
+// freeze reason
+const (
+	FreezeReasonLatestBlockConflict = "provider contradicted its own signed latest block"
+)
+
 func CommitVoteData(nonce int64, dataHash []byte, providerAddress string) []byte {
 	commitData := sigs.EncodeUint64(uint64(nonce))
 	commitData = append(commitData, dataHash...)
@@ -20,7 +20,7 @@ func NewQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(CmdQueryDowntime(), CmdQueryParams())
+	cmd.AddCommand(CmdQueryDowntime(), CmdQueryParams(), CmdQueryDowntimeHistory(), CmdQueryEpochDowntimes())
 	return cmd
 }
 
@@ -75,3 +75,65 @@ func CmdQueryDowntime() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdQueryDowntimeHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "downtime-history",
+		Short: "Query downtime history",
+		Long:  "Query all the recorded downtime periods with their start and end blocks and times, and the virtual epochs they added to their epoch",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+			resp, err := queryClient.QueryDowntimeHistory(cmd.Context(), &v1.QueryDowntimeHistoryRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryEpochDowntimes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-downtimes",
+		Short: "Query the downtime of all epochs",
+		Long:  "Query the cumulative downtime of all the epochs with downtime, the virtual epochs added to them and the resulting epoch CU allowance factor",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+			resp, err := queryClient.QueryEpochDowntimes(cmd.Context(), &v1.QueryEpochDowntimesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"math"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -63,6 +64,12 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (*v1.GenesisState, error) {
 		return false
 	})
 
+	// get downtime periods
+	k.IterateDowntimePeriods(ctx, func(period v1.DowntimePeriod) (stop bool) {
+		gs.DowntimePeriods = append(gs.DowntimePeriods, &period)
+		return false
+	})
+
 	// get last block time, we report it only if it's set
 	lastBlockTime, ok := k.GetLastBlockTime(ctx)
 	if ok {
@@ -79,6 +86,10 @@ func (k Keeper) ImportGenesis(ctx sdk.Context, gs *v1.GenesisState) error {
 	for _, downtime := range gs.Downtimes {
 		k.SetDowntime(ctx, downtime.Block, downtime.Duration)
 	}
+	// set downtime periods
+	for _, period := range gs.DowntimePeriods {
+		k.SetDowntimePeriod(ctx, *period)
+	}
 	// set last block time, only if it's present in genesis
 	// otherwise it means we don't care about it. This can
 	// happen when we are creating a new chain from scratch
@@ -171,6 +182,41 @@ func (k Keeper) IterateDowntimes(ctx sdk.Context, startHeight, endHeight uint64,
 	}
 }
 
+func (k Keeper) SetDowntimePeriod(ctx sdk.Context, period v1.DowntimePeriod) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetDowntimePeriodKey(period.EpochStartBlock, period.EndBlock)
+	bz := k.cdc.MustMarshal(&period)
+	store.Set(key, bz)
+}
+
+// DeleteDowntimePeriods deletes all the downtime periods of an epoch.
+func (k Keeper) DeleteDowntimePeriods(ctx sdk.Context, epochStartBlock uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetDowntimePeriodPrefix(epochStartBlock))
+	iter := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IterateDowntimePeriods will iterate over all downtime periods, ordered by epoch and block.
+// Will stop iteration when the callback returns true.
+func (k Keeper) IterateDowntimePeriods(ctx sdk.Context, onResult func(period v1.DowntimePeriod) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.DowntimePeriodKey).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var period v1.DowntimePeriod
+		k.cdc.MustUnmarshal(iter.Value(), &period)
+		if onResult(period) {
+			break
+		}
+	}
+}
+
 // ------ STATE END -------
 
 // RecordDowntime will record a downtime for the current block
@@ -179,12 +225,24 @@ func (k Keeper) RecordDowntime(ctx sdk.Context, duration time.Duration) {
 	// get epoch identifier
 	cumulativeEpochDowntime, _ := k.GetDowntime(ctx, epochStartBlock)
 	k.SetDowntime(ctx, epochStartBlock, duration+cumulativeEpochDowntime)
+
+	// keep the downtime period in the history, along with the virtual epochs it added
+	epochDuration := k.GetParams(ctx).EpochDuration
+	k.SetDowntimePeriod(ctx, v1.DowntimePeriod{
+		EpochStartBlock: epochStartBlock,
+		StartBlock:      uint64(ctx.BlockHeight()) - 1,
+		EndBlock:        uint64(ctx.BlockHeight()),
+		StartTime:       ctx.BlockTime().Add(-duration),
+		EndTime:         ctx.BlockTime(),
+		VirtualEpochs:   virtualEpochs(duration+cumulativeEpochDowntime, epochDuration) - virtualEpochs(cumulativeEpochDowntime, epochDuration),
+	})
 }
 
 // GarbageCollectDowntimes will garbage collect downtimes.
 func (k Keeper) GarbageCollectDowntimes(ctx sdk.Context) {
 	for _, epoch := range k.epochStorageKeeper.GetDeletedEpochs(ctx) {
 		k.DeleteDowntime(ctx, epoch)
+		k.DeleteDowntimePeriods(ctx, epoch)
 	}
 }
 
@@ -194,7 +252,12 @@ func (k Keeper) GetDowntimeFactor(ctx sdk.Context, epoch uint64) uint64 {
 		return 1
 	}
 	epochDuration := k.GetParams(ctx).EpochDuration
-	return uint64(duration/epochDuration) + 1
+	return virtualEpochs(duration, epochDuration) + 1
+}
+
+// virtualEpochs returns the number of virtual epochs added by the given downtime duration.
+func virtualEpochs(downtime time.Duration, epochDuration time.Duration) uint64 {
+	return uint64(downtime / epochDuration)
 }
 
 func (k Keeper) BeginBlock(ctx sdk.Context) {
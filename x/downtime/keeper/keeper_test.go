@@ -117,6 +117,18 @@ func TestBeginBlock(t *testing.T) {
 	factor = keeper.GetDowntimeFactor(ctx, epochStartBlock(ctx))
 	require.Equal(t, uint64(2), factor)
 
+	// each downtime is kept in the history, the last one added the virtual epoch
+	var periods []v1.DowntimePeriod
+	keeper.IterateDowntimePeriods(ctx, func(period v1.DowntimePeriod) (stop bool) {
+		periods = append(periods, period)
+		return false
+	})
+	require.Len(t, periods, 3)
+	require.Equal(t, []uint64{0, 0, 1}, []uint64{periods[0].VirtualEpochs, periods[1].VirtualEpochs, periods[2].VirtualEpochs})
+	require.Equal(t, uint64(ctx.BlockHeight()), periods[2].EndBlock)
+	require.Equal(t, periods[2].EndBlock-1, periods[2].StartBlock)
+	require.Equal(t, ctx.BlockTime(), periods[2].EndTime)
+
 	beforeAdvanceEpoch := epochStartBlock(ctx)
 	// check garbage collection was done, after forcing epochs to pass until
 	// the first epoch is deleted.
@@ -128,6 +140,10 @@ func TestBeginBlock(t *testing.T) {
 	}
 	_, hadDowntime := keeper.GetDowntime(ctx, beforeAdvanceEpoch)
 	require.False(t, hadDowntime)
+	keeper.IterateDowntimePeriods(ctx, func(period v1.DowntimePeriod) (stop bool) {
+		require.NotEqual(t, beforeAdvanceEpoch, period.EpochStartBlock)
+		return false
+	})
 }
 
 func TestImportExportGenesis(t *testing.T) {
@@ -145,6 +161,16 @@ func TestImportExportGenesis(t *testing.T) {
 					Duration: 50 * time.Minute,
 				},
 			},
+			DowntimePeriods: []*v1.DowntimePeriod{
+				{
+					EpochStartBlock: 1,
+					StartBlock:      1,
+					EndBlock:        2,
+					StartTime:       ctx.BlockTime().Add(-50 * time.Minute),
+					EndTime:         ctx.BlockTime(),
+					VirtualEpochs:   1,
+				},
+			},
 			LastBlockTime: nil,
 		}
 		err := keeper.ImportGenesis(ctx, wantGs)
@@ -3,8 +3,13 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/lavanet/lava/x/downtime/types"
 	v1 "github.com/lavanet/lava/x/downtime/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ v1.QueryServer = queryServer{}
@@ -28,6 +33,56 @@ func (q queryServer) QueryDowntime(ctx context.Context, request *v1.QueryDowntim
 	return &v1.QueryDowntimeResponse{CumulativeDowntimeDuration: dt}, nil
 }
 
+func (q queryServer) QueryDowntimeHistory(ctx context.Context, request *v1.QueryDowntimeHistoryRequest) (*v1.QueryDowntimeHistoryResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	_ctx := sdk.UnwrapSDKContext(ctx)
+	store := prefix.NewStore(_ctx.KVStore(q.k.storeKey), types.DowntimePeriodKey)
+
+	var periods []v1.DowntimePeriod
+	pageRes, err := query.Paginate(store, request.Pagination, func(key, value []byte) error {
+		var period v1.DowntimePeriod
+		if err := q.k.cdc.Unmarshal(value, &period); err != nil {
+			return err
+		}
+		periods = append(periods, period)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryDowntimeHistoryResponse{DowntimePeriods: periods, Pagination: pageRes}, nil
+}
+
+func (q queryServer) QueryEpochDowntimes(ctx context.Context, request *v1.QueryEpochDowntimesRequest) (*v1.QueryEpochDowntimesResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	_ctx := sdk.UnwrapSDKContext(ctx)
+	store := prefix.NewStore(_ctx.KVStore(q.k.storeKey), types.DowntimeHeightKey)
+	epochDuration := q.k.GetParams(_ctx).EpochDuration
+
+	var epochDowntimes []v1.EpochDowntime
+	pageRes, err := query.Paginate(store, request.Pagination, func(key, value []byte) error {
+		duration := q.k.unmarshalDuration(value)
+		virtual := virtualEpochs(duration, epochDuration)
+		epochDowntimes = append(epochDowntimes, v1.EpochDowntime{
+			EpochStartBlock:            sdk.BigEndianToUint64(key),
+			CumulativeDowntimeDuration: duration,
+			VirtualEpochs:              virtual,
+			CuFactor:                   virtual + 1,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryEpochDowntimesResponse{EpochDowntimes: epochDowntimes, Pagination: pageRes}, nil
+}
+
 func NewQueryServer(k Keeper) v1.QueryServer {
 	return &queryServer{k: k}
 }
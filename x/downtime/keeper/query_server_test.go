@@ -6,6 +6,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/lavanet/lava/app"
 	"github.com/lavanet/lava/x/downtime/keeper"
 	v1 "github.com/lavanet/lava/x/downtime/v1"
@@ -63,3 +64,54 @@ func TestQueryServer_QueryParams(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, &v1.QueryParamsResponse{Params: &wantParams}, resp)
 }
+
+func TestQueryServer_QueryDowntimeHistory(t *testing.T) {
+	app, ctx := app.TestSetup()
+	dk := app.DowntimeKeeper
+	qs := keeper.NewQueryServer(dk)
+	dk.SetParams(ctx, v1.DefaultParams())
+	epochDuration := dk.GetParams(ctx).EpochDuration
+
+	// two downtimes in epoch 20 (the second adds a virtual epoch) and one in epoch 40
+	now := time.Now().UTC()
+	periods := []v1.DowntimePeriod{
+		{EpochStartBlock: 20, StartBlock: 21, EndBlock: 22, StartTime: now, EndTime: now.Add(epochDuration / 2)},
+		{EpochStartBlock: 20, StartBlock: 25, EndBlock: 26, StartTime: now, EndTime: now.Add(epochDuration), VirtualEpochs: 1},
+		{EpochStartBlock: 40, StartBlock: 41, EndBlock: 42, StartTime: now, EndTime: now.Add(2 * epochDuration), VirtualEpochs: 2},
+	}
+	for _, period := range periods {
+		dk.SetDowntimePeriod(ctx, period)
+	}
+	dk.SetDowntime(ctx, 20, epochDuration*3/2)
+	dk.SetDowntime(ctx, 40, 2*epochDuration)
+
+	t.Run("history", func(t *testing.T) {
+		resp, err := qs.QueryDowntimeHistory(sdk.WrapSDKContext(ctx), &v1.QueryDowntimeHistoryRequest{})
+		require.NoError(t, err)
+		require.Equal(t, periods, resp.DowntimePeriods)
+	})
+
+	t.Run("history pagination", func(t *testing.T) {
+		resp, err := qs.QueryDowntimeHistory(sdk.WrapSDKContext(ctx), &v1.QueryDowntimeHistoryRequest{
+			Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, periods[:2], resp.DowntimePeriods)
+		require.Equal(t, uint64(3), resp.Pagination.Total)
+
+		resp, err = qs.QueryDowntimeHistory(sdk.WrapSDKContext(ctx), &v1.QueryDowntimeHistoryRequest{
+			Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+		})
+		require.NoError(t, err)
+		require.Equal(t, periods[2:], resp.DowntimePeriods)
+	})
+
+	t.Run("epoch downtimes", func(t *testing.T) {
+		resp, err := qs.QueryEpochDowntimes(sdk.WrapSDKContext(ctx), &v1.QueryEpochDowntimesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, []v1.EpochDowntime{
+			{EpochStartBlock: 20, CumulativeDowntimeDuration: epochDuration * 3 / 2, VirtualEpochs: 1, CuFactor: 2},
+			{EpochStartBlock: 40, CumulativeDowntimeDuration: 2 * epochDuration, VirtualEpochs: 2, CuFactor: 3},
+		}, resp.EpochDowntimes)
+	})
+}
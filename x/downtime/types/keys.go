@@ -15,6 +15,7 @@ var (
 	LastBlockTimeKey         = []byte{0x01}
 	DowntimeHeightKey        = []byte{0x02}
 	DowntimeHeightGarbageKey = []byte{0x03}
+	DowntimePeriodKey        = []byte{0x04}
 )
 
 // GetDowntimeKey returns the downtime storage key given the height.
@@ -26,3 +27,13 @@ func GetDowntimeKey(height uint64) []byte {
 func ParseDowntimeKey(key []byte) uint64 {
 	return sdk.BigEndianToUint64(key[1:])
 }
+
+// GetDowntimePeriodPrefix returns the prefix of the downtime periods of an epoch.
+func GetDowntimePeriodPrefix(epochStartBlock uint64) []byte {
+	return append(DowntimePeriodKey, sdk.Uint64ToBigEndian(epochStartBlock)...)
+}
+
+// GetDowntimePeriodKey returns the downtime period storage key given the epoch and the block that ended the downtime.
+func GetDowntimePeriodKey(epochStartBlock uint64, endBlock uint64) []byte {
+	return append(GetDowntimePeriodPrefix(epochStartBlock), sdk.Uint64ToBigEndian(endBlock)...)
+}
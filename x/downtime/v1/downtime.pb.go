@@ -140,9 +140,175 @@ func (m *Downtime) GetDuration() time.Duration {
 	return 0
 }
 
+// DowntimePeriod defines a single period in which the chain was down.
+type DowntimePeriod struct {
+	// epoch_start_block defines the start block of the epoch in which the downtime was recorded.
+	EpochStartBlock uint64 `protobuf:"varint,1,opt,name=epoch_start_block,json=epochStartBlock,proto3" json:"epoch_start_block,omitempty"`
+	// start_block defines the last block produced before the downtime.
+	StartBlock uint64 `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	// end_block defines the block that took time to produce.
+	EndBlock uint64 `protobuf:"varint,3,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+	// start_time defines the time of the last block produced before the downtime.
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time defines the time of the block that took time to produce.
+	EndTime time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// virtual_epochs defines the number of virtual epochs this downtime added to its epoch.
+	VirtualEpochs uint64 `protobuf:"varint,6,opt,name=virtual_epochs,json=virtualEpochs,proto3" json:"virtual_epochs,omitempty"`
+}
+
+func (m *DowntimePeriod) Reset()         { *m = DowntimePeriod{} }
+func (m *DowntimePeriod) String() string { return proto.CompactTextString(m) }
+func (*DowntimePeriod) ProtoMessage()    {}
+func (*DowntimePeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17cbf2f7c6c4bd94, []int{2}
+}
+func (m *DowntimePeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DowntimePeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DowntimePeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DowntimePeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DowntimePeriod.Merge(m, src)
+}
+func (m *DowntimePeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *DowntimePeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_DowntimePeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DowntimePeriod proto.InternalMessageInfo
+
+func (m *DowntimePeriod) GetEpochStartBlock() uint64 {
+	if m != nil {
+		return m.EpochStartBlock
+	}
+	return 0
+}
+
+func (m *DowntimePeriod) GetStartBlock() uint64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+func (m *DowntimePeriod) GetEndBlock() uint64 {
+	if m != nil {
+		return m.EndBlock
+	}
+	return 0
+}
+
+func (m *DowntimePeriod) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *DowntimePeriod) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *DowntimePeriod) GetVirtualEpochs() uint64 {
+	if m != nil {
+		return m.VirtualEpochs
+	}
+	return 0
+}
+
+// EpochDowntime defines the downtime summary of a single epoch.
+type EpochDowntime struct {
+	// epoch_start_block defines the start block of the epoch.
+	EpochStartBlock uint64 `protobuf:"varint,1,opt,name=epoch_start_block,json=epochStartBlock,proto3" json:"epoch_start_block,omitempty"`
+	// cumulative_downtime_duration defines the total downtime recorded in the epoch.
+	CumulativeDowntimeDuration time.Duration `protobuf:"bytes,2,opt,name=cumulative_downtime_duration,json=cumulativeDowntimeDuration,proto3,stdduration" json:"cumulative_downtime_duration"`
+	// virtual_epochs defines the number of virtual epochs added to the epoch.
+	VirtualEpochs uint64 `protobuf:"varint,3,opt,name=virtual_epochs,json=virtualEpochs,proto3" json:"virtual_epochs,omitempty"`
+	// cu_factor defines the multiplier of the consumers' epoch CU allowance in the epoch (virtual_epochs + 1).
+	CuFactor uint64 `protobuf:"varint,4,opt,name=cu_factor,json=cuFactor,proto3" json:"cu_factor,omitempty"`
+}
+
+func (m *EpochDowntime) Reset()         { *m = EpochDowntime{} }
+func (m *EpochDowntime) String() string { return proto.CompactTextString(m) }
+func (*EpochDowntime) ProtoMessage()    {}
+func (*EpochDowntime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17cbf2f7c6c4bd94, []int{3}
+}
+func (m *EpochDowntime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochDowntime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochDowntime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochDowntime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochDowntime.Merge(m, src)
+}
+func (m *EpochDowntime) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochDowntime) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochDowntime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochDowntime proto.InternalMessageInfo
+
+func (m *EpochDowntime) GetEpochStartBlock() uint64 {
+	if m != nil {
+		return m.EpochStartBlock
+	}
+	return 0
+}
+
+func (m *EpochDowntime) GetCumulativeDowntimeDuration() time.Duration {
+	if m != nil {
+		return m.CumulativeDowntimeDuration
+	}
+	return 0
+}
+
+func (m *EpochDowntime) GetVirtualEpochs() uint64 {
+	if m != nil {
+		return m.VirtualEpochs
+	}
+	return 0
+}
+
+func (m *EpochDowntime) GetCuFactor() uint64 {
+	if m != nil {
+		return m.CuFactor
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "lavanet.lava.downtime.v1.Params")
 	proto.RegisterType((*Downtime)(nil), "lavanet.lava.downtime.v1.Downtime")
+	proto.RegisterType((*DowntimePeriod)(nil), "lavanet.lava.downtime.v1.DowntimePeriod")
+	proto.RegisterType((*EpochDowntime)(nil), "lavanet.lava.downtime.v1.EpochDowntime")
 }
 
 func init() {
@@ -150,25 +316,36 @@ func init() {
 }
 
 var fileDescriptor_17cbf2f7c6c4bd94 = []byte{
-	// 273 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcf, 0x49, 0x2c, 0x4b,
-	0xcc, 0x4b, 0x2d, 0xd1, 0x07, 0xd1, 0xfa, 0x29, 0xf9, 0xe5, 0x79, 0x25, 0x99, 0xb9, 0xa9, 0xfa,
-	0x65, 0x86, 0x70, 0xb6, 0x5e, 0x41, 0x51, 0x7e, 0x49, 0xbe, 0x90, 0x04, 0x54, 0xa1, 0x1e, 0x88,
-	0xd6, 0x83, 0x4b, 0x96, 0x19, 0x4a, 0xc9, 0xa5, 0xe7, 0xe7, 0xa7, 0xe7, 0xa4, 0xea, 0x83, 0xd5,
-	0x25, 0x95, 0xa6, 0xe9, 0xa7, 0x94, 0x16, 0x25, 0x96, 0x64, 0xe6, 0xe7, 0x41, 0x74, 0x4a, 0xc9,
-	0xa3, 0xcb, 0x83, 0x34, 0x16, 0x97, 0x24, 0xe6, 0x16, 0x40, 0x15, 0x88, 0xa4, 0xe7, 0xa7, 0xe7,
-	0x83, 0x99, 0xfa, 0x20, 0x16, 0x44, 0x54, 0x69, 0x19, 0x23, 0x17, 0x5b, 0x40, 0x62, 0x51, 0x62,
-	0x6e, 0xb1, 0x50, 0x00, 0x97, 0x20, 0xcc, 0xc2, 0x78, 0x98, 0xe1, 0x12, 0x8c, 0x0a, 0x8c, 0x1a,
-	0xdc, 0x46, 0x92, 0x7a, 0x10, 0xd3, 0xf5, 0x60, 0xa6, 0xeb, 0xb9, 0x40, 0x15, 0x38, 0x71, 0x9c,
-	0xb8, 0x27, 0xcf, 0x30, 0xe3, 0xbe, 0x3c, 0x63, 0x90, 0x00, 0x4c, 0x37, 0x4c, 0x4e, 0xc8, 0x8b,
-	0x8b, 0x2f, 0xb5, 0x20, 0x3f, 0x39, 0x03, 0x61, 0x1c, 0x13, 0xf1, 0xc6, 0xf1, 0x82, 0xb5, 0xc2,
-	0x24, 0x94, 0x12, 0xb9, 0x38, 0x5c, 0xa0, 0xe6, 0x0b, 0x89, 0x70, 0xb1, 0x26, 0xe5, 0xe4, 0x27,
-	0x67, 0x83, 0x5d, 0xc7, 0x12, 0x04, 0xe1, 0x08, 0xd9, 0x73, 0x71, 0x90, 0x63, 0x0f, 0x5c, 0x93,
-	0x93, 0xfd, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xa9, 0xa6, 0x67, 0x96,
-	0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xa3, 0x44, 0x65, 0x05, 0x72, 0x64, 0x26, 0xb1,
-	0x81, 0xed, 0x31, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xd2, 0xea, 0xa8, 0x87, 0xef, 0x01, 0x00,
-	0x00,
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xcd, 0xa6, 0x69, 0x70, 0xa7, 0x4a, 0xa0, 0x56, 0x0f, 0x21, 0x20, 0x07, 0x45, 0xaa, 0x40,
+	0x1c, 0x6c, 0x15, 0x3e, 0xa0, 0x52, 0x08, 0x1c, 0x38, 0x45, 0x81, 0x13, 0x17, 0x6b, 0x6d, 0x6f,
+	0x5d, 0x0b, 0xdb, 0x1b, 0xd9, 0xbb, 0x86, 0xcf, 0xe8, 0x91, 0x2f, 0x80, 0x5f, 0xe9, 0xb1, 0x47,
+	0x4e, 0x80, 0x12, 0x89, 0xef, 0x40, 0x3b, 0xeb, 0x75, 0x42, 0xc2, 0x81, 0xf4, 0xe4, 0xf1, 0xbc,
+	0x37, 0xef, 0xcd, 0xec, 0xec, 0xc2, 0xd3, 0x94, 0x56, 0x34, 0x67, 0xc2, 0x53, 0x5f, 0x2f, 0xe2,
+	0x9f, 0x72, 0x91, 0x64, 0xcc, 0xab, 0xce, 0x9b, 0xd8, 0x5d, 0x14, 0x5c, 0x70, 0x7b, 0x50, 0x13,
+	0x5d, 0xf5, 0x75, 0x1b, 0xb0, 0x3a, 0x1f, 0x3a, 0x31, 0xe7, 0x71, 0xca, 0x3c, 0xe4, 0x05, 0xf2,
+	0xd2, 0x8b, 0x64, 0x41, 0x45, 0xc2, 0x73, 0x5d, 0x39, 0x1c, 0x6d, 0xe3, 0xaa, 0xb0, 0x14, 0x34,
+	0x5b, 0xd4, 0x84, 0xd3, 0x98, 0xc7, 0x1c, 0x43, 0x4f, 0x45, 0x3a, 0x3b, 0xfe, 0x4a, 0xa0, 0x3b,
+	0xa3, 0x05, 0xcd, 0x4a, 0x7b, 0x06, 0x27, 0xc6, 0xd0, 0x37, 0xe2, 0x03, 0xf2, 0x84, 0x3c, 0x3b,
+	0x7e, 0xf1, 0xd0, 0xd5, 0xea, 0xae, 0x51, 0x77, 0xa7, 0x35, 0x61, 0x62, 0xdd, 0xfc, 0x18, 0xb5,
+	0xbe, 0xfc, 0x1c, 0x91, 0xf9, 0x03, 0x53, 0x6d, 0x30, 0xfb, 0x2d, 0xf4, 0xd9, 0x82, 0x87, 0x57,
+	0x6b, 0xb9, 0xf6, 0xff, 0xcb, 0xf5, 0xb0, 0xd4, 0x00, 0x63, 0x0a, 0xd6, 0xb4, 0xd6, 0xb7, 0x4f,
+	0xe1, 0x30, 0x48, 0x79, 0xf8, 0x11, 0xbb, 0xeb, 0xcc, 0xf5, 0x8f, 0x7d, 0x01, 0xd6, 0x5d, 0x7c,
+	0x9a, 0xa2, 0xf1, 0xb7, 0x36, 0xf4, 0x8d, 0xc7, 0x8c, 0x15, 0x09, 0x8f, 0xec, 0xe7, 0x70, 0xa2,
+	0x27, 0x28, 0x05, 0x2d, 0x84, 0xbf, 0xe9, 0x7a, 0x1f, 0x81, 0x77, 0x2a, 0x3f, 0x41, 0xff, 0x11,
+	0x1c, 0x6f, 0xb2, 0xda, 0xc8, 0x82, 0x72, 0x4d, 0x78, 0x04, 0x47, 0x2c, 0x8f, 0x6a, 0xf8, 0x00,
+	0x61, 0x8b, 0xe5, 0x91, 0x06, 0x5f, 0x81, 0xa6, 0xfa, 0xca, 0x7d, 0xd0, 0xc1, 0xfe, 0x87, 0x3b,
+	0xfd, 0xbf, 0x37, 0x4b, 0xd5, 0x03, 0x5c, 0xab, 0x01, 0x8e, 0xb0, 0x4e, 0x21, 0xea, 0x08, 0x94,
+	0x03, 0x4a, 0x1c, 0xee, 0x21, 0x71, 0x8f, 0xe5, 0x11, 0x0a, 0x9c, 0x41, 0xbf, 0x4a, 0x0a, 0x21,
+	0x69, 0xea, 0xe3, 0x78, 0xe5, 0xa0, 0x8b, 0x7d, 0xf6, 0xea, 0xec, 0x6b, 0x4c, 0x8e, 0x7f, 0x13,
+	0xe8, 0x61, 0xd8, 0xac, 0x64, 0x9f, 0x83, 0x62, 0xf0, 0x38, 0x94, 0x99, 0x4c, 0xa9, 0x48, 0x2a,
+	0xe6, 0xef, 0xde, 0xb9, 0x3d, 0x96, 0x37, 0x5c, 0x0b, 0x4d, 0xb7, 0x6f, 0xdf, 0xee, 0x2c, 0x07,
+	0xff, 0x98, 0x45, 0x6d, 0x25, 0x94, 0xfe, 0x25, 0x0d, 0x05, 0x2f, 0xf0, 0xdc, 0x3b, 0x73, 0x2b,
+	0x94, 0x6f, 0xf0, 0x7f, 0x72, 0x71, 0xb3, 0x74, 0xc8, 0xed, 0xd2, 0x21, 0xbf, 0x96, 0x0e, 0xb9,
+	0x5e, 0x39, 0xad, 0xdb, 0x95, 0xd3, 0xfa, 0xbe, 0x72, 0x5a, 0x1f, 0xce, 0xe2, 0x44, 0x5c, 0xc9,
+	0xc0, 0x0d, 0x79, 0xe6, 0xfd, 0xf5, 0xba, 0x3f, 0x6f, 0xbe, 0xef, 0xa0, 0x8b, 0xdd, 0xbf, 0xfc,
+	0x33, 0x00, 0x11, 0x68, 0x8b, 0x09, 0x02, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -246,6 +423,111 @@ func (m *Downtime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DowntimePeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DowntimePeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DowntimePeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VirtualEpochs != 0 {
+		i = encodeVarintDowntime(dAtA, i, uint64(m.VirtualEpochs))
+		i--
+		dAtA[i] = 0x30
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintDowntime(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintDowntime(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if m.EndBlock != 0 {
+		i = encodeVarintDowntime(dAtA, i, uint64(m.EndBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartBlock != 0 {
+		i = encodeVarintDowntime(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochStartBlock != 0 {
+		i = encodeVarintDowntime(dAtA, i, uint64(m.EpochStartBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochDowntime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochDowntime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochDowntime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CuFactor != 0 {
+		i = encodeVarintDowntime(dAtA, i, uint64(m.CuFactor))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VirtualEpochs != 0 {
+		i = encodeVarintDowntime(dAtA, i, uint64(m.VirtualEpochs))
+		i--
+		dAtA[i] = 0x18
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CumulativeDowntimeDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CumulativeDowntimeDuration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintDowntime(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if m.EpochStartBlock != 0 {
+		i = encodeVarintDowntime(dAtA, i, uint64(m.EpochStartBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDowntime(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDowntime(v)
 	base := offset
@@ -284,6 +566,51 @@ func (m *Downtime) Size() (n int) {
 	return n
 }
 
+func (m *DowntimePeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochStartBlock != 0 {
+		n += 1 + sovDowntime(uint64(m.EpochStartBlock))
+	}
+	if m.StartBlock != 0 {
+		n += 1 + sovDowntime(uint64(m.StartBlock))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovDowntime(uint64(m.EndBlock))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovDowntime(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovDowntime(uint64(l))
+	if m.VirtualEpochs != 0 {
+		n += 1 + sovDowntime(uint64(m.VirtualEpochs))
+	}
+	return n
+}
+
+func (m *EpochDowntime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochStartBlock != 0 {
+		n += 1 + sovDowntime(uint64(m.EpochStartBlock))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CumulativeDowntimeDuration)
+	n += 1 + l + sovDowntime(uint64(l))
+	if m.VirtualEpochs != 0 {
+		n += 1 + sovDowntime(uint64(m.VirtualEpochs))
+	}
+	if m.CuFactor != 0 {
+		n += 1 + sovDowntime(uint64(m.CuFactor))
+	}
+	return n
+}
+
 func sovDowntime(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -508,6 +835,338 @@ func (m *Downtime) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DowntimePeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDowntime
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DowntimePeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DowntimePeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartBlock", wireType)
+			}
+			m.EpochStartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDowntime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochStartBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+			}
+			m.StartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDowntime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			m.EndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDowntime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDowntime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDowntime
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDowntime
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDowntime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDowntime
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDowntime
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualEpochs", wireType)
+			}
+			m.VirtualEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDowntime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VirtualEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDowntime(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDowntime
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochDowntime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDowntime
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochDowntime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochDowntime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartBlock", wireType)
+			}
+			m.EpochStartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDowntime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochStartBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeDowntimeDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDowntime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDowntime
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDowntime
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CumulativeDowntimeDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualEpochs", wireType)
+			}
+			m.VirtualEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDowntime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VirtualEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CuFactor", wireType)
+			}
+			m.CuFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDowntime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CuFactor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDowntime(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDowntime
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDowntime(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
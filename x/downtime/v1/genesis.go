@@ -20,6 +20,11 @@ func (m *GenesisState) Validate() error {
 			return fmt.Errorf("invalid downtime %d: %w", i, err)
 		}
 	}
+	for i, p := range m.DowntimePeriods {
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("invalid downtime period %d: %w", i, err)
+		}
+	}
 
 	return nil
 }
@@ -33,3 +38,13 @@ func (m *Downtime) Validate() error {
 	}
 	return nil
 }
+
+func (m *DowntimePeriod) Validate() error {
+	if m.EndBlock <= m.StartBlock {
+		return fmt.Errorf("invalid downtime period blocks: start %d, end %d", m.StartBlock, m.EndBlock)
+	}
+	if !m.EndTime.After(m.StartTime) {
+		return fmt.Errorf("invalid downtime period times: start %s, end %s", m.StartTime, m.EndTime)
+	}
+	return nil
+}
@@ -34,7 +34,8 @@ type GenesisState struct {
 	// last_block_time keeps track of when the last block time was set.
 	// it's nullable because we might want it to be non existent.
 	// we want it to exist when we have a genesis export-import migration scenario.
-	LastBlockTime *time.Time `protobuf:"bytes,3,opt,name=last_block_time,json=lastBlockTime,proto3,stdtime" json:"last_block_time,omitempty"`
+	LastBlockTime   *time.Time        `protobuf:"bytes,3,opt,name=last_block_time,json=lastBlockTime,proto3,stdtime" json:"last_block_time,omitempty"`
+	DowntimePeriods []*DowntimePeriod `protobuf:"bytes,4,rep,name=downtime_periods,json=downtimePeriods,proto3" json:"downtime_periods,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -91,6 +92,13 @@ func (m *GenesisState) GetLastBlockTime() *time.Time {
 	return nil
 }
 
+func (m *GenesisState) GetDowntimePeriods() []*DowntimePeriod {
+	if m != nil {
+		return m.DowntimePeriods
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lavanet.lava.downtime.v1.GenesisState")
 }
@@ -100,26 +108,27 @@ func init() {
 }
 
 var fileDescriptor_6fd11efb3f8802bf = []byte{
-	// 293 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcb, 0x49, 0x2c, 0x4b,
-	0xcc, 0x4b, 0x2d, 0xd1, 0x07, 0xd1, 0xfa, 0x29, 0xf9, 0xe5, 0x79, 0x25, 0x99, 0xb9, 0xa9, 0xfa,
-	0x65, 0x86, 0xfa, 0xe9, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0x12, 0x50, 0x75, 0x7a, 0x20, 0x5a, 0x0f, 0xa6, 0x4e, 0xaf, 0xcc, 0x50, 0x4a, 0x1d, 0xa7,
-	0x09, 0x70, 0x55, 0x60, 0x23, 0xa4, 0xe4, 0xd3, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0xc1, 0xbc,
-	0xa4, 0xd2, 0x34, 0x7d, 0x90, 0x5c, 0x71, 0x49, 0x62, 0x6e, 0x01, 0x54, 0x81, 0x48, 0x7a, 0x7e,
-	0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95, 0xee, 0x31, 0x72, 0xf1, 0xb8, 0x43, 0xdc,
-	0x12, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc7, 0xc5, 0x56, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c,
-	0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xa0, 0x87, 0xcb, 0x6d, 0x7a, 0x01, 0x60, 0x75, 0x4e,
-	0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x75, 0x09, 0x39, 0x70, 0x71, 0xc2, 0xd4, 0x14, 0x4b,
-	0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x29, 0xe1, 0x36, 0xc2, 0x05, 0xca, 0x0e, 0x42, 0x68, 0x12,
-	0xf2, 0xe1, 0xe2, 0xcf, 0x49, 0x2c, 0x2e, 0x89, 0x4f, 0xca, 0xc9, 0x4f, 0xce, 0x8e, 0x07, 0x89,
-	0x49, 0x30, 0x83, 0x9d, 0x22, 0xa5, 0x07, 0xf1, 0xa3, 0x1e, 0xcc, 0x8f, 0x7a, 0x21, 0x30, 0x3f,
-	0x3a, 0x71, 0x9c, 0xb8, 0x27, 0xcf, 0x38, 0xe1, 0xbe, 0x3c, 0x63, 0x10, 0x2f, 0x48, 0xb3, 0x13,
-	0x48, 0x2f, 0x48, 0xd6, 0xc9, 0xfe, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c,
-	0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2,
-	0x54, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x51, 0x42, 0xb9, 0x02,
-	0x39, 0x9c, 0x93, 0xd8, 0xc0, 0xb6, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x3b, 0x46,
-	0x49, 0xcc, 0x01, 0x00, 0x00,
+	// 319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x51, 0x3f, 0x4f, 0xc2, 0x40,
+	0x1c, 0xed, 0x01, 0x21, 0x7a, 0x68, 0x30, 0x8d, 0x43, 0xc3, 0x70, 0x25, 0x24, 0x2a, 0xd3, 0x5d,
+	0xc0, 0x5d, 0x4d, 0x63, 0xe2, 0xe2, 0x40, 0x8a, 0x93, 0x0b, 0xb9, 0xc2, 0x79, 0x36, 0xb6, 0x5c,
+	0xc3, 0x1d, 0xe8, 0xc7, 0xe0, 0xbb, 0xf8, 0x25, 0x18, 0x19, 0x9d, 0xd0, 0xb4, 0x5f, 0xc4, 0xdc,
+	0xb5, 0x55, 0x19, 0x1a, 0xa7, 0xdf, 0xbf, 0xf7, 0x5e, 0xde, 0xcb, 0x0f, 0x9e, 0x47, 0x74, 0x45,
+	0xe7, 0x4c, 0x11, 0x5d, 0xc9, 0x4c, 0xbc, 0xce, 0x55, 0x18, 0x33, 0xb2, 0x1a, 0x10, 0xce, 0xe6,
+	0x4c, 0x86, 0x12, 0x27, 0x0b, 0xa1, 0x84, 0xed, 0x14, 0x38, 0xac, 0x2b, 0x2e, 0x71, 0x78, 0x35,
+	0xe8, 0x5c, 0x54, 0x2a, 0xfc, 0xa0, 0x8c, 0x44, 0xc7, 0xe5, 0x42, 0xf0, 0x88, 0x11, 0x33, 0x05,
+	0xcb, 0x27, 0xa2, 0x6f, 0x52, 0xd1, 0x38, 0x29, 0x00, 0xa7, 0x5c, 0x70, 0x61, 0x5a, 0xa2, 0xbb,
+	0x7c, 0xdb, 0x7b, 0xaf, 0xc1, 0xa3, 0xbb, 0xdc, 0xcb, 0x58, 0x51, 0xc5, 0xec, 0x2b, 0xd8, 0x4c,
+	0xe8, 0x82, 0xc6, 0xd2, 0x01, 0x5d, 0xd0, 0x6f, 0x0d, 0xbb, 0xb8, 0xca, 0x1b, 0x1e, 0x19, 0x9c,
+	0xd7, 0xd8, 0xec, 0x5c, 0xcb, 0x2f, 0x58, 0xf6, 0x0d, 0x3c, 0x2c, 0x31, 0xd2, 0xa9, 0x75, 0xeb,
+	0xfd, 0xd6, 0xb0, 0x57, 0x2d, 0x71, 0x5b, 0xf4, 0xfe, 0x2f, 0xc9, 0xbe, 0x87, 0xed, 0x88, 0x4a,
+	0x35, 0x09, 0x22, 0x31, 0x7d, 0x99, 0xe8, 0x9d, 0x53, 0x37, 0x56, 0x3a, 0x38, 0xcf, 0x88, 0xcb,
+	0x8c, 0xf8, 0xa1, 0xcc, 0xe8, 0x1d, 0x6c, 0x76, 0x2e, 0x58, 0x7f, 0xba, 0xc0, 0x3f, 0xd6, 0x64,
+	0x4f, 0x73, 0xf5, 0xd5, 0x1e, 0xc3, 0x93, 0x52, 0x7a, 0x92, 0xb0, 0x45, 0x28, 0x66, 0xd2, 0x69,
+	0x18, 0x5b, 0xfd, 0xff, 0x6d, 0x8d, 0x0c, 0xc1, 0x6f, 0xcf, 0xf6, 0x66, 0xe9, 0x5d, 0x6f, 0x52,
+	0x04, 0xb6, 0x29, 0x02, 0x5f, 0x29, 0x02, 0xeb, 0x0c, 0x59, 0xdb, 0x0c, 0x59, 0x1f, 0x19, 0xb2,
+	0x1e, 0xcf, 0x78, 0xa8, 0x9e, 0x97, 0x01, 0x9e, 0x8a, 0x98, 0xec, 0xbd, 0xee, 0xed, 0xef, 0xf3,
+	0x82, 0xa6, 0x89, 0x70, 0xf9, 0x3d, 0x00, 0xfe, 0xed, 0xb0, 0x3c, 0x21, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -142,6 +151,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DowntimePeriods) > 0 {
+		for iNdEx := len(m.DowntimePeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DowntimePeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.LastBlockTime != nil {
 		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastBlockTime):])
 		if err1 != nil {
@@ -208,6 +231,12 @@ func (m *GenesisState) Size() (n int) {
 		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastBlockTime)
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.DowntimePeriods) > 0 {
+		for _, e := range m.DowntimePeriods {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -349,6 +378,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimePeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DowntimePeriods = append(m.DowntimePeriods, &DowntimePeriod{})
+			if err := m.DowntimePeriods[len(m.DowntimePeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
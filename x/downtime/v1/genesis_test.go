@@ -55,6 +55,34 @@ func TestGenesis_Validate(t *testing.T) {
 			},
 			ExpError: "invalid downtime block",
 		},
+		"invalid downtime period - blocks": {
+			Genesis: GenesisState{
+				Params: DefaultParams(),
+				DowntimePeriods: []*DowntimePeriod{
+					{
+						StartBlock: 2,
+						EndBlock:   2,
+						StartTime:  time.Unix(1, 0),
+						EndTime:    time.Unix(2, 0),
+					},
+				},
+			},
+			ExpError: "invalid downtime period blocks",
+		},
+		"invalid downtime period - times": {
+			Genesis: GenesisState{
+				Params: DefaultParams(),
+				DowntimePeriods: []*DowntimePeriod{
+					{
+						StartBlock: 1,
+						EndBlock:   2,
+						StartTime:  time.Unix(2, 0),
+						EndTime:    time.Unix(2, 0),
+					},
+				},
+			},
+			ExpError: "invalid downtime period times",
+		},
 	}
 
 	for name, tc := range tests {
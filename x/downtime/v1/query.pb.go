@@ -6,6 +6,7 @@ package v1
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -123,6 +124,202 @@ func (m *QueryDowntimeResponse) GetCumulativeDowntimeDuration() time.Duration {
 	return 0
 }
 
+// QueryDowntimeHistoryRequest is the request type for the Query/QueryDowntimeHistory RPC method.
+type QueryDowntimeHistoryRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDowntimeHistoryRequest) Reset()         { *m = QueryDowntimeHistoryRequest{} }
+func (m *QueryDowntimeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDowntimeHistoryRequest) ProtoMessage()    {}
+func (*QueryDowntimeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b667ee25520f3a08, []int{2}
+}
+func (m *QueryDowntimeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDowntimeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDowntimeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDowntimeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDowntimeHistoryRequest.Merge(m, src)
+}
+func (m *QueryDowntimeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDowntimeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDowntimeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDowntimeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryDowntimeHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDowntimeHistoryResponse is the response type for the Query/QueryDowntimeHistory RPC method.
+type QueryDowntimeHistoryResponse struct {
+	DowntimePeriods []DowntimePeriod    `protobuf:"bytes,1,rep,name=downtime_periods,json=downtimePeriods,proto3" json:"downtime_periods"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDowntimeHistoryResponse) Reset()         { *m = QueryDowntimeHistoryResponse{} }
+func (m *QueryDowntimeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDowntimeHistoryResponse) ProtoMessage()    {}
+func (*QueryDowntimeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b667ee25520f3a08, []int{3}
+}
+func (m *QueryDowntimeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDowntimeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDowntimeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDowntimeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDowntimeHistoryResponse.Merge(m, src)
+}
+func (m *QueryDowntimeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDowntimeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDowntimeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDowntimeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryDowntimeHistoryResponse) GetDowntimePeriods() []DowntimePeriod {
+	if m != nil {
+		return m.DowntimePeriods
+	}
+	return nil
+}
+
+func (m *QueryDowntimeHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEpochDowntimesRequest is the request type for the Query/QueryEpochDowntimes RPC method.
+type QueryEpochDowntimesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEpochDowntimesRequest) Reset()         { *m = QueryEpochDowntimesRequest{} }
+func (m *QueryEpochDowntimesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochDowntimesRequest) ProtoMessage()    {}
+func (*QueryEpochDowntimesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b667ee25520f3a08, []int{4}
+}
+func (m *QueryEpochDowntimesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochDowntimesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochDowntimesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochDowntimesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochDowntimesRequest.Merge(m, src)
+}
+func (m *QueryEpochDowntimesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochDowntimesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochDowntimesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochDowntimesRequest proto.InternalMessageInfo
+
+func (m *QueryEpochDowntimesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEpochDowntimesResponse is the response type for the Query/QueryEpochDowntimes RPC method.
+type QueryEpochDowntimesResponse struct {
+	EpochDowntimes []EpochDowntime     `protobuf:"bytes,1,rep,name=epoch_downtimes,json=epochDowntimes,proto3" json:"epoch_downtimes"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEpochDowntimesResponse) Reset()         { *m = QueryEpochDowntimesResponse{} }
+func (m *QueryEpochDowntimesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochDowntimesResponse) ProtoMessage()    {}
+func (*QueryEpochDowntimesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b667ee25520f3a08, []int{5}
+}
+func (m *QueryEpochDowntimesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochDowntimesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochDowntimesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochDowntimesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochDowntimesResponse.Merge(m, src)
+}
+func (m *QueryEpochDowntimesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochDowntimesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochDowntimesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochDowntimesResponse proto.InternalMessageInfo
+
+func (m *QueryEpochDowntimesResponse) GetEpochDowntimes() []EpochDowntime {
+	if m != nil {
+		return m.EpochDowntimes
+	}
+	return nil
+}
+
+func (m *QueryEpochDowntimesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryParamsRequest struct {
 }
 
@@ -130,7 +327,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b667ee25520f3a08, []int{2}
+	return fileDescriptor_b667ee25520f3a08, []int{6}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -167,7 +364,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b667ee25520f3a08, []int{3}
+	return fileDescriptor_b667ee25520f3a08, []int{7}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,6 +403,10 @@ func (m *QueryParamsResponse) GetParams() *Params {
 func init() {
 	proto.RegisterType((*QueryDowntimeRequest)(nil), "lavanet.lava.downtime.v1.QueryDowntimeRequest")
 	proto.RegisterType((*QueryDowntimeResponse)(nil), "lavanet.lava.downtime.v1.QueryDowntimeResponse")
+	proto.RegisterType((*QueryDowntimeHistoryRequest)(nil), "lavanet.lava.downtime.v1.QueryDowntimeHistoryRequest")
+	proto.RegisterType((*QueryDowntimeHistoryResponse)(nil), "lavanet.lava.downtime.v1.QueryDowntimeHistoryResponse")
+	proto.RegisterType((*QueryEpochDowntimesRequest)(nil), "lavanet.lava.downtime.v1.QueryEpochDowntimesRequest")
+	proto.RegisterType((*QueryEpochDowntimesResponse)(nil), "lavanet.lava.downtime.v1.QueryEpochDowntimesResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "lavanet.lava.downtime.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.downtime.v1.QueryParamsResponse")
 }
@@ -215,34 +416,48 @@ func init() {
 }
 
 var fileDescriptor_b667ee25520f3a08 = []byte{
-	// 423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0xeb, 0xd3, 0x30,
-	0x18, 0xc6, 0x9b, 0xa1, 0x43, 0x32, 0x44, 0x8c, 0x13, 0x66, 0x19, 0x5d, 0x29, 0x8a, 0x65, 0x68,
-	0xe2, 0xe6, 0xc5, 0x9b, 0x50, 0x76, 0x57, 0xe7, 0xcd, 0x4b, 0x49, 0xbb, 0xd8, 0x15, 0xdb, 0xa6,
-	0x6b, 0xd3, 0xaa, 0x17, 0x0f, 0x7e, 0x02, 0x41, 0x0f, 0x9e, 0xfd, 0x2a, 0x5e, 0x76, 0x1c, 0x78,
-	0xf1, 0xa4, 0xb2, 0xf9, 0x41, 0xa4, 0x69, 0x3a, 0xb7, 0x49, 0xfd, 0xef, 0x94, 0xf0, 0xe4, 0xc9,
-	0xef, 0x7d, 0xde, 0xbe, 0x29, 0xbc, 0x1d, 0xd1, 0x92, 0x26, 0x4c, 0x90, 0x6a, 0x25, 0x0b, 0xfe,
-	0x3a, 0x11, 0x61, 0xcc, 0x48, 0x39, 0x21, 0xab, 0x82, 0x65, 0x6f, 0x71, 0x9a, 0x71, 0xc1, 0xd1,
-	0x40, 0xb9, 0x70, 0xb5, 0xe2, 0xc6, 0x85, 0xcb, 0x89, 0x6e, 0x04, 0x9c, 0x07, 0x11, 0x23, 0xd2,
-	0xe7, 0x15, 0x2f, 0xc9, 0xa2, 0xc8, 0xa8, 0x08, 0x79, 0x52, 0xdf, 0xd4, 0xef, 0xb6, 0xf2, 0xf7,
-	0x94, 0xda, 0xd8, 0x0f, 0x78, 0xc0, 0xe5, 0x96, 0x54, 0x3b, 0xa5, 0x0e, 0x15, 0x9e, 0xa6, 0x21,
-	0xa1, 0x49, 0xc2, 0x85, 0x64, 0xe7, 0xf5, 0xa9, 0xe5, 0xc0, 0xfe, 0xb3, 0x2a, 0xe5, 0x4c, 0xa1,
-	0xe6, 0x6c, 0x55, 0xb0, 0x5c, 0xa0, 0x31, 0xbc, 0xce, 0x52, 0xee, 0x2f, 0xdd, 0x5c, 0xd0, 0x4c,
-	0xb8, 0x5e, 0xc4, 0xfd, 0x57, 0x03, 0x60, 0x02, 0xfb, 0xd2, 0xfc, 0x9a, 0x3c, 0x78, 0x5e, 0xe9,
-	0x4e, 0x25, 0x5b, 0xef, 0xe0, 0xcd, 0x13, 0x46, 0x9e, 0xf2, 0x24, 0x67, 0x88, 0xc1, 0xa1, 0x5f,
-	0xc4, 0x45, 0x44, 0x45, 0x58, 0x32, 0xb7, 0x49, 0xeb, 0x36, 0xfd, 0x0d, 0x3a, 0x26, 0xb0, 0x7b,
-	0xd3, 0x5b, 0xb8, 0x4e, 0x88, 0x9b, 0x0f, 0x80, 0x67, 0xca, 0xe0, 0x5c, 0x59, 0xff, 0x18, 0x69,
-	0x9f, 0x7f, 0x8e, 0xc0, 0x5c, 0xff, 0x0b, 0x6a, 0xca, 0x34, 0x2e, 0xab, 0x0f, 0x91, 0xac, 0xff,
-	0x94, 0x66, 0x34, 0xce, 0x55, 0x07, 0xd6, 0x13, 0x78, 0xe3, 0x48, 0x55, 0x99, 0x1e, 0xc1, 0x6e,
-	0x2a, 0x15, 0xd9, 0x4d, 0x6f, 0x6a, 0xe2, 0xb6, 0xc1, 0x60, 0x75, 0x53, 0xf9, 0xa7, 0x5f, 0x3b,
-	0xf0, 0xb2, 0x24, 0xa2, 0x4f, 0x00, 0xf6, 0x0e, 0xd8, 0xe8, 0x5e, 0x3b, 0xe3, 0xdf, 0x60, 0xfa,
-	0xfd, 0x33, 0xdd, 0x75, 0x60, 0xcb, 0x7e, 0xff, 0xed, 0xf7, 0xc7, 0x8e, 0x85, 0x4c, 0xd2, 0xfa,
-	0x0e, 0xea, 0x80, 0xe8, 0x0b, 0x80, 0x57, 0x8f, 0x06, 0x81, 0xf0, 0x05, 0xa5, 0x4e, 0xa6, 0xae,
-	0x93, 0xb3, 0xfd, 0x2a, 0xdc, 0x03, 0x19, 0x6e, 0x8c, 0x6c, 0xf2, 0xff, 0x9f, 0x60, 0x3f, 0x7c,
-	0xe7, 0xf1, 0x7a, 0x6b, 0x80, 0xcd, 0xd6, 0x00, 0xbf, 0xb6, 0x06, 0xf8, 0xb0, 0x33, 0xb4, 0xcd,
-	0xce, 0xd0, 0xbe, 0xef, 0x0c, 0xed, 0xc5, 0x9d, 0x20, 0x14, 0xcb, 0xc2, 0xc3, 0x3e, 0x8f, 0x8f,
-	0x69, 0x6f, 0x0e, 0x79, 0x5e, 0x57, 0x3e, 0x93, 0x87, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xeb,
-	0x4f, 0x82, 0x6d, 0x77, 0x03, 0x00, 0x00,
+	// 653 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
+	0x18, 0xad, 0xc7, 0x36, 0x21, 0x57, 0x30, 0x30, 0x45, 0x2a, 0xd9, 0x94, 0x55, 0x11, 0xb0, 0x52,
+	0x86, 0x4d, 0x0b, 0x43, 0xdc, 0x90, 0xaa, 0xf1, 0xe3, 0xc6, 0x28, 0x12, 0x12, 0x5c, 0x2a, 0xa7,
+	0x35, 0x59, 0x44, 0x1b, 0x67, 0xb1, 0x53, 0xd8, 0x85, 0x03, 0x7f, 0x01, 0x12, 0x1c, 0x38, 0xf3,
+	0x3f, 0x20, 0xc4, 0x85, 0x03, 0xa7, 0x1d, 0x27, 0x71, 0xe1, 0x04, 0x68, 0xe3, 0x0f, 0x41, 0x71,
+	0xec, 0xae, 0x19, 0x0d, 0xed, 0xa4, 0x9d, 0x12, 0x39, 0xef, 0x7b, 0xdf, 0x7b, 0x2f, 0x9f, 0x3f,
+	0x78, 0xb1, 0x47, 0x07, 0x34, 0x60, 0x92, 0x24, 0x4f, 0xd2, 0xe5, 0x2f, 0x03, 0xe9, 0xf7, 0x19,
+	0x19, 0xd4, 0xc9, 0x56, 0xcc, 0xa2, 0x6d, 0x1c, 0x46, 0x5c, 0x72, 0x54, 0xd6, 0x28, 0x9c, 0x3c,
+	0xb1, 0x41, 0xe1, 0x41, 0xdd, 0xb2, 0x3d, 0xce, 0xbd, 0x1e, 0x23, 0x0a, 0xe7, 0xc6, 0xcf, 0x49,
+	0x37, 0x8e, 0xa8, 0xf4, 0x79, 0x90, 0x56, 0x5a, 0x2b, 0xb9, 0xfc, 0x43, 0x96, 0x14, 0x58, 0xf2,
+	0xb8, 0xc7, 0xd5, 0x2b, 0x49, 0xde, 0xf4, 0xe9, 0x92, 0xa6, 0xa7, 0xa1, 0x4f, 0x68, 0x10, 0x70,
+	0xa9, 0xb8, 0x85, 0xfe, 0x5a, 0xeb, 0x70, 0xd1, 0xe7, 0x82, 0xb8, 0x54, 0xb0, 0x54, 0x2f, 0x19,
+	0xd4, 0x5d, 0x26, 0x69, 0x9d, 0x84, 0xd4, 0xf3, 0x83, 0x11, 0x21, 0x4e, 0x13, 0x96, 0x1e, 0x25,
+	0x88, 0x75, 0xdd, 0xb6, 0xc5, 0xb6, 0x62, 0x26, 0x24, 0xaa, 0xc1, 0xb3, 0x2c, 0xe4, 0x9d, 0xcd,
+	0xb6, 0x90, 0x34, 0x92, 0x6d, 0xb7, 0xc7, 0x3b, 0x2f, 0xca, 0xa0, 0x02, 0xaa, 0xb3, 0xad, 0x05,
+	0xf5, 0xe1, 0x71, 0x72, 0xde, 0x4c, 0x8e, 0x9d, 0xd7, 0xf0, 0xfc, 0x21, 0x0e, 0x11, 0xf2, 0x40,
+	0x30, 0xc4, 0xe0, 0x52, 0x27, 0xee, 0xc7, 0x3d, 0x2a, 0xfd, 0x01, 0x6b, 0x1b, 0x67, 0x6d, 0x93,
+	0x45, 0x79, 0xa6, 0x02, 0xaa, 0xc5, 0xc6, 0x05, 0x9c, 0xba, 0xc1, 0x26, 0x2c, 0xbc, 0xae, 0x01,
+	0xcd, 0x93, 0x3b, 0x3f, 0x97, 0x0b, 0x1f, 0x7e, 0x2d, 0x83, 0x96, 0x75, 0x40, 0x64, 0xda, 0x18,
+	0x94, 0xc3, 0xe0, 0x62, 0xa6, 0xff, 0x03, 0x5f, 0x48, 0x1e, 0x6d, 0x1b, 0x2b, 0xf7, 0x20, 0x3c,
+	0xb0, 0xad, 0x3c, 0x14, 0x1b, 0x97, 0x71, 0x9a, 0x11, 0x4e, 0x32, 0xc2, 0xe9, 0x3f, 0xd5, 0x19,
+	0xe1, 0x0d, 0xea, 0x99, 0x18, 0x5a, 0x23, 0x95, 0xce, 0x37, 0x00, 0x97, 0xc6, 0xf7, 0xd1, 0x76,
+	0x9f, 0xc2, 0x33, 0x43, 0x8f, 0x21, 0x8b, 0x7c, 0xde, 0x15, 0x65, 0x50, 0x39, 0x51, 0x2d, 0x36,
+	0xaa, 0x38, 0x6f, 0x52, 0xb0, 0x21, 0xdb, 0x50, 0x05, 0xcd, 0xd9, 0xc4, 0x71, 0x6b, 0xa1, 0x9b,
+	0x39, 0x15, 0xe8, 0x7e, 0xc6, 0x43, 0x9a, 0xdb, 0xca, 0x44, 0x0f, 0xa9, 0xae, 0x8c, 0x89, 0x2e,
+	0xb4, 0x94, 0x87, 0xbb, 0xc9, 0x3f, 0x34, 0xbd, 0xc5, 0x71, 0x47, 0xf5, 0x15, 0xc0, 0xc5, 0xb1,
+	0x6d, 0x74, 0x52, 0x4f, 0x60, 0x3a, 0x44, 0xc3, 0x99, 0x30, 0x41, 0xad, 0xe4, 0x07, 0x95, 0xa1,
+	0xd2, 0x39, 0x9d, 0x66, 0x19, 0xfe, 0xe3, 0x8b, 0xa9, 0x04, 0x91, 0xd2, 0xbf, 0x41, 0x23, 0xda,
+	0x37, 0xf1, 0x38, 0x0f, 0xe1, 0xb9, 0xcc, 0xa9, 0x76, 0x73, 0x1b, 0xce, 0x87, 0xea, 0x44, 0x27,
+	0x56, 0xc9, 0x37, 0xa1, 0x2b, 0x35, 0xbe, 0xf1, 0x79, 0x0e, 0xce, 0x29, 0x46, 0xf4, 0x1e, 0xc0,
+	0xe2, 0x08, 0x37, 0x5a, 0xcd, 0xe7, 0xf8, 0x57, 0x98, 0x75, 0x6d, 0x4a, 0x74, 0x2a, 0xd8, 0xa9,
+	0xbe, 0xf9, 0xfe, 0xe7, 0xdd, 0x8c, 0x83, 0x2a, 0x24, 0x77, 0x0d, 0xa5, 0x02, 0xd1, 0x47, 0x00,
+	0x4f, 0x65, 0x66, 0x1e, 0xe1, 0x09, 0xad, 0x0e, 0x2d, 0x12, 0x8b, 0x4c, 0x8d, 0xd7, 0xe2, 0xae,
+	0x2b, 0x71, 0x35, 0x54, 0x25, 0xff, 0xdf, 0xc1, 0xc3, 0xd9, 0x41, 0x5f, 0x00, 0x2c, 0x8d, 0xbb,
+	0x98, 0x68, 0x6d, 0xca, 0xde, 0xd9, 0x85, 0x61, 0xdd, 0x3a, 0x6a, 0x99, 0x56, 0xde, 0x50, 0xca,
+	0x57, 0x51, 0x8d, 0x4c, 0xdc, 0xee, 0xed, 0x4d, 0x2d, 0xf1, 0x13, 0xd0, 0x33, 0x95, 0xbd, 0x29,
+	0xe8, 0xe6, 0x04, 0x0d, 0x63, 0xef, 0xaf, 0xb5, 0x76, 0xc4, 0x2a, 0x2d, 0xbc, 0xae, 0x84, 0x5f,
+	0x45, 0x57, 0xf2, 0x85, 0x1f, 0xba, 0xae, 0xcd, 0x3b, 0x3b, 0x7b, 0x36, 0xd8, 0xdd, 0xb3, 0xc1,
+	0xef, 0x3d, 0x1b, 0xbc, 0xdd, 0xb7, 0x0b, 0xbb, 0xfb, 0x76, 0xe1, 0xc7, 0xbe, 0x5d, 0x78, 0x76,
+	0xc9, 0xf3, 0xe5, 0x66, 0xec, 0xe2, 0x0e, 0xef, 0x67, 0xe9, 0x5e, 0x8d, 0x12, 0xba, 0xf3, 0x6a,
+	0xdb, 0xdf, 0xf8, 0x3b, 0x00, 0x17, 0xc6, 0xb2, 0x46, 0x6a, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -259,6 +474,8 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	QueryDowntime(ctx context.Context, in *QueryDowntimeRequest, opts ...grpc.CallOption) (*QueryDowntimeResponse, error)
+	QueryDowntimeHistory(ctx context.Context, in *QueryDowntimeHistoryRequest, opts ...grpc.CallOption) (*QueryDowntimeHistoryResponse, error)
+	QueryEpochDowntimes(ctx context.Context, in *QueryEpochDowntimesRequest, opts ...grpc.CallOption) (*QueryEpochDowntimesResponse, error)
 }
 
 type queryClient struct {
@@ -287,10 +504,30 @@ func (c *queryClient) QueryDowntime(ctx context.Context, in *QueryDowntimeReques
 	return out, nil
 }
 
+func (c *queryClient) QueryDowntimeHistory(ctx context.Context, in *QueryDowntimeHistoryRequest, opts ...grpc.CallOption) (*QueryDowntimeHistoryResponse, error) {
+	out := new(QueryDowntimeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.downtime.v1.Query/QueryDowntimeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryEpochDowntimes(ctx context.Context, in *QueryEpochDowntimesRequest, opts ...grpc.CallOption) (*QueryEpochDowntimesResponse, error) {
+	out := new(QueryEpochDowntimesResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.downtime.v1.Query/QueryEpochDowntimes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	QueryDowntime(context.Context, *QueryDowntimeRequest) (*QueryDowntimeResponse, error)
+	QueryDowntimeHistory(context.Context, *QueryDowntimeHistoryRequest) (*QueryDowntimeHistoryResponse, error)
+	QueryEpochDowntimes(context.Context, *QueryEpochDowntimesRequest) (*QueryEpochDowntimesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -303,6 +540,12 @@ func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryPara
 func (*UnimplementedQueryServer) QueryDowntime(ctx context.Context, req *QueryDowntimeRequest) (*QueryDowntimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryDowntime not implemented")
 }
+func (*UnimplementedQueryServer) QueryDowntimeHistory(ctx context.Context, req *QueryDowntimeHistoryRequest) (*QueryDowntimeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDowntimeHistory not implemented")
+}
+func (*UnimplementedQueryServer) QueryEpochDowntimes(ctx context.Context, req *QueryEpochDowntimesRequest) (*QueryEpochDowntimesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryEpochDowntimes not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -344,6 +587,42 @@ func _Query_QueryDowntime_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryDowntimeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDowntimeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDowntimeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.downtime.v1.Query/QueryDowntimeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDowntimeHistory(ctx, req.(*QueryDowntimeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryEpochDowntimes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochDowntimesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryEpochDowntimes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.downtime.v1.Query/QueryEpochDowntimes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryEpochDowntimes(ctx, req.(*QueryEpochDowntimesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.downtime.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -356,6 +635,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryDowntime",
 			Handler:    _Query_QueryDowntime_Handler,
 		},
+		{
+			MethodName: "QueryDowntimeHistory",
+			Handler:    _Query_QueryDowntimeHistory_Handler,
+		},
+		{
+			MethodName: "QueryEpochDowntimes",
+			Handler:    _Query_QueryEpochDowntimes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/downtime/v1/query.proto",
@@ -420,7 +707,7 @@ func (m *QueryDowntimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDowntimeHistoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -430,20 +717,32 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDowntimeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDowntimeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDowntimeHistoryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -453,19 +752,19 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDowntimeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDowntimeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -473,63 +772,283 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.DowntimePeriods) > 0 {
+		for iNdEx := len(m.DowntimePeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DowntimePeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryEpochDowntimesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryDowntimeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryEpochDowntimesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochDowntimesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EpochStartBlock != 0 {
-		n += 1 + sovQuery(uint64(m.EpochStartBlock))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDowntimeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEpochDowntimesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CumulativeDowntimeDuration)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryEpochDowntimesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEpochDowntimesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EpochDowntimes) > 0 {
+		for iNdEx := len(m.EpochDowntimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochDowntimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryDowntimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochStartBlock != 0 {
+		n += 1 + sovQuery(uint64(m.EpochStartBlock))
+	}
+	return n
+}
+
+func (m *QueryDowntimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CumulativeDowntimeDuration)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDowntimeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDowntimeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DowntimePeriods) > 0 {
+		for _, e := range m.DowntimePeriods {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochDowntimesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochDowntimesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EpochDowntimes) > 0 {
+		for _, e := range m.EpochDowntimes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
@@ -692,6 +1211,418 @@ func (m *QueryDowntimeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDowntimeHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDowntimeHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDowntimeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDowntimeHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDowntimeHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDowntimeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimePeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DowntimePeriods = append(m.DowntimePeriods, DowntimePeriod{})
+			if err := m.DowntimePeriods[len(m.DowntimePeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochDowntimesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochDowntimesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochDowntimesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochDowntimesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochDowntimesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochDowntimesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDowntimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochDowntimes = append(m.EpochDowntimes, EpochDowntime{})
+			if err := m.EpochDowntimes[len(m.EpochDowntimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
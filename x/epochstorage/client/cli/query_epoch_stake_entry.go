@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	chainIDFlagName      = "chain-id"
+	startEpochFlagName   = "start-epoch"
+	endEpochFlagName     = "end-epoch"
+	geolocationFlagName  = "geolocation"
+	apiInterfaceFlagName = "api-interface"
+)
+
+func CmdProviderStakeHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-stake-history [provider]",
+		Short: "shows a provider's stake entries over a range of epochs",
+		Long: `shows a provider's stake, delegation total, geolocation and endpoints for every stored epoch.
+Can be more specific using the optional --chain-id, --start-epoch and --end-epoch flags`,
+		Example: `lavad q epochstorage provider-stake-history lava@1xxx --chain-id ETH1 --start-epoch 1000 --end-epoch 2000`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			chainID, err := cmd.Flags().GetString(chainIDFlagName)
+			if err != nil {
+				return err
+			}
+			startEpoch, err := cmd.Flags().GetUint64(startEpochFlagName)
+			if err != nil {
+				return err
+			}
+			endEpoch, err := cmd.Flags().GetUint64(endEpochFlagName)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProviderStakeHistory(cmd.Context(), &types.QueryProviderStakeHistoryRequest{
+				Provider:   args[0],
+				ChainId:    chainID,
+				StartEpoch: startEpoch,
+				EndEpoch:   endEpoch,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(chainIDFlagName, "", "output stake entries of a specific chain")
+	cmd.Flags().Uint64(startEpochFlagName, 0, "first epoch (inclusive)")
+	cmd.Flags().Uint64(endEpochFlagName, 0, "last epoch (inclusive), 0 for no limit")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdEpochStakeEntries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-stake-entries [chain-id] [epoch]",
+		Short: "shows the stake entries of a chain at a past epoch",
+		Long: `shows the stake entries of a chain at a past epoch.
+Can be more specific using the optional --geolocation and --api-interface flags`,
+		Example: `lavad q epochstorage epoch-stake-entries ETH1 1000 --geolocation 2 --api-interface jsonrpc`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			epoch, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			geolocation, err := cmd.Flags().GetInt32(geolocationFlagName)
+			if err != nil {
+				return err
+			}
+			apiInterface, err := cmd.Flags().GetString(apiInterfaceFlagName)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EpochStakeEntries(cmd.Context(), &types.QueryEpochStakeEntriesRequest{
+				ChainId:      args[0],
+				Epoch:        epoch,
+				Geolocation:  geolocation,
+				ApiInterface: apiInterface,
+				Pagination:   pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int32(geolocationFlagName, 0, "output only stake entries in the geolocation (0 for all)")
+	cmd.Flags().String(apiInterfaceFlagName, "", "output only stake entries that support the api interface")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/lavanet/lava/utils/lavaslices"
+	"github.com/lavanet/lava/x/epochstorage/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) EpochStakeEntries(c context.Context, req *types.QueryEpochStakeEntriesRequest) (*types.QueryEpochStakeEntriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing chain id")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stakeStorage, found := k.GetStakeStorageEpoch(ctx, req.Epoch, req.ChainId)
+	if !found {
+		return &types.QueryEpochStakeEntriesResponse{Pagination: &query.PageResponse{}}, nil
+	}
+
+	var entries []types.StakeEntry
+	for _, entry := range stakeStorage.StakeEntries {
+		if req.Geolocation != 0 && entry.Geolocation&req.Geolocation == 0 {
+			continue
+		}
+		if req.ApiInterface != "" && !hasApiInterface(entry, req.ApiInterface) {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	entries, pageRes, err := paginateStakes(entries, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryEpochStakeEntriesResponse{StakeEntries: entries, Pagination: pageRes}, nil
+}
+
+func hasApiInterface(entry types.StakeEntry, apiInterface string) bool {
+	for _, endpoint := range entry.Endpoints {
+		if lavaslices.Contains(endpoint.ApiInterfaces, apiInterface) {
+			return true
+		}
+	}
+	return false
+}
+
+// paginateStakes applies a page request on stakes that were read from the stake storages,
+// similarly to query.Paginate. The pagination key is the position of the first stake of
+// the next page.
+func paginateStakes[T any](stakes []T, pageReq *query.PageRequest) ([]T, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	offset, limit := pageReq.Offset, pageReq.Limit
+	if offset > 0 && pageReq.Key != nil {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	start := offset
+	if pageReq.Key != nil {
+		if len(pageReq.Key) != 8 {
+			return nil, nil, fmt.Errorf("invalid request, invalid pagination key")
+		}
+		start = sdk.BigEndianToUint64(pageReq.Key)
+	}
+	if start > uint64(len(stakes)) {
+		start = uint64(len(stakes))
+	}
+
+	end := start + limit
+	if end > uint64(len(stakes)) {
+		end = uint64(len(stakes))
+	}
+
+	pageRes := &query.PageResponse{}
+	if end < uint64(len(stakes)) {
+		pageRes.NextKey = sdk.Uint64ToBigEndian(end)
+	}
+	if pageReq.Key == nil && pageReq.CountTotal {
+		pageRes.Total = uint64(len(stakes))
+	}
+
+	return stakes[start:end], pageRes, nil
+}
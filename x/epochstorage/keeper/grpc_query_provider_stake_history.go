@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/epochstorage/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ProviderStakeHistory(c context.Context, req *types.QueryProviderStakeHistoryRequest) (*types.QueryProviderStakeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	provider, err := sdk.AccAddressFromBech32(req.Provider)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
+	}
+	if req.EndEpoch != 0 && req.EndEpoch < req.StartEpoch {
+		return nil, status.Error(codes.InvalidArgument, "end epoch is lower than start epoch")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// the provider's history is read from the epoch stake storages that are still saved
+	// (see EpochsToSave), so there is no need to keep a separate copy of its stake entries
+	chainIDs := []string{req.ChainId}
+	if req.ChainId == "" {
+		chainIDs = k.specKeeper.GetAllChainIDs(ctx)
+		sort.Strings(chainIDs)
+	}
+
+	endEpoch := k.GetEpochStart(ctx)
+	if req.EndEpoch != 0 && req.EndEpoch < endEpoch {
+		endEpoch = req.EndEpoch
+	}
+	// go over the saved epochs in the requested range, the stakes are sorted by epoch and chain ID
+	epoch := k.GetEarliestEpochStart(ctx)
+	if req.StartEpoch > endEpoch {
+		epoch = endEpoch + 1
+	} else if req.StartEpoch > epoch {
+		epoch, _, err = k.GetEpochStartForBlock(ctx, req.StartEpoch)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if epoch < req.StartEpoch {
+			epoch, err = k.GetNextEpoch(ctx, epoch)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+		}
+	}
+
+	var stakes []types.ProviderEpochStake
+	for epoch <= endEpoch {
+		for _, chainID := range chainIDs {
+			entry, err := k.GetStakeEntryForProviderEpoch(ctx, chainID, provider, epoch)
+			if err != nil {
+				continue
+			}
+			stakes = append(stakes, types.ProviderEpochStake{
+				Epoch:         epoch,
+				ChainId:       chainID,
+				Stake:         entry.Stake,
+				DelegateTotal: entry.DelegateTotal,
+				Geolocation:   entry.Geolocation,
+				Endpoints:     entry.Endpoints,
+			})
+		}
+		epoch, err = k.GetNextEpoch(ctx, epoch)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	stakes, pageRes, err := paginateStakes(stakes, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryProviderStakeHistoryResponse{Stakes: stakes, Pagination: pageRes}, nil
+}
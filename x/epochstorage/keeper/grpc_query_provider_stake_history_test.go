@@ -0,0 +1,209 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/lavanet/lava/testutil/common"
+	keepertest "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/lavanet/lava/x/epochstorage"
+	"github.com/lavanet/lava/x/epochstorage/keeper"
+	"github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createEpochStakeStorages(k *keeper.Keeper, ctx sdk.Context, providers []string, chainIDs []string, epochs []uint64) {
+	for _, epoch := range epochs {
+		for _, chainID := range chainIDs {
+			stakeStorage := types.StakeStorage{Index: k.StakeStorageKey(epoch, chainID)}
+			for i, provider := range providers {
+				stakeStorage.StakeEntries = append(stakeStorage.StakeEntries, types.StakeEntry{
+					Address:       provider,
+					Chain:         chainID,
+					Stake:         sdk.NewCoin("ulava", sdk.NewIntFromUint64(epoch+uint64(i))),
+					DelegateTotal: sdk.NewCoin("ulava", sdk.NewIntFromUint64(epoch)),
+					Geolocation:   int32(1 << i),
+					Endpoints:     []types.Endpoint{{IPPORT: "1.2.3.4:5", ApiInterfaces: []string{"jsonrpc"}, Geolocation: int32(1 << i)}},
+				})
+			}
+			stakeStorage.StakeEntries[0].Endpoints[0].ApiInterfaces = []string{"rest"}
+			k.SetStakeStorage(ctx, stakeStorage)
+		}
+	}
+}
+
+// newStakeHistoryTester creates a tester with the ETH1 and LAV1 specs and a few saved epochs, the stake
+// history is read from the epoch stake storages of the specs' chains in the saved epochs
+func newStakeHistoryTester(t *testing.T) (*tester, []uint64) {
+	ts := &tester{Tester: *common.NewTester(t)}
+	for _, chainID := range []string{"ETH1", "LAV1"} {
+		spec := common.CreateMockSpec()
+		spec.Index = chainID
+		spec.Name = chainID
+		ts.AddSpec(chainID, spec)
+	}
+	epochs := []uint64{}
+	for i := 0; i < 3; i++ {
+		ts.AdvanceEpoch()
+		epochs = append(epochs, ts.EpochStart())
+	}
+	return ts, epochs
+}
+
+func TestProviderStakeHistoryStakeStorages(t *testing.T) {
+	ts, epochs := newStakeHistoryTester(t)
+	k := &ts.Keepers.Epochstorage
+	providers := []string{sample.AccAddress(), sample.AccAddress()}
+	createEpochStakeStorages(k, ts.Ctx, providers, []string{"ETH1", "LAV1"}, epochs[:2])
+
+	// current and unstake stake storages are not part of the history
+	k.SetStakeStorageCurrent(ts.Ctx, "ETH1", types.StakeStorage{StakeEntries: []types.StakeEntry{{Address: providers[0], Chain: "ETH1"}}})
+	k.SetStakeStorageUnstake(ts.Ctx, types.StakeStorage{StakeEntries: []types.StakeEntry{{Address: providers[0], Chain: "ETH1"}}})
+	res, err := k.ProviderStakeHistory(ts.Ctx, &types.QueryProviderStakeHistoryRequest{Provider: providers[0]})
+	require.NoError(t, err)
+	require.Len(t, res.Stakes, 4)
+
+	// the history is sorted by epoch and chain ID
+	for i, epoch := range []uint64{epochs[0], epochs[0], epochs[1], epochs[1]} {
+		require.Equal(t, epoch, res.Stakes[i].Epoch)
+	}
+	require.Equal(t, "ETH1", res.Stakes[0].ChainId)
+	require.Equal(t, "LAV1", res.Stakes[1].ChainId)
+
+	// removing an epoch stake storage removes it from the history
+	k.RemoveStakeStorageByBlockAndChain(ts.Ctx, epochs[0], "ETH1")
+	res, err = k.ProviderStakeHistory(ts.Ctx, &types.QueryProviderStakeHistoryRequest{Provider: providers[1]})
+	require.NoError(t, err)
+	require.Len(t, res.Stakes, 3)
+	require.Equal(t, "LAV1", res.Stakes[0].ChainId)
+	require.Equal(t, epochs[0], res.Stakes[0].Epoch)
+}
+
+func TestProviderStakeHistoryQuery(t *testing.T) {
+	ts, epochs := newStakeHistoryTester(t)
+	k := &ts.Keepers.Epochstorage
+	providers := []string{sample.AccAddress(), sample.AccAddress()}
+	createEpochStakeStorages(k, ts.Ctx, providers, []string{"ETH1", "LAV1"}, epochs)
+
+	for _, tc := range []struct {
+		name    string
+		req     *types.QueryProviderStakeHistoryRequest
+		epochs  []uint64
+		chainID string
+		valid   bool
+	}{
+		{"all", &types.QueryProviderStakeHistoryRequest{Provider: providers[1]}, []uint64{epochs[0], epochs[0], epochs[1], epochs[1], epochs[2], epochs[2]}, "", true},
+		{"chain", &types.QueryProviderStakeHistoryRequest{Provider: providers[1], ChainId: "LAV1"}, epochs, "LAV1", true},
+		{"range", &types.QueryProviderStakeHistoryRequest{Provider: providers[1], ChainId: "ETH1", StartEpoch: epochs[0] + 1, EndEpoch: epochs[2]}, epochs[1:], "ETH1", true},
+		{"start only", &types.QueryProviderStakeHistoryRequest{Provider: providers[1], ChainId: "ETH1", StartEpoch: epochs[2]}, epochs[2:], "ETH1", true},
+		{"future start", &types.QueryProviderStakeHistoryRequest{Provider: providers[1], StartEpoch: epochs[2] + 1}, nil, "", true},
+		{"unknown chain", &types.QueryProviderStakeHistoryRequest{Provider: providers[1], ChainId: "COS3"}, nil, "", true},
+		{"unstaked provider", &types.QueryProviderStakeHistoryRequest{Provider: sample.AccAddress()}, nil, "", true},
+		{"invalid provider", &types.QueryProviderStakeHistoryRequest{Provider: "dummy"}, nil, "", false},
+		{"invalid range", &types.QueryProviderStakeHistoryRequest{Provider: providers[1], StartEpoch: epochs[1], EndEpoch: epochs[0]}, nil, "", false},
+		{"nil request", nil, nil, "", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := k.ProviderStakeHistory(ts.Ctx, tc.req)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, res.Stakes, len(tc.epochs))
+			for i, stake := range res.Stakes {
+				require.Equal(t, tc.epochs[i], stake.Epoch)
+				if tc.chainID != "" {
+					require.Equal(t, tc.chainID, stake.ChainId)
+				}
+				require.Equal(t, int64(stake.Epoch+1), stake.Stake.Amount.Int64())
+				require.Equal(t, int64(stake.Epoch), stake.DelegateTotal.Amount.Int64())
+				require.Equal(t, int32(2), stake.Geolocation)
+				require.Len(t, stake.Endpoints, 1)
+			}
+		})
+	}
+
+	// pagination
+	res, err := k.ProviderStakeHistory(ts.Ctx, &types.QueryProviderStakeHistoryRequest{Provider: providers[0], ChainId: "ETH1", Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Stakes, 2)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	res, err = k.ProviderStakeHistory(ts.Ctx, &types.QueryProviderStakeHistoryRequest{Provider: providers[0], ChainId: "ETH1", Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}})
+	require.NoError(t, err)
+	require.Len(t, res.Stakes, 1)
+	require.Equal(t, epochs[2], res.Stakes[0].Epoch)
+}
+
+// TestGenesisEpochStakeEntries checks that stake storages imported from genesis are part of the
+// history only if they are epoch stake storages
+func TestGenesisEpochStakeEntries(t *testing.T) {
+	ts, epochs := newStakeHistoryTester(t)
+	k := &ts.Keepers.Epochstorage
+	provider := sample.AccAddress()
+	genesisState := epochstorage.ExportGenesis(ts.Ctx, *k)
+	genesisState.StakeStorageList = []types.StakeStorage{
+		{Index: "ETH1", StakeEntries: []types.StakeEntry{{Address: provider, Chain: "ETH1"}}},
+		{Index: k.StakeStorageKey(epochs[1], "ETH1"), StakeEntries: []types.StakeEntry{{Address: provider, Chain: "ETH1"}}},
+		{Index: types.StakeStorageKeyUnstakeConst, StakeEntries: []types.StakeEntry{{Address: provider, Chain: "ETH1"}}},
+	}
+	epochstorage.InitGenesis(ts.Ctx, *k, *genesisState)
+
+	// only the epoch stake storage is part of the provider's history
+	res, err := k.ProviderStakeHistory(ts.Ctx, &types.QueryProviderStakeHistoryRequest{Provider: provider})
+	require.NoError(t, err)
+	require.Len(t, res.Stakes, 1)
+	require.Equal(t, epochs[1], res.Stakes[0].Epoch)
+}
+
+func TestEpochStakeEntriesQuery(t *testing.T) {
+	k, ctx := keepertest.EpochstorageKeeper(t)
+	providers := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+	createEpochStakeStorages(k, ctx, providers, []string{"ETH1", "LAV1"}, []uint64{10, 20})
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryEpochStakeEntriesRequest
+		providers []string
+		valid     bool
+	}{
+		{"all", &types.QueryEpochStakeEntriesRequest{ChainId: "ETH1", Epoch: 10}, providers, true},
+		{"geolocation", &types.QueryEpochStakeEntriesRequest{ChainId: "ETH1", Epoch: 10, Geolocation: 6}, providers[1:], true},
+		{"api interface", &types.QueryEpochStakeEntriesRequest{ChainId: "LAV1", Epoch: 20, ApiInterface: "rest"}, providers[:1], true},
+		{"geolocation and api interface", &types.QueryEpochStakeEntriesRequest{ChainId: "LAV1", Epoch: 20, Geolocation: 3, ApiInterface: "jsonrpc"}, providers[1:2], true},
+		{"unknown epoch", &types.QueryEpochStakeEntriesRequest{ChainId: "ETH1", Epoch: 15}, nil, true},
+		{"missing chain", &types.QueryEpochStakeEntriesRequest{Epoch: 10}, nil, false},
+		{"nil request", nil, nil, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := k.EpochStakeEntries(ctx, tc.req)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, res.StakeEntries, len(tc.providers))
+			for _, entry := range res.StakeEntries {
+				require.Contains(t, tc.providers, entry.Address)
+				require.Equal(t, tc.req.ChainId, entry.Chain)
+			}
+		})
+	}
+
+	// pagination
+	var entries []types.StakeEntry
+	var nextKey []byte
+	for {
+		res, err := k.EpochStakeEntries(ctx, &types.QueryEpochStakeEntriesRequest{ChainId: "ETH1", Epoch: 20, Pagination: &query.PageRequest{Key: nextKey, Limit: 2}})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(res.StakeEntries), 2)
+		entries = append(entries, res.StakeEntries...)
+		nextKey = res.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	require.Len(t, entries, len(providers))
+}
@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -562,6 +562,347 @@ func (m *QueryAllFixatedParamsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryProviderStakeHistoryRequest struct {
+	Provider   string             `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainId    string             `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	StartEpoch uint64             `protobuf:"varint,3,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch   uint64             `protobuf:"varint,4,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProviderStakeHistoryRequest) Reset()         { *m = QueryProviderStakeHistoryRequest{} }
+func (m *QueryProviderStakeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderStakeHistoryRequest) ProtoMessage()    {}
+func (*QueryProviderStakeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60112e15fc266719, []int{12}
+}
+func (m *QueryProviderStakeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderStakeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderStakeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderStakeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderStakeHistoryRequest.Merge(m, src)
+}
+func (m *QueryProviderStakeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderStakeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderStakeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderStakeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryProviderStakeHistoryRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *QueryProviderStakeHistoryRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QueryProviderStakeHistoryRequest) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *QueryProviderStakeHistoryRequest) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
+	}
+	return 0
+}
+
+func (m *QueryProviderStakeHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryProviderStakeHistoryResponse struct {
+	Stakes     []ProviderEpochStake `protobuf:"bytes,1,rep,name=stakes,proto3" json:"stakes"`
+	Pagination *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProviderStakeHistoryResponse) Reset()         { *m = QueryProviderStakeHistoryResponse{} }
+func (m *QueryProviderStakeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderStakeHistoryResponse) ProtoMessage()    {}
+func (*QueryProviderStakeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60112e15fc266719, []int{13}
+}
+func (m *QueryProviderStakeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderStakeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderStakeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderStakeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderStakeHistoryResponse.Merge(m, src)
+}
+func (m *QueryProviderStakeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderStakeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderStakeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderStakeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryProviderStakeHistoryResponse) GetStakes() []ProviderEpochStake {
+	if m != nil {
+		return m.Stakes
+	}
+	return nil
+}
+
+func (m *QueryProviderStakeHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// ProviderEpochStake is the state of a provider's stake entry on a chain at an epoch
+type ProviderEpochStake struct {
+	Epoch         uint64     `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ChainId       string     `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Stake         types.Coin `protobuf:"bytes,3,opt,name=stake,proto3" json:"stake"`
+	DelegateTotal types.Coin `protobuf:"bytes,4,opt,name=delegate_total,json=delegateTotal,proto3" json:"delegate_total"`
+	Geolocation   int32      `protobuf:"varint,5,opt,name=geolocation,proto3" json:"geolocation,omitempty"`
+	Endpoints     []Endpoint `protobuf:"bytes,6,rep,name=endpoints,proto3" json:"endpoints"`
+}
+
+func (m *ProviderEpochStake) Reset()         { *m = ProviderEpochStake{} }
+func (m *ProviderEpochStake) String() string { return proto.CompactTextString(m) }
+func (*ProviderEpochStake) ProtoMessage()    {}
+func (*ProviderEpochStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60112e15fc266719, []int{14}
+}
+func (m *ProviderEpochStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderEpochStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderEpochStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderEpochStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderEpochStake.Merge(m, src)
+}
+func (m *ProviderEpochStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderEpochStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderEpochStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderEpochStake proto.InternalMessageInfo
+
+func (m *ProviderEpochStake) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ProviderEpochStake) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ProviderEpochStake) GetStake() types.Coin {
+	if m != nil {
+		return m.Stake
+	}
+	return types.Coin{}
+}
+
+func (m *ProviderEpochStake) GetDelegateTotal() types.Coin {
+	if m != nil {
+		return m.DelegateTotal
+	}
+	return types.Coin{}
+}
+
+func (m *ProviderEpochStake) GetGeolocation() int32 {
+	if m != nil {
+		return m.Geolocation
+	}
+	return 0
+}
+
+func (m *ProviderEpochStake) GetEndpoints() []Endpoint {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
+}
+
+type QueryEpochStakeEntriesRequest struct {
+	ChainId      string             `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Epoch        uint64             `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Geolocation  int32              `protobuf:"varint,3,opt,name=geolocation,proto3" json:"geolocation,omitempty"`
+	ApiInterface string             `protobuf:"bytes,4,opt,name=api_interface,json=apiInterface,proto3" json:"api_interface,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEpochStakeEntriesRequest) Reset()         { *m = QueryEpochStakeEntriesRequest{} }
+func (m *QueryEpochStakeEntriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochStakeEntriesRequest) ProtoMessage()    {}
+func (*QueryEpochStakeEntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60112e15fc266719, []int{15}
+}
+func (m *QueryEpochStakeEntriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochStakeEntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochStakeEntriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochStakeEntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochStakeEntriesRequest.Merge(m, src)
+}
+func (m *QueryEpochStakeEntriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochStakeEntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochStakeEntriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochStakeEntriesRequest proto.InternalMessageInfo
+
+func (m *QueryEpochStakeEntriesRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QueryEpochStakeEntriesRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *QueryEpochStakeEntriesRequest) GetGeolocation() int32 {
+	if m != nil {
+		return m.Geolocation
+	}
+	return 0
+}
+
+func (m *QueryEpochStakeEntriesRequest) GetApiInterface() string {
+	if m != nil {
+		return m.ApiInterface
+	}
+	return ""
+}
+
+func (m *QueryEpochStakeEntriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryEpochStakeEntriesResponse struct {
+	StakeEntries []StakeEntry        `protobuf:"bytes,1,rep,name=stake_entries,json=stakeEntries,proto3" json:"stake_entries"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEpochStakeEntriesResponse) Reset()         { *m = QueryEpochStakeEntriesResponse{} }
+func (m *QueryEpochStakeEntriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochStakeEntriesResponse) ProtoMessage()    {}
+func (*QueryEpochStakeEntriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60112e15fc266719, []int{16}
+}
+func (m *QueryEpochStakeEntriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochStakeEntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochStakeEntriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochStakeEntriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochStakeEntriesResponse.Merge(m, src)
+}
+func (m *QueryEpochStakeEntriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochStakeEntriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochStakeEntriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochStakeEntriesResponse proto.InternalMessageInfo
+
+func (m *QueryEpochStakeEntriesResponse) GetStakeEntries() []StakeEntry {
+	if m != nil {
+		return m.StakeEntries
+	}
+	return nil
+}
+
+func (m *QueryEpochStakeEntriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "lavanet.lava.epochstorage.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.epochstorage.QueryParamsResponse")
@@ -575,6 +916,11 @@ func init() {
 	proto.RegisterType((*QueryGetFixatedParamsResponse)(nil), "lavanet.lava.epochstorage.QueryGetFixatedParamsResponse")
 	proto.RegisterType((*QueryAllFixatedParamsRequest)(nil), "lavanet.lava.epochstorage.QueryAllFixatedParamsRequest")
 	proto.RegisterType((*QueryAllFixatedParamsResponse)(nil), "lavanet.lava.epochstorage.QueryAllFixatedParamsResponse")
+	proto.RegisterType((*QueryProviderStakeHistoryRequest)(nil), "lavanet.lava.epochstorage.QueryProviderStakeHistoryRequest")
+	proto.RegisterType((*QueryProviderStakeHistoryResponse)(nil), "lavanet.lava.epochstorage.QueryProviderStakeHistoryResponse")
+	proto.RegisterType((*ProviderEpochStake)(nil), "lavanet.lava.epochstorage.ProviderEpochStake")
+	proto.RegisterType((*QueryEpochStakeEntriesRequest)(nil), "lavanet.lava.epochstorage.QueryEpochStakeEntriesRequest")
+	proto.RegisterType((*QueryEpochStakeEntriesResponse)(nil), "lavanet.lava.epochstorage.QueryEpochStakeEntriesResponse")
 }
 
 func init() {
@@ -582,51 +928,77 @@ func init() {
 }
 
 var fileDescriptor_60112e15fc266719 = []byte{
-	// 700 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x41, 0x4f, 0xd4, 0x40,
-	0x14, 0xc7, 0x77, 0x40, 0x88, 0x8e, 0x10, 0xcd, 0xc8, 0x41, 0x57, 0xa8, 0x52, 0x23, 0x02, 0xca,
-	0x0c, 0x0b, 0x46, 0xf1, 0x64, 0x20, 0x0a, 0x57, 0x58, 0x8c, 0x07, 0x2f, 0x64, 0x76, 0x19, 0x4a,
-	0x63, 0xe9, 0x94, 0xed, 0x2c, 0x81, 0x18, 0x2f, 0x7e, 0x02, 0xa3, 0x9f, 0xc4, 0xc4, 0x83, 0x9a,
-	0xc8, 0x99, 0x23, 0x89, 0x17, 0x4f, 0xc6, 0x80, 0x1f, 0xc4, 0x74, 0x66, 0xd6, 0xce, 0x84, 0x76,
-	0x5b, 0x84, 0xd3, 0x6e, 0xa7, 0xef, 0xfd, 0xdf, 0xef, 0x3f, 0xf3, 0xfa, 0x5a, 0x78, 0x37, 0xa0,
-	0x3b, 0x34, 0x64, 0x82, 0x24, 0xbf, 0x84, 0x45, 0xbc, 0xb9, 0x19, 0x0b, 0xde, 0xa2, 0x1e, 0x23,
-	0xdb, 0x6d, 0xd6, 0xda, 0xc3, 0x51, 0x8b, 0x0b, 0x8e, 0x6e, 0xe8, 0x30, 0x9c, 0xfc, 0x62, 0x33,
-	0xac, 0x3a, 0xec, 0x71, 0xee, 0x05, 0x8c, 0xd0, 0xc8, 0x27, 0x34, 0x0c, 0xb9, 0xa0, 0xc2, 0xe7,
-	0x61, 0xac, 0x12, 0xab, 0x93, 0x4d, 0x1e, 0x6f, 0xf1, 0x98, 0x34, 0x68, 0xac, 0x15, 0xc9, 0x4e,
-	0xad, 0xc1, 0x04, 0xad, 0x91, 0x88, 0x7a, 0x7e, 0x28, 0x83, 0x75, 0xec, 0x58, 0x3e, 0x4b, 0x44,
-	0x5b, 0x74, 0xab, 0xa3, 0x39, 0x95, 0x1f, 0x17, 0x0b, 0xfa, 0x9a, 0xad, 0xe9, 0xab, 0xe2, 0x70,
-	0x79, 0xb1, 0xb6, 0xce, 0x04, 0xf5, 0x83, 0x8e, 0x3a, 0xce, 0x0f, 0xdf, 0xf0, 0x77, 0xa9, 0x60,
-	0xeb, 0x6b, 0x16, 0x8d, 0x63, 0x3a, 0xec, 0x78, 0x6b, 0x72, 0xbf, 0xe3, 0x6a, 0xc8, 0xe3, 0x1e,
-	0x97, 0x7f, 0x49, 0xf2, 0x4f, 0xad, 0xba, 0x43, 0x10, 0xad, 0x24, 0xbb, 0xb1, 0x2c, 0xa5, 0xea,
-	0x6c, 0xbb, 0xcd, 0x62, 0xe1, 0xbe, 0x84, 0xd7, 0xac, 0xd5, 0x38, 0xe2, 0x61, 0xcc, 0xd0, 0x53,
-	0xd8, 0xaf, 0x4a, 0x5e, 0x07, 0xb7, 0xc1, 0xf8, 0xe5, 0x99, 0x51, 0x9c, 0x7b, 0x1c, 0x58, 0xa5,
-	0x2e, 0x5c, 0x38, 0xf8, 0x75, 0xab, 0x52, 0xd7, 0x69, 0xee, 0x2c, 0xbc, 0x29, 0x75, 0x97, 0x98,
-	0x58, 0x4d, 0x76, 0x68, 0x55, 0x05, 0xeb, 0xb2, 0x68, 0x08, 0xf6, 0xf9, 0xe1, 0x3a, 0xdb, 0x95,
-	0xf2, 0x97, 0xea, 0xea, 0xc2, 0xdd, 0x86, 0xc3, 0xd9, 0x49, 0x9a, 0x6a, 0x05, 0x0e, 0xc4, 0xc6,
-	0xba, 0x66, 0xbb, 0xd7, 0x85, 0xcd, 0x94, 0xd1, 0x84, 0x96, 0x84, 0xcb, 0x34, 0xe7, 0x7c, 0x10,
-	0x64, 0x71, 0x2e, 0x42, 0x98, 0x36, 0x8d, 0xae, 0x37, 0x86, 0xd5, 0xfe, 0xe3, 0x64, 0xff, 0xb1,
-	0xea, 0x59, 0x7d, 0x0a, 0x78, 0x39, 0xcd, 0xad, 0x1b, 0x99, 0xee, 0x37, 0xa0, 0xad, 0x9d, 0xa8,
-	0x93, 0x6b, 0xad, 0xf7, 0x8c, 0xd6, 0xd0, 0x92, 0xc5, 0xde, 0xa3, 0xf7, 0xaa, 0x88, 0x5d, 0xf1,
-	0x58, 0xf0, 0x23, 0xe9, 0x59, 0x3e, 0x4f, 0x08, 0x9e, 0xa9, 0xee, 0xed, 0xb4, 0x90, 0x71, 0x6a,
-	0xf6, 0xed, 0xd4, 0x9a, 0xb9, 0x5e, 0xe2, 0xd4, 0xcc, 0xf0, 0x8e, 0x35, 0x73, 0xcd, 0x7d, 0x98,
-	0x96, 0x5c, 0x54, 0x4f, 0x88, 0xd5, 0xd5, 0x39, 0xed, 0xd5, 0x86, 0x23, 0x39, 0x59, 0x9a, 0xf4,
-	0x05, 0x1c, 0xdc, 0x30, 0x6f, 0x68, 0xd4, 0xf1, 0x2e, 0xa8, 0x96, 0x90, 0x66, 0xb5, 0x45, 0xdc,
-	0x8d, 0xf4, 0xe8, 0x33, 0x61, 0xcf, 0xab, 0xc7, 0xf6, 0x81, 0xf6, 0x77, 0xb2, 0x50, 0xbe, 0xbf,
-	0xde, 0x33, 0xfb, 0x3b, 0xb7, 0x3e, 0x9b, 0xd9, 0xbf, 0x08, 0xfb, 0xa4, 0x01, 0xf4, 0x01, 0xc0,
-	0x7e, 0xad, 0x3e, 0xd5, 0x05, 0xee, 0xe4, 0x3c, 0xab, 0xe2, 0xb2, 0xe1, 0xaa, 0xbe, 0x3b, 0xf1,
-	0xee, 0xc7, 0x9f, 0x8f, 0x3d, 0x77, 0xd0, 0x28, 0x29, 0x7a, 0x15, 0xa0, 0xaf, 0x00, 0x0e, 0x98,
-	0x0f, 0x1d, 0x7a, 0x54, 0x54, 0x2b, 0x7b, 0xf8, 0x55, 0x1f, 0x9f, 0x3a, 0x4f, 0xc3, 0xce, 0x49,
-	0xd8, 0x19, 0x34, 0x4d, 0x4a, 0xbe, 0x8f, 0xc8, 0x1b, 0xd9, 0xf9, 0x6f, 0xd1, 0x67, 0x00, 0xaf,
-	0x98, 0x92, 0xf3, 0x41, 0x50, 0x8c, 0x9f, 0x3d, 0x13, 0x8b, 0xf1, 0x73, 0x66, 0x9c, 0x3b, 0x2d,
-	0xf1, 0x27, 0xd1, 0x78, 0x59, 0x7c, 0xf4, 0x09, 0xd8, 0xb3, 0xa3, 0xd4, 0x96, 0x67, 0xcc, 0xa8,
-	0x52, 0x5b, 0x9e, 0x35, 0xbc, 0x4a, 0x31, 0x5b, 0xef, 0x74, 0xf4, 0x1d, 0xc0, 0x41, 0xeb, 0xa9,
-	0x41, 0x65, 0x8a, 0x67, 0x4d, 0x86, 0xea, 0xdc, 0xe9, 0x13, 0x35, 0xf6, 0x13, 0x89, 0x3d, 0x8b,
-	0x6a, 0xa4, 0xec, 0xb7, 0xc5, 0xbf, 0x56, 0xf9, 0x02, 0xe0, 0x55, 0x4b, 0x34, 0xe9, 0x95, 0x32,
-	0x67, 0xfe, 0x7f, 0x16, 0xf2, 0x86, 0x95, 0x5b, 0x93, 0x16, 0xee, 0xa3, 0x89, 0xd2, 0x16, 0x16,
-	0x16, 0x0f, 0x8e, 0x1c, 0x70, 0x78, 0xe4, 0x80, 0xdf, 0x47, 0x0e, 0x78, 0x7f, 0xec, 0x54, 0x0e,
-	0x8f, 0x9d, 0xca, 0xcf, 0x63, 0xa7, 0xf2, 0xea, 0x81, 0xe7, 0x8b, 0xcd, 0x76, 0x03, 0x37, 0xf9,
-	0x96, 0x2d, 0xb7, 0x6b, 0x0b, 0x8a, 0xbd, 0x88, 0xc5, 0x8d, 0x7e, 0xf9, 0xc5, 0x34, 0xfb, 0x37,
-	0x00, 0x00, 0xff, 0xff, 0xab, 0x9f, 0x18, 0x9d, 0xab, 0x0a, 0x00, 0x00,
+	// 1110 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0xdc, 0xc4,
+	0x17, 0xcf, 0xe4, 0xd7, 0x37, 0xfb, 0x36, 0xfb, 0x05, 0x86, 0x1c, 0x12, 0x37, 0xd9, 0x26, 0x8e,
+	0x5a, 0xb6, 0x2d, 0xb5, 0x9b, 0x04, 0x48, 0x2b, 0x2a, 0x50, 0xfa, 0x23, 0x21, 0xe2, 0x92, 0x6e,
+	0x2b, 0x0e, 0x5c, 0x56, 0x93, 0xf5, 0x64, 0x63, 0xe1, 0x78, 0x9c, 0xf5, 0x24, 0x4a, 0x14, 0xe5,
+	0xc2, 0x5f, 0x80, 0xe0, 0x0f, 0x41, 0x48, 0x1c, 0x28, 0x08, 0xce, 0x3d, 0x56, 0xea, 0x01, 0x4e,
+	0x14, 0x25, 0x9c, 0xf9, 0x1b, 0x90, 0x67, 0xc6, 0x6b, 0x0f, 0x59, 0xaf, 0xbd, 0xe9, 0x9e, 0x76,
+	0x67, 0xe6, 0xfd, 0xf8, 0xbc, 0xcf, 0x7b, 0x33, 0xef, 0x19, 0xae, 0x79, 0xe4, 0x90, 0xf8, 0x94,
+	0xdb, 0xd1, 0xaf, 0x4d, 0x03, 0xd6, 0xdc, 0x0d, 0x39, 0x6b, 0x93, 0x16, 0xb5, 0xf7, 0x0f, 0x68,
+	0xfb, 0xd8, 0x0a, 0xda, 0x8c, 0x33, 0x3c, 0xa3, 0xc4, 0xac, 0xe8, 0xd7, 0x4a, 0x8b, 0x19, 0xb3,
+	0x2d, 0xc6, 0x5a, 0x1e, 0xb5, 0x49, 0xe0, 0xda, 0xc4, 0xf7, 0x19, 0x27, 0xdc, 0x65, 0x7e, 0x28,
+	0x15, 0x8d, 0x9b, 0x4d, 0x16, 0xee, 0xb1, 0xd0, 0xde, 0x26, 0xa1, 0xb2, 0x68, 0x1f, 0x2e, 0x6d,
+	0x53, 0x4e, 0x96, 0xec, 0x80, 0xb4, 0x5c, 0x5f, 0x08, 0x2b, 0xd9, 0xeb, 0xd9, 0x58, 0x02, 0xd2,
+	0x26, 0x7b, 0xb1, 0xcd, 0xdb, 0xd9, 0x72, 0x21, 0x27, 0x5f, 0xd1, 0x86, 0x5a, 0xe5, 0x8b, 0x8b,
+	0x45, 0xc3, 0xa1, 0x9c, 0xb8, 0x5e, 0x6c, 0xdd, 0xca, 0x16, 0xdf, 0x71, 0x8f, 0x08, 0xa7, 0x4e,
+	0x43, 0x43, 0x73, 0x2b, 0x0f, 0x0d, 0xf5, 0x79, 0xcc, 0xa3, 0x51, 0xeb, 0x81, 0xc5, 0x77, 0x02,
+	0xe6, 0xfa, 0x5c, 0x49, 0x56, 0xd3, 0xc4, 0xc5, 0x94, 0x35, 0x99, 0x1b, 0x93, 0x35, 0xd5, 0x62,
+	0x2d, 0x26, 0xfe, 0xda, 0xd1, 0x3f, 0xb9, 0x6b, 0x4e, 0x01, 0x7e, 0x12, 0x91, 0xbc, 0x25, 0x10,
+	0xd6, 0xe9, 0xfe, 0x01, 0x0d, 0xb9, 0xf9, 0x05, 0xbc, 0xab, 0xed, 0x86, 0x01, 0xf3, 0x43, 0x8a,
+	0x3f, 0x85, 0x71, 0x19, 0xc9, 0x34, 0x9a, 0x47, 0xb5, 0xf2, 0xf2, 0x82, 0x95, 0x99, 0x65, 0x4b,
+	0xaa, 0x3e, 0x18, 0x7d, 0xf1, 0xe7, 0xd5, 0xa1, 0xba, 0x52, 0x33, 0x57, 0xe0, 0x8a, 0xb0, 0xbb,
+	0x41, 0xf9, 0xd3, 0x28, 0xd4, 0xa7, 0x52, 0x58, 0xb9, 0xc5, 0x53, 0x30, 0xe6, 0xfa, 0x0e, 0x3d,
+	0x12, 0xe6, 0x4b, 0x75, 0xb9, 0x30, 0xf7, 0x61, 0xb6, 0xbb, 0x92, 0x42, 0xf5, 0x04, 0x26, 0xc3,
+	0xd4, 0xbe, 0xc2, 0xf6, 0x5e, 0x0f, 0x6c, 0x69, 0x33, 0x0a, 0xa1, 0x66, 0xc2, 0xa4, 0x0a, 0xe7,
+	0x9a, 0xe7, 0x75, 0xc3, 0xb9, 0x0e, 0x90, 0xd4, 0xa2, 0xf2, 0x77, 0xdd, 0x92, 0xfc, 0x5b, 0x11,
+	0xff, 0x96, 0xbc, 0x0a, 0x2a, 0x0b, 0xd6, 0x56, 0xa2, 0x5b, 0x4f, 0x69, 0x9a, 0x3f, 0x23, 0x98,
+	0xed, 0xee, 0x27, 0x33, 0xb4, 0x91, 0x37, 0x0c, 0x0d, 0x6f, 0x68, 0xd8, 0x87, 0x15, 0x57, 0x79,
+	0xd8, 0x25, 0x1e, 0x0d, 0xfc, 0x5c, 0x92, 0xcb, 0xc7, 0x11, 0x82, 0x47, 0xf2, 0x52, 0xc4, 0x25,
+	0x94, 0xca, 0x9a, 0x7e, 0x9c, 0x84, 0x96, 0xde, 0x2f, 0x90, 0xb5, 0xb4, 0x78, 0x1c, 0x5a, 0x7a,
+	0xcf, 0xfc, 0x20, 0x71, 0xb9, 0x2e, 0x2f, 0x9e, 0x56, 0xd5, 0x19, 0xe5, 0x75, 0x00, 0x73, 0x19,
+	0x5a, 0x0a, 0xe9, 0x33, 0xa8, 0xec, 0xa4, 0x0f, 0x14, 0xd4, 0x5a, 0x0f, 0xa8, 0x9a, 0x21, 0x85,
+	0x55, 0x37, 0x62, 0xee, 0x24, 0xa9, 0xef, 0x0a, 0x76, 0x50, 0x35, 0xf6, 0x1b, 0x82, 0xb9, 0x0c,
+	0x47, 0xd9, 0xf1, 0x8d, 0xbc, 0x71, 0x7c, 0x83, 0xab, 0xb3, 0xd7, 0x08, 0xe6, 0xe5, 0x63, 0xd4,
+	0x66, 0x87, 0xae, 0x43, 0xdb, 0xa2, 0xc4, 0x3f, 0x73, 0x23, 0x2c, 0xc7, 0x31, 0x5b, 0x06, 0x4c,
+	0x04, 0xea, 0x58, 0x65, 0xb7, 0xb3, 0xc6, 0x33, 0x30, 0xd1, 0xdc, 0x25, 0xae, 0xdf, 0x70, 0x1d,
+	0x81, 0xa3, 0x54, 0xff, 0x9f, 0x58, 0x6f, 0x3a, 0xf8, 0x2a, 0x94, 0x43, 0x4e, 0xda, 0xbc, 0x21,
+	0xa2, 0x9b, 0x1e, 0x99, 0x47, 0xb5, 0xd1, 0x3a, 0x88, 0x2d, 0x51, 0x59, 0xf8, 0x0a, 0x94, 0xa8,
+	0xef, 0xa8, 0xe3, 0x51, 0x71, 0x3c, 0x41, 0x7d, 0x47, 0x1e, 0xea, 0x29, 0x1a, 0xbb, 0x74, 0x8a,
+	0x9e, 0x23, 0x58, 0xe8, 0x11, 0xa1, 0x4a, 0xd3, 0xe7, 0x30, 0x2e, 0x2e, 0x72, 0x9c, 0x9f, 0xdb,
+	0xbd, 0x1e, 0x5f, 0x65, 0x48, 0xe0, 0x14, 0xd6, 0xe2, 0x87, 0x58, 0x9a, 0x18, 0x5c, 0x76, 0xbe,
+	0x1f, 0x06, 0x7c, 0xd1, 0x5b, 0x74, 0xd5, 0x24, 0x67, 0x48, 0x70, 0x26, 0x17, 0xbd, 0x32, 0xf1,
+	0x21, 0x8c, 0x09, 0x68, 0x22, 0x07, 0xe5, 0xe5, 0x19, 0x0d, 0x4b, 0x8c, 0xe2, 0x21, 0x73, 0x7d,
+	0x15, 0x88, 0x94, 0xc6, 0xeb, 0xf0, 0x7f, 0x87, 0x7a, 0xb4, 0x45, 0x38, 0x6d, 0x70, 0xc6, 0x89,
+	0x37, 0x3d, 0x5a, 0x4c, 0xbf, 0x12, 0xab, 0x3d, 0x8b, 0xb4, 0xf0, 0x3c, 0x94, 0x5b, 0x94, 0x79,
+	0xac, 0x99, 0xe4, 0x72, 0xac, 0x9e, 0xde, 0xc2, 0x1b, 0x50, 0x8a, 0x1b, 0x6e, 0x38, 0x3d, 0x2e,
+	0x32, 0xb0, 0xd8, 0xeb, 0xb1, 0x52, 0xb2, 0xca, 0x5d, 0xa2, 0x6b, 0xbe, 0x8e, 0x2f, 0x64, 0x42,
+	0xd7, 0x63, 0x9f, 0xb7, 0x5d, 0xda, 0xb9, 0xfa, 0x69, 0x9a, 0x90, 0x4e, 0x53, 0x87, 0xd7, 0xe1,
+	0x34, 0xaf, 0xff, 0x41, 0x3f, 0x72, 0x11, 0xfd, 0x22, 0x54, 0x48, 0xe0, 0x36, 0x5c, 0x9f, 0xd3,
+	0xf6, 0x0e, 0x69, 0x52, 0x41, 0x53, 0xa9, 0x3e, 0x49, 0x02, 0x77, 0x33, 0xde, 0x1b, 0x58, 0x3d,
+	0xff, 0x82, 0xa0, 0x9a, 0x15, 0xa1, 0x2a, 0xe6, 0x2d, 0xa8, 0x24, 0xb3, 0x8e, 0xdb, 0xa9, 0xe9,
+	0x6b, 0x79, 0x9d, 0x2d, 0xb2, 0x73, 0xac, 0xf5, 0x35, 0x65, 0x79, 0x60, 0x15, 0xbd, 0xfc, 0x4f,
+	0x19, 0xc6, 0x04, 0x7a, 0xfc, 0x2d, 0x82, 0x71, 0xf5, 0x9a, 0xf5, 0xba, 0x6c, 0x17, 0xe7, 0x27,
+	0xc3, 0x2a, 0x2a, 0x2e, 0xfd, 0x9b, 0x37, 0xbe, 0x7e, 0xf5, 0xf7, 0x77, 0xc3, 0x8b, 0x78, 0xc1,
+	0xce, 0x9b, 0x68, 0xf1, 0x73, 0x04, 0x93, 0xe9, 0x26, 0x8f, 0x3f, 0xca, 0xf3, 0xd5, 0x7d, 0xd8,
+	0x32, 0x56, 0xfb, 0xd6, 0x53, 0x60, 0xef, 0x0a, 0xb0, 0xcb, 0xf8, 0x8e, 0x5d, 0x70, 0xac, 0xb6,
+	0x4f, 0x44, 0xa7, 0x3d, 0xc5, 0x3f, 0x22, 0x78, 0x2b, 0x6d, 0x72, 0xcd, 0xf3, 0xf2, 0xe1, 0x77,
+	0x9f, 0xc1, 0x8c, 0xd5, 0xbe, 0xf5, 0x14, 0xfc, 0x3b, 0x02, 0xfe, 0x4d, 0x5c, 0x2b, 0x0a, 0x1f,
+	0xff, 0x80, 0xf4, 0x59, 0xa5, 0x10, 0xe5, 0x5d, 0x66, 0x22, 0x63, 0xb5, 0x6f, 0xbd, 0x3e, 0x30,
+	0x6b, 0x9f, 0x26, 0xf8, 0x57, 0x04, 0x15, 0xad, 0x4b, 0xe3, 0x22, 0xce, 0xbb, 0x4d, 0x22, 0xc6,
+	0xdd, 0xfe, 0x15, 0x15, 0xec, 0x7b, 0x02, 0xf6, 0x0a, 0x5e, 0xb2, 0x8b, 0x7e, 0x22, 0x75, 0x4a,
+	0xe5, 0x27, 0x04, 0x6f, 0x6b, 0x46, 0xa3, 0x5a, 0x29, 0x92, 0xf3, 0xcb, 0x85, 0x90, 0x35, 0x1c,
+	0x99, 0x4b, 0x22, 0x84, 0x5b, 0xf8, 0x46, 0xe1, 0x10, 0xf0, 0xef, 0x08, 0xa6, 0xba, 0x75, 0x72,
+	0xfc, 0x71, 0xee, 0xab, 0x90, 0x3d, 0xe1, 0x18, 0xf7, 0x2f, 0xa7, 0xac, 0xc2, 0x78, 0x24, 0xc2,
+	0xf8, 0x04, 0xdf, 0xef, 0xf5, 0xc0, 0x28, 0x03, 0x0d, 0x59, 0xfd, 0xbb, 0xd2, 0x84, 0x7d, 0x12,
+	0xef, 0x9f, 0xe2, 0x57, 0x08, 0xde, 0xb9, 0xf0, 0xa6, 0xe3, 0x5c, 0x72, 0xb3, 0x1a, 0x9d, 0x71,
+	0xef, 0x12, 0x9a, 0x2a, 0xa0, 0x4d, 0x11, 0xd0, 0x43, 0xbc, 0x96, 0x7b, 0x23, 0xb4, 0x3e, 0x63,
+	0x9f, 0xc4, 0x9d, 0xf5, 0xd4, 0x3e, 0x11, 0xe7, 0xa7, 0x0f, 0xd6, 0x5f, 0x9c, 0x55, 0xd1, 0xcb,
+	0xb3, 0x2a, 0xfa, 0xeb, 0xac, 0x8a, 0xbe, 0x39, 0xaf, 0x0e, 0xbd, 0x3c, 0xaf, 0x0e, 0xfd, 0x71,
+	0x5e, 0x1d, 0xfa, 0xf2, 0xfd, 0x96, 0xcb, 0x77, 0x0f, 0xb6, 0xad, 0x26, 0xdb, 0xd3, 0xdd, 0x1c,
+	0xe9, 0x8e, 0xf8, 0x71, 0x40, 0xc3, 0xed, 0x71, 0xf1, 0x45, 0xbd, 0xf2, 0xef, 0x00, 0x82, 0xe3,
+	0x73, 0xc6, 0x22, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -653,6 +1025,10 @@ type QueryClient interface {
 	FixatedParams(ctx context.Context, in *QueryGetFixatedParamsRequest, opts ...grpc.CallOption) (*QueryGetFixatedParamsResponse, error)
 	// Queries a list of FixatedParams items.
 	FixatedParamsAll(ctx context.Context, in *QueryAllFixatedParamsRequest, opts ...grpc.CallOption) (*QueryAllFixatedParamsResponse, error)
+	// Queries a provider's stake entries over a range of epochs.
+	ProviderStakeHistory(ctx context.Context, in *QueryProviderStakeHistoryRequest, opts ...grpc.CallOption) (*QueryProviderStakeHistoryResponse, error)
+	// Queries the stake entries of a chain at a past epoch.
+	EpochStakeEntries(ctx context.Context, in *QueryEpochStakeEntriesRequest, opts ...grpc.CallOption) (*QueryEpochStakeEntriesResponse, error)
 }
 
 type queryClient struct {
@@ -717,6 +1093,24 @@ func (c *queryClient) FixatedParamsAll(ctx context.Context, in *QueryAllFixatedP
 	return out, nil
 }
 
+func (c *queryClient) ProviderStakeHistory(ctx context.Context, in *QueryProviderStakeHistoryRequest, opts ...grpc.CallOption) (*QueryProviderStakeHistoryResponse, error) {
+	out := new(QueryProviderStakeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.epochstorage.Query/ProviderStakeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochStakeEntries(ctx context.Context, in *QueryEpochStakeEntriesRequest, opts ...grpc.CallOption) (*QueryEpochStakeEntriesResponse, error) {
+	out := new(QueryEpochStakeEntriesResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.epochstorage.Query/EpochStakeEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -731,6 +1125,10 @@ type QueryServer interface {
 	FixatedParams(context.Context, *QueryGetFixatedParamsRequest) (*QueryGetFixatedParamsResponse, error)
 	// Queries a list of FixatedParams items.
 	FixatedParamsAll(context.Context, *QueryAllFixatedParamsRequest) (*QueryAllFixatedParamsResponse, error)
+	// Queries a provider's stake entries over a range of epochs.
+	ProviderStakeHistory(context.Context, *QueryProviderStakeHistoryRequest) (*QueryProviderStakeHistoryResponse, error)
+	// Queries the stake entries of a chain at a past epoch.
+	EpochStakeEntries(context.Context, *QueryEpochStakeEntriesRequest) (*QueryEpochStakeEntriesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -755,6 +1153,12 @@ func (*UnimplementedQueryServer) FixatedParams(ctx context.Context, req *QueryGe
 func (*UnimplementedQueryServer) FixatedParamsAll(ctx context.Context, req *QueryAllFixatedParamsRequest) (*QueryAllFixatedParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FixatedParamsAll not implemented")
 }
+func (*UnimplementedQueryServer) ProviderStakeHistory(ctx context.Context, req *QueryProviderStakeHistoryRequest) (*QueryProviderStakeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderStakeHistory not implemented")
+}
+func (*UnimplementedQueryServer) EpochStakeEntries(ctx context.Context, req *QueryEpochStakeEntriesRequest) (*QueryEpochStakeEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochStakeEntries not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -868,6 +1272,42 @@ func _Query_FixatedParamsAll_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProviderStakeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderStakeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderStakeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.epochstorage.Query/ProviderStakeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderStakeHistory(ctx, req.(*QueryProviderStakeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochStakeEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochStakeEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochStakeEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.epochstorage.Query/EpochStakeEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochStakeEntries(ctx, req.(*QueryEpochStakeEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.epochstorage.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -896,6 +1336,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FixatedParamsAll",
 			Handler:    _Query_FixatedParamsAll_Handler,
 		},
+		{
+			MethodName: "ProviderStakeHistory",
+			Handler:    _Query_ProviderStakeHistory_Handler,
+		},
+		{
+			MethodName: "EpochStakeEntries",
+			Handler:    _Query_EpochStakeEntries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/epochstorage/query.proto",
@@ -1307,16 +1755,306 @@ func (m *QueryAllFixatedParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryProviderStakeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderStakeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderStakeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EndEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderStakeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderStakeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderStakeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stakes) > 0 {
+		for iNdEx := len(m.Stakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderEpochStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderEpochStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderEpochStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Endpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Geolocation != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Geolocation))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.DelegateTotal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Stake.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochStakeEntriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochStakeEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochStakeEntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ApiInterface) > 0 {
+		i -= len(m.ApiInterface)
+		copy(dAtA[i:], m.ApiInterface)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ApiInterface)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Geolocation != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Geolocation))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochStakeEntriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochStakeEntriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochStakeEntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakeEntries) > 0 {
+		for iNdEx := len(m.StakeEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
@@ -1463,20 +2201,645 @@ func (m *QueryAllFixatedParamsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderStakeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.EndEpoch))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderStakeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stakes) > 0 {
+		for _, e := range m.Stakes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ProviderEpochStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Stake.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DelegateTotal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Geolocation != 0 {
+		n += 1 + sovQuery(uint64(m.Geolocation))
+	}
+	if len(m.Endpoints) > 0 {
+		for _, e := range m.Endpoints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEpochStakeEntriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if m.Geolocation != 0 {
+		n += 1 + sovQuery(uint64(m.Geolocation))
+	}
+	l = len(m.ApiInterface)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochStakeEntriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StakeEntries) > 0 {
+		for _, e := range m.StakeEntries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetStakeStorageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStakeStorageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStakeStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetStakeStorageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStakeStorageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStakeStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeStorage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakeStorage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllStakeStorageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllStakeStorageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllStakeStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllStakeStorageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllStakeStorageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllStakeStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeStorage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeStorage = append(m.StakeStorage, StakeStorage{})
+			if err := m.StakeStorage[len(m.StakeStorage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetEpochDetailsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1499,10 +2862,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetEpochDetailsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetEpochDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1526,7 +2889,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetEpochDetailsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1549,15 +2912,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetEpochDetailsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetEpochDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDetails", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1584,7 +2947,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EpochDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1609,7 +2972,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStakeStorageRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetFixatedParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1632,10 +2995,10 @@ func (m *QueryGetStakeStorageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStakeStorageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetFixatedParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStakeStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetFixatedParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1691,7 +3054,7 @@ func (m *QueryGetStakeStorageRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStakeStorageResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetFixatedParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1714,15 +3077,15 @@ func (m *QueryGetStakeStorageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStakeStorageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetFixatedParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStakeStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetFixatedParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeStorage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FixatedParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1749,7 +3112,7 @@ func (m *QueryGetStakeStorageResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StakeStorage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FixatedParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1774,7 +3137,7 @@ func (m *QueryGetStakeStorageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllStakeStorageRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllFixatedParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1797,10 +3160,10 @@ func (m *QueryAllStakeStorageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllStakeStorageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllFixatedParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllStakeStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllFixatedParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1860,7 +3223,7 @@ func (m *QueryAllStakeStorageRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllStakeStorageResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllFixatedParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1883,15 +3246,15 @@ func (m *QueryAllStakeStorageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllStakeStorageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllFixatedParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllStakeStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllFixatedParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeStorage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FixatedParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1918,8 +3281,8 @@ func (m *QueryAllStakeStorageResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeStorage = append(m.StakeStorage, StakeStorage{})
-			if err := m.StakeStorage[len(m.StakeStorage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FixatedParams = append(m.FixatedParams, FixatedParams{})
+			if err := m.FixatedParams[len(m.FixatedParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1980,7 +3343,7 @@ func (m *QueryAllStakeStorageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEpochDetailsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderStakeHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2003,12 +3366,150 @@ func (m *QueryGetEpochDetailsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEpochDetailsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderStakeHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEpochDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderStakeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2030,7 +3531,7 @@ func (m *QueryGetEpochDetailsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEpochDetailsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderStakeHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2049,19 +3550,53 @@ func (m *QueryGetEpochDetailsResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEpochDetailsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEpochDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderStakeHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderStakeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stakes = append(m.Stakes, ProviderEpochStake{})
+			if err := m.Stakes[len(m.Stakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochDetails", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2088,7 +3623,10 @@ func (m *QueryGetEpochDetailsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EpochDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2113,7 +3651,7 @@ func (m *QueryGetEpochDetailsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetFixatedParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *ProviderEpochStake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2136,15 +3674,34 @@ func (m *QueryGetFixatedParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetFixatedParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProviderEpochStake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetFixatedParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProviderEpochStake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2172,61 +3729,96 @@ func (m *QueryGetFixatedParamsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetFixatedParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateTotal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.DelegateTotal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetFixatedParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetFixatedParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Geolocation", wireType)
+			}
+			m.Geolocation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Geolocation |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FixatedParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2253,7 +3845,8 @@ func (m *QueryGetFixatedParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FixatedParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Endpoints = append(m.Endpoints, Endpoint{})
+			if err := m.Endpoints[len(m.Endpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2278,7 +3871,7 @@ func (m *QueryGetFixatedParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllFixatedParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochStakeEntriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2301,13 +3894,115 @@ func (m *QueryAllFixatedParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllFixatedParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochStakeEntriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllFixatedParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochStakeEntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Geolocation", wireType)
+			}
+			m.Geolocation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Geolocation |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiInterface", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiInterface = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2364,7 +4059,7 @@ func (m *QueryAllFixatedParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllFixatedParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochStakeEntriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2387,15 +4082,15 @@ func (m *QueryAllFixatedParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllFixatedParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochStakeEntriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllFixatedParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochStakeEntriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FixatedParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeEntries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2422,8 +4117,8 @@ func (m *QueryAllFixatedParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FixatedParams = append(m.FixatedParams, FixatedParams{})
-			if err := m.FixatedParams[len(m.FixatedParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StakeEntries = append(m.StakeEntries, StakeEntry{})
+			if err := m.StakeEntries[len(m.StakeEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
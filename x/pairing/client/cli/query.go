@@ -39,6 +39,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdSubscriptionMonthlyPayout())
 
 	cmd.AddCommand(CmdProvidersEpochCu())
+	cmd.AddCommand(CmdUpcomingMaintenance())
 
 	cmd.AddCommand(CmdDebugQuery())
 
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	MaintenanceChainIDFlag  = "chain-id"
+	MaintenanceProviderFlag = "provider"
+)
+
+func CmdUpcomingMaintenance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upcoming-maintenance",
+		Short: "Query for the ongoing and upcoming provider maintenance windows",
+		Example: `
+		lavad q pairing upcoming-maintenance
+		lavad q pairing upcoming-maintenance --chain-id ETH1
+		lavad q pairing upcoming-maintenance --provider <provider_address>`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := cmd.Flags().GetString(MaintenanceChainIDFlag)
+			if err != nil {
+				return err
+			}
+			provider, err := cmd.Flags().GetString(MaintenanceProviderFlag)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryUpcomingMaintenanceRequest{
+				ChainId:  chainID,
+				Provider: provider,
+			}
+
+			res, err := queryClient.UpcomingMaintenance(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(MaintenanceChainIDFlag, "", "show only maintenance windows of this chain")
+	cmd.Flags().String(MaintenanceProviderFlag, "", "show only maintenance windows of this provider")
+
+	return cmd
+}
@@ -45,6 +45,7 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUnstakeProvider())
 	cmd.AddCommand(CmdRelayPayment())
 	cmd.AddCommand(CmdFreeze())
+	cmd.AddCommand(CmdScheduleMaintenance())
 	cmd.AddCommand(CmdUnfreeze())
 	cmd.AddCommand(CmdModifyProvider())
 	cmd.AddCommand(CmdSimulateRelayPayment())
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdScheduleMaintenance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-maintenance [start-in-epochs] [duration] [chain-ids]",
+		Short: "Schedules a maintenance window for a provider",
+		Long: `The schedule-maintenance command allows a provider to announce a maintenance window in advance. The provider is frozen on the given chains at the start of the epoch [start-in-epochs] epochs from now (1 = next epoch), and is unfrozen automatically after [duration] epochs.
+		The duration is limited by the MaxMaintenanceDuration param, and consecutive maintenance windows must start at least MaintenanceCooldown epochs apart.`,
+		Example: `required flags: --from alice. optional flags: --reason
+		lavad tx pairing schedule-maintenance [start-in-epochs] [duration] [chain-ids] --from <provider_address>
+		lavad tx pairing schedule-maintenance 2 4 ETH1,OSMOSIS --from alice --reason "hardware upgrade"`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			startInEpochs, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			duration, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argChainIds := strings.Split(args[2], listSeparator)
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get the maintenance reason (default value: "")
+			reason, err := cmd.Flags().GetString(types.ReasonFlagName)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read maintenance reason flag", err)
+			}
+
+			msg := types.NewMsgScheduleMaintenance(
+				clientCtx.GetFromAddress().String(),
+				argChainIds,
+				startInEpochs,
+				duration,
+				reason,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.MarkFlagRequired(flags.FlagFrom)
+	cmd.Flags().String(types.ReasonFlagName, "", "reason for maintenance")
+
+	return cmd
+}
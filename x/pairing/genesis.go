@@ -30,7 +30,13 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetBadgeUsedCu(ctx, elem)
 	}
 
+	// Set all the providerMaintenance
+	for _, elem := range genState.ProviderMaintenances {
+		k.SetProviderMaintenance(ctx, elem)
+	}
+
 	k.InitBadgeTimers(ctx, genState.BadgesTS)
+	k.InitMaintenanceTimers(ctx, genState.MaintenanceTS)
 	k.InitProviderQoS(ctx, genState.ProviderQosFS)
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
@@ -47,6 +53,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.BadgeUsedCuList = k.GetAllBadgeUsedCu(ctx)
 	genesis.BadgesTS = k.ExportBadgesTimers(ctx)
 	genesis.ProviderQosFS = k.ExportProviderQoS(ctx)
+	genesis.ProviderMaintenances = k.GetAllProviderMaintenance(ctx)
+	genesis.MaintenanceTS = k.ExportMaintenanceTimers(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -37,9 +37,12 @@ func (k Keeper) getPairing(ctx sdk.Context, req *types.QueryGetPairingRequest) (
 		return nil, nil, fmt.Errorf("invalid creator address %s error: %s", req.Client, err)
 	}
 
-	// Make sure the chain ID exists and the chain's functional
-	foundAndActive, _, _ := k.specKeeper.IsSpecFoundAndActive(ctx, req.ChainID)
-	// TODO: handle spec changes
+	// Get current epoch start block
+	currentEpoch := k.epochStorageKeeper.GetEpochStart(ctx)
+
+	// Make sure the chain ID exists and the chain's functional (using the spec version
+	// that is in effect at the current epoch)
+	foundAndActive, _, _ := k.specKeeper.IsSpecFoundAndActiveForBlock(ctx, req.ChainID, currentEpoch)
 	if !foundAndActive {
 		return nil, nil, errors.New("spec not found or not enabled")
 	}
@@ -58,11 +61,8 @@ func (k Keeper) getPairing(ctx sdk.Context, req *types.QueryGetPairingRequest) (
 		timeLeftToNextPairing = 0
 	}
 
-	// Get current epoch start block
-	currentEpoch := k.epochStorageKeeper.GetEpochStart(ctx)
-
 	// Get the block in which there was the latest change for the current spec
-	spec, err := k.specKeeper.GetExpandedSpec(ctx, req.GetChainID())
+	spec, err := k.specKeeper.GetExpandedSpecForBlock(ctx, req.GetChainID(), currentEpoch)
 	if err != nil {
 		return nil, nil, err
 	}
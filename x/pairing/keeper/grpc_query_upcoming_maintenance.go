@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) UpcomingMaintenance(goCtx context.Context, req *types.QueryUpcomingMaintenanceRequest) (*types.QueryUpcomingMaintenanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryUpcomingMaintenanceResponse{Maintenances: k.GetUpcomingMaintenance(ctx, req.ChainId, req.Provider)}, nil
+}
@@ -48,7 +48,7 @@ func (k Keeper) UserEntry(goCtx context.Context, req *types.QueryUserEntryReques
 	planPolicy := plan.GetPlanPolicy()
 	policies := []*planstypes.Policy{&planPolicy, project.AdminPolicy, project.SubscriptionPolicy}
 	// geolocation is a bitmap. common denominator can be calculated with logical AND
-	geolocation, err := k.CalculateEffectiveGeolocationFromPolicies(policies)
+	geolocation, err := k.CalculateEffectiveGeolocationFromPolicies(append(policies, project.GetDeveloperKeyPolicy(req.Address)))
 	if err != nil {
 		return nil, err
 	}
@@ -56,6 +56,7 @@ func (k Keeper) UserEntry(goCtx context.Context, req *types.QueryUserEntryReques
 	if !planstypes.VerifyTotalCuUsage(allowedCUTotal, project.GetUsedCu()) {
 		allowedCU = 0
 	}
+	allowedCU, _ = k.CalculateDeveloperKeyAllowedCu(project, req.Address, allowedCU, allowedCUTotal)
 
 	return &types.QueryUserEntryResponse{Consumer: epochstoragetypes.StakeEntry{
 		Geolocation: geolocation,
@@ -18,8 +18,12 @@ func (k Keeper) VerifyPairing(goCtx context.Context, req *types.QueryVerifyPairi
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	foundAndActive, _, _ := k.specKeeper.IsSpecFoundAndActive(ctx, req.ChainID)
-	// TODO:handle spec changes
+	// use the spec version that was in effect at the requested block's epoch
+	epochStart, _, err := k.epochStorageKeeper.GetEpochStartForBlock(ctx, req.Block)
+	if err != nil {
+		return nil, err
+	}
+	foundAndActive, _, _ := k.specKeeper.IsSpecFoundAndActiveForBlock(ctx, req.ChainID, epochStart)
 	if !foundAndActive {
 		return &types.QueryVerifyPairingResponse{Valid: false}, errors.New("spec not found or not enabled")
 	}
@@ -38,7 +42,7 @@ func (k Keeper) VerifyPairing(goCtx context.Context, req *types.QueryVerifyPairi
 		return nil, err
 	}
 
-	isValidPairing, cuPerEpoch, providersToPair, err := k.ValidatePairingForClient(ctx, req.ChainID, providerAddr, req.Block, project)
+	isValidPairing, cuPerEpoch, providersToPair, err := k.ValidatePairingForClient(ctx, req.ChainID, providerAddr, req.Block, project, clientAddr.String())
 
 	return &types.QueryVerifyPairingResponse{Valid: isValidPairing, PairedProviders: uint64(len(providersToPair)), CuPerEpoch: cuPerEpoch, ProjectId: project.Index}, err
 }
@@ -22,18 +22,19 @@ type (
 		memKey     storetypes.StoreKey
 		paramstore paramtypes.Subspace
 
-		bankKeeper         types.BankKeeper
-		accountKeeper      types.AccountKeeper
-		specKeeper         types.SpecKeeper
-		epochStorageKeeper types.EpochstorageKeeper
-		projectsKeeper     types.ProjectsKeeper
-		subscriptionKeeper types.SubscriptionKeeper
-		planKeeper         types.PlanKeeper
-		badgeTimerStore    timerstoretypes.TimerStore
-		providerQosFS      fixationtypes.FixationStore
-		downtimeKeeper     types.DowntimeKeeper
-		dualstakingKeeper  types.DualstakingKeeper
-		stakingKeeper      types.StakingKeeper
+		bankKeeper            types.BankKeeper
+		accountKeeper         types.AccountKeeper
+		specKeeper            types.SpecKeeper
+		epochStorageKeeper    types.EpochstorageKeeper
+		projectsKeeper        types.ProjectsKeeper
+		subscriptionKeeper    types.SubscriptionKeeper
+		planKeeper            types.PlanKeeper
+		badgeTimerStore       timerstoretypes.TimerStore
+		maintenanceTimerStore timerstoretypes.TimerStore
+		providerQosFS         fixationtypes.FixationStore
+		downtimeKeeper        types.DowntimeKeeper
+		dualstakingKeeper     types.DualstakingKeeper
+		stakingKeeper         types.StakingKeeper
 	}
 )
 
@@ -96,6 +97,13 @@ func NewKeeper(
 		WithCallbackByBlockHeight(badgeTimerCallback)
 	keeper.badgeTimerStore = *badgeTimerStore
 
+	maintenanceTimerCallback := func(ctx sdk.Context, key, data []byte) {
+		keeper.maintenanceTimerCallback(ctx, key, data)
+	}
+	maintenanceTimerStore := timerStoreKeeper.NewTimerStoreBeginBlock(storeKey, types.MaintenanceTimerStorePrefix).
+		WithCallbackByBlockHeight(maintenanceTimerCallback)
+	keeper.maintenanceTimerStore = *maintenanceTimerStore
+
 	keeper.providerQosFS = *fixationStoreKeeper.NewFixationStore(storeKey, types.ProviderQosStorePrefix)
 
 	return keeper
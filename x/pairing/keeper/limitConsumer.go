@@ -37,6 +37,22 @@ func (k Keeper) EnforceClientCUsUsageInEpoch(ctx sdk.Context, relayCU, epochAllo
 		return effectivePolicyTotalCu - project.UsedCu, nil
 	}
 
+	// the developer key's total CU limit applies to the CU used by the key
+	if keyPolicy := project.GetDeveloperKeyPolicy(clientAddr.String()); keyPolicy != nil && keyPolicy.TotalCuLimit != 0 {
+		keyCuLeft := developerKeyCuLeft(project, clientAddr.String(), keyPolicy)
+		if relayCU > keyCuLeft {
+			utils.LavaFormatInfo("Developer key exceeded its total CU limit",
+				utils.LogAttr("keyTotalCuLimit", keyPolicy.TotalCuLimit),
+				utils.LogAttr("keyCuLeft", keyCuLeft),
+				utils.LogAttr("relayCU", relayCU),
+				utils.LogAttr("clientAddr", clientAddr.String()),
+				utils.LogAttr("chainID", chainID),
+				utils.LogAttr("epoch", epoch),
+			)
+			return keyCuLeft, nil
+		}
+	}
+
 	epochCuLimit := epochAllowedCU * k.downtimeKeeper.GetDowntimeFactor(ctx, epoch)
 	// Check if the total CUs used in the epoch is larger than the CU left for this epoch
 	if totalCUInEpochForUserProvider > epochCuLimit {
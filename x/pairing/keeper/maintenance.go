@@ -0,0 +1,269 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/lavaslices"
+	"github.com/lavanet/lava/x/pairing/types"
+	timertypes "github.com/lavanet/lava/x/timerstore/types"
+)
+
+// SetProviderMaintenance sets a provider's maintenance window in the store
+func (k Keeper) SetProviderMaintenance(ctx sdk.Context, maintenance types.ProviderMaintenance) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderMaintenanceKeyPrefix))
+	b := k.cdc.MustMarshal(&maintenance)
+	store.Set(types.ProviderMaintenanceKey(maintenance.Provider), b)
+}
+
+// GetProviderMaintenance returns a provider's latest maintenance window
+func (k Keeper) GetProviderMaintenance(ctx sdk.Context, provider string) (val types.ProviderMaintenance, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderMaintenanceKeyPrefix))
+	b := store.Get(types.ProviderMaintenanceKey(provider))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllProviderMaintenance returns the latest maintenance windows of all providers
+func (k Keeper) GetAllProviderMaintenance(ctx sdk.Context) (list []types.ProviderMaintenance) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderMaintenanceKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ProviderMaintenance
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// ScheduleMaintenance schedules a maintenance window for a provider: its chains are frozen
+// at the start of the epoch startInEpochs epochs from now, and unfrozen automatically
+// after duration epochs. A provider can have a single upcoming maintenance window, and
+// consecutive windows must start at least MaintenanceCooldown epochs apart.
+func (k Keeper) ScheduleMaintenance(ctx sdk.Context, provider string, chainIDs []string, startInEpochs uint64, duration uint64, reason string) (startBlock uint64, endBlock uint64, err error) {
+	providerAddr, err := sdk.AccAddressFromBech32(provider)
+	if err != nil {
+		return 0, 0, utils.LavaFormatWarning("ScheduleMaintenance_get_provider_address", err, utils.Attribute{Key: "providerAddress", Value: provider})
+	}
+
+	if !commontypes.ValidateString(reason, commontypes.DESCRIPTION_RESTRICTIONS, nil) {
+		return 0, 0, utils.LavaFormatWarning("ScheduleMaintenance_invalid_reason", fmt.Errorf("invalid string"),
+			utils.LogAttr("reason", reason),
+		)
+	}
+
+	maxDuration := k.MaxMaintenanceDuration(ctx)
+	if duration > maxDuration {
+		return 0, 0, utils.LavaFormatWarning("ScheduleMaintenance_duration_too_long", types.InvalidMaintenanceError,
+			utils.LogAttr("duration", duration),
+			utils.LogAttr("maxDuration", maxDuration),
+		)
+	}
+
+	if startInEpochs > types.MAX_MAINTENANCE_START_EPOCHS {
+		return 0, 0, utils.LavaFormatWarning("ScheduleMaintenance_start_too_far", types.InvalidMaintenanceError,
+			utils.LogAttr("startInEpochs", startInEpochs),
+			utils.LogAttr("maxStartInEpochs", types.MAX_MAINTENANCE_START_EPOCHS),
+		)
+	}
+
+	for _, chainID := range chainIDs {
+		_, found, _ := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, providerAddr)
+		if !found {
+			return 0, 0, utils.LavaFormatWarning("ScheduleMaintenance_cant_get_stake_entry", types.FreezeStakeEntryNotFoundError,
+				utils.LogAttr("chainID", chainID),
+				utils.LogAttr("providerAddress", provider),
+			)
+		}
+	}
+
+	epochBlocks, err := k.epochStorageKeeper.EpochBlocks(ctx, uint64(ctx.BlockHeight()))
+	if err != nil {
+		return 0, 0, utils.LavaFormatError("ScheduleMaintenance_get_epoch_blocks", err)
+	}
+
+	// the maintenance is aligned to epoch starts (assuming EpochBlocks doesn't change meanwhile)
+	startBlock = k.epochStorageKeeper.GetCurrentNextEpoch(ctx) + (startInEpochs-1)*epochBlocks
+	endBlock = startBlock + duration*epochBlocks
+
+	if prev, found := k.GetProviderMaintenance(ctx, provider); found {
+		if prev.EndBlock > uint64(ctx.BlockHeight()) {
+			return 0, 0, utils.LavaFormatWarning("ScheduleMaintenance_already_scheduled", types.InvalidMaintenanceError,
+				utils.LogAttr("providerAddress", provider),
+				utils.LogAttr("startBlock", prev.StartBlock),
+				utils.LogAttr("endBlock", prev.EndBlock),
+			)
+		}
+		cooldownEnd := prev.StartBlock + k.MaintenanceCooldown(ctx)*epochBlocks
+		if startBlock < cooldownEnd {
+			return 0, 0, utils.LavaFormatWarning("ScheduleMaintenance_cooldown", types.InvalidMaintenanceError,
+				utils.LogAttr("providerAddress", provider),
+				utils.LogAttr("startBlock", startBlock),
+				utils.LogAttr("cooldownEnd", cooldownEnd),
+			)
+		}
+	}
+
+	k.SetProviderMaintenance(ctx, types.ProviderMaintenance{
+		Provider:   provider,
+		ChainIds:   chainIDs,
+		StartBlock: startBlock,
+		EndBlock:   endBlock,
+		Reason:     reason,
+	})
+	k.maintenanceTimerStore.AddTimerByBlockHeight(ctx, startBlock, types.MaintenanceTimerKey(types.MaintenanceStartTimerType, provider), []byte{})
+	k.maintenanceTimerStore.AddTimerByBlockHeight(ctx, endBlock, types.MaintenanceTimerKey(types.MaintenanceEndTimerType, provider), []byte{})
+
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ScheduleMaintenanceEventName, map[string]string{
+		"providerAddress": provider,
+		"chainIDs":        strings.Join(chainIDs, ","),
+		"startBlock":      strconv.FormatUint(startBlock, 10),
+		"endBlock":        strconv.FormatUint(endBlock, 10),
+		"reason":          reason,
+	}, "Provider Maintenance Scheduled")
+
+	return startBlock, endBlock, nil
+}
+
+// maintenanceTimerCallback freezes/unfreezes a provider's chains when its maintenance starts/ends
+func (k Keeper) maintenanceTimerCallback(ctx sdk.Context, key, _ []byte) {
+	if len(key) < 2 {
+		utils.LavaFormatError("critical: invalid maintenance timer key", fmt.Errorf("key too short"),
+			utils.LogAttr("key", key),
+		)
+		return
+	}
+
+	provider := string(key[1:])
+	maintenance, found := k.GetProviderMaintenance(ctx, provider)
+	if !found {
+		utils.LavaFormatError("critical: maintenance timer expired for unknown maintenance, skipping", fmt.Errorf("maintenance not found"),
+			utils.LogAttr("provider", provider),
+			utils.LogAttr("block", ctx.BlockHeight()),
+		)
+		return
+	}
+
+	switch key[0] {
+	case types.MaintenanceStartTimerType:
+		k.startMaintenance(ctx, maintenance)
+	case types.MaintenanceEndTimerType:
+		k.endMaintenance(ctx, maintenance)
+	default:
+		utils.LavaFormatError("critical: invalid maintenance timer type", fmt.Errorf("unknown timer type"),
+			utils.LogAttr("type", key[0]),
+			utils.LogAttr("provider", provider),
+		)
+	}
+}
+
+// startMaintenance freezes the maintenance chains. Chains that are already frozen (or no
+// longer staked) are skipped and are not unfrozen when the maintenance ends.
+func (k Keeper) startMaintenance(ctx sdk.Context, maintenance types.ProviderMaintenance) {
+	providerAddr, err := sdk.AccAddressFromBech32(maintenance.Provider)
+	if err != nil {
+		utils.LavaFormatError("critical: invalid provider address in maintenance", err,
+			utils.LogAttr("provider", maintenance.Provider),
+		)
+		return
+	}
+
+	maintenance.FrozenChainIds = []string{}
+	for _, chainID := range maintenance.ChainIds {
+		stakeEntry, found, index := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, providerAddr)
+		if !found || stakeEntry.IsFrozen() {
+			continue
+		}
+		stakeEntry.Freeze()
+		k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, chainID, stakeEntry, index)
+		maintenance.FrozenChainIds = append(maintenance.FrozenChainIds, chainID)
+	}
+	k.SetProviderMaintenance(ctx, maintenance)
+
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.MaintenanceStartEventName, map[string]string{
+		"providerAddress": maintenance.Provider,
+		"chainIDs":        strings.Join(maintenance.FrozenChainIds, ","),
+		"endBlock":        strconv.FormatUint(maintenance.EndBlock, 10),
+	}, "Provider Maintenance Started")
+}
+
+// endMaintenance unfreezes the chains that were frozen by the maintenance (unless the
+// provider's stake dropped below the minimum stake meanwhile)
+func (k Keeper) endMaintenance(ctx sdk.Context, maintenance types.ProviderMaintenance) {
+	providerAddr, err := sdk.AccAddressFromBech32(maintenance.Provider)
+	if err != nil {
+		utils.LavaFormatError("critical: invalid provider address in maintenance", err,
+			utils.LogAttr("provider", maintenance.Provider),
+		)
+		return
+	}
+
+	unfrozenChains := []string{}
+	for _, chainID := range maintenance.FrozenChainIds {
+		stakeEntry, found, index := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, providerAddr)
+		if !found || !stakeEntry.IsFrozen() {
+			continue
+		}
+		minStake := k.specKeeper.GetMinStake(ctx, chainID)
+		if stakeEntry.EffectiveStake().LT(minStake.Amount) {
+			utils.LavaFormatWarning("provider stays frozen after maintenance due to insufficient stake", types.UnFreezeInsufficientStakeError,
+				utils.LogAttr("chainID", chainID),
+				utils.LogAttr("providerAddress", maintenance.Provider),
+				utils.LogAttr("stake", stakeEntry.Stake),
+				utils.LogAttr("minStake", minStake),
+			)
+			continue
+		}
+		// the timers run before the epoch's stake storage is stored, so the provider
+		// is back in the pairing starting this epoch
+		stakeEntry.UnFreeze(uint64(ctx.BlockHeight()))
+		k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, chainID, stakeEntry, index)
+		unfrozenChains = append(unfrozenChains, chainID)
+	}
+
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.MaintenanceEndEventName, map[string]string{
+		"providerAddress": maintenance.Provider,
+		"chainIDs":        strings.Join(unfrozenChains, ","),
+	}, "Provider Maintenance Ended")
+}
+
+// GetUpcomingMaintenance returns the ongoing and upcoming maintenance windows, optionally
+// filtered by chain and provider
+func (k Keeper) GetUpcomingMaintenance(ctx sdk.Context, chainID string, provider string) []types.ProviderMaintenance {
+	list := []types.ProviderMaintenance{}
+	for _, maintenance := range k.GetAllProviderMaintenance(ctx) {
+		if maintenance.EndBlock <= uint64(ctx.BlockHeight()) {
+			continue
+		}
+		if provider != "" && maintenance.Provider != provider {
+			continue
+		}
+		if chainID != "" && !lavaslices.Contains(maintenance.ChainIds, chainID) {
+			continue
+		}
+		list = append(list, maintenance)
+	}
+	return list
+}
+
+// InitMaintenanceTimers imports maintenance timers data (from genesis)
+func (k Keeper) InitMaintenanceTimers(ctx sdk.Context, gs timertypes.GenesisState) {
+	k.maintenanceTimerStore.Init(ctx, gs)
+}
+
+// ExportMaintenanceTimers exports maintenance timers data (for genesis)
+func (k Keeper) ExportMaintenanceTimers(ctx sdk.Context) timertypes.GenesisState {
+	return k.maintenanceTimerStore.Export(ctx)
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/stretchr/testify/require"
+)
+
+// Test that a scheduled maintenance freezes the provider during the window and unfreezes it after
+func TestScheduleMaintenance(t *testing.T) {
+	ts := newTester(t)
+
+	providersCount := 2
+	ts.setupForPayments(providersCount, 1, providersCount) // 1 client, set providers-to-pair
+
+	_, clientAddr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider := ts.GetAccount(common.PROVIDER, 0)
+
+	isPaired := func() bool {
+		res, err := ts.QueryPairingGetPairing(ts.spec.Index, clientAddr)
+		require.NoError(t, err)
+		for _, p := range res.Providers {
+			if p.Address == provider {
+				return true
+			}
+		}
+		return false
+	}
+
+	// maintenance starts in 2 epochs and lasts 3 epochs
+	res, err := ts.TxPairingScheduleMaintenance(provider, ts.spec.Index, 2, 3)
+	require.NoError(t, err)
+	epochBlocks := ts.EpochBlocks()
+	require.Equal(t, ts.EpochStart()+2*epochBlocks, res.StartBlock)
+	require.Equal(t, res.StartBlock+3*epochBlocks, res.EndBlock)
+
+	upcoming, err := ts.QueryPairingUpcomingMaintenance(ts.spec.Index, "")
+	require.NoError(t, err)
+	require.Len(t, upcoming.Maintenances, 1)
+	require.Equal(t, provider, upcoming.Maintenances[0].Provider)
+
+	// a second maintenance can't be scheduled while one is upcoming
+	_, err = ts.TxPairingScheduleMaintenance(provider, ts.spec.Index, 1, 1)
+	require.Error(t, err)
+
+	ts.AdvanceEpoch()
+	require.True(t, isPaired())
+
+	// maintenance started
+	ts.AdvanceEpoch()
+	require.Equal(t, res.StartBlock, ts.BlockHeight())
+	require.False(t, isPaired())
+
+	ts.AdvanceEpochs(2)
+	require.False(t, isPaired())
+
+	// maintenance ended, the provider is unfrozen automatically
+	ts.AdvanceEpoch()
+	require.Equal(t, res.EndBlock, ts.BlockHeight())
+	require.True(t, isPaired())
+
+	upcoming, err = ts.QueryPairingUpcomingMaintenance("", provider)
+	require.NoError(t, err)
+	require.Len(t, upcoming.Maintenances, 0)
+
+	// the next maintenance must respect the cooldown
+	_, err = ts.TxPairingScheduleMaintenance(provider, ts.spec.Index, 1, 1)
+	require.Error(t, err)
+	cooldown := ts.Keepers.Pairing.MaintenanceCooldown(ts.Ctx)
+	_, err = ts.TxPairingScheduleMaintenance(provider, ts.spec.Index, cooldown, 1)
+	require.NoError(t, err)
+}
+
+// Test that a provider that was frozen before the maintenance stays frozen after it ends
+func TestMaintenanceFrozenProvider(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(2, 1, 2)
+
+	_, clientAddr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider := ts.GetAccount(common.PROVIDER, 0)
+
+	_, err := ts.TxPairingFreezeProvider(provider, ts.spec.Index)
+	require.NoError(t, err)
+
+	res, err := ts.TxPairingScheduleMaintenance(provider, ts.spec.Index, 1, 1)
+	require.NoError(t, err)
+
+	ts.AdvanceEpochs(2)
+	require.Equal(t, res.EndBlock, ts.BlockHeight())
+
+	pairing, err := ts.QueryPairingGetPairing(ts.spec.Index, clientAddr)
+	require.NoError(t, err)
+	for _, p := range pairing.Providers {
+		require.NotEqual(t, provider, p.Address)
+	}
+}
+
+func TestScheduleMaintenanceInvalid(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 1)
+
+	_, provider := ts.GetAccount(common.PROVIDER, 0)
+	_, consumer := ts.GetAccount(common.CONSUMER, 0)
+	maxDuration := ts.Keepers.Pairing.MaxMaintenanceDuration(ts.Ctx)
+
+	// too long
+	_, err := ts.TxPairingScheduleMaintenance(provider, ts.spec.Index, 1, maxDuration+1)
+	require.Error(t, err)
+
+	// unknown chain
+	_, err = ts.TxPairingScheduleMaintenance(provider, "dummy", 1, 1)
+	require.Error(t, err)
+
+	// not a provider
+	_, err = ts.TxPairingScheduleMaintenance(consumer, ts.spec.Index, 1, 1)
+	require.Error(t, err)
+
+	_, err = ts.TxPairingScheduleMaintenance(provider, ts.spec.Index, 1, maxDuration)
+	require.NoError(t, err)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v2 "github.com/lavanet/lava/x/pairing/migrations/v2"
+	"github.com/lavanet/lava/x/pairing/types"
 )
 
 type Migrator struct {
@@ -20,3 +21,10 @@ func (m Migrator) MigrateVersion2To3(ctx sdk.Context) error {
 	v2.RemoveAllEpochPayments(ctx, m.keeper.storeKey)
 	return nil
 }
+
+// MigrateVersion3To4 sets the new maintenance params to their default values
+func (m Migrator) MigrateVersion3To4(ctx sdk.Context) error {
+	m.keeper.paramstore.Set(ctx, types.KeyMaxMaintenanceDuration, types.DefaultMaxMaintenanceDuration)
+	m.keeper.paramstore.Set(ctx, types.KeyMaintenanceCooldown, types.DefaultMaintenanceCooldown)
+	return nil
+}
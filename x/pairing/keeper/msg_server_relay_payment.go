@@ -172,8 +172,8 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 			k.handleBadgeCu(ctx, badgeData, relay.Provider, relay.CuSum, newBadgeTimerExpiry)
 		}
 
-		// TODO: add support for spec changes
-		spec, found := k.specKeeper.GetSpec(ctx, relay.SpecId)
+		// use the spec version that was in effect at the relay's epoch
+		spec, found := k.specKeeper.GetSpecForBlock(ctx, relay.SpecId, epochStart)
 		if !found || !spec.Enabled {
 			return nil, utils.LavaFormatWarning("invalid spec ID in relay msg", fmt.Errorf("spec in proof is not found or disabled"),
 				utils.Attribute{Key: "chainID", Value: relay.SpecId},
@@ -187,7 +187,7 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 		val, ok := validatePairingCache[validatePairingKey]
 		if ok {
 			providers = val
-			strictestPolicy, _, err := k.GetProjectStrictestPolicy(ctx, project, relay.SpecId, epochStart)
+			strictestPolicy, _, err := k.GetProjectStrictestPolicy(ctx, project, clientAddr.String(), relay.SpecId, epochStart)
 			if err != nil {
 				return nil, utils.LavaFormatError("strictest policy calculation for pairing validation cache failed", err,
 					utils.LogAttr("project", project.Index),
@@ -204,6 +204,7 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 				providerAddr,
 				uint64(relay.Epoch),
 				project,
+				clientAddr.String(),
 			)
 			if err != nil {
 				return nil, utils.LavaFormatWarning("invalid pairing on proof of relay", err,
@@ -277,7 +278,7 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 		utils.LogLavaEvent(ctx, logger, types.RelayPaymentEventName, successDetails, "New Proof Of Work Was Accepted")
 
 		cuAfterQos := rewardedCUDec.TruncateInt().Uint64()
-		err = k.chargeCuToSubscriptionAndCreditProvider(ctx, project, relay, cuAfterQos)
+		err = k.chargeCuToSubscriptionAndCreditProvider(ctx, clientAddr.String(), project, relay, cuAfterQos)
 		if err != nil {
 			return nil, utils.LavaFormatError("Failed charging CU to project and subscription", err)
 		}
@@ -393,10 +394,10 @@ func (k EpochCuCache) updateProvidersComplainerCU(ctx sdk.Context, unresponsiveP
 	return nil
 }
 
-func (k Keeper) chargeCuToSubscriptionAndCreditProvider(ctx sdk.Context, project projectstypes.Project, relay *types.RelaySession, cuAfterQos uint64) error {
+func (k Keeper) chargeCuToSubscriptionAndCreditProvider(ctx sdk.Context, developerKey string, project projectstypes.Project, relay *types.RelaySession, cuAfterQos uint64) error {
 	epoch := uint64(relay.Epoch)
 
-	err := k.projectsKeeper.ChargeComputeUnitsToProject(ctx, project, epoch, relay.CuSum)
+	err := k.projectsKeeper.ChargeComputeUnitsToProject(ctx, project, developerKey, epoch, relay.CuSum)
 	if err != nil {
 		return fmt.Errorf("failed to add CU to the project")
 	}
@@ -411,6 +412,8 @@ func (k Keeper) chargeCuToSubscriptionAndCreditProvider(ctx sdk.Context, project
 		return err
 	}
 
+	k.subscriptionKeeper.RecordUsage(ctx, sub, project.Index, developerKey, relay.Provider, relay.SpecId, relay.CuSum)
+
 	return nil
 }
 
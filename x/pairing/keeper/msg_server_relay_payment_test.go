@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/testutil/common"
 	commonconsts "github.com/lavanet/lava/testutil/common/consts"
@@ -11,6 +12,8 @@ import (
 	"github.com/lavanet/lava/x/pairing/types"
 	planstypes "github.com/lavanet/lava/x/plans/types"
 	projectstypes "github.com/lavanet/lava/x/projects/types"
+	speckeeper "github.com/lavanet/lava/x/spec/keeper"
+	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -1022,3 +1025,95 @@ func TestPairingCaching(t *testing.T) {
 		require.Equal(t, totalCU*3, sub.Sub.MonthCuTotal-sub.Sub.MonthCuLeft)
 	}
 }
+
+// TestRelayPaymentSpecChange checks that relays are validated against the spec
+// version that was in effect at the relay's epoch: a spec modification (here,
+// disabling the spec) does not affect relays of the epoch in which it was made.
+func TestRelayPaymentSpecChange(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
+
+	client1Acct, _ := ts.GetAccount(common.CONSUMER, 0)
+	_, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+
+	cuSum := ts.spec.ApiCollections[0].Apis[0].ComputeUnits * 10
+
+	// schedule the spec change to the next epoch, like a spec proposal would
+	// (no TxProposalAddSpecs because the mock spec does not pass validaton)
+	spec := ts.spec
+	spec.Enabled = false
+	err := ts.Keepers.Spec.SetSpecVersion(ts.Ctx, spec, ts.GetNextEpoch())
+	require.NoError(t, err)
+
+	// the spec is still enabled for the current epoch
+	relaySession := ts.newRelaySession(providerAddr, 1, cuSum, ts.BlockHeight(), 0)
+	sig, err := sigs.Sign(client1Acct.SK, *relaySession)
+	relaySession.Sig = sig
+	require.NoError(t, err)
+	_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+	require.NoError(t, err)
+
+	ts.AdvanceEpoch()
+
+	// relays of the previous epoch are still valid
+	relaySession = ts.newRelaySession(providerAddr, 2, cuSum, ts.BlockHeight()-ts.EpochBlocks(), 0)
+	sig, err = sigs.Sign(client1Acct.SK, *relaySession)
+	relaySession.Sig = sig
+	require.NoError(t, err)
+	_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+	require.NoError(t, err)
+
+	// but the spec is disabled for the new epoch
+	relaySession = ts.newRelaySession(providerAddr, 3, cuSum, ts.BlockHeight(), 0)
+	sig, err = sigs.Sign(client1Acct.SK, *relaySession)
+	relaySession.Sig = sig
+	require.NoError(t, err)
+	_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+	require.Error(t, err)
+}
+
+// TestRelayPaymentSpecMigration checks that relays of the epoch before the spec
+// store migration (v4 -> v5) are still paid after it
+func TestRelayPaymentSpecMigration(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
+
+	client1Acct, _ := ts.GetAccount(common.CONSUMER, 0)
+	_, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+
+	cuSum := ts.spec.ApiCollections[0].Apis[0].ComputeUnits * 10
+
+	// the upgrade happens in the middle of an epoch
+	epochBefore := ts.EpochStart()
+	ts.AdvanceBlock()
+
+	// rewind the spec store to its v4 layout: only the legacy spec store
+	specStore := ts.Ctx.MultiStore().(interface {
+		GetStoreByName(name string) storetypes.Store
+	}).GetStoreByName(spectypes.StoreKey).(storetypes.KVStore)
+	iterator := specStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		specStore.Delete(key)
+	}
+	specBytes, err := ts.spec.Marshal()
+	require.NoError(t, err)
+	specStore.Set(append(spectypes.KeyPrefix(spectypes.SpecKeyPrefix), spectypes.SpecKey(ts.spec.Index)...), specBytes)
+
+	err = speckeeper.NewMigrator(ts.Keepers.Spec).Migrate4to5(ts.Ctx)
+	require.NoError(t, err)
+
+	ts.AdvanceEpoch()
+
+	// a relay of the epoch before the migration is paid
+	relaySession := ts.newRelaySession(providerAddr, 1, cuSum, epochBefore, 0)
+	sig, err := sigs.Sign(client1Acct.SK, *relaySession)
+	relaySession.Sig = sig
+	require.NoError(t, err)
+	_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+	require.NoError(t, err)
+}
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+func (k msgServer) ScheduleMaintenance(goCtx context.Context, msg *types.MsgScheduleMaintenance) (*types.MsgScheduleMaintenanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	startBlock, endBlock, err := k.Keeper.ScheduleMaintenance(ctx, msg.Creator, msg.ChainIds, msg.StartInEpochs, msg.Duration, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleMaintenanceResponse{StartBlock: startBlock, EndBlock: endBlock}, nil
+}
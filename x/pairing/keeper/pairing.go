@@ -17,11 +17,6 @@ import (
 )
 
 func (k Keeper) VerifyPairingData(ctx sdk.Context, chainID string, block uint64) (epoch uint64, providersType spectypes.Spec_ProvidersTypes, errorRet error) {
-	// TODO: add support for spec changes
-	foundAndActive, _, providersType := k.specKeeper.IsSpecFoundAndActive(ctx, chainID)
-	if !foundAndActive {
-		return 0, providersType, fmt.Errorf("spec not found and active for chainID given: %s", chainID)
-	}
 	earliestSavedEpoch := k.epochStorageKeeper.GetEarliestEpochStart(ctx)
 	if block < earliestSavedEpoch {
 		return 0, providersType, fmt.Errorf("block %d is earlier than earliest saved block %d", block, earliestSavedEpoch)
@@ -33,6 +28,12 @@ func (k Keeper) VerifyPairingData(ctx sdk.Context, chainID string, block uint64)
 	}
 	currentEpochStart := k.epochStorageKeeper.GetEpochStart(ctx)
 
+	// use the spec version that was in effect at the requested epoch
+	foundAndActive, _, providersType := k.specKeeper.IsSpecFoundAndActiveForBlock(ctx, chainID, requestedEpochStart)
+	if !foundAndActive {
+		return 0, providersType, fmt.Errorf("spec not found and active for chainID given: %s", chainID)
+	}
+
 	if requestedEpochStart > currentEpochStart {
 		return 0, providersType, utils.LavaFormatWarning("VerifyPairing requested epoch is too new", fmt.Errorf("cant get epoch start for future block"),
 			utils.Attribute{Key: "requested block", Value: block},
@@ -73,13 +74,13 @@ func (k Keeper) GetPairingForClient(ctx sdk.Context, chainID string, clientAddre
 		return nil, err
 	}
 
-	providers, _, err = k.getPairingForClient(ctx, chainID, uint64(ctx.BlockHeight()), project)
+	providers, _, err = k.getPairingForClient(ctx, chainID, uint64(ctx.BlockHeight()), project, clientAddress.String())
 	return providers, err
 }
 
 // function used to get a new pairing from provider and client
 // first argument has all metadata, second argument is only the addresses
-func (k Keeper) getPairingForClient(ctx sdk.Context, chainID string, block uint64, project projectstypes.Project) (providers []epochstoragetypes.StakeEntry, allowedCU uint64, errorRet error) {
+func (k Keeper) getPairingForClient(ctx sdk.Context, chainID string, block uint64, project projectstypes.Project, developerKey string) (providers []epochstoragetypes.StakeEntry, allowedCU uint64, errorRet error) {
 	var strictestPolicy *planstypes.Policy
 
 	epoch, providersType, err := k.VerifyPairingData(ctx, chainID, block)
@@ -91,7 +92,7 @@ func (k Keeper) getPairingForClient(ctx sdk.Context, chainID string, block uint6
 		return nil, 0, fmt.Errorf("did not find providers for pairing: epoch:%d, chainID: %s", block, chainID)
 	}
 
-	strictestPolicy, cluster, err := k.GetProjectStrictestPolicy(ctx, project, chainID, block)
+	strictestPolicy, cluster, err := k.GetProjectStrictestPolicy(ctx, project, developerKey, chainID, block)
 	if err != nil {
 		return nil, 0, fmt.Errorf("invalid user for pairing: %s", err.Error())
 	}
@@ -136,7 +137,9 @@ func (k Keeper) getPairingForClient(ctx sdk.Context, chainID string, block uint6
 	return providers, strictestPolicy.EpochCuLimit, err
 }
 
-func (k Keeper) GetProjectStrictestPolicy(ctx sdk.Context, project projectstypes.Project, chainID string, block uint64) (*planstypes.Policy, string, error) {
+// GetProjectStrictestPolicy merges the plan policy, the project's subscription and admin policies
+// and the policy of the developer key (if it has one) into the strictest policy
+func (k Keeper) GetProjectStrictestPolicy(ctx sdk.Context, project projectstypes.Project, developerKey string, chainID string, block uint64) (*planstypes.Policy, string, error) {
 	plan, err := k.subscriptionKeeper.GetPlanFromSubscription(ctx, project.GetSubscription(), block)
 	if err != nil {
 		return nil, "", err
@@ -150,6 +153,11 @@ func (k Keeper) GetProjectStrictestPolicy(ctx sdk.Context, project projectstypes
 	if project.AdminPolicy != nil {
 		policies = append(policies, project.AdminPolicy)
 	}
+	projectPolicies := policies
+	keyPolicy := project.GetDeveloperKeyPolicy(developerKey)
+	if keyPolicy != nil {
+		policies = append(policies, keyPolicy)
+	}
 	chainPolicy, allowed := planstypes.GetStrictestChainPolicyForSpec(chainID, policies)
 	if !allowed {
 		return nil, "", fmt.Errorf("chain ID not allowed in all policies, or collections specified and have no intersection %#v", policies)
@@ -168,7 +176,8 @@ func (k Keeper) GetProjectStrictestPolicy(ctx sdk.Context, project projectstypes
 	if !found {
 		return nil, "", fmt.Errorf("could not find subscription with address %s", project.GetSubscription())
 	}
-	allowedCUEpoch, allowedCUTotal := k.CalculateEffectiveAllowedCuPerEpochFromPolicies(policies, project.GetUsedCu(), sub.GetMonthCuLeft())
+	allowedCUEpoch, allowedCUTotal := k.CalculateEffectiveAllowedCuPerEpochFromPolicies(projectPolicies, project.GetUsedCu(), sub.GetMonthCuLeft())
+	allowedCUEpoch, allowedCUTotal = k.CalculateDeveloperKeyAllowedCu(project, developerKey, allowedCUEpoch, allowedCUTotal)
 
 	selectedProvidersMode, selectedProvidersList := k.CalculateEffectiveSelectedProviders(policies)
 
@@ -264,7 +273,35 @@ func (k Keeper) CalculateEffectiveAllowedCuPerEpochFromPolicies(policies []*plan
 	return lavaslices.Min(slice), effectiveTotalCuOfProject
 }
 
-func (k Keeper) ValidatePairingForClient(ctx sdk.Context, chainID string, providerAddress sdk.AccAddress, reqEpoch uint64, project projectstypes.Project) (isValidPairing bool, allowedCU uint64, pairedProviders []epochstoragetypes.StakeEntry, errorRet error) {
+// CalculateDeveloperKeyAllowedCu limits the CU allowed by the project's policies with the developer key's
+// policy. Unlike the project's policies, the key's total CU limit applies to the CU used by the key
+func (k Keeper) CalculateDeveloperKeyAllowedCu(project projectstypes.Project, developerKey string, allowedCUThisEpoch, allowedCUTotal uint64) (uint64, uint64) {
+	keyPolicy := project.GetDeveloperKeyPolicy(developerKey)
+	if keyPolicy == nil {
+		return allowedCUThisEpoch, allowedCUTotal
+	}
+
+	if keyPolicy.EpochCuLimit != 0 {
+		allowedCUThisEpoch = lavaslices.Min([]uint64{allowedCUThisEpoch, keyPolicy.EpochCuLimit})
+	}
+	if keyPolicy.TotalCuLimit != 0 {
+		allowedCUThisEpoch = lavaslices.Min([]uint64{allowedCUThisEpoch, developerKeyCuLeft(project, developerKey, keyPolicy)})
+		allowedCUTotal = lavaslices.Min([]uint64{allowedCUTotal, keyPolicy.TotalCuLimit})
+	}
+
+	return allowedCUThisEpoch, allowedCUTotal
+}
+
+// developerKeyCuLeft returns the CU a developer key can still use under its policy's total CU limit
+func developerKeyCuLeft(project projectstypes.Project, developerKey string, keyPolicy *planstypes.Policy) uint64 {
+	usedCu := project.GetKey(developerKey).UsedCu
+	if usedCu >= keyPolicy.TotalCuLimit {
+		return 0
+	}
+	return keyPolicy.TotalCuLimit - usedCu
+}
+
+func (k Keeper) ValidatePairingForClient(ctx sdk.Context, chainID string, providerAddress sdk.AccAddress, reqEpoch uint64, project projectstypes.Project, developerKey string) (isValidPairing bool, allowedCU uint64, pairedProviders []epochstoragetypes.StakeEntry, errorRet error) {
 	epoch, _, err := k.epochStorageKeeper.GetEpochStartForBlock(ctx, reqEpoch)
 	if err != nil {
 		return false, allowedCU, []epochstoragetypes.StakeEntry{}, err
@@ -277,7 +314,7 @@ func (k Keeper) ValidatePairingForClient(ctx sdk.Context, chainID string, provid
 		return false, allowedCU, []epochstoragetypes.StakeEntry{}, err
 	}
 
-	validAddresses, allowedCU, err := k.getPairingForClient(ctx, chainID, epoch, project)
+	validAddresses, allowedCU, err := k.getPairingForClient(ctx, chainID, epoch, project, developerKey)
 	if err != nil {
 		return false, allowedCU, []epochstoragetypes.StakeEntry{}, err
 	}
@@ -542,3 +542,77 @@ func TestAddProjectAfterPlanUpdate(t *testing.T) {
 	// to the second project (since it's under a subscription that uses the old plan)
 	require.Equal(t, adminPolicy.EpochCuLimit, verify.CuPerEpoch)
 }
+
+func TestDeveloperKeyPolicy(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(0, 0, 1)    // 0 sub, 0 adm, 1 dev
+	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
+
+	_, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	dev1Acct, dev1Addr := ts.Account("dev1")
+
+	proj, err := ts.QueryProjectDeveloper(client1Addr)
+	require.NoError(t, err)
+	projectID := proj.Project.Index
+
+	// the developer key's policy is stricter than the plan policy
+	keyPolicy := ts.plan.PlanPolicy
+	keyPolicy.EpochCuLimit = ts.plan.PlanPolicy.EpochCuLimit / 2
+	keyPolicy.TotalCuLimit = keyPolicy.EpochCuLimit + keyPolicy.EpochCuLimit/2
+
+	err = ts.TxProjectAddKeys(projectID, client1Addr, projectstypes.ProjectDeveloperKey(dev1Addr).WithPolicy(&keyPolicy))
+	require.NoError(t, err)
+
+	ts.AdvanceEpoch()
+
+	res, err := ts.QueryPairingEffectivePolicy(ts.spec.Index, dev1Addr)
+	require.NoError(t, err)
+	require.Equal(t, keyPolicy.EpochCuLimit, res.Policy.EpochCuLimit)
+	require.Equal(t, keyPolicy.TotalCuLimit, res.Policy.TotalCuLimit)
+
+	// other keys of the project are not affected
+	res, err = ts.QueryPairingEffectivePolicy(ts.spec.Index, client1Addr)
+	require.NoError(t, err)
+	require.Equal(t, ts.plan.PlanPolicy.EpochCuLimit, res.Policy.EpochCuLimit)
+
+	// use CU with the developer key, the key's used CU is tracked
+	relaySession := ts.newRelaySession(providerAddr, 1, keyPolicy.EpochCuLimit, ts.BlockHeight(), 0)
+	relaySession.Sig, err = sigs.Sign(dev1Acct.SK, *relaySession)
+	require.NoError(t, err)
+	_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+	require.NoError(t, err)
+
+	proj, err = ts.QueryProjectDeveloper(dev1Addr)
+	require.NoError(t, err)
+	require.Equal(t, keyPolicy.EpochCuLimit, proj.Project.GetKey(dev1Addr).UsedCu)
+
+	ts.AdvanceEpoch()
+
+	// the key's CU allowance in the epoch is capped by the CU left of its total limit
+	res, err = ts.QueryPairingEffectivePolicy(ts.spec.Index, dev1Addr)
+	require.NoError(t, err)
+	require.Equal(t, keyPolicy.TotalCuLimit-keyPolicy.EpochCuLimit, res.Policy.EpochCuLimit)
+
+	// only an admin can set the policy of a developer key
+	_, err = ts.TxProjectSetDeveloperKeyPolicy(projectID, dev1Addr, dev1Addr, nil)
+	require.Error(t, err)
+	// only developer keys of the project can have a policy
+	_, err = ts.TxProjectSetDeveloperKeyPolicy(projectID, client1Addr, providerAddr, nil)
+	require.Error(t, err)
+
+	// remove the key's policy, applied in the next epoch
+	_, err = ts.TxProjectSetDeveloperKeyPolicy(projectID, client1Addr, dev1Addr, nil)
+	require.NoError(t, err)
+
+	res, err = ts.QueryPairingEffectivePolicy(ts.spec.Index, dev1Addr)
+	require.NoError(t, err)
+	require.NotNil(t, res.PendingPolicy)
+	require.Equal(t, ts.plan.PlanPolicy.EpochCuLimit, res.PendingPolicy.EpochCuLimit)
+
+	ts.AdvanceEpoch()
+
+	res, err = ts.QueryPairingEffectivePolicy(ts.spec.Index, dev1Addr)
+	require.NoError(t, err)
+	require.Equal(t, ts.plan.PlanPolicy.EpochCuLimit, res.Policy.EpochCuLimit)
+}
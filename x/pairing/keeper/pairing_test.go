@@ -553,7 +553,7 @@ func TestAddonPairing(t *testing.T) {
 			project, err := ts.GetProjectForBlock(projectID, ts.BlockHeight())
 			require.NoError(t, err)
 
-			strictestPolicy, _, err := ts.Keepers.Pairing.GetProjectStrictestPolicy(ts.Ctx, project, specId, ts.BlockHeight())
+			strictestPolicy, _, err := ts.Keepers.Pairing.GetProjectStrictestPolicy(ts.Ctx, project, "", specId, ts.BlockHeight())
 			require.NoError(t, err)
 			if len(tt.expectedStrictestPolicies) > 0 {
 				require.NotEqual(t, 0, len(strictestPolicy.ChainPolicies))
@@ -1979,7 +1979,7 @@ func TestExtensionAndAddonPairing(t *testing.T) {
 			project, err := ts.GetProjectForBlock(projectID, ts.BlockHeight())
 			require.NoError(t, err)
 
-			strictestPolicy, _, err := ts.Keepers.Pairing.GetProjectStrictestPolicy(ts.Ctx, project, specId, ts.BlockHeight())
+			strictestPolicy, _, err := ts.Keepers.Pairing.GetProjectStrictestPolicy(ts.Ctx, project, "", specId, ts.BlockHeight())
 			require.NoError(t, err)
 			if len(tt.expectedStrictestPolicies) > 0 {
 				require.NotEqual(t, 0, len(strictestPolicy.ChainPolicies))
@@ -2130,7 +2130,7 @@ func TestMixSelectedProvidersAndArchivePairing(t *testing.T) {
 		project, err := ts.GetProjectForBlock(projectID, ts.BlockHeight())
 		require.NoError(t, err)
 
-		strictestPolicy, _, err := ts.Keepers.Pairing.GetProjectStrictestPolicy(ts.Ctx, project, specId, ts.BlockHeight())
+		strictestPolicy, _, err := ts.Keepers.Pairing.GetProjectStrictestPolicy(ts.Ctx, project, "", specId, ts.BlockHeight())
 		require.NoError(t, err)
 
 		require.NotEqual(t, 0, len(strictestPolicy.ChainPolicies))
@@ -14,6 +14,8 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.EpochBlocksOverlap(ctx),
 		k.QoSWeight(ctx),
 		k.RecommendedEpochNumToCollectPayment(ctx),
+		k.MaxMaintenanceDuration(ctx),
+		k.MaintenanceCooldown(ctx),
 	)
 }
 
@@ -56,3 +58,15 @@ func (k Keeper) RecommendedEpochNumToCollectPayment(ctx sdk.Context) (res uint64
 func (k Keeper) SetRecommendedEpochNumToCollectPayment(ctx sdk.Context, val uint64) {
 	k.paramstore.Set(ctx, types.KeyRecommendedEpochNumToCollectPayment, val)
 }
+
+// MaxMaintenanceDuration returns the MaxMaintenanceDuration param
+func (k Keeper) MaxMaintenanceDuration(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxMaintenanceDuration, &res)
+	return
+}
+
+// MaintenanceCooldown returns the MaintenanceCooldown param
+func (k Keeper) MaintenanceCooldown(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaintenanceCooldown, &res)
+	return
+}
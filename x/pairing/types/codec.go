@@ -14,6 +14,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRelayPayment{}, "pairing/RelayPayment", nil)
 	cdc.RegisterConcrete(&MsgFreezeProvider{}, "pairing/Freeze", nil)
 	cdc.RegisterConcrete(&MsgUnfreezeProvider{}, "pairing/Unfreeze", nil)
+	cdc.RegisterConcrete(&MsgScheduleMaintenance{}, "pairing/ScheduleMaintenance", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -33,6 +34,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUnfreezeProvider{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgScheduleMaintenance{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
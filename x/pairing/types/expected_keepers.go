@@ -22,8 +22,11 @@ import (
 type SpecKeeper interface {
 	// Methods imported from spec should be defined here
 	IsSpecFoundAndActive(ctx sdk.Context, chainID string) (foundAndActive, found bool, providersType spectypes.Spec_ProvidersTypes)
+	IsSpecFoundAndActiveForBlock(ctx sdk.Context, chainID string, block uint64) (foundAndActive, found bool, providersType spectypes.Spec_ProvidersTypes)
 	GetExpandedSpec(ctx sdk.Context, index string) (val spectypes.Spec, err error)
+	GetExpandedSpecForBlock(ctx sdk.Context, index string, block uint64) (val spectypes.Spec, err error)
 	GetSpec(ctx sdk.Context, index string) (val spectypes.Spec, found bool) // this spec is unexpanded don;t use for collections work
+	GetSpecForBlock(ctx sdk.Context, index string, block uint64) (val spectypes.Spec, found bool)
 	GetExpectedServicesForExpandedSpec(expandedSpec spectypes.Spec, mandatory bool) map[epochstoragetypes.EndpointService]struct{}
 	GetAllChainIDs(ctx sdk.Context) (chainIDs []string)
 	GetMinStake(ctx sdk.Context, chainID string) sdk.Coin
@@ -79,7 +82,7 @@ type BankKeeper interface {
 }
 
 type ProjectsKeeper interface {
-	ChargeComputeUnitsToProject(ctx sdk.Context, project projectstypes.Project, block, cu uint64) (err error)
+	ChargeComputeUnitsToProject(ctx sdk.Context, project projectstypes.Project, developerKey string, block, cu uint64) (err error)
 	GetProjectForDeveloper(ctx sdk.Context, developerKey string, blockHeight uint64) (proj projectstypes.Project, errRet error)
 	GetProjectForBlock(ctx sdk.Context, projectID string, block uint64) (projectstypes.Project, error)
 }
@@ -92,6 +95,7 @@ type SubscriptionKeeper interface {
 	GetTrackedCu(ctx sdk.Context, sub string, provider string, chainID string, block uint64) (cu uint64, found bool, key string)
 	CalcTotalMonthlyReward(ctx sdk.Context, totalAmount math.Int, trackedCu uint64, totalCuUsedBySub uint64) math.Int
 	AddTrackedCu(ctx sdk.Context, sub string, provider string, chainID string, cu uint64, block uint64) error
+	RecordUsage(ctx sdk.Context, sub subscriptiontypes.Subscription, projectID string, developerKey string, provider string, chainID string, cu uint64)
 	GetAllSubscriptionsIndices(ctx sdk.Context) []string
 	AppendAdjustment(ctx sdk.Context, consumer string, provider string, totalConsumerUsage uint64, usageWithThisProvider uint64)
 }
@@ -19,6 +19,8 @@ func DefaultGenesis() *GenesisState {
 		BadgeUsedCuList:          []BadgeUsedCu{},
 		BadgesTS:                 *timerstoretypes.DefaultGenesis(),
 		ProviderQosFS:            *fixationtypes.DefaultGenesis(),
+		ProviderMaintenances:     []ProviderMaintenance{},
+		MaintenanceTS:            *timerstoretypes.DefaultGenesis(),
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -73,6 +75,15 @@ func (gs GenesisState) Validate() error {
 		return fmt.Errorf("badgeUsedCuList is not empty")
 	}
 
+	// Check for duplicated index in ProviderMaintenance
+	providerMaintenanceIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ProviderMaintenances {
+		if _, ok := providerMaintenanceIndexMap[elem.Provider]; ok {
+			return fmt.Errorf("duplicated index for ProviderMaintenance")
+		}
+		providerMaintenanceIndexMap[elem.Provider] = struct{}{}
+	}
+
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -87,6 +87,8 @@ type GenesisState struct {
 	ProviderEpochCus           []ProviderEpochCuGenesis           `protobuf:"bytes,9,rep,name=provider_epoch_cus,json=providerEpochCus,proto3" json:"provider_epoch_cus"`
 	ProviderEpochComplainedCus []ProviderEpochComplainerCuGenesis `protobuf:"bytes,10,rep,name=provider_epoch_complained_cus,json=providerEpochComplainedCus,proto3" json:"provider_epoch_complained_cus"`
 	ProviderConsumerEpochCus   []ProviderConsumerEpochCuGenesis   `protobuf:"bytes,11,rep,name=provider_consumer_epoch_cus,json=providerConsumerEpochCus,proto3" json:"provider_consumer_epoch_cus"`
+	ProviderMaintenances       []ProviderMaintenance              `protobuf:"bytes,12,rep,name=provider_maintenances,json=providerMaintenances,proto3" json:"provider_maintenances"`
+	MaintenanceTS              types.GenesisState                 `protobuf:"bytes,13,opt,name=maintenanceTS,proto3" json:"maintenanceTS"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -178,6 +180,20 @@ func (m *GenesisState) GetProviderConsumerEpochCus() []ProviderConsumerEpochCuGe
 	return nil
 }
 
+func (m *GenesisState) GetProviderMaintenances() []ProviderMaintenance {
+	if m != nil {
+		return m.ProviderMaintenances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMaintenanceTS() types.GenesisState {
+	if m != nil {
+		return m.MaintenanceTS
+	}
+	return types.GenesisState{}
+}
+
 type UniqueEpochSessionGenesis struct {
 	Epoch     uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	Provider  string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
@@ -480,51 +496,54 @@ func init() {
 }
 
 var fileDescriptor_dbd1e49b8b57595b = []byte{
-	// 693 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x41, 0x53, 0xd3, 0x40,
-	0x18, 0x6d, 0x68, 0x5a, 0xca, 0x57, 0xd4, 0xb2, 0xa2, 0x84, 0x0a, 0xb1, 0xc4, 0x71, 0x2c, 0x33,
-	0x9a, 0xcc, 0xa0, 0xe3, 0xc1, 0x9b, 0x30, 0xca, 0x80, 0x1e, 0xa4, 0x85, 0x71, 0xc6, 0x4b, 0x4c,
-	0x93, 0xb5, 0xac, 0xd2, 0xec, 0x9a, 0x4d, 0x18, 0x7a, 0xf2, 0x2f, 0x78, 0xf3, 0xec, 0x1f, 0xf1,
-	0xcc, 0x91, 0xa3, 0x27, 0xc7, 0x81, 0x83, 0xbf, 0xc1, 0x9b, 0x93, 0xcd, 0xb6, 0x34, 0x25, 0x41,
-	0x71, 0x1c, 0x4f, 0xc9, 0x66, 0xdf, 0xf7, 0xde, 0xf7, 0xb2, 0xef, 0x9b, 0x05, 0x63, 0xcf, 0xd9,
-	0x77, 0x7c, 0x1c, 0x5a, 0xf1, 0xd3, 0x62, 0x0e, 0x09, 0x88, 0xdf, 0xb5, 0xba, 0xd8, 0xc7, 0x9c,
-	0x70, 0x93, 0x05, 0x34, 0xa4, 0x68, 0x56, 0x62, 0xcc, 0xf8, 0x69, 0x4a, 0x4c, 0x7d, 0xb6, 0x4b,
-	0xbb, 0x54, 0x00, 0xac, 0xf8, 0x2d, 0xc1, 0xd6, 0x97, 0x32, 0xf9, 0x98, 0x13, 0x38, 0x3d, 0x49,
-	0x57, 0xbf, 0x95, 0x09, 0xc1, 0x8c, 0xba, 0xbb, 0xb6, 0x1b, 0x49, 0xd0, 0x72, 0x0a, 0xf4, 0x86,
-	0x1c, 0x38, 0x21, 0xa1, 0x3e, 0x0f, 0x69, 0x80, 0x87, 0xab, 0x4c, 0xbe, 0x90, 0xf4, 0x70, 0x90,
-	0xe0, 0xc4, 0x6b, 0x02, 0x32, 0xb6, 0xa0, 0xba, 0xea, 0x78, 0x5d, 0xbc, 0xc3, 0xb1, 0xb7, 0x16,
-	0xa1, 0x65, 0x98, 0xe9, 0xc4, 0x4b, 0x3b, 0xe2, 0xd8, 0xb3, 0xdd, 0xc8, 0x7e, 0x87, 0xfb, 0x9a,
-	0xd2, 0x50, 0x9a, 0xd3, 0xad, 0xcb, 0x9d, 0x53, 0xdc, 0x33, 0xdc, 0x47, 0x73, 0x30, 0x29, 0x41,
-	0xda, 0x44, 0x43, 0x69, 0xaa, 0xad, 0x72, 0x24, 0xf6, 0x8c, 0x4f, 0x65, 0x98, 0x5e, 0x4f, 0x7e,
-	0x54, 0x3b, 0x74, 0x42, 0x8c, 0x1e, 0x41, 0x39, 0x31, 0x2a, 0x98, 0xaa, 0x2b, 0x0b, 0x66, 0xd6,
-	0x8f, 0x33, 0x5f, 0x08, 0xcc, 0xaa, 0x7a, 0xf8, 0xed, 0x66, 0xa1, 0x25, 0x2b, 0xd0, 0x16, 0x5c,
-	0x19, 0xd1, 0x7d, 0x4e, 0x78, 0xa8, 0x95, 0x1a, 0xc5, 0x66, 0x75, 0x65, 0x29, 0x9b, 0x64, 0xc4,
-	0x8c, 0x64, 0x1a, 0xaf, 0x47, 0xeb, 0x50, 0x11, 0x9f, 0xf8, 0x76, 0x5b, 0x2b, 0x8b, 0x86, 0x6e,
-	0xa7, 0xb9, 0x4e, 0x7f, 0x95, 0x39, 0xea, 0x43, 0xf2, 0x0d, 0x8b, 0xd1, 0x36, 0x5c, 0x62, 0x01,
-	0xdd, 0x27, 0x1e, 0x0e, 0xb6, 0x28, 0x7f, 0xda, 0xd6, 0x26, 0x05, 0x5b, 0x33, 0xcd, 0x96, 0x3a,
-	0xa3, 0x2c, 0xc2, 0x34, 0x09, 0x22, 0x70, 0x2d, 0xf2, 0xc9, 0xfb, 0x08, 0xdb, 0xc9, 0xd1, 0x73,
-	0xcc, 0x79, 0x5c, 0xae, 0x55, 0x84, 0x6f, 0x2b, 0xdb, 0xf7, 0x8e, 0x28, 0x79, 0x12, 0x57, 0xb4,
-	0x93, 0x02, 0xa9, 0x24, 0x45, 0xae, 0x46, 0x67, 0x00, 0x1c, 0xbd, 0x06, 0x34, 0xd0, 0xb6, 0x07,
-	0x39, 0xe3, 0xda, 0x94, 0xd0, 0xb9, 0x9b, 0x73, 0x48, 0x12, 0x2f, 0x88, 0xd6, 0xa2, 0xb4, 0x48,
-	0x8d, 0xa5, 0x77, 0x39, 0xfa, 0x00, 0x8b, 0xe3, 0x0a, 0xb4, 0xc7, 0xf6, 0x1c, 0xe2, 0x8b, 0xe4,
-	0x70, 0x0d, 0x84, 0xd8, 0xc3, 0x3f, 0x11, 0x1b, 0x14, 0x06, 0xe3, 0xb2, 0x75, 0x96, 0x89, 0xf3,
-	0xe2, 0x06, 0xfa, 0x70, 0x63, 0xd8, 0x80, 0x4b, 0x7d, 0x1e, 0xf5, 0x52, 0x5e, 0xab, 0x42, 0xfe,
-	0xc1, 0xf9, 0xf2, 0x6b, 0xb2, 0x2e, 0xd3, 0xb3, 0xc6, 0xb2, 0x51, 0x7c, 0x53, 0xad, 0x4c, 0xd4,
-	0x8a, 0x9b, 0x6a, 0xa5, 0x58, 0x53, 0x37, 0xd5, 0x8a, 0x5a, 0x2b, 0x19, 0x9f, 0x15, 0x98, 0xcf,
-	0x3d, 0x28, 0x34, 0x0b, 0x25, 0xd1, 0x98, 0x98, 0x12, 0xb5, 0x95, 0x2c, 0x50, 0x1d, 0x2a, 0x03,
-	0x05, 0x31, 0x67, 0x53, 0xad, 0xe1, 0x1a, 0x69, 0x30, 0xc9, 0x02, 0xfa, 0x16, 0xbb, 0xa1, 0x56,
-	0x14, 0x5b, 0x83, 0x25, 0x9a, 0x87, 0x8a, 0xbb, 0xeb, 0x10, 0xdf, 0x26, 0x9e, 0xa6, 0x26, 0x5b,
-	0x62, 0xbd, 0xe1, 0xa1, 0x45, 0x00, 0x19, 0xa9, 0x78, 0xb3, 0x24, 0xb4, 0xa6, 0xe4, 0x97, 0x0d,
-	0xcf, 0xf8, 0xa2, 0xc0, 0xf5, 0xec, 0x43, 0xfe, 0x8b, 0x06, 0x47, 0xdb, 0x28, 0xa6, 0xdb, 0x78,
-	0x09, 0x33, 0x67, 0xb2, 0x27, 0x5a, 0x3d, 0x33, 0x8e, 0x39, 0xd1, 0x1b, 0x8c, 0xf7, 0x58, 0xe6,
-	0x8c, 0x1f, 0x0a, 0x34, 0x7e, 0x17, 0x9c, 0x7f, 0x6b, 0x65, 0x1f, 0x16, 0xf2, 0x42, 0x1e, 0x9c,
-	0xba, 0xb2, 0x2e, 0x98, 0x71, 0xe9, 0x6f, 0x9e, 0xe5, 0x01, 0x8c, 0x9f, 0x0a, 0xe8, 0xe7, 0x67,
-	0xf4, 0x7f, 0x65, 0x8a, 0x41, 0x3d, 0x7f, 0xca, 0x44, 0xc6, 0xaa, 0x2b, 0xf7, 0x2e, 0x34, 0x64,
-	0xd2, 0xfd, 0x5c, 0xce, 0x74, 0xad, 0x3e, 0x3e, 0x3c, 0xd6, 0x95, 0xa3, 0x63, 0x5d, 0xf9, 0x7e,
-	0xac, 0x2b, 0x1f, 0x4f, 0xf4, 0xc2, 0xd1, 0x89, 0x5e, 0xf8, 0x7a, 0xa2, 0x17, 0x5e, 0xdd, 0xe9,
-	0x92, 0x70, 0x37, 0xea, 0x98, 0x2e, 0xed, 0x59, 0xa9, 0x1b, 0xf0, 0x60, 0x78, 0xa7, 0x86, 0x7d,
-	0x86, 0x79, 0xa7, 0x2c, 0x6e, 0xc0, 0xfb, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x79, 0x43, 0xa2,
-	0x96, 0xeb, 0x07, 0x00, 0x00,
+	// 743 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0x8e, 0x1b, 0xe7, 0x4f, 0x27, 0xed, 0xef, 0x97, 0x2e, 0x29, 0xdd, 0x86, 0x36, 0xa4, 0x41,
+	0x40, 0x2a, 0x81, 0x2d, 0x15, 0xc4, 0x81, 0x1b, 0xad, 0xa0, 0x6a, 0x01, 0x89, 0x26, 0xad, 0x90,
+	0xb8, 0x18, 0xc7, 0x5e, 0xd2, 0x85, 0xc6, 0x5e, 0xbc, 0x76, 0xd5, 0x9c, 0x78, 0x05, 0x5e, 0x81,
+	0x17, 0xe1, 0xdc, 0x63, 0x8f, 0x9c, 0x10, 0x6a, 0x0f, 0x3c, 0x03, 0x37, 0xe4, 0xf5, 0xe6, 0x8f,
+	0x53, 0xbb, 0x50, 0x84, 0x38, 0xd9, 0xe3, 0xf9, 0xe6, 0xfb, 0x66, 0xc6, 0x33, 0xbb, 0xd0, 0x38,
+	0x30, 0x0f, 0x4d, 0x87, 0xf8, 0x7a, 0xf8, 0xd4, 0x99, 0x49, 0x3d, 0xea, 0x74, 0xf5, 0x2e, 0x71,
+	0x08, 0xa7, 0x5c, 0x63, 0x9e, 0xeb, 0xbb, 0xa8, 0x22, 0x31, 0x5a, 0xf8, 0xd4, 0x24, 0xa6, 0x5a,
+	0xe9, 0xba, 0x5d, 0x57, 0x00, 0xf4, 0xf0, 0x2d, 0xc2, 0x56, 0x57, 0x12, 0xf9, 0x98, 0xe9, 0x99,
+	0x3d, 0x49, 0x57, 0xbd, 0x91, 0x08, 0x21, 0xcc, 0xb5, 0xf6, 0x0d, 0x2b, 0x90, 0xa0, 0xd5, 0x18,
+	0xe8, 0x0d, 0x3d, 0x32, 0x7d, 0xea, 0x3a, 0xdc, 0x77, 0x3d, 0x32, 0xb4, 0x12, 0xf9, 0x7c, 0xda,
+	0x23, 0x5e, 0x84, 0x13, 0xaf, 0x12, 0x74, 0x2b, 0x51, 0xb4, 0x67, 0x52, 0xc7, 0x27, 0x8e, 0xe9,
+	0x58, 0x24, 0xc2, 0x35, 0x76, 0xa0, 0xb4, 0x6e, 0xda, 0x5d, 0xb2, 0xc7, 0x89, 0xbd, 0x11, 0xa0,
+	0x55, 0x98, 0xeb, 0x84, 0xa6, 0x11, 0x70, 0x62, 0x1b, 0x56, 0x60, 0xbc, 0x23, 0x7d, 0xac, 0xd4,
+	0x95, 0xe6, 0x4c, 0xeb, 0xbf, 0xce, 0x08, 0xf7, 0x94, 0xf4, 0xd1, 0x02, 0x14, 0x24, 0x08, 0x4f,
+	0xd5, 0x95, 0xa6, 0xda, 0xca, 0x07, 0xc2, 0xd7, 0x38, 0x29, 0xc0, 0xcc, 0x66, 0xd4, 0xd0, 0xb6,
+	0x6f, 0xfa, 0x04, 0x3d, 0x84, 0x7c, 0xd4, 0x10, 0xc1, 0x54, 0x5a, 0x5b, 0xd2, 0x92, 0x1a, 0xac,
+	0xbd, 0x10, 0x98, 0x75, 0xf5, 0xf8, 0xeb, 0xf5, 0x4c, 0x4b, 0x46, 0xa0, 0x1d, 0xf8, 0x7f, 0x4c,
+	0xf7, 0x19, 0xe5, 0x3e, 0xce, 0xd5, 0xb3, 0xcd, 0xd2, 0xda, 0x4a, 0x32, 0xc9, 0x58, 0x31, 0x92,
+	0x69, 0x32, 0x1e, 0x6d, 0x42, 0x51, 0x7c, 0xe2, 0xbb, 0x6d, 0x9c, 0x17, 0x09, 0xdd, 0x8c, 0x73,
+	0x8d, 0x5a, 0xaa, 0x8d, 0xd7, 0x21, 0xf9, 0x86, 0xc1, 0x68, 0x17, 0x66, 0x99, 0xe7, 0x1e, 0x52,
+	0x9b, 0x78, 0x3b, 0x2e, 0x7f, 0xd2, 0xc6, 0x05, 0xc1, 0xd6, 0x8c, 0xb3, 0xc5, 0xfe, 0x65, 0x12,
+	0x61, 0x9c, 0x04, 0x51, 0x98, 0x0f, 0x1c, 0xfa, 0x3e, 0x20, 0x46, 0x34, 0x22, 0x9c, 0x70, 0x1e,
+	0x86, 0xe3, 0xa2, 0xa8, 0x5b, 0x4f, 0xae, 0x7b, 0x4f, 0x84, 0x3c, 0x0e, 0x23, 0xda, 0x51, 0x80,
+	0x54, 0x92, 0x22, 0x57, 0x82, 0x73, 0x00, 0x8e, 0x5e, 0x03, 0x1a, 0x68, 0x1b, 0x83, 0x79, 0xe4,
+	0x78, 0x5a, 0xe8, 0xdc, 0x49, 0xf9, 0x49, 0x12, 0x2f, 0x88, 0x36, 0x82, 0xb8, 0x48, 0x99, 0xc5,
+	0xbd, 0x1c, 0x7d, 0x80, 0xe5, 0x49, 0x05, 0xb7, 0xc7, 0x0e, 0x4c, 0xea, 0x88, 0xc9, 0xe1, 0x18,
+	0x84, 0xd8, 0x83, 0xdf, 0x11, 0x1b, 0x04, 0x7a, 0x93, 0xb2, 0x55, 0x96, 0x88, 0xb3, 0xc3, 0x04,
+	0xfa, 0x70, 0x6d, 0x98, 0x80, 0xe5, 0x3a, 0x3c, 0xe8, 0xc5, 0x6a, 0x2d, 0x09, 0xf9, 0xfb, 0x17,
+	0xcb, 0x6f, 0xc8, 0xb8, 0xc4, 0x9a, 0x31, 0x4b, 0x46, 0x71, 0x64, 0xc3, 0xfc, 0x50, 0x7a, 0x6c,
+	0xf1, 0x38, 0x9e, 0x11, 0xa2, 0xab, 0x17, 0x8b, 0x3e, 0x1f, 0x45, 0x48, 0xa5, 0x0a, 0x3b, 0xef,
+	0x0a, 0x17, 0x64, 0x76, 0x8c, 0x7c, 0xb7, 0x8d, 0x67, 0x2f, 0x3f, 0xd2, 0x71, 0x86, 0x6d, 0xb5,
+	0x38, 0x55, 0xce, 0x6e, 0xab, 0xc5, 0x6c, 0x59, 0xdd, 0x56, 0x8b, 0x6a, 0x39, 0xd7, 0xf8, 0xa4,
+	0xc0, 0x62, 0xea, 0x84, 0xa1, 0x0a, 0xe4, 0x44, 0x47, 0xc5, 0x7a, 0xab, 0xad, 0xc8, 0x40, 0x55,
+	0x28, 0x0e, 0x12, 0x16, 0x07, 0xc4, 0x74, 0x6b, 0x68, 0x23, 0x0c, 0x05, 0xe6, 0xb9, 0x6f, 0x89,
+	0xe5, 0xe3, 0xac, 0x70, 0x0d, 0x4c, 0xb4, 0x08, 0x45, 0x6b, 0xdf, 0xa4, 0x8e, 0x41, 0x6d, 0xac,
+	0x46, 0x2e, 0x61, 0x6f, 0xd9, 0x68, 0x19, 0x40, 0xee, 0x42, 0xe8, 0xcc, 0x09, 0xad, 0x69, 0xf9,
+	0x65, 0xcb, 0x6e, 0x7c, 0x56, 0xe0, 0x6a, 0xf2, 0x74, 0xfe, 0x41, 0x82, 0xe3, 0x69, 0x64, 0xe3,
+	0x69, 0xbc, 0x84, 0xb9, 0x73, 0x4b, 0x83, 0xd5, 0xa4, 0xa6, 0xa7, 0xec, 0xcc, 0xe0, 0x5c, 0x9a,
+	0x58, 0x96, 0xc6, 0x77, 0x05, 0xea, 0xbf, 0x9a, 0xf8, 0xbf, 0x5b, 0xca, 0x21, 0x2c, 0xa5, 0x6d,
+	0xa7, 0x37, 0xaa, 0x4a, 0xbf, 0xe4, 0x72, 0xca, 0xfa, 0x16, 0x59, 0x1a, 0xa0, 0xf1, 0x43, 0x81,
+	0xda, 0xc5, 0xcb, 0xf5, 0xaf, 0x66, 0x8a, 0x41, 0x35, 0xfd, 0x78, 0x10, 0x33, 0x56, 0x5a, 0xbb,
+	0x7b, 0xa9, 0xd3, 0x41, 0x56, 0xbf, 0x90, 0x72, 0x2c, 0xac, 0x3f, 0x3a, 0x3e, 0xad, 0x29, 0x27,
+	0xa7, 0x35, 0xe5, 0xdb, 0x69, 0x4d, 0xf9, 0x78, 0x56, 0xcb, 0x9c, 0x9c, 0xd5, 0x32, 0x5f, 0xce,
+	0x6a, 0x99, 0x57, 0xb7, 0xbb, 0xd4, 0xdf, 0x0f, 0x3a, 0x9a, 0xe5, 0xf6, 0xf4, 0xd8, 0xed, 0x7d,
+	0x34, 0xbc, 0xbf, 0xfd, 0x3e, 0x23, 0xbc, 0x93, 0x17, 0x57, 0xf7, 0xbd, 0x9f, 0x03, 0x00, 0x7c,
+	0xf8, 0xe9, 0xa0, 0xcc, 0x08, 0x00, 0x00,
 }
 
 func (m *BadgeUsedCu) Marshal() (dAtA []byte, err error) {
@@ -582,6 +601,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.MaintenanceTS.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if len(m.ProviderMaintenances) > 0 {
+		for iNdEx := len(m.ProviderMaintenances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderMaintenances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.ProviderConsumerEpochCus) > 0 {
 		for iNdEx := len(m.ProviderConsumerEpochCus) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -971,6 +1014,14 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ProviderMaintenances) > 0 {
+		for _, e := range m.ProviderMaintenances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.MaintenanceTS.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1478,6 +1529,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderMaintenances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderMaintenances = append(m.ProviderMaintenances, ProviderMaintenance{})
+			if err := m.ProviderMaintenances[len(m.ProviderMaintenances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceTS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceTS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
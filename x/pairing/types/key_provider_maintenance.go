@@ -0,0 +1,23 @@
+package types
+
+const (
+	// ProviderMaintenanceKeyPrefix is the prefix to retrieve all ProviderMaintenance
+	ProviderMaintenanceKeyPrefix = "ProviderMaintenance/value/"
+	MaintenanceTimerStorePrefix  = "MaintenanceTimerStore/"
+)
+
+// maintenance timer types (first byte of the timer key)
+const (
+	MaintenanceStartTimerType byte = iota
+	MaintenanceEndTimerType
+)
+
+// ProviderMaintenanceKey returns the store key to retrieve a ProviderMaintenance from the index fields
+func ProviderMaintenanceKey(provider string) []byte {
+	return []byte(provider + "/")
+}
+
+// MaintenanceTimerKey returns the timer key of a provider's maintenance start/end
+func MaintenanceTimerKey(timerType byte, provider string) []byte {
+	return append([]byte{timerType}, []byte(provider)...)
+}
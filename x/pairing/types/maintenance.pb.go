@@ -0,0 +1,556 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lavanet/lava/pairing/maintenance.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ProviderMaintenance is a scheduled maintenance window of a provider. The provider's
+// chains are frozen at start_block and unfrozen at end_block.
+type ProviderMaintenance struct {
+	Provider       string   `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainIds       []string `protobuf:"bytes,2,rep,name=chain_ids,json=chainIds,proto3" json:"chain_ids,omitempty"`
+	StartBlock     uint64   `protobuf:"varint,3,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	EndBlock       uint64   `protobuf:"varint,4,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+	Reason         string   `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	FrozenChainIds []string `protobuf:"bytes,6,rep,name=frozen_chain_ids,json=frozenChainIds,proto3" json:"frozen_chain_ids,omitempty"`
+}
+
+func (m *ProviderMaintenance) Reset()         { *m = ProviderMaintenance{} }
+func (m *ProviderMaintenance) String() string { return proto.CompactTextString(m) }
+func (*ProviderMaintenance) ProtoMessage()    {}
+func (*ProviderMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2018651d146b6a26, []int{0}
+}
+func (m *ProviderMaintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderMaintenance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderMaintenance.Merge(m, src)
+}
+func (m *ProviderMaintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderMaintenance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderMaintenance proto.InternalMessageInfo
+
+func (m *ProviderMaintenance) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *ProviderMaintenance) GetChainIds() []string {
+	if m != nil {
+		return m.ChainIds
+	}
+	return nil
+}
+
+func (m *ProviderMaintenance) GetStartBlock() uint64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+func (m *ProviderMaintenance) GetEndBlock() uint64 {
+	if m != nil {
+		return m.EndBlock
+	}
+	return 0
+}
+
+func (m *ProviderMaintenance) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ProviderMaintenance) GetFrozenChainIds() []string {
+	if m != nil {
+		return m.FrozenChainIds
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ProviderMaintenance)(nil), "lavanet.lava.pairing.ProviderMaintenance")
+}
+
+func init() {
+	proto.RegisterFile("lavanet/lava/pairing/maintenance.proto", fileDescriptor_2018651d146b6a26)
+}
+
+var fileDescriptor_2018651d146b6a26 = []byte{
+	// 261 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcb, 0x49, 0x2c, 0x4b,
+	0xcc, 0x4b, 0x2d, 0xd1, 0x07, 0xd1, 0xfa, 0x05, 0x89, 0x99, 0x45, 0x99, 0x79, 0xe9, 0xfa, 0xb9,
+	0x89, 0x99, 0x79, 0x25, 0xa9, 0x79, 0x89, 0x79, 0xc9, 0xa9, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
+	0x42, 0x22, 0x50, 0x75, 0x7a, 0x20, 0x5a, 0x0f, 0xaa, 0x4e, 0xe9, 0x1c, 0x23, 0x97, 0x70, 0x40,
+	0x51, 0x7e, 0x59, 0x66, 0x4a, 0x6a, 0x91, 0x2f, 0x42, 0x8f, 0x90, 0x14, 0x17, 0x47, 0x01, 0x54,
+	0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0x33, 0x08, 0xce, 0x17, 0x92, 0xe6, 0xe2, 0x4c, 0xce, 0x48,
+	0xcc, 0xcc, 0x8b, 0xcf, 0x4c, 0x29, 0x96, 0x60, 0x52, 0x60, 0x06, 0x49, 0x82, 0x05, 0x3c, 0x53,
+	0x8a, 0x85, 0xe4, 0xb9, 0xb8, 0x8b, 0x4b, 0x12, 0x8b, 0x4a, 0xe2, 0x93, 0x72, 0xf2, 0x93, 0xb3,
+	0x25, 0x98, 0x15, 0x18, 0x35, 0x58, 0x82, 0xb8, 0xc0, 0x42, 0x4e, 0x20, 0x11, 0x90, 0xee, 0xd4,
+	0xbc, 0x14, 0xa8, 0x34, 0x0b, 0x58, 0x9a, 0x23, 0x35, 0x2f, 0x05, 0x22, 0x29, 0xc6, 0xc5, 0x56,
+	0x94, 0x9a, 0x58, 0x9c, 0x9f, 0x27, 0xc1, 0x0a, 0xb6, 0x14, 0xca, 0x13, 0xd2, 0xe0, 0x12, 0x48,
+	0x2b, 0xca, 0xaf, 0x4a, 0xcd, 0x8b, 0x47, 0xd8, 0xcc, 0x06, 0xb6, 0x99, 0x0f, 0x22, 0xee, 0x0c,
+	0xb5, 0xdf, 0xc9, 0xf1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63,
+	0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xd4, 0xd3,
+	0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x51, 0xc2, 0xac, 0x02, 0x1e, 0x6a,
+	0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x00, 0x33, 0x06, 0x0c, 0x00, 0x09, 0x23, 0x2b,
+	0xbf, 0x5a, 0x01, 0x00, 0x00,
+}
+
+func (m *ProviderMaintenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderMaintenance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FrozenChainIds) > 0 {
+		for iNdEx := len(m.FrozenChainIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenChainIds[iNdEx])
+			copy(dAtA[i:], m.FrozenChainIds[iNdEx])
+			i = encodeVarintMaintenance(dAtA, i, uint64(len(m.FrozenChainIds[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMaintenance(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EndBlock != 0 {
+		i = encodeVarintMaintenance(dAtA, i, uint64(m.EndBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartBlock != 0 {
+		i = encodeVarintMaintenance(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainIds) > 0 {
+		for iNdEx := len(m.ChainIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChainIds[iNdEx])
+			copy(dAtA[i:], m.ChainIds[iNdEx])
+			i = encodeVarintMaintenance(dAtA, i, uint64(len(m.ChainIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintMaintenance(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMaintenance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMaintenance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProviderMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovMaintenance(uint64(l))
+	}
+	if len(m.ChainIds) > 0 {
+		for _, s := range m.ChainIds {
+			l = len(s)
+			n += 1 + l + sovMaintenance(uint64(l))
+		}
+	}
+	if m.StartBlock != 0 {
+		n += 1 + sovMaintenance(uint64(m.StartBlock))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovMaintenance(uint64(m.EndBlock))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMaintenance(uint64(l))
+	}
+	if len(m.FrozenChainIds) > 0 {
+		for _, s := range m.FrozenChainIds {
+			l = len(s)
+			n += 1 + l + sovMaintenance(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMaintenance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMaintenance(x uint64) (n int) {
+	return sovMaintenance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProviderMaintenance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaintenance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderMaintenance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaintenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaintenance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaintenance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaintenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaintenance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaintenance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainIds = append(m.ChainIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+			}
+			m.StartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaintenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			m.EndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaintenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaintenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaintenance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaintenance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenChainIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaintenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaintenance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaintenance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenChainIds = append(m.FrozenChainIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaintenance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaintenance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMaintenance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMaintenance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMaintenance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMaintenance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMaintenance
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMaintenance
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMaintenance
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMaintenance        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMaintenance          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMaintenance = fmt.Errorf("proto: unexpected end of group")
+)
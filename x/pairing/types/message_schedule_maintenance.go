@@ -0,0 +1,69 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgScheduleMaintenance = "schedule_maintenance"
+
+var _ sdk.Msg = &MsgScheduleMaintenance{}
+
+func NewMsgScheduleMaintenance(creator string, chainIds []string, startInEpochs uint64, duration uint64, reason string) *MsgScheduleMaintenance {
+	return &MsgScheduleMaintenance{
+		Creator:       creator,
+		ChainIds:      chainIds,
+		StartInEpochs: startInEpochs,
+		Duration:      duration,
+		Reason:        reason,
+	}
+}
+
+func (msg *MsgScheduleMaintenance) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgScheduleMaintenance) Type() string {
+	return TypeMsgScheduleMaintenance
+}
+
+func (msg *MsgScheduleMaintenance) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgScheduleMaintenance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgScheduleMaintenance) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.ChainIds) == 0 {
+		return sdkerrors.Wrapf(InvalidMaintenanceError, "missing chain IDs")
+	}
+	seen := map[string]struct{}{}
+	for _, chainID := range msg.ChainIds {
+		if _, ok := seen[chainID]; ok {
+			return sdkerrors.Wrapf(InvalidMaintenanceError, "duplicate chain ID %s", chainID)
+		}
+		seen[chainID] = struct{}{}
+	}
+	if msg.StartInEpochs == 0 {
+		return sdkerrors.Wrapf(InvalidMaintenanceError, "maintenance must start in a future epoch")
+	}
+	if msg.Duration == 0 {
+		return sdkerrors.Wrapf(InvalidMaintenanceError, "maintenance duration must be positive")
+	}
+	if len(msg.GetReason()) > ReasonMaxLength {
+		return sdkerrors.Wrapf(FreezeReasonTooLongError, "invalid maintenance reason error (%s) ", FreezeReasonTooLongError.Error())
+	}
+	return nil
+}
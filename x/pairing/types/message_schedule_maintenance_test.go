@@ -0,0 +1,91 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgScheduleMaintenance_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgScheduleMaintenance
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgScheduleMaintenance{
+				Creator:       "invalid_address",
+				ChainIds:      []string{"ETH1"},
+				StartInEpochs: 1,
+				Duration:      1,
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "valid",
+			msg: MsgScheduleMaintenance{
+				Creator:       sample.AccAddress(),
+				ChainIds:      []string{"ETH1", "LAV1"},
+				StartInEpochs: 1,
+				Duration:      1,
+				Reason:        "upgrade",
+			},
+		}, {
+			name: "no chains",
+			msg: MsgScheduleMaintenance{
+				Creator:       sample.AccAddress(),
+				StartInEpochs: 1,
+				Duration:      1,
+			},
+			err: InvalidMaintenanceError,
+		}, {
+			name: "duplicate chains",
+			msg: MsgScheduleMaintenance{
+				Creator:       sample.AccAddress(),
+				ChainIds:      []string{"ETH1", "ETH1"},
+				StartInEpochs: 1,
+				Duration:      1,
+			},
+			err: InvalidMaintenanceError,
+		}, {
+			name: "start in current epoch",
+			msg: MsgScheduleMaintenance{
+				Creator:  sample.AccAddress(),
+				ChainIds: []string{"ETH1"},
+				Duration: 1,
+			},
+			err: InvalidMaintenanceError,
+		}, {
+			name: "zero duration",
+			msg: MsgScheduleMaintenance{
+				Creator:       sample.AccAddress(),
+				ChainIds:      []string{"ETH1"},
+				StartInEpochs: 1,
+			},
+			err: InvalidMaintenanceError,
+		}, {
+			name: "reason too long",
+			msg: MsgScheduleMaintenance{
+				Creator:       sample.AccAddress(),
+				ChainIds:      []string{"ETH1"},
+				StartInEpochs: 1,
+				Duration:      1,
+				Reason:        strings.Repeat("a", ReasonMaxLength+1),
+			},
+			err: FreezeReasonTooLongError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
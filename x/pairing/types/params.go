@@ -26,6 +26,16 @@ var (
 	DefaultRecommendedEpochNumToCollectPayment uint64 = 3
 )
 
+var (
+	KeyMaxMaintenanceDuration            = []byte("MaxMaintenanceDuration") // max number of epochs of a maintenance window
+	DefaultMaxMaintenanceDuration uint64 = 96
+)
+
+var (
+	KeyMaintenanceCooldown            = []byte("MaintenanceCooldown") // min number of epochs between the starts of a provider's maintenance windows
+	DefaultMaintenanceCooldown uint64 = 672
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -36,11 +46,15 @@ func NewParams(
 	epochBlocksOverlap uint64,
 	qoSWeight sdk.Dec,
 	recommendedEpochNumToCollectPayment uint64,
+	maxMaintenanceDuration uint64,
+	maintenanceCooldown uint64,
 ) Params {
 	return Params{
 		EpochBlocksOverlap:                  epochBlocksOverlap,
 		QoSWeight:                           qoSWeight,
 		RecommendedEpochNumToCollectPayment: recommendedEpochNumToCollectPayment,
+		MaxMaintenanceDuration:              maxMaintenanceDuration,
+		MaintenanceCooldown:                 maintenanceCooldown,
 	}
 }
 
@@ -50,6 +64,8 @@ func DefaultParams() Params {
 		DefaultEpochBlocksOverlap,
 		DefaultQoSWeight,
 		DefaultRecommendedEpochNumToCollectPayment,
+		DefaultMaxMaintenanceDuration,
+		DefaultMaintenanceCooldown,
 	)
 }
 
@@ -59,6 +75,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyEpochBlocksOverlap, &p.EpochBlocksOverlap, validateEpochBlocksOverlap),
 		paramtypes.NewParamSetPair(KeyQoSWeight, &p.QoSWeight, validateQoSWeight),
 		paramtypes.NewParamSetPair(KeyRecommendedEpochNumToCollectPayment, &p.RecommendedEpochNumToCollectPayment, validateRecommendedEpochNumToCollectPayment),
+		paramtypes.NewParamSetPair(KeyMaxMaintenanceDuration, &p.MaxMaintenanceDuration, validateMaxMaintenanceDuration),
+		paramtypes.NewParamSetPair(KeyMaintenanceCooldown, &p.MaintenanceCooldown, validateMaintenanceCooldown),
 	}
 }
 
@@ -71,6 +89,14 @@ func (p Params) Validate() error {
 	if err := validateRecommendedEpochNumToCollectPayment(p.RecommendedEpochNumToCollectPayment); err != nil {
 		return err
 	}
+
+	if err := validateMaxMaintenanceDuration(p.MaxMaintenanceDuration); err != nil {
+		return err
+	}
+
+	if err := validateMaintenanceCooldown(p.MaintenanceCooldown); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -119,3 +145,23 @@ func validateRecommendedEpochNumToCollectPayment(v interface{}) error {
 
 	return nil
 }
+
+// validateMaxMaintenanceDuration validates the MaxMaintenanceDuration param
+func validateMaxMaintenanceDuration(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaintenanceCooldown validates the MaintenanceCooldown param
+func validateMaintenanceCooldown(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
@@ -29,6 +29,8 @@ type Params struct {
 	EpochBlocksOverlap                  uint64                                 `protobuf:"varint,8,opt,name=epochBlocksOverlap,proto3" json:"epochBlocksOverlap,omitempty" yaml:"epoch_blocks_overlap"`
 	QoSWeight                           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=QoSWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"QoSWeight" yaml:"data_reliability_reward"`
 	RecommendedEpochNumToCollectPayment uint64                                 `protobuf:"varint,14,opt,name=recommendedEpochNumToCollectPayment,proto3" json:"recommendedEpochNumToCollectPayment,omitempty" yaml:"recommended_epoch_num_to_collect_payment"`
+	MaxMaintenanceDuration              uint64                                 `protobuf:"varint,15,opt,name=max_maintenance_duration,json=maxMaintenanceDuration,proto3" json:"max_maintenance_duration,omitempty" yaml:"max_maintenance_duration"`
+	MaintenanceCooldown                 uint64                                 `protobuf:"varint,16,opt,name=maintenance_cooldown,json=maintenanceCooldown,proto3" json:"maintenance_cooldown,omitempty" yaml:"maintenance_cooldown"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -77,6 +79,20 @@ func (m *Params) GetRecommendedEpochNumToCollectPayment() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxMaintenanceDuration() uint64 {
+	if m != nil {
+		return m.MaxMaintenanceDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMaintenanceCooldown() uint64 {
+	if m != nil {
+		return m.MaintenanceCooldown
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "lavanet.lava.pairing.Params")
 }
@@ -84,33 +100,38 @@ func init() {
 func init() { proto.RegisterFile("lavanet/lava/pairing/params.proto", fileDescriptor_fc338fce33b3b67a) }
 
 var fileDescriptor_fc338fce33b3b67a = []byte{
-	// 414 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0x8f, 0xd3, 0x30,
-	0x14, 0xc7, 0x93, 0xab, 0x2f, 0xe7, 0xe6, 0xee, 0x90, 0x15, 0xdd, 0x50, 0x81, 0x94, 0x1c, 0x41,
-	0x82, 0x5b, 0x48, 0x86, 0xdb, 0x6e, 0x23, 0xc0, 0xe2, 0x81, 0x2b, 0x05, 0x09, 0x89, 0xc5, 0x72,
-	0x1c, 0x2b, 0x8d, 0xce, 0x8e, 0x23, 0xc7, 0x2d, 0xe4, 0x03, 0xb0, 0x33, 0xc2, 0xc6, 0xc7, 0xe9,
-	0xd8, 0x11, 0x31, 0x44, 0xa8, 0xfd, 0x06, 0xfd, 0x04, 0x28, 0x49, 0x05, 0x45, 0x62, 0x60, 0xfa,
-	0x67, 0xf8, 0xfd, 0xde, 0xcb, 0x7b, 0x7e, 0xee, 0x43, 0x41, 0x97, 0xb4, 0xe4, 0x26, 0xee, 0x32,
-	0xae, 0x68, 0xa1, 0x8b, 0x32, 0x8f, 0x2b, 0xaa, 0xa9, 0xac, 0xa3, 0x4a, 0x2b, 0xa3, 0xbc, 0x8b,
-	0x3d, 0x12, 0x75, 0x19, 0xed, 0x91, 0xfb, 0x17, 0xb9, 0xca, 0x55, 0x0f, 0xc4, 0xdd, 0xd7, 0xc0,
-	0x86, 0x5f, 0x47, 0xae, 0x33, 0xed, 0x65, 0xef, 0xd6, 0xf5, 0x78, 0xa5, 0xd8, 0x3c, 0x11, 0x8a,
-	0xdd, 0xd5, 0xb7, 0x4b, 0xae, 0x05, 0xad, 0x26, 0xf0, 0xd2, 0xbe, 0x02, 0x49, 0xb0, 0x6b, 0x83,
-	0x07, 0x0d, 0x95, 0xe2, 0x26, 0xec, 0x19, 0x92, 0xf6, 0x10, 0x51, 0x03, 0x15, 0xce, 0xfe, 0xa1,
-	0x7a, 0xa5, 0x3b, 0x7e, 0xad, 0xde, 0xbc, 0xe3, 0x45, 0x3e, 0x37, 0x93, 0xf3, 0x4b, 0xfb, 0x6a,
-	0x9c, 0x4c, 0x57, 0x6d, 0x60, 0xfd, 0x68, 0x83, 0xc7, 0x79, 0x61, 0xe6, 0x8b, 0x34, 0x62, 0x4a,
-	0xc6, 0x4c, 0xd5, 0x52, 0xd5, 0xfb, 0x78, 0x5a, 0x67, 0x77, 0xb1, 0x69, 0x2a, 0x5e, 0x47, 0x2f,
-	0x38, 0xdb, 0xb5, 0x81, 0x3f, 0x74, 0xcd, 0xa8, 0xa1, 0x44, 0x73, 0x51, 0xd0, 0xb4, 0x10, 0x85,
-	0x69, 0x88, 0xe6, 0x1f, 0xa8, 0xce, 0xc2, 0xd9, 0x9f, 0x16, 0xde, 0x27, 0xdb, 0x7d, 0xa4, 0x39,
-	0x53, 0x52, 0xf2, 0x32, 0xe3, 0xd9, 0xcb, 0xee, 0x8f, 0x5e, 0x2d, 0xe4, 0x5b, 0xf5, 0x5c, 0x09,
-	0xc1, 0x99, 0x99, 0xd2, 0x46, 0xf2, 0xd2, 0x4c, 0xee, 0xf5, 0x23, 0x5d, 0xef, 0xda, 0x20, 0x1e,
-	0x8a, 0x1f, 0x48, 0x64, 0x18, 0xaf, 0x5c, 0x48, 0x62, 0x14, 0x61, 0x83, 0x48, 0xaa, 0xc1, 0x0c,
-	0x67, 0xff, 0x53, 0xff, 0x06, 0x7c, 0xf9, 0x16, 0x58, 0x18, 0x40, 0x1b, 0x1d, 0x61, 0x00, 0x8f,
-	0xd0, 0x08, 0x03, 0x38, 0x42, 0x00, 0x03, 0x08, 0xd0, 0x31, 0x06, 0xf0, 0x18, 0x39, 0x18, 0x40,
-	0x07, 0x9d, 0x60, 0x00, 0x4f, 0x10, 0xc4, 0x00, 0x8e, 0x91, 0x8b, 0x01, 0x74, 0xd1, 0x29, 0x06,
-	0xf0, 0x14, 0x9d, 0x61, 0x00, 0xcf, 0xd0, 0x79, 0xf2, 0x6c, 0xb5, 0xf1, 0xed, 0xf5, 0xc6, 0xb7,
-	0x7f, 0x6e, 0x7c, 0xfb, 0xf3, 0xd6, 0xb7, 0xd6, 0x5b, 0xdf, 0xfa, 0xbe, 0xf5, 0xad, 0xf7, 0x4f,
-	0x0e, 0xd6, 0xf7, 0xd7, 0x3d, 0x7c, 0xfc, 0x7d, 0x11, 0xfd, 0x0e, 0x53, 0xa7, 0x7f, 0xe5, 0xeb,
-	0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd2, 0xf4, 0x67, 0x9e, 0x36, 0x02, 0x00, 0x00,
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x37, 0xed, 0x74, 0x3b, 0x4d, 0x5b, 0x1d, 0xe2, 0x22, 0x41, 0x21, 0xa9, 0x29, 0x68,
+	0x2f, 0x6e, 0x0e, 0xbd, 0xf5, 0xe6, 0xb6, 0x5e, 0x06, 0xb4, 0x6b, 0x14, 0x04, 0x41, 0x86, 0xd9,
+	0xc9, 0x90, 0x0d, 0xcd, 0xcc, 0x0b, 0xc9, 0x6c, 0xbb, 0xfb, 0x01, 0xbc, 0x7b, 0xf4, 0xe8, 0xc7,
+	0xe9, 0xc1, 0x43, 0x8f, 0xe2, 0x21, 0xc8, 0xee, 0x37, 0xd8, 0x4f, 0x20, 0x9b, 0x04, 0xbb, 0x4a,
+	0x05, 0x4f, 0x6f, 0x78, 0xef, 0xf7, 0x7f, 0xff, 0x79, 0xbc, 0x67, 0x3f, 0xc9, 0xf8, 0x25, 0xd7,
+	0xd2, 0x84, 0xab, 0x18, 0xe6, 0x3c, 0x2d, 0x52, 0x9d, 0x84, 0x39, 0x2f, 0xb8, 0x2a, 0xfb, 0x79,
+	0x01, 0x06, 0x9c, 0x5e, 0x8b, 0xf4, 0x57, 0xb1, 0xdf, 0x22, 0x8f, 0x7a, 0x09, 0x24, 0x50, 0x03,
+	0xe1, 0xea, 0xd5, 0xb0, 0xc1, 0x37, 0x64, 0x77, 0x87, 0xb5, 0xd8, 0x39, 0xb7, 0x1d, 0x99, 0x83,
+	0x18, 0x0f, 0x32, 0x10, 0x17, 0xe5, 0xf9, 0xa5, 0x2c, 0x32, 0x9e, 0xbb, 0xf8, 0xc0, 0x3a, 0x42,
+	0x03, 0x7f, 0x59, 0xf9, 0x8f, 0x67, 0x5c, 0x65, 0x27, 0x41, 0xcd, 0xb0, 0x51, 0x0d, 0x31, 0x68,
+	0xa8, 0x20, 0xba, 0x43, 0xea, 0x68, 0x7b, 0xe7, 0x0d, 0xbc, 0x7d, 0x2f, 0xd3, 0x64, 0x6c, 0xdc,
+	0xfd, 0x03, 0xeb, 0x68, 0x67, 0x30, 0xbc, 0xae, 0xfc, 0xce, 0x8f, 0xca, 0x7f, 0x9a, 0xa4, 0x66,
+	0x3c, 0x19, 0xf5, 0x05, 0xa8, 0x50, 0x40, 0xa9, 0xa0, 0x6c, 0xc3, 0xf3, 0x32, 0xbe, 0x08, 0xcd,
+	0x2c, 0x97, 0x65, 0xff, 0x4c, 0x8a, 0x65, 0xe5, 0x7b, 0x8d, 0x6b, 0xcc, 0x0d, 0x67, 0x85, 0xcc,
+	0x52, 0x3e, 0x4a, 0xb3, 0xd4, 0xcc, 0x58, 0x21, 0xaf, 0x78, 0x11, 0x07, 0xd1, 0xad, 0x85, 0xf3,
+	0xc9, 0xb2, 0x0f, 0x0b, 0x29, 0x40, 0x29, 0xa9, 0x63, 0x19, 0xbf, 0x5c, 0xfd, 0xe8, 0xf5, 0x44,
+	0xbd, 0x83, 0x53, 0xc8, 0x32, 0x29, 0xcc, 0x90, 0xcf, 0x94, 0xd4, 0xc6, 0xbd, 0x57, 0x8f, 0x74,
+	0xbc, 0xac, 0xfc, 0xb0, 0x69, 0xbe, 0x26, 0x62, 0xcd, 0x78, 0x7a, 0xa2, 0x98, 0x01, 0x26, 0x1a,
+	0x21, 0xcb, 0x1b, 0x65, 0x10, 0xfd, 0x4f, 0x7f, 0xe7, 0xa3, 0xed, 0x2a, 0x3e, 0x65, 0x8a, 0xa7,
+	0xda, 0x48, 0xcd, 0xb5, 0x90, 0x2c, 0x9e, 0x14, 0xdc, 0xa4, 0xa0, 0xdd, 0xfb, 0xb5, 0xf7, 0xe1,
+	0xb2, 0xf2, 0xfd, 0xc6, 0xfb, 0x5f, 0x64, 0x10, 0x3d, 0x54, 0x7c, 0xfa, 0xea, 0xb6, 0x72, 0xd6,
+	0x16, 0x9c, 0xc8, 0xee, 0xad, 0x0b, 0x04, 0x40, 0x16, 0xc3, 0x95, 0x76, 0xc9, 0xdf, 0x9b, 0xba,
+	0x8b, 0x0a, 0xa2, 0x07, 0x6b, 0xe9, 0xd3, 0x36, 0x7b, 0x82, 0xbe, 0x7c, 0xf5, 0x3b, 0x14, 0x61,
+	0x8b, 0x6c, 0x50, 0x84, 0x37, 0xc8, 0x26, 0x45, 0x78, 0x93, 0x20, 0x8a, 0x30, 0x22, 0x5b, 0x14,
+	0xe1, 0x2d, 0xd2, 0xa5, 0x08, 0x77, 0xc9, 0x36, 0x45, 0x78, 0x9b, 0x60, 0x8a, 0xf0, 0x0e, 0xb1,
+	0x29, 0xc2, 0x36, 0xd9, 0xa5, 0x08, 0xef, 0x92, 0x3d, 0x8a, 0xf0, 0x1e, 0xd9, 0x1f, 0xbc, 0xb8,
+	0x9e, 0x7b, 0xd6, 0xcd, 0xdc, 0xb3, 0x7e, 0xce, 0x3d, 0xeb, 0xf3, 0xc2, 0xeb, 0xdc, 0x2c, 0xbc,
+	0xce, 0xf7, 0x85, 0xd7, 0xf9, 0xf0, 0x6c, 0x6d, 0xe3, 0x7f, 0x9c, 0xf0, 0xf4, 0xf7, 0x11, 0xd7,
+	0x6b, 0x1f, 0x75, 0xeb, 0xc3, 0x3c, 0xfe, 0x35, 0x00, 0x94, 0x6e, 0xa7, 0x77, 0xe9, 0x02, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -133,6 +154,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaintenanceCooldown != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaintenanceCooldown))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxMaintenanceDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMaintenanceDuration))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.RecommendedEpochNumToCollectPayment != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.RecommendedEpochNumToCollectPayment))
 		i--
@@ -181,6 +214,12 @@ func (m *Params) Size() (n int) {
 	if m.RecommendedEpochNumToCollectPayment != 0 {
 		n += 1 + sovParams(uint64(m.RecommendedEpochNumToCollectPayment))
 	}
+	if m.MaxMaintenanceDuration != 0 {
+		n += 1 + sovParams(uint64(m.MaxMaintenanceDuration))
+	}
+	if m.MaintenanceCooldown != 0 {
+		n += 2 + sovParams(uint64(m.MaintenanceCooldown))
+	}
 	return n
 }
 
@@ -291,6 +330,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMaintenanceDuration", wireType)
+			}
+			m.MaxMaintenanceDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMaintenanceDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceCooldown", wireType)
+			}
+			m.MaintenanceCooldown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceCooldown |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1341,6 +1341,102 @@ func (m *ProviderCuInfo) GetCu() uint64 {
 	return 0
 }
 
+type QueryUpcomingMaintenanceRequest struct {
+	ChainId  string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+}
+
+func (m *QueryUpcomingMaintenanceRequest) Reset()         { *m = QueryUpcomingMaintenanceRequest{} }
+func (m *QueryUpcomingMaintenanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpcomingMaintenanceRequest) ProtoMessage()    {}
+func (*QueryUpcomingMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e149ce9d21da0d8, []int{25}
+}
+func (m *QueryUpcomingMaintenanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpcomingMaintenanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpcomingMaintenanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpcomingMaintenanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpcomingMaintenanceRequest.Merge(m, src)
+}
+func (m *QueryUpcomingMaintenanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpcomingMaintenanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpcomingMaintenanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpcomingMaintenanceRequest proto.InternalMessageInfo
+
+func (m *QueryUpcomingMaintenanceRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QueryUpcomingMaintenanceRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+type QueryUpcomingMaintenanceResponse struct {
+	Maintenances []ProviderMaintenance `protobuf:"bytes,1,rep,name=maintenances,proto3" json:"maintenances"`
+}
+
+func (m *QueryUpcomingMaintenanceResponse) Reset()         { *m = QueryUpcomingMaintenanceResponse{} }
+func (m *QueryUpcomingMaintenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpcomingMaintenanceResponse) ProtoMessage()    {}
+func (*QueryUpcomingMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e149ce9d21da0d8, []int{26}
+}
+func (m *QueryUpcomingMaintenanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpcomingMaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpcomingMaintenanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpcomingMaintenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpcomingMaintenanceResponse.Merge(m, src)
+}
+func (m *QueryUpcomingMaintenanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpcomingMaintenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpcomingMaintenanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpcomingMaintenanceResponse proto.InternalMessageInfo
+
+func (m *QueryUpcomingMaintenanceResponse) GetMaintenances() []ProviderMaintenance {
+	if m != nil {
+		return m.Maintenances
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "lavanet.lava.pairing.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.pairing.QueryParamsResponse")
@@ -1367,113 +1463,121 @@ func init() {
 	proto.RegisterType((*QueryProvidersEpochCuRequest)(nil), "lavanet.lava.pairing.QueryProvidersEpochCuRequest")
 	proto.RegisterType((*QueryProvidersEpochCuResponse)(nil), "lavanet.lava.pairing.QueryProvidersEpochCuResponse")
 	proto.RegisterType((*ProviderCuInfo)(nil), "lavanet.lava.pairing.ProviderCuInfo")
+	proto.RegisterType((*QueryUpcomingMaintenanceRequest)(nil), "lavanet.lava.pairing.QueryUpcomingMaintenanceRequest")
+	proto.RegisterType((*QueryUpcomingMaintenanceResponse)(nil), "lavanet.lava.pairing.QueryUpcomingMaintenanceResponse")
 }
 
 func init() { proto.RegisterFile("lavanet/lava/pairing/query.proto", fileDescriptor_9e149ce9d21da0d8) }
 
 var fileDescriptor_9e149ce9d21da0d8 = []byte{
-	// 1615 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x73, 0xd4, 0x46,
-	0x1a, 0xb6, 0xc6, 0x63, 0xe3, 0x79, 0xc1, 0x36, 0xd5, 0xd8, 0x60, 0x6b, 0xcd, 0x60, 0x64, 0x58,
-	0x6c, 0xcc, 0x8e, 0xb0, 0x59, 0x3e, 0x0a, 0x0c, 0xbb, 0xd8, 0xb0, 0x94, 0x59, 0xef, 0xae, 0x2d,
-	0x2f, 0x7b, 0xd8, 0x8b, 0x4a, 0x96, 0x7a, 0xc6, 0xc2, 0x33, 0x6a, 0x21, 0xb5, 0x06, 0x3b, 0xae,
-	0x49, 0xaa, 0x92, 0x3f, 0x90, 0xaa, 0x24, 0x87, 0xdc, 0xa9, 0xca, 0x89, 0x7f, 0x90, 0x9c, 0x52,
-	0x95, 0xe2, 0x90, 0x03, 0x55, 0xb9, 0xe4, 0x90, 0x4a, 0xa5, 0x20, 0x7f, 0x20, 0xff, 0x20, 0xa5,
-	0xee, 0x96, 0x46, 0x1a, 0x6b, 0x34, 0x36, 0xe1, 0xe2, 0x99, 0x96, 0xde, 0xa7, 0xfb, 0x79, 0x9f,
-	0x7e, 0xbf, 0xc6, 0x30, 0x5d, 0x37, 0x9a, 0x86, 0x83, 0xa9, 0x1a, 0x7e, 0xaa, 0xae, 0x61, 0x7b,
-	0xb6, 0x53, 0x53, 0x9f, 0x05, 0xd8, 0xdb, 0xab, 0xb8, 0x1e, 0xa1, 0x04, 0x8d, 0x09, 0x8b, 0x4a,
-	0xf8, 0x59, 0x11, 0x16, 0xf2, 0x58, 0x8d, 0xd4, 0x08, 0x33, 0x50, 0xc3, 0x6f, 0xdc, 0x56, 0x9e,
-	0xaa, 0x11, 0x52, 0xab, 0x63, 0xd5, 0x70, 0x6d, 0xd5, 0x70, 0x1c, 0x42, 0x0d, 0x6a, 0x13, 0xc7,
-	0x17, 0x6f, 0x2f, 0x9b, 0xc4, 0x6f, 0x10, 0x5f, 0xdd, 0x32, 0x7c, 0xcc, 0x8f, 0x50, 0x9b, 0x0b,
-	0x5b, 0x98, 0x1a, 0x0b, 0xaa, 0x6b, 0xd4, 0x6c, 0x87, 0x19, 0x0b, 0xdb, 0xf3, 0x99, 0xbc, 0x5c,
-	0xc3, 0x33, 0x1a, 0xd1, 0x76, 0x53, 0x29, 0x13, 0xdf, 0xc5, 0x26, 0xfb, 0x23, 0xde, 0x9e, 0x4b,
-	0x6f, 0x50, 0x37, 0x1c, 0x5f, 0x75, 0x49, 0xdd, 0x36, 0x85, 0x5f, 0xf2, 0x7c, 0xca, 0x00, 0xbb,
-	0xc4, 0xdc, 0xf6, 0x29, 0xf1, 0x8c, 0x1a, 0x56, 0x7d, 0x6a, 0xec, 0x60, 0x1d, 0x3b, 0x34, 0x12,
-	0x41, 0xbe, 0x92, 0x3e, 0x2b, 0xd8, 0xf2, 0x4d, 0xcf, 0x76, 0x43, 0xbe, 0xa9, 0x85, 0xb0, 0x9e,
-	0x49, 0x9f, 0xed, 0x91, 0xa7, 0xd8, 0xa4, 0x7e, 0xf4, 0x45, 0x18, 0x5d, 0x4a, 0x19, 0x59, 0xe4,
-	0xb9, 0x43, 0xed, 0x06, 0x56, 0x9b, 0x0b, 0xf1, 0x77, 0x6e, 0xa8, 0x8c, 0x01, 0xda, 0x08, 0xc5,
-	0x5a, 0x67, 0xce, 0x6b, 0xf8, 0x59, 0x80, 0x7d, 0xaa, 0x6c, 0xc0, 0xa9, 0xd4, 0x53, 0xdf, 0x25,
-	0x8e, 0x8f, 0xd1, 0x6d, 0x18, 0xe4, 0x22, 0x4d, 0x48, 0xd3, 0xd2, 0xec, 0xf1, 0xc5, 0xa9, 0x4a,
-	0xd6, 0xf5, 0x55, 0x38, 0x6a, 0xb9, 0xf8, 0xea, 0xe7, 0x73, 0x7d, 0x9a, 0x40, 0x28, 0x1b, 0x30,
-	0xce, 0xb7, 0xf4, 0x48, 0xd3, 0xb6, 0xb0, 0x17, 0x9d, 0x85, 0x26, 0xe0, 0x98, 0xb9, 0x6d, 0xd8,
-	0xce, 0xea, 0x03, 0xb6, 0x6b, 0x49, 0x8b, 0x96, 0xa8, 0x0c, 0xe0, 0x6f, 0x93, 0xe7, 0xff, 0xf0,
-	0xc8, 0x07, 0xd8, 0x99, 0x28, 0x4c, 0x4b, 0xb3, 0x43, 0x5a, 0xe2, 0x89, 0xb2, 0x03, 0xa7, 0x3b,
-	0xb7, 0x14, 0x44, 0xff, 0x09, 0xc0, 0x64, 0x7e, 0x18, 0xaa, 0x3c, 0x21, 0x4d, 0xf7, 0xcf, 0x1e,
-	0x5f, 0xbc, 0x98, 0x26, 0x9b, 0xbc, 0x93, 0xca, 0x66, 0x6c, 0x2c, 0x58, 0x27, 0xe0, 0x8f, 0x8b,
-	0x43, 0x85, 0x93, 0xfd, 0xca, 0x63, 0x71, 0xd8, 0x23, 0x4c, 0xd7, 0xb9, 0x9f, 0xbd, 0x1d, 0x38,
-	0x0d, 0x83, 0x66, 0xdd, 0xc6, 0x0e, 0x65, 0xe4, 0x4b, 0x9a, 0x58, 0x29, 0x2f, 0x0b, 0x70, 0xe6,
-	0xc0, 0x66, 0x82, 0xfa, 0x2a, 0x94, 0xdc, 0xc8, 0x9f, 0x77, 0x61, 0xde, 0x46, 0xa3, 0x19, 0x18,
-	0x36, 0x03, 0xcf, 0xc3, 0x0e, 0xd5, 0x19, 0x86, 0xb1, 0x28, 0x6a, 0x27, 0xc4, 0xc3, 0x87, 0xe1,
-	0x33, 0x74, 0x0b, 0x26, 0xc3, 0x70, 0xd0, 0xeb, 0xb8, 0x4a, 0x75, 0x4a, 0x74, 0x07, 0xef, 0x52,
-	0x5d, 0xdc, 0xe4, 0x44, 0x3f, 0x03, 0x8c, 0x87, 0x06, 0x6b, 0xb8, 0x4a, 0xff, 0x4b, 0xfe, 0x8d,
-	0x77, 0x23, 0xc6, 0xe8, 0x3a, 0x9c, 0x09, 0x53, 0x42, 0xaf, 0x1b, 0x3e, 0xd5, 0x03, 0xd7, 0x32,
-	0x28, 0xb6, 0xf4, 0xad, 0x3a, 0x31, 0x77, 0x26, 0x8a, 0x0c, 0x37, 0x16, 0xbe, 0x5e, 0x33, 0x7c,
-	0xfa, 0x84, 0xbf, 0x5c, 0x0e, 0xdf, 0xa1, 0x05, 0x18, 0x67, 0x46, 0x3a, 0xa9, 0xa6, 0x0f, 0x1b,
-	0x60, 0x20, 0xc4, 0x5e, 0xfe, 0xa7, 0x9a, 0x38, 0x49, 0xf9, 0x08, 0x26, 0x99, 0x5c, 0xff, 0xc3,
-	0x9e, 0x5d, 0xdd, 0xfb, 0xa3, 0xf2, 0x23, 0x19, 0x86, 0x22, 0x91, 0x98, 0x87, 0x25, 0x2d, 0x5e,
-	0xa3, 0x31, 0x18, 0x48, 0xba, 0xc0, 0x17, 0xca, 0x0b, 0x09, 0xe4, 0x2c, 0x06, 0xe2, 0xce, 0xc6,
-	0x60, 0xa0, 0x69, 0xd4, 0x6d, 0x8b, 0x11, 0x18, 0xd2, 0xf8, 0x02, 0xcd, 0xc1, 0xc9, 0xd0, 0x35,
-	0x6c, 0xe9, 0xed, 0x0b, 0xe5, 0x82, 0x8e, 0xf2, 0xe7, 0x71, 0xdc, 0xa2, 0x69, 0x38, 0x61, 0x06,
-	0xba, 0x8b, 0x3d, 0x71, 0x51, 0xfc, 0x70, 0x30, 0x83, 0x75, 0xec, 0xf1, 0x6b, 0x3a, 0x0b, 0x20,
-	0x32, 0x5c, 0xb7, 0x2d, 0x26, 0x55, 0x89, 0x5d, 0x75, 0xf8, 0x64, 0xd5, 0x12, 0x31, 0x6a, 0x88,
-	0x1c, 0x7b, 0xe2, 0x63, 0x8f, 0xc5, 0x44, 0x42, 0x23, 0xc3, 0xb2, 0x3c, 0xec, 0xfb, 0x91, 0x46,
-	0x62, 0x99, 0x54, 0xaf, 0x90, 0x56, 0x2f, 0x56, 0xa2, 0x3f, 0xa9, 0xc4, 0x73, 0x91, 0x06, 0x89,
-	0x23, 0x84, 0x08, 0x8f, 0x60, 0xc8, 0x24, 0x8e, 0x1f, 0x34, 0xb0, 0x27, 0xca, 0xc3, 0x91, 0xe2,
-	0x36, 0x06, 0x87, 0x07, 0x37, 0x8c, 0xdd, 0x95, 0x27, 0x22, 0x5c, 0xf9, 0x42, 0xb9, 0x03, 0xe7,
-	0xd8, 0xc1, 0x9b, 0x61, 0xd9, 0x37, 0x63, 0xe9, 0xd6, 0x6c, 0x9f, 0xf6, 0x8c, 0x04, 0xa5, 0x01,
-	0xd3, 0xdd, 0xc1, 0xef, 0x3d, 0xf1, 0x94, 0x0d, 0xf8, 0x13, 0x3b, 0xee, 0x61, 0xb5, 0x8a, 0x4d,
-	0x6a, 0x37, 0xf1, 0x3a, 0xeb, 0x0d, 0x11, 0x4f, 0xb9, 0x43, 0xa9, 0x52, 0xc2, 0xf9, 0xd3, 0x30,
-	0x18, 0x66, 0x4d, 0x7c, 0x1d, 0x62, 0xa5, 0x7c, 0x21, 0xc1, 0x54, 0xf6, 0x9e, 0x82, 0xfe, 0x22,
-	0x0c, 0xf2, 0x0e, 0x24, 0xc4, 0x97, 0x3b, 0x6a, 0x73, 0xd8, 0xa3, 0x2a, 0x02, 0x23, 0x2c, 0xd1,
-	0x7d, 0x18, 0x71, 0xb1, 0x63, 0xd9, 0x4e, 0x4d, 0x17, 0xd8, 0x42, 0x4f, 0xec, 0xb0, 0x40, 0xf0,
-	0xa5, 0xf2, 0x9b, 0x24, 0x4a, 0xd9, 0xa6, 0xb5, 0xd3, 0x99, 0x16, 0x8f, 0xe0, 0x58, 0x94, 0xdb,
-	0x9c, 0xd3, 0x5f, 0xb2, 0xfb, 0x45, 0x97, 0x52, 0xa8, 0x45, 0x68, 0x34, 0x0e, 0x83, 0x0d, 0x63,
-	0x57, 0x37, 0x83, 0x64, 0x48, 0x04, 0x68, 0x1e, 0x8a, 0xa1, 0x3a, 0x2c, 0x40, 0x8f, 0x2f, 0x9e,
-	0x49, 0x6f, 0xce, 0xba, 0xf5, 0xa6, 0x8b, 0x4d, 0x8d, 0x19, 0xa1, 0x55, 0x18, 0x8d, 0x5a, 0x9f,
-	0x2e, 0x9a, 0x58, 0x91, 0xe1, 0xa6, 0xd3, 0xb8, 0xb8, 0x3f, 0x36, 0x17, 0x44, 0x23, 0xd3, 0x46,
-	0xa2, 0x67, 0x7c, 0xad, 0xfc, 0x0d, 0xce, 0xa7, 0xfa, 0xce, 0xbf, 0x88, 0x43, 0xb7, 0xeb, 0x7b,
-	0xeb, 0xc6, 0x1e, 0x09, 0x68, 0xe2, 0x92, 0xe3, 0x22, 0x23, 0xa5, 0x8b, 0x8c, 0xb2, 0x03, 0x68,
-	0x33, 0xd1, 0xd8, 0x39, 0x10, 0x29, 0x70, 0x22, 0xd9, 0xee, 0x05, 0x2a, 0xf5, 0x0c, 0x4d, 0xc2,
-	0x10, 0x8b, 0xe9, 0xb0, 0x08, 0xa4, 0xf2, 0xd5, 0x0a, 0x23, 0xc7, 0x68, 0x90, 0xc0, 0xa1, 0x22,
-	0x61, 0xc5, 0x4a, 0xf9, 0x10, 0x94, 0x3c, 0xb6, 0xed, 0x12, 0x46, 0x09, 0x35, 0xea, 0xec, 0xd4,
-	0xa2, 0xc6, 0x17, 0x68, 0x19, 0x8e, 0x59, 0x98, 0x1a, 0x76, 0xdd, 0x9f, 0x28, 0xb0, 0x8c, 0x98,
-	0xcd, 0xbe, 0xc1, 0x83, 0xde, 0x68, 0x11, 0x50, 0x79, 0x00, 0x23, 0xd1, 0xd1, 0xc2, 0xd1, 0x1c,
-	0x69, 0x12, 0x5e, 0x14, 0x52, 0x5e, 0x3c, 0x85, 0xe1, 0x15, 0x9e, 0xcc, 0x62, 0x93, 0xa4, 0x12,
-	0x52, 0x5a, 0x89, 0x7b, 0x61, 0xdc, 0x85, 0x46, 0x11, 0xeb, 0x0b, 0x5d, 0xe6, 0x94, 0x14, 0x2d,
-	0x2d, 0x02, 0x29, 0x2b, 0x70, 0x91, 0x87, 0x74, 0xc2, 0xab, 0x6e, 0x77, 0xdc, 0x2d, 0x91, 0x95,
-	0x16, 0xfc, 0xb9, 0xd7, 0x26, 0xb9, 0xd2, 0xdf, 0xed, 0x94, 0x7e, 0x26, 0xdb, 0x89, 0x94, 0x2a,
-	0x6d, 0xd5, 0xcb, 0xa2, 0x5c, 0xc4, 0xb5, 0x8e, 0xb5, 0x91, 0x95, 0x20, 0x9a, 0xf0, 0x74, 0x38,
-	0xdb, 0xe5, 0xbd, 0x60, 0x75, 0x0f, 0x8a, 0xb6, 0x53, 0x25, 0xa2, 0x12, 0xf6, 0x50, 0x70, 0x25,
-	0x58, 0x75, 0xaa, 0x44, 0x14, 0x42, 0x86, 0x53, 0x96, 0xda, 0xd7, 0xce, 0xdf, 0xe6, 0x5e, 0xfb,
-	0x08, 0x14, 0xe2, 0xec, 0x2e, 0x98, 0xc1, 0xe2, 0x37, 0xa3, 0x30, 0xc0, 0xf8, 0xa1, 0x4f, 0x24,
-	0x18, 0xe4, 0x79, 0x87, 0x66, 0x73, 0xca, 0x47, 0x6a, 0x7e, 0x95, 0xe7, 0x0e, 0x61, 0xc9, 0xfd,
-	0x54, 0x2e, 0x7c, 0xfc, 0xc3, 0xaf, 0x9f, 0x15, 0xca, 0x68, 0x4a, 0xcd, 0xf9, 0x51, 0x80, 0xbe,
-	0x94, 0xa0, 0xd4, 0x6e, 0xd7, 0xf3, 0x79, 0xdb, 0x77, 0xcc, 0xb7, 0xf2, 0x95, 0xc3, 0x19, 0x0b,
-	0x3a, 0x0b, 0x8c, 0xce, 0x3c, 0x9a, 0xeb, 0x42, 0x27, 0x02, 0xa8, 0xfb, 0xa2, 0xb7, 0xb5, 0xd0,
-	0x57, 0x12, 0x40, 0xbb, 0x7a, 0xa2, 0x2b, 0x87, 0x2c, 0xb2, 0x9c, 0xdd, 0xd1, 0x4a, 0xb2, 0xb2,
-	0xc4, 0xe8, 0xdd, 0x40, 0x7f, 0xcd, 0xa6, 0x57, 0xc3, 0xf1, 0x38, 0xd7, 0x26, 0xa8, 0xee, 0xf3,
-	0xb9, 0xab, 0x85, 0xbe, 0x93, 0x60, 0x38, 0x35, 0x41, 0x21, 0x35, 0xe7, 0xf8, 0xac, 0x69, 0x4f,
-	0xbe, 0x7a, 0x78, 0x80, 0xa0, 0xac, 0x31, 0xca, 0x6b, 0xe8, 0x71, 0x36, 0xe5, 0x26, 0x03, 0xe5,
-	0xb0, 0x56, 0xf7, 0x23, 0xd1, 0x5b, 0xea, 0x3e, 0x1b, 0x82, 0x5a, 0xe8, 0x85, 0x04, 0xa5, 0x78,
-	0x02, 0xca, 0x0d, 0x87, 0xce, 0x51, 0x2c, 0x37, 0x1c, 0x0e, 0x0c, 0x55, 0xbd, 0xf4, 0x0e, 0xfc,
-	0x70, 0x64, 0x0c, 0x11, 0xea, 0xbe, 0x98, 0xe8, 0x5a, 0x89, 0xc8, 0xf8, 0x56, 0x82, 0x53, 0x19,
-	0x23, 0x0f, 0xba, 0x9e, 0xc3, 0xa1, 0xfb, 0x7c, 0x25, 0xdf, 0x38, 0x2a, 0x4c, 0x38, 0x71, 0x97,
-	0x39, 0x71, 0x13, 0x5d, 0xcf, 0x76, 0xc2, 0x67, 0xd0, 0xf6, 0x90, 0xac, 0xd7, 0x6d, 0x9f, 0x26,
-	0xbc, 0xf8, 0x5a, 0x82, 0xd1, 0x8e, 0xa9, 0x07, 0x2d, 0xe4, 0x50, 0xc9, 0x9e, 0xba, 0xe4, 0xc5,
-	0xa3, 0x40, 0x04, 0xf3, 0x65, 0xc6, 0x7c, 0x09, 0xdd, 0xce, 0x66, 0x8e, 0x23, 0x98, 0x18, 0x9f,
-	0xd4, 0xfd, 0xa8, 0xfc, 0xb7, 0xd4, 0x7d, 0x3e, 0xb8, 0xb5, 0xd0, 0xf7, 0x12, 0x8c, 0x67, 0xf6,
-	0x5e, 0x74, 0xf3, 0x10, 0x95, 0x21, 0xab, 0xef, 0xc8, 0xb7, 0x8e, 0x0e, 0x14, 0x0e, 0xfd, 0x9d,
-	0x39, 0x74, 0x1b, 0xdd, 0xca, 0x2f, 0x2f, 0x7a, 0x83, 0xa3, 0x75, 0xde, 0x12, 0x13, 0x29, 0x80,
-	0x7e, 0x92, 0x60, 0xb2, 0x6b, 0x4f, 0x43, 0x77, 0xf2, 0x42, 0xa4, 0x47, 0x3b, 0x95, 0x97, 0xde,
-	0x0d, 0x2c, 0x5c, 0x7b, 0xc0, 0x5c, 0xbb, 0x87, 0x96, 0xba, 0x44, 0x59, 0x62, 0x83, 0x03, 0xee,
-	0xc5, 0xd7, 0x86, 0x3e, 0x97, 0x00, 0xda, 0xa3, 0xec, 0x7b, 0x2c, 0xa6, 0x07, 0xe7, 0x63, 0x65,
-	0x8e, 0x31, 0x9e, 0x41, 0xe7, 0xbb, 0x30, 0xb6, 0x76, 0xa2, 0xb2, 0x84, 0x5e, 0x4a, 0x70, 0xb2,
-	0xb3, 0x55, 0xa3, 0xc5, 0xc3, 0x74, 0x96, 0x74, 0xdf, 0x97, 0xaf, 0x1d, 0x09, 0x23, 0x88, 0x5e,
-	0x65, 0x44, 0x2f, 0xa3, 0xd9, 0x1e, 0x4d, 0x89, 0xff, 0x7a, 0xd5, 0xcd, 0x60, 0xf9, 0xfe, 0xab,
-	0x37, 0x65, 0xe9, 0xf5, 0x9b, 0xb2, 0xf4, 0xcb, 0x9b, 0xb2, 0xf4, 0xe9, 0xdb, 0x72, 0xdf, 0xeb,
-	0xb7, 0xe5, 0xbe, 0x1f, 0xdf, 0x96, 0xfb, 0xfe, 0x7f, 0xa9, 0x66, 0xd3, 0xed, 0x60, 0xab, 0x62,
-	0x92, 0x46, 0x7a, 0xb7, 0xdd, 0x78, 0x3f, 0xba, 0xe7, 0x62, 0x7f, 0x6b, 0x90, 0xfd, 0x83, 0xea,
-	0xda, 0xef, 0x01, 0x00, 0x00, 0xff, 0xff, 0x1f, 0xa2, 0x9a, 0x9b, 0x45, 0x14, 0x00, 0x00,
+	// 1703 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x4f, 0xdc, 0xc6,
+	0x17, 0xc7, 0xcb, 0x42, 0xd8, 0x17, 0x20, 0x68, 0x02, 0x64, 0xf1, 0x97, 0x6c, 0x36, 0x26, 0x3f,
+	0x20, 0xf0, 0x5d, 0x87, 0x4d, 0xf3, 0x43, 0x09, 0x49, 0x1b, 0x48, 0x1a, 0x91, 0x92, 0x16, 0x4c,
+	0xa9, 0xaa, 0x5e, 0x2c, 0xe3, 0x9d, 0x5d, 0x1c, 0x76, 0x3d, 0x8e, 0x3d, 0x5e, 0xa0, 0x68, 0x5b,
+	0xa9, 0xfd, 0x07, 0x2a, 0xb5, 0x3d, 0xf4, 0x1e, 0xa9, 0xa7, 0xdc, 0x7b, 0xe8, 0xad, 0x52, 0x95,
+	0x43, 0x0f, 0x91, 0x7a, 0xe9, 0xa1, 0xaa, 0xaa, 0xa4, 0xff, 0x40, 0x6f, 0x3d, 0x56, 0x1e, 0x8f,
+	0x77, 0xed, 0xc5, 0x6b, 0x96, 0x34, 0x97, 0xc0, 0x78, 0xde, 0x67, 0xde, 0xe7, 0xbd, 0x79, 0xf3,
+	0xde, 0x87, 0x40, 0xbe, 0xaa, 0xd5, 0x35, 0x13, 0x53, 0xd9, 0xfb, 0x29, 0x5b, 0x9a, 0x61, 0x1b,
+	0x66, 0x45, 0x7e, 0xe2, 0x62, 0x7b, 0xaf, 0x60, 0xd9, 0x84, 0x12, 0x34, 0xca, 0x2d, 0x0a, 0xde,
+	0xcf, 0x02, 0xb7, 0x10, 0x47, 0x2b, 0xa4, 0x42, 0x98, 0x81, 0xec, 0xfd, 0xe6, 0xdb, 0x8a, 0x93,
+	0x15, 0x42, 0x2a, 0x55, 0x2c, 0x6b, 0x96, 0x21, 0x6b, 0xa6, 0x49, 0xa8, 0x46, 0x0d, 0x62, 0x3a,
+	0x7c, 0xf7, 0x92, 0x4e, 0x9c, 0x1a, 0x71, 0xe4, 0x4d, 0xcd, 0xc1, 0xbe, 0x0b, 0xb9, 0x3e, 0xbf,
+	0x89, 0xa9, 0x36, 0x2f, 0x5b, 0x5a, 0xc5, 0x30, 0x99, 0x31, 0xb7, 0x3d, 0x1b, 0xcb, 0xcb, 0xd2,
+	0x6c, 0xad, 0x16, 0x1c, 0x37, 0x19, 0x31, 0x71, 0x2c, 0xac, 0xb3, 0x7f, 0xf8, 0xee, 0x99, 0xe8,
+	0x01, 0x55, 0xcd, 0x74, 0x64, 0x8b, 0x54, 0x0d, 0x9d, 0xc7, 0x25, 0xce, 0x46, 0x0c, 0xb0, 0x45,
+	0xf4, 0x2d, 0x87, 0x12, 0x5b, 0xab, 0x60, 0xd9, 0xa1, 0xda, 0x36, 0x56, 0xb1, 0x49, 0x83, 0x24,
+	0x88, 0x73, 0x51, 0x5f, 0xee, 0xa6, 0xa3, 0xdb, 0x86, 0xe5, 0xf1, 0x8d, 0x2c, 0xb8, 0xf5, 0x54,
+	0xd4, 0xb7, 0x4d, 0x1e, 0x63, 0x9d, 0x3a, 0xc1, 0x2f, 0xdc, 0xe8, 0x62, 0xc4, 0xa8, 0x44, 0x76,
+	0x4c, 0x6a, 0xd4, 0xb0, 0x5c, 0x9f, 0x6f, 0xfe, 0xce, 0x0d, 0x2f, 0xc4, 0xa6, 0xa2, 0xa6, 0x19,
+	0x26, 0xc5, 0xa6, 0x66, 0xea, 0xdc, 0x4e, 0x1a, 0x05, 0xb4, 0xe6, 0x25, 0x75, 0x95, 0x25, 0x49,
+	0xc1, 0x4f, 0x5c, 0xec, 0x50, 0x69, 0x0d, 0x4e, 0x46, 0xbe, 0x3a, 0x16, 0x31, 0x1d, 0x8c, 0x6e,
+	0x42, 0xbf, 0x9f, 0xcc, 0xac, 0x90, 0x17, 0xa6, 0x8f, 0x17, 0x27, 0x0b, 0x71, 0xd7, 0x5c, 0xf0,
+	0x51, 0x8b, 0xe9, 0xe7, 0x7f, 0x9c, 0xe9, 0x51, 0x38, 0x42, 0x5a, 0x83, 0x31, 0xff, 0x48, 0x9b,
+	0xd4, 0x8d, 0x12, 0xb6, 0x03, 0x5f, 0x28, 0x0b, 0xc7, 0xf4, 0x2d, 0xcd, 0x30, 0x97, 0xef, 0xb1,
+	0x53, 0x33, 0x4a, 0xb0, 0x44, 0x39, 0x00, 0x67, 0x8b, 0xec, 0xbc, 0x6b, 0x93, 0x4f, 0xb1, 0x99,
+	0x4d, 0xe5, 0x85, 0xe9, 0x01, 0x25, 0xf4, 0x45, 0xda, 0x86, 0xf1, 0xf6, 0x23, 0x39, 0xd1, 0xf7,
+	0x00, 0xd8, 0x75, 0xdc, 0xf7, 0x6e, 0x23, 0x2b, 0xe4, 0x7b, 0xa7, 0x8f, 0x17, 0xcf, 0x47, 0xc9,
+	0x86, 0xef, 0xae, 0xb0, 0xde, 0x34, 0xe6, 0xac, 0x43, 0xf0, 0x87, 0xe9, 0x81, 0xd4, 0x48, 0xaf,
+	0xf4, 0x90, 0x3b, 0x7b, 0x80, 0xe9, 0xaa, 0x1f, 0xe7, 0xe1, 0x01, 0x8c, 0x43, 0xbf, 0x5e, 0x35,
+	0xb0, 0x49, 0x19, 0xf9, 0x8c, 0xc2, 0x57, 0xd2, 0xb3, 0x14, 0x9c, 0x3a, 0x70, 0x18, 0xa7, 0xbe,
+	0x0c, 0x19, 0x2b, 0x88, 0xe7, 0x75, 0x98, 0xb7, 0xd0, 0x68, 0x0a, 0x86, 0x74, 0xd7, 0xb6, 0xb1,
+	0x49, 0x55, 0x86, 0x61, 0x2c, 0xd2, 0xca, 0x20, 0xff, 0x78, 0xdf, 0xfb, 0x86, 0x6e, 0xc0, 0x84,
+	0x57, 0x36, 0x6a, 0x15, 0x97, 0xa9, 0x4a, 0x89, 0x6a, 0xe2, 0x5d, 0xaa, 0xf2, 0x9b, 0xcc, 0xf6,
+	0x32, 0xc0, 0x98, 0x67, 0xb0, 0x82, 0xcb, 0xf4, 0x43, 0xf2, 0x3e, 0xde, 0x0d, 0x18, 0xa3, 0xab,
+	0x70, 0xca, 0x7b, 0x3a, 0x6a, 0x55, 0x73, 0xa8, 0xea, 0x5a, 0x25, 0x8d, 0xe2, 0x92, 0xba, 0x59,
+	0x25, 0xfa, 0x76, 0x36, 0xcd, 0x70, 0xa3, 0xde, 0xf6, 0x8a, 0xe6, 0xd0, 0x0d, 0x7f, 0x73, 0xd1,
+	0xdb, 0x43, 0xf3, 0x30, 0xc6, 0x8c, 0x54, 0x52, 0x8e, 0x3a, 0xeb, 0x63, 0x20, 0xc4, 0x36, 0x3f,
+	0x28, 0x87, 0x3c, 0x49, 0x9f, 0xc3, 0x04, 0x4b, 0xd7, 0x47, 0xd8, 0x36, 0xca, 0x7b, 0xff, 0x35,
+	0xfd, 0x48, 0x84, 0x81, 0x20, 0x49, 0x2c, 0xc2, 0x8c, 0xd2, 0x5c, 0xa3, 0x51, 0xe8, 0x0b, 0x87,
+	0xe0, 0x2f, 0xa4, 0xa7, 0x02, 0x88, 0x71, 0x0c, 0xf8, 0x9d, 0x8d, 0x42, 0x5f, 0x5d, 0xab, 0x1a,
+	0x25, 0x46, 0x60, 0x40, 0xf1, 0x17, 0x68, 0x06, 0x46, 0xbc, 0xd0, 0x70, 0x49, 0x6d, 0x5d, 0xa8,
+	0x9f, 0xd0, 0x13, 0xfe, 0xf7, 0x66, 0xdd, 0xa2, 0x3c, 0x0c, 0xea, 0xae, 0x6a, 0x61, 0x9b, 0x5f,
+	0x94, 0xef, 0x1c, 0x74, 0x77, 0x15, 0xdb, 0xfe, 0x35, 0x9d, 0x06, 0xe0, 0x9d, 0x40, 0x35, 0x4a,
+	0x2c, 0x55, 0x19, 0x25, 0xc3, 0xbf, 0x2c, 0x97, 0x78, 0x8d, 0x6a, 0xfc, 0x8d, 0x6d, 0x38, 0xd8,
+	0x66, 0x35, 0x11, 0xca, 0x91, 0x56, 0x2a, 0xd9, 0xd8, 0x71, 0x82, 0x1c, 0xf1, 0x65, 0x38, 0x7b,
+	0xa9, 0x68, 0xf6, 0x9a, 0x99, 0xe8, 0x0d, 0x67, 0x62, 0x07, 0xc6, 0xdb, 0x5d, 0xf0, 0x24, 0x3c,
+	0x80, 0x01, 0x9d, 0x98, 0x8e, 0x5b, 0xc3, 0x36, 0x6f, 0x0f, 0x47, 0xaa, 0xdb, 0x26, 0xd8, 0x73,
+	0x5c, 0xd3, 0x76, 0x97, 0x36, 0x78, 0xb9, 0xfa, 0x0b, 0xe9, 0x16, 0x9c, 0x61, 0x8e, 0xd7, 0xa9,
+	0x46, 0x0d, 0xbd, 0x99, 0xba, 0x15, 0xc3, 0xa1, 0x87, 0x56, 0x82, 0x54, 0x83, 0x7c, 0x67, 0xf0,
+	0x1b, 0x7f, 0x78, 0xd2, 0x1a, 0xfc, 0x8f, 0xb9, 0xbb, 0x5f, 0x2e, 0x63, 0x9d, 0x1a, 0x75, 0xbc,
+	0xca, 0x66, 0x48, 0xc0, 0x53, 0x6c, 0xcb, 0x54, 0x26, 0x14, 0xfc, 0x38, 0xf4, 0x7b, 0xaf, 0xa6,
+	0x79, 0x1d, 0x7c, 0x25, 0x7d, 0x2b, 0xc0, 0x64, 0xfc, 0x99, 0x9c, 0x7e, 0x11, 0xfa, 0xfd, 0x49,
+	0xc5, 0x93, 0x2f, 0xb6, 0xf5, 0x66, 0x6f, 0x96, 0x15, 0x38, 0x86, 0x5b, 0xa2, 0xbb, 0x30, 0x6c,
+	0x61, 0xb3, 0x64, 0x98, 0x15, 0x95, 0x63, 0x53, 0x87, 0x62, 0x87, 0x38, 0xc2, 0x5f, 0x4a, 0x7f,
+	0x0b, 0xbc, 0x95, 0xad, 0x97, 0xb6, 0xdb, 0x9f, 0xc5, 0x03, 0x38, 0x16, 0xbc, 0x6d, 0x9f, 0xd3,
+	0xff, 0xe3, 0xe7, 0x45, 0x87, 0x56, 0xa8, 0x04, 0x68, 0x34, 0x06, 0xfd, 0x35, 0x6d, 0x57, 0xd5,
+	0xdd, 0x70, 0x49, 0xb8, 0x68, 0x16, 0xd2, 0x5e, 0x76, 0x58, 0x81, 0x1e, 0x2f, 0x9e, 0x8a, 0x1e,
+	0xee, 0xed, 0x14, 0xd6, 0x2d, 0xac, 0x2b, 0xcc, 0x08, 0x2d, 0xc3, 0x89, 0x60, 0x44, 0xaa, 0x7c,
+	0x88, 0xa5, 0x19, 0x2e, 0x1f, 0xc5, 0x05, 0x46, 0x85, 0xfa, 0x3c, 0x1f, 0x64, 0xca, 0x70, 0xf0,
+	0xcd, 0x5f, 0x4b, 0x6f, 0xc3, 0xd9, 0xc8, 0xdc, 0x79, 0x44, 0x4c, 0xba, 0x55, 0xdd, 0x5b, 0xd5,
+	0xf6, 0x88, 0x4b, 0x43, 0x97, 0xdc, 0x6c, 0x32, 0x42, 0xb4, 0xc9, 0x48, 0xdb, 0x80, 0xd6, 0x43,
+	0x02, 0xc0, 0x07, 0x22, 0x09, 0x06, 0xc3, 0xb2, 0x80, 0xa3, 0x22, 0xdf, 0xd0, 0x04, 0x0c, 0xb0,
+	0x9a, 0xf6, 0x9a, 0x40, 0xe4, 0xbd, 0x96, 0xbc, 0xca, 0xd1, 0x6a, 0xc4, 0x35, 0x29, 0x7f, 0xb0,
+	0x7c, 0x25, 0x7d, 0x06, 0x52, 0x12, 0xdb, 0x56, 0x0b, 0xa3, 0x84, 0x6a, 0x55, 0xe6, 0x35, 0xad,
+	0xf8, 0x0b, 0xb4, 0x08, 0xc7, 0x4a, 0x98, 0x6a, 0x46, 0xd5, 0xc9, 0xa6, 0xd8, 0x8b, 0x98, 0x8e,
+	0xbf, 0xc1, 0x83, 0xd1, 0x28, 0x01, 0x50, 0xba, 0x07, 0xc3, 0x81, 0x6b, 0x1e, 0x68, 0x42, 0x6a,
+	0x42, 0x51, 0xa4, 0x22, 0x51, 0x3c, 0x86, 0xa1, 0x25, 0xff, 0x31, 0xf3, 0x43, 0xc2, 0x99, 0x10,
+	0xa2, 0x99, 0xb8, 0xe3, 0xd5, 0x9d, 0x67, 0x14, 0xb0, 0x3e, 0xd7, 0x41, 0xa7, 0x44, 0x68, 0x29,
+	0x01, 0x48, 0x5a, 0x82, 0xf3, 0x7e, 0x49, 0x87, 0xa2, 0xea, 0x74, 0xc7, 0x9d, 0x1e, 0xb2, 0xd4,
+	0x80, 0x0b, 0x87, 0x1d, 0x92, 0x98, 0xfa, 0xdb, 0xed, 0xa9, 0x9f, 0x8a, 0x0f, 0x22, 0x92, 0x95,
+	0x56, 0xd6, 0x73, 0xbc, 0x5d, 0x34, 0x7b, 0x1d, 0x1b, 0x23, 0x4b, 0x6e, 0xa0, 0xf0, 0x54, 0x38,
+	0xdd, 0x61, 0x9f, 0xb3, 0xba, 0x03, 0x69, 0xc3, 0x2c, 0x93, 0xac, 0xd0, 0x4d, 0x06, 0x97, 0xdc,
+	0x65, 0xb3, 0x4c, 0x78, 0x23, 0x64, 0x38, 0x69, 0x01, 0x86, 0xa3, 0xbb, 0x89, 0xd7, 0x3e, 0x0c,
+	0xa9, 0xe6, 0xeb, 0x4e, 0xe9, 0xae, 0xf4, 0x31, 0xef, 0xf6, 0x1b, 0x96, 0x4e, 0x6a, 0x86, 0x59,
+	0x79, 0xd4, 0x12, 0xae, 0x41, 0xf2, 0x13, 0x0a, 0x20, 0xec, 0x29, 0xd5, 0xf6, 0xf6, 0x76, 0x20,
+	0xdf, 0xf9, 0x64, 0x1e, 0xfb, 0x3a, 0x0c, 0x86, 0x94, 0x72, 0x30, 0x0d, 0x66, 0x92, 0x73, 0x10,
+	0x3a, 0x88, 0x27, 0x22, 0x72, 0x48, 0xf1, 0x9f, 0x11, 0xe8, 0x63, 0x9e, 0xd1, 0x97, 0x02, 0xf4,
+	0xfb, 0xad, 0x04, 0x4d, 0x27, 0x74, 0xc4, 0x88, 0x24, 0x17, 0x67, 0xba, 0xb0, 0xf4, 0xe9, 0x4b,
+	0xe7, 0xbe, 0xf8, 0xf5, 0xaf, 0xaf, 0x53, 0x39, 0x34, 0x29, 0x27, 0xfc, 0x3d, 0x84, 0xbe, 0x13,
+	0x20, 0xd3, 0x52, 0x20, 0xb3, 0x49, 0xc7, 0xb7, 0x49, 0x76, 0x71, 0xae, 0x3b, 0x63, 0x4e, 0x67,
+	0x9e, 0xd1, 0x99, 0x45, 0x33, 0x1d, 0xe8, 0x04, 0x00, 0x79, 0x9f, 0x8f, 0xeb, 0x06, 0xfa, 0x5e,
+	0x00, 0x68, 0x0d, 0x04, 0x34, 0xd7, 0xe5, 0xdc, 0xf0, 0xd9, 0x1d, 0x6d, 0xca, 0x48, 0x0b, 0x8c,
+	0xde, 0x35, 0xf4, 0x56, 0x3c, 0xbd, 0x0a, 0x6e, 0x2a, 0xd4, 0x16, 0x41, 0x79, 0xdf, 0x97, 0x92,
+	0x0d, 0xf4, 0xb3, 0x00, 0x43, 0x11, 0x51, 0x88, 0xe4, 0x04, 0xf7, 0x71, 0x02, 0x56, 0xbc, 0xdc,
+	0x3d, 0x80, 0x53, 0x56, 0x18, 0xe5, 0x15, 0xf4, 0x30, 0x9e, 0x72, 0x9d, 0x81, 0x12, 0x58, 0xcb,
+	0xfb, 0x41, 0xd2, 0x1b, 0xf2, 0x3e, 0xd3, 0x75, 0x0d, 0xf4, 0x54, 0x80, 0x4c, 0x53, 0xd4, 0x25,
+	0x96, 0x43, 0xbb, 0xba, 0x14, 0xe7, 0xba, 0x33, 0xee, 0x2e, 0xdf, 0xae, 0xe3, 0xa9, 0x60, 0x0f,
+	0x21, 0xef, 0x73, 0x91, 0xda, 0x08, 0x55, 0xc6, 0x4f, 0x02, 0x9c, 0x8c, 0x51, 0x71, 0xe8, 0x6a,
+	0x02, 0x87, 0xce, 0x92, 0x51, 0xbc, 0x76, 0x54, 0x18, 0x0f, 0xe2, 0x36, 0x0b, 0xe2, 0x3a, 0xba,
+	0x1a, 0x1f, 0x84, 0xc3, 0xa0, 0x2d, 0xdd, 0xaf, 0x56, 0x0d, 0x87, 0x86, 0xa2, 0xf8, 0x51, 0x80,
+	0x13, 0x6d, 0x42, 0x0e, 0xcd, 0x27, 0x50, 0x89, 0x17, 0x92, 0x62, 0xf1, 0x28, 0x10, 0xce, 0x7c,
+	0x91, 0x31, 0x5f, 0x40, 0x37, 0xe3, 0x99, 0xe3, 0x00, 0xc6, 0x15, 0xa1, 0xbc, 0x1f, 0x4c, 0xb4,
+	0x86, 0xbc, 0xef, 0x6b, 0xd1, 0x06, 0xfa, 0x45, 0x80, 0xb1, 0x58, 0x39, 0x81, 0xae, 0x77, 0xd1,
+	0x19, 0xe2, 0x46, 0xa9, 0x78, 0xe3, 0xe8, 0x40, 0x1e, 0xd0, 0x3b, 0x2c, 0xa0, 0x9b, 0xe8, 0x46,
+	0x72, 0x7b, 0x51, 0x6b, 0x3e, 0x5a, 0xf5, 0xa7, 0x7c, 0xe8, 0x09, 0xa0, 0xdf, 0x05, 0x98, 0xe8,
+	0x38, 0xa6, 0xd1, 0xad, 0xa4, 0x12, 0x39, 0x44, 0x21, 0x88, 0x0b, 0xaf, 0x07, 0xe6, 0xa1, 0xdd,
+	0x63, 0xa1, 0xdd, 0x41, 0x0b, 0x1d, 0xaa, 0x2c, 0x74, 0xc0, 0x81, 0xf0, 0x9a, 0xd7, 0x86, 0xbe,
+	0x11, 0x00, 0x5a, 0xea, 0xfc, 0x0d, 0x36, 0xd3, 0x83, 0x92, 0x5f, 0x9a, 0x61, 0x8c, 0xa7, 0xd0,
+	0xd9, 0x0e, 0x8c, 0x4b, 0xdb, 0x41, 0x5b, 0x42, 0xcf, 0x04, 0x18, 0x69, 0x57, 0x1f, 0xa8, 0xd8,
+	0xcd, 0x64, 0x89, 0x4a, 0x19, 0xf1, 0xca, 0x91, 0x30, 0x9c, 0xe8, 0x65, 0x46, 0xf4, 0x12, 0x9a,
+	0x3e, 0x64, 0x28, 0xf9, 0x7f, 0x90, 0xab, 0xba, 0x8b, 0x7e, 0x10, 0xe0, 0x64, 0x8c, 0x68, 0x48,
+	0xec, 0x3c, 0x9d, 0xe5, 0x8b, 0x78, 0xed, 0xa8, 0x30, 0x4e, 0xbc, 0xc8, 0x88, 0xcf, 0xa1, 0x4b,
+	0x1d, 0xda, 0x27, 0x87, 0xaa, 0x21, 0xed, 0xb1, 0x78, 0xf7, 0xf9, 0xcb, 0x9c, 0xf0, 0xe2, 0x65,
+	0x4e, 0xf8, 0xf3, 0x65, 0x4e, 0xf8, 0xea, 0x55, 0xae, 0xe7, 0xc5, 0xab, 0x5c, 0xcf, 0x6f, 0xaf,
+	0x72, 0x3d, 0x9f, 0x5c, 0xac, 0x18, 0x74, 0xcb, 0xdd, 0x2c, 0xe8, 0xa4, 0x16, 0x3d, 0x6f, 0xb7,
+	0x79, 0x22, 0xdd, 0xb3, 0xb0, 0xb3, 0xd9, 0xcf, 0xfe, 0xbb, 0xf0, 0xca, 0xbf, 0x03, 0x00, 0x96,
+	0xc9, 0x65, 0x29, 0xfb, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1510,6 +1614,8 @@ type QueryClient interface {
 	SdkPairing(ctx context.Context, in *QueryGetPairingRequest, opts ...grpc.CallOption) (*QuerySdkPairingResponse, error)
 	// Queries a for the aggregated CU of all ProviderEpochCu objects all the providers.
 	ProvidersEpochCu(ctx context.Context, in *QueryProvidersEpochCuRequest, opts ...grpc.CallOption) (*QueryProvidersEpochCuResponse, error)
+	// Queries the ongoing and upcoming maintenance windows of providers.
+	UpcomingMaintenance(ctx context.Context, in *QueryUpcomingMaintenanceRequest, opts ...grpc.CallOption) (*QueryUpcomingMaintenanceResponse, error)
 }
 
 type queryClient struct {
@@ -1619,6 +1725,15 @@ func (c *queryClient) ProvidersEpochCu(ctx context.Context, in *QueryProvidersEp
 	return out, nil
 }
 
+func (c *queryClient) UpcomingMaintenance(ctx context.Context, in *QueryUpcomingMaintenanceRequest, opts ...grpc.CallOption) (*QueryUpcomingMaintenanceResponse, error) {
+	out := new(QueryUpcomingMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/UpcomingMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -1643,6 +1758,8 @@ type QueryServer interface {
 	SdkPairing(context.Context, *QueryGetPairingRequest) (*QuerySdkPairingResponse, error)
 	// Queries a for the aggregated CU of all ProviderEpochCu objects all the providers.
 	ProvidersEpochCu(context.Context, *QueryProvidersEpochCuRequest) (*QueryProvidersEpochCuResponse, error)
+	// Queries the ongoing and upcoming maintenance windows of providers.
+	UpcomingMaintenance(context.Context, *QueryUpcomingMaintenanceRequest) (*QueryUpcomingMaintenanceResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1682,6 +1799,9 @@ func (*UnimplementedQueryServer) SdkPairing(ctx context.Context, req *QueryGetPa
 func (*UnimplementedQueryServer) ProvidersEpochCu(ctx context.Context, req *QueryProvidersEpochCuRequest) (*QueryProvidersEpochCuResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProvidersEpochCu not implemented")
 }
+func (*UnimplementedQueryServer) UpcomingMaintenance(ctx context.Context, req *QueryUpcomingMaintenanceRequest) (*QueryUpcomingMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpcomingMaintenance not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1885,6 +2005,24 @@ func _Query_ProvidersEpochCu_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UpcomingMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpcomingMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpcomingMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/UpcomingMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpcomingMaintenance(ctx, req.(*QueryUpcomingMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.pairing.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1933,6 +2071,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ProvidersEpochCu",
 			Handler:    _Query_ProvidersEpochCu_Handler,
 		},
+		{
+			MethodName: "UpcomingMaintenance",
+			Handler:    _Query_UpcomingMaintenance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/pairing/query.proto",
@@ -2919,6 +3061,80 @@ func (m *ProviderCuInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryUpcomingMaintenanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpcomingMaintenanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpcomingMaintenanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpcomingMaintenanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpcomingMaintenanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpcomingMaintenanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Maintenances) > 0 {
+		for iNdEx := len(m.Maintenances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Maintenances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3348,6 +3564,38 @@ func (m *ProviderCuInfo) Size() (n int) {
 	return n
 }
 
+func (m *QueryUpcomingMaintenanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUpcomingMaintenanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Maintenances) > 0 {
+		for _, e := range m.Maintenances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6021,6 +6269,204 @@ func (m *ProviderCuInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryUpcomingMaintenanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpcomingMaintenanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpcomingMaintenanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpcomingMaintenanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpcomingMaintenanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpcomingMaintenanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maintenances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maintenances = append(m.Maintenances, ProviderMaintenance{})
+			if err := m.Maintenances[len(m.Maintenances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
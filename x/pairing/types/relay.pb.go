@@ -763,6 +763,142 @@ func (m *RelayReply) GetMetadata() []Metadata {
 	return nil
 }
 
+type RelayChunk struct {
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Data  []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Sig   []byte `protobuf:"bytes,3,opt,name=sig,proto3" json:"sig,omitempty"`
+}
+
+func (m *RelayChunk) Reset()         { *m = RelayChunk{} }
+func (m *RelayChunk) String() string { return proto.CompactTextString(m) }
+func (*RelayChunk) ProtoMessage()    {}
+func (*RelayChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a61d253b10eeeb9e, []int{9}
+}
+func (m *RelayChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayChunk.Merge(m, src)
+}
+func (m *RelayChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayChunk proto.InternalMessageInfo
+
+func (m *RelayChunk) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *RelayChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *RelayChunk) GetSig() []byte {
+	if m != nil {
+		return m.Sig
+	}
+	return nil
+}
+
+type RelayStreamReply struct {
+	Chunk       *RelayChunk `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Reply       *RelayReply `protobuf:"bytes,2,opt,name=reply,proto3" json:"reply,omitempty"`
+	TotalChunks uint64      `protobuf:"varint,3,opt,name=total_chunks,json=totalChunks,proto3" json:"total_chunks,omitempty"`
+	Metadata    []Metadata  `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata"`
+	StatusCode  int64       `protobuf:"varint,5,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+}
+
+func (m *RelayStreamReply) Reset()         { *m = RelayStreamReply{} }
+func (m *RelayStreamReply) String() string { return proto.CompactTextString(m) }
+func (*RelayStreamReply) ProtoMessage()    {}
+func (*RelayStreamReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a61d253b10eeeb9e, []int{10}
+}
+func (m *RelayStreamReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayStreamReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayStreamReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayStreamReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayStreamReply.Merge(m, src)
+}
+func (m *RelayStreamReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayStreamReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayStreamReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayStreamReply proto.InternalMessageInfo
+
+func (m *RelayStreamReply) GetChunk() *RelayChunk {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
+func (m *RelayStreamReply) GetReply() *RelayReply {
+	if m != nil {
+		return m.Reply
+	}
+	return nil
+}
+
+func (m *RelayStreamReply) GetTotalChunks() uint64 {
+	if m != nil {
+		return m.TotalChunks
+	}
+	return 0
+}
+
+func (m *RelayStreamReply) GetMetadata() []Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *RelayStreamReply) GetStatusCode() int64 {
+	if m != nil {
+		return m.StatusCode
+	}
+	return 0
+}
+
 type QualityOfServiceReport struct {
 	Latency      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=latency,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"latency" yaml:"Latency"`
 	Availability github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=availability,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"availability" yaml:"availability"`
@@ -773,7 +909,7 @@ func (m *QualityOfServiceReport) Reset()         { *m = QualityOfServiceReport{}
 func (m *QualityOfServiceReport) String() string { return proto.CompactTextString(m) }
 func (*QualityOfServiceReport) ProtoMessage()    {}
 func (*QualityOfServiceReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a61d253b10eeeb9e, []int{9}
+	return fileDescriptor_a61d253b10eeeb9e, []int{11}
 }
 func (m *QualityOfServiceReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -812,89 +948,98 @@ func init() {
 	proto.RegisterType((*Metadata)(nil), "lavanet.lava.pairing.Metadata")
 	proto.RegisterType((*RelayRequest)(nil), "lavanet.lava.pairing.RelayRequest")
 	proto.RegisterType((*RelayReply)(nil), "lavanet.lava.pairing.RelayReply")
+	proto.RegisterType((*RelayChunk)(nil), "lavanet.lava.pairing.RelayChunk")
+	proto.RegisterType((*RelayStreamReply)(nil), "lavanet.lava.pairing.RelayStreamReply")
 	proto.RegisterType((*QualityOfServiceReport)(nil), "lavanet.lava.pairing.QualityOfServiceReport")
 }
 
 func init() { proto.RegisterFile("lavanet/lava/pairing/relay.proto", fileDescriptor_a61d253b10eeeb9e) }
 
 var fileDescriptor_a61d253b10eeeb9e = []byte{
-	// 1206 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0xf6, 0xfa, 0x27, 0xb6, 0x8f, 0xb7, 0x69, 0x99, 0x36, 0xad, 0x95, 0x0a, 0xc7, 0x5d, 0xa4,
-	0x34, 0x42, 0x60, 0x43, 0x40, 0x5c, 0x20, 0x21, 0xb5, 0xa6, 0x11, 0x04, 0x0a, 0x4d, 0x37, 0x70,
-	0x13, 0x09, 0x6d, 0xc7, 0xbb, 0x93, 0xcd, 0xd0, 0xf5, 0xce, 0x66, 0x66, 0xd6, 0xc4, 0xbc, 0x00,
-	0x57, 0x48, 0x3c, 0x04, 0x4f, 0xc0, 0x43, 0x54, 0xbd, 0xec, 0x25, 0x42, 0x22, 0x42, 0xc9, 0x1b,
-	0x20, 0x1e, 0x00, 0xcd, 0x8f, 0xff, 0x12, 0x27, 0x28, 0xc0, 0xd5, 0xce, 0x7c, 0x73, 0xf6, 0x9c,
-	0x33, 0xdf, 0x39, 0xe7, 0xdb, 0x85, 0x76, 0x82, 0x87, 0x38, 0x25, 0xb2, 0xab, 0x9e, 0xdd, 0x0c,
-	0x53, 0x4e, 0xd3, 0xb8, 0xcb, 0x49, 0x82, 0x47, 0x9d, 0x8c, 0x33, 0xc9, 0xd0, 0x2d, 0x6b, 0xd1,
-	0x51, 0xcf, 0x8e, 0xb5, 0x58, 0xbd, 0x15, 0xb3, 0x98, 0x69, 0x83, 0xae, 0x5a, 0x19, 0xdb, 0xd5,
-	0x56, 0xcc, 0x58, 0x9c, 0x90, 0xae, 0xde, 0xf5, 0xf3, 0xfd, 0xee, 0x77, 0x1c, 0x67, 0x19, 0xe1,
-	0xc2, 0x9e, 0xaf, 0x9d, 0x3d, 0x97, 0x74, 0x40, 0x84, 0xc4, 0x83, 0xcc, 0x18, 0x78, 0xcf, 0xc0,
-	0xdd, 0xe1, 0xac, 0x4f, 0x7c, 0x72, 0x98, 0x13, 0x21, 0x11, 0x82, 0x72, 0x9c, 0xd3, 0xa8, 0xe9,
-	0xb4, 0x9d, 0x8d, 0xb2, 0xaf, 0xd7, 0xe8, 0x0e, 0x54, 0x45, 0x46, 0xc2, 0x80, 0x46, 0xcd, 0x62,
-	0xdb, 0xd9, 0xa8, 0xfb, 0x4b, 0x6a, 0xbb, 0x1d, 0xa1, 0x37, 0xe0, 0x1a, 0xce, 0x68, 0x40, 0x53,
-	0x49, 0xf8, 0x3e, 0x0e, 0x49, 0xb3, 0xa4, 0x8f, 0x5d, 0x9c, 0xd1, 0xed, 0x31, 0xe6, 0xbd, 0x70,
-	0x00, 0x6c, 0x88, 0x2c, 0x19, 0x2d, 0x0c, 0x70, 0x0f, 0xdc, 0x04, 0x4b, 0x22, 0x64, 0xd0, 0x4f,
-	0x58, 0xf8, 0x5c, 0x47, 0x29, 0xf9, 0x0d, 0x83, 0xf5, 0x14, 0x84, 0x3e, 0x80, 0x3b, 0xfb, 0x34,
-	0xc5, 0x09, 0xfd, 0x9e, 0x44, 0xc6, 0x4a, 0x04, 0x07, 0x58, 0x1c, 0x10, 0xa1, 0x83, 0xba, 0xfe,
-	0xca, 0xe4, 0x58, 0xbf, 0x20, 0x3e, 0xd5, 0x87, 0xe8, 0x75, 0x00, 0x45, 0x63, 0x40, 0x32, 0x16,
-	0x1e, 0x34, 0xcb, 0x3a, 0x68, 0x5d, 0x21, 0x5b, 0x0a, 0x40, 0x6f, 0xc2, 0x6b, 0xfa, 0x78, 0x2e,
-	0x7c, 0x45, 0x5b, 0x5d, 0x57, 0x07, 0x8f, 0xa7, 0x29, 0x78, 0x2f, 0xca, 0xe0, 0xfa, 0xaa, 0x4e,
-	0xbb, 0x44, 0x08, 0xca, 0xd2, 0x59, 0x5e, 0x9c, 0x39, 0x5e, 0xee, 0x81, 0x1b, 0xb2, 0x54, 0x92,
-	0x54, 0xea, 0x1c, 0xf5, 0x7d, 0x5c, 0xbf, 0x61, 0x31, 0x95, 0x99, 0xca, 0x4b, 0x18, 0x37, 0xea,
-	0xf5, 0x92, 0xc9, 0xcb, 0x22, 0xdb, 0x11, 0x5a, 0x81, 0xa5, 0x30, 0x0f, 0x44, 0x3e, 0xb0, 0x29,
-	0x57, 0xc2, 0x7c, 0x37, 0x1f, 0xa0, 0x55, 0xa8, 0x65, 0x9c, 0x0d, 0x69, 0x44, 0xb8, 0xce, 0xb2,
-	0xee, 0x4f, 0xf6, 0xe8, 0x2e, 0xd4, 0x75, 0x17, 0x05, 0x69, 0x3e, 0x68, 0x2e, 0xe9, 0xb7, 0x6a,
-	0x1a, 0xf8, 0x32, 0x1f, 0xa0, 0xcf, 0x01, 0x0e, 0x99, 0x08, 0x38, 0xc9, 0x18, 0x97, 0xcd, 0x6a,
-	0xdb, 0xd9, 0x68, 0x6c, 0xbe, 0xd5, 0x59, 0xd4, 0x68, 0x9d, 0xa7, 0x39, 0x4e, 0xa8, 0x1c, 0x3d,
-	0xd9, 0xdf, 0x25, 0x7c, 0x48, 0x43, 0x55, 0x36, 0xc6, 0xa5, 0x5f, 0x3f, 0x64, 0xc2, 0x2c, 0xd1,
-	0x2d, 0xa8, 0x18, 0x3a, 0x6b, 0xba, 0x4e, 0x66, 0x83, 0xbe, 0x81, 0xdb, 0x79, 0xca, 0x89, 0xc8,
-	0x58, 0x2a, 0xe8, 0x90, 0x04, 0xe3, 0xc4, 0x44, 0xb3, 0xde, 0x2e, 0x6d, 0x34, 0x36, 0xd7, 0x17,
-	0x87, 0x33, 0x3e, 0x49, 0xb4, 0x63, 0xcd, 0xfd, 0x95, 0x59, 0x2f, 0x63, 0x54, 0x20, 0x0f, 0xae,
-	0xe9, 0x4a, 0x85, 0x07, 0x98, 0x6a, 0xce, 0x40, 0xdf, 0xbf, 0xa1, 0xc0, 0x8f, 0x15, 0xb6, 0x1d,
-	0xa1, 0x1b, 0x50, 0x12, 0x34, 0x6e, 0x36, 0x34, 0xdd, 0x6a, 0x89, 0xde, 0x85, 0x4a, 0x1f, 0x47,
-	0x31, 0x69, 0xba, 0xfa, 0xca, 0x77, 0x17, 0xe7, 0xd0, 0x53, 0x26, 0xbe, 0xb1, 0x44, 0xcf, 0x60,
-	0x45, 0x51, 0x45, 0x8e, 0x42, 0x92, 0x24, 0x24, 0x0d, 0xc9, 0x98, 0xb5, 0x6b, 0xff, 0x82, 0xb5,
-	0x9b, 0x87, 0x4c, 0x6c, 0x4d, 0x3c, 0x19, 0x50, 0x4d, 0x44, 0x45, 0x87, 0x54, 0x03, 0x14, 0xe6,
-	0x01, 0x4e, 0x12, 0x16, 0x62, 0x49, 0x59, 0x6a, 0xa7, 0xc2, 0x0d, 0xf3, 0x87, 0x13, 0x6c, 0x4a,
-	0x77, 0xd1, 0xb4, 0x82, 0xa1, 0xbb, 0x09, 0x55, 0x1c, 0x45, 0x9c, 0x08, 0x61, 0xa7, 0x6e, 0xbc,
-	0x3d, 0xcf, 0x54, 0xf9, 0x3c, 0x53, 0x6b, 0xd0, 0xc8, 0x38, 0xfb, 0x96, 0x84, 0x32, 0x50, 0x8c,
-	0x55, 0x34, 0x63, 0x60, 0xa1, 0x5d, 0x1a, 0xab, 0xcc, 0x86, 0x94, 0xcb, 0x1c, 0x27, 0x76, 0x74,
-	0x4c, 0x47, 0xb9, 0x16, 0xd4, 0xd3, 0xe3, 0xfd, 0x5e, 0x84, 0x1b, 0x7a, 0x22, 0x76, 0x38, 0x1d,
-	0x62, 0x49, 0x1e, 0x61, 0x89, 0xd1, 0x7d, 0xb8, 0x1e, 0xb2, 0x34, 0x25, 0xa1, 0x4a, 0x3e, 0x90,
-	0xa3, 0x8c, 0xd8, 0xe9, 0x58, 0x9e, 0xc2, 0x5f, 0x8d, 0x32, 0xa2, 0xc6, 0x47, 0xa9, 0x47, 0xce,
-	0x93, 0xb1, 0xac, 0xe0, 0x8c, 0x7e, 0xcd, 0x13, 0x25, 0x11, 0x11, 0x96, 0xd8, 0x0e, 0xb6, 0x5e,
-	0xab, 0x7c, 0xb8, 0x91, 0x28, 0x3b, 0xa4, 0x65, 0xdd, 0x7b, 0xae, 0x05, 0x8d, 0x48, 0x9c, 0xd3,
-	0xa3, 0xca, 0x79, 0x3d, 0x52, 0xde, 0x05, 0x4e, 0xa4, 0xbe, 0x90, 0xeb, 0xeb, 0x35, 0x7a, 0x00,
-	0xb5, 0x01, 0x91, 0x58, 0x47, 0xad, 0xea, 0x6e, 0x6d, 0x2d, 0x2e, 0xf3, 0x17, 0xd6, 0xaa, 0x57,
-	0x7e, 0x79, 0xbc, 0x56, 0xf0, 0x27, 0x6f, 0xa9, 0x22, 0xe1, 0x28, 0x62, 0xa9, 0x9e, 0x89, 0xba,
-	0x6f, 0x36, 0xa8, 0x05, 0x40, 0x8e, 0x24, 0x49, 0xd5, 0x54, 0x9b, 0x39, 0xa8, 0xfb, 0x33, 0x88,
-	0x51, 0x01, 0x92, 0xda, 0x2b, 0x81, 0xbe, 0x52, 0x5d, 0x21, 0x46, 0x71, 0x7e, 0x74, 0x14, 0xbf,
-	0xf3, 0xf3, 0x31, 0x5b, 0x78, 0x67, 0xbe, 0xf0, 0xeb, 0xb0, 0x1c, 0x51, 0x31, 0x65, 0x59, 0xd8,
-	0x8e, 0x39, 0x83, 0xa2, 0xdb, 0xb0, 0x44, 0x38, 0x67, 0x5c, 0x58, 0xdd, 0xb1, 0x3b, 0xd5, 0x14,
-	0x93, 0xcf, 0x43, 0x20, 0x2c, 0xc3, 0x30, 0x81, 0x76, 0xbd, 0xf7, 0xa1, 0x36, 0x26, 0x40, 0xd1,
-	0x98, 0xe2, 0xc1, 0xb8, 0xb6, 0x7a, 0xad, 0x48, 0x18, 0xe2, 0x24, 0x27, 0xb6, 0x9e, 0x66, 0xe3,
-	0xfd, 0xec, 0x58, 0xdd, 0x1c, 0x7f, 0x63, 0x3e, 0x51, 0xb5, 0x54, 0x4a, 0x65, 0xf5, 0x4e, 0xfb,
-	0x68, 0x6c, 0x7a, 0x17, 0x09, 0xc4, 0x54, 0x72, 0x55, 0xbd, 0x67, 0x04, 0x78, 0x0b, 0xc0, 0x38,
-	0xd2, 0x85, 0x2b, 0x6a, 0x2f, 0xeb, 0x97, 0x78, 0x99, 0x69, 0x53, 0xdf, 0x88, 0xa5, 0x5a, 0x7e,
-	0x56, 0xae, 0x95, 0x6e, 0x94, 0xbd, 0xbf, 0x1c, 0x00, 0x9b, 0xa6, 0xfd, 0x4e, 0x69, 0xaf, 0xce,
-	0x4c, 0x13, 0x5a, 0x7d, 0x29, 0x4e, 0xf5, 0xe5, 0xec, 0x97, 0xab, 0x7c, 0xa5, 0x2f, 0x57, 0xe5,
-	0x1f, 0xbe, 0x5c, 0x82, 0xc6, 0xf6, 0x0d, 0xdb, 0xad, 0x75, 0x41, 0x63, 0x63, 0xf4, 0xdf, 0x5b,
-	0xd6, 0x5e, 0xfb, 0x97, 0x22, 0xdc, 0x5e, 0x2c, 0x5e, 0x68, 0x0f, 0xaa, 0xea, 0x22, 0x69, 0x38,
-	0x32, 0x55, 0xee, 0x3d, 0x50, 0x1e, 0x7e, 0x3b, 0x5e, 0x5b, 0x8f, 0xa9, 0x3c, 0xc8, 0xfb, 0x9d,
-	0x90, 0x0d, 0xba, 0x21, 0x13, 0x03, 0x26, 0xec, 0xe3, 0x6d, 0x11, 0x3d, 0xef, 0xaa, 0x91, 0x17,
-	0x9d, 0x47, 0x24, 0xfc, 0xf3, 0x78, 0x6d, 0x79, 0x84, 0x07, 0xc9, 0x87, 0xde, 0x63, 0xe3, 0xc6,
-	0xf3, 0xc7, 0x0e, 0x11, 0x05, 0x17, 0x0f, 0x31, 0x4d, 0x70, 0x9f, 0xaa, 0xd0, 0xa6, 0x63, 0x7a,
-	0x5b, 0x57, 0x0e, 0x70, 0xd3, 0x04, 0x98, 0xf5, 0xe5, 0xf9, 0x73, 0xae, 0xd1, 0x53, 0x28, 0x8b,
-	0x51, 0x1a, 0x1a, 0x99, 0xec, 0x7d, 0x74, 0xe5, 0x10, 0x0d, 0x13, 0x42, 0xf9, 0xf0, 0x7c, 0xed,
-	0x6a, 0xf3, 0x87, 0x22, 0x54, 0x75, 0xaf, 0x10, 0x8e, 0x9e, 0x40, 0x45, 0x2f, 0xd1, 0x65, 0xfd,
-	0x6b, 0x5b, 0x7f, 0xb5, 0x7d, 0xa9, 0x4d, 0x96, 0x8c, 0xbc, 0x02, 0xda, 0x83, 0x65, 0xd3, 0xf3,
-	0x79, 0x5f, 0x84, 0x9c, 0xf6, 0xc9, 0xff, 0xe5, 0xf9, 0x1d, 0x47, 0x25, 0xab, 0xff, 0xc5, 0x2e,
-	0x72, 0x39, 0xfb, 0x2f, 0x78, 0x91, 0xcb, 0xe9, 0xcf, 0x9c, 0x57, 0xe8, 0x3d, 0x7c, 0x79, 0xd2,
-	0x72, 0x5e, 0x9d, 0xb4, 0x9c, 0x3f, 0x4e, 0x5a, 0xce, 0x4f, 0xa7, 0xad, 0xc2, 0xab, 0xd3, 0x56,
-	0xe1, 0xd7, 0xd3, 0x56, 0x61, 0xef, 0xfe, 0x0c, 0xc1, 0x73, 0xff, 0xbc, 0x47, 0x93, 0xbf, 0x5e,
-	0xcd, 0x72, 0x7f, 0x49, 0xff, 0x89, 0xbe, 0xf7, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xda, 0x6e,
-	0xf9, 0x4b, 0x1a, 0x0b, 0x00, 0x00,
+	// 1324 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x6e, 0x1c, 0xc5,
+	0x12, 0xf6, 0xec, 0x8f, 0xed, 0xad, 0x1d, 0x3b, 0x3e, 0x9d, 0x38, 0x59, 0x39, 0x3a, 0xeb, 0xcd,
+	0x1c, 0x29, 0xb1, 0x8e, 0x60, 0x17, 0x0c, 0xca, 0x05, 0x12, 0x52, 0xb2, 0x89, 0x45, 0x0c, 0x81,
+	0x24, 0x63, 0xb8, 0x89, 0x14, 0x4d, 0x7a, 0x67, 0xda, 0xeb, 0x26, 0xb3, 0xd3, 0xe3, 0xee, 0x9e,
+	0xc5, 0xcb, 0x13, 0x70, 0x83, 0xc4, 0x43, 0xf0, 0x04, 0x3c, 0x44, 0x94, 0xcb, 0x5c, 0x22, 0x24,
+	0x22, 0x94, 0x48, 0x3c, 0x00, 0xe2, 0x01, 0x50, 0x57, 0xf7, 0xfe, 0xc5, 0x8e, 0x43, 0x02, 0x57,
+	0xdb, 0x55, 0x5d, 0x5d, 0x55, 0xfd, 0x55, 0xd5, 0xd7, 0x3b, 0xd0, 0x4a, 0xe9, 0x90, 0x66, 0x4c,
+	0x77, 0xcc, 0x6f, 0x27, 0xa7, 0x5c, 0xf2, 0xac, 0xdf, 0x91, 0x2c, 0xa5, 0xa3, 0x76, 0x2e, 0x85,
+	0x16, 0xe4, 0x9c, 0xb3, 0x68, 0x9b, 0xdf, 0xb6, 0xb3, 0xd8, 0x38, 0xd7, 0x17, 0x7d, 0x81, 0x06,
+	0x1d, 0xb3, 0xb2, 0xb6, 0x1b, 0xcd, 0xbe, 0x10, 0xfd, 0x94, 0x75, 0x50, 0xea, 0x15, 0xfb, 0x9d,
+	0x6f, 0x24, 0xcd, 0x73, 0x26, 0x95, 0xdb, 0xdf, 0x7c, 0x79, 0x5f, 0xf3, 0x01, 0x53, 0x9a, 0x0e,
+	0x72, 0x6b, 0x10, 0x3c, 0x04, 0xff, 0xae, 0x14, 0x3d, 0x16, 0xb2, 0xc3, 0x82, 0x29, 0x4d, 0x08,
+	0x54, 0xfa, 0x05, 0x4f, 0x1a, 0x5e, 0xcb, 0xdb, 0xaa, 0x84, 0xb8, 0x26, 0x17, 0x60, 0x49, 0xe5,
+	0x2c, 0x8e, 0x78, 0xd2, 0x28, 0xb5, 0xbc, 0xad, 0x5a, 0xb8, 0x68, 0xc4, 0xdd, 0x84, 0xfc, 0x0f,
+	0x56, 0x68, 0xce, 0x23, 0x9e, 0x69, 0x26, 0xf7, 0x69, 0xcc, 0x1a, 0x65, 0xdc, 0xf6, 0x69, 0xce,
+	0x77, 0xc7, 0xba, 0xe0, 0xb1, 0x07, 0xe0, 0x42, 0xe4, 0xe9, 0xe8, 0xc4, 0x00, 0x97, 0xc0, 0x4f,
+	0xa9, 0x66, 0x4a, 0x47, 0xbd, 0x54, 0xc4, 0x8f, 0x30, 0x4a, 0x39, 0xac, 0x5b, 0x5d, 0xd7, 0xa8,
+	0xc8, 0x55, 0xb8, 0xb0, 0xcf, 0x33, 0x9a, 0xf2, 0x6f, 0x59, 0x62, 0xad, 0x54, 0x74, 0x40, 0xd5,
+	0x01, 0x53, 0x18, 0xd4, 0x0f, 0xd7, 0x27, 0xdb, 0x78, 0x40, 0xdd, 0xc2, 0x4d, 0xf2, 0x5f, 0x00,
+	0x03, 0x63, 0xc4, 0x72, 0x11, 0x1f, 0x34, 0x2a, 0x18, 0xb4, 0x66, 0x34, 0x3b, 0x46, 0x41, 0xfe,
+	0x0f, 0xff, 0xc1, 0xed, 0xb9, 0xf0, 0x55, 0xb4, 0x3a, 0x63, 0x36, 0x6e, 0x4f, 0x53, 0x08, 0x1e,
+	0x57, 0xc0, 0x0f, 0x4d, 0x9d, 0xf6, 0x98, 0x52, 0x5c, 0x64, 0xb3, 0xb8, 0x78, 0x73, 0xb8, 0x5c,
+	0x02, 0x3f, 0x16, 0x99, 0x66, 0x99, 0xc6, 0x1c, 0xf1, 0x3e, 0x7e, 0x58, 0x77, 0x3a, 0x93, 0x99,
+	0xc9, 0x4b, 0x59, 0x37, 0xe6, 0x78, 0xd9, 0xe6, 0xe5, 0x34, 0xbb, 0x09, 0x59, 0x87, 0xc5, 0xb8,
+	0x88, 0x54, 0x31, 0x70, 0x29, 0x57, 0xe3, 0x62, 0xaf, 0x18, 0x90, 0x0d, 0x58, 0xce, 0xa5, 0x18,
+	0xf2, 0x84, 0x49, 0xcc, 0xb2, 0x16, 0x4e, 0x64, 0x72, 0x11, 0x6a, 0xd8, 0x45, 0x51, 0x56, 0x0c,
+	0x1a, 0x8b, 0x78, 0x6a, 0x19, 0x15, 0x5f, 0x14, 0x03, 0xf2, 0x19, 0xc0, 0xa1, 0x50, 0x91, 0x64,
+	0xb9, 0x90, 0xba, 0xb1, 0xd4, 0xf2, 0xb6, 0xea, 0xdb, 0xef, 0xb4, 0x4f, 0x6a, 0xb4, 0xf6, 0xbd,
+	0x82, 0xa6, 0x5c, 0x8f, 0xee, 0xec, 0xef, 0x31, 0x39, 0xe4, 0xb1, 0x29, 0x9b, 0x90, 0x3a, 0xac,
+	0x1d, 0x0a, 0x65, 0x97, 0xe4, 0x1c, 0x54, 0x2d, 0x9c, 0xcb, 0x58, 0x27, 0x2b, 0x90, 0x07, 0x70,
+	0xbe, 0xc8, 0x24, 0x53, 0xb9, 0xc8, 0x14, 0x1f, 0xb2, 0x68, 0x9c, 0x98, 0x6a, 0xd4, 0x5a, 0xe5,
+	0xad, 0xfa, 0xf6, 0xe5, 0x93, 0xc3, 0x59, 0x9f, 0x2c, 0xb9, 0xeb, 0xcc, 0xc3, 0xf5, 0x59, 0x2f,
+	0x63, 0xad, 0x22, 0x01, 0xac, 0x60, 0xa5, 0xe2, 0x03, 0xca, 0x11, 0x33, 0xc0, 0xfb, 0xd7, 0x8d,
+	0xf2, 0x86, 0xd1, 0xed, 0x26, 0x64, 0x0d, 0xca, 0x8a, 0xf7, 0x1b, 0x75, 0x84, 0xdb, 0x2c, 0xc9,
+	0xfb, 0x50, 0xed, 0xd1, 0xa4, 0xcf, 0x1a, 0x3e, 0x5e, 0xf9, 0xe2, 0xc9, 0x39, 0x74, 0x8d, 0x49,
+	0x68, 0x2d, 0xc9, 0x43, 0x58, 0x37, 0x50, 0xb1, 0xa3, 0x98, 0xa5, 0x29, 0xcb, 0x62, 0x36, 0x46,
+	0x6d, 0xe5, 0x2d, 0x50, 0x3b, 0x7b, 0x28, 0xd4, 0xce, 0xc4, 0x93, 0x55, 0x9a, 0x89, 0xa8, 0x62,
+	0x48, 0x33, 0x40, 0x71, 0x11, 0xd1, 0x34, 0x15, 0x31, 0xd5, 0x5c, 0x64, 0x6e, 0x2a, 0xfc, 0xb8,
+	0xb8, 0x3e, 0xd1, 0x4d, 0xe1, 0x2e, 0xd9, 0x56, 0x40, 0x81, 0x34, 0x60, 0x89, 0x26, 0x89, 0x64,
+	0x4a, 0xb9, 0xa9, 0x1b, 0x8b, 0xc7, 0x91, 0xaa, 0x1c, 0x47, 0x6a, 0x13, 0xea, 0xb9, 0x14, 0x5f,
+	0xb3, 0x58, 0x47, 0x06, 0xb1, 0x2a, 0x22, 0x06, 0x4e, 0xb5, 0xc7, 0xfb, 0x26, 0xb3, 0x21, 0x97,
+	0xba, 0xa0, 0xa9, 0x1b, 0x1d, 0xdb, 0x51, 0xbe, 0x53, 0xe2, 0xf4, 0x04, 0xbf, 0x96, 0x60, 0x0d,
+	0x27, 0xe2, 0xae, 0xe4, 0x43, 0xaa, 0xd9, 0x4d, 0xaa, 0x29, 0xb9, 0x02, 0x67, 0x62, 0x91, 0x65,
+	0x2c, 0x36, 0xc9, 0x47, 0x7a, 0x94, 0x33, 0x37, 0x1d, 0xab, 0x53, 0xf5, 0x97, 0xa3, 0x9c, 0x99,
+	0xf1, 0x31, 0xec, 0x51, 0xc8, 0x74, 0x4c, 0x2b, 0x34, 0xe7, 0x5f, 0xc9, 0xd4, 0x50, 0x44, 0x42,
+	0x35, 0x75, 0x83, 0x8d, 0x6b, 0x93, 0x8f, 0xb4, 0x14, 0xe5, 0x86, 0xb4, 0x82, 0xbd, 0xe7, 0x3b,
+	0xa5, 0x25, 0x89, 0x63, 0x7c, 0x54, 0x3d, 0xce, 0x47, 0xc6, 0xbb, 0xa2, 0xa9, 0xc6, 0x0b, 0xf9,
+	0x21, 0xae, 0xc9, 0x35, 0x58, 0x1e, 0x30, 0x4d, 0x31, 0xea, 0x12, 0x76, 0x6b, 0xf3, 0xe4, 0x32,
+	0x7f, 0xee, 0xac, 0xba, 0x95, 0x27, 0xcf, 0x36, 0x17, 0xc2, 0xc9, 0x29, 0x53, 0x24, 0x9a, 0x24,
+	0x22, 0xc3, 0x99, 0xa8, 0x85, 0x56, 0x20, 0x4d, 0x00, 0x76, 0xa4, 0x59, 0x66, 0xa6, 0xda, 0xce,
+	0x41, 0x2d, 0x9c, 0xd1, 0x58, 0x16, 0x60, 0x99, 0xbb, 0x12, 0xe0, 0x95, 0x6a, 0x46, 0x63, 0x19,
+	0xe7, 0x7b, 0x0f, 0xd6, 0x6c, 0xcf, 0x4c, 0xe7, 0x63, 0xb6, 0xf0, 0xde, 0x7c, 0xe1, 0x2f, 0xc3,
+	0x6a, 0xc2, 0xd5, 0x14, 0x65, 0xe5, 0x3a, 0xe6, 0x25, 0x2d, 0x39, 0x0f, 0x8b, 0x4c, 0x4a, 0x21,
+	0x95, 0xe3, 0x1d, 0x27, 0x99, 0xa6, 0x98, 0x3c, 0x0f, 0x91, 0x72, 0x08, 0xc3, 0x44, 0xb5, 0x17,
+	0x7c, 0x08, 0xcb, 0x63, 0x00, 0x0c, 0x8c, 0x19, 0x1d, 0x8c, 0x6b, 0x8b, 0x6b, 0x03, 0xc2, 0x90,
+	0xa6, 0x05, 0x73, 0xf5, 0xb4, 0x42, 0xf0, 0xa3, 0xe7, 0x78, 0x73, 0xfc, 0xc6, 0x7c, 0x02, 0x2b,
+	0x96, 0xa9, 0x1c, 0xdf, 0xa1, 0x8f, 0xfa, 0x76, 0xf0, 0x2a, 0x82, 0x98, 0x52, 0xae, 0xa9, 0xf7,
+	0x54, 0x22, 0x3b, 0x00, 0xd6, 0x11, 0x16, 0xae, 0xd4, 0xf2, 0x4e, 0xa3, 0x99, 0xf9, 0x36, 0x0d,
+	0x2d, 0x59, 0x9a, 0xe5, 0xa7, 0x95, 0xe5, 0xf2, 0x5a, 0x25, 0xf8, 0xd3, 0x03, 0x70, 0x69, 0xba,
+	0x77, 0x0a, 0xbd, 0x7a, 0x33, 0x4d, 0xe8, 0xf8, 0xa5, 0x34, 0xe5, 0x97, 0x97, 0x5f, 0xae, 0xca,
+	0x1b, 0xbd, 0x5c, 0xd5, 0xd7, 0xbc, 0x5c, 0x8a, 0xf7, 0xdd, 0x09, 0xd7, 0xad, 0x35, 0xc5, 0xfb,
+	0xd6, 0xe8, 0x9f, 0xb7, 0xac, 0xbb, 0xf6, 0x2d, 0x77, 0xeb, 0x1b, 0x07, 0x45, 0xf6, 0xc8, 0x54,
+	0x90, 0x67, 0x09, 0x3b, 0x72, 0x44, 0x64, 0x85, 0x09, 0x16, 0xa5, 0xe3, 0x58, 0x94, 0x27, 0x58,
+	0x04, 0xdf, 0x8d, 0xd9, 0x60, 0x4f, 0x4b, 0x46, 0x07, 0x16, 0xc6, 0xab, 0x50, 0x8d, 0x8d, 0x67,
+	0x57, 0xe3, 0xd6, 0x29, 0xd5, 0xc1, 0x0c, 0x42, 0x6b, 0x6e, 0xce, 0x49, 0xe3, 0xa0, 0x51, 0x7a,
+	0xed, 0x39, 0x0c, 0x14, 0x5a, 0x73, 0x53, 0x10, 0x2d, 0x34, 0x4d, 0x23, 0x74, 0x33, 0xee, 0xf0,
+	0x3a, 0xea, 0x30, 0xc0, 0x3c, 0x72, 0x95, 0xb7, 0x1a, 0xf6, 0x4d, 0xa8, 0x2b, 0x4d, 0x75, 0xa1,
+	0xa2, 0x58, 0x24, 0x96, 0x65, 0xca, 0x21, 0x58, 0xd5, 0x0d, 0x91, 0xb0, 0xe0, 0xa7, 0x12, 0x9c,
+	0x3f, 0xf9, 0x45, 0x20, 0xf7, 0x61, 0xc9, 0x74, 0x47, 0x16, 0x8f, 0xec, 0xe8, 0x74, 0xaf, 0x19,
+	0xe7, 0xbf, 0x3c, 0xdb, 0xbc, 0xdc, 0xe7, 0xfa, 0xa0, 0xe8, 0xb5, 0x63, 0x31, 0xe8, 0xc4, 0x42,
+	0x0d, 0x84, 0x72, 0x3f, 0xef, 0xaa, 0xe4, 0x51, 0xc7, 0xf0, 0xa8, 0x6a, 0xdf, 0x64, 0xf1, 0x1f,
+	0xcf, 0x36, 0x57, 0x47, 0x74, 0x90, 0x7e, 0x14, 0xdc, 0xb6, 0x6e, 0x82, 0x70, 0xec, 0x90, 0x70,
+	0xf0, 0xe9, 0x90, 0xf2, 0x94, 0xf6, 0xb8, 0x09, 0x6d, 0xc7, 0xb0, 0xbb, 0xf3, 0xc6, 0x01, 0xce,
+	0xda, 0x00, 0xb3, 0xbe, 0x82, 0x70, 0xce, 0x35, 0xb9, 0x07, 0x15, 0x35, 0xca, 0x62, 0xfb, 0xf6,
+	0x74, 0x3f, 0x7e, 0xe3, 0x10, 0x75, 0x1b, 0xc2, 0xf8, 0x08, 0x42, 0x74, 0xb5, 0xfd, 0x7b, 0x09,
+	0x96, 0xb0, 0xa0, 0x4c, 0x92, 0x3b, 0x50, 0xc5, 0x25, 0x09, 0x4e, 0x2d, 0x3c, 0xf2, 0xc9, 0xc6,
+	0x6b, 0x9b, 0x23, 0x58, 0x20, 0xf7, 0x61, 0xd5, 0xf6, 0x66, 0xd1, 0x53, 0xb1, 0xe4, 0x3d, 0xf6,
+	0x6f, 0x79, 0x7e, 0xcf, 0x33, 0xc9, 0xe2, 0x1f, 0xdc, 0x57, 0xb9, 0x9c, 0xfd, 0x83, 0xbd, 0xd1,
+	0x3a, 0xd5, 0xc6, 0x26, 0xfb, 0x00, 0xea, 0x33, 0x83, 0xf4, 0xb7, 0x32, 0x3d, 0x8d, 0xf6, 0x66,
+	0xe6, 0xd1, 0xe4, 0xdb, 0xbd, 0xfe, 0xe4, 0x79, 0xd3, 0x7b, 0xfa, 0xbc, 0xe9, 0xfd, 0xf6, 0xbc,
+	0xe9, 0xfd, 0xf0, 0xa2, 0xb9, 0xf0, 0xf4, 0x45, 0x73, 0xe1, 0xe7, 0x17, 0xcd, 0x85, 0xfb, 0x57,
+	0x66, 0xea, 0x37, 0xf7, 0x9d, 0x72, 0x34, 0xf9, 0x52, 0xc1, 0x22, 0xf6, 0x16, 0xf1, 0xeb, 0xe1,
+	0x83, 0xbf, 0x06, 0x00, 0xa6, 0x72, 0xfd, 0x70, 0xce, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -912,6 +1057,7 @@ type RelayerClient interface {
 	Relay(ctx context.Context, in *RelayRequest, opts ...grpc.CallOption) (*RelayReply, error)
 	RelaySubscribe(ctx context.Context, in *RelayRequest, opts ...grpc.CallOption) (Relayer_RelaySubscribeClient, error)
 	Probe(ctx context.Context, in *ProbeRequest, opts ...grpc.CallOption) (*ProbeReply, error)
+	RelayStream(ctx context.Context, in *RelayRequest, opts ...grpc.CallOption) (Relayer_RelayStreamClient, error)
 }
 
 type relayerClient struct {
@@ -972,11 +1118,44 @@ func (c *relayerClient) Probe(ctx context.Context, in *ProbeRequest, opts ...grp
 	return out, nil
 }
 
+func (c *relayerClient) RelayStream(ctx context.Context, in *RelayRequest, opts ...grpc.CallOption) (Relayer_RelayStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Relayer_serviceDesc.Streams[1], "/lavanet.lava.pairing.Relayer/RelayStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &relayerRelayStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Relayer_RelayStreamClient interface {
+	Recv() (*RelayStreamReply, error)
+	grpc.ClientStream
+}
+
+type relayerRelayStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *relayerRelayStreamClient) Recv() (*RelayStreamReply, error) {
+	m := new(RelayStreamReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // RelayerServer is the server API for Relayer service.
 type RelayerServer interface {
 	Relay(context.Context, *RelayRequest) (*RelayReply, error)
 	RelaySubscribe(*RelayRequest, Relayer_RelaySubscribeServer) error
 	Probe(context.Context, *ProbeRequest) (*ProbeReply, error)
+	RelayStream(*RelayRequest, Relayer_RelayStreamServer) error
 }
 
 // UnimplementedRelayerServer can be embedded to have forward compatible implementations.
@@ -992,6 +1171,9 @@ func (*UnimplementedRelayerServer) RelaySubscribe(req *RelayRequest, srv Relayer
 func (*UnimplementedRelayerServer) Probe(ctx context.Context, req *ProbeRequest) (*ProbeReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Probe not implemented")
 }
+func (*UnimplementedRelayerServer) RelayStream(req *RelayRequest, srv Relayer_RelayStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method RelayStream not implemented")
+}
 
 func RegisterRelayerServer(s grpc1.Server, srv RelayerServer) {
 	s.RegisterService(&_Relayer_serviceDesc, srv)
@@ -1054,6 +1236,27 @@ func _Relayer_Probe_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Relayer_RelayStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RelayRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RelayerServer).RelayStream(m, &relayerRelayStreamServer{stream})
+}
+
+type Relayer_RelayStreamServer interface {
+	Send(*RelayStreamReply) error
+	grpc.ServerStream
+}
+
+type relayerRelayStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *relayerRelayStreamServer) Send(m *RelayStreamReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Relayer_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.pairing.Relayer",
 	HandlerType: (*RelayerServer)(nil),
@@ -1073,6 +1276,11 @@ var _Relayer_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Relayer_RelaySubscribe_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "RelayStream",
+			Handler:       _Relayer_RelayStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "lavanet/lava/pairing/relay.proto",
 }
@@ -1653,6 +1861,119 @@ func (m *RelayReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RelayChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sig) > 0 {
+		i -= len(m.Sig)
+		copy(dAtA[i:], m.Sig)
+		i = encodeVarintRelay(dAtA, i, uint64(len(m.Sig)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintRelay(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintRelay(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayStreamReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayStreamReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayStreamReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StatusCode != 0 {
+		i = encodeVarintRelay(dAtA, i, uint64(m.StatusCode))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRelay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TotalChunks != 0 {
+		i = encodeVarintRelay(dAtA, i, uint64(m.TotalChunks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Reply != nil {
+		{
+			size, err := m.Reply.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRelay(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Chunk != nil {
+		{
+			size, err := m.Chunk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRelay(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QualityOfServiceReport) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1992,35 +2313,84 @@ func (m *RelayReply) Size() (n int) {
 	return n
 }
 
-func (m *QualityOfServiceReport) Size() (n int) {
+func (m *RelayChunk) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Latency.Size()
-	n += 1 + l + sovRelay(uint64(l))
-	l = m.Availability.Size()
-	n += 1 + l + sovRelay(uint64(l))
-	l = m.Sync.Size()
-	n += 1 + l + sovRelay(uint64(l))
+	if m.Index != 0 {
+		n += 1 + sovRelay(uint64(m.Index))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRelay(uint64(l))
+	}
+	l = len(m.Sig)
+	if l > 0 {
+		n += 1 + l + sovRelay(uint64(l))
+	}
 	return n
 }
 
-func sovRelay(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRelay(x uint64) (n int) {
-	return sovRelay(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *ProbeRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+func (m *RelayStreamReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Chunk != nil {
+		l = m.Chunk.Size()
+		n += 1 + l + sovRelay(uint64(l))
+	}
+	if m.Reply != nil {
+		l = m.Reply.Size()
+		n += 1 + l + sovRelay(uint64(l))
+	}
+	if m.TotalChunks != 0 {
+		n += 1 + sovRelay(uint64(m.TotalChunks))
+	}
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovRelay(uint64(l))
+		}
+	}
+	if m.StatusCode != 0 {
+		n += 1 + sovRelay(uint64(m.StatusCode))
+	}
+	return n
+}
+
+func (m *QualityOfServiceReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Latency.Size()
+	n += 1 + l + sovRelay(uint64(l))
+	l = m.Availability.Size()
+	n += 1 + l + sovRelay(uint64(l))
+	l = m.Sync.Size()
+	n += 1 + l + sovRelay(uint64(l))
+	return n
+}
+
+func sovRelay(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRelay(x uint64) (n int) {
+	return sovRelay(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProbeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowRelay
 			}
 			if iNdEx >= l {
@@ -3907,6 +4277,337 @@ func (m *RelayReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RelayChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRelay
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRelay
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sig = append(m.Sig[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sig == nil {
+				m.Sig = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayStreamReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayStreamReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayStreamReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Chunk == nil {
+				m.Chunk = &RelayChunk{}
+			}
+			if err := m.Chunk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reply == nil {
+				m.Reply = &RelayReply{}
+			}
+			if err := m.Reply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalChunks", wireType)
+			}
+			m.TotalChunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalChunks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, Metadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusCode", wireType)
+			}
+			m.StatusCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatusCode |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QualityOfServiceReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
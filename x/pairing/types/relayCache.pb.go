@@ -402,12 +402,66 @@ func (m *RelayCacheSet) GetAverageBlockTime() int64 {
 	return 0
 }
 
+// evicts the non finalized entries of a chain from a block and above, used when the chain forked
+type RelayCacheInvalidate struct {
+	ChainId   string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	FromBlock int64  `protobuf:"varint,2,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`
+}
+
+func (m *RelayCacheInvalidate) Reset()         { *m = RelayCacheInvalidate{} }
+func (m *RelayCacheInvalidate) String() string { return proto.CompactTextString(m) }
+func (*RelayCacheInvalidate) ProtoMessage()    {}
+func (*RelayCacheInvalidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36fbab536e2bbad1, []int{5}
+}
+func (m *RelayCacheInvalidate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayCacheInvalidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayCacheInvalidate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayCacheInvalidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayCacheInvalidate.Merge(m, src)
+}
+func (m *RelayCacheInvalidate) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayCacheInvalidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayCacheInvalidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayCacheInvalidate proto.InternalMessageInfo
+
+func (m *RelayCacheInvalidate) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *RelayCacheInvalidate) GetFromBlock() int64 {
+	if m != nil {
+		return m.FromBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*CacheRelayReply)(nil), "lavanet.lava.pairing.CacheRelayReply")
 	proto.RegisterType((*CacheUsage)(nil), "lavanet.lava.pairing.CacheUsage")
 	proto.RegisterType((*CacheHash)(nil), "lavanet.lava.pairing.CacheHash")
 	proto.RegisterType((*RelayCacheGet)(nil), "lavanet.lava.pairing.RelayCacheGet")
 	proto.RegisterType((*RelayCacheSet)(nil), "lavanet.lava.pairing.RelayCacheSet")
+	proto.RegisterType((*RelayCacheInvalidate)(nil), "lavanet.lava.pairing.RelayCacheInvalidate")
 }
 
 func init() {
@@ -415,47 +469,50 @@ func init() {
 }
 
 var fileDescriptor_36fbab536e2bbad1 = []byte{
-	// 638 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xb6, 0x9b, 0x34, 0x3f, 0x93, 0x96, 0x96, 0x55, 0x85, 0x42, 0x68, 0x8d, 0x31, 0xea, 0xcf,
-	0x01, 0xd9, 0x52, 0x91, 0x38, 0x71, 0x80, 0x52, 0xd4, 0x56, 0xa2, 0x12, 0x38, 0x20, 0x21, 0x2e,
-	0xd1, 0x26, 0x9e, 0xda, 0x2b, 0x1c, 0xdb, 0x78, 0xb7, 0x15, 0xe5, 0x29, 0x78, 0x1d, 0xc4, 0x0b,
-	0xf4, 0xd8, 0x23, 0x27, 0x84, 0xda, 0xa7, 0x80, 0x13, 0xf2, 0xc4, 0x49, 0x93, 0xc8, 0x8d, 0x2a,
-	0xc1, 0xc9, 0xde, 0x6f, 0xbf, 0xd9, 0x99, 0xf9, 0xbe, 0xd1, 0xc0, 0x7a, 0xc8, 0x4f, 0x78, 0x84,
-	0xca, 0xc9, 0xbe, 0x4e, 0xc2, 0x45, 0x2a, 0x22, 0xdf, 0x49, 0x31, 0xe4, 0xa7, 0x2f, 0x78, 0x2f,
-	0x40, 0x3b, 0x49, 0x63, 0x15, 0xb3, 0x95, 0x9c, 0x66, 0x67, 0x5f, 0x3b, 0xa7, 0xb5, 0x56, 0xfc,
-	0xd8, 0x8f, 0x89, 0xe0, 0x64, 0x7f, 0x03, 0x6e, 0xcb, 0xbc, 0xfe, 0xc9, 0x9c, 0x71, 0xcf, 0x8f,
-	0x63, 0x3f, 0x44, 0x87, 0x4e, 0xdd, 0xe3, 0x23, 0x07, 0xfb, 0x89, 0xca, 0x2f, 0xad, 0xef, 0x3a,
-	0x2c, 0x51, 0x6a, 0x37, 0x8b, 0x70, 0x31, 0x09, 0x4f, 0xd9, 0x13, 0x98, 0x4f, 0xb3, 0x9f, 0xa6,
-	0x6e, 0xea, 0x5b, 0x8d, 0x6d, 0xd3, 0x2e, 0x2a, 0xc7, 0xbe, 0x0a, 0x70, 0x07, 0x74, 0xf6, 0x06,
-	0x6e, 0xc7, 0x89, 0x12, 0x71, 0xc4, 0xc3, 0x4e, 0x1f, 0x15, 0xf7, 0xb8, 0xe2, 0xcd, 0x39, 0xb3,
-	0xb4, 0xd5, 0xd8, 0x36, 0x8a, 0xdf, 0x38, 0xcc, 0x59, 0x3b, 0xe5, 0xb3, 0x9f, 0xf7, 0x35, 0x77,
-	0x79, 0x18, 0x3e, 0xc4, 0xd9, 0x1a, 0x80, 0x44, 0x8c, 0x3a, 0xdd, 0x30, 0xee, 0x7d, 0x6c, 0x96,
-	0x4c, 0x7d, 0xab, 0xe4, 0xd6, 0x33, 0x64, 0x27, 0x03, 0xac, 0x57, 0x00, 0x54, 0xfc, 0x3b, 0xc9,
-	0x7d, 0x64, 0xab, 0x50, 0xa7, 0xd3, 0xbe, 0x50, 0x92, 0x6a, 0x2f, 0xbb, 0x57, 0x00, 0x33, 0xa1,
-	0x41, 0x87, 0x43, 0x21, 0x25, 0xca, 0xe6, 0x1c, 0xdd, 0x8f, 0x43, 0x56, 0x30, 0x8c, 0xe7, 0x32,
-	0x60, 0xcf, 0xa0, 0x9a, 0xe2, 0xa7, 0x63, 0x94, 0x2a, 0x97, 0x61, 0x63, 0x86, 0x0c, 0xaf, 0x53,
-	0x71, 0xc2, 0x15, 0xee, 0x72, 0xc5, 0xdd, 0x61, 0x18, 0xbb, 0x0b, 0xb5, 0x5e, 0xc0, 0x45, 0xd4,
-	0x11, 0x1e, 0x65, 0xab, 0xbb, 0x55, 0x3a, 0x1f, 0x78, 0xd6, 0x1f, 0x1d, 0x16, 0xdd, 0x91, 0xeb,
-	0x7b, 0xa8, 0xd8, 0x03, 0x58, 0xc8, 0xe3, 0x3a, 0x01, 0x97, 0x01, 0xe5, 0x5c, 0x70, 0x1b, 0x39,
-	0x46, 0x15, 0xad, 0x01, 0x90, 0x0c, 0x03, 0xc2, 0x1c, 0x11, 0xea, 0x84, 0xd0, 0xf5, 0x2a, 0xd4,
-	0x8f, 0x44, 0xc4, 0x43, 0xf1, 0x05, 0x3d, 0x52, 0xaa, 0xe6, 0x5e, 0x01, 0x6c, 0x13, 0x96, 0xf2,
-	0xb7, 0xd0, 0xcb, 0xd5, 0x2c, 0x93, 0x9a, 0xb7, 0x46, 0x30, 0x49, 0xca, 0x36, 0x60, 0x49, 0x06,
-	0x3c, 0x45, 0xaf, 0x23, 0x15, 0x57, 0x98, 0x15, 0x3f, 0x4f, 0xc5, 0x2f, 0x0e, 0xe0, 0x76, 0x86,
-	0x1e, 0x78, 0x13, 0xdd, 0x55, 0x26, 0xba, 0x9b, 0x32, 0xad, 0x3a, 0x6d, 0xda, 0xb7, 0xd2, 0x78,
-	0xf3, 0xed, 0xff, 0xd2, 0xfc, 0x53, 0xa8, 0xa5, 0x28, 0x93, 0x38, 0x92, 0x48, 0xbd, 0xdf, 0x64,
-	0x6a, 0x47, 0x11, 0x93, 0xd2, 0x95, 0xa7, 0xa5, 0x2b, 0x1c, 0xeb, 0xf9, 0x7f, 0x1a, 0xeb, 0x02,
-	0x91, 0x2b, 0x45, 0x22, 0x17, 0xb8, 0x56, 0x2d, 0x74, 0x6d, 0xdc, 0x8d, 0xfa, 0x2c, 0x37, 0x60,
-	0xca, 0x0d, 0xf6, 0x08, 0x18, 0x3f, 0xc1, 0x94, 0xfb, 0x38, 0x60, 0x74, 0x94, 0xe8, 0x63, 0xb3,
-	0x41, 0xb4, 0xe5, 0xfc, 0x86, 0x98, 0x6f, 0x45, 0x1f, 0xb7, 0x7f, 0xeb, 0xb0, 0x40, 0x12, 0x62,
-	0x4a, 0xee, 0xb1, 0xf7, 0x50, 0xdb, 0x43, 0x45, 0x10, 0x7b, 0x38, 0x43, 0xf2, 0xe1, 0xa0, 0xb7,
-	0xd6, 0x8b, 0x49, 0x53, 0x3b, 0xc8, 0xd2, 0xd8, 0x01, 0xd4, 0xda, 0x37, 0x7e, 0xb9, 0x8d, 0xaa,
-	0x75, 0xc7, 0x1e, 0x2c, 0x3a, 0x7b, 0xb8, 0xe8, 0xec, 0x97, 0xd9, 0xa2, 0xb3, 0x34, 0xb6, 0x0b,
-	0x95, 0x7d, 0xe4, 0xa1, 0x0a, 0xd8, 0x35, 0x9c, 0x96, 0x39, 0xa3, 0x2a, 0x5a, 0x2e, 0x96, 0xb6,
-	0xf3, 0xfc, 0xec, 0xc2, 0xd0, 0xcf, 0x2f, 0x0c, 0xfd, 0xd7, 0x85, 0xa1, 0x7f, 0xbd, 0x34, 0xb4,
-	0xf3, 0x4b, 0x43, 0xfb, 0x71, 0x69, 0x68, 0x1f, 0x36, 0x7d, 0xa1, 0x82, 0xe3, 0xae, 0xdd, 0x8b,
-	0xfb, 0xce, 0xc4, 0x3a, 0xfe, 0x3c, 0x5a, 0xc8, 0xea, 0x34, 0x41, 0xd9, 0xad, 0x50, 0xda, 0xc7,
-	0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x81, 0x66, 0x88, 0xc1, 0x08, 0x06, 0x00, 0x00,
+	// 686 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0x8e, 0x93, 0x34, 0x3f, 0x27, 0xed, 0x6d, 0xef, 0xa8, 0xba, 0xca, 0xcd, 0x6d, 0x73, 0x83,
+	0x51, 0x7f, 0x84, 0x90, 0x2d, 0x15, 0x89, 0x15, 0x0b, 0x28, 0x45, 0x6d, 0x24, 0x2a, 0x15, 0x07,
+	0xa4, 0x8a, 0x4d, 0x34, 0x89, 0x4f, 0xed, 0x11, 0x8e, 0x6d, 0x3c, 0xd3, 0x88, 0xf2, 0x14, 0xbc,
+	0x0e, 0xe2, 0x05, 0xba, 0xac, 0xc4, 0x86, 0x15, 0x42, 0xed, 0x5b, 0xb0, 0x42, 0x3e, 0x76, 0x92,
+	0x26, 0xb8, 0xa1, 0x12, 0xac, 0xec, 0xf9, 0xe6, 0x3b, 0x3f, 0xf3, 0x7d, 0xe3, 0x63, 0xd8, 0xf0,
+	0xf8, 0x90, 0xfb, 0xa8, 0xcc, 0xf8, 0x69, 0x86, 0x5c, 0x44, 0xc2, 0x77, 0xcc, 0x08, 0x3d, 0x7e,
+	0xf6, 0x94, 0xf7, 0x5d, 0x34, 0xc2, 0x28, 0x50, 0x01, 0x5b, 0x4d, 0x69, 0x46, 0xfc, 0x34, 0x52,
+	0x5a, 0x63, 0xd5, 0x09, 0x9c, 0x80, 0x08, 0x66, 0xfc, 0x96, 0x70, 0x1b, 0xad, 0x9b, 0x53, 0xa6,
+	0x8c, 0xff, 0x9c, 0x20, 0x70, 0x3c, 0x34, 0x69, 0xd5, 0x3b, 0x3d, 0x31, 0x71, 0x10, 0xaa, 0x74,
+	0x53, 0xff, 0xa4, 0xc1, 0x32, 0x95, 0xb6, 0xe2, 0x08, 0x0b, 0x43, 0xef, 0x8c, 0x3d, 0x84, 0x85,
+	0x28, 0x7e, 0xa9, 0x6b, 0x2d, 0x6d, 0xbb, 0xb6, 0xd3, 0x32, 0xb2, 0xda, 0x31, 0x26, 0x01, 0x56,
+	0x42, 0x67, 0x2f, 0xe0, 0xef, 0x20, 0x54, 0x22, 0xf0, 0xb9, 0xd7, 0x1d, 0xa0, 0xe2, 0x36, 0x57,
+	0xbc, 0x9e, 0x6f, 0x15, 0xb6, 0x6b, 0x3b, 0xcd, 0xec, 0x1c, 0x87, 0x29, 0x6b, 0xb7, 0x78, 0xfe,
+	0xf5, 0xff, 0x9c, 0xb5, 0x32, 0x0a, 0x1f, 0xe1, 0x6c, 0x1d, 0x40, 0x22, 0xfa, 0xdd, 0x9e, 0x17,
+	0xf4, 0xdf, 0xd4, 0x0b, 0x2d, 0x6d, 0xbb, 0x60, 0x55, 0x63, 0x64, 0x37, 0x06, 0xf4, 0xe7, 0x00,
+	0xd4, 0xfc, 0x2b, 0xc9, 0x1d, 0x64, 0x6b, 0x50, 0xa5, 0xd5, 0x81, 0x50, 0x92, 0x7a, 0x2f, 0x5a,
+	0x13, 0x80, 0xb5, 0xa0, 0x46, 0x8b, 0x43, 0x21, 0x25, 0xca, 0x7a, 0x9e, 0xf6, 0xaf, 0x43, 0xba,
+	0x3b, 0x8a, 0xe7, 0xd2, 0x65, 0x8f, 0xa1, 0x1c, 0xe1, 0xdb, 0x53, 0x94, 0x2a, 0x95, 0x61, 0x73,
+	0x8e, 0x0c, 0x47, 0x91, 0x18, 0x72, 0x85, 0x7b, 0x5c, 0x71, 0x6b, 0x14, 0xc6, 0xfe, 0x85, 0x4a,
+	0xdf, 0xe5, 0xc2, 0xef, 0x0a, 0x9b, 0xaa, 0x55, 0xad, 0x32, 0xad, 0xdb, 0xb6, 0xfe, 0x5d, 0x83,
+	0x25, 0x6b, 0xec, 0xfa, 0x3e, 0x2a, 0x76, 0x07, 0x16, 0xd3, 0xb8, 0xae, 0xcb, 0xa5, 0x4b, 0x35,
+	0x17, 0xad, 0x5a, 0x8a, 0x51, 0x47, 0xeb, 0x00, 0x24, 0x43, 0x42, 0xc8, 0x13, 0xa1, 0x4a, 0x08,
+	0x6d, 0xaf, 0x41, 0xf5, 0x44, 0xf8, 0xdc, 0x13, 0xef, 0xd1, 0x26, 0xa5, 0x2a, 0xd6, 0x04, 0x60,
+	0x5b, 0xb0, 0x9c, 0xe6, 0x42, 0x3b, 0x55, 0xb3, 0x48, 0x6a, 0xfe, 0x35, 0x86, 0x49, 0x52, 0xb6,
+	0x09, 0xcb, 0xd2, 0xe5, 0x11, 0xda, 0x5d, 0xa9, 0xb8, 0xc2, 0xb8, 0xf9, 0x05, 0x6a, 0x7e, 0x29,
+	0x81, 0x3b, 0x31, 0xda, 0xb6, 0xa7, 0x4e, 0x57, 0x9a, 0x3a, 0xdd, 0x8c, 0x69, 0xe5, 0x59, 0xd3,
+	0x3e, 0x16, 0xae, 0x1f, 0xbe, 0xf3, 0x47, 0x0e, 0xff, 0x08, 0x2a, 0x11, 0xca, 0x30, 0xf0, 0x25,
+	0xd6, 0x0b, 0xb7, 0xbc, 0xb5, 0xe3, 0x88, 0x69, 0xe9, 0x8a, 0xb3, 0xd2, 0x65, 0x5e, 0xeb, 0x85,
+	0xdf, 0xba, 0xd6, 0x19, 0x22, 0x97, 0xb2, 0x44, 0xce, 0x70, 0xad, 0x9c, 0xe9, 0xda, 0x75, 0x37,
+	0xaa, 0xf3, 0xdc, 0x80, 0x19, 0x37, 0xd8, 0x7d, 0x60, 0x7c, 0x88, 0x11, 0x77, 0x30, 0x61, 0x74,
+	0x95, 0x18, 0x60, 0xbd, 0x46, 0xb4, 0x95, 0x74, 0x87, 0x98, 0x2f, 0xc5, 0x00, 0xf5, 0x23, 0x58,
+	0x9d, 0x58, 0xd7, 0xf6, 0x87, 0xdc, 0x13, 0x36, 0x57, 0x38, 0x55, 0x5f, 0xfb, 0xa9, 0xfe, 0x49,
+	0x14, 0x0c, 0xd2, 0xfa, 0xf9, 0xa4, 0x7e, 0x8c, 0x50, 0xd6, 0x9d, 0xcf, 0x79, 0x58, 0xa4, 0x94,
+	0x18, 0x51, 0x52, 0x76, 0x0c, 0x95, 0x7d, 0x54, 0x04, 0xb1, 0xbb, 0x73, 0x4c, 0x1c, 0x7d, 0x3a,
+	0x8d, 0x8d, 0x6c, 0xd2, 0xcc, 0x54, 0xd3, 0x73, 0xac, 0x0d, 0x95, 0xce, 0xad, 0x33, 0x77, 0x50,
+	0x35, 0xfe, 0x31, 0x92, 0xd1, 0x69, 0x8c, 0x46, 0xa7, 0xf1, 0x2c, 0x1e, 0x9d, 0x7a, 0x8e, 0xed,
+	0x41, 0xe9, 0x00, 0xb9, 0xa7, 0x5c, 0x76, 0x03, 0xa7, 0xd1, 0x9a, 0xd3, 0x15, 0x8d, 0x2b, 0x3d,
+	0xc7, 0x8e, 0x61, 0x65, 0xa2, 0x21, 0xc9, 0x21, 0xd9, 0xbd, 0x5f, 0x35, 0x36, 0x89, 0xb8, 0xb9,
+	0xbf, 0xdd, 0x27, 0xe7, 0x97, 0x4d, 0xed, 0xe2, 0xb2, 0xa9, 0x7d, 0xbb, 0x6c, 0x6a, 0x1f, 0xae,
+	0x9a, 0xb9, 0x8b, 0xab, 0x66, 0xee, 0xcb, 0x55, 0x33, 0xf7, 0x7a, 0xcb, 0x11, 0xca, 0x3d, 0xed,
+	0x19, 0xfd, 0x60, 0x60, 0x4e, 0xfd, 0x3a, 0xde, 0x8d, 0x7f, 0x1e, 0xea, 0x2c, 0x44, 0xd9, 0x2b,
+	0x51, 0xd2, 0x07, 0x3f, 0x06, 0x00, 0x3d, 0x10, 0xa5, 0xde, 0xb4, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -473,6 +530,7 @@ type RelayerCacheClient interface {
 	GetRelay(ctx context.Context, in *RelayCacheGet, opts ...grpc.CallOption) (*CacheRelayReply, error)
 	SetRelay(ctx context.Context, in *RelayCacheSet, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Health(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CacheUsage, error)
+	InvalidateBlocks(ctx context.Context, in *RelayCacheInvalidate, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type relayerCacheClient struct {
@@ -510,11 +568,21 @@ func (c *relayerCacheClient) Health(ctx context.Context, in *emptypb.Empty, opts
 	return out, nil
 }
 
+func (c *relayerCacheClient) InvalidateBlocks(ctx context.Context, in *RelayCacheInvalidate, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelayerCache/InvalidateBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RelayerCacheServer is the server API for RelayerCache service.
 type RelayerCacheServer interface {
 	GetRelay(context.Context, *RelayCacheGet) (*CacheRelayReply, error)
 	SetRelay(context.Context, *RelayCacheSet) (*emptypb.Empty, error)
 	Health(context.Context, *emptypb.Empty) (*CacheUsage, error)
+	InvalidateBlocks(context.Context, *RelayCacheInvalidate) (*emptypb.Empty, error)
 }
 
 // UnimplementedRelayerCacheServer can be embedded to have forward compatible implementations.
@@ -530,6 +598,9 @@ func (*UnimplementedRelayerCacheServer) SetRelay(ctx context.Context, req *Relay
 func (*UnimplementedRelayerCacheServer) Health(ctx context.Context, req *emptypb.Empty) (*CacheUsage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
 }
+func (*UnimplementedRelayerCacheServer) InvalidateBlocks(ctx context.Context, req *RelayCacheInvalidate) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateBlocks not implemented")
+}
 
 func RegisterRelayerCacheServer(s grpc1.Server, srv RelayerCacheServer) {
 	s.RegisterService(&_RelayerCache_serviceDesc, srv)
@@ -589,6 +660,24 @@ func _RelayerCache_Health_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RelayerCache_InvalidateBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelayCacheInvalidate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerCacheServer).InvalidateBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelayerCache/InvalidateBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerCacheServer).InvalidateBlocks(ctx, req.(*RelayCacheInvalidate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RelayerCache_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.pairing.RelayerCache",
 	HandlerType: (*RelayerCacheServer)(nil),
@@ -605,6 +694,10 @@ var _RelayerCache_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Health",
 			Handler:    _RelayerCache_Health_Handler,
 		},
+		{
+			MethodName: "InvalidateBlocks",
+			Handler:    _RelayerCache_InvalidateBlocks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/pairing/relayCache.proto",
@@ -912,6 +1005,41 @@ func (m *RelayCacheSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RelayCacheInvalidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayCacheInvalidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayCacheInvalidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FromBlock != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.FromBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRelayCache(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRelayCache(v)
 	base := offset
@@ -1058,6 +1186,22 @@ func (m *RelayCacheSet) Size() (n int) {
 	return n
 }
 
+func (m *RelayCacheInvalidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	if m.FromBlock != 0 {
+		n += 1 + sovRelayCache(uint64(m.FromBlock))
+	}
+	return n
+}
+
 func sovRelayCache(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1978,6 +2122,107 @@ func (m *RelayCacheSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RelayCacheInvalidate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayCacheInvalidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayCacheInvalidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromBlock", wireType)
+			}
+			m.FromBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRelayCache(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,48 @@
+package types
+
+import (
+	tendermintcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/lavanet/lava/utils/sigs"
+)
+
+// RelayChunkExchange consists a relay request and a single chunk of its streamed response
+type RelayChunkExchange struct {
+	Request RelayRequest
+	Chunk   RelayChunk
+}
+
+func NewRelayChunkExchange(req RelayRequest, chunk RelayChunk) RelayChunkExchange {
+	return RelayChunkExchange{Request: req, Chunk: chunk}
+}
+
+func (rce RelayChunkExchange) GetSignature() []byte {
+	return rce.Chunk.Sig
+}
+
+func (rce RelayChunkExchange) DataToSign() []byte {
+	// the content hash is used instead of the relay data since the provider may update the requested block in the relay data
+	msgParts := [][]byte{
+		sigs.EncodeUint64(rce.Chunk.Index),
+		tendermintcrypto.Sha256(rce.Chunk.Data),
+		rce.Request.RelaySession.ContentHash,
+		rce.Request.RelaySession.CalculateHashForFinalization(),
+	}
+	return sigs.Join(msgParts)
+}
+
+func (rce RelayChunkExchange) HashRounds() int {
+	return 1
+}
+
+// SplitToChunks splits the data of a reply to chunks of at most chunkSize bytes
+func SplitToChunks(data []byte, chunkSize int) []*RelayChunk {
+	chunks := make([]*RelayChunk, 0, len(data)/chunkSize+1)
+	for start := 0; start < len(data); start += chunkSize {
+		end := start + chunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+		chunks = append(chunks, &RelayChunk{Index: uint64(len(chunks)), Data: data[start:end]})
+	}
+	return chunks
+}
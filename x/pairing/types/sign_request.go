@@ -0,0 +1,108 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils/sigs"
+)
+
+const (
+	SignableTypeRelaySession      = "relay_session"
+	SignableTypeRelayExchange     = "relay_exchange"
+	SignableTypeRelayFinalization = "relay_finalization"
+	SignableTypeRelayChunk        = "relay_chunk"
+	SignableTypeBadge             = "badge"
+)
+
+// NewSignRequest wraps a signable object in a request for a remote signer
+func NewSignRequest(data sigs.Signable) (*SignRequest, error) {
+	switch signable := data.(type) {
+	case RelaySession:
+		return &SignRequest{Signable: &SignRequest_RelaySession{RelaySession: &signable}}, nil
+	case RelayExchange:
+		return &SignRequest{Signable: &SignRequest_RelayExchange{RelayExchange: &SignRelayExchange{Request: &signable.Request, Reply: &signable.Reply}}}, nil
+	case RelayFinalization:
+		return &SignRequest{Signable: &SignRequest_RelayFinalization{RelayFinalization: &SignRelayFinalization{Request: &signable.Exchange.Request, Reply: &signable.Exchange.Reply, ConsumerAddress: signable.Addr}}}, nil
+	case RelayChunkExchange:
+		return &SignRequest{Signable: &SignRequest_RelayChunk{RelayChunk: &SignRelayChunk{Request: &signable.Request, Chunk: &signable.Chunk}}}, nil
+	case Badge:
+		return &SignRequest{Signable: &SignRequest_Badge{Badge: &signable}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported signable type %T", data)
+	}
+}
+
+// ToSignable unwraps the signable object of the request, it fails if the object is missing fields needed for signing
+func (sr *SignRequest) ToSignable() (sigs.Signable, error) {
+	switch signable := sr.GetSignable().(type) {
+	case *SignRequest_RelaySession:
+		if signable.RelaySession == nil {
+			return nil, fmt.Errorf("sign request is missing the relay session")
+		}
+		return *signable.RelaySession, nil
+	case *SignRequest_RelayExchange:
+		exchange := signable.RelayExchange
+		if exchange == nil || !isCompleteRelayRequest(exchange.Request) || exchange.Reply == nil {
+			return nil, fmt.Errorf("sign request is missing relay exchange fields")
+		}
+		return NewRelayExchange(*exchange.Request, *exchange.Reply), nil
+	case *SignRequest_RelayFinalization:
+		finalization := signable.RelayFinalization
+		if finalization == nil || !isCompleteRelayRequest(finalization.Request) || finalization.Reply == nil {
+			return nil, fmt.Errorf("sign request is missing relay finalization fields")
+		}
+		return NewRelayFinalization(NewRelayExchange(*finalization.Request, *finalization.Reply), sdk.AccAddress(finalization.ConsumerAddress)), nil
+	case *SignRequest_RelayChunk:
+		chunk := signable.RelayChunk
+		if chunk == nil || !isCompleteRelayRequest(chunk.Request) || chunk.Chunk == nil {
+			return nil, fmt.Errorf("sign request is missing relay chunk fields")
+		}
+		return NewRelayChunkExchange(*chunk.Request, *chunk.Chunk), nil
+	case *SignRequest_Badge:
+		if signable.Badge == nil {
+			return nil, fmt.Errorf("sign request is missing the badge")
+		}
+		return *signable.Badge, nil
+	default:
+		return nil, fmt.Errorf("sign request has no signable object")
+	}
+}
+
+// SignableType gets the type name of the signable object, used by the remote signer allow rules
+func (sr *SignRequest) SignableType() string {
+	switch sr.GetSignable().(type) {
+	case *SignRequest_RelaySession:
+		return SignableTypeRelaySession
+	case *SignRequest_RelayExchange:
+		return SignableTypeRelayExchange
+	case *SignRequest_RelayFinalization:
+		return SignableTypeRelayFinalization
+	case *SignRequest_RelayChunk:
+		return SignableTypeRelayChunk
+	case *SignRequest_Badge:
+		return SignableTypeBadge
+	default:
+		return ""
+	}
+}
+
+// GetRelaySessionData gets the relay session the signed object belongs to, nil for badges
+func (sr *SignRequest) GetRelaySessionData() *RelaySession {
+	switch signable := sr.GetSignable().(type) {
+	case *SignRequest_RelaySession:
+		return signable.RelaySession
+	case *SignRequest_RelayExchange:
+		return signable.RelayExchange.GetRequest().GetRelaySession()
+	case *SignRequest_RelayFinalization:
+		return signable.RelayFinalization.GetRequest().GetRelaySession()
+	case *SignRequest_RelayChunk:
+		return signable.RelayChunk.GetRequest().GetRelaySession()
+	default:
+		return nil
+	}
+}
+
+func isCompleteRelayRequest(request *RelayRequest) bool {
+	return request != nil && request.RelaySession != nil && request.RelayData != nil
+}
@@ -618,6 +618,134 @@ func (m *MsgUnfreezeProviderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnfreezeProviderResponse proto.InternalMessageInfo
 
+type MsgScheduleMaintenance struct {
+	Creator       string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainIds      []string `protobuf:"bytes,2,rep,name=chain_ids,json=chainIds,proto3" json:"chain_ids,omitempty"`
+	StartInEpochs uint64   `protobuf:"varint,3,opt,name=start_in_epochs,json=startInEpochs,proto3" json:"start_in_epochs,omitempty"`
+	Duration      uint64   `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	Reason        string   `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgScheduleMaintenance) Reset()         { *m = MsgScheduleMaintenance{} }
+func (m *MsgScheduleMaintenance) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleMaintenance) ProtoMessage()    {}
+func (*MsgScheduleMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07b85a84d2198a91, []int{11}
+}
+func (m *MsgScheduleMaintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleMaintenance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleMaintenance.Merge(m, src)
+}
+func (m *MsgScheduleMaintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleMaintenance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleMaintenance proto.InternalMessageInfo
+
+func (m *MsgScheduleMaintenance) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgScheduleMaintenance) GetChainIds() []string {
+	if m != nil {
+		return m.ChainIds
+	}
+	return nil
+}
+
+func (m *MsgScheduleMaintenance) GetStartInEpochs() uint64 {
+	if m != nil {
+		return m.StartInEpochs
+	}
+	return 0
+}
+
+func (m *MsgScheduleMaintenance) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *MsgScheduleMaintenance) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type MsgScheduleMaintenanceResponse struct {
+	StartBlock uint64 `protobuf:"varint,1,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	EndBlock   uint64 `protobuf:"varint,2,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+}
+
+func (m *MsgScheduleMaintenanceResponse) Reset()         { *m = MsgScheduleMaintenanceResponse{} }
+func (m *MsgScheduleMaintenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleMaintenanceResponse) ProtoMessage()    {}
+func (*MsgScheduleMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07b85a84d2198a91, []int{12}
+}
+func (m *MsgScheduleMaintenanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleMaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleMaintenanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleMaintenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleMaintenanceResponse.Merge(m, src)
+}
+func (m *MsgScheduleMaintenanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleMaintenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleMaintenanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleMaintenanceResponse proto.InternalMessageInfo
+
+func (m *MsgScheduleMaintenanceResponse) GetStartBlock() uint64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+func (m *MsgScheduleMaintenanceResponse) GetEndBlock() uint64 {
+	if m != nil {
+		return m.EndBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgStakeProvider)(nil), "lavanet.lava.pairing.MsgStakeProvider")
 	proto.RegisterType((*MsgStakeProviderResponse)(nil), "lavanet.lava.pairing.MsgStakeProviderResponse")
@@ -630,61 +758,70 @@ func init() {
 	proto.RegisterType((*MsgFreezeProviderResponse)(nil), "lavanet.lava.pairing.MsgFreezeProviderResponse")
 	proto.RegisterType((*MsgUnfreezeProvider)(nil), "lavanet.lava.pairing.MsgUnfreezeProvider")
 	proto.RegisterType((*MsgUnfreezeProviderResponse)(nil), "lavanet.lava.pairing.MsgUnfreezeProviderResponse")
+	proto.RegisterType((*MsgScheduleMaintenance)(nil), "lavanet.lava.pairing.MsgScheduleMaintenance")
+	proto.RegisterType((*MsgScheduleMaintenanceResponse)(nil), "lavanet.lava.pairing.MsgScheduleMaintenanceResponse")
 }
 
 func init() { proto.RegisterFile("lavanet/lava/pairing/tx.proto", fileDescriptor_07b85a84d2198a91) }
 
 var fileDescriptor_07b85a84d2198a91 = []byte{
-	// 769 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x4e, 0xeb, 0x46,
-	0x14, 0x8e, 0x89, 0x09, 0xc9, 0x09, 0xbf, 0x03, 0x2a, 0xc6, 0x40, 0x9a, 0xba, 0x6a, 0x93, 0x4a,
-	0xad, 0x5d, 0xe8, 0xa2, 0x52, 0x77, 0x0d, 0x2d, 0x15, 0x2d, 0x51, 0x91, 0x51, 0x17, 0xed, 0x26,
-	0x9a, 0xd8, 0x83, 0x19, 0xb0, 0x3d, 0x96, 0x67, 0x88, 0xa0, 0x4f, 0xd1, 0x7d, 0x57, 0x7d, 0x1b,
-	0x96, 0x2c, 0xbb, 0xba, 0xba, 0x82, 0x77, 0xb8, 0xeb, 0x2b, 0x4f, 0x6c, 0x13, 0x3b, 0x01, 0x45,
-	0xba, 0x77, 0x15, 0x9f, 0x39, 0xdf, 0x39, 0xdf, 0x77, 0x7e, 0x26, 0x03, 0xfb, 0x3e, 0x1e, 0xe1,
-	0x90, 0x08, 0x2b, 0xf9, 0xb5, 0x22, 0x4c, 0x63, 0x1a, 0x7a, 0x96, 0xb8, 0x35, 0xa3, 0x98, 0x09,
-	0x86, 0xb6, 0x52, 0xb7, 0x99, 0xfc, 0x9a, 0xa9, 0x5b, 0x6f, 0x39, 0x8c, 0x07, 0x8c, 0x5b, 0x43,
-	0xcc, 0x89, 0x35, 0x3a, 0x18, 0x12, 0x81, 0x0f, 0x2c, 0x87, 0xd1, 0x70, 0x1c, 0xa5, 0x6f, 0x79,
-	0xcc, 0x63, 0xf2, 0xd3, 0x4a, 0xbe, 0xd2, 0xd3, 0x6e, 0x81, 0x8a, 0x44, 0xcc, 0xb9, 0xe4, 0x82,
-	0xc5, 0xd8, 0x23, 0x16, 0x09, 0xdd, 0x88, 0xd1, 0x50, 0xa4, 0xc8, 0xf6, 0x4c, 0x51, 0x31, 0xf1,
-	0xf1, 0xdd, 0x18, 0x61, 0xfc, 0x5b, 0x85, 0xf5, 0x3e, 0xf7, 0xce, 0x05, 0xbe, 0x26, 0x67, 0x31,
-	0x1b, 0x51, 0x97, 0xc4, 0x48, 0x83, 0x25, 0x27, 0x26, 0x58, 0xb0, 0x58, 0x53, 0xda, 0x4a, 0xb7,
-	0x61, 0x67, 0xa6, 0xf4, 0x5c, 0x62, 0x1a, 0x9e, 0xfc, 0xa4, 0x2d, 0xa4, 0x9e, 0xb1, 0x89, 0xbe,
-	0x87, 0x1a, 0x0e, 0xd8, 0x4d, 0x28, 0xb4, 0x6a, 0x5b, 0xe9, 0x36, 0x0f, 0x77, 0xcc, 0x71, 0x6d,
-	0x66, 0x52, 0x9b, 0x99, 0xd6, 0x66, 0x1e, 0x31, 0x1a, 0xf6, 0xd4, 0xfb, 0x37, 0x9f, 0x56, 0xec,
-	0x14, 0x8e, 0x7e, 0x81, 0x46, 0xa6, 0x9a, 0x6b, 0x6a, 0xbb, 0xda, 0x6d, 0x1e, 0x7e, 0x6e, 0x16,
-	0xba, 0x35, 0x59, 0xa1, 0xf9, 0x73, 0x8a, 0x4d, 0xb3, 0x3c, 0xc7, 0xa2, 0x36, 0x34, 0x3d, 0xc2,
-	0x7c, 0xe6, 0x60, 0x41, 0x59, 0xa8, 0x2d, 0xb6, 0x95, 0xee, 0xa2, 0x3d, 0x79, 0x94, 0xa8, 0x0f,
-	0x58, 0x48, 0xaf, 0x49, 0xac, 0xd5, 0xc6, 0xea, 0x53, 0x13, 0x1d, 0xc3, 0xaa, 0x4b, 0x7c, 0xe2,
-	0x61, 0x41, 0x06, 0x3e, 0x0d, 0xa8, 0xd0, 0x96, 0xe6, 0xab, 0x62, 0x25, 0x0b, 0x3b, 0x4d, 0xa2,
-	0x90, 0x05, 0x9b, 0x79, 0x1e, 0x87, 0x05, 0x01, 0xe5, 0x3c, 0xd1, 0x52, 0x6f, 0x2b, 0x5d, 0xd5,
-	0x46, 0x99, 0xeb, 0x28, 0xf7, 0xa0, 0x3d, 0x68, 0x8c, 0xb0, 0x4f, 0x5d, 0xd9, 0xec, 0x86, 0x14,
-	0xf5, 0x7c, 0x60, 0xe8, 0xa0, 0x95, 0x87, 0x63, 0x13, 0x1e, 0xb1, 0x90, 0x13, 0xe3, 0x02, 0x50,
-	0x9f, 0x7b, 0x7f, 0x84, 0xfc, 0x83, 0x47, 0x57, 0xd0, 0x50, 0x2d, 0x6b, 0xd8, 0x03, 0x7d, 0x9a,
-	0x27, 0x57, 0xf1, 0x4e, 0x81, 0xb5, 0x3e, 0xf7, 0xec, 0x64, 0xa5, 0xce, 0xf0, 0x5d, 0x40, 0x42,
-	0xf1, 0x8a, 0x86, 0x1f, 0xa0, 0x26, 0x97, 0x8f, 0x6b, 0x0b, 0x72, 0xd0, 0x86, 0x39, 0xeb, 0x5a,
-	0x98, 0x32, 0xdb, 0x39, 0x91, 0x1d, 0xb2, 0xd3, 0x08, 0xf4, 0x35, 0x6c, 0xb8, 0x84, 0x3b, 0x31,
-	0x8d, 0x92, 0x59, 0x9e, 0x8b, 0x04, 0xa9, 0xa9, 0x32, 0xff, 0xb4, 0x03, 0xfd, 0x09, 0x5b, 0x3e,
-	0x16, 0x84, 0x8b, 0xc1, 0xd0, 0x67, 0xce, 0xf5, 0x20, 0x26, 0x11, 0x8b, 0x05, 0xd7, 0x16, 0x25,
-	0x6f, 0x67, 0x36, 0xef, 0xa9, 0x8c, 0xe8, 0x25, 0x01, 0xb6, 0xc4, 0xdb, 0xc8, 0x2f, 0x1f, 0xf1,
-	0x5f, 0xd5, 0x7a, 0x75, 0x5d, 0x35, 0x7e, 0x87, 0x8d, 0x29, 0x38, 0xda, 0x86, 0x25, 0x1e, 0x11,
-	0x67, 0x40, 0xdd, 0xb4, 0xf2, 0x5a, 0x62, 0x9e, 0xb8, 0xe8, 0x33, 0x58, 0x9e, 0x94, 0x23, 0x27,
-	0xa0, 0xda, 0xcd, 0x89, 0xec, 0x46, 0x0f, 0xb6, 0x4b, 0x8d, 0xcc, 0x9a, 0x8c, 0x3a, 0xb0, 0x16,
-	0x93, 0x2b, 0xe2, 0x08, 0xe2, 0x0e, 0xd2, 0xfe, 0x25, 0xe9, 0xeb, 0xf6, 0x6a, 0x76, 0x2c, 0xc3,
-	0xb8, 0x81, 0x61, 0xa3, 0xcf, 0xbd, 0xe3, 0x98, 0x90, 0xbf, 0xe7, 0x59, 0x09, 0x1d, 0xea, 0xe3,
-	0x1d, 0x70, 0xc7, 0x03, 0x69, 0xd8, 0xb9, 0x8d, 0x3e, 0x49, 0x46, 0x85, 0x39, 0x0b, 0xd3, 0x8d,
-	0x48, 0x2d, 0x63, 0x17, 0x76, 0xa6, 0x28, 0xf2, 0x6d, 0xf8, 0x0d, 0x36, 0xe5, 0xae, 0x5c, 0x7c,
-	0x04, 0x05, 0xc6, 0x3e, 0xec, 0xce, 0x48, 0x96, 0x71, 0x1d, 0xfe, 0xa7, 0x42, 0xb5, 0xcf, 0x3d,
-	0xe4, 0xc1, 0x4a, 0xf1, 0xdf, 0xeb, 0xcb, 0xd9, 0xc3, 0x2d, 0x5f, 0x24, 0xdd, 0x9c, 0x0f, 0x97,
-	0x4f, 0x21, 0x80, 0xb5, 0xf2, 0x6d, 0xeb, 0xbe, 0x98, 0xa2, 0x84, 0xd4, 0xbf, 0x9d, 0x17, 0x99,
-	0xd3, 0xb9, 0xb0, 0x5c, 0xb8, 0x55, 0x5f, 0xbc, 0x98, 0x61, 0x12, 0xa6, 0x7f, 0x33, 0x17, 0x2c,
-	0x67, 0xb9, 0x82, 0xd5, 0xd2, 0xba, 0x74, 0x5e, 0x4c, 0x50, 0x04, 0xea, 0xd6, 0x9c, 0xc0, 0x9c,
-	0x2b, 0x82, 0xf5, 0xa9, 0xd5, 0xf8, 0xea, 0x95, 0xbe, 0x14, 0xa1, 0xfa, 0xc1, 0xdc, 0xd0, 0x8c,
-	0xb1, 0xf7, 0xe3, 0xfd, 0x63, 0x4b, 0x79, 0x78, 0x6c, 0x29, 0x6f, 0x1f, 0x5b, 0xca, 0x3f, 0x4f,
-	0xad, 0xca, 0xc3, 0x53, 0xab, 0xf2, 0xff, 0x53, 0xab, 0xf2, 0x57, 0xc7, 0xa3, 0xe2, 0xf2, 0x66,
-	0x68, 0x3a, 0x2c, 0xb0, 0x0a, 0x8f, 0xe4, 0xed, 0xf3, 0xdb, 0x7d, 0x17, 0x11, 0x3e, 0xac, 0xc9,
-	0x77, 0xf2, 0xbb, 0xf7, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd1, 0xd0, 0x0c, 0x12, 0xe0, 0x07, 0x00,
-	0x00,
+	// 888 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6e, 0xdb, 0xc6,
+	0x13, 0x37, 0x23, 0x4a, 0x96, 0x46, 0xf1, 0xd7, 0xda, 0x48, 0x18, 0x3a, 0x51, 0xf4, 0xe7, 0x1f,
+	0x8d, 0x55, 0x20, 0x25, 0x6b, 0xb7, 0x40, 0x81, 0xde, 0xea, 0x34, 0x29, 0xdc, 0x46, 0x68, 0x40,
+	0xa3, 0x87, 0xf6, 0x50, 0x61, 0x45, 0x4e, 0xe8, 0x8d, 0xc9, 0x5d, 0x82, 0xbb, 0x36, 0xe2, 0x3e,
+	0x45, 0xef, 0xed, 0x1b, 0xf4, 0x45, 0x72, 0xcc, 0xb1, 0xa7, 0xa2, 0xb0, 0xdf, 0xa1, 0xe7, 0x82,
+	0x4b, 0x8a, 0x16, 0x25, 0xd9, 0x50, 0xd1, 0x9e, 0xc8, 0x99, 0xf9, 0xcd, 0xcc, 0x6f, 0x3e, 0x76,
+	0xb1, 0xf0, 0x28, 0xa6, 0xe7, 0x94, 0xa3, 0xf2, 0xf2, 0xaf, 0x97, 0x52, 0x96, 0x31, 0x1e, 0x79,
+	0xea, 0xad, 0x9b, 0x66, 0x42, 0x09, 0xb2, 0x53, 0x9a, 0xdd, 0xfc, 0xeb, 0x96, 0x66, 0xbb, 0x17,
+	0x08, 0x99, 0x08, 0xe9, 0x8d, 0xa9, 0x44, 0xef, 0x7c, 0x7f, 0x8c, 0x8a, 0xee, 0x7b, 0x81, 0x60,
+	0xbc, 0xf0, 0xb2, 0x77, 0x22, 0x11, 0x09, 0xfd, 0xeb, 0xe5, 0x7f, 0xa5, 0x76, 0x50, 0x4b, 0x85,
+	0xa9, 0x08, 0x4e, 0xa4, 0x12, 0x19, 0x8d, 0xd0, 0x43, 0x1e, 0xa6, 0x82, 0x71, 0x55, 0x22, 0xfb,
+	0x0b, 0x49, 0x65, 0x18, 0xd3, 0x8b, 0x02, 0xe1, 0xfc, 0xd2, 0x80, 0xcd, 0xa1, 0x8c, 0x8e, 0x15,
+	0x3d, 0xc5, 0x57, 0x99, 0x38, 0x67, 0x21, 0x66, 0xc4, 0x82, 0xd5, 0x20, 0x43, 0xaa, 0x44, 0x66,
+	0x19, 0x7d, 0x63, 0xd0, 0xf1, 0x27, 0xa2, 0xb6, 0x9c, 0x50, 0xc6, 0x8f, 0xbe, 0xb4, 0xee, 0x94,
+	0x96, 0x42, 0x24, 0x9f, 0x41, 0x8b, 0x26, 0xe2, 0x8c, 0x2b, 0xab, 0xd1, 0x37, 0x06, 0xdd, 0x83,
+	0x07, 0x6e, 0x51, 0x9b, 0x9b, 0xd7, 0xe6, 0x96, 0xb5, 0xb9, 0xcf, 0x04, 0xe3, 0x87, 0xe6, 0xbb,
+	0x3f, 0x1e, 0xaf, 0xf8, 0x25, 0x9c, 0x7c, 0x05, 0x9d, 0x09, 0x6b, 0x69, 0x99, 0xfd, 0xc6, 0xa0,
+	0x7b, 0xf0, 0x7f, 0xb7, 0xd6, 0xad, 0xe9, 0x0a, 0xdd, 0xe7, 0x25, 0xb6, 0x8c, 0x72, 0xed, 0x4b,
+	0xfa, 0xd0, 0x8d, 0x50, 0xc4, 0x22, 0xa0, 0x8a, 0x09, 0x6e, 0x35, 0xfb, 0xc6, 0xa0, 0xe9, 0x4f,
+	0xab, 0x72, 0xf6, 0x89, 0xe0, 0xec, 0x14, 0x33, 0xab, 0x55, 0xb0, 0x2f, 0x45, 0xf2, 0x02, 0xd6,
+	0x43, 0x8c, 0x31, 0xa2, 0x0a, 0x47, 0x31, 0x4b, 0x98, 0xb2, 0x56, 0x97, 0xab, 0x62, 0x6d, 0xe2,
+	0xf6, 0x32, 0xf7, 0x22, 0x1e, 0x6c, 0x57, 0x71, 0x02, 0x91, 0x24, 0x4c, 0xca, 0x9c, 0x4b, 0xbb,
+	0x6f, 0x0c, 0x4c, 0x9f, 0x4c, 0x4c, 0xcf, 0x2a, 0x0b, 0x79, 0x08, 0x9d, 0x73, 0x1a, 0xb3, 0x50,
+	0x37, 0xbb, 0xa3, 0x49, 0x5d, 0x2b, 0x1c, 0x1b, 0xac, 0xd9, 0xe1, 0xf8, 0x28, 0x53, 0xc1, 0x25,
+	0x3a, 0xaf, 0x81, 0x0c, 0x65, 0xf4, 0x1d, 0x97, 0xff, 0x7a, 0x74, 0x35, 0x0e, 0x8d, 0x59, 0x0e,
+	0x0f, 0xc1, 0x9e, 0xcf, 0x53, 0xb1, 0xf8, 0xcb, 0x80, 0x8d, 0xa1, 0x8c, 0xfc, 0x7c, 0xa5, 0x5e,
+	0xd1, 0x8b, 0x04, 0xb9, 0xba, 0x85, 0xc3, 0xe7, 0xd0, 0xd2, 0xcb, 0x27, 0xad, 0x3b, 0x7a, 0xd0,
+	0x8e, 0xbb, 0xe8, 0x58, 0xb8, 0x3a, 0xda, 0x31, 0xea, 0x0e, 0xf9, 0xa5, 0x07, 0x79, 0x0a, 0x5b,
+	0x21, 0xca, 0x20, 0x63, 0x69, 0x3e, 0xcb, 0x63, 0x95, 0x23, 0x2d, 0x53, 0xc7, 0x9f, 0x37, 0x90,
+	0xef, 0x61, 0x27, 0xa6, 0x0a, 0xa5, 0x1a, 0x8d, 0x63, 0x11, 0x9c, 0x8e, 0x32, 0x4c, 0x45, 0xa6,
+	0xa4, 0xd5, 0xd4, 0x79, 0xf7, 0x16, 0xe7, 0x7d, 0xa9, 0x3d, 0x0e, 0x73, 0x07, 0x5f, 0xe3, 0x7d,
+	0x12, 0xcf, 0xaa, 0xe4, 0xd7, 0x66, 0xbb, 0xb1, 0x69, 0x3a, 0xdf, 0xc2, 0xd6, 0x1c, 0x9c, 0xdc,
+	0x87, 0x55, 0x99, 0x62, 0x30, 0x62, 0x61, 0x59, 0x79, 0x2b, 0x17, 0x8f, 0x42, 0xf2, 0x3f, 0xb8,
+	0x3b, 0x4d, 0x47, 0x4f, 0xc0, 0xf4, 0xbb, 0x53, 0xd1, 0x9d, 0x43, 0xb8, 0x3f, 0xd3, 0xc8, 0x49,
+	0x93, 0xc9, 0x1e, 0x6c, 0x64, 0xf8, 0x06, 0x03, 0x85, 0xe1, 0xa8, 0xec, 0x5f, 0x1e, 0xbe, 0xed,
+	0xaf, 0x4f, 0xd4, 0xda, 0x4d, 0x3a, 0x14, 0xb6, 0x86, 0x32, 0x7a, 0x91, 0x21, 0xfe, 0xb4, 0xcc,
+	0x4a, 0xd8, 0xd0, 0x2e, 0x76, 0x20, 0x2c, 0x06, 0xd2, 0xf1, 0x2b, 0x99, 0xdc, 0xcb, 0x47, 0x45,
+	0xa5, 0xe0, 0xe5, 0x46, 0x94, 0x92, 0xb3, 0x0b, 0x0f, 0xe6, 0x52, 0x54, 0xdb, 0xf0, 0x0d, 0x6c,
+	0xeb, 0x5d, 0x79, 0xfd, 0x1f, 0x30, 0x70, 0x1e, 0xc1, 0xee, 0x82, 0x60, 0x55, 0xae, 0xdf, 0x0c,
+	0xb8, 0x97, 0x1f, 0x8e, 0xe0, 0x04, 0xc3, 0xb3, 0x18, 0x87, 0x94, 0x71, 0x85, 0x9c, 0xf2, 0x00,
+	0x6f, 0xc9, 0xb7, 0x0b, 0x1d, 0x1d, 0x7f, 0xc4, 0x16, 0x94, 0xfc, 0x04, 0x36, 0xa4, 0xa2, 0x99,
+	0x1a, 0x31, 0x3e, 0x2a, 0xee, 0x1c, 0x5d, 0xbb, 0xe9, 0xaf, 0x69, 0xf5, 0x11, 0x7f, 0xae, 0x95,
+	0x39, 0xe9, 0xf0, 0x2c, 0x2b, 0x6e, 0x19, 0x53, 0x03, 0x2a, 0x79, 0xaa, 0x6d, 0xcd, 0x5a, 0xdb,
+	0x7e, 0x84, 0xde, 0x62, 0xb2, 0xd5, 0x90, 0x1f, 0x43, 0xb7, 0xc8, 0x5e, 0x6c, 0x88, 0xa1, 0x03,
+	0x83, 0x56, 0xe9, 0x05, 0xc9, 0xb9, 0x23, 0x0f, 0x6b, 0x0b, 0xd4, 0x46, 0x1e, 0x6a, 0xe3, 0xc1,
+	0xaf, 0x4d, 0x68, 0x0c, 0x65, 0x44, 0x22, 0x58, 0xab, 0xdf, 0xe5, 0x4f, 0x16, 0xaf, 0xfa, 0xec,
+	0xb5, 0x62, 0xbb, 0xcb, 0xe1, 0x2a, 0xba, 0x09, 0x6c, 0xcc, 0xde, 0x3d, 0x83, 0x1b, 0x43, 0xcc,
+	0x20, 0xed, 0x8f, 0x97, 0x45, 0x56, 0xe9, 0x42, 0xb8, 0x5b, 0xbb, 0x63, 0x3e, 0xb8, 0x31, 0xc2,
+	0x34, 0xcc, 0xfe, 0x68, 0x29, 0x58, 0x95, 0xe5, 0x0d, 0xac, 0xcf, 0x1c, 0x9e, 0xbd, 0x1b, 0x03,
+	0xd4, 0x81, 0xb6, 0xb7, 0x24, 0xb0, 0xca, 0x95, 0xc2, 0xe6, 0xdc, 0x41, 0xf9, 0xf0, 0x96, 0xbe,
+	0xd4, 0xa1, 0xf6, 0xfe, 0xd2, 0xd0, 0x2a, 0xe3, 0x05, 0x6c, 0x2f, 0x3a, 0x2d, 0x4f, 0x6f, 0x9e,
+	0xfc, 0x3c, 0xda, 0xfe, 0xf4, 0x9f, 0xa0, 0x27, 0xa9, 0x0f, 0xbf, 0x78, 0x77, 0xd9, 0x33, 0xde,
+	0x5f, 0xf6, 0x8c, 0x3f, 0x2f, 0x7b, 0xc6, 0xcf, 0x57, 0xbd, 0x95, 0xf7, 0x57, 0xbd, 0x95, 0xdf,
+	0xaf, 0x7a, 0x2b, 0x3f, 0xec, 0x45, 0x4c, 0x9d, 0x9c, 0x8d, 0xdd, 0x40, 0x24, 0x5e, 0xed, 0xb5,
+	0xf2, 0xf6, 0xfa, 0x11, 0x75, 0x91, 0xa2, 0x1c, 0xb7, 0xf4, 0x83, 0xe5, 0x93, 0xbf, 0x07, 0x00,
+	0x4d, 0x8b, 0xc8, 0xe6, 0x69, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -704,6 +841,7 @@ type MsgClient interface {
 	RelayPayment(ctx context.Context, in *MsgRelayPayment, opts ...grpc.CallOption) (*MsgRelayPaymentResponse, error)
 	FreezeProvider(ctx context.Context, in *MsgFreezeProvider, opts ...grpc.CallOption) (*MsgFreezeProviderResponse, error)
 	UnfreezeProvider(ctx context.Context, in *MsgUnfreezeProvider, opts ...grpc.CallOption) (*MsgUnfreezeProviderResponse, error)
+	ScheduleMaintenance(ctx context.Context, in *MsgScheduleMaintenance, opts ...grpc.CallOption) (*MsgScheduleMaintenanceResponse, error)
 }
 
 type msgClient struct {
@@ -759,6 +897,15 @@ func (c *msgClient) UnfreezeProvider(ctx context.Context, in *MsgUnfreezeProvide
 	return out, nil
 }
 
+func (c *msgClient) ScheduleMaintenance(ctx context.Context, in *MsgScheduleMaintenance, opts ...grpc.CallOption) (*MsgScheduleMaintenanceResponse, error) {
+	out := new(MsgScheduleMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/ScheduleMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	StakeProvider(context.Context, *MsgStakeProvider) (*MsgStakeProviderResponse, error)
@@ -766,6 +913,7 @@ type MsgServer interface {
 	RelayPayment(context.Context, *MsgRelayPayment) (*MsgRelayPaymentResponse, error)
 	FreezeProvider(context.Context, *MsgFreezeProvider) (*MsgFreezeProviderResponse, error)
 	UnfreezeProvider(context.Context, *MsgUnfreezeProvider) (*MsgUnfreezeProviderResponse, error)
+	ScheduleMaintenance(context.Context, *MsgScheduleMaintenance) (*MsgScheduleMaintenanceResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -787,6 +935,9 @@ func (*UnimplementedMsgServer) FreezeProvider(ctx context.Context, req *MsgFreez
 func (*UnimplementedMsgServer) UnfreezeProvider(ctx context.Context, req *MsgUnfreezeProvider) (*MsgUnfreezeProviderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeProvider not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleMaintenance(ctx context.Context, req *MsgScheduleMaintenance) (*MsgScheduleMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMaintenance not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -882,6 +1033,24 @@ func _Msg_UnfreezeProvider_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleMaintenance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/ScheduleMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleMaintenance(ctx, req.(*MsgScheduleMaintenance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.pairing.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -906,6 +1075,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnfreezeProvider",
 			Handler:    _Msg_UnfreezeProvider_Handler,
 		},
+		{
+			MethodName: "ScheduleMaintenance",
+			Handler:    _Msg_ScheduleMaintenance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/pairing/tx.proto",
@@ -1360,6 +1533,95 @@ func (m *MsgUnfreezeProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgScheduleMaintenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleMaintenance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Duration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartInEpochs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartInEpochs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainIds) > 0 {
+		for iNdEx := len(m.ChainIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChainIds[iNdEx])
+			copy(dAtA[i:], m.ChainIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ChainIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleMaintenanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleMaintenanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleMaintenanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1568,6 +1830,50 @@ func (m *MsgUnfreezeProviderResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgScheduleMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ChainIds) > 0 {
+		for _, s := range m.ChainIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.StartInEpochs != 0 {
+		n += 1 + sovTx(uint64(m.StartInEpochs))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovTx(uint64(m.Duration))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgScheduleMaintenanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartBlock != 0 {
+		n += 1 + sovTx(uint64(m.StartBlock))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovTx(uint64(m.EndBlock))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2849,6 +3155,278 @@ func (m *MsgUnfreezeProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgScheduleMaintenance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleMaintenance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainIds = append(m.ChainIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartInEpochs", wireType)
+			}
+			m.StartInEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartInEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleMaintenanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleMaintenanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleMaintenanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+			}
+			m.StartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			m.EndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
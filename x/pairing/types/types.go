@@ -11,6 +11,10 @@ const (
 	LatestBlocksReportEventName = "provider_latest_block_report"
 	RejectedCuEventName         = "rejected_cu"
 	UnstakeProposalEventName    = "unstake_gov_proposal"
+
+	ScheduleMaintenanceEventName = "schedule_maintenance"
+	MaintenanceStartEventName    = "maintenance_start"
+	MaintenanceEndEventName      = "maintenance_end"
 )
 
 // unstake description strings
@@ -25,7 +29,8 @@ const (
 	FlagCommission               = "delegate-commission"
 	FlagDelegationLimit          = "delegate-limit"
 	MAX_LEN_MONIKER              = 50
-	MAX_ENDPOINTS_AMOUNT_PER_GEO = 5 // max number of endpoints per geolocation for provider stake entry
+	MAX_ENDPOINTS_AMOUNT_PER_GEO = 5    // max number of endpoints per geolocation for provider stake entry
+	MAX_MAINTENANCE_START_EPOCHS = 1000 // max number of epochs until a scheduled maintenance starts
 )
 
 // unresponsiveness consts
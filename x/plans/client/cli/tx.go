@@ -36,6 +36,9 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(CmdCreatePlan())
+	cmd.AddCommand(CmdUpdatePlan())
+	cmd.AddCommand(CmdDeprecatePlan())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/plans/client/utils"
+	"github.com/lavanet/lava/x/plans/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdCreatePlan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-plan [plan-file]",
+		Short: "create a new plan (plan issuers only)",
+		Long: `The create-plan command allows a plan issuer (an address in the allowed_plan_creators param) to create a new plan without a governance proposal.
+The plan is described in a JSON file (see example in cookbook/plans/issuer_plan.json). Its price and CU limits must be within the bounds set in the module's params.
+Creating a plan requires a deposit (the plan_creation_deposit param) that is returned when the plan is deprecated.`,
+		Example: `lavad tx plan create-plan plan.json --from <plan_issuer>`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			plan, err := utils.ParsePlanJSON(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePlan(clientCtx.GetFromAddress().String(), plan)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
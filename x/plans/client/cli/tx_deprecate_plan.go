@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/plans/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdDeprecatePlan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deprecate-plan [plan-index]",
+		Short: "deprecate a plan created by the plan issuer",
+		Long: `The deprecate-plan command allows a plan issuer to deprecate one of its plans, so new subscriptions can't buy it.
+Existing subscriptions keep using the plan until they expire. The plan's deposit is returned to the plan issuer.`,
+		Example: `lavad tx plan deprecate-plan reseller-basic --from <plan_issuer>`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeprecatePlan(clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
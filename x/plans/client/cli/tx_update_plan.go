@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/plans/client/utils"
+	"github.com/lavanet/lava/x/plans/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdUpdatePlan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-plan [plan-file]",
+		Short: "update a plan created by the plan issuer",
+		Long: `The update-plan command allows a plan issuer to update one of its plans. The update creates a new version of the plan,
+existing subscriptions keep using the version of the plan they bought. The plan is described in a JSON file (see example in cookbook/plans/issuer_plan.json).`,
+		Example: `lavad tx plan update-plan plan.json --from <plan_issuer>`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			plan, err := utils.ParsePlanJSON(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdatePlan(clientCtx.GetFromAddress().String(), plan)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
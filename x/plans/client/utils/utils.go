@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"fmt"
 	"strings"
 
 	sdkerrors "cosmossdk.io/errors"
@@ -144,3 +145,41 @@ func ParsePlansDelProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (ret
 	}
 	return ret, nil
 }
+
+// Parse a plan JSON form file (used by plan issuers to create and update plans)
+func ParsePlanJSON(planFile string) (types.Plan, error) {
+	decoderHooks := []mapstructure.DecodeHookFunc{
+		types.PriceDecodeHookFunc,
+		types.PolicyEnumDecodeHookFunc,
+	}
+
+	var (
+		plan   types.Plan
+		unused []string
+		unset  []string
+	)
+
+	err := decoder.DecodeFile(planFile, "plan", &plan, decoderHooks, &unset, &unused)
+	if err != nil {
+		return types.Plan{}, err
+	}
+
+	if !types.CheckPlanMandatoryFields(unset) {
+		return types.Plan{}, fmt.Errorf("plan has no specified price or index. plan's unset fields: %v", unset)
+	}
+
+	planPolicyPrefix := "plan_policy."
+	var planPolicyUnset []string
+	for _, unsetField := range unset {
+		if strings.HasPrefix(unsetField, planPolicyPrefix) {
+			planPolicyUnset = append(planPolicyUnset, strings.TrimPrefix(unsetField, planPolicyPrefix))
+		}
+	}
+
+	err = plan.PlanPolicy.HandleUnsetPolicyFields(planPolicyUnset)
+	if err != nil {
+		return types.Plan{}, err
+	}
+
+	return plan, plan.ValidatePlan()
+}
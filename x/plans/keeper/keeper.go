@@ -19,6 +19,7 @@ type (
 		memKey     storetypes.StoreKey
 		paramstore paramtypes.Subspace
 
+		bankKeeper         types.BankKeeper
 		epochstorageKeeper types.EpochStorageKeeper
 		specKeeper         types.SpecKeeper
 		stakingKeeper      types.StakingKeeper
@@ -32,6 +33,7 @@ func NewKeeper(
 	storeKey,
 	memKey storetypes.StoreKey,
 	ps paramtypes.Subspace,
+	bankKeeper types.BankKeeper,
 	epochstorageKeeper types.EpochStorageKeeper,
 	specKeeper types.SpecKeeper,
 	fixationStoreKeeper types.FixationStoreKeeper,
@@ -47,6 +49,7 @@ func NewKeeper(
 	return &Keeper{
 		memKey:             memKey,
 		paramstore:         ps,
+		bankKeeper:         bankKeeper,
 		epochstorageKeeper: epochstorageKeeper,
 		plansFS:            fs,
 		specKeeper:         specKeeper,
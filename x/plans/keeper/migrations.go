@@ -8,6 +8,7 @@ import (
 	v3 "github.com/lavanet/lava/x/plans/migrations/v3"
 	v7 "github.com/lavanet/lava/x/plans/migrations/v7"
 	v8 "github.com/lavanet/lava/x/plans/migrations/v8"
+	"github.com/lavanet/lava/x/plans/types"
 	projectsv3 "github.com/lavanet/lava/x/projects/migrations/v3"
 )
 
@@ -154,3 +155,10 @@ func (m Migrator) Migrate7to8(ctx sdk.Context) error {
 
 	return nil
 }
+
+// Migrate8to9 implements store migration from v8 to v9:
+//   - Set the default params of the plan issuers (plans created without governance)
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	m.keeper.SetParams(ctx, types.DefaultParams())
+	return nil
+}
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/plans/types"
+)
+
+func (k msgServer) DeprecatePlan(goCtx context.Context, msg *types.MsgDeprecatePlan) (*types.MsgDeprecatePlanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.DeprecatePlan(ctx, msg.Creator, msg.Index)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeprecatePlanResponse{}, nil
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/plans/types"
+)
+
+func (k msgServer) UpdatePlan(goCtx context.Context, msg *types.MsgUpdatePlan) (*types.MsgUpdatePlanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.Plan.ValidatePlan(); err != nil {
+		return nil, err
+	}
+
+	err := k.Keeper.UpdatePlan(ctx, msg.Creator, msg.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdatePlanResponse{}, nil
+}
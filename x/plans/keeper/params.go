@@ -7,10 +7,46 @@ import (
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.AllowedPlanCreators(ctx),
+		k.PlanCreationDeposit(ctx),
+		k.MinPlanPrice(ctx),
+		k.MaxPlanTotalCuLimit(ctx),
+		k.MaxPlanEpochCuLimit(ctx),
+	)
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// AllowedPlanCreators returns the AllowedPlanCreators param
+func (k Keeper) AllowedPlanCreators(ctx sdk.Context) (res []string) {
+	k.paramstore.Get(ctx, types.KeyAllowedPlanCreators, &res)
+	return
+}
+
+// PlanCreationDeposit returns the PlanCreationDeposit param
+func (k Keeper) PlanCreationDeposit(ctx sdk.Context) (res sdk.Coin) {
+	k.paramstore.Get(ctx, types.KeyPlanCreationDeposit, &res)
+	return
+}
+
+// MinPlanPrice returns the MinPlanPrice param
+func (k Keeper) MinPlanPrice(ctx sdk.Context) (res sdk.Coin) {
+	k.paramstore.Get(ctx, types.KeyMinPlanPrice, &res)
+	return
+}
+
+// MaxPlanTotalCuLimit returns the MaxPlanTotalCuLimit param
+func (k Keeper) MaxPlanTotalCuLimit(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxPlanTotalCuLimit, &res)
+	return
+}
+
+// MaxPlanEpochCuLimit returns the MaxPlanEpochCuLimit param
+func (k Keeper) MaxPlanEpochCuLimit(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxPlanEpochCuLimit, &res)
+	return
+}
@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/plans/types"
+)
+
+// Plan issuers are addresses allowed by governance (the AllowedPlanCreators param) to create,
+// update and deprecate their own plans without a governance proposal. The plans are kept in
+// the same fixation store as the governance plans, so existing subscriptions stay pinned to the
+// plan version they bought. Creating a plan requires a deposit that is returned when the plan
+// is deprecated.
+
+// CreatePlan adds a new plan created by a plan issuer
+func (k Keeper) CreatePlan(ctx sdk.Context, creator string, plan types.Plan) error {
+	params := k.GetParams(ctx)
+	if !params.IsAllowedPlanCreator(creator) {
+		return utils.LavaFormatWarning("failed to create plan", types.ErrPlanCreatorNotAllowed,
+			utils.Attribute{Key: "creator", Value: creator},
+			utils.Attribute{Key: "plan", Value: plan.Index},
+		)
+	}
+
+	if _, found := k.FindPlan(ctx, plan.Index, uint64(ctx.BlockHeight())); found {
+		return utils.LavaFormatWarning("failed to create plan", fmt.Errorf("plan already exists"),
+			utils.Attribute{Key: "creator", Value: creator},
+			utils.Attribute{Key: "plan", Value: plan.Index},
+		)
+	}
+
+	if err := k.validatePlanBounds(params, plan); err != nil {
+		return err
+	}
+
+	creatorAddr, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		return utils.LavaFormatWarning("invalid creator address", err,
+			utils.Attribute{Key: "creator", Value: creator},
+		)
+	}
+
+	deposit := params.PlanCreationDeposit
+	if deposit.IsPositive() {
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, creatorAddr, types.ModuleName, sdk.NewCoins(deposit))
+		if err != nil {
+			return utils.LavaFormatWarning("failed to pay plan creation deposit", err,
+				utils.Attribute{Key: "creator", Value: creator},
+				utils.Attribute{Key: "deposit", Value: deposit},
+			)
+		}
+	}
+
+	plan.Creator = creator
+	plan.Deposit = &deposit
+	if err := k.AddPlan(ctx, plan, false); err != nil {
+		return err
+	}
+
+	details := map[string]string{
+		"creator":     creator,
+		"planDetails": plan.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.PlanCreateEventName, details, "Plan created by plan issuer")
+	return nil
+}
+
+// UpdatePlan adds a new version of a plan created by a plan issuer. Subscriptions that
+// bought the plan before the update keep using their version of the plan
+func (k Keeper) UpdatePlan(ctx sdk.Context, creator string, plan types.Plan) error {
+	params := k.GetParams(ctx)
+	if !params.IsAllowedPlanCreator(creator) {
+		return utils.LavaFormatWarning("failed to update plan", types.ErrPlanCreatorNotAllowed,
+			utils.Attribute{Key: "creator", Value: creator},
+			utils.Attribute{Key: "plan", Value: plan.Index},
+		)
+	}
+
+	current, err := k.getIssuedPlan(ctx, creator, plan.Index)
+	if err != nil {
+		return err
+	}
+
+	if err := k.validatePlanBounds(params, plan); err != nil {
+		return err
+	}
+
+	plan.Creator = current.Creator
+	plan.Deposit = current.Deposit
+	if err := k.AddPlan(ctx, plan, false); err != nil {
+		return err
+	}
+
+	details := map[string]string{
+		"creator":     creator,
+		"planDetails": plan.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.PlanUpdateEventName, details, "Plan updated by plan issuer")
+	return nil
+}
+
+// DeprecatePlan deletes a plan created by a plan issuer (so new subscriptions can't buy it)
+// and returns the plan's deposit to its creator. A plan issuer can deprecate its plans even
+// after it is removed from the allowed plan creators
+func (k Keeper) DeprecatePlan(ctx sdk.Context, creator string, index string) error {
+	current, err := k.getIssuedPlan(ctx, creator, index)
+	if err != nil {
+		return err
+	}
+
+	if err := k.DelPlan(ctx, index); err != nil {
+		return utils.LavaFormatWarning("failed to deprecate plan", err,
+			utils.Attribute{Key: "creator", Value: creator},
+			utils.Attribute{Key: "plan", Value: index},
+		)
+	}
+
+	if current.Deposit != nil && current.Deposit.IsPositive() {
+		creatorAddr, err := sdk.AccAddressFromBech32(creator)
+		if err != nil {
+			return utils.LavaFormatWarning("invalid creator address", err,
+				utils.Attribute{Key: "creator", Value: creator},
+			)
+		}
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creatorAddr, sdk.NewCoins(*current.Deposit))
+		if err != nil {
+			return utils.LavaFormatError("critical: failed to return plan deposit", err,
+				utils.Attribute{Key: "creator", Value: creator},
+				utils.Attribute{Key: "plan", Value: index},
+				utils.Attribute{Key: "deposit", Value: current.Deposit},
+			)
+		}
+	}
+
+	details := map[string]string{
+		"creator": creator,
+		"index":   index,
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.PlanDeprecateEventName, details, "Plan deprecated by plan issuer")
+	return nil
+}
+
+// getIssuedPlan returns the current version of a plan, verifying it was created by the creator
+func (k Keeper) getIssuedPlan(ctx sdk.Context, creator string, index string) (types.Plan, error) {
+	plan, found := k.FindPlan(ctx, index, uint64(ctx.BlockHeight()))
+	if !found {
+		return types.Plan{}, utils.LavaFormatWarning("plan not found", fmt.Errorf("plan does not exist"),
+			utils.Attribute{Key: "creator", Value: creator},
+			utils.Attribute{Key: "plan", Value: index},
+		)
+	}
+
+	if plan.Creator == "" || plan.Creator != creator {
+		return types.Plan{}, utils.LavaFormatWarning("plan can only be changed by its creator", types.ErrPlanNotOwned,
+			utils.Attribute{Key: "creator", Value: creator},
+			utils.Attribute{Key: "planCreator", Value: plan.Creator},
+			utils.Attribute{Key: "plan", Value: index},
+		)
+	}
+
+	return plan, nil
+}
+
+// validatePlanBounds checks the plan's price and CU limits against the module's params. Plan
+// issuers can't create trial plans, since these are paid by the governance funded trial pool
+func (k Keeper) validatePlanBounds(params types.Params, plan types.Plan) error {
+	if plan.HasTrialFields() {
+		return utils.LavaFormatWarning("plan issuers can't create trial plans", types.ErrInvalidPlanTrial,
+			utils.Attribute{Key: "plan", Value: plan.Index},
+			utils.Attribute{Key: "trial", Value: plan.Trial},
+			utils.Attribute{Key: "trialDuration", Value: plan.TrialDuration},
+			utils.Attribute{Key: "trialCuLimit", Value: plan.TrialCuLimit},
+		)
+	}
+
+	if plan.Price.Denom != params.MinPlanPrice.Denom || plan.Price.Amount.LT(params.MinPlanPrice.Amount) {
+		return utils.LavaFormatWarning("plan price is below the minimum", types.ErrPlanOutOfBounds,
+			utils.Attribute{Key: "plan", Value: plan.Index},
+			utils.Attribute{Key: "price", Value: plan.Price},
+			utils.Attribute{Key: "minPrice", Value: params.MinPlanPrice},
+		)
+	}
+
+	// the monthly price of a yearly subscription (after the annual discount) must be above the minimum too
+	// (ValidatePlan already checked that the discount is at most 100 percent)
+	discountedPrice := plan.Price.Amount.MulRaw(int64(100 - plan.AnnualDiscountPercentage)).QuoRaw(100)
+	if discountedPrice.LT(params.MinPlanPrice.Amount) {
+		return utils.LavaFormatWarning("plan price after the annual discount is below the minimum", types.ErrPlanOutOfBounds,
+			utils.Attribute{Key: "plan", Value: plan.Index},
+			utils.Attribute{Key: "price", Value: plan.Price},
+			utils.Attribute{Key: "annualDiscount", Value: plan.AnnualDiscountPercentage},
+			utils.Attribute{Key: "minPrice", Value: params.MinPlanPrice},
+		)
+	}
+
+	if plan.PlanPolicy.TotalCuLimit > params.MaxPlanTotalCuLimit {
+		return utils.LavaFormatWarning("plan total CU limit is above the maximum", types.ErrPlanOutOfBounds,
+			utils.Attribute{Key: "plan", Value: plan.Index},
+			utils.Attribute{Key: "totalCuLimit", Value: plan.PlanPolicy.TotalCuLimit},
+			utils.Attribute{Key: "maxTotalCuLimit", Value: params.MaxPlanTotalCuLimit},
+		)
+	}
+
+	if plan.PlanPolicy.EpochCuLimit > params.MaxPlanEpochCuLimit {
+		return utils.LavaFormatWarning("plan epoch CU limit is above the maximum", types.ErrPlanOutOfBounds,
+			utils.Attribute{Key: "plan", Value: plan.Index},
+			utils.Attribute{Key: "epochCuLimit", Value: plan.PlanPolicy.EpochCuLimit},
+			utils.Attribute{Key: "maxEpochCuLimit", Value: params.MaxPlanEpochCuLimit},
+		)
+	}
+
+	return nil
+}
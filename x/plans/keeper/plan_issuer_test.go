@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/lavanet/lava/testutil/common"
+	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/x/plans/types"
+	"github.com/stretchr/testify/require"
+)
+
+func (ts *tester) setupPlanIssuers(issuers ...string) types.Params {
+	params := ts.Keepers.Plans.GetParams(ts.Ctx)
+	params.AllowedPlanCreators = issuers
+	params.PlanCreationDeposit = common.NewCoin(ts.Keepers.StakingKeeper.BondDenom(ts.Ctx), 1000)
+	params.MinPlanPrice = common.NewCoin(ts.Keepers.StakingKeeper.BondDenom(ts.Ctx), 80)
+	params.MaxPlanTotalCuLimit = 100000
+	params.MaxPlanEpochCuLimit = 10000
+	ts.Keepers.Plans.SetParams(ts.Ctx, params)
+	return params
+}
+
+func TestCreatePlanByIssuer(t *testing.T) {
+	ts := newTester(t)
+	issuerAcct, issuer := ts.AddAccount("issuer", 1, 10000)
+	_, other := ts.AddAccount("issuer", 2, 10000)
+	ts.setupPlanIssuers(issuer)
+
+	plan := common.CreateMockPlan()
+	plan.Index = "reseller"
+
+	// only allowed plan creators can create plans
+	_, err := ts.TxPlansCreatePlan(other, plan)
+	require.ErrorIs(t, err, types.ErrPlanCreatorNotAllowed)
+
+	// plans must be within the bounds of the params
+	tooCheap := plan
+	tooCheap.Price = common.NewCoin(plan.Price.Denom, 10)
+	_, err = ts.TxPlansCreatePlan(issuer, tooCheap)
+	require.ErrorIs(t, err, types.ErrPlanOutOfBounds)
+
+	// the annual discount can't take the price below the minimum
+	tooDiscounted := plan
+	tooDiscounted.Price = common.NewCoin(plan.Price.Denom, 90)
+	tooDiscounted.AnnualDiscountPercentage = 20
+	_, err = ts.TxPlansCreatePlan(issuer, tooDiscounted)
+	require.ErrorIs(t, err, types.ErrPlanOutOfBounds)
+	tooDiscounted.AnnualDiscountPercentage = 100
+	tooDiscounted.Price = plan.Price
+	_, err = ts.TxPlansCreatePlan(issuer, tooDiscounted)
+	require.ErrorIs(t, err, types.ErrPlanOutOfBounds)
+
+	// trial plans are paid by the trial pool, so only governance can add them
+	trialPlan := plan
+	trialPlan.Trial = true
+	trialPlan.TrialDuration = 1
+	trialPlan.TrialCuLimit = 500
+	_, err = ts.TxPlansCreatePlan(issuer, trialPlan)
+	require.ErrorIs(t, err, types.ErrInvalidPlanTrial)
+
+	tooBig := plan
+	tooBig.PlanPolicy.TotalCuLimit = 200000
+	_, err = ts.TxPlansCreatePlan(issuer, tooBig)
+	require.ErrorIs(t, err, types.ErrPlanOutOfBounds)
+
+	tooBig = plan
+	tooBig.PlanPolicy.EpochCuLimit = 20000
+	_, err = ts.TxPlansCreatePlan(issuer, tooBig)
+	require.ErrorIs(t, err, types.ErrPlanOutOfBounds)
+
+	_, err = ts.TxPlansCreatePlan(issuer, plan)
+	require.NoError(t, err)
+
+	// the deposit is held by the module
+	require.Equal(t, int64(9000), ts.GetBalance(issuerAcct.Addr))
+	require.Equal(t, int64(1000), ts.GetBalance(testkeeper.GetModuleAddress(types.ModuleName)))
+
+	res, found := ts.FindPlan(plan.Index, ts.BlockHeight())
+	require.True(t, found)
+	require.Equal(t, issuer, res.Creator)
+	require.Equal(t, int64(1000), res.Deposit.Amount.Int64())
+	require.Equal(t, ts.BlockHeight(), res.Block)
+
+	// plan indices are unique (including plans added by governance)
+	_, err = ts.TxPlansCreatePlan(issuer, plan)
+	require.Error(t, err)
+
+	govPlan := common.CreateMockPlan()
+	govPlan.Index = "governance"
+	err = ts.TxProposalAddPlans(govPlan)
+	require.NoError(t, err)
+	govPlan.Price = plan.Price
+	_, err = ts.TxPlansCreatePlan(issuer, govPlan)
+	require.Error(t, err)
+
+	// issuers can't change plans they did not create
+	_, err = ts.TxPlansUpdatePlan(issuer, govPlan)
+	require.ErrorIs(t, err, types.ErrPlanNotOwned)
+	_, err = ts.TxPlansDeprecatePlan(issuer, govPlan.Index)
+	require.ErrorIs(t, err, types.ErrPlanNotOwned)
+}
+
+func TestUpdatePlanByIssuer(t *testing.T) {
+	ts := newTester(t)
+	_, issuer := ts.AddAccount("issuer", 1, 10000)
+	_, other := ts.AddAccount("issuer", 2, 10000)
+	_, consumer := ts.AddAccount("consumer", 1, 10000)
+	ts.setupPlanIssuers(issuer, other)
+
+	plan := common.CreateMockPlan()
+	plan.Index = "reseller"
+	_, err := ts.TxPlansCreatePlan(issuer, plan)
+	require.NoError(t, err)
+	createBlock := ts.BlockHeight()
+
+	ts.AdvanceEpoch()
+
+	_, err = ts.TxSubscriptionBuy(consumer, consumer, plan.Index, 1, false, false)
+	require.NoError(t, err)
+
+	ts.AdvanceEpoch()
+
+	// only the plan's creator can update it
+	updated := plan
+	updated.Price = common.NewCoin(plan.Price.Denom, 200)
+	_, err = ts.TxPlansUpdatePlan(other, updated)
+	require.ErrorIs(t, err, types.ErrPlanNotOwned)
+
+	// updates are bound by the params too
+	tooCheap := updated
+	tooCheap.Price = common.NewCoin(plan.Price.Denom, 10)
+	_, err = ts.TxPlansUpdatePlan(issuer, tooCheap)
+	require.ErrorIs(t, err, types.ErrPlanOutOfBounds)
+
+	// an issued plan can't be turned into a trial plan
+	trialPlan := updated
+	trialPlan.Trial = true
+	trialPlan.TrialDuration = 1
+	trialPlan.TrialCuLimit = 500
+	_, err = ts.TxPlansUpdatePlan(issuer, trialPlan)
+	require.ErrorIs(t, err, types.ErrInvalidPlanTrial)
+
+	_, err = ts.TxPlansUpdatePlan(issuer, updated)
+	require.NoError(t, err)
+
+	res, found := ts.FindPlan(plan.Index, ts.BlockHeight())
+	require.True(t, found)
+	require.Equal(t, int64(200), res.Price.Amount.Int64())
+	require.Equal(t, issuer, res.Creator)
+	require.Equal(t, int64(1000), res.Deposit.Amount.Int64())
+
+	// the existing subscription stays pinned to the plan version it bought
+	sub, err := ts.QuerySubscriptionCurrent(consumer)
+	require.NoError(t, err)
+	require.Equal(t, createBlock, sub.Sub.PlanBlock)
+	res, found = ts.FindPlan(plan.Index, sub.Sub.PlanBlock)
+	require.True(t, found)
+	require.Equal(t, int64(100), res.Price.Amount.Int64())
+
+	// an issuer removed from the allowed plan creators can't update its plans
+	ts.setupPlanIssuers(other)
+	_, err = ts.TxPlansUpdatePlan(issuer, updated)
+	require.ErrorIs(t, err, types.ErrPlanCreatorNotAllowed)
+}
+
+func TestDeprecatePlanByIssuer(t *testing.T) {
+	ts := newTester(t)
+	issuerAcct, issuer := ts.AddAccount("issuer", 1, 10000)
+	_, other := ts.AddAccount("issuer", 2, 10000)
+	ts.setupPlanIssuers(issuer, other)
+
+	plan := common.CreateMockPlan()
+	plan.Index = "reseller"
+	_, err := ts.TxPlansCreatePlan(issuer, plan)
+	require.NoError(t, err)
+
+	// the deposit of the plan is kept even if the deposit param changes
+	ts.setupPlanIssuers()
+
+	_, err = ts.TxPlansDeprecatePlan(other, plan.Index)
+	require.ErrorIs(t, err, types.ErrPlanNotOwned)
+
+	// an issuer can deprecate its plans even if it is no longer allowed to create plans
+	_, err = ts.TxPlansDeprecatePlan(issuer, plan.Index)
+	require.NoError(t, err)
+	require.Equal(t, int64(10000), ts.GetBalance(issuerAcct.Addr))
+	require.Equal(t, int64(0), ts.GetBalance(testkeeper.GetModuleAddress(types.ModuleName)))
+
+	// the deposit can't be returned twice
+	_, err = ts.TxPlansDeprecatePlan(issuer, plan.Index)
+	require.Error(t, err)
+
+	ts.AdvanceEpoch()
+
+	_, found := ts.FindPlan(plan.Index, ts.BlockHeight())
+	require.False(t, found)
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
 	// this line is used by starport scaffolding # 1
@@ -10,10 +11,22 @@ import (
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreatePlan{}, "plans/CreatePlan", nil)
+	cdc.RegisterConcrete(&MsgUpdatePlan{}, "plans/UpdatePlan", nil)
+	cdc.RegisterConcrete(&MsgDeprecatePlan{}, "plans/DeprecatePlan", nil)
 	// this line is used by starport scaffolding # 2
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreatePlan{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdatePlan{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDeprecatePlan{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
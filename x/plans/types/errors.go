@@ -27,4 +27,8 @@ var (
 	ErrPolicyGeolocation                    = sdkerrors.Register(ModuleName, 16, "plan's geolocation is invalid")
 	ErrInvalidDenom                         = sdkerrors.Register(ModuleName, 17, commontypes.ErrInvalidDenomMsg)
 	ErrInvalidPlanProjects                  = sdkerrors.Register(ModuleName, 18, "plan's projects field is invalid")
+	ErrPlanCreatorNotAllowed                = sdkerrors.Register(ModuleName, 19, "plan creator is not allowed to create plans")
+	ErrPlanOutOfBounds                      = sdkerrors.Register(ModuleName, 20, "plan's fields are out of the allowed bounds")
+	ErrPlanNotOwned                         = sdkerrors.Register(ModuleName, 21, "plan was not created by the plan creator")
+	ErrInvalidPlanTrial                     = sdkerrors.Register(ModuleName, 22, "plan's trial fields are invalid")
 )
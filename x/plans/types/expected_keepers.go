@@ -7,6 +7,12 @@ import (
 	fixationstoretypes "github.com/lavanet/lava/x/fixationstore/types"
 )
 
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	// Methods imported from bank should be defined here
+}
+
 type EpochStorageKeeper interface {
 	GetEpochStart(ctx sdk.Context) uint64
 	GetNextEpoch(ctx sdk.Context, block uint64) (uint64, error)
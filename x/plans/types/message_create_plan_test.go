@@ -0,0 +1,95 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func createMessagePlan() Plan {
+	return Plan{
+		Index: "reseller",
+		Price: sdk.NewCoin("ulava", sdk.NewInt(100)),
+		PlanPolicy: Policy{
+			EpochCuLimit:       100,
+			TotalCuLimit:       1000,
+			MaxProvidersToPair: 3,
+			GeolocationProfile: 1,
+		},
+	}
+}
+
+func TestMsgCreatePlan_ValidateBasic(t *testing.T) {
+	freePlan := createMessagePlan()
+	freePlan.Price = sdk.NewCoin("ulava", sdk.ZeroInt())
+	trialPlan := createMessagePlan()
+	trialPlan.Trial = true
+	trialPlan.TrialDuration = 1
+	trialPlan.TrialCuLimit = 500
+	unboundedTrialPlan := trialPlan
+	unboundedTrialPlan.TrialDuration = MAX_TRIAL_DURATION + 1
+	notTrialPlan := trialPlan
+	notTrialPlan.Trial = false
+
+	tests := []struct {
+		name string
+		msg  MsgCreatePlan
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCreatePlan{
+				Creator: "invalid_address",
+				Plan:    createMessagePlan(),
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid plan",
+			msg: MsgCreatePlan{
+				Creator: sample.AccAddress(),
+				Plan:    freePlan,
+			},
+			err: ErrInvalidPlanPrice,
+		}, {
+			name: "trial plan with unbounded duration",
+			msg: MsgCreatePlan{
+				Creator: sample.AccAddress(),
+				Plan:    unboundedTrialPlan,
+			},
+			err: ErrInvalidPlanTrial,
+		}, {
+			name: "trial fields without trial",
+			msg: MsgCreatePlan{
+				Creator: sample.AccAddress(),
+				Plan:    notTrialPlan,
+			},
+			err: ErrInvalidPlanTrial,
+		}, {
+			name: "trial plan",
+			msg: MsgCreatePlan{
+				Creator: sample.AccAddress(),
+				Plan:    trialPlan,
+			},
+			err: ErrInvalidPlanTrial,
+		}, {
+			name: "valid",
+			msg: MsgCreatePlan{
+				Creator: sample.AccAddress(),
+				Plan:    createMessagePlan(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgDeprecatePlan = "deprecate_plan"
+
+var _ sdk.Msg = &MsgDeprecatePlan{}
+
+func NewMsgDeprecatePlan(creator string, index string) *MsgDeprecatePlan {
+	return &MsgDeprecatePlan{
+		Creator: creator,
+		Index:   index,
+	}
+}
+
+func (msg *MsgDeprecatePlan) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDeprecatePlan) Type() string {
+	return TypeMsgDeprecatePlan
+}
+
+func (msg *MsgDeprecatePlan) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDeprecatePlan) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDeprecatePlan) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.Index == "" {
+		return sdkerrors.Wrap(ErrInvalidPlanIndex, "plan's index can't be empty")
+	}
+
+	return nil
+}
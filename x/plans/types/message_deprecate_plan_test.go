@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgDeprecatePlan_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgDeprecatePlan
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgDeprecatePlan{
+				Creator: "invalid_address",
+				Index:   "reseller",
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "empty index",
+			msg: MsgDeprecatePlan{
+				Creator: sample.AccAddress(),
+			},
+			err: ErrInvalidPlanIndex,
+		}, {
+			name: "valid",
+			msg: MsgDeprecatePlan{
+				Creator: sample.AccAddress(),
+				Index:   "reseller",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
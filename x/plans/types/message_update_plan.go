@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdatePlan = "update_plan"
+
+var _ sdk.Msg = &MsgUpdatePlan{}
+
+func NewMsgUpdatePlan(creator string, plan Plan) *MsgUpdatePlan {
+	return &MsgUpdatePlan{
+		Creator: creator,
+		Plan:    plan,
+	}
+}
+
+func (msg *MsgUpdatePlan) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdatePlan) Type() string {
+	return TypeMsgUpdatePlan
+}
+
+func (msg *MsgUpdatePlan) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdatePlan) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdatePlan) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := msg.Plan.ValidatePlan(); err != nil {
+		return sdkerrors.Wrapf(err, "invalid plan")
+	}
+
+	// trial plans are paid by the trial pool, so only governance can add them
+	if msg.Plan.HasTrialFields() {
+		return sdkerrors.Wrap(ErrInvalidPlanTrial, "plan issuers can't create trial plans")
+	}
+
+	return nil
+}
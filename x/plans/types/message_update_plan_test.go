@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdatePlan_ValidateBasic(t *testing.T) {
+	noIndexPlan := createMessagePlan()
+	noIndexPlan.Index = ""
+
+	tests := []struct {
+		name string
+		msg  MsgUpdatePlan
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdatePlan{
+				Creator: "invalid_address",
+				Plan:    createMessagePlan(),
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid plan",
+			msg: MsgUpdatePlan{
+				Creator: sample.AccAddress(),
+				Plan:    noIndexPlan,
+			},
+			err: ErrInvalidPlanIndex,
+		}, {
+			name: "valid",
+			msg: MsgUpdatePlan{
+				Creator: sample.AccAddress(),
+				Plan:    createMessagePlan(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
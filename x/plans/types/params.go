@@ -1,39 +1,159 @@
 package types
 
 import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	commontypes "github.com/lavanet/lava/common/types"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyAllowedPlanCreators     = []byte("AllowedPlanCreators")
+	DefaultAllowedPlanCreators []string
+)
+
+var (
+	KeyPlanCreationDeposit              = []byte("PlanCreationDeposit")
+	DefaultPlanCreationDeposit sdk.Coin = sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(1000000000)) // 1000 lava = 1,000,000,000 ulava
+)
+
+var (
+	KeyMinPlanPrice              = []byte("MinPlanPrice")
+	DefaultMinPlanPrice sdk.Coin = sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(1000000)) // 1 lava = 1,000,000 ulava
+)
+
+var (
+	KeyMaxPlanTotalCuLimit     = []byte("MaxPlanTotalCuLimit")
+	DefaultMaxPlanTotalCuLimit = uint64(1000000000)
+)
+
+var (
+	KeyMaxPlanEpochCuLimit     = []byte("MaxPlanEpochCuLimit")
+	DefaultMaxPlanEpochCuLimit = uint64(5000000)
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	allowedPlanCreators []string,
+	planCreationDeposit sdk.Coin,
+	minPlanPrice sdk.Coin,
+	maxPlanTotalCuLimit uint64,
+	maxPlanEpochCuLimit uint64,
+) Params {
+	return Params{
+		AllowedPlanCreators: allowedPlanCreators,
+		PlanCreationDeposit: planCreationDeposit,
+		MinPlanPrice:        minPlanPrice,
+		MaxPlanTotalCuLimit: maxPlanTotalCuLimit,
+		MaxPlanEpochCuLimit: maxPlanEpochCuLimit,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultAllowedPlanCreators,
+		DefaultPlanCreationDeposit,
+		DefaultMinPlanPrice,
+		DefaultMaxPlanTotalCuLimit,
+		DefaultMaxPlanEpochCuLimit,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyAllowedPlanCreators, &p.AllowedPlanCreators, validateAllowedPlanCreators),
+		paramtypes.NewParamSetPair(KeyPlanCreationDeposit, &p.PlanCreationDeposit, validateCoin),
+		paramtypes.NewParamSetPair(KeyMinPlanPrice, &p.MinPlanPrice, validateCoin),
+		paramtypes.NewParamSetPair(KeyMaxPlanTotalCuLimit, &p.MaxPlanTotalCuLimit, validateUint64),
+		paramtypes.NewParamSetPair(KeyMaxPlanEpochCuLimit, &p.MaxPlanEpochCuLimit, validateUint64),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateAllowedPlanCreators(p.AllowedPlanCreators); err != nil {
+		return fmt.Errorf("invalid AllowedPlanCreators. Error: %s", err.Error())
+	}
+
+	if err := validateCoin(p.PlanCreationDeposit); err != nil {
+		return fmt.Errorf("invalid PlanCreationDeposit. Error: %s", err.Error())
+	}
+
+	if err := validateCoin(p.MinPlanPrice); err != nil {
+		return fmt.Errorf("invalid MinPlanPrice. Error: %s", err.Error())
+	}
+
+	if p.MaxPlanEpochCuLimit > p.MaxPlanTotalCuLimit {
+		return fmt.Errorf("max_plan_epoch_cu_limit cannot be greater than max_plan_total_cu_limit")
+	}
+
 	return nil
 }
 
+// IsAllowedPlanCreator checks whether an address is allowed to create plans
+func (p Params) IsAllowedPlanCreator(creator string) bool {
+	for _, allowed := range p.AllowedPlanCreators {
+		if allowed == creator {
+			return true
+		}
+	}
+	return false
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateAllowedPlanCreators(v interface{}) error {
+	creators, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	for _, creator := range creators {
+		if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+			return fmt.Errorf("invalid plan creator address %s: %w", creator, err)
+		}
+	}
+
+	return nil
+}
+
+func validateCoin(v interface{}) error {
+	coin, ok := v.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if coin.Denom != commontypes.TokenDenom {
+		return fmt.Errorf("invalid coin denom %s", coin.Denom)
+	}
+
+	if coin.IsNil() || coin.IsNegative() {
+		return fmt.Errorf("invalid coin amount %s", coin.Amount)
+	}
+
+	return nil
+}
+
+func validateUint64(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -25,6 +26,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	AllowedPlanCreators []string   `protobuf:"bytes,1,rep,name=allowed_plan_creators,json=allowedPlanCreators,proto3" json:"allowed_plan_creators,omitempty" yaml:"allowed_plan_creators"`
+	PlanCreationDeposit types.Coin `protobuf:"bytes,2,opt,name=plan_creation_deposit,json=planCreationDeposit,proto3" json:"plan_creation_deposit" yaml:"plan_creation_deposit"`
+	MinPlanPrice        types.Coin `protobuf:"bytes,3,opt,name=min_plan_price,json=minPlanPrice,proto3" json:"min_plan_price" yaml:"min_plan_price"`
+	MaxPlanTotalCuLimit uint64     `protobuf:"varint,4,opt,name=max_plan_total_cu_limit,json=maxPlanTotalCuLimit,proto3" json:"max_plan_total_cu_limit,omitempty" yaml:"max_plan_total_cu_limit"`
+	MaxPlanEpochCuLimit uint64     `protobuf:"varint,5,opt,name=max_plan_epoch_cu_limit,json=maxPlanEpochCuLimit,proto3" json:"max_plan_epoch_cu_limit,omitempty" yaml:"max_plan_epoch_cu_limit"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +65,41 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetAllowedPlanCreators() []string {
+	if m != nil {
+		return m.AllowedPlanCreators
+	}
+	return nil
+}
+
+func (m *Params) GetPlanCreationDeposit() types.Coin {
+	if m != nil {
+		return m.PlanCreationDeposit
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetMinPlanPrice() types.Coin {
+	if m != nil {
+		return m.MinPlanPrice
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetMaxPlanTotalCuLimit() uint64 {
+	if m != nil {
+		return m.MaxPlanTotalCuLimit
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPlanEpochCuLimit() uint64 {
+	if m != nil {
+		return m.MaxPlanEpochCuLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "lavanet.lava.plans.Params")
 }
@@ -66,17 +107,32 @@ func init() {
 func init() { proto.RegisterFile("lavanet/lava/plans/params.proto", fileDescriptor_bb70e7895581f8ab) }
 
 var fileDescriptor_bb70e7895581f8ab = []byte{
-	// 146 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x49, 0x2c, 0x4b,
-	0xcc, 0x4b, 0x2d, 0xd1, 0x07, 0xd1, 0xfa, 0x05, 0x39, 0x89, 0x79, 0xc5, 0xfa, 0x05, 0x89, 0x45,
-	0x89, 0xb9, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x42, 0x50, 0x05, 0x7a, 0x20, 0x5a,
-	0x0f, 0xac, 0x40, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0x2c, 0xad, 0x0f, 0x62, 0x41, 0x54, 0x2a,
-	0xf1, 0x71, 0xb1, 0x05, 0x80, 0x75, 0x5a, 0xb1, 0xcc, 0x58, 0x20, 0xcf, 0xe0, 0x64, 0x7f, 0xe2,
-	0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xaa, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49,
-	0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x28, 0xf6, 0x57, 0x40, 0x5d, 0x50, 0x52, 0x59, 0x90, 0x5a, 0x9c,
-	0xc4, 0x06, 0x36, 0xd7, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xb1, 0xb3, 0x0f, 0x7a, 0xa4, 0x00,
-	0x00, 0x00,
+	// 394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x31, 0x8f, 0xd3, 0x30,
+	0x18, 0x86, 0x13, 0x5a, 0x4e, 0x22, 0x20, 0x86, 0x94, 0x8a, 0x72, 0x02, 0x27, 0x8a, 0x40, 0xea,
+	0x64, 0xeb, 0x60, 0xbb, 0x05, 0x29, 0x85, 0x8d, 0xa1, 0x8a, 0x6e, 0x40, 0x0c, 0x44, 0x4e, 0xce,
+	0xea, 0x59, 0xb2, 0xfd, 0x59, 0xb1, 0x7b, 0xf4, 0xfe, 0x05, 0x23, 0x23, 0x3f, 0xe7, 0xc6, 0x1b,
+	0x99, 0x2a, 0xd4, 0xce, 0x2c, 0xfd, 0x05, 0xc8, 0x89, 0x51, 0x14, 0xa9, 0xe8, 0x26, 0x27, 0xaf,
+	0x9f, 0x3c, 0xef, 0x17, 0xe9, 0x8b, 0x12, 0x41, 0xaf, 0xa9, 0x62, 0x96, 0xb8, 0x93, 0x68, 0x41,
+	0x95, 0x21, 0x9a, 0x36, 0x54, 0x1a, 0xac, 0x1b, 0xb0, 0x10, 0xc7, 0x1e, 0xc0, 0xee, 0xc4, 0x2d,
+	0x70, 0xfa, 0x6c, 0x05, 0x2b, 0x68, 0xaf, 0x89, 0x7b, 0xea, 0xc8, 0x53, 0x54, 0x83, 0x91, 0x60,
+	0x48, 0x45, 0x0d, 0x23, 0xd7, 0x67, 0x15, 0xb3, 0xf4, 0x8c, 0xd4, 0xc0, 0x55, 0x77, 0x9f, 0xfd,
+	0x19, 0x45, 0x27, 0xcb, 0x56, 0x1d, 0x5f, 0x44, 0x53, 0x2a, 0x04, 0x7c, 0x63, 0x97, 0xa5, 0x33,
+	0x96, 0x75, 0xc3, 0xa8, 0x85, 0xc6, 0xcc, 0xc2, 0x74, 0x34, 0x7f, 0x94, 0xa7, 0x87, 0x6d, 0xf2,
+	0xf2, 0x86, 0x4a, 0x71, 0x9e, 0x1d, 0xc5, 0xb2, 0x62, 0xe2, 0xf3, 0xa5, 0xa0, 0x6a, 0xe1, 0xd3,
+	0xd8, 0x44, 0xd3, 0x1e, 0xe3, 0xa0, 0xca, 0x4b, 0xa6, 0xc1, 0x70, 0x3b, 0x7b, 0x90, 0x86, 0xf3,
+	0xc7, 0x6f, 0x5f, 0xe0, 0x6e, 0x40, 0xec, 0x06, 0xc4, 0x7e, 0x40, 0xbc, 0x00, 0xae, 0xf2, 0xd7,
+	0xb7, 0xdb, 0x24, 0xe8, 0x4b, 0x8f, 0x5a, 0xb2, 0x62, 0xa2, 0xff, 0xb5, 0x71, 0x50, 0x1f, 0xba,
+	0x34, 0xfe, 0x1a, 0x3d, 0x95, 0x5c, 0x75, 0xf3, 0xe9, 0x86, 0xd7, 0x6c, 0x36, 0xba, 0xaf, 0xed,
+	0x95, 0x6f, 0x9b, 0x76, 0x6d, 0xc3, 0xcf, 0xb3, 0xe2, 0x89, 0xe4, 0xca, 0xfd, 0xd7, 0xd2, 0xbd,
+	0xc6, 0x9f, 0xa3, 0xe7, 0x92, 0x6e, 0x3a, 0xc0, 0x82, 0xa5, 0xa2, 0xac, 0xd7, 0xa5, 0xe0, 0x92,
+	0xdb, 0xd9, 0x38, 0x0d, 0xe7, 0xe3, 0x3c, 0x3b, 0x6c, 0x13, 0xe4, 0x4d, 0xc7, 0xc1, 0xac, 0x98,
+	0x48, 0xba, 0x71, 0xca, 0x0b, 0x97, 0x2f, 0xd6, 0x9f, 0x5c, 0x3a, 0x30, 0x33, 0x0d, 0xf5, 0x55,
+	0x6f, 0x7e, 0xf8, 0x5f, 0xf3, 0x10, 0xec, 0xcd, 0x1f, 0x5d, 0xee, 0xcd, 0xe7, 0xe3, 0x1f, 0x3f,
+	0x93, 0x20, 0x7f, 0x7f, 0xbb, 0x43, 0xe1, 0xdd, 0x0e, 0x85, 0xbf, 0x77, 0x28, 0xfc, 0xbe, 0x47,
+	0xc1, 0xdd, 0x1e, 0x05, 0xbf, 0xf6, 0x28, 0xf8, 0xf2, 0x66, 0xc5, 0xed, 0xd5, 0xba, 0xc2, 0x35,
+	0x48, 0x32, 0xd8, 0xbf, 0x8d, 0xdf, 0x40, 0x7b, 0xa3, 0x99, 0xa9, 0x4e, 0xda, 0xbd, 0x79, 0xf7,
+	0x77, 0x00, 0x10, 0x63, 0x1b, 0x83, 0xa4, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -99,6 +155,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPlanEpochCuLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPlanEpochCuLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxPlanTotalCuLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPlanTotalCuLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.MinPlanPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.PlanCreationDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.AllowedPlanCreators) > 0 {
+		for iNdEx := len(m.AllowedPlanCreators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedPlanCreators[iNdEx])
+			copy(dAtA[i:], m.AllowedPlanCreators[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedPlanCreators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -119,6 +214,22 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.AllowedPlanCreators) > 0 {
+		for _, s := range m.AllowedPlanCreators {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.PlanCreationDeposit.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinPlanPrice.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxPlanTotalCuLimit != 0 {
+		n += 1 + sovParams(uint64(m.MaxPlanTotalCuLimit))
+	}
+	if m.MaxPlanEpochCuLimit != 0 {
+		n += 1 + sovParams(uint64(m.MaxPlanEpochCuLimit))
+	}
 	return n
 }
 
@@ -157,6 +268,142 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPlanCreators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedPlanCreators = append(m.AllowedPlanCreators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanCreationDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PlanCreationDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPlanPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPlanPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPlanTotalCuLimit", wireType)
+			}
+			m.MaxPlanTotalCuLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPlanTotalCuLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPlanEpochCuLimit", wireType)
+			}
+			m.MaxPlanEpochCuLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPlanEpochCuLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
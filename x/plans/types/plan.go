@@ -45,6 +45,18 @@ func (p Plan) ValidatePlan() error {
 		return sdkerrors.Wrap(ErrInvalidPlanAnnualDiscount, "plan's annual discount is invalid (not between 0-100 percent)")
 	}
 
+	// check that a trial plan has a bounded duration and CU, and that other plans have no trial fields
+	if p.GetTrial() {
+		if p.GetTrialDuration() == 0 || p.GetTrialDuration() > MAX_TRIAL_DURATION {
+			return sdkerrors.Wrapf(ErrInvalidPlanTrial, "trial plan's duration must be between 1-%d months", MAX_TRIAL_DURATION)
+		}
+		if p.GetTrialCuLimit() == 0 {
+			return sdkerrors.Wrap(ErrInvalidPlanTrial, "trial plan's CU limit can't be zero")
+		}
+	} else if p.GetTrialDuration() != 0 || p.GetTrialCuLimit() != 0 {
+		return sdkerrors.Wrap(ErrInvalidPlanTrial, "plan can't set trial duration or trial CU limit without being a trial plan")
+	}
+
 	err := p.PlanPolicy.ValidateBasicPolicy(true)
 	if err != nil {
 		return err
@@ -53,6 +65,11 @@ func (p Plan) ValidatePlan() error {
 	return nil
 }
 
+// HasTrialFields returns whether the plan is a trial plan or sets any of the trial fields
+func (p Plan) HasTrialFields() bool {
+	return p.GetTrial() || p.GetTrialDuration() != 0 || p.GetTrialCuLimit() != 0
+}
+
 // PriceDecodeHookFunc helps the decoder to correctly unmarshal the price field's amount (type math.Int)
 func PriceDecodeHookFunc(f reflect.Type, t reflect.Type, data interface{}) (interface{}, error) {
 	if t == reflect.TypeOf(sdk.NewInt(0)) {
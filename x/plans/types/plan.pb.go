@@ -75,17 +75,22 @@ func (Geolocation) EnumDescriptor() ([]byte, []int) {
 }
 
 type Plan struct {
-	Index                    string     `protobuf:"bytes,1,opt,name=index,proto3" json:"index"`
-	Block                    uint64     `protobuf:"varint,3,opt,name=block,proto3" json:"block"`
-	Price                    types.Coin `protobuf:"bytes,4,opt,name=price,proto3" json:"price"`
-	AllowOveruse             bool       `protobuf:"varint,8,opt,name=allow_overuse,json=allowOveruse,proto3" json:"allow_overuse"`
-	OveruseRate              uint64     `protobuf:"varint,9,opt,name=overuse_rate,json=overuseRate,proto3" json:"overuse_rate"`
-	Description              string     `protobuf:"bytes,11,opt,name=description,proto3" json:"description"`
-	Type                     string     `protobuf:"bytes,12,opt,name=type,proto3" json:"type"`
-	AnnualDiscountPercentage uint64     `protobuf:"varint,13,opt,name=annual_discount_percentage,json=annualDiscountPercentage,proto3" json:"annual_discount_percentage"`
-	PlanPolicy               Policy     `protobuf:"bytes,14,opt,name=plan_policy,json=planPolicy,proto3" json:"plan_policy"`
-	ProjectsLimit            uint64     `protobuf:"varint,15,opt,name=projects_limit,json=projectsLimit,proto3" json:"projects_limit"`
-	AllowedBuyers            []string   `protobuf:"bytes,16,rep,name=allowed_buyers,json=allowedBuyers,proto3" json:"allowed_buyers"`
+	Index                    string      `protobuf:"bytes,1,opt,name=index,proto3" json:"index"`
+	Block                    uint64      `protobuf:"varint,3,opt,name=block,proto3" json:"block"`
+	Price                    types.Coin  `protobuf:"bytes,4,opt,name=price,proto3" json:"price"`
+	AllowOveruse             bool        `protobuf:"varint,8,opt,name=allow_overuse,json=allowOveruse,proto3" json:"allow_overuse"`
+	OveruseRate              uint64      `protobuf:"varint,9,opt,name=overuse_rate,json=overuseRate,proto3" json:"overuse_rate"`
+	Description              string      `protobuf:"bytes,11,opt,name=description,proto3" json:"description"`
+	Type                     string      `protobuf:"bytes,12,opt,name=type,proto3" json:"type"`
+	AnnualDiscountPercentage uint64      `protobuf:"varint,13,opt,name=annual_discount_percentage,json=annualDiscountPercentage,proto3" json:"annual_discount_percentage"`
+	PlanPolicy               Policy      `protobuf:"bytes,14,opt,name=plan_policy,json=planPolicy,proto3" json:"plan_policy"`
+	ProjectsLimit            uint64      `protobuf:"varint,15,opt,name=projects_limit,json=projectsLimit,proto3" json:"projects_limit"`
+	AllowedBuyers            []string    `protobuf:"bytes,16,rep,name=allowed_buyers,json=allowedBuyers,proto3" json:"allowed_buyers"`
+	Creator                  string      `protobuf:"bytes,17,opt,name=creator,proto3" json:"creator"`
+	Deposit                  *types.Coin `protobuf:"bytes,18,opt,name=deposit,proto3" json:"deposit"`
+	Trial                    bool        `protobuf:"varint,19,opt,name=trial,proto3" json:"trial"`
+	TrialDuration            uint64      `protobuf:"varint,20,opt,name=trial_duration,json=trialDuration,proto3" json:"trial_duration"`
+	TrialCuLimit             uint64      `protobuf:"varint,21,opt,name=trial_cu_limit,json=trialCuLimit,proto3" json:"trial_cu_limit"`
 }
 
 func (m *Plan) Reset()         { *m = Plan{} }
@@ -198,6 +203,41 @@ func (m *Plan) GetAllowedBuyers() []string {
 	return nil
 }
 
+func (m *Plan) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Plan) GetDeposit() *types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *Plan) GetTrial() bool {
+	if m != nil {
+		return m.Trial
+	}
+	return false
+}
+
+func (m *Plan) GetTrialDuration() uint64 {
+	if m != nil {
+		return m.TrialDuration
+	}
+	return 0
+}
+
+func (m *Plan) GetTrialCuLimit() uint64 {
+	if m != nil {
+		return m.TrialCuLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("lavanet.lava.plans.Geolocation", Geolocation_name, Geolocation_value)
 	proto.RegisterType((*Plan)(nil), "lavanet.lava.plans.Plan")
@@ -206,45 +246,51 @@ func init() {
 func init() { proto.RegisterFile("lavanet/lava/plans/plan.proto", fileDescriptor_64c3707a3b09a2e5) }
 
 var fileDescriptor_64c3707a3b09a2e5 = []byte{
-	// 603 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0x6e, 0xda, 0xb4, 0x4b, 0x9d, 0x75, 0x33, 0x86, 0x43, 0xa8, 0x20, 0xa9, 0x90, 0x40, 0x15,
-	0x87, 0x44, 0x63, 0x12, 0x12, 0x17, 0x04, 0x1d, 0x63, 0x52, 0x35, 0x89, 0x2a, 0xd3, 0x84, 0x04,
-	0x48, 0x91, 0xeb, 0x5a, 0xc5, 0xe0, 0xc5, 0x51, 0xe2, 0x8e, 0xed, 0x15, 0x38, 0xf1, 0x18, 0x3c,
-	0xca, 0x8e, 0x3b, 0x72, 0x8a, 0x50, 0x77, 0xcb, 0x4b, 0x0c, 0xd9, 0xce, 0x50, 0x0b, 0x88, 0x8b,
-	0x3f, 0x7f, 0xbf, 0xef, 0xfb, 0xb5, 0xbf, 0x3f, 0x31, 0xb8, 0xcf, 0xf1, 0x29, 0x4e, 0xa9, 0x8c,
-	0x14, 0x46, 0x19, 0xc7, 0x69, 0xa1, 0xcf, 0x30, 0xcb, 0x85, 0x14, 0x08, 0xd5, 0x72, 0xa8, 0x30,
-	0xd4, 0x72, 0xff, 0xce, 0x5c, 0xcc, 0x85, 0x96, 0x23, 0x75, 0x33, 0xce, 0xbe, 0x4f, 0x44, 0x71,
-	0x22, 0x8a, 0x68, 0x8a, 0x0b, 0x1a, 0x9d, 0xee, 0x4c, 0xa9, 0xc4, 0x3b, 0x11, 0x11, 0xac, 0xfe,
-	0xa5, 0xfe, 0xa3, 0xb5, 0x3f, 0x2a, 0x32, 0x4a, 0x22, 0x9c, 0xb1, 0x84, 0x08, 0xce, 0x29, 0x91,
-	0x4c, 0xdc, 0xf8, 0x82, 0x7f, 0x15, 0x24, 0x38, 0x23, 0xe7, 0xc6, 0xf0, 0xe0, 0x6b, 0x1b, 0xd8,
-	0x13, 0x8e, 0x53, 0x14, 0x80, 0x36, 0x4b, 0x67, 0xf4, 0xcc, 0xb3, 0x06, 0xd6, 0xb0, 0x3b, 0xea,
-	0x56, 0x65, 0x60, 0x02, 0xb1, 0x01, 0x65, 0x98, 0x72, 0x41, 0x3e, 0x7b, 0xad, 0x81, 0x35, 0xb4,
-	0x8d, 0x41, 0x07, 0x62, 0x03, 0xe8, 0x39, 0x68, 0x67, 0x39, 0x23, 0xd4, 0xb3, 0x07, 0xd6, 0xd0,
-	0x7d, 0x72, 0x37, 0x34, 0x3d, 0x84, 0xaa, 0x87, 0xb0, 0xee, 0x21, 0xdc, 0x13, 0x2c, 0x1d, 0xf5,
-	0x2e, 0xca, 0xa0, 0xa1, 0xf2, 0xb5, 0x3f, 0x36, 0x80, 0x9e, 0x82, 0x1e, 0xe6, 0x5c, 0x7c, 0x49,
-	0xc4, 0x29, 0xcd, 0x17, 0x05, 0xf5, 0x9c, 0x81, 0x35, 0x74, 0x46, 0xb7, 0xaa, 0x32, 0x58, 0x17,
-	0xe2, 0x4d, 0x4d, 0xdf, 0x18, 0x86, 0x76, 0xc1, 0x66, 0x2d, 0x24, 0x39, 0x96, 0xd4, 0xeb, 0xea,
-	0xfa, 0x60, 0x55, 0x06, 0x6b, 0xf1, 0xd8, 0xbd, 0x49, 0xc7, 0x92, 0xa2, 0x1d, 0xe0, 0xce, 0x68,
-	0x41, 0x72, 0x96, 0xa9, 0x69, 0x79, 0xae, 0x6e, 0x7a, 0xbb, 0x2a, 0x83, 0xd5, 0x70, 0xbc, 0x4a,
-	0xd0, 0x3d, 0x60, 0xcb, 0xf3, 0x8c, 0x7a, 0x9b, 0xda, 0xeb, 0x54, 0x65, 0xa0, 0x79, 0xac, 0x4f,
-	0xf4, 0x01, 0xf4, 0x71, 0x9a, 0x2e, 0x30, 0x4f, 0x66, 0xac, 0x20, 0x62, 0x91, 0xca, 0x24, 0xa3,
-	0x39, 0xa1, 0xa9, 0xc4, 0x73, 0xea, 0xf5, 0x74, 0x4d, 0x7e, 0x55, 0x06, 0xff, 0x71, 0xc5, 0x9e,
-	0xd1, 0x5e, 0xd5, 0xd2, 0xe4, 0xb7, 0x82, 0x26, 0xc0, 0x55, 0xcb, 0x4b, 0xcc, 0xee, 0xbc, 0x2d,
-	0x3d, 0xe1, 0x7e, 0xf8, 0xf7, 0xf7, 0x14, 0x4e, 0xb4, 0x63, 0x74, 0xbb, 0x1e, 0xf1, 0x6a, 0x5a,
-	0x0c, 0x14, 0x31, 0x06, 0xf4, 0x0c, 0x6c, 0x65, 0xb9, 0xf8, 0x44, 0x89, 0x2c, 0x12, 0xce, 0x4e,
-	0x98, 0xf4, 0xb6, 0x75, 0x8d, 0xa8, 0x2a, 0x83, 0x3f, 0x94, 0xb8, 0x77, 0xc3, 0x0f, 0x15, 0x55,
-	0xa9, 0x7a, 0x01, 0x74, 0x96, 0x4c, 0x17, 0xe7, 0x34, 0x2f, 0x3c, 0x38, 0x68, 0x0d, 0xbb, 0x26,
-	0x75, 0x5d, 0x89, 0x7b, 0x35, 0x1f, 0x69, 0x3a, 0xb6, 0x1d, 0x00, 0xdd, 0xb1, 0xed, 0x34, 0x61,
-	0x6b, 0x6c, 0x3b, 0x6d, 0xd8, 0x19, 0xdb, 0x4e, 0x07, 0x6e, 0x8c, 0x6d, 0x67, 0x03, 0x3a, 0x8f,
-	0xdf, 0x03, 0xf7, 0x80, 0x0a, 0x2e, 0x08, 0xd6, 0x03, 0xdf, 0x00, 0xad, 0x83, 0xc3, 0x23, 0xd8,
-	0x50, 0x97, 0xe3, 0xa3, 0x3d, 0x68, 0xa1, 0x0e, 0x68, 0xee, 0x1f, 0xc3, 0xa6, 0x09, 0xec, 0x43,
-	0xdb, 0x5c, 0xde, 0x42, 0x47, 0x29, 0x2f, 0x5f, 0x43, 0xa8, 0xf1, 0x08, 0x0e, 0x34, 0x1e, 0xc3,
-	0x17, 0xc8, 0x01, 0xcd, 0x83, 0x43, 0x78, 0x7d, 0xdd, 0x1a, 0xed, 0x7d, 0x5f, 0xfa, 0xd6, 0xc5,
-	0xd2, 0xb7, 0x2e, 0x97, 0xbe, 0xf5, 0x73, 0xe9, 0x5b, 0xdf, 0xae, 0xfc, 0xc6, 0xe5, 0x95, 0xdf,
-	0xf8, 0x71, 0xe5, 0x37, 0xde, 0x3d, 0x9c, 0x33, 0xf9, 0x71, 0x31, 0x0d, 0x89, 0x38, 0x89, 0xd6,
-	0xde, 0xcc, 0x59, 0xfd, 0x6a, 0xd4, 0x92, 0x8b, 0x69, 0x47, 0xbf, 0x9a, 0xdd, 0x5f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xfc, 0x16, 0x46, 0xe9, 0xe9, 0x03, 0x00, 0x00,
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x4f, 0x6b, 0xdb, 0x48,
+	0x14, 0xb7, 0x6c, 0xc5, 0x96, 0x47, 0x76, 0x32, 0x99, 0x64, 0x41, 0x6b, 0x76, 0x25, 0xb3, 0x90,
+	0xc5, 0xec, 0x41, 0x22, 0x1b, 0x58, 0x76, 0x2f, 0x4b, 0xd6, 0x4e, 0x36, 0x60, 0x02, 0x35, 0x13,
+	0x42, 0xa1, 0x2d, 0x88, 0xf1, 0x78, 0x70, 0xd5, 0x2a, 0x1a, 0xa1, 0x3f, 0x69, 0xf2, 0x11, 0x7a,
+	0xeb, 0xc7, 0xe8, 0x47, 0xc9, 0x31, 0xc7, 0x9e, 0x44, 0x71, 0x6e, 0xfa, 0x12, 0x29, 0x33, 0x23,
+	0xb5, 0x71, 0x5a, 0xda, 0x8b, 0xde, 0xfb, 0xbd, 0xdf, 0xef, 0x69, 0xde, 0x7b, 0xf3, 0x18, 0xf0,
+	0x6b, 0x48, 0x2e, 0x49, 0xc4, 0x32, 0x4f, 0x58, 0x2f, 0x0e, 0x49, 0x94, 0xca, 0xaf, 0x1b, 0x27,
+	0x3c, 0xe3, 0x08, 0x55, 0xb4, 0x2b, 0xac, 0x2b, 0xe9, 0xc1, 0xee, 0x92, 0x2f, 0xb9, 0xa4, 0x3d,
+	0xe1, 0x29, 0xe5, 0xc0, 0xa6, 0x3c, 0xbd, 0xe0, 0xa9, 0x37, 0x27, 0x29, 0xf3, 0x2e, 0xf7, 0xe7,
+	0x2c, 0x23, 0xfb, 0x1e, 0xe5, 0x41, 0xf5, 0xa7, 0xc1, 0xef, 0x6b, 0x07, 0xa5, 0x31, 0xa3, 0x1e,
+	0x89, 0x03, 0x9f, 0xf2, 0x30, 0x64, 0x34, 0x0b, 0x78, 0xad, 0x73, 0xbe, 0x55, 0x10, 0x0f, 0x03,
+	0x7a, 0xad, 0x04, 0xbf, 0xbd, 0xed, 0x00, 0x7d, 0x16, 0x92, 0x08, 0x39, 0x60, 0x23, 0x88, 0x16,
+	0xec, 0xca, 0xd2, 0x86, 0xda, 0xa8, 0x3b, 0xee, 0x96, 0x85, 0xa3, 0x02, 0x58, 0x19, 0x21, 0x98,
+	0x87, 0x9c, 0xbe, 0xb6, 0x5a, 0x43, 0x6d, 0xa4, 0x2b, 0x81, 0x0c, 0x60, 0x65, 0xd0, 0xbf, 0x60,
+	0x23, 0x4e, 0x02, 0xca, 0x2c, 0x7d, 0xa8, 0x8d, 0xcc, 0x3f, 0x7f, 0x76, 0x55, 0x0f, 0xae, 0xe8,
+	0xc1, 0xad, 0x7a, 0x70, 0x27, 0x3c, 0x88, 0xc6, 0xfd, 0x9b, 0xc2, 0x69, 0x88, 0x7c, 0xa9, 0xc7,
+	0xca, 0xa0, 0xbf, 0x40, 0x9f, 0x84, 0x21, 0x7f, 0xe3, 0xf3, 0x4b, 0x96, 0xe4, 0x29, 0xb3, 0x8c,
+	0xa1, 0x36, 0x32, 0xc6, 0xdb, 0x65, 0xe1, 0xac, 0x13, 0xb8, 0x27, 0xe1, 0x13, 0x85, 0xd0, 0x01,
+	0xe8, 0x55, 0x84, 0x9f, 0x90, 0x8c, 0x59, 0x5d, 0x59, 0x1f, 0x2c, 0x0b, 0x67, 0x2d, 0x8e, 0xcd,
+	0x3a, 0x9d, 0x64, 0x0c, 0xed, 0x03, 0x73, 0xc1, 0x52, 0x9a, 0x04, 0xb1, 0x98, 0x96, 0x65, 0xca,
+	0xa6, 0xb7, 0xca, 0xc2, 0x79, 0x18, 0xc6, 0x0f, 0x01, 0xfa, 0x05, 0xe8, 0xd9, 0x75, 0xcc, 0xac,
+	0x9e, 0xd4, 0x1a, 0x65, 0xe1, 0x48, 0x8c, 0xe5, 0x17, 0xbd, 0x00, 0x03, 0x12, 0x45, 0x39, 0x09,
+	0xfd, 0x45, 0x90, 0x52, 0x9e, 0x47, 0x99, 0x1f, 0xb3, 0x84, 0xb2, 0x28, 0x23, 0x4b, 0x66, 0xf5,
+	0x65, 0x4d, 0x76, 0x59, 0x38, 0xdf, 0x51, 0x61, 0x4b, 0x71, 0x47, 0x15, 0x35, 0xfb, 0xcc, 0xa0,
+	0x19, 0x30, 0xc5, 0xe5, 0xf9, 0xea, 0xee, 0xac, 0x4d, 0x39, 0xe1, 0x81, 0xfb, 0xf5, 0x3e, 0xb9,
+	0x33, 0xa9, 0x18, 0xef, 0x54, 0x23, 0x7e, 0x98, 0x86, 0x81, 0x00, 0x4a, 0x80, 0xfe, 0x01, 0x9b,
+	0x71, 0xc2, 0x5f, 0x31, 0x9a, 0xa5, 0x7e, 0x18, 0x5c, 0x04, 0x99, 0xb5, 0x25, 0x6b, 0x44, 0x65,
+	0xe1, 0x3c, 0x62, 0x70, 0xbf, 0xc6, 0xa7, 0x02, 0x8a, 0x54, 0x79, 0x01, 0x6c, 0xe1, 0xcf, 0xf3,
+	0x6b, 0x96, 0xa4, 0x16, 0x1c, 0xb6, 0x46, 0x5d, 0x95, 0xba, 0xce, 0xe0, 0x7e, 0x85, 0xc7, 0x12,
+	0xa2, 0x3d, 0xd0, 0xa1, 0x09, 0x23, 0x19, 0x4f, 0xac, 0x6d, 0x39, 0x46, 0xb3, 0x2c, 0x9c, 0x3a,
+	0x84, 0x6b, 0x07, 0x1d, 0x82, 0xce, 0x82, 0xc5, 0x3c, 0x0d, 0x32, 0x0b, 0xfd, 0x68, 0x99, 0xe4,
+	0x1f, 0x2a, 0x35, 0xae, 0x1d, 0xb1, 0xad, 0x59, 0x12, 0x90, 0xd0, 0xda, 0x91, 0x4b, 0x24, 0xb7,
+	0x55, 0x06, 0xb0, 0x32, 0xa2, 0x09, 0xe9, 0xf8, 0x8b, 0x3c, 0x21, 0x72, 0x07, 0x76, 0xbf, 0xf4,
+	0xbf, 0xce, 0xe0, 0xbe, 0xc4, 0x47, 0x15, 0x44, 0x7f, 0xd7, 0xa9, 0x34, 0xaf, 0x46, 0xf7, 0xd3,
+	0xe3, 0xd4, 0x9a, 0xc1, 0x3d, 0x89, 0x27, 0xb9, 0x9c, 0xdc, 0x54, 0x37, 0x00, 0x34, 0xa7, 0xba,
+	0xd1, 0x84, 0xad, 0xa9, 0x6e, 0x6c, 0xc0, 0xf6, 0x54, 0x37, 0xda, 0xb0, 0x33, 0xd5, 0x8d, 0x0e,
+	0x34, 0xfe, 0x78, 0x0e, 0xcc, 0x13, 0xc6, 0x43, 0x4e, 0xd5, 0x31, 0x1d, 0xd0, 0x3a, 0x39, 0x3d,
+	0x83, 0x0d, 0xe1, 0x9c, 0x9f, 0x4d, 0xa0, 0x86, 0xda, 0xa0, 0x79, 0x7c, 0x0e, 0x9b, 0x2a, 0x70,
+	0x0c, 0x75, 0xe5, 0x3c, 0x85, 0x86, 0x60, 0xfe, 0xfb, 0x1f, 0x42, 0x69, 0xcf, 0xe0, 0x50, 0xda,
+	0x73, 0x78, 0x88, 0x0c, 0xd0, 0x3c, 0x39, 0x85, 0xf7, 0xf7, 0xad, 0xf1, 0xe4, 0xfd, 0xca, 0xd6,
+	0x6e, 0x56, 0xb6, 0x76, 0xbb, 0xb2, 0xb5, 0x8f, 0x2b, 0x5b, 0x7b, 0x77, 0x67, 0x37, 0x6e, 0xef,
+	0xec, 0xc6, 0x87, 0x3b, 0xbb, 0xf1, 0x6c, 0x6f, 0x19, 0x64, 0x2f, 0xf3, 0xb9, 0x4b, 0xf9, 0x85,
+	0xb7, 0xf6, 0x64, 0x5c, 0x55, 0x8f, 0x86, 0xd8, 0xf1, 0x74, 0xde, 0x96, 0x8f, 0xc6, 0xc1, 0xa7,
+	0x01, 0x00, 0x4d, 0xfb, 0x21, 0x8c, 0xe8, 0x04, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -304,6 +350,21 @@ func (this *Plan) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if !this.Deposit.Equal(that1.Deposit) {
+		return false
+	}
+	if this.Trial != that1.Trial {
+		return false
+	}
+	if this.TrialDuration != that1.TrialDuration {
+		return false
+	}
+	if this.TrialCuLimit != that1.TrialCuLimit {
+		return false
+	}
 	return true
 }
 func (m *Plan) Marshal() (dAtA []byte, err error) {
@@ -326,6 +387,55 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TrialCuLimit != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.TrialCuLimit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.TrialDuration != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.TrialDuration))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.Trial {
+		i--
+		if m.Trial {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.Deposit != nil {
+		{
+			size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if len(m.AllowedBuyers) > 0 {
 		for iNdEx := len(m.AllowedBuyers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedBuyers[iNdEx])
@@ -465,6 +575,23 @@ func (m *Plan) Size() (n int) {
 			n += 2 + l + sovPlan(uint64(l))
 		}
 	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	if m.Trial {
+		n += 3
+	}
+	if m.TrialDuration != 0 {
+		n += 2 + sovPlan(uint64(m.TrialDuration))
+	}
+	if m.TrialCuLimit != 0 {
+		n += 2 + sovPlan(uint64(m.TrialCuLimit))
+	}
 	return n
 }
 
@@ -793,6 +920,132 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedBuyers = append(m.AllowedBuyers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deposit == nil {
+				m.Deposit = &types.Coin{}
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trial", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Trial = bool(v != 0)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrialDuration", wireType)
+			}
+			m.TrialDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrialDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrialCuLimit", wireType)
+			}
+			m.TrialCuLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrialCuLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -4,9 +4,14 @@ const (
 	MAX_LEN_PLAN_NAME        = 50
 	MAX_LEN_PLAN_DESCRIPTION = 500
 	MAX_LEN_PLAN_TYPE        = 20
+	MAX_TRIAL_DURATION       = 3 // months
 )
 
 const (
 	PlanAddEventName = "add_new_plan_to_storage"
 	PlanDelEventName = "del_plan_from_storage"
+
+	PlanCreateEventName    = "create_plan"
+	PlanUpdateEventName    = "update_plan"
+	PlanDeprecateEventName = "deprecate_plan"
 )
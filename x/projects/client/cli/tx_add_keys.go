@@ -5,7 +5,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/lavanet/lava/utils/decoder"
+	planstypes "github.com/lavanet/lava/x/plans/types"
 	"github.com/lavanet/lava/x/projects/types"
+	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/cobra"
 )
 
@@ -15,6 +17,7 @@ func CmdAddKeys() *cobra.Command {
 		Short: "Add developer/admin keys to an existing project",
 		Long: `The add-keys command allows the project admin to add new project keys (admin/developer) to the project.
 		To add the keys you can optionally provide a YAML file of the new project keys (see example in cookbook/project/example_project_keys.yml).
+		Developer keys in the YAML file can have a policy that limits them on top of the project's policies.
 		Another way to add keys is with the --admin-key and --developer-key flags.`,
 		Example: `required flags: --from <admin-key> (the project's subscription address is also considered admin)
 				  
@@ -27,7 +30,10 @@ func CmdAddKeys() *cobra.Command {
 
 			if len(args) > 1 {
 				projectKeysFilePath := args[1]
-				err = decoder.DecodeFile(projectKeysFilePath, "Project-Keys", &projectKeys, nil, nil, nil)
+				enumHooks := []mapstructure.DecodeHookFunc{
+					planstypes.PolicyEnumDecodeHookFunc,
+				}
+				err = decoder.DecodeFile(projectKeysFilePath, "Project-Keys", &projectKeys, enumHooks, nil, nil)
 				if err != nil {
 					return err
 				}
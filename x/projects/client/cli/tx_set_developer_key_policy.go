@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	planstypes "github.com/lavanet/lava/x/plans/types"
+	"github.com/lavanet/lava/x/projects/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetDeveloperKeyPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-developer-key-policy project-index developer-key [policy-file-path]",
+		Short: "set policy to a developer key of a project",
+		Long:  `The set-developer-key-policy command allows a project admin to set a policy to one of the project's developer keys. The key policy is merged with the project's policies, its total CU limit applies to the CU used by the key. The policy file is a YAML file (see cookbook/projects/example_policy.yml for reference). The new policy will be applied from the next epoch. To define a geolocation in the policy file, use the available geolocations: ` + planstypes.PrintGeolocations(),
+		Example: `required flags: --from <creator-address>
+		lavad tx project set-developer-key-policy [project-index] [developer-key] [policy-file-path] --from <creator_address>
+		lavad tx project set-developer-key-policy [project-index] [developer-key] --delete-policy --from <creator_address>`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			projectId := args[0]
+			developerKey := args[1]
+
+			// check if the command includes --delete-policy
+			deletePolicyFlag := cmd.Flags().Lookup(DeletePolicyFlagName)
+			if deletePolicyFlag == nil {
+				return fmt.Errorf("%s flag wasn't found", DeletePolicyFlagName)
+			}
+			deletePolicy := deletePolicyFlag.Changed
+
+			var policy *planstypes.Policy
+			if !deletePolicy {
+				if len(args) < 3 {
+					return fmt.Errorf("not enough arguments")
+				}
+				policy, err = planstypes.ParsePolicyFromYamlPath(args[2])
+				if err != nil {
+					return err
+				}
+
+				err = verifyChainPoliciesAreCorrectlySet(clientCtx, policy)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSetDeveloperKeyPolicy(
+				clientCtx.GetFromAddress().String(),
+				projectId,
+				developerKey,
+				policy,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.MarkFlagRequired(flags.FlagFrom)
+	cmd.Flags().Bool(DeletePolicyFlagName, false, "deletes the policy")
+
+	return cmd
+}
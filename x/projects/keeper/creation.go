@@ -215,7 +215,7 @@ func (k Keeper) registerKey(ctx sdk.Context, key types.ProjectKey, project *type
 			}
 		}
 
-		project.AppendKey(types.ProjectDeveloperKey(key.Key))
+		project.AppendKey(types.ProjectDeveloperKey(key.Key).WithPolicy(key.Policy))
 	}
 
 	return nil
@@ -298,6 +298,7 @@ func (k Keeper) snapshotProject(ctx sdk.Context, projectID string, block uint64)
 	}
 
 	project.UsedCu = 0
+	project.ResetKeysUsedCu()
 	project.Snapshot += 1
 
 	err := k.projectsFS.AppendEntry(ctx, project.Index, block, &project)
@@ -27,6 +27,18 @@ func (k msgServer) AddKeys(goCtx context.Context, msg *types.MsgAddKeys) (*types
 				utils.Attribute{Key: "keyType", Value: projectKey.Kinds},
 			)
 		}
+
+		if projectKey.Policy != nil {
+			if !projectKey.IsType(types.ProjectKey_DEVELOPER) {
+				return nil, utils.LavaFormatWarning("invalid project key policy",
+					fmt.Errorf("only developer keys can have a policy"),
+					utils.Attribute{Key: "key", Value: projectKey.Key},
+				)
+			}
+			if err := projectKey.Policy.ValidateBasicPolicy(false); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	err := k.AddKeysToProject(ctx, msg.Project, msg.Creator, msg.ProjectKeys)
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/projects/types"
+)
+
+func (k msgServer) SetDeveloperKeyPolicy(goCtx context.Context, msg *types.MsgSetDeveloperKeyPolicy) (*types.MsgSetDeveloperKeyPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, utils.LavaFormatError("Invalid creator address", err,
+			utils.LogAttr("creator", msg.Creator),
+		)
+	}
+
+	policy := msg.GetPolicy()
+
+	if policy != nil {
+		err := policy.ValidateBasicPolicy(false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := k.Keeper.SetDeveloperKeyPolicy(ctx, msg.GetProject(), msg.GetCreator(), msg.GetKey(), policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDeveloperKeyPolicyResponse{}, nil
+}
@@ -258,9 +258,10 @@ func (k Keeper) DelKeysFromProject(ctx sdk.Context, projectID, adminKey string,
 	return nil
 }
 
-// ChargeComputUnitsToProject charges use of CU to the project at a given block.
-// Propgage the charge to subsequent versions (blocks) within the same snapshot.
-func (k Keeper) ChargeComputeUnitsToProject(ctx sdk.Context, project types.Project, blockHeight, cu uint64) (err error) {
+// ChargeComputUnitsToProject charges use of CU to the project and to the developer key
+// that used it at a given block. Propgage the charge to subsequent versions (blocks)
+// within the same snapshot.
+func (k Keeper) ChargeComputeUnitsToProject(ctx sdk.Context, project types.Project, developerKey string, blockHeight, cu uint64) (err error) {
 	blocks := k.projectsFS.GetEntryVersionsRange(ctx, project.Index, blockHeight, k.epochstorageKeeper.BlocksToSaveRaw(ctx))
 
 	for _, block := range blocks {
@@ -270,6 +271,7 @@ func (k Keeper) ChargeComputeUnitsToProject(ctx sdk.Context, project types.Proje
 			break
 		}
 		proj.UsedCu += cu
+		proj.ChargeDeveloperKeyCu(developerKey, cu)
 		k.projectsFS.ModifyEntry(ctx, project.Index, block, &proj)
 	}
 
@@ -356,6 +358,66 @@ func (k Keeper) SetProjectPolicy(ctx sdk.Context, projectIDs []string, policy *p
 	return nil
 }
 
+// SetDeveloperKeyPolicy applies a new policy to a developer key of a project. The change will
+// take effect in the beginning of the next epoch. The adminKey must be valid (and specifically,
+// not already marked for deletion by next epoch).
+func (k Keeper) SetDeveloperKeyPolicy(ctx sdk.Context, projectID, adminKey, developerKey string, policy *planstypes.Policy) error {
+	ctxBlock := uint64(ctx.BlockHeight())
+
+	nextEpoch, err := k.epochstorageKeeper.GetNextEpoch(ctx, ctxBlock)
+	if err != nil {
+		return utils.LavaFormatError("critical: SetDeveloperKeyPolicy failed to get NextEpoch", err,
+			utils.Attribute{Key: "block", Value: ctxBlock},
+		)
+	}
+
+	projectNextEpoch, _, err := k.getProjectForBlock(ctx, projectID, nextEpoch)
+	if err != nil {
+		return utils.LavaFormatWarning("failed to set developer key policy (peek)", err,
+			utils.Attribute{Key: "project", Value: projectID},
+			utils.Attribute{Key: "block", Value: ctxBlock},
+		)
+	}
+
+	// all checks for admin key are done respective of next epoch (because any
+	// deletion earlier in this epoch thereof should be effecitive immediately
+	// but would be marked there).
+
+	if !projectNextEpoch.IsAdminKey(adminKey) {
+		return utils.LavaFormatWarning("failed to set developer key policy",
+			fmt.Errorf("requesting key must be admin key"),
+			utils.Attribute{Key: "project", Value: projectID},
+			utils.Attribute{Key: "key", Value: adminKey},
+		)
+	}
+
+	if !projectNextEpoch.SetDeveloperKeyPolicy(developerKey, policy) {
+		return utils.LavaFormatWarning("failed to set developer key policy",
+			fmt.Errorf("key is not a developer key of the project"),
+			utils.Attribute{Key: "project", Value: projectID},
+			utils.Attribute{Key: "developer_key", Value: developerKey},
+		)
+	}
+
+	err = k.projectsFS.AppendEntry(ctx, projectID, nextEpoch, &projectNextEpoch)
+	if err != nil {
+		return utils.LavaFormatError("critical: failed to set developer key policy",
+			fmt.Errorf("append entry: %w", err),
+			utils.Attribute{Key: "project", Value: projectID},
+			utils.Attribute{Key: "block", Value: ctxBlock},
+		)
+	}
+
+	details := map[string]string{
+		"creator":       adminKey,
+		"project":       projectID,
+		"developer_key": developerKey,
+		"policy":        policy.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.SetDeveloperKeyPolicyEventName, details, "set developer key policy successfully")
+	return nil
+}
+
 // GetAllProjectsForSubscription returns a list of all projectID for a subscription
 func (k Keeper) GetAllProjectsForSubscription(ctx sdk.Context, subscription string) []string {
 	return k.projectsFS.GetAllEntryIndicesWithPrefix(ctx, subscription)
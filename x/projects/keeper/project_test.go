@@ -604,7 +604,7 @@ func TestChargeComputeUnits(t *testing.T) {
 	// try to charge CUs: should update oldest and second-oldest entries, but not the latest
 	// (because the latter is in a new snapshot)
 
-	err = ts.Keepers.Projects.ChargeComputeUnitsToProject(ts.Ctx, project, block1, 1000)
+	err = ts.Keepers.Projects.ChargeComputeUnitsToProject(ts.Ctx, project, "", block1, 1000)
 	require.NoError(t, err)
 
 	proj, err := ts.GetProjectForBlock(project.Index, block1)
@@ -617,7 +617,7 @@ func TestChargeComputeUnits(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, uint64(0), proj.UsedCu)
 
-	err = ts.Keepers.Projects.ChargeComputeUnitsToProject(ts.Ctx, project, block2, 1000)
+	err = ts.Keepers.Projects.ChargeComputeUnitsToProject(ts.Ctx, project, "", block2, 1000)
 	require.NoError(t, err)
 
 	proj, err = ts.GetProjectForBlock(project.Index, block1)
@@ -631,6 +631,57 @@ func TestChargeComputeUnits(t *testing.T) {
 	require.Equal(t, uint64(0), proj.UsedCu)
 }
 
+func TestChargeComputeUnitsDeveloperKey(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(0, 0, 1) // 0 sub, 0 adm, 1 dev
+	ts.setupProjectData()
+
+	projectData := ts.ProjectData("pd1")
+	plan := ts.Plan("free")
+
+	_, sub1Addr := ts.Account("pd_adm_1")
+	_, dev1Addr := ts.Account("dev1")
+
+	err := ts.Keepers.Projects.CreateProject(ts.Ctx, sub1Addr, projectData, plan)
+	require.NoError(t, err)
+
+	projectID := types.ProjectIndex(sub1Addr, projectData.Name)
+	keyPolicy := plan.PlanPolicy
+	keyPolicy.EpochCuLimit = 1000
+	keyPolicy.TotalCuLimit = 1500
+	err = ts.TxProjectAddKeys(projectID, sub1Addr, types.ProjectDeveloperKey(dev1Addr).WithPolicy(&keyPolicy))
+	require.NoError(t, err)
+
+	ts.AdvanceEpoch()
+	block1 := ts.BlockHeight()
+
+	project, err := ts.GetProjectForBlock(projectID, block1)
+	require.NoError(t, err)
+	require.Equal(t, keyPolicy, *project.GetDeveloperKeyPolicy(dev1Addr))
+
+	// charge CU with the developer key: both the project and the key are charged
+	err = ts.Keepers.Projects.ChargeComputeUnitsToProject(ts.Ctx, project, dev1Addr, block1, 1000)
+	require.NoError(t, err)
+	err = ts.Keepers.Projects.ChargeComputeUnitsToProject(ts.Ctx, project, sub1Addr, block1, 500)
+	require.NoError(t, err)
+
+	proj, err := ts.GetProjectForBlock(projectID, block1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1500), proj.UsedCu)
+	require.Equal(t, uint64(1000), proj.GetKey(dev1Addr).UsedCu)
+
+	// a snapshot (new subscription month) resets the keys' used CU but keeps their policy
+	ts.AdvanceEpoch()
+	block2 := ts.BlockHeight()
+	ts.Keepers.Projects.SnapshotSubscriptionProjects(ts.Ctx, sub1Addr, block2)
+
+	proj, err = ts.GetProjectForBlock(projectID, block2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), proj.UsedCu)
+	require.Equal(t, uint64(0), proj.GetKey(dev1Addr).UsedCu)
+	require.NotNil(t, proj.GetDeveloperKeyPolicy(dev1Addr))
+}
+
 func TestAddAfterDelKeys(t *testing.T) {
 	ts := newTester(t)
 	ts.SetupAccounts(1, 0, 2) // 1 sub, 0 adm, 2 dev
@@ -50,6 +50,15 @@ func (msg *MsgAddKeys) ValidateBasic() error {
 		if err != nil {
 			return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid project key address (%s)", err)
 		}
+
+		if key.Policy != nil {
+			if !key.IsType(ProjectKey_DEVELOPER) {
+				return sdkerrors.Wrapf(legacyerrors.ErrInvalidRequest, "policy of project key %s that is not a developer key", key.Key)
+			}
+			if err := key.Policy.ValidateBasicPolicy(false); err != nil {
+				return sdkerrors.Wrapf(err, "invalid policy of project key %s", key.Key)
+			}
+		}
 	}
 
 	return nil
@@ -5,6 +5,7 @@ import (
 
 	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/lavanet/lava/testutil/sample"
+	planstypes "github.com/lavanet/lava/x/plans/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -30,6 +31,25 @@ func TestMsgAddKeys_ValidateBasic(t *testing.T) {
 			},
 			err: legacyerrors.ErrInvalidAddress,
 		},
+		{
+			name: "policy of an admin key",
+			msg: MsgAddKeys{
+				Creator: sample.AccAddress(),
+				ProjectKeys: []ProjectKey{
+					ProjectAdminKey(sample.AccAddress()).WithPolicy(&planstypes.Policy{TotalCuLimit: 1000, MaxProvidersToPair: 3, GeolocationProfile: 1}),
+				},
+			},
+			err: legacyerrors.ErrInvalidRequest,
+		},
+		{
+			name: "developer key with policy",
+			msg: MsgAddKeys{
+				Creator: sample.AccAddress(),
+				ProjectKeys: []ProjectKey{
+					ProjectDeveloperKey(sample.AccAddress()).WithPolicy(&planstypes.Policy{TotalCuLimit: 1000, MaxProvidersToPair: 3, GeolocationProfile: 1}),
+				},
+			},
+		},
 		{
 			name: "valid address",
 			msg: MsgAddKeys{
@@ -0,0 +1,62 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	planstypes "github.com/lavanet/lava/x/plans/types"
+)
+
+const TypeMsgSetDeveloperKeyPolicy = "set_developer_key_policy"
+
+var _ sdk.Msg = &MsgSetDeveloperKeyPolicy{}
+
+func NewMsgSetDeveloperKeyPolicy(creator, project, key string, policy *planstypes.Policy) *MsgSetDeveloperKeyPolicy {
+	return &MsgSetDeveloperKeyPolicy{
+		Creator: creator,
+		Project: project,
+		Key:     key,
+		Policy:  policy,
+	}
+}
+
+func (msg *MsgSetDeveloperKeyPolicy) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetDeveloperKeyPolicy) Type() string {
+	return TypeMsgSetDeveloperKeyPolicy
+}
+
+func (msg *MsgSetDeveloperKeyPolicy) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetDeveloperKeyPolicy) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetDeveloperKeyPolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Key)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid developer key address (%s)", err)
+	}
+
+	if msg.Policy != nil {
+		if err := msg.Policy.ValidateBasicPolicy(false); err != nil {
+			return sdkerrors.Wrapf(err, "invalid policy")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"testing"
+
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	planstypes "github.com/lavanet/lava/x/plans/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetDeveloperKeyPolicy_ValidateBasic(t *testing.T) {
+	policy := &planstypes.Policy{
+		EpochCuLimit:       100,
+		TotalCuLimit:       1000,
+		MaxProvidersToPair: 3,
+		GeolocationProfile: 1,
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgSetDeveloperKeyPolicy
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetDeveloperKeyPolicy{
+				Creator: "invalid_address",
+				Key:     sample.AccAddress(),
+				Policy:  policy,
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid key",
+			msg: MsgSetDeveloperKeyPolicy{
+				Creator: sample.AccAddress(),
+				Key:     "invalid_address",
+				Policy:  policy,
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid policy",
+			msg: MsgSetDeveloperKeyPolicy{
+				Creator: sample.AccAddress(),
+				Key:     sample.AccAddress(),
+				Policy:  &planstypes.Policy{EpochCuLimit: 100, TotalCuLimit: 10, MaxProvidersToPair: 3, GeolocationProfile: 1},
+			},
+			err: planstypes.ErrInvalidPolicyCuFields,
+		}, {
+			name: "valid",
+			msg: MsgSetDeveloperKeyPolicy{
+				Creator: sample.AccAddress(),
+				Key:     sample.AccAddress(),
+				Policy:  policy,
+			},
+		}, {
+			name: "delete policy",
+			msg: MsgSetDeveloperKeyPolicy{
+				Creator: sample.AccAddress(),
+				Key:     sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	commontypes "github.com/lavanet/lava/common/types"
+	planstypes "github.com/lavanet/lava/x/plans/types"
 )
 
 const (
@@ -61,6 +62,12 @@ func ProjectDeveloperKey(key string) ProjectKey {
 	return NewProjectKey(key).AddType(ProjectKey_DEVELOPER)
 }
 
+// WithPolicy sets the policy of a developer key
+func (projectKey ProjectKey) WithPolicy(policy *planstypes.Policy) ProjectKey {
+	projectKey.Policy = policy
+	return projectKey
+}
+
 func (projectKey ProjectKey) IsType(kind ProjectKey_Type) bool {
 	return projectKey.Kinds&uint32(kind) != 0x0
 }
@@ -85,6 +92,9 @@ func (project *Project) AppendKey(key ProjectKey) bool {
 	for i, projectKey := range project.ProjectKeys {
 		if projectKey.Key == key.Key {
 			project.ProjectKeys[i].Kinds |= key.Kinds
+			if key.Policy != nil {
+				project.ProjectKeys[i].Policy = key.Policy
+			}
 			return true
 		}
 	}
@@ -97,6 +107,11 @@ func (project *Project) DeleteKey(key ProjectKey) bool {
 	for i, projectKey := range project.ProjectKeys {
 		if projectKey.Key == key.Key {
 			project.ProjectKeys[i].Kinds &= ^key.Kinds
+			if !project.ProjectKeys[i].IsType(ProjectKey_DEVELOPER) {
+				// the policy and usage only apply to developer keys
+				project.ProjectKeys[i].Policy = nil
+				project.ProjectKeys[i].UsedCu = 0
+			}
 			if project.ProjectKeys[i].Kinds == uint32(ProjectKey_NONE) {
 				if i < length-1 {
 					project.ProjectKeys[i] = project.ProjectKeys[length-1]
@@ -109,6 +124,43 @@ func (project *Project) DeleteKey(key ProjectKey) bool {
 	return false
 }
 
+// GetDeveloperKeyPolicy returns the policy of a developer key, nil if the key has no policy
+func (project *Project) GetDeveloperKeyPolicy(key string) *planstypes.Policy {
+	projectKey := project.GetKey(key)
+	if !projectKey.IsType(ProjectKey_DEVELOPER) {
+		return nil
+	}
+	return projectKey.Policy
+}
+
+// SetDeveloperKeyPolicy sets the policy of a developer key, returns false if the key is not a developer key of the project
+func (project *Project) SetDeveloperKeyPolicy(key string, policy *planstypes.Policy) bool {
+	for i, projectKey := range project.ProjectKeys {
+		if projectKey.Key == key && projectKey.IsType(ProjectKey_DEVELOPER) {
+			project.ProjectKeys[i].Policy = policy
+			return true
+		}
+	}
+	return false
+}
+
+// ChargeDeveloperKeyCu adds CU to the usage of a developer key
+func (project *Project) ChargeDeveloperKeyCu(key string, cu uint64) {
+	for i, projectKey := range project.ProjectKeys {
+		if projectKey.Key == key {
+			project.ProjectKeys[i].UsedCu += cu
+			return
+		}
+	}
+}
+
+// ResetKeysUsedCu resets the CU usage of all the project keys
+func (project *Project) ResetKeysUsedCu() {
+	for i := range project.ProjectKeys {
+		project.ProjectKeys[i].UsedCu = 0
+	}
+}
+
 func (project *Project) IsAdminKey(key string) bool {
 	return project.Subscription == key || project.GetKey(key).IsType(ProjectKey_ADMIN)
 }
@@ -153,8 +153,10 @@ func (m *Project) GetSnapshot() uint64 {
 }
 
 type ProjectKey struct {
-	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
-	Kinds uint32 `protobuf:"varint,4,opt,name=kinds,proto3" json:"kinds"`
+	Key    string        `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
+	Kinds  uint32        `protobuf:"varint,4,opt,name=kinds,proto3" json:"kinds"`
+	Policy *types.Policy `protobuf:"bytes,5,opt,name=policy,proto3" json:"policy"`
+	UsedCu uint64        `protobuf:"varint,6,opt,name=used_cu,json=usedCu,proto3" json:"used_cu,omitempty"`
 }
 
 func (m *ProjectKey) Reset()         { *m = ProjectKey{} }
@@ -204,6 +206,20 @@ func (m *ProjectKey) GetKinds() uint32 {
 	return 0
 }
 
+func (m *ProjectKey) GetPolicy() *types.Policy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+func (m *ProjectKey) GetUsedCu() uint64 {
+	if m != nil {
+		return m.UsedCu
+	}
+	return 0
+}
+
 type ProtoDeveloperData struct {
 	ProjectID string `protobuf:"bytes,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
 }
@@ -330,41 +346,42 @@ func init() {
 }
 
 var fileDescriptor_9027839604ae2915 = []byte{
-	// 543 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x8f, 0xd2, 0x40,
-	0x14, 0x67, 0x68, 0x81, 0xf6, 0xc1, 0x26, 0xcd, 0x88, 0xd9, 0x4a, 0x4c, 0x8b, 0x78, 0x69, 0x3c,
-	0x94, 0x04, 0x2f, 0x5e, 0x45, 0x30, 0x61, 0x55, 0x20, 0x8d, 0xf1, 0xb0, 0x17, 0x52, 0xe8, 0x84,
-	0xad, 0x40, 0xdb, 0x30, 0x65, 0xb3, 0xbd, 0xf9, 0x11, 0xfc, 0x18, 0x26, 0x7e, 0x09, 0x8f, 0x7b,
-	0xdc, 0xa3, 0x27, 0x62, 0xe0, 0xc6, 0xa7, 0x30, 0xd3, 0x19, 0x96, 0xd6, 0x6c, 0xb2, 0x5e, 0x3a,
-	0xf3, 0xde, 0xfc, 0xde, 0xbf, 0xfe, 0x7e, 0x0f, 0x5e, 0x2e, 0xdd, 0x6b, 0x37, 0x20, 0x71, 0x9b,
-	0x9d, 0xed, 0x68, 0x1d, 0x7e, 0x25, 0xb3, 0x98, 0x1e, 0x2f, 0x76, 0xb4, 0x0e, 0xe3, 0x10, 0x3f,
-	0x15, 0x20, 0x9b, 0x9d, 0xf6, 0x11, 0xd4, 0xa8, 0xcf, 0xc3, 0x79, 0x98, 0x22, 0xda, 0xec, 0xc6,
-	0xc1, 0x0d, 0x33, 0x9f, 0x71, 0xe9, 0x06, 0xb4, 0x1d, 0x85, 0x4b, 0x7f, 0x96, 0x70, 0x40, 0xeb,
-	0xa7, 0x04, 0x95, 0x31, 0xcf, 0x81, 0xeb, 0x50, 0xf2, 0x03, 0x8f, 0xdc, 0xe8, 0xa8, 0x89, 0x2c,
-	0xd5, 0xe1, 0x06, 0x6e, 0x41, 0x8d, 0x6e, 0xa6, 0x74, 0xb6, 0xf6, 0xa3, 0xd8, 0x0f, 0x03, 0xbd,
-	0x98, 0x3e, 0xe6, 0x7c, 0x58, 0x87, 0x0a, 0x09, 0xdc, 0xe9, 0x92, 0x78, 0xba, 0xdc, 0x44, 0x96,
-	0xe2, 0x1c, 0x4d, 0x7c, 0x09, 0x35, 0xd1, 0xe2, 0x64, 0x41, 0x12, 0xaa, 0x97, 0x9a, 0x92, 0x55,
-	0xed, 0xbc, 0xb0, 0x1f, 0x1c, 0xc2, 0x16, 0x9d, 0x7c, 0x20, 0x49, 0xb7, 0x7e, 0xbb, 0x35, 0x0b,
-	0x87, 0xad, 0x99, 0x0b, 0x77, 0xaa, 0xd1, 0x3d, 0x82, 0xe2, 0x11, 0xd4, 0x5c, 0x6f, 0xe5, 0x07,
-	0x13, 0x3e, 0x91, 0x5e, 0x6e, 0x22, 0xab, 0xda, 0x69, 0xfc, 0x93, 0x9b, 0xcd, 0x6c, 0x8f, 0x53,
-	0x44, 0x57, 0x63, 0x09, 0xb3, 0x31, 0x4e, 0x35, 0xb5, 0xf8, 0x33, 0x3e, 0x87, 0xca, 0x86, 0x12,
-	0x6f, 0x32, 0xdb, 0xe8, 0x95, 0x26, 0xb2, 0x64, 0xa7, 0xcc, 0xcc, 0x77, 0x1b, 0xec, 0xc1, 0x93,
-	0xec, 0xbc, 0xc7, 0x82, 0xca, 0xa3, 0x05, 0xcf, 0x0f, 0x5b, 0xf3, 0xa1, 0x50, 0x07, 0x67, 0x9d,
-	0xa2, 0x7c, 0x03, 0x14, 0x1a, 0xb8, 0x11, 0xbd, 0x0a, 0x63, 0x5d, 0x4d, 0xeb, 0xdf, 0xdb, 0x17,
-	0xb2, 0x22, 0x69, 0x72, 0xeb, 0x1b, 0x02, 0x38, 0xfd, 0x23, 0xfc, 0x0c, 0xa4, 0x05, 0x49, 0x38,
-	0x5d, 0xdd, 0xca, 0x61, 0x6b, 0x32, 0xd3, 0x61, 0x1f, 0x6c, 0x42, 0x69, 0xe1, 0x07, 0x1e, 0x4d,
-	0xf9, 0x38, 0xeb, 0xaa, 0x87, 0xad, 0xc9, 0x1d, 0x0e, 0x3f, 0x5a, 0xaf, 0x40, 0xfe, 0x9c, 0x44,
-	0x04, 0x2b, 0x20, 0x0f, 0x47, 0xc3, 0xbe, 0x56, 0xc0, 0x2a, 0x94, 0xde, 0xf6, 0x3e, 0x0d, 0x86,
-	0x1a, 0xc2, 0x67, 0xa0, 0xf6, 0xfa, 0x5f, 0xfa, 0x1f, 0x47, 0xe3, 0xbe, 0xa3, 0x15, 0x2f, 0x64,
-	0xa5, 0xa8, 0x49, 0xa2, 0x85, 0x37, 0x80, 0xc7, 0x4c, 0x39, 0x3d, 0x72, 0x4d, 0x96, 0x61, 0x44,
-	0xd6, 0x3d, 0x37, 0x76, 0xf1, 0x73, 0x50, 0x05, 0x33, 0x83, 0x9e, 0x90, 0xcf, 0xc9, 0xc1, 0xe3,
-	0x5b, 0xbf, 0x10, 0x54, 0x45, 0xf3, 0x69, 0x0c, 0x06, 0x39, 0x70, 0x57, 0x44, 0xc0, 0xd3, 0x7b,
-	0x56, 0x48, 0x52, 0x5e, 0x48, 0x03, 0xc8, 0x72, 0xaf, 0xcb, 0xff, 0xab, 0x23, 0x99, 0xe9, 0x28,
-	0xaf, 0x9b, 0x0e, 0x94, 0x05, 0x81, 0xa5, 0xc7, 0x08, 0x74, 0x04, 0x92, 0x8f, 0xd0, 0x7d, 0xff,
-	0x63, 0x67, 0xa0, 0xdb, 0x9d, 0x81, 0xee, 0x76, 0x06, 0xfa, 0xb3, 0x33, 0xd0, 0xf7, 0xbd, 0x51,
-	0xb8, 0xdb, 0x1b, 0x85, 0xdf, 0x7b, 0xa3, 0x70, 0x69, 0xcd, 0xfd, 0xf8, 0x6a, 0x33, 0xb5, 0x67,
-	0xe1, 0xaa, 0x9d, 0xdb, 0xbb, 0x9b, 0xd3, 0x2e, 0xc7, 0x49, 0x44, 0xe8, 0xb4, 0x9c, 0x2e, 0xdf,
-	0xeb, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xee, 0xd2, 0x34, 0xbb, 0xf1, 0x03, 0x00, 0x00,
+	// 560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xc1, 0x6e, 0xda, 0x4c,
+	0x10, 0x66, 0x63, 0x63, 0xec, 0x81, 0x48, 0xd6, 0xfe, 0xfc, 0x8a, 0x8b, 0x2a, 0x9b, 0xd2, 0x8b,
+	0xd5, 0x83, 0x91, 0xe8, 0xa5, 0xa7, 0x4a, 0xa5, 0x50, 0x89, 0xb4, 0x05, 0x64, 0x55, 0x3d, 0xe4,
+	0x82, 0x0c, 0x5e, 0x11, 0x17, 0xb0, 0x2d, 0xd6, 0x44, 0xf1, 0x5b, 0xf4, 0x31, 0x2a, 0xf5, 0x25,
+	0x7a, 0xcc, 0x31, 0xc7, 0xf6, 0x62, 0x55, 0x70, 0xe3, 0x29, 0x2a, 0x7b, 0x97, 0x60, 0xb7, 0x91,
+	0x92, 0x8b, 0x77, 0x67, 0xf6, 0x9b, 0x99, 0xfd, 0x66, 0xbe, 0x35, 0x3c, 0x5f, 0x3a, 0x57, 0x8e,
+	0x4f, 0xa2, 0x76, 0xba, 0xb6, 0xc3, 0x75, 0xf0, 0x85, 0xcc, 0x22, 0x7a, 0xd8, 0x58, 0xe1, 0x3a,
+	0x88, 0x02, 0xfc, 0x3f, 0x07, 0x59, 0xe9, 0x6a, 0x1d, 0x40, 0x8d, 0xfa, 0x3c, 0x98, 0x07, 0x19,
+	0xa2, 0x9d, 0xee, 0x18, 0xb8, 0x61, 0x14, 0x33, 0x2e, 0x1d, 0x9f, 0xb6, 0xc3, 0x60, 0xe9, 0xcd,
+	0x62, 0x06, 0x68, 0x7d, 0x17, 0xa0, 0x32, 0x66, 0x39, 0x70, 0x1d, 0xca, 0x9e, 0xef, 0x92, 0x6b,
+	0x0d, 0x35, 0x91, 0xa9, 0xd8, 0xcc, 0xc0, 0x2d, 0xa8, 0xd1, 0xcd, 0x94, 0xce, 0xd6, 0x5e, 0x18,
+	0x79, 0x81, 0xaf, 0x9d, 0x64, 0x87, 0x05, 0x1f, 0xd6, 0xa0, 0x42, 0x7c, 0x67, 0xba, 0x24, 0xae,
+	0x26, 0x36, 0x91, 0x29, 0xdb, 0x07, 0x13, 0x5f, 0x40, 0x8d, 0x5f, 0x71, 0xb2, 0x20, 0x31, 0xd5,
+	0xca, 0x4d, 0xc1, 0xac, 0x76, 0x9e, 0x59, 0xf7, 0x92, 0xb0, 0xf8, 0x4d, 0xde, 0x93, 0xb8, 0x5b,
+	0xbf, 0x49, 0x8c, 0xd2, 0x3e, 0x31, 0x0a, 0xe1, 0x76, 0x35, 0xbc, 0x43, 0x50, 0x3c, 0x82, 0x9a,
+	0xe3, 0xae, 0x3c, 0x7f, 0xc2, 0x18, 0x69, 0x52, 0x13, 0x99, 0xd5, 0x4e, 0xe3, 0xaf, 0xdc, 0x29,
+	0x67, 0x6b, 0x9c, 0x21, 0xba, 0x6a, 0x9a, 0x30, 0x1f, 0x63, 0x57, 0x33, 0x8b, 0x1d, 0xe3, 0x33,
+	0xa8, 0x6c, 0x28, 0x71, 0x27, 0xb3, 0x8d, 0x56, 0x69, 0x22, 0x53, 0xb4, 0xa5, 0xd4, 0x7c, 0xbb,
+	0xc1, 0x2e, 0xfc, 0x97, 0xe7, 0x7b, 0x28, 0x28, 0x3f, 0x58, 0xf0, 0x6c, 0x9f, 0x18, 0xf7, 0x85,
+	0xda, 0x38, 0xef, 0xe4, 0xe5, 0x1b, 0x20, 0x53, 0xdf, 0x09, 0xe9, 0x65, 0x10, 0x69, 0x4a, 0x56,
+	0xff, 0xce, 0x3e, 0x17, 0x65, 0x41, 0x15, 0x5b, 0xbf, 0x10, 0xc0, 0xb1, 0x47, 0xf8, 0x09, 0x08,
+	0x0b, 0x12, 0xb3, 0x71, 0x75, 0x2b, 0xfb, 0xc4, 0x48, 0x4d, 0x3b, 0xfd, 0x60, 0x03, 0xca, 0x0b,
+	0xcf, 0x77, 0x69, 0x36, 0x8f, 0xd3, 0xae, 0xb2, 0x4f, 0x0c, 0xe6, 0xb0, 0xd9, 0x82, 0x5f, 0x83,
+	0xc4, 0x59, 0x94, 0x1f, 0x64, 0x01, 0xfb, 0xc4, 0xe0, 0x68, 0x5b, 0x0a, 0xff, 0xe9, 0x95, 0x94,
+	0xef, 0x55, 0xeb, 0x05, 0x88, 0x9f, 0xe2, 0x90, 0x60, 0x19, 0xc4, 0xe1, 0x68, 0xd8, 0x57, 0x4b,
+	0x58, 0x81, 0xf2, 0x9b, 0xde, 0xc7, 0xc1, 0x50, 0x45, 0xf8, 0x14, 0x94, 0x5e, 0xff, 0x73, 0xff,
+	0xc3, 0x68, 0xdc, 0xb7, 0xd5, 0x93, 0x73, 0x51, 0x3e, 0x51, 0x05, 0xce, 0xed, 0x15, 0xe0, 0x71,
+	0x2a, 0xc9, 0x1e, 0xb9, 0x22, 0xcb, 0x20, 0x24, 0xeb, 0x9e, 0x13, 0x39, 0xf8, 0x29, 0x28, 0x7c,
+	0xe4, 0x83, 0x1e, 0xd7, 0xe5, 0xd1, 0xc1, 0xe2, 0x5b, 0x3f, 0x10, 0x54, 0x79, 0x57, 0xb2, 0x18,
+	0x0c, 0xa2, 0xef, 0xac, 0x08, 0x87, 0x67, 0xfb, 0xbc, 0x42, 0x85, 0xa2, 0x42, 0x07, 0x90, 0x17,
+	0x95, 0x26, 0x3e, 0x56, 0xa0, 0x62, 0x2a, 0xd0, 0xa2, 0x20, 0x3b, 0x8f, 0xef, 0xe9, 0xa1, 0x8f,
+	0x8c, 0x42, 0xf7, 0xdd, 0xb7, 0xad, 0x8e, 0x6e, 0xb6, 0x3a, 0xba, 0xdd, 0xea, 0xe8, 0xf7, 0x56,
+	0x47, 0x5f, 0x77, 0x7a, 0xe9, 0x76, 0xa7, 0x97, 0x7e, 0xee, 0xf4, 0xd2, 0x85, 0x39, 0xf7, 0xa2,
+	0xcb, 0xcd, 0xd4, 0x9a, 0x05, 0xab, 0x76, 0xe1, 0x41, 0x5f, 0x1f, 0x7f, 0x12, 0x51, 0x1c, 0x12,
+	0x3a, 0x95, 0xb2, 0x57, 0xfd, 0xf2, 0xcf, 0x00, 0x7a, 0x01, 0x2f, 0xd7, 0x4a, 0x04, 0x00, 0x00,
 }
 
 func (this *Project) Equal(that interface{}) bool {
@@ -442,6 +459,12 @@ func (this *ProjectKey) Equal(that interface{}) bool {
 	if this.Kinds != that1.Kinds {
 		return false
 	}
+	if !this.Policy.Equal(that1.Policy) {
+		return false
+	}
+	if this.UsedCu != that1.UsedCu {
+		return false
+	}
 	return true
 }
 func (this *ProtoDeveloperData) Equal(that interface{}) bool {
@@ -621,6 +644,23 @@ func (m *ProjectKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UsedCu != 0 {
+		i = encodeVarintProject(dAtA, i, uint64(m.UsedCu))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProject(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Kinds != 0 {
 		i = encodeVarintProject(dAtA, i, uint64(m.Kinds))
 		i--
@@ -796,6 +836,13 @@ func (m *ProjectKey) Size() (n int) {
 	if m.Kinds != 0 {
 		n += 1 + sovProject(uint64(m.Kinds))
 	}
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.UsedCu != 0 {
+		n += 1 + sovProject(uint64(m.UsedCu))
+	}
 	return n
 }
 
@@ -1202,6 +1249,61 @@ func (m *ProjectKey) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Policy == nil {
+				m.Policy = &types.Policy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedCu", wireType)
+			}
+			m.UsedCu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedCu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProject(dAtA[iNdEx:])
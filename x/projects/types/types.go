@@ -18,5 +18,6 @@ const (
 	DelProjectKeyEventName         = "del_key_from_project_event"
 	SetAdminPolicyEventName        = "set_admin_policy_event"
 	SetSubscriptionPolicyEventName = "set_subscription_policy_event"
+	SetDeveloperKeyPolicyEventName = "set_developer_key_policy_event"
 	ProjectResetFailEventName      = "project_reset_failed"
 )
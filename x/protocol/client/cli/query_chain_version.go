@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/protocol/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryChainVersion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chain-version [chain-id]",
+		Short: "shows the effective protocol version of a chain (the global version with the chain's minimum versions applied)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ChainVersion(context.Background(), &types.QueryChainVersionRequest{ChainId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryChainVersions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chain-versions",
+		Short: "shows the minimum protocol versions of all the chains that override the global version",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ChainVersions(context.Background(), &types.QueryChainVersionsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
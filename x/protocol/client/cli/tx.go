@@ -87,3 +87,58 @@ func NewSubmitSetProtocolVersionProposalTxCmd() *cobra.Command {
 	cmd.Flags().Bool(expeditedFlagName, false, "set to true to make the spec proposal expedited")
 	return cmd
 }
+
+// SetChainProtocolVersionProposalHandler is the chain version proposal handler.
+var SetChainProtocolVersionProposalHandler = govclient.NewProposalHandler(NewSubmitSetChainProtocolVersionProposalTxCmd)
+
+// NewSubmitSetChainProtocolVersionProposalTxCmd returns a CLI command handler for creating
+// a set-chain-version proposal governance transaction.
+func NewSubmitSetChainProtocolVersionProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-chain-protocol-version chain-id provider-minimum consumer-minimum <deposit>",
+		Args:  cobra.ExactArgs(4),
+		Short: "Submit a set chain protocol version proposal",
+		Long: strings.TrimSpace(
+			`Submit a set chain protocol version proposal along with an initial deposit. The proposal sets the minimum versions 
+			of the lavap binary for a specific chain, overriding the global minimum versions. Use "" to keep the global minimum 
+			version (using "" for both removes the chain's override).
+			Example:
+			tx gov submit-legacy-proposal set-chain-protocol-version ETH1 1.2.1 1.2.1 10000000ulava`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress()
+
+			isExpedited, err := cmd.Flags().GetBool(expeditedFlagName)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetChainVersion(
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				types.ChainVersion{ChainId: args[0], ProviderMin: args[1], ConsumerMin: args[2]},
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			submitPropMsg, err := govv1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, from.String(), "", "Set chain protocol version", "Set chain protocol version", isExpedited)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), submitPropMsg)
+		},
+	}
+
+	cmd.Flags().Bool(expeditedFlagName, false, "set to true to make the spec proposal expedited")
+	return cmd
+}
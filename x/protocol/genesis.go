@@ -11,12 +11,16 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
+	for _, chainVersion := range genState.ChainVersions {
+		k.SetChainVersion(ctx, chainVersion)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.ChainVersions = k.GetAllChainVersion(ctx)
 
 	// this line is used by starport scaffolding # genesis/module/export
 
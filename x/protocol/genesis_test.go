@@ -13,7 +13,10 @@ import (
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
-
+		ChainVersions: []types.ChainVersion{
+			{ChainId: "ETH1", ProviderMin: "1.2.0", ConsumerMin: "1.1.0"},
+			{ChainId: "LAV1", ProviderMin: "1.2.1"},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -25,5 +28,6 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
+	require.ElementsMatch(t, genesisState.ChainVersions, got.ChainVersions)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
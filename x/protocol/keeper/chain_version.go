@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/protocol/types"
+)
+
+// SetChainVersion set a chain's minimum versions in the store. An empty chain
+// version removes the chain's override
+func (k Keeper) SetChainVersion(ctx sdk.Context, chainVersion types.ChainVersion) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainVersionKeyPrefix))
+	if chainVersion.IsEmpty() {
+		store.Delete([]byte(chainVersion.ChainId))
+		return
+	}
+	b := k.cdc.MustMarshal(&chainVersion)
+	store.Set([]byte(chainVersion.ChainId), b)
+}
+
+// GetChainVersion returns a chain's minimum versions
+func (k Keeper) GetChainVersion(ctx sdk.Context, chainID string) (val types.ChainVersion, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainVersionKeyPrefix))
+	b := store.Get([]byte(chainID))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllChainVersion returns all the chains' minimum versions
+func (k Keeper) GetAllChainVersion(ctx sdk.Context) (list []types.ChainVersion) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainVersionKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ChainVersion
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetEffectiveVersion returns the protocol version of a chain: the global version
+// with the chain's minimum versions (if set) applied
+func (k Keeper) GetEffectiveVersion(ctx sdk.Context, chainID string) types.Version {
+	version := k.Version(ctx)
+	chainVersion, found := k.GetChainVersion(ctx, chainID)
+	if !found {
+		return version
+	}
+	return version.ApplyChainVersion(chainVersion)
+}
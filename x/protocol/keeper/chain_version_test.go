@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	keepertest "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/lavanet/lava/x/protocol/keeper"
+	"github.com/lavanet/lava/x/protocol/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainVersion(t *testing.T) {
+	k, ctx := keepertest.ProtocolKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	global := k.Version(ctx)
+
+	// no override: the effective version is the global one
+	res, err := k.ChainVersion(goCtx, &types.QueryChainVersionRequest{ChainId: "LAV1"})
+	require.NoError(t, err)
+	require.Equal(t, global, res.Version)
+	require.True(t, res.ChainVersion.IsEmpty())
+
+	// only the authority can set a chain version
+	chainVersion := types.ChainVersion{ChainId: "LAV1", ProviderMin: "5.0.0"}
+	_, err = msgServer.SetChainVersion(goCtx, types.NewMsgSetChainVersion(sample.AccAddress(), chainVersion))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	_, err = msgServer.SetChainVersion(goCtx, types.NewMsgSetChainVersion(authority, chainVersion))
+	require.NoError(t, err)
+
+	// the provider min (and target, since it's lower) are overridden, consumer is unchanged
+	res, err = k.ChainVersion(goCtx, &types.QueryChainVersionRequest{ChainId: "LAV1"})
+	require.NoError(t, err)
+	require.Equal(t, chainVersion, res.ChainVersion)
+	require.Equal(t, "5.0.0", res.Version.ProviderMin)
+	require.Equal(t, "5.0.0", res.Version.ProviderTarget)
+	require.Equal(t, global.ConsumerMin, res.Version.ConsumerMin)
+	require.Equal(t, global.ConsumerTarget, res.Version.ConsumerTarget)
+
+	// other chains are unaffected
+	require.Equal(t, global, k.GetEffectiveVersion(ctx, "ETH1"))
+
+	// a process serving both chains must meet the higher versions
+	require.Equal(t, res.Version, k.GetEffectiveVersion(ctx, "ETH1").Max(res.Version))
+	require.Equal(t, res.Version, res.Version.Max(global))
+	all, err := k.ChainVersions(goCtx, &types.QueryChainVersionsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.ChainVersion{chainVersion}, all.ChainVersions)
+
+	// empty minimum versions remove the override
+	_, err = msgServer.SetChainVersion(goCtx, types.NewMsgSetChainVersion(authority, types.ChainVersion{ChainId: "LAV1"}))
+	require.NoError(t, err)
+	_, found := k.GetChainVersion(ctx, "LAV1")
+	require.False(t, found)
+	require.Equal(t, global, k.GetEffectiveVersion(ctx, "LAV1"))
+}
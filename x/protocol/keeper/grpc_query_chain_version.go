@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/protocol/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ChainVersion(c context.Context, req *types.QueryChainVersionRequest) (*types.QueryChainVersionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chainVersion, _ := k.GetChainVersion(ctx, req.ChainId)
+	return &types.QueryChainVersionResponse{
+		Version:      k.GetEffectiveVersion(ctx, req.ChainId),
+		ChainVersion: chainVersion,
+	}, nil
+}
+
+func (k Keeper) ChainVersions(c context.Context, req *types.QueryChainVersionsRequest) (*types.QueryChainVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryChainVersionsResponse{ChainVersions: k.GetAllChainVersion(ctx)}, nil
+}
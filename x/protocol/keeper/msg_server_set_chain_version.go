@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/protocol/types"
+)
+
+func (k msgServer) SetChainVersion(goCtx context.Context, msg *types.MsgSetChainVersion) (*types.MsgSetChainVersionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.authority {
+		return &types.MsgSetChainVersionResponse{}, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.ChainVersion.Validate(); err != nil {
+		return &types.MsgSetChainVersionResponse{}, sdkerrors.Wrap(types.ErrInvalidChainVersion, err.Error())
+	}
+
+	k.Keeper.SetChainVersion(ctx, msg.ChainVersion)
+
+	details := map[string]string{
+		"chain_id":     msg.ChainVersion.ChainId,
+		"provider_min": msg.ChainVersion.ProviderMin,
+		"consumer_min": msg.ChainVersion.ConsumerMin,
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.SetChainVersionEventName, details, "Chain protocol version set")
+
+	return &types.MsgSetChainVersionResponse{}, nil
+}
@@ -0,0 +1,423 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lavanet/lava/protocol/chain_version.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ChainVersion holds the minimum protocol versions of a specific chain (spec).
+// A non-empty minimum overrides the global minimum version for that chain.
+type ChainVersion struct {
+	ChainId     string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ProviderMin string `protobuf:"bytes,2,opt,name=provider_min,json=providerMin,proto3" json:"provider_min,omitempty"`
+	ConsumerMin string `protobuf:"bytes,3,opt,name=consumer_min,json=consumerMin,proto3" json:"consumer_min,omitempty"`
+}
+
+func (m *ChainVersion) Reset()         { *m = ChainVersion{} }
+func (m *ChainVersion) String() string { return proto.CompactTextString(m) }
+func (*ChainVersion) ProtoMessage()    {}
+func (*ChainVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38cbcba73bd58b6b, []int{0}
+}
+func (m *ChainVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainVersion.Merge(m, src)
+}
+func (m *ChainVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainVersion proto.InternalMessageInfo
+
+func (m *ChainVersion) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ChainVersion) GetProviderMin() string {
+	if m != nil {
+		return m.ProviderMin
+	}
+	return ""
+}
+
+func (m *ChainVersion) GetConsumerMin() string {
+	if m != nil {
+		return m.ConsumerMin
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ChainVersion)(nil), "lavanet.lava.protocol.ChainVersion")
+}
+
+func init() {
+	proto.RegisterFile("lavanet/lava/protocol/chain_version.proto", fileDescriptor_38cbcba73bd58b6b)
+}
+
+var fileDescriptor_38cbcba73bd58b6b = []byte{
+	// 197 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcc, 0x49, 0x2c, 0x4b,
+	0xcc, 0x4b, 0x2d, 0xd1, 0x07, 0xd1, 0xfa, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0xc9, 0xf9, 0x39, 0xfa,
+	0xc9, 0x19, 0x89, 0x99, 0x79, 0xf1, 0x65, 0xa9, 0x45, 0xc5, 0x99, 0xf9, 0x79, 0x7a, 0x60, 0x61,
+	0x21, 0x51, 0xa8, 0x52, 0x3d, 0x10, 0xad, 0x07, 0x53, 0xaa, 0x94, 0xcf, 0xc5, 0xe3, 0x0c, 0x52,
+	0x1d, 0x06, 0x51, 0x2c, 0x24, 0xc9, 0xc5, 0x01, 0xd1, 0x9d, 0x99, 0x22, 0xc1, 0xa8, 0xc0, 0xa8,
+	0xc1, 0x19, 0xc4, 0x0e, 0xe6, 0x7b, 0xa6, 0x08, 0x29, 0x72, 0xf1, 0x14, 0x14, 0xe5, 0x97, 0x65,
+	0xa6, 0xa4, 0x16, 0xc5, 0xe7, 0x66, 0xe6, 0x49, 0x30, 0x81, 0xa5, 0xb9, 0x61, 0x62, 0xbe, 0x99,
+	0x79, 0x20, 0x25, 0xc9, 0xf9, 0x79, 0xc5, 0xa5, 0xb9, 0x50, 0x25, 0xcc, 0x10, 0x25, 0x30, 0x31,
+	0xdf, 0xcc, 0x3c, 0x27, 0xa7, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48,
+	0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2,
+	0x48, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x47, 0xf1, 0x57, 0x05, 0xc2,
+	0x67, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xbe, 0x31, 0x60, 0x00, 0xc1, 0x60, 0x22,
+	0xfd, 0xff, 0x00, 0x00, 0x00,
+}
+
+func (m *ChainVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerMin) > 0 {
+		i -= len(m.ConsumerMin)
+		copy(dAtA[i:], m.ConsumerMin)
+		i = encodeVarintChainVersion(dAtA, i, uint64(len(m.ConsumerMin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProviderMin) > 0 {
+		i -= len(m.ProviderMin)
+		copy(dAtA[i:], m.ProviderMin)
+		i = encodeVarintChainVersion(dAtA, i, uint64(len(m.ProviderMin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintChainVersion(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintChainVersion(dAtA []byte, offset int, v uint64) int {
+	offset -= sovChainVersion(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChainVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovChainVersion(uint64(l))
+	}
+	l = len(m.ProviderMin)
+	if l > 0 {
+		n += 1 + l + sovChainVersion(uint64(l))
+	}
+	l = len(m.ConsumerMin)
+	if l > 0 {
+		n += 1 + l + sovChainVersion(uint64(l))
+	}
+	return n
+}
+
+func sovChainVersion(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozChainVersion(x uint64) (n int) {
+	return sovChainVersion(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChainVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChainVersion
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChainVersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChainVersion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChainVersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChainVersion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderMin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChainVersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChainVersion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerMin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChainVersion(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChainVersion
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipChainVersion(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowChainVersion
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChainVersion
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChainVersion
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthChainVersion
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupChainVersion
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthChainVersion
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthChainVersion        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowChainVersion          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupChainVersion = fmt.Errorf("proto: unexpected end of group")
+)
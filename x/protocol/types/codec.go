@@ -9,12 +9,13 @@ import (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetVersion{}, "protocol/MsgSetVersion", nil)
+	cdc.RegisterConcrete(&MsgSetChainVersion{}, "protocol/MsgSetChainVersion", nil)
 	// this line is used by starport scaffolding # 2
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	// this line is used by starport scaffolding # 3
-	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetVersion{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetVersion{}, &MsgSetChainVersion{})
 }
 
 var (
@@ -8,5 +8,6 @@ import (
 
 // x/protocol module sentinel errors
 var (
-	ErrSample = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrSample              = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrInvalidChainVersion = sdkerrors.Register(ModuleName, 1101, "invalid chain version")
 )
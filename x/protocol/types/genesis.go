@@ -1,5 +1,7 @@
 package types
 
+import fmt "fmt"
+
 // DefaultIndex is the default capability global index
 const DefaultIndex uint64 = 1
 
@@ -7,7 +9,8 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		// this line is used by starport scaffolding # genesis/types/default
-		Params: DefaultParams(),
+		Params:        DefaultParams(),
+		ChainVersions: []ChainVersion{},
 	}
 }
 
@@ -15,6 +18,16 @@ func DefaultGenesis() *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
+	chainIds := map[string]struct{}{}
+	for _, chainVersion := range gs.ChainVersions {
+		if err := chainVersion.Validate(); err != nil {
+			return fmt.Errorf("invalid chain version: %w", err)
+		}
+		if _, ok := chainIds[chainVersion.ChainId]; ok {
+			return fmt.Errorf("duplicated chain version for chain %s", chainVersion.ChainId)
+		}
+		chainIds[chainVersion.ChainId] = struct{}{}
+	}
 
 	return gs.Params.Validate()
 }
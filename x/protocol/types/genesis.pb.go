@@ -25,7 +25,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the protocol module's genesis state.
 type GenesisState struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params        Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	ChainVersions []ChainVersion `protobuf:"bytes,2,rep,name=chain_versions,json=chainVersions,proto3" json:"chain_versions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +69,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetChainVersions() []ChainVersion {
+	if m != nil {
+		return m.ChainVersions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lavanet.lava.protocol.GenesisState")
 }
@@ -77,19 +85,22 @@ func init() {
 }
 
 var fileDescriptor_5875ab05db1f379d = []byte{
-	// 188 bytes of a gzipped FileDescriptorProto
+	// 236 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xce, 0x49, 0x2c, 0x4b,
 	0xcc, 0x4b, 0x2d, 0xd1, 0x07, 0xd1, 0xfa, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0xc9, 0xf9, 0x39, 0xfa,
 	0xe9, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5, 0x7a, 0x60, 0x01, 0x21, 0x51, 0xa8, 0x22, 0x3d, 0x10,
 	0xad, 0x07, 0x53, 0x24, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0xe6, 0xe9, 0x83, 0x58, 0x10, 0x09,
-	0x29, 0x25, 0xec, 0x26, 0x16, 0x24, 0x16, 0x25, 0xe6, 0x42, 0x0d, 0x54, 0xf2, 0xe6, 0xe2, 0x71,
-	0x87, 0xd8, 0x10, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xcd, 0xc5, 0x06, 0x91, 0x97, 0x60, 0x54,
-	0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd5, 0xc3, 0x6a, 0xa3, 0x5e, 0x00, 0x58, 0x91, 0x13, 0xcb, 0x89,
-	0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x2d, 0x4e, 0x4e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7,
-	0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c,
-	0xc7, 0x10, 0xa5, 0x91, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x8f, 0xe2,
-	0xaa, 0x0a, 0x84, 0xbb, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x7c, 0x63, 0x40, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x6b, 0x15, 0x52, 0x8e, 0x0f, 0x01, 0x00, 0x00,
+	0x29, 0x25, 0xec, 0x26, 0x16, 0x24, 0x16, 0x25, 0xe6, 0x42, 0x0d, 0x94, 0xd2, 0xc4, 0xae, 0x26,
+	0x39, 0x23, 0x31, 0x33, 0x2f, 0xbe, 0x2c, 0xb5, 0xa8, 0x38, 0x33, 0x3f, 0x0f, 0xa2, 0x54, 0x69,
+	0x2e, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0x35, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0xd6, 0x5c, 0x6c,
+	0x10, 0xb3, 0x24, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x64, 0xf5, 0xb0, 0xba, 0x4e, 0x2f, 0x00,
+	0xac, 0xc8, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8, 0x16, 0xa1, 0x00, 0x2e, 0x3e, 0x14,
+	0x4b, 0x8a, 0x25, 0x98, 0x14, 0x98, 0x35, 0xb8, 0x8d, 0x94, 0x71, 0x18, 0xe2, 0x0c, 0x52, 0x1c,
+	0x06, 0x51, 0x0b, 0x35, 0x8a, 0x37, 0x19, 0x49, 0xac, 0xd8, 0xc9, 0xe9, 0xc4, 0x23, 0x39, 0xc6,
+	0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39,
+	0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x34, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3,
+	0x73, 0xf5, 0x51, 0xfc, 0x5b, 0x81, 0xf0, 0x71, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x98,
+	0x6f, 0x0c, 0x18, 0x00, 0x5d, 0xe5, 0x8f, 0x5f, 0x8d, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,6 +123,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChainVersions) > 0 {
+		for iNdEx := len(m.ChainVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -144,6 +169,12 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ChainVersions) > 0 {
+		for _, e := range m.ChainVersions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -215,6 +246,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainVersions = append(m.ChainVersions, ChainVersion{})
+			if err := m.ChainVersions[len(m.ChainVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -26,6 +26,27 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated chain version",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ChainVersions: []types.ChainVersion{
+					{ChainId: "LAV1", ProviderMin: "1.2.0"},
+					{ChainId: "LAV1", ConsumerMin: "1.2.0"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid chain version",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ChainVersions: []types.ChainVersion{
+					{ChainId: "LAV1", ProviderMin: "1.2"},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -15,6 +15,9 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_protocol"
+
+	// ChainVersionKeyPrefix is the prefix to retrieve all ChainVersion
+	ChainVersionKeyPrefix = "ChainVersion/"
 )
 
 func KeyPrefix(p string) []byte {
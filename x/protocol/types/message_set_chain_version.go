@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgSetChainVersion = "set_chain_version"
+
+var _ sdk.Msg = &MsgSetChainVersion{}
+
+func NewMsgSetChainVersion(authority string, chainVersion ChainVersion) *MsgSetChainVersion {
+	return &MsgSetChainVersion{
+		Authority:    authority,
+		ChainVersion: chainVersion,
+	}
+}
+
+func (msg *MsgSetChainVersion) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetChainVersion) Type() string {
+	return TypeMsgSetChainVersion
+}
+
+func (msg *MsgSetChainVersion) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetChainVersion) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetChainVersion) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	if err := msg.ChainVersion.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidChainVersion, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetChainVersion_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name  string
+		msg   MsgSetChainVersion
+		valid bool
+	}{
+		{
+			name: "invalid authority address",
+			msg: MsgSetChainVersion{
+				Authority:    "invalid_address",
+				ChainVersion: ChainVersion{ChainId: "LAV1", ProviderMin: "1.2.0", ConsumerMin: "1.2.0"},
+			},
+			valid: false,
+		},
+		{
+			name: "empty chain ID",
+			msg: MsgSetChainVersion{
+				Authority:    sample.AccAddress(),
+				ChainVersion: ChainVersion{ProviderMin: "1.2.0"},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid provider min version",
+			msg: MsgSetChainVersion{
+				Authority:    sample.AccAddress(),
+				ChainVersion: ChainVersion{ChainId: "LAV1", ProviderMin: "1.2"},
+			},
+			valid: false,
+		},
+		{
+			name: "valid message",
+			msg: MsgSetChainVersion{
+				Authority:    sample.AccAddress(),
+				ChainVersion: ChainVersion{ChainId: "LAV1", ProviderMin: "1.2.0", ConsumerMin: "1.1.0"},
+			},
+			valid: true,
+		},
+		{
+			name: "valid message - only provider min",
+			msg: MsgSetChainVersion{
+				Authority:    sample.AccAddress(),
+				ChainVersion: ChainVersion{ChainId: "LAV1", ProviderMin: "1.2.0"},
+			},
+			valid: true,
+		},
+		{
+			name: "valid message - remove override",
+			msg: MsgSetChainVersion{
+				Authority:    sample.AccAddress(),
+				ChainVersion: ChainVersion{ChainId: "LAV1"},
+			},
+			valid: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.valid {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestApplyChainVersion(t *testing.T) {
+	global := Version{ProviderTarget: "1.2.0", ProviderMin: "1.0.0", ConsumerTarget: "1.2.0", ConsumerMin: "1.0.0"}
+
+	// no override
+	require.Equal(t, global, global.ApplyChainVersion(ChainVersion{ChainId: "LAV1"}))
+
+	// provider min override (below target)
+	effective := global.ApplyChainVersion(ChainVersion{ChainId: "LAV1", ProviderMin: "1.1.0"})
+	require.Equal(t, Version{ProviderTarget: "1.2.0", ProviderMin: "1.1.0", ConsumerTarget: "1.2.0", ConsumerMin: "1.0.0"}, effective)
+
+	// consumer min override above target raises the target
+	effective = global.ApplyChainVersion(ChainVersion{ChainId: "LAV1", ConsumerMin: "1.3.0"})
+	require.Equal(t, Version{ProviderTarget: "1.2.0", ProviderMin: "1.0.0", ConsumerTarget: "1.3.0", ConsumerMin: "1.3.0"}, effective)
+}
+
+func TestIsVersionLower(t *testing.T) {
+	require.True(t, IsVersionLower("1.0.2", "1.0.10"))
+	require.True(t, IsVersionLower("0.35.0", "1.0.0"))
+	require.False(t, IsVersionLower("1.0.10", "1.0.2"))
+	require.False(t, IsVersionLower("1.0.2", "1.0.2"))
+	require.True(t, IsVersionLower("invalid", "1.0.2"))
+	require.False(t, IsVersionLower("1.0.2", ""))
+}
@@ -113,34 +113,232 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryChainVersionRequest is request type for the Query/ChainVersion RPC method.
+type QueryChainVersionRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryChainVersionRequest) Reset()         { *m = QueryChainVersionRequest{} }
+func (m *QueryChainVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChainVersionRequest) ProtoMessage()    {}
+func (*QueryChainVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c616be00122ebd89, []int{2}
+}
+func (m *QueryChainVersionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainVersionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChainVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainVersionRequest.Merge(m, src)
+}
+func (m *QueryChainVersionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChainVersionRequest proto.InternalMessageInfo
+
+func (m *QueryChainVersionRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+// QueryChainVersionResponse is response type for the Query/ChainVersion RPC method.
+type QueryChainVersionResponse struct {
+	// version holds the global version with the chain's minimum versions applied.
+	Version Version `protobuf:"bytes,1,opt,name=version,proto3" json:"version"`
+	// chain_version holds the chain's minimum versions (empty if the chain uses the global version).
+	ChainVersion ChainVersion `protobuf:"bytes,2,opt,name=chain_version,json=chainVersion,proto3" json:"chain_version"`
+}
+
+func (m *QueryChainVersionResponse) Reset()         { *m = QueryChainVersionResponse{} }
+func (m *QueryChainVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChainVersionResponse) ProtoMessage()    {}
+func (*QueryChainVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c616be00122ebd89, []int{3}
+}
+func (m *QueryChainVersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainVersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChainVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainVersionResponse.Merge(m, src)
+}
+func (m *QueryChainVersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChainVersionResponse proto.InternalMessageInfo
+
+func (m *QueryChainVersionResponse) GetVersion() Version {
+	if m != nil {
+		return m.Version
+	}
+	return Version{}
+}
+
+func (m *QueryChainVersionResponse) GetChainVersion() ChainVersion {
+	if m != nil {
+		return m.ChainVersion
+	}
+	return ChainVersion{}
+}
+
+// QueryChainVersionsRequest is request type for the Query/ChainVersions RPC method.
+type QueryChainVersionsRequest struct {
+}
+
+func (m *QueryChainVersionsRequest) Reset()         { *m = QueryChainVersionsRequest{} }
+func (m *QueryChainVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChainVersionsRequest) ProtoMessage()    {}
+func (*QueryChainVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c616be00122ebd89, []int{4}
+}
+func (m *QueryChainVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChainVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainVersionsRequest.Merge(m, src)
+}
+func (m *QueryChainVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChainVersionsRequest proto.InternalMessageInfo
+
+// QueryChainVersionsResponse is response type for the Query/ChainVersions RPC method.
+type QueryChainVersionsResponse struct {
+	ChainVersions []ChainVersion `protobuf:"bytes,1,rep,name=chain_versions,json=chainVersions,proto3" json:"chain_versions"`
+}
+
+func (m *QueryChainVersionsResponse) Reset()         { *m = QueryChainVersionsResponse{} }
+func (m *QueryChainVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChainVersionsResponse) ProtoMessage()    {}
+func (*QueryChainVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c616be00122ebd89, []int{5}
+}
+func (m *QueryChainVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChainVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainVersionsResponse.Merge(m, src)
+}
+func (m *QueryChainVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChainVersionsResponse proto.InternalMessageInfo
+
+func (m *QueryChainVersionsResponse) GetChainVersions() []ChainVersion {
+	if m != nil {
+		return m.ChainVersions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "lavanet.lava.protocol.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.protocol.QueryParamsResponse")
+	proto.RegisterType((*QueryChainVersionRequest)(nil), "lavanet.lava.protocol.QueryChainVersionRequest")
+	proto.RegisterType((*QueryChainVersionResponse)(nil), "lavanet.lava.protocol.QueryChainVersionResponse")
+	proto.RegisterType((*QueryChainVersionsRequest)(nil), "lavanet.lava.protocol.QueryChainVersionsRequest")
+	proto.RegisterType((*QueryChainVersionsResponse)(nil), "lavanet.lava.protocol.QueryChainVersionsResponse")
 }
 
 func init() { proto.RegisterFile("lavanet/lava/protocol/query.proto", fileDescriptor_c616be00122ebd89) }
 
 var fileDescriptor_c616be00122ebd89 = []byte{
-	// 297 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0x31, 0x4b, 0x03, 0x31,
-	0x14, 0x80, 0x2f, 0xa2, 0x1d, 0xe2, 0x16, 0x2b, 0x48, 0xb1, 0xa9, 0x1e, 0x08, 0xb5, 0x43, 0x42,
-	0xeb, 0xe8, 0xd6, 0x5f, 0xa0, 0x1d, 0xdd, 0x72, 0x25, 0xc4, 0x83, 0x5e, 0x5e, 0x7a, 0xc9, 0x15,
-	0xbb, 0x3a, 0x38, 0x17, 0xfc, 0x53, 0x1d, 0x0b, 0x2e, 0x4e, 0x22, 0x77, 0xfe, 0x10, 0xb9, 0xe4,
-	0x44, 0x8a, 0x55, 0x9c, 0x5e, 0xde, 0x7b, 0xdf, 0xfb, 0xf2, 0x12, 0x7c, 0x3e, 0x13, 0x0b, 0xa1,
-	0xa5, 0xe3, 0x75, 0xe4, 0x26, 0x07, 0x07, 0x53, 0x98, 0xf1, 0x79, 0x21, 0xf3, 0x25, 0xf3, 0x29,
-	0x39, 0x6e, 0x10, 0x56, 0x47, 0xf6, 0x85, 0x74, 0xda, 0x0a, 0x14, 0xf8, 0x8c, 0xd7, 0xa7, 0xd0,
-	0xe8, 0x9c, 0x2a, 0x00, 0x35, 0x93, 0x5c, 0x98, 0x94, 0x0b, 0xad, 0xc1, 0x09, 0x97, 0x82, 0xb6,
-	0x4d, 0x77, 0x30, 0x05, 0x9b, 0x81, 0xe5, 0x89, 0xb0, 0x32, 0xdc, 0xc1, 0x17, 0xc3, 0x44, 0x3a,
-	0x31, 0xe4, 0x46, 0xa8, 0x54, 0x7b, 0xb8, 0x61, 0xe3, 0xdd, 0x9b, 0x19, 0x91, 0x8b, 0xac, 0xf1,
-	0xc5, 0x6d, 0x4c, 0x6e, 0x6b, 0xcb, 0x8d, 0x2f, 0x4e, 0xe4, 0xbc, 0x90, 0xd6, 0xc5, 0x13, 0x7c,
-	0xb4, 0x55, 0xb5, 0x06, 0xb4, 0x95, 0xe4, 0x1a, 0xb7, 0xc2, 0xf0, 0x09, 0x3a, 0x43, 0xfd, 0xc3,
-	0x51, 0x97, 0xed, 0x7c, 0x18, 0x0b, 0x63, 0xe3, 0xfd, 0xf5, 0x5b, 0x2f, 0x9a, 0x34, 0x23, 0xa3,
-	0x15, 0xc2, 0x07, 0x5e, 0x4a, 0x9e, 0x10, 0x6e, 0x05, 0x84, 0x5c, 0xfe, 0x62, 0xf8, 0xb9, 0x53,
-	0x67, 0xf0, 0x1f, 0x34, 0x2c, 0x1a, 0x5f, 0x3c, 0xbe, 0x7c, 0x3c, 0xef, 0xf5, 0x48, 0x97, 0xff,
-	0xf5, 0x05, 0xe3, 0xf1, 0xba, 0xa4, 0x68, 0x53, 0x52, 0xf4, 0x5e, 0x52, 0xb4, 0xaa, 0x68, 0xb4,
-	0xa9, 0x68, 0xf4, 0x5a, 0xd1, 0xe8, 0xae, 0xaf, 0x52, 0x77, 0x5f, 0x24, 0x6c, 0x0a, 0xd9, 0xb6,
-	0xe2, 0xe1, 0x5b, 0xe2, 0x96, 0x46, 0xda, 0xa4, 0xe5, 0xf3, 0xab, 0xcf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xc6, 0x96, 0xf2, 0x1e, 0x07, 0x02, 0x00, 0x00,
+	// 483 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x31, 0x6f, 0xd4, 0x30,
+	0x14, 0xc7, 0xe3, 0x16, 0xae, 0x60, 0x7a, 0x0c, 0xa6, 0x48, 0xd7, 0x40, 0x53, 0x08, 0xaa, 0x68,
+	0x2b, 0x11, 0x93, 0x22, 0xc4, 0x80, 0xc4, 0x70, 0x4c, 0x2c, 0xa8, 0x64, 0x60, 0x60, 0x41, 0x4e,
+	0x6a, 0xa5, 0x91, 0xee, 0xec, 0x34, 0xf6, 0x9d, 0x38, 0x21, 0x16, 0x06, 0x66, 0x24, 0x3e, 0x02,
+	0x1b, 0x7c, 0x91, 0x1b, 0x4f, 0x62, 0x61, 0x42, 0xe8, 0xc2, 0x07, 0x41, 0xb1, 0x9d, 0x23, 0x11,
+	0xb9, 0x53, 0x6e, 0x72, 0x9e, 0xfd, 0xfe, 0xff, 0xf7, 0xf3, 0xf3, 0x0b, 0xbc, 0x3b, 0x20, 0x63,
+	0xc2, 0xa8, 0xc4, 0xc5, 0x8a, 0xd3, 0x8c, 0x4b, 0x1e, 0xf1, 0x01, 0xbe, 0x18, 0xd1, 0x6c, 0xe2,
+	0xa9, 0x10, 0xdd, 0x34, 0x29, 0x5e, 0xb1, 0x7a, 0x65, 0x8a, 0xbd, 0x13, 0xf3, 0x98, 0xab, 0x08,
+	0x17, 0x5f, 0xfa, 0xc0, 0xbe, 0x1d, 0x73, 0x1e, 0x0f, 0x28, 0x26, 0x69, 0x82, 0x09, 0x63, 0x5c,
+	0x12, 0x99, 0x70, 0x26, 0xcc, 0xe9, 0x71, 0xc4, 0xc5, 0x90, 0x0b, 0x1c, 0x12, 0x41, 0x75, 0x0d,
+	0x3c, 0xf6, 0x43, 0x2a, 0x89, 0x8f, 0x53, 0x12, 0x27, 0x4c, 0x25, 0x9b, 0x5c, 0xb7, 0x99, 0x2c,
+	0x25, 0x19, 0x19, 0x96, 0x7e, 0x47, 0xcd, 0x39, 0xd1, 0x39, 0x49, 0xd8, 0xdb, 0x31, 0xcd, 0xc4,
+	0xc2, 0xce, 0xdd, 0x81, 0xe8, 0x55, 0x51, 0xf0, 0x54, 0xe9, 0x03, 0x7a, 0x31, 0xa2, 0x42, 0xba,
+	0x01, 0xbc, 0x51, 0xdb, 0x15, 0x29, 0x67, 0x82, 0xa2, 0xa7, 0xb0, 0xa3, 0xeb, 0xf4, 0xc0, 0x1d,
+	0x70, 0x78, 0xed, 0x64, 0xcf, 0x6b, 0xec, 0x81, 0xa7, 0x65, 0xfd, 0x4b, 0xd3, 0x5f, 0xfb, 0x56,
+	0x60, 0x24, 0xee, 0x63, 0xd8, 0x53, 0x9e, 0xcf, 0x0b, 0x8a, 0xd7, 0x1a, 0xc2, 0xd4, 0x43, 0xbb,
+	0xf0, 0x8a, 0x86, 0x4b, 0xce, 0x94, 0xf5, 0xd5, 0x60, 0x4b, 0xc5, 0x2f, 0xce, 0xdc, 0xef, 0x00,
+	0xee, 0x36, 0xe8, 0x0c, 0xd1, 0x33, 0xb8, 0x65, 0xee, 0x63, 0x90, 0x9c, 0x25, 0x48, 0x46, 0x68,
+	0x98, 0x4a, 0x11, 0x7a, 0x09, 0xbb, 0xb5, 0xae, 0xf4, 0x36, 0x94, 0xcb, 0xbd, 0x25, 0x2e, 0x55,
+	0x06, 0x63, 0xb5, 0x1d, 0x55, 0xf6, 0xdc, 0x5b, 0x0d, 0xb0, 0x8b, 0xae, 0x32, 0x68, 0x37, 0x1d,
+	0x9a, 0xab, 0x9c, 0xc2, 0xeb, 0x35, 0x94, 0xa2, 0xc9, 0x9b, 0xeb, 0xb1, 0x74, 0xab, 0x2c, 0xe2,
+	0x24, 0xdf, 0x84, 0x97, 0x55, 0x41, 0xf4, 0x09, 0xc0, 0x8e, 0x7e, 0x14, 0x74, 0xb4, 0xc4, 0xee,
+	0xff, 0x29, 0xb0, 0x8f, 0xdb, 0xa4, 0x6a, 0x7a, 0xf7, 0xe0, 0xe3, 0x8f, 0x3f, 0x5f, 0x36, 0xf6,
+	0xd1, 0x1e, 0x5e, 0x35, 0x9f, 0xe8, 0x1b, 0x80, 0xdb, 0x55, 0x70, 0x84, 0x57, 0xd5, 0x68, 0x18,
+	0x15, 0xfb, 0x61, 0x7b, 0x81, 0x41, 0x7b, 0xa2, 0xd0, 0x7c, 0x84, 0x71, 0x8b, 0xdf, 0x02, 0xbf,
+	0x2f, 0x07, 0xf1, 0x03, 0xfa, 0x0a, 0x60, 0xb7, 0xf6, 0x56, 0xa8, 0x75, 0xf1, 0x45, 0x0f, 0xfd,
+	0x35, 0x14, 0x86, 0xf7, 0x81, 0xe2, 0xbd, 0x8f, 0x0e, 0xda, 0xf0, 0x8a, 0x7e, 0x7f, 0x3a, 0x77,
+	0xc0, 0x6c, 0xee, 0x80, 0xdf, 0x73, 0x07, 0x7c, 0xce, 0x1d, 0x6b, 0x96, 0x3b, 0xd6, 0xcf, 0xdc,
+	0xb1, 0xde, 0x1c, 0xc6, 0x89, 0x3c, 0x1f, 0x85, 0x5e, 0xc4, 0x87, 0x75, 0xab, 0x77, 0xff, 0xcc,
+	0xe4, 0x24, 0xa5, 0x22, 0xec, 0xa8, 0xf8, 0xd1, 0xdf, 0x01, 0x00, 0x2d, 0xbb, 0xea, 0xe9, 0xf7,
+	0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -157,6 +355,10 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ChainVersion queries the effective protocol version of a chain.
+	ChainVersion(ctx context.Context, in *QueryChainVersionRequest, opts ...grpc.CallOption) (*QueryChainVersionResponse, error)
+	// ChainVersions queries all the chains' minimum protocol versions.
+	ChainVersions(ctx context.Context, in *QueryChainVersionsRequest, opts ...grpc.CallOption) (*QueryChainVersionsResponse, error)
 }
 
 type queryClient struct {
@@ -176,10 +378,32 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ChainVersion(ctx context.Context, in *QueryChainVersionRequest, opts ...grpc.CallOption) (*QueryChainVersionResponse, error) {
+	out := new(QueryChainVersionResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.protocol.Query/ChainVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChainVersions(ctx context.Context, in *QueryChainVersionsRequest, opts ...grpc.CallOption) (*QueryChainVersionsResponse, error) {
+	out := new(QueryChainVersionsResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.protocol.Query/ChainVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ChainVersion queries the effective protocol version of a chain.
+	ChainVersion(context.Context, *QueryChainVersionRequest) (*QueryChainVersionResponse, error)
+	// ChainVersions queries all the chains' minimum protocol versions.
+	ChainVersions(context.Context, *QueryChainVersionsRequest) (*QueryChainVersionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -189,6 +413,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) ChainVersion(ctx context.Context, req *QueryChainVersionRequest) (*QueryChainVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainVersion not implemented")
+}
+func (*UnimplementedQueryServer) ChainVersions(ctx context.Context, req *QueryChainVersionsRequest) (*QueryChainVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainVersions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -212,6 +442,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ChainVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChainVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.protocol.Query/ChainVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainVersion(ctx, req.(*QueryChainVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChainVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChainVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.protocol.Query/ChainVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainVersions(ctx, req.(*QueryChainVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.protocol.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -220,6 +486,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ChainVersion",
+			Handler:    _Query_ChainVersion_Handler,
+		},
+		{
+			MethodName: "ChainVersions",
+			Handler:    _Query_ChainVersions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/protocol/query.proto",
@@ -281,6 +555,139 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryChainVersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainVersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ChainVersion.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainVersions) > 0 {
+		for iNdEx := len(m.ChainVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -312,8 +719,58 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryChainVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChainVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Version.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ChainVersion.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryChainVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryChainVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChainVersions) > 0 {
+		for _, e := range m.ChainVersions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -451,6 +908,338 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryChainVersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainVersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainVersion", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainVersion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainVersions = append(m.ChainVersions, ChainVersion{})
+			if err := m.ChainVersions[len(m.ChainVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -116,30 +117,127 @@ func (m *MsgSetVersionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetVersionResponse proto.InternalMessageInfo
 
+// MsgSetChainVersion sets the minimum protocol versions of a chain. Empty minimum
+// versions remove the chain's override (the chain uses the global version).
+type MsgSetChainVersion struct {
+	Authority    string       `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ChainVersion ChainVersion `protobuf:"bytes,2,opt,name=chain_version,json=chainVersion,proto3" json:"chain_version"`
+}
+
+func (m *MsgSetChainVersion) Reset()         { *m = MsgSetChainVersion{} }
+func (m *MsgSetChainVersion) String() string { return proto.CompactTextString(m) }
+func (*MsgSetChainVersion) ProtoMessage()    {}
+func (*MsgSetChainVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96fb5a36c35e2c61, []int{2}
+}
+func (m *MsgSetChainVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetChainVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetChainVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetChainVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetChainVersion.Merge(m, src)
+}
+func (m *MsgSetChainVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetChainVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetChainVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetChainVersion proto.InternalMessageInfo
+
+func (m *MsgSetChainVersion) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetChainVersion) GetChainVersion() ChainVersion {
+	if m != nil {
+		return m.ChainVersion
+	}
+	return ChainVersion{}
+}
+
+type MsgSetChainVersionResponse struct {
+}
+
+func (m *MsgSetChainVersionResponse) Reset()         { *m = MsgSetChainVersionResponse{} }
+func (m *MsgSetChainVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetChainVersionResponse) ProtoMessage()    {}
+func (*MsgSetChainVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96fb5a36c35e2c61, []int{3}
+}
+func (m *MsgSetChainVersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetChainVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetChainVersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetChainVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetChainVersionResponse.Merge(m, src)
+}
+func (m *MsgSetChainVersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetChainVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetChainVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetChainVersionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetVersion)(nil), "lavanet.lava.protocol.MsgSetVersion")
 	proto.RegisterType((*MsgSetVersionResponse)(nil), "lavanet.lava.protocol.MsgSetVersionResponse")
+	proto.RegisterType((*MsgSetChainVersion)(nil), "lavanet.lava.protocol.MsgSetChainVersion")
+	proto.RegisterType((*MsgSetChainVersionResponse)(nil), "lavanet.lava.protocol.MsgSetChainVersionResponse")
 }
 
 func init() { proto.RegisterFile("lavanet/lava/protocol/tx.proto", fileDescriptor_96fb5a36c35e2c61) }
 
 var fileDescriptor_96fb5a36c35e2c61 = []byte{
-	// 230 bytes of a gzipped FileDescriptorProto
+	// 319 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0x49, 0x2c, 0x4b,
 	0xcc, 0x4b, 0x2d, 0xd1, 0x07, 0xd1, 0xfa, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0xc9, 0xf9, 0x39, 0xfa,
 	0x25, 0x15, 0x7a, 0x60, 0xb6, 0x90, 0x28, 0x54, 0x5e, 0x0f, 0x44, 0xeb, 0xc1, 0xe4, 0xa5, 0x94,
-	0xb0, 0x6b, 0x2b, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x86, 0x28, 0x53, 0x4a, 0xe7, 0xe2, 0xf5, 0x2d,
-	0x4e, 0x0f, 0x4e, 0x2d, 0x09, 0x4b, 0x2d, 0x2a, 0xce, 0xcc, 0xcf, 0x13, 0x92, 0xe1, 0xe2, 0x4c,
-	0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca, 0x2c, 0xa9, 0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x42,
-	0x08, 0x08, 0x59, 0x70, 0xb1, 0x97, 0x41, 0x14, 0x4a, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x1b, 0xc9,
-	0xe9, 0x61, 0xb5, 0x5b, 0x0f, 0x6a, 0x5c, 0x10, 0x4c, 0xb9, 0x92, 0x38, 0x97, 0x28, 0x8a, 0x45,
-	0x41, 0xa9, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x46, 0xe9, 0x5c, 0xcc, 0xbe, 0xc5, 0xe9, 0x42,
-	0x09, 0x5c, 0x5c, 0x48, 0xae, 0x50, 0xc1, 0x61, 0x2c, 0x8a, 0x11, 0x52, 0x3a, 0xc4, 0xa8, 0x82,
-	0x59, 0xe4, 0xe4, 0x74, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31,
-	0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x1a, 0xe9,
-	0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x28, 0x61, 0x56, 0x81, 0x14, 0xd8,
-	0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xbe, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x81, 0x81,
-	0x84, 0xec, 0x92, 0x01, 0x00, 0x00,
+	0xb0, 0x6b, 0x2b, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x86, 0x28, 0x93, 0xd2, 0xc4, 0xae, 0x26, 0x39,
+	0x23, 0x31, 0x33, 0x2f, 0xbe, 0x2c, 0xb5, 0xa8, 0x38, 0x33, 0x3f, 0x0f, 0xaa, 0x54, 0x24, 0x3d,
+	0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1, 0x20, 0xa2, 0x4a, 0xe9, 0x5c, 0xbc, 0xbe, 0xc5, 0xe9,
+	0xc1, 0xa9, 0x25, 0x61, 0x10, 0xc5, 0x42, 0x32, 0x5c, 0x9c, 0x89, 0xa5, 0x25, 0x19, 0xf9, 0x45,
+	0x99, 0x25, 0x95, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x08, 0x01, 0x21, 0x0b, 0x2e, 0x76,
+	0xa8, 0xa9, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x72, 0x7a, 0x58, 0x1d, 0xaf, 0x07, 0x35,
+	0x2e, 0x08, 0xa6, 0x5c, 0x49, 0x9c, 0x4b, 0x14, 0xc5, 0xa2, 0xa0, 0xd4, 0xe2, 0x82, 0xfc, 0xbc,
+	0xe2, 0x54, 0xa5, 0x26, 0x46, 0x2e, 0x21, 0x88, 0x8c, 0x33, 0xc8, 0xd5, 0xc4, 0xb9, 0xc3, 0x8f,
+	0x8b, 0x17, 0xc5, 0x8f, 0x50, 0xd7, 0x28, 0xe3, 0x70, 0x0d, 0xb2, 0xc9, 0x4e, 0x2c, 0x27, 0xee,
+	0xc9, 0x33, 0x04, 0xf1, 0x24, 0x23, 0x89, 0x29, 0xc9, 0x70, 0x49, 0x61, 0xba, 0x01, 0xe6, 0x44,
+	0xa3, 0x1b, 0x8c, 0x5c, 0xcc, 0xbe, 0xc5, 0xe9, 0x42, 0x09, 0x5c, 0x5c, 0x48, 0x21, 0xa5, 0x82,
+	0xc3, 0x32, 0x14, 0x6f, 0x4a, 0xe9, 0x10, 0xa3, 0x0a, 0x66, 0x93, 0x50, 0x3e, 0x17, 0x3f, 0x7a,
+	0x40, 0x68, 0xe2, 0x35, 0x00, 0x59, 0xa9, 0x94, 0x21, 0xd1, 0x4a, 0x61, 0x16, 0x3a, 0x39, 0x9d,
+	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
+	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x46, 0x7a, 0x66, 0x49, 0x46, 0x69,
+	0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x4a, 0x2a, 0xab, 0x40, 0x4a, 0xc2, 0x95, 0x05, 0xa9, 0xc5,
+	0x49, 0x6c, 0x60, 0xbe, 0x31, 0x60, 0x00, 0x48, 0x02, 0x2d, 0x9f, 0xe8, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -155,6 +253,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	SetVersion(ctx context.Context, in *MsgSetVersion, opts ...grpc.CallOption) (*MsgSetVersionResponse, error)
+	SetChainVersion(ctx context.Context, in *MsgSetChainVersion, opts ...grpc.CallOption) (*MsgSetChainVersionResponse, error)
 }
 
 type msgClient struct {
@@ -174,9 +273,19 @@ func (c *msgClient) SetVersion(ctx context.Context, in *MsgSetVersion, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) SetChainVersion(ctx context.Context, in *MsgSetChainVersion, opts ...grpc.CallOption) (*MsgSetChainVersionResponse, error) {
+	out := new(MsgSetChainVersionResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.protocol.Msg/SetChainVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	SetVersion(context.Context, *MsgSetVersion) (*MsgSetVersionResponse, error)
+	SetChainVersion(context.Context, *MsgSetChainVersion) (*MsgSetChainVersionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -186,6 +295,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) SetVersion(ctx context.Context, req *MsgSetVersion) (*MsgSetVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetVersion not implemented")
 }
+func (*UnimplementedMsgServer) SetChainVersion(ctx context.Context, req *MsgSetChainVersion) (*MsgSetChainVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChainVersion not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -209,6 +321,24 @@ func _Msg_SetVersion_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetChainVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetChainVersion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetChainVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.protocol.Msg/SetChainVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetChainVersion(ctx, req.(*MsgSetChainVersion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.protocol.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -217,6 +347,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetVersion",
 			Handler:    _Msg_SetVersion_Handler,
 		},
+		{
+			MethodName: "SetChainVersion",
+			Handler:    _Msg_SetChainVersion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/protocol/tx.proto",
@@ -287,6 +421,69 @@ func (m *MsgSetVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetChainVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetChainVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetChainVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ChainVersion.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetChainVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetChainVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetChainVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -324,6 +521,30 @@ func (m *MsgSetVersionResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetChainVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ChainVersion.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetChainVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -498,6 +719,171 @@ func (m *MsgSetVersionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetChainVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetChainVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetChainVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainVersion", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainVersion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetChainVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetChainVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetChainVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
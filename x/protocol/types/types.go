@@ -1 +1,5 @@
 package types
+
+const (
+	SetChainVersionEventName = "set_chain_version"
+)
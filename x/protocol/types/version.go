@@ -1,6 +1,9 @@
 package types
 
-import fmt "fmt"
+import (
+	fmt "fmt"
+	"strings"
+)
 
 // validateVersion validates the Version param
 func (v Version) validateVersion() error {
@@ -43,3 +46,78 @@ func (v Version) validateVersion() error {
 
 	return nil
 }
+
+// Validate validates the chain's minimum versions. Empty minimum versions are allowed
+// (the global minimum is used for them)
+func (cv ChainVersion) Validate() error {
+	if strings.TrimSpace(cv.ChainId) == "" {
+		return fmt.Errorf("empty chain ID")
+	}
+	if cv.ProviderMin != "" {
+		if _, err := versionToInteger(cv.ProviderMin); err != nil {
+			return fmt.Errorf("provider min version: %w", err)
+		}
+	}
+	if cv.ConsumerMin != "" {
+		if _, err := versionToInteger(cv.ConsumerMin); err != nil {
+			return fmt.Errorf("consumer min version: %w", err)
+		}
+	}
+	return nil
+}
+
+// IsEmpty returns true if the chain version doesn't override any of the global minimum versions
+func (cv ChainVersion) IsEmpty() bool {
+	return cv.ProviderMin == "" && cv.ConsumerMin == ""
+}
+
+// ApplyChainVersion returns the version with the chain's minimum versions applied. If a chain's
+// minimum version is above the target version, the target version is raised to it
+func (v Version) ApplyChainVersion(cv ChainVersion) Version {
+	if cv.ProviderMin != "" {
+		v.ProviderMin = cv.ProviderMin
+		if IsVersionLower(v.ProviderTarget, v.ProviderMin) {
+			v.ProviderTarget = v.ProviderMin
+		}
+	}
+	if cv.ConsumerMin != "" {
+		v.ConsumerMin = cv.ConsumerMin
+		if IsVersionLower(v.ConsumerTarget, v.ConsumerMin) {
+			v.ConsumerTarget = v.ConsumerMin
+		}
+	}
+	return v
+}
+
+// IsVersionLower returns true if version is lower than minVersion. A version
+// that can't be parsed is considered lower. An empty (or invalid) minVersion
+// is not enforced
+func IsVersionLower(version string, minVersion string) bool {
+	minVersionInt, err := versionToInteger(minVersion)
+	if err != nil {
+		return false
+	}
+	versionInt, err := versionToInteger(version)
+	if err != nil {
+		return true
+	}
+	return versionInt < minVersionInt
+}
+
+// Max returns a version with the higher of each of the two versions' fields. It combines the
+// versions of all the chains served by a single process
+func (v Version) Max(other Version) Version {
+	if IsVersionLower(v.ProviderTarget, other.ProviderTarget) {
+		v.ProviderTarget = other.ProviderTarget
+	}
+	if IsVersionLower(v.ProviderMin, other.ProviderMin) {
+		v.ProviderMin = other.ProviderMin
+	}
+	if IsVersionLower(v.ConsumerTarget, other.ConsumerTarget) {
+		v.ConsumerTarget = other.ConsumerTarget
+	}
+	if IsVersionLower(v.ConsumerMin, other.ConsumerMin) {
+		v.ConsumerMin = other.ConsumerMin
+	}
+	return v
+}
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/rewards/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdQueryIprpcFunderSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "iprpc-funder-schedule [funder] {spec}",
+		Short: "Query for a funder's remaining IPRPC funds per spec and month. If no spec is given, the funds of all specs will be shown",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			funder := args[0]
+			var spec string
+			if len(args) > 1 {
+				spec = args[1]
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryIprpcFunderScheduleRequest{
+				Funder: funder,
+				Spec:   spec,
+			}
+
+			res, err := queryClient.IprpcFunderSchedule(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/rewards/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdWithdrawIprpcFund() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "withdraw-iprpc-fund [spec] --from <creator>",
+		Short: `withdraw the creator's IPRPC funds of a specific spec for the months that have not started yet.
+		A part of the funds (iprpc_withdraw_penalty param) is sent to the community pool, and the min_iprpc_cost that was paid is not refunded`,
+		Example: `lavad tx rewards withdraw-iprpc-fund ETH1 --from alice`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawIprpcFund(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -23,6 +23,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetIprpcReward(ctx, iprpcReward)
 	}
 	k.SetIprpcRewardsCurrentId(ctx, genState.IprpcRewardsCurrent)
+	for _, iprpcFund := range genState.IprpcFunds {
+		k.SetIprpcFund(ctx, iprpcFund)
+	}
 	k.SetIprpcData(ctx, genState.MinIprpcCost, genState.IprpcSubscriptions)
 }
 
@@ -36,6 +39,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.MinIprpcCost = k.GetMinIprpcCost(ctx)
 	genesis.IprpcRewards = k.GetAllIprpcReward(ctx)
 	genesis.IprpcRewardsCurrent = k.GetIprpcRewardsCurrentId(ctx)
+	genesis.IprpcFunds = k.GetAllIprpcFund(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
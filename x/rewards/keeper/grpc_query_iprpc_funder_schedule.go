@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/rewards/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) IprpcFunderSchedule(goCtx context.Context, req *types.QueryIprpcFunderScheduleRequest) (*types.QueryIprpcFunderScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Funder); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid funder address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	currentMonthId := k.GetIprpcRewardsCurrentId(ctx)
+	funds := k.GetFunderIprpcFunds(ctx, req.Funder, req.Spec, currentMonthId)
+	if funds == nil {
+		funds = []types.IprpcFund{}
+	}
+
+	return &types.QueryIprpcFunderScheduleResponse{Funds: funds, CurrentMonthId: currentMonthId}, nil
+}
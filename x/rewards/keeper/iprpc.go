@@ -56,10 +56,111 @@ func (k Keeper) FundIprpc(ctx sdk.Context, creator string, duration uint64, fund
 
 	// add spec funds to next month IPRPC reward object
 	k.addSpecFunds(ctx, spec, fund, duration, true)
+	k.addIprpcFunderFunds(ctx, creator, spec, fund, duration)
 
 	return nil
 }
 
+// WithdrawIprpcFund refunds a funder's IPRPC funds of a spec for the months that have not started yet.
+// A part of the refund (according to the IprpcWithdrawPenalty param) is sent to the community pool.
+// Note that the min IPRPC cost that was paid to the validators is not refunded
+func (k Keeper) WithdrawIprpcFund(ctx sdk.Context, creator string, spec string) (refund sdk.Coins, penalty sdk.Coins, err error) {
+	addr, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		return nil, nil, utils.LavaFormatWarning("invalid creator address", types.ErrWithdrawIprpcFund,
+			utils.LogAttr("creator", creator),
+		)
+	}
+
+	// the current month's funds are already in use, so only later months are withdrawn
+	iprpcFunds := k.GetFunderIprpcFunds(ctx, creator, spec, k.GetIprpcRewardsCurrentId(ctx)+1)
+	if len(iprpcFunds) == 0 {
+		return nil, nil, utils.LavaFormatWarning("no iprpc funds to withdraw", types.ErrWithdrawIprpcFund,
+			utils.LogAttr("creator", creator),
+			utils.LogAttr("spec", spec),
+		)
+	}
+
+	total := sdk.NewCoins()
+	for _, iprpcFund := range iprpcFunds {
+		err = k.removeSpecFunds(ctx, iprpcFund.MonthId, spec, iprpcFund.Fund)
+		if err != nil {
+			return nil, nil, err
+		}
+		k.RemoveIprpcFund(ctx, iprpcFund.MonthId, creator, spec)
+		total = total.Add(iprpcFund.Fund...)
+	}
+
+	penalty, _ = sdk.NewDecCoinsFromCoins(total...).MulDecTruncate(k.IprpcWithdrawPenalty(ctx)).TruncateDecimal()
+	refund = total.Sub(penalty...)
+
+	if !refund.IsZero() {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, string(types.IprpcPoolName), addr, refund)
+		if err != nil {
+			return nil, nil, utils.LavaFormatError("failed to refund iprpc funds", err,
+				utils.LogAttr("creator", creator),
+				utils.LogAttr("refund", refund.String()),
+			)
+		}
+	}
+
+	if !penalty.IsZero() {
+		err = k.FundCommunityPoolFromModule(ctx, penalty, string(types.IprpcPoolName))
+		if err != nil {
+			return nil, nil, utils.LavaFormatError("failed to send iprpc withdraw penalty to community pool", err,
+				utils.LogAttr("creator", creator),
+				utils.LogAttr("penalty", penalty.String()),
+			)
+		}
+	}
+
+	return refund, penalty, nil
+}
+
+// removeSpecFunds subtracts funds of a specific spec from a month's IPRPC reward object
+func (k Keeper) removeSpecFunds(ctx sdk.Context, monthId uint64, spec string, fund sdk.Coins) error {
+	iprpcReward, found := k.GetIprpcReward(ctx, monthId)
+	if !found {
+		return utils.LavaFormatError("iprpc reward not found", types.ErrWithdrawIprpcFund,
+			utils.LogAttr("month_id", monthId),
+		)
+	}
+
+	for i, specFund := range iprpcReward.SpecFunds {
+		if specFund.Spec != spec {
+			continue
+		}
+
+		remaining, anyNeg := specFund.Fund.SafeSub(fund...)
+		if anyNeg {
+			return utils.LavaFormatError("iprpc spec fund is lower than the withdrawn fund", types.ErrWithdrawIprpcFund,
+				utils.LogAttr("month_id", monthId),
+				utils.LogAttr("spec", spec),
+				utils.LogAttr("spec_fund", specFund.Fund.String()),
+				utils.LogAttr("fund", fund.String()),
+			)
+		}
+
+		if remaining.IsZero() {
+			iprpcReward.SpecFunds = append(iprpcReward.SpecFunds[:i], iprpcReward.SpecFunds[i+1:]...)
+		} else {
+			iprpcReward.SpecFunds[i].Fund = remaining
+		}
+
+		if len(iprpcReward.SpecFunds) == 0 {
+			k.RemoveIprpcReward(ctx, monthId)
+		} else {
+			k.SetIprpcReward(ctx, iprpcReward)
+		}
+		return nil
+	}
+
+	return utils.LavaFormatError("iprpc spec fund not found", types.ErrWithdrawIprpcFund,
+		utils.LogAttr("month_id", monthId),
+		utils.LogAttr("spec", spec),
+	)
+}
+
 // handleNoIprpcRewardToProviders handles the situation in which there are no providers to send IPRPC rewards to
 // so the IPRPC rewards transfer to the next month
 func (k Keeper) handleNoIprpcRewardToProviders(ctx sdk.Context, iprpcFunds []types.Specfund) {
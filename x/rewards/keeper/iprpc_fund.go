@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/rewards/types"
+)
+
+// IprpcFund objects keep the funds of each funder per spec and month, so funders can see
+// their fund schedule and withdraw the funds of months that have not started yet.
+// The key starts with the month ID, so the funds of a month can be removed once it's distributed.
+// An index keyed by funder, spec and month ID (with empty values) is kept alongside, so a
+// funder's funds are found without scanning the funds of all funders
+
+func (k Keeper) iprpcFundStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IprpcFundPrefix))
+}
+
+func (k Keeper) iprpcFunderIndexStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IprpcFunderIndexPrefix))
+}
+
+// GetIprpcFundKey returns the key of a funder's IprpcFund for a spec in a specific month
+func GetIprpcFundKey(monthId uint64, funder string, spec string) []byte {
+	return append(GetIprpcRewardIDBytes(monthId), []byte(funder+"/"+spec)...)
+}
+
+// getIprpcFunderIndexPrefix returns the index prefix of a funder's funds (of a specific spec, if not empty)
+func getIprpcFunderIndexPrefix(funder string, spec string) []byte {
+	if spec == "" {
+		return []byte(funder + "/")
+	}
+	return []byte(funder + "/" + spec + "/")
+}
+
+// GetIprpcFunderIndexKey returns the index key of a funder's IprpcFund for a spec in a specific month
+func GetIprpcFunderIndexKey(funder string, spec string, monthId uint64) []byte {
+	return append(getIprpcFunderIndexPrefix(funder, spec), GetIprpcRewardIDBytes(monthId)...)
+}
+
+// SetIprpcFund set a specific IprpcFund in the store
+func (k Keeper) SetIprpcFund(ctx sdk.Context, iprpcFund types.IprpcFund) {
+	b := k.cdc.MustMarshal(&iprpcFund)
+	k.iprpcFundStore(ctx).Set(GetIprpcFundKey(iprpcFund.MonthId, iprpcFund.Funder, iprpcFund.Spec), b)
+	k.iprpcFunderIndexStore(ctx).Set(GetIprpcFunderIndexKey(iprpcFund.Funder, iprpcFund.Spec, iprpcFund.MonthId), []byte{})
+}
+
+// GetIprpcFund returns a funder's IprpcFund for a spec in a specific month
+func (k Keeper) GetIprpcFund(ctx sdk.Context, monthId uint64, funder string, spec string) (val types.IprpcFund, found bool) {
+	b := k.iprpcFundStore(ctx).Get(GetIprpcFundKey(monthId, funder, spec))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveIprpcFund removes a IprpcFund from the store
+func (k Keeper) RemoveIprpcFund(ctx sdk.Context, monthId uint64, funder string, spec string) {
+	k.iprpcFundStore(ctx).Delete(GetIprpcFundKey(monthId, funder, spec))
+	k.iprpcFunderIndexStore(ctx).Delete(GetIprpcFunderIndexKey(funder, spec, monthId))
+}
+
+// GetAllIprpcFund returns all IprpcFund
+func (k Keeper) GetAllIprpcFund(ctx sdk.Context) (list []types.IprpcFund) {
+	return k.getIprpcFundsFromMonth(ctx, 0)
+}
+
+// getIprpcFundsFromMonth returns all the IprpcFund objects of the given month and the months after it
+func (k Keeper) getIprpcFundsFromMonth(ctx sdk.Context, monthId uint64) (list []types.IprpcFund) {
+	iterator := k.iprpcFundStore(ctx).Iterator(GetIprpcRewardIDBytes(monthId), nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.IprpcFund
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetFunderIprpcFunds returns a funder's IprpcFund objects from the given month onwards.
+// If spec is empty, the funds of all specs are returned
+func (k Keeper) GetFunderIprpcFunds(ctx sdk.Context, funder string, spec string, fromMonthId uint64) (list []types.IprpcFund) {
+	iterator := sdk.KVStorePrefixIterator(k.iprpcFunderIndexStore(ctx), getIprpcFunderIndexPrefix(funder, spec))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// the index key is <funder>/<spec>/<month ID>
+		key := iterator.Key()
+		monthId := GetIprpcRewardIDFromBytes(key[len(key)-8:])
+		if monthId < fromMonthId {
+			continue
+		}
+		fundSpec := string(key[len(funder)+1 : len(key)-9])
+		iprpcFund, found := k.GetIprpcFund(ctx, monthId, funder, fundSpec)
+		if found {
+			list = append(list, iprpcFund)
+		}
+	}
+
+	return list
+}
+
+// addIprpcFunderFunds records a funder's fund for a spec for <duration> months, starting next month
+func (k Keeper) addIprpcFunderFunds(ctx sdk.Context, funder string, spec string, fund sdk.Coins, duration uint64) {
+	startID := k.GetIprpcRewardsCurrentId(ctx) + 1
+	for i := startID; i < startID+duration; i++ {
+		iprpcFund, found := k.GetIprpcFund(ctx, i, funder, spec)
+		if found {
+			iprpcFund.Fund = iprpcFund.Fund.Add(fund...)
+		} else {
+			iprpcFund = types.IprpcFund{Funder: funder, Spec: spec, MonthId: i, Fund: fund}
+		}
+		k.SetIprpcFund(ctx, iprpcFund)
+	}
+}
+
+// removeMonthIprpcFunds removes all the IprpcFund objects of a specific month
+func (k Keeper) removeMonthIprpcFunds(ctx sdk.Context, monthId uint64) {
+	store := prefix.NewStore(k.iprpcFundStore(ctx), GetIprpcRewardIDBytes(monthId))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	funds := []types.IprpcFund{}
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.IprpcFund
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		funds = append(funds, val)
+	}
+	iterator.Close()
+
+	for _, fund := range funds {
+		k.RemoveIprpcFund(ctx, monthId, fund.Funder, fund.Spec)
+	}
+}
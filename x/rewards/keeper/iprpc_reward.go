@@ -84,10 +84,11 @@ func GetIprpcRewardIDFromBytes(bz []byte) uint64 {
 	return binary.BigEndian.Uint64(bz)
 }
 
-// PopIprpcReward gets the lowest id IprpcReward object and removes it
+// PopIprpcReward gets the lowest id IprpcReward object and removes it (along with its funders' records)
 func (k Keeper) PopIprpcReward(ctx sdk.Context) (types.IprpcReward, bool) {
 	current := k.GetIprpcRewardsCurrentId(ctx)
 	k.SetIprpcRewardsCurrentId(ctx, current+1)
+	k.removeMonthIprpcFunds(ctx, current)
 	defer k.RemoveIprpcReward(ctx, current)
 	return k.GetIprpcReward(ctx, current)
 }
@@ -715,3 +715,110 @@ func TestMinIprpcCostForSeveralMonths(t *testing.T) {
 	_, err = ts.TxRewardsFundIprpc(consumer, mockSpec2, 2, iprpcFunds.MulInt(math.NewInt(110)).QuoInt(math.NewInt(100)))
 	require.NoError(ts.T, err)
 }
+
+// TestIprpcFunderSchedule tests that the funder schedule query shows the funder's funds per spec and month
+// from the current month onwards, and that funds of past months are removed
+func TestIprpcFunderSchedule(t *testing.T) {
+	ts := newTester(t, true)
+	ts.setupForIprpcTests(false)
+	consumerAcc, consumer := ts.GetAccount(common.CONSUMER, 0)
+
+	// fund the iprpc pool for 3 months on mockSpec2 and for 1 month on mock
+	err := ts.Keepers.BankKeeper.AddToBalance(consumerAcc.Addr, iprpcFunds.MulInt(math.NewInt(4)))
+	require.NoError(t, err)
+	_, err = ts.TxRewardsFundIprpc(consumer, mockSpec2, 3, iprpcFunds)
+	require.NoError(t, err)
+	_, err = ts.TxRewardsFundIprpc(consumer, ts.specs[0].Index, 1, iprpcFunds)
+	require.NoError(t, err)
+
+	// the funds start from the next month
+	res, err := ts.QueryRewardsIprpcFunderSchedule(consumer, "")
+	require.NoError(t, err)
+	require.Len(t, res.Funds, 4)
+	res, err = ts.QueryRewardsIprpcFunderSchedule(consumer, mockSpec2)
+	require.NoError(t, err)
+	require.Len(t, res.Funds, 3)
+	for i, fund := range res.Funds {
+		require.Equal(t, consumer, fund.Funder)
+		require.Equal(t, mockSpec2, fund.Spec)
+		require.Equal(t, res.CurrentMonthId+uint64(i)+1, fund.MonthId)
+		require.True(t, iprpcFunds.Sub(minIprpcCost).IsEqual(fund.Fund))
+	}
+
+	// other funders have no schedule
+	_, consumer2 := ts.GetAccount(common.CONSUMER, 1)
+	res, err = ts.QueryRewardsIprpcFunderSchedule(consumer2, "")
+	require.NoError(t, err)
+	require.Len(t, res.Funds, 0)
+
+	// after two months, the first funded month is removed
+	ts.AdvanceMonths(1).AdvanceEpoch()
+	ts.AdvanceMonths(1).AdvanceEpoch()
+	res, err = ts.QueryRewardsIprpcFunderSchedule(consumer, "")
+	require.NoError(t, err)
+	require.Len(t, res.Funds, 2)
+	require.Equal(t, res.CurrentMonthId, res.Funds[0].MonthId)
+	require.Len(t, ts.Keepers.Rewards.GetAllIprpcFund(ts.Ctx), 2)
+	require.Len(t, ts.Keepers.Rewards.GetFunderIprpcFunds(ts.Ctx, consumer, "", 0), 2)
+	require.Len(t, ts.Keepers.Rewards.GetFunderIprpcFunds(ts.Ctx, consumer, ts.specs[0].Index, 0), 0)
+
+	_, err = ts.QueryRewardsIprpcFunderSchedule("invalid", "")
+	require.Error(t, err)
+}
+
+// TestWithdrawIprpcFund tests that a funder can withdraw the funds of months that have not started yet
+// Scenario:
+// 1. fund mockSpec2 for 3 months and advance a month (the first funded month starts)
+// 2. withdraw with a 10% penalty -> the last 2 months are refunded minus the penalty
+// 3. withdraw again or on a spec without funds -> TX fails
+func TestWithdrawIprpcFund(t *testing.T) {
+	ts := newTester(t, true)
+	ts.setupForIprpcTests(false)
+	consumerAcc, consumer := ts.GetAccount(common.CONSUMER, 0)
+
+	params := ts.Keepers.Rewards.GetParams(ts.Ctx)
+	params.IprpcWithdrawPenalty = sdk.NewDecWithPrec(1, 1) // 0.1
+	ts.Keepers.Rewards.SetParams(ts.Ctx, params)
+
+	err := ts.Keepers.BankKeeper.AddToBalance(consumerAcc.Addr, iprpcFunds.MulInt(math.NewInt(3)))
+	require.NoError(t, err)
+	_, err = ts.TxRewardsFundIprpc(consumer, mockSpec2, 3, iprpcFunds)
+	require.NoError(t, err)
+	ts.AdvanceMonths(1).AdvanceEpoch()
+
+	// nothing to withdraw on a spec that was not funded
+	_, err = ts.TxRewardsWithdrawIprpcFund(consumer, ts.specs[0].Index)
+	require.ErrorIs(t, err, rewardstypes.ErrWithdrawIprpcFund)
+
+	// withdraw the last 2 months
+	monthlyFund := iprpcFunds.Sub(minIprpcCost)
+	withdrawn := monthlyFund.MulInt(math.NewInt(2))
+	expectedPenalty := sdk.NewCoins(
+		sdk.NewCoin(ts.BondDenom(), withdrawn.AmountOf(ts.BondDenom()).QuoRaw(10)),
+		sdk.NewCoin(ibcDenom, withdrawn.AmountOf(ibcDenom).QuoRaw(10)),
+	)
+	balanceBefore := ts.GetBalances(consumerAcc.Addr)
+	poolBefore := ts.Keepers.Rewards.TotalPoolTokens(ts.Ctx, rewardstypes.IprpcPoolName)
+
+	res, err := ts.TxRewardsWithdrawIprpcFund(consumer, mockSpec2)
+	require.NoError(t, err)
+	require.True(t, expectedPenalty.IsEqual(res.Penalty))
+	require.True(t, withdrawn.Sub(expectedPenalty...).IsEqual(res.Refund))
+	require.True(t, balanceBefore.Add(res.Refund...).IsEqual(ts.GetBalances(consumerAcc.Addr)))
+	require.True(t, poolBefore.Sub(withdrawn...).IsEqual(ts.Keepers.Rewards.TotalPoolTokens(ts.Ctx, rewardstypes.IprpcPoolName)))
+
+	// only the current month is left in the schedule and the IPRPC rewards
+	schedule, err := ts.QueryRewardsIprpcFunderSchedule(consumer, mockSpec2)
+	require.NoError(t, err)
+	require.Len(t, schedule.Funds, 1)
+	require.Equal(t, schedule.CurrentMonthId, schedule.Funds[0].MonthId)
+	specRewards, err := ts.QueryRewardsIprpcSpecReward(mockSpec2)
+	require.NoError(t, err)
+	require.Len(t, specRewards.IprpcRewards, 1)
+	require.Equal(t, schedule.CurrentMonthId, specRewards.IprpcRewards[0].Id)
+	require.True(t, monthlyFund.IsEqual(specRewards.IprpcRewards[0].SpecFunds[0].Fund))
+
+	// the current month can't be withdrawn
+	_, err = ts.TxRewardsWithdrawIprpcFund(consumer, mockSpec2)
+	require.ErrorIs(t, err, rewardstypes.ErrWithdrawIprpcFund)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/rewards/types"
 )
 
 type Migrator struct {
@@ -16,3 +17,12 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) MigrateVersion1To2(ctx sdk.Context) error {
 	return m.keeper.SetIprpcData(ctx, sdk.NewCoin(m.keeper.stakingKeeper.BondDenom(ctx), sdk.NewInt(100000000)), []string{})
 }
+
+// MigrateVersion2To3 sets the new IprpcWithdrawPenalty param to its default value.
+// IprpcFund records are not backfilled: the IprpcReward objects of funds made before the upgrade
+// keep the funds per spec without their funders, so these funds are still distributed but don't
+// show in the funders' schedule and can't be withdrawn
+func (m Migrator) MigrateVersion2To3(ctx sdk.Context) error {
+	m.keeper.paramstore.Set(ctx, types.KeyIprpcWithdrawPenalty, types.DefaultIprpcWithdrawPenalty)
+	return nil
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/rewards/types"
+)
+
+func (k msgServer) WithdrawIprpcFund(goCtx context.Context, msg *types.MsgWithdrawIprpcFund) (*types.MsgWithdrawIprpcFundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return &types.MsgWithdrawIprpcFundResponse{}, err
+	}
+
+	refund, penalty, err := k.Keeper.WithdrawIprpcFund(ctx, msg.Creator, msg.Spec)
+	if err != nil {
+		return &types.MsgWithdrawIprpcFundResponse{}, err
+	}
+
+	logger := k.Keeper.Logger(ctx)
+	details := map[string]string{
+		"creator": msg.Creator,
+		"spec":    msg.Spec,
+		"refund":  refund.String(),
+		"penalty": penalty.String(),
+	}
+	utils.LogLavaEvent(ctx, logger, types.WithdrawIprpcFundEventName, details, "Withdrew IPRPC funds successfully")
+
+	return &types.MsgWithdrawIprpcFundResponse{Refund: refund, Penalty: penalty}, nil
+}
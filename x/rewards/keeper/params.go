@@ -14,6 +14,7 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.LeftoverBurnRate(ctx),
 		k.MaxRewardBoost(ctx),
 		k.ValidatorsSubscriptionParticipation(ctx),
+		k.IprpcWithdrawPenalty(ctx),
 	)
 }
 
@@ -57,3 +58,9 @@ func (k Keeper) ValidatorsSubscriptionParticipation(ctx sdk.Context) (res sdk.De
 	k.paramstore.Get(ctx, types.KeyValidatorsSubscriptionParticipation, &res)
 	return
 }
+
+// IprpcWithdrawPenalty returns the IprpcWithdrawPenalty param
+func (k Keeper) IprpcWithdrawPenalty(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyIprpcWithdrawPenalty, &res)
+	return
+}
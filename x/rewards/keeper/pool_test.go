@@ -326,6 +326,7 @@ func TestBondedTargetFactorEdgeCases(t *testing.T) {
 				LeftoverBurnRate:                    types.DefaultLeftOverBurnRate,
 				MaxRewardBoost:                      types.DefaultMaxRewardBoost,
 				ValidatorsSubscriptionParticipation: types.DefaultValidatorsSubscriptionParticipation,
+				IprpcWithdrawPenalty:                types.DefaultIprpcWithdrawPenalty,
 			}
 			ts.Keepers.Rewards.SetParams(ts.Ctx, params)
 
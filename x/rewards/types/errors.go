@@ -8,5 +8,6 @@ import (
 
 // x/rewards module sentinel errors
 var (
-	ErrFundIprpc = sdkerrors.Register(ModuleName, 1, "fund iprpc TX failed")
+	ErrFundIprpc         = sdkerrors.Register(ModuleName, 1, "fund iprpc TX failed")
+	ErrWithdrawIprpcFund = sdkerrors.Register(ModuleName, 2, "withdraw iprpc fund TX failed")
 )
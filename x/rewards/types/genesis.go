@@ -24,6 +24,7 @@ func DefaultGenesis() *GenesisState {
 		MinIprpcCost:        sdk.NewCoin(commontypes.TokenDenom, sdk.ZeroInt()),
 		IprpcRewards:        []IprpcReward{},
 		IprpcRewardsCurrent: 0,
+		IprpcFunds:          []IprpcFund{},
 	}
 }
 
@@ -68,5 +69,15 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for _, iprpcFund := range gs.IprpcFunds {
+		_, err := sdk.AccAddressFromBech32(iprpcFund.Funder)
+		if err != nil {
+			return fmt.Errorf("invalid iprpc funder address. err: %s", err.Error())
+		}
+		if !iprpcFund.Fund.IsValid() {
+			return fmt.Errorf("invalid iprpc fund. fund: %s", iprpcFund.Fund.String())
+		}
+	}
+
 	return gs.Params.Validate()
 }
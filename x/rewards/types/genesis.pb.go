@@ -34,6 +34,7 @@ type GenesisState struct {
 	MinIprpcCost        types1.Coin        `protobuf:"bytes,5,opt,name=min_iprpc_cost,json=minIprpcCost,proto3" json:"min_iprpc_cost"`
 	IprpcRewards        []IprpcReward      `protobuf:"bytes,6,rep,name=iprpc_rewards,json=iprpcRewards,proto3" json:"iprpc_rewards"`
 	IprpcRewardsCurrent uint64             `protobuf:"varint,7,opt,name=iprpc_rewards_current,json=iprpcRewardsCurrent,proto3" json:"iprpc_rewards_current,omitempty"`
+	IprpcFunds          []IprpcFund        `protobuf:"bytes,8,rep,name=iprpc_funds,json=iprpcFunds,proto3" json:"iprpc_funds"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -118,6 +119,13 @@ func (m *GenesisState) GetIprpcRewardsCurrent() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetIprpcFunds() []IprpcFund {
+	if m != nil {
+		return m.IprpcFunds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lavanet.lava.rewards.GenesisState")
 }
@@ -127,34 +135,36 @@ func init() {
 }
 
 var fileDescriptor_02c24f4df31ca14e = []byte{
-	// 429 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x80, 0x13, 0x52, 0x0a, 0xf3, 0x06, 0x48, 0xde, 0x90, 0x42, 0x85, 0x42, 0x36, 0x40, 0xe4,
-	0x64, 0x6b, 0xe5, 0xc6, 0x8d, 0x56, 0x68, 0x42, 0xe2, 0x30, 0xa5, 0x70, 0xe1, 0x12, 0x39, 0xc1,
-	0x04, 0x4b, 0x8d, 0x1d, 0xf9, 0xb9, 0x83, 0xfe, 0x0b, 0x7e, 0xd6, 0x8e, 0x3b, 0x72, 0x42, 0xa8,
-	0xe5, 0x87, 0xa0, 0xd8, 0x2e, 0x6d, 0xa7, 0x9c, 0x6c, 0xf9, 0x7d, 0xef, 0xf3, 0x7b, 0x4f, 0x0f,
-	0x9d, 0xcd, 0xd9, 0x15, 0x93, 0xdc, 0xd0, 0xee, 0xa4, 0x9a, 0x7f, 0x67, 0xfa, 0x0b, 0xd0, 0x9a,
-	0x4b, 0x0e, 0x02, 0x48, 0xab, 0x95, 0x51, 0xf8, 0xc4, 0x33, 0xa4, 0x3b, 0x89, 0x67, 0x46, 0x27,
-	0xb5, 0xaa, 0x95, 0x05, 0x68, 0x77, 0x73, 0xec, 0xe8, 0xb4, 0xd7, 0xd7, 0x32, 0xcd, 0x1a, 0xaf,
-	0x1b, 0x3d, 0xef, 0x45, 0x4a, 0x06, 0xbc, 0x68, 0xd9, 0xd2, 0x43, 0x69, 0x2f, 0x24, 0x5a, 0xdd,
-	0x56, 0xbd, 0x1a, 0x23, 0x1a, 0xae, 0xc1, 0x28, 0xcd, 0xdd, 0xd5, 0x43, 0x49, 0xa5, 0xa0, 0x51,
-	0xce, 0x4e, 0xaf, 0xce, 0x4b, 0x6e, 0xd8, 0x39, 0xad, 0x94, 0x90, 0x2e, 0x7e, 0xf6, 0x37, 0x42,
-	0x47, 0x17, 0xae, 0xd9, 0x99, 0x61, 0x86, 0xe3, 0x37, 0x68, 0xe8, 0x8a, 0x8d, 0xc3, 0x34, 0xcc,
-	0x0e, 0xc7, 0x4f, 0x49, 0x5f, 0xf3, 0xe4, 0xd2, 0x32, 0x93, 0xc1, 0xf5, 0xef, 0x67, 0x41, 0xee,
-	0x33, 0xf0, 0x27, 0xf4, 0x48, 0xf3, 0xaf, 0x62, 0x3e, 0xcf, 0x1d, 0xf5, 0x71, 0x16, 0xdf, 0xb1,
-	0x92, 0x97, 0xfb, 0x92, 0x6d, 0xad, 0x64, 0xf7, 0x6f, 0x6f, 0xbb, 0xed, 0xc0, 0x17, 0xe8, 0x60,
-	0x33, 0x1c, 0x88, 0xa3, 0x34, 0xca, 0x0e, 0xc7, 0x2f, 0xfa, 0xab, 0x9a, 0x30, 0xe0, 0x97, 0x6c,
-	0xe9, 0xa5, 0xde, 0x77, 0xbf, 0x74, 0xaf, 0x80, 0x29, 0x3a, 0xb6, 0x03, 0x2c, 0x60, 0x51, 0x42,
-	0xa5, 0x45, 0x6b, 0x84, 0x92, 0x10, 0x0f, 0xd2, 0x28, 0x3b, 0xc8, 0xb1, 0x0d, 0xcd, 0x76, 0x23,
-	0xf8, 0x1d, 0x7a, 0xd8, 0x08, 0x59, 0xb8, 0xa4, 0x4a, 0x81, 0x89, 0xef, 0xda, 0x7e, 0x9e, 0x10,
-	0x37, 0x56, 0xd2, 0xa9, 0x89, 0x1f, 0x2b, 0x99, 0x2a, 0x21, 0xfd, 0x9f, 0x47, 0x8d, 0x90, 0xef,
-	0xbb, 0xac, 0xa9, 0x02, 0x83, 0x3f, 0xa0, 0x07, 0x4e, 0xe1, 0xeb, 0x8c, 0x87, 0xb6, 0x89, 0xd3,
-	0xfe, 0x26, 0x6c, 0x9e, 0xeb, 0x7e, 0x63, 0x13, 0xdb, 0x27, 0xc0, 0x63, 0xf4, 0x78, 0xcf, 0x56,
-	0x54, 0x0b, 0xad, 0xb9, 0x34, 0xf1, 0xbd, 0x34, 0xcc, 0x06, 0xf9, 0xf1, 0x2e, 0x3c, 0x75, 0xa1,
-	0xc9, 0xdb, 0xeb, 0x55, 0x12, 0xde, 0xac, 0x92, 0xf0, 0xcf, 0x2a, 0x09, 0x7f, 0xae, 0x93, 0xe0,
-	0x66, 0x9d, 0x04, 0xbf, 0xd6, 0x49, 0xf0, 0xf9, 0x55, 0x2d, 0xcc, 0xb7, 0x45, 0x49, 0x2a, 0xd5,
-	0xd0, 0xbd, 0x85, 0xfa, 0xf1, 0x7f, 0xe9, 0xcc, 0xb2, 0xe5, 0x50, 0x0e, 0xed, 0xc2, 0xbc, 0xfe,
-	0x17, 0x00, 0x00, 0xff, 0xff, 0x15, 0xb1, 0xb4, 0xbe, 0x31, 0x03, 0x00, 0x00,
+	// 453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x6e, 0x13, 0x31,
+	0x10, 0x86, 0xb3, 0x24, 0x84, 0xd6, 0x29, 0x20, 0xb9, 0x45, 0x32, 0x11, 0xda, 0x6e, 0x0b, 0x88,
+	0x9c, 0x6c, 0x35, 0xdc, 0xb8, 0x91, 0x88, 0x56, 0x48, 0x1c, 0xaa, 0x04, 0x2e, 0x5c, 0x22, 0xef,
+	0xd6, 0x0d, 0x96, 0xb2, 0xf6, 0xca, 0xe3, 0x2d, 0xe4, 0x2d, 0x78, 0x10, 0x1e, 0xa4, 0xc7, 0x1e,
+	0x39, 0x21, 0x94, 0xbc, 0x08, 0x5a, 0xdb, 0x4b, 0x12, 0xb4, 0xe2, 0x64, 0x6b, 0xe6, 0x9f, 0x6f,
+	0xe6, 0x1f, 0x0d, 0x3a, 0x5d, 0xf0, 0x1b, 0xae, 0x84, 0x65, 0xd5, 0xcb, 0x8c, 0xf8, 0xca, 0xcd,
+	0x15, 0xb0, 0xb9, 0x50, 0x02, 0x24, 0xd0, 0xc2, 0x68, 0xab, 0xf1, 0x51, 0xd0, 0xd0, 0xea, 0xa5,
+	0x41, 0xd3, 0x3f, 0x9a, 0xeb, 0xb9, 0x76, 0x02, 0x56, 0xfd, 0xbc, 0xb6, 0x7f, 0xd2, 0xc8, 0x2b,
+	0xb8, 0xe1, 0x79, 0xc0, 0xf5, 0x9f, 0x37, 0x4a, 0x52, 0x0e, 0x62, 0x56, 0xf0, 0x65, 0x10, 0x25,
+	0x8d, 0x22, 0x59, 0x98, 0x22, 0x6b, 0xc4, 0x58, 0x99, 0x0b, 0x03, 0x56, 0x1b, 0xe1, 0xbf, 0x41,
+	0x14, 0x67, 0x1a, 0x72, 0xed, 0xe9, 0xec, 0xe6, 0x2c, 0x15, 0x96, 0x9f, 0xb1, 0x4c, 0x4b, 0xe5,
+	0xf3, 0xa7, 0x3f, 0x3a, 0xe8, 0xe0, 0xc2, 0x9b, 0x9d, 0x5a, 0x6e, 0x05, 0x7e, 0x83, 0xba, 0x7e,
+	0x58, 0x12, 0x25, 0xd1, 0xa0, 0x37, 0x7c, 0x46, 0x9b, 0xcc, 0xd3, 0x4b, 0xa7, 0x19, 0x75, 0x6e,
+	0x7f, 0x1d, 0xb7, 0x26, 0xa1, 0x02, 0x7f, 0x42, 0x8f, 0x8d, 0xb8, 0x96, 0x8b, 0xc5, 0xc4, 0xab,
+	0x3e, 0x4e, 0xc9, 0x3d, 0x07, 0x79, 0xb9, 0x0b, 0xd9, 0xcc, 0x4a, 0xb7, 0x7b, 0x07, 0xda, 0xbf,
+	0x0c, 0x7c, 0x81, 0xf6, 0xeb, 0xe5, 0x00, 0x69, 0x27, 0xed, 0x41, 0x6f, 0xf8, 0xa2, 0x79, 0xaa,
+	0x11, 0x07, 0x71, 0xc9, 0x97, 0x01, 0x1a, 0x78, 0x7b, 0xa9, 0x8f, 0x02, 0x66, 0xe8, 0xd0, 0x2d,
+	0x70, 0x06, 0x65, 0x0a, 0x99, 0x91, 0x85, 0x95, 0x5a, 0x01, 0xe9, 0x24, 0xed, 0xc1, 0xfe, 0x04,
+	0xbb, 0xd4, 0x74, 0x3b, 0x83, 0xdf, 0xa1, 0x47, 0xb9, 0x54, 0x33, 0x5f, 0x94, 0x69, 0xb0, 0xe4,
+	0xbe, 0xf3, 0xf3, 0x94, 0xfa, 0xb5, 0xd2, 0x0a, 0x4d, 0xc3, 0x5a, 0xe9, 0x58, 0x4b, 0x15, 0x7a,
+	0x1e, 0xe4, 0x52, 0xbd, 0xaf, 0xaa, 0xc6, 0x1a, 0x2c, 0xfe, 0x80, 0x1e, 0x7a, 0x44, 0x98, 0x93,
+	0x74, 0x9d, 0x89, 0x93, 0x66, 0x13, 0xae, 0xce, 0xbb, 0xaf, 0x69, 0x72, 0x13, 0x02, 0x3c, 0x44,
+	0x4f, 0x76, 0x68, 0xb3, 0xac, 0x34, 0x46, 0x28, 0x4b, 0x1e, 0x24, 0xd1, 0xa0, 0x33, 0x39, 0xdc,
+	0x16, 0x8f, 0x7d, 0x0a, 0x9f, 0xa3, 0x9e, 0xaf, 0xb9, 0x2e, 0xd5, 0x15, 0x90, 0x3d, 0xd7, 0xff,
+	0xf8, 0x3f, 0xfd, 0xcf, 0x4b, 0x55, 0x77, 0x47, 0xb2, 0x0e, 0xc0, 0xe8, 0xed, 0xed, 0x2a, 0x8e,
+	0xee, 0x56, 0x71, 0xf4, 0x7b, 0x15, 0x47, 0xdf, 0xd7, 0x71, 0xeb, 0x6e, 0x1d, 0xb7, 0x7e, 0xae,
+	0xe3, 0xd6, 0xe7, 0x57, 0x73, 0x69, 0xbf, 0x94, 0x29, 0xcd, 0x74, 0xce, 0x76, 0x0e, 0xf3, 0xdb,
+	0xdf, 0xe3, 0xb5, 0xcb, 0x42, 0x40, 0xda, 0x75, 0x87, 0xf7, 0xfa, 0xcf, 0x00, 0x1c, 0xbe, 0xfd,
+	0x98, 0x79, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -177,6 +187,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IprpcFunds) > 0 {
+		for iNdEx := len(m.IprpcFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IprpcFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.IprpcRewardsCurrent != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.IprpcRewardsCurrent))
 		i--
@@ -296,6 +320,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.IprpcRewardsCurrent != 0 {
 		n += 1 + sovGenesis(uint64(m.IprpcRewardsCurrent))
 	}
+	if len(m.IprpcFunds) > 0 {
+		for _, e := range m.IprpcFunds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -552,6 +582,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IprpcFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IprpcFunds = append(m.IprpcFunds, IprpcFund{})
+			if err := m.IprpcFunds[len(m.IprpcFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
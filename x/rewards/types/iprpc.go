@@ -12,4 +12,10 @@ const (
 
 	// IprpcRewardsCurrentPrefix is the prefix to retrieve all IprpcRewardsCurrent
 	IprpcRewardsCurrentPrefix = "IprpcRewardsCurrent/"
+
+	// IprpcFundPrefix is the prefix to retrieve all IprpcFund
+	IprpcFundPrefix = "IprpcFund/"
+
+	// IprpcFunderIndexPrefix is the prefix of the index of IprpcFund by funder
+	IprpcFunderIndexPrefix = "IprpcFunderIndex/"
 )
@@ -130,34 +130,107 @@ func (m *Specfund) GetFund() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+// object that holds a funder's IPRPC fund of a spec for a specific month id
+type IprpcFund struct {
+	Funder  string                                   `protobuf:"bytes,1,opt,name=funder,proto3" json:"funder,omitempty"`
+	Spec    string                                   `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	MonthId uint64                                   `protobuf:"varint,3,opt,name=month_id,json=monthId,proto3" json:"month_id,omitempty"`
+	Fund    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=fund,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fund"`
+}
+
+func (m *IprpcFund) Reset()         { *m = IprpcFund{} }
+func (m *IprpcFund) String() string { return proto.CompactTextString(m) }
+func (*IprpcFund) ProtoMessage()    {}
+func (*IprpcFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1293618a311573f7, []int{2}
+}
+func (m *IprpcFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IprpcFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IprpcFund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IprpcFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IprpcFund.Merge(m, src)
+}
+func (m *IprpcFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *IprpcFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_IprpcFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IprpcFund proto.InternalMessageInfo
+
+func (m *IprpcFund) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+func (m *IprpcFund) GetSpec() string {
+	if m != nil {
+		return m.Spec
+	}
+	return ""
+}
+
+func (m *IprpcFund) GetMonthId() uint64 {
+	if m != nil {
+		return m.MonthId
+	}
+	return 0
+}
+
+func (m *IprpcFund) GetFund() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fund
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*IprpcReward)(nil), "lavanet.lava.rewards.IprpcReward")
 	proto.RegisterType((*Specfund)(nil), "lavanet.lava.rewards.Specfund")
+	proto.RegisterType((*IprpcFund)(nil), "lavanet.lava.rewards.IprpcFund")
 }
 
 func init() { proto.RegisterFile("lavanet/lava/rewards/iprpc.proto", fileDescriptor_1293618a311573f7) }
 
 var fileDescriptor_1293618a311573f7 = []byte{
-	// 301 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
-	0x14, 0x85, 0x93, 0xfc, 0xd1, 0x2f, 0xea, 0x4a, 0x0c, 0x56, 0x87, 0xd2, 0xc1, 0x8d, 0xba, 0x90,
-	0x05, 0x9b, 0xc2, 0x13, 0x90, 0x4a, 0x48, 0xac, 0x61, 0x63, 0xa9, 0x12, 0xc7, 0x04, 0x0b, 0x1a,
-	0x5b, 0x71, 0x5a, 0x60, 0xe2, 0x15, 0x78, 0x0e, 0x9e, 0xa4, 0x63, 0x47, 0x26, 0x40, 0xc9, 0x8b,
-	0xa0, 0xeb, 0x18, 0x04, 0x12, 0xd3, 0xb5, 0x74, 0xce, 0xfd, 0xee, 0xf1, 0x41, 0xd1, 0x5d, 0xb6,
-	0xc9, 0x2a, 0xd1, 0x30, 0x98, 0xac, 0x16, 0xf7, 0x59, 0x5d, 0x18, 0x26, 0x75, 0xad, 0x39, 0xd5,
-	0xb5, 0x6a, 0x14, 0x1e, 0x39, 0x07, 0x85, 0x49, 0x9d, 0x63, 0x32, 0x2a, 0x55, 0xa9, 0xac, 0x81,
-	0xc1, 0xab, 0xf7, 0x4e, 0x08, 0x57, 0x66, 0xa5, 0x0c, 0xcb, 0x33, 0x23, 0xd8, 0x66, 0x9e, 0x8b,
-	0x26, 0x9b, 0x33, 0xae, 0x64, 0xd5, 0xeb, 0xb3, 0x1c, 0x0d, 0x2f, 0x00, 0x9d, 0x5a, 0x0a, 0xde,
-	0x47, 0x81, 0x2c, 0xc6, 0x7e, 0xe4, 0xc7, 0x61, 0x1a, 0xc8, 0x02, 0x2f, 0x10, 0x32, 0x5a, 0xf0,
-	0xe5, 0xf5, 0xba, 0x2a, 0xcc, 0x38, 0x88, 0xfe, 0xc5, 0xc3, 0x13, 0x42, 0xff, 0xba, 0x4f, 0x2f,
-	0xb5, 0xe0, 0x60, 0x4b, 0xc2, 0xed, 0xdb, 0xd4, 0x4b, 0x07, 0xb0, 0x77, 0x0e, 0x6b, 0xb3, 0x27,
-	0xb4, 0xf7, 0x25, 0x62, 0x8c, 0x42, 0x10, 0xec, 0x89, 0x41, 0x6a, 0xdf, 0x78, 0x89, 0x42, 0xd0,
-	0x1c, 0xfe, 0x80, 0xf6, 0x91, 0x29, 0x44, 0xa6, 0x2e, 0x32, 0x5d, 0x28, 0x59, 0x25, 0xc7, 0x40,
-	0x7e, 0x79, 0x9f, 0xc6, 0xa5, 0x6c, 0x6e, 0xd6, 0x39, 0xe5, 0x6a, 0xc5, 0xdc, 0xff, 0xfa, 0x71,
-	0x64, 0x8a, 0x5b, 0xd6, 0x3c, 0x6a, 0x61, 0xec, 0x82, 0x49, 0x2d, 0x38, 0x39, 0xdb, 0xb6, 0xc4,
-	0xdf, 0xb5, 0xc4, 0xff, 0x68, 0x89, 0xff, 0xdc, 0x11, 0x6f, 0xd7, 0x11, 0xef, 0xb5, 0x23, 0xde,
-	0xd5, 0xe1, 0x0f, 0xd2, 0xaf, 0xde, 0x1f, 0xbe, 0x9b, 0xb7, 0xb8, 0xfc, 0xbf, 0xad, 0xeb, 0xf4,
-	0x33, 0x00, 0x00, 0xff, 0xff, 0x19, 0x8c, 0x21, 0xa2, 0x9e, 0x01, 0x00, 0x00,
+	// 348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xc1, 0x4e, 0x32, 0x31,
+	0x14, 0x85, 0x67, 0x86, 0x09, 0x3f, 0x94, 0xe4, 0x5f, 0x34, 0xc4, 0x00, 0x8b, 0x42, 0xd8, 0xc8,
+	0xc6, 0x56, 0xf4, 0x09, 0x84, 0xc4, 0x84, 0xed, 0xb8, 0x73, 0x43, 0x66, 0xa6, 0x15, 0x1a, 0x65,
+	0x3a, 0x99, 0x16, 0xd4, 0x95, 0xaf, 0xe0, 0x73, 0xb8, 0xf5, 0x25, 0x58, 0xb2, 0x74, 0xa5, 0x06,
+	0x5e, 0xc4, 0xdc, 0x4e, 0x25, 0x98, 0xb8, 0x75, 0x75, 0xdb, 0x39, 0xe7, 0x9e, 0x7e, 0x9d, 0x5e,
+	0xd4, 0xbb, 0x8b, 0x57, 0x71, 0x26, 0x0c, 0x83, 0xca, 0x0a, 0x71, 0x1f, 0x17, 0x5c, 0x33, 0x99,
+	0x17, 0x79, 0x4a, 0xf3, 0x42, 0x19, 0x85, 0x9b, 0xce, 0x41, 0xa1, 0x52, 0xe7, 0xe8, 0x34, 0x67,
+	0x6a, 0xa6, 0xac, 0x81, 0xc1, 0xaa, 0xf4, 0x76, 0x48, 0xaa, 0xf4, 0x42, 0x69, 0x96, 0xc4, 0x5a,
+	0xb0, 0xd5, 0x30, 0x11, 0x26, 0x1e, 0xb2, 0x54, 0xc9, 0xac, 0xd4, 0xfb, 0x09, 0x6a, 0x4c, 0x20,
+	0x3a, 0xb2, 0x29, 0xf8, 0x3f, 0x0a, 0x24, 0x6f, 0xf9, 0x3d, 0x7f, 0x10, 0x46, 0x81, 0xe4, 0x78,
+	0x8c, 0x90, 0xce, 0x45, 0x3a, 0xbd, 0x59, 0x66, 0x5c, 0xb7, 0x82, 0x5e, 0x65, 0xd0, 0x38, 0x23,
+	0xf4, 0xb7, 0xf3, 0xe9, 0x55, 0x2e, 0x52, 0xb0, 0x8d, 0xc2, 0xf5, 0x7b, 0xd7, 0x8b, 0xea, 0xd0,
+	0x77, 0x09, 0x6d, 0xfd, 0x27, 0x54, 0xfb, 0x16, 0x31, 0x46, 0x21, 0x08, 0xf6, 0x88, 0x7a, 0x64,
+	0xd7, 0x78, 0x8a, 0x42, 0xd0, 0x5c, 0x7c, 0x9b, 0x96, 0xc8, 0x14, 0x90, 0xa9, 0x43, 0xa6, 0x63,
+	0x25, 0xb3, 0xd1, 0x29, 0x24, 0xbf, 0x7c, 0x74, 0x07, 0x33, 0x69, 0xe6, 0xcb, 0x84, 0xa6, 0x6a,
+	0xc1, 0xdc, 0xfd, 0xca, 0x72, 0xa2, 0xf9, 0x2d, 0x33, 0x8f, 0xb9, 0xd0, 0xb6, 0x41, 0x47, 0x36,
+	0xb8, 0xff, 0xea, 0xa3, 0xba, 0xbd, 0x25, 0xf0, 0xe0, 0x23, 0x54, 0x85, 0xaf, 0xa2, 0x70, 0x10,
+	0x6e, 0xb7, 0x47, 0x0b, 0x0e, 0xd0, 0xda, 0xa8, 0xb6, 0x50, 0x99, 0x99, 0x4f, 0x25, 0x6f, 0x55,
+	0xec, 0x5f, 0xf9, 0x67, 0xf7, 0x13, 0xbe, 0xa7, 0x0e, 0xff, 0x88, 0x7a, 0x74, 0xb1, 0xde, 0x12,
+	0x7f, 0xb3, 0x25, 0xfe, 0xe7, 0x96, 0xf8, 0xcf, 0x3b, 0xe2, 0x6d, 0x76, 0xc4, 0x7b, 0xdb, 0x11,
+	0xef, 0xfa, 0xf8, 0x20, 0xe9, 0xc7, 0xb4, 0x3c, 0xec, 0xe7, 0xc5, 0xc6, 0x25, 0x55, 0xfb, 0xc8,
+	0xe7, 0x5f, 0x03, 0x00, 0xa7, 0xe4, 0x94, 0x6f, 0x54, 0x02, 0x00, 0x00,
 }
 
 func (m *IprpcReward) Marshal() (dAtA []byte, err error) {
@@ -246,6 +319,62 @@ func (m *Specfund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IprpcFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IprpcFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IprpcFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fund) > 0 {
+		for iNdEx := len(m.Fund) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fund[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIprpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MonthId != 0 {
+		i = encodeVarintIprpc(dAtA, i, uint64(m.MonthId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Spec) > 0 {
+		i -= len(m.Spec)
+		copy(dAtA[i:], m.Spec)
+		i = encodeVarintIprpc(dAtA, i, uint64(len(m.Spec)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintIprpc(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintIprpc(dAtA []byte, offset int, v uint64) int {
 	offset -= sovIprpc(v)
 	base := offset
@@ -294,6 +423,32 @@ func (m *Specfund) Size() (n int) {
 	return n
 }
 
+func (m *IprpcFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovIprpc(uint64(l))
+	}
+	l = len(m.Spec)
+	if l > 0 {
+		n += 1 + l + sovIprpc(uint64(l))
+	}
+	if m.MonthId != 0 {
+		n += 1 + sovIprpc(uint64(m.MonthId))
+	}
+	if len(m.Fund) > 0 {
+		for _, e := range m.Fund {
+			l = e.Size()
+			n += 1 + l + sovIprpc(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovIprpc(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -519,6 +674,173 @@ func (m *Specfund) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *IprpcFund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIprpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IprpcFund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IprpcFund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIprpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIprpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIprpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIprpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIprpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIprpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthId", wireType)
+			}
+			m.MonthId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIprpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIprpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIprpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIprpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fund = append(m.Fund, types.Coin{})
+			if err := m.Fund[len(m.Fund)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIprpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIprpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipIprpc(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,54 @@
+package types
+
+import (
+	fmt "fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgWithdrawIprpcFund = "withdraw_iprpc_fund"
+
+var _ sdk.Msg = &MsgWithdrawIprpcFund{}
+
+func NewMsgWithdrawIprpcFund(creator string, spec string) *MsgWithdrawIprpcFund {
+	return &MsgWithdrawIprpcFund{
+		Creator: creator,
+		Spec:    spec,
+	}
+}
+
+func (msg *MsgWithdrawIprpcFund) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgWithdrawIprpcFund) Type() string {
+	return TypeMsgWithdrawIprpcFund
+}
+
+func (msg *MsgWithdrawIprpcFund) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgWithdrawIprpcFund) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgWithdrawIprpcFund) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.Spec == "" {
+		return sdkerrors.Wrap(fmt.Errorf("spec cannot be empty"), "")
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithdrawIprpcFund_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name  string
+		msg   MsgWithdrawIprpcFund
+		valid bool
+	}{
+		{
+			name: "valid",
+			msg: MsgWithdrawIprpcFund{
+				Creator: sample.AccAddress(),
+				Spec:    "spec",
+			},
+			valid: true,
+		},
+		{
+			name: "invalid creator address",
+			msg: MsgWithdrawIprpcFund{
+				Creator: "invalid_address",
+				Spec:    "spec",
+			},
+			valid: false,
+		},
+		{
+			name: "empty spec",
+			msg: MsgWithdrawIprpcFund{
+				Creator: sample.AccAddress(),
+				Spec:    "",
+			},
+			valid: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.valid {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+		})
+	}
+}
@@ -40,6 +40,11 @@ var (
 	DefaultValidatorsSubscriptionParticipation sdk.Dec = sdk.NewDecWithPrec(5, 2) // 0.05
 )
 
+var (
+	KeyIprpcWithdrawPenalty             = []byte("IprpcWithdrawPenalty")
+	DefaultIprpcWithdrawPenalty sdk.Dec = sdk.ZeroDec()
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -53,6 +58,7 @@ func NewParams(
 	leftoverBurnRate sdk.Dec,
 	maxRewardBoost uint64,
 	validatorsSubscriptionParticipation sdk.Dec,
+	iprpcWithdrawPenalty sdk.Dec,
 ) Params {
 	return Params{
 		MinBondedTarget:                     minBondedTarget,
@@ -61,6 +67,7 @@ func NewParams(
 		LeftoverBurnRate:                    leftoverBurnRate,
 		MaxRewardBoost:                      maxRewardBoost,
 		ValidatorsSubscriptionParticipation: validatorsSubscriptionParticipation,
+		IprpcWithdrawPenalty:                iprpcWithdrawPenalty,
 	}
 }
 
@@ -73,6 +80,7 @@ func DefaultParams() Params {
 		DefaultLeftOverBurnRate,
 		DefaultMaxRewardBoost,
 		DefaultValidatorsSubscriptionParticipation,
+		DefaultIprpcWithdrawPenalty,
 	)
 }
 
@@ -85,6 +93,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyLeftoverBurnRate, &p.LeftoverBurnRate, validateDec),
 		paramtypes.NewParamSetPair(KeyMaxRewardBoost, &p.MaxRewardBoost, validateuint64),
 		paramtypes.NewParamSetPair(KeyValidatorsSubscriptionParticipation, &p.ValidatorsSubscriptionParticipation, validateDec),
+		paramtypes.NewParamSetPair(KeyIprpcWithdrawPenalty, &p.IprpcWithdrawPenalty, validateDec),
 	}
 }
 
@@ -118,6 +127,10 @@ func (p Params) Validate() error {
 		return fmt.Errorf("invalid ValidatorsSubscriptionParticipation. Error: %s", err.Error())
 	}
 
+	if err := validateDec(p.IprpcWithdrawPenalty); err != nil {
+		return fmt.Errorf("invalid IprpcWithdrawPenalty. Error: %s", err.Error())
+	}
+
 	return nil
 }
 
@@ -32,6 +32,7 @@ type Params struct {
 	LeftoverBurnRate                    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=leftover_burn_rate,json=leftoverBurnRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"leftover_burn_rate" yaml:"leftover_burn_rate"`
 	MaxRewardBoost                      uint64                                 `protobuf:"varint,5,opt,name=max_reward_boost,json=maxRewardBoost,proto3" json:"max_reward_boost,omitempty"`
 	ValidatorsSubscriptionParticipation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=validators_subscription_participation,json=validatorsSubscriptionParticipation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validators_subscription_participation" yaml:"validators_subscription_participation"`
+	IprpcWithdrawPenalty                github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=iprpc_withdraw_penalty,json=iprpcWithdrawPenalty,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"iprpc_withdraw_penalty" yaml:"iprpc_withdraw_penalty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -80,34 +81,36 @@ func init() {
 func init() { proto.RegisterFile("lavanet/lava/rewards/params.proto", fileDescriptor_12687c5fbcde5c39) }
 
 var fileDescriptor_12687c5fbcde5c39 = []byte{
-	// 418 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xbf, 0x8e, 0x94, 0x50,
-	0x14, 0xc6, 0x41, 0x67, 0x27, 0xd9, 0x5b, 0xe8, 0x2e, 0xd9, 0x02, 0x2d, 0x60, 0xc5, 0xa8, 0x53,
-	0x28, 0x14, 0x76, 0xdb, 0x89, 0xc6, 0x42, 0x9b, 0x0d, 0x5a, 0x59, 0x78, 0x73, 0x80, 0xbb, 0x78,
-	0x23, 0x70, 0xc8, 0xbd, 0x17, 0x86, 0x79, 0x0b, 0x4b, 0x4b, 0x9f, 0xc1, 0xda, 0x07, 0xd8, 0x72,
-	0x4a, 0x63, 0x31, 0x31, 0x33, 0x6f, 0xe0, 0x13, 0x18, 0x2e, 0xe8, 0xfc, 0x6b, 0x9c, 0x58, 0x9d,
-	0xc3, 0x97, 0x8f, 0xef, 0xf7, 0x05, 0x72, 0xc8, 0xbd, 0x1c, 0x1a, 0x28, 0x99, 0x0a, 0xba, 0x19,
-	0x08, 0x36, 0x05, 0x91, 0xca, 0xa0, 0x02, 0x01, 0x85, 0xf4, 0x2b, 0x81, 0x0a, 0xad, 0xb3, 0xc1,
-	0xe2, 0x77, 0xd3, 0x1f, 0x2c, 0x77, 0xcf, 0x32, 0xcc, 0x50, 0x1b, 0x82, 0x6e, 0xeb, 0xbd, 0xde,
-	0xb7, 0x23, 0x32, 0xbe, 0xd4, 0x2f, 0x5b, 0x0d, 0x39, 0x2d, 0x78, 0x49, 0x63, 0x2c, 0x53, 0x96,
-	0x52, 0x05, 0x22, 0x63, 0xca, 0x36, 0xcf, 0xcd, 0xc9, 0x71, 0xf8, 0xea, 0x7a, 0xe1, 0x1a, 0x3f,
-	0x16, 0xee, 0xc3, 0x8c, 0xab, 0x0f, 0x75, 0xec, 0x27, 0x58, 0x04, 0x09, 0xca, 0x02, 0xe5, 0x30,
-	0x9e, 0xc8, 0xf4, 0x63, 0xa0, 0x66, 0x15, 0x93, 0xfe, 0x0b, 0x96, 0xfc, 0x5a, 0xb8, 0xf6, 0x0c,
-	0x8a, 0xfc, 0xc2, 0xdb, 0x0b, 0xf4, 0xa2, 0xdb, 0x05, 0x2f, 0x43, 0x2d, 0xbd, 0xd5, 0x8a, 0xe6,
-	0x42, 0xbb, 0xc3, 0xbd, 0xf1, 0x9f, 0xdc, 0xdd, 0xc0, 0x8e, 0x0b, 0xed, 0x16, 0x37, 0x26, 0x24,
-	0xc7, 0x29, 0xbd, 0x82, 0x44, 0xa1, 0xb0, 0x6f, 0x6a, 0xe0, 0xf3, 0x83, 0x81, 0xa7, 0x3d, 0x70,
-	0x9d, 0xe4, 0x45, 0xc7, 0x39, 0x4e, 0x5f, 0xea, 0xdd, 0x9a, 0x11, 0x2b, 0x67, 0x57, 0x0a, 0x1b,
-	0x26, 0x68, 0x5c, 0x8b, 0x92, 0x0a, 0x50, 0xcc, 0x1e, 0x69, 0xd6, 0xeb, 0x83, 0x59, 0x77, 0x06,
-	0xd6, 0x5e, 0xa2, 0x17, 0x9d, 0xfc, 0x11, 0xc3, 0x5a, 0x94, 0x11, 0x28, 0x66, 0x4d, 0xc8, 0x49,
-	0xf7, 0x15, 0xfa, 0xdf, 0x4f, 0x63, 0x44, 0xa9, 0xec, 0xa3, 0x73, 0x73, 0x32, 0x8a, 0x6e, 0x15,
-	0xd0, 0x46, 0x5a, 0x0e, 0x3b, 0xd5, 0xfa, 0x6a, 0x92, 0x07, 0x0d, 0xe4, 0x3c, 0x05, 0x85, 0x42,
-	0x52, 0x59, 0xc7, 0x32, 0x11, 0xbc, 0x52, 0x1c, 0x4b, 0x5a, 0x81, 0x50, 0x3c, 0xe1, 0x15, 0x74,
-	0x4f, 0xf6, 0x58, 0x17, 0x7f, 0x7f, 0x70, 0xf1, 0xc7, 0x7d, 0xf1, 0x7f, 0x82, 0x78, 0xd1, 0xfd,
-	0xb5, 0xef, 0xcd, 0x86, 0xed, 0x72, 0xd3, 0x75, 0x31, 0xfa, 0xfc, 0xc5, 0x35, 0xc2, 0x67, 0xd7,
-	0x4b, 0xc7, 0x9c, 0x2f, 0x1d, 0xf3, 0xe7, 0xd2, 0x31, 0x3f, 0xad, 0x1c, 0x63, 0xbe, 0x72, 0x8c,
-	0xef, 0x2b, 0xc7, 0x78, 0xf7, 0x68, 0xa3, 0xdc, 0xd6, 0xc9, 0xb4, 0x7f, 0x8f, 0x46, 0x37, 0x8c,
-	0xc7, 0xfa, 0x10, 0x9e, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xed, 0xaf, 0xe7, 0x4f, 0x59, 0x03,
-	0x00, 0x00,
+	// 460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x31, 0x8e, 0xd3, 0x40,
+	0x14, 0x86, 0x6d, 0x08, 0x41, 0x3b, 0x05, 0xec, 0x5a, 0x11, 0x32, 0x48, 0x38, 0x8b, 0x11, 0x90,
+	0x02, 0xec, 0x82, 0x6e, 0x3b, 0x0c, 0xa2, 0x80, 0x82, 0xc8, 0x20, 0x21, 0x51, 0x30, 0x7a, 0xb6,
+	0x67, 0xb3, 0x23, 0x6c, 0xcf, 0x68, 0xe6, 0x25, 0x76, 0x0e, 0x40, 0x4f, 0x49, 0xc9, 0x19, 0x38,
+	0x03, 0xc5, 0x96, 0x5b, 0x22, 0x8a, 0x08, 0x25, 0x37, 0xe0, 0x04, 0xc8, 0x63, 0xc3, 0x26, 0x84,
+	0x82, 0x88, 0xea, 0x8d, 0x7f, 0xff, 0xfe, 0xbf, 0x5f, 0x1e, 0x3d, 0x72, 0x2b, 0x87, 0x19, 0x94,
+	0x0c, 0xc3, 0x66, 0x86, 0x8a, 0x55, 0xa0, 0x32, 0x1d, 0x4a, 0x50, 0x50, 0xe8, 0x40, 0x2a, 0x81,
+	0xc2, 0x19, 0x74, 0x96, 0xa0, 0x99, 0x41, 0x67, 0xb9, 0x31, 0x98, 0x88, 0x89, 0x30, 0x86, 0xb0,
+	0x39, 0xb5, 0x5e, 0xff, 0x4b, 0x9f, 0xf4, 0xc7, 0xe6, 0x63, 0x67, 0x46, 0x0e, 0x0a, 0x5e, 0xd2,
+	0x44, 0x94, 0x19, 0xcb, 0x28, 0x82, 0x9a, 0x30, 0x74, 0xed, 0x43, 0x7b, 0xb4, 0x17, 0x3d, 0x3b,
+	0x5d, 0x0c, 0xad, 0x6f, 0x8b, 0xe1, 0xdd, 0x09, 0xc7, 0x93, 0x69, 0x12, 0xa4, 0xa2, 0x08, 0x53,
+	0xa1, 0x0b, 0xa1, 0xbb, 0xf1, 0x40, 0x67, 0xef, 0x42, 0x9c, 0x4b, 0xa6, 0x83, 0x27, 0x2c, 0xfd,
+	0xb1, 0x18, 0xba, 0x73, 0x28, 0xf2, 0x23, 0x7f, 0x2b, 0xd0, 0x8f, 0xaf, 0x16, 0xbc, 0x8c, 0x8c,
+	0xf4, 0xca, 0x28, 0x86, 0x0b, 0xf5, 0x1f, 0xdc, 0x0b, 0xff, 0xc9, 0x85, 0x7a, 0x9b, 0x0b, 0xf5,
+	0x06, 0x37, 0x21, 0x24, 0x17, 0x15, 0x3d, 0x86, 0x14, 0x85, 0x72, 0x2f, 0x1a, 0xe0, 0xe3, 0x9d,
+	0x81, 0x07, 0x2d, 0xf0, 0x3c, 0xc9, 0x8f, 0xf7, 0x72, 0x51, 0x3d, 0x35, 0x67, 0x67, 0x4e, 0x9c,
+	0x9c, 0x1d, 0xa3, 0x98, 0x31, 0x45, 0x93, 0xa9, 0x2a, 0xa9, 0x02, 0x64, 0x6e, 0xcf, 0xb0, 0x9e,
+	0xef, 0xcc, 0xba, 0xde, 0xb1, 0xb6, 0x12, 0xfd, 0x78, 0xff, 0x97, 0x18, 0x4d, 0x55, 0x19, 0x03,
+	0x32, 0x67, 0x44, 0xf6, 0x9b, 0xbf, 0xd0, 0x5e, 0x3f, 0x4d, 0x84, 0xd0, 0xe8, 0x5e, 0x3a, 0xb4,
+	0x47, 0xbd, 0xf8, 0x4a, 0x01, 0x75, 0x6c, 0xe4, 0xa8, 0x51, 0x9d, 0xcf, 0x36, 0xb9, 0x33, 0x83,
+	0x9c, 0x67, 0x80, 0x42, 0x69, 0xaa, 0xa7, 0x89, 0x4e, 0x15, 0x97, 0xc8, 0x45, 0x49, 0x25, 0x28,
+	0xe4, 0x29, 0x97, 0xd0, 0x3c, 0xb9, 0x7d, 0x53, 0xfc, 0xed, 0xce, 0xc5, 0xef, 0xb7, 0xc5, 0xff,
+	0x09, 0xe2, 0xc7, 0xb7, 0xcf, 0x7d, 0x2f, 0xd7, 0x6c, 0xe3, 0x75, 0x97, 0xf3, 0xde, 0x26, 0xd7,
+	0xb8, 0x54, 0x32, 0xa5, 0x15, 0xc7, 0x93, 0x4c, 0x41, 0x45, 0x25, 0x2b, 0x21, 0xc7, 0xb9, 0x7b,
+	0xd9, 0xb4, 0x7c, 0xb1, 0x73, 0xcb, 0x9b, 0x6d, 0xcb, 0xbf, 0xa7, 0xfa, 0xf1, 0xc0, 0xbc, 0x78,
+	0xdd, 0xe9, 0xe3, 0x56, 0x3e, 0xea, 0x7d, 0xfc, 0x34, 0xb4, 0xa2, 0x47, 0xa7, 0x4b, 0xcf, 0x3e,
+	0x5b, 0x7a, 0xf6, 0xf7, 0xa5, 0x67, 0x7f, 0x58, 0x79, 0xd6, 0xd9, 0xca, 0xb3, 0xbe, 0xae, 0x3c,
+	0xeb, 0xcd, 0xbd, 0x35, 0xfc, 0xc6, 0xea, 0xd6, 0xbf, 0x97, 0xd7, 0x74, 0x48, 0xfa, 0x66, 0x21,
+	0x1f, 0xfe, 0x1c, 0x00, 0x56, 0xcd, 0x12, 0xa3, 0xe1, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -130,6 +133,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.IprpcWithdrawPenalty.Size()
+		i -= size
+		if _, err := m.IprpcWithdrawPenalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	{
 		size := m.ValidatorsSubscriptionParticipation.Size()
 		i -= size
@@ -218,6 +231,8 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.ValidatorsSubscriptionParticipation.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.IprpcWithdrawPenalty.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -445,6 +460,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IprpcWithdrawPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IprpcWithdrawPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
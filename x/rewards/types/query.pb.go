@@ -800,6 +800,112 @@ func (m *QueryIprpcSpecRewardResponse) GetCurrentMonthId() uint64 {
 	return 0
 }
 
+// QueryIprpcFunderScheduleRequest is request type for the Query/IprpcFunderSchedule RPC method.
+type QueryIprpcFunderScheduleRequest struct {
+	Funder string `protobuf:"bytes,1,opt,name=funder,proto3" json:"funder,omitempty"`
+	Spec   string `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+}
+
+func (m *QueryIprpcFunderScheduleRequest) Reset()         { *m = QueryIprpcFunderScheduleRequest{} }
+func (m *QueryIprpcFunderScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIprpcFunderScheduleRequest) ProtoMessage()    {}
+func (*QueryIprpcFunderScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15bce9a904340007, []int{16}
+}
+func (m *QueryIprpcFunderScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIprpcFunderScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIprpcFunderScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIprpcFunderScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIprpcFunderScheduleRequest.Merge(m, src)
+}
+func (m *QueryIprpcFunderScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIprpcFunderScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIprpcFunderScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIprpcFunderScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryIprpcFunderScheduleRequest) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+func (m *QueryIprpcFunderScheduleRequest) GetSpec() string {
+	if m != nil {
+		return m.Spec
+	}
+	return ""
+}
+
+// QueryIprpcFunderScheduleResponse is response type for the Query/IprpcFunderSchedule RPC method.
+type QueryIprpcFunderScheduleResponse struct {
+	Funds          []IprpcFund `protobuf:"bytes,1,rep,name=funds,proto3" json:"funds"`
+	CurrentMonthId uint64      `protobuf:"varint,2,opt,name=current_month_id,json=currentMonthId,proto3" json:"current_month_id,omitempty"`
+}
+
+func (m *QueryIprpcFunderScheduleResponse) Reset()         { *m = QueryIprpcFunderScheduleResponse{} }
+func (m *QueryIprpcFunderScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIprpcFunderScheduleResponse) ProtoMessage()    {}
+func (*QueryIprpcFunderScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15bce9a904340007, []int{17}
+}
+func (m *QueryIprpcFunderScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIprpcFunderScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIprpcFunderScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIprpcFunderScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIprpcFunderScheduleResponse.Merge(m, src)
+}
+func (m *QueryIprpcFunderScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIprpcFunderScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIprpcFunderScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIprpcFunderScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryIprpcFunderScheduleResponse) GetFunds() []IprpcFund {
+	if m != nil {
+		return m.Funds
+	}
+	return nil
+}
+
+func (m *QueryIprpcFunderScheduleResponse) GetCurrentMonthId() uint64 {
+	if m != nil {
+		return m.CurrentMonthId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "lavanet.lava.rewards.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.rewards.QueryParamsResponse")
@@ -817,78 +923,85 @@ func init() {
 	proto.RegisterType((*QueryIprpcProviderRewardEstimationResponse)(nil), "lavanet.lava.rewards.QueryIprpcProviderRewardEstimationResponse")
 	proto.RegisterType((*QueryIprpcSpecRewardRequest)(nil), "lavanet.lava.rewards.QueryIprpcSpecRewardRequest")
 	proto.RegisterType((*QueryIprpcSpecRewardResponse)(nil), "lavanet.lava.rewards.QueryIprpcSpecRewardResponse")
+	proto.RegisterType((*QueryIprpcFunderScheduleRequest)(nil), "lavanet.lava.rewards.QueryIprpcFunderScheduleRequest")
+	proto.RegisterType((*QueryIprpcFunderScheduleResponse)(nil), "lavanet.lava.rewards.QueryIprpcFunderScheduleResponse")
 }
 
 func init() { proto.RegisterFile("lavanet/lava/rewards/query.proto", fileDescriptor_15bce9a904340007) }
 
 var fileDescriptor_15bce9a904340007 = []byte{
-	// 1041 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0x8e, 0xf3, 0x3b, 0x2f, 0x6d, 0x80, 0x49, 0xa4, 0x6e, 0x9c, 0x74, 0x9b, 0x9a, 0xa0, 0x6c,
-	0x23, 0xc5, 0x4e, 0x82, 0x54, 0x10, 0x08, 0x28, 0x09, 0x3f, 0x14, 0xa9, 0x48, 0xad, 0x97, 0x13,
-	0x17, 0x6b, 0xd6, 0x3b, 0xbb, 0x6b, 0xd5, 0xf6, 0x38, 0x9e, 0xd9, 0x84, 0x0a, 0x2a, 0x24, 0x10,
-	0x07, 0x24, 0x0e, 0x48, 0x48, 0x88, 0x2b, 0x12, 0x27, 0x4e, 0xfc, 0x19, 0x3d, 0x56, 0xe2, 0xc2,
-	0x09, 0x50, 0xc2, 0x5f, 0xc0, 0x5f, 0x80, 0xe6, 0xcd, 0x78, 0xb3, 0x9b, 0x38, 0x9b, 0x6d, 0x4f,
-	0x6b, 0xcf, 0x7c, 0xef, 0x7d, 0xdf, 0xf7, 0xe6, 0xcd, 0x5b, 0xc3, 0x5a, 0x4c, 0x8f, 0x68, 0xca,
-	0xa4, 0xa7, 0x7e, 0xbd, 0x9c, 0x1d, 0xd3, 0xbc, 0x29, 0xbc, 0xc3, 0x2e, 0xcb, 0x1f, 0xbb, 0x59,
-	0xce, 0x25, 0x27, 0x4b, 0x06, 0xe1, 0xaa, 0x5f, 0xd7, 0x20, 0xec, 0xa5, 0x36, 0x6f, 0x73, 0x04,
-	0x78, 0xea, 0x49, 0x63, 0xed, 0xd5, 0x36, 0xe7, 0xed, 0x98, 0x79, 0x34, 0x8b, 0x3c, 0x9a, 0xa6,
-	0x5c, 0x52, 0x19, 0xf1, 0x54, 0x98, 0xdd, 0xcd, 0x90, 0x8b, 0x84, 0x0b, 0xaf, 0x41, 0x05, 0xd3,
-	0x14, 0xde, 0xd1, 0x4e, 0x83, 0x49, 0xba, 0xe3, 0x65, 0xb4, 0x1d, 0xa5, 0x08, 0x36, 0xd8, 0xdb,
-	0xa5, 0xba, 0x32, 0x9a, 0xd3, 0xa4, 0x48, 0x57, 0x2e, 0x3d, 0xca, 0xf2, 0x2c, 0x34, 0x88, 0x6a,
-	0x3f, 0x61, 0x41, 0x15, 0xf2, 0xc8, 0x90, 0x38, 0x4b, 0x40, 0x1e, 0x2a, 0x19, 0x0f, 0x30, 0xad,
-	0xcf, 0x0e, 0xbb, 0x4c, 0x48, 0xe7, 0x21, 0x2c, 0x0e, 0xac, 0x8a, 0x8c, 0xa7, 0x82, 0x91, 0xb7,
-	0x60, 0x5a, 0xd3, 0x57, 0xac, 0x35, 0xab, 0x36, 0xbf, 0xbb, 0xea, 0x96, 0x15, 0xc6, 0xd5, 0x51,
-	0x7b, 0x93, 0x4f, 0xff, 0xba, 0x35, 0xe6, 0x9b, 0x08, 0x67, 0x11, 0x5e, 0xd1, 0x29, 0x39, 0x8f,
-	0x7b, 0x3c, 0xdf, 0x5a, 0x30, 0xab, 0x16, 0x0e, 0xd2, 0x16, 0x27, 0x04, 0x26, 0x53, 0x9a, 0x30,
-	0xcc, 0x3d, 0xe7, 0xe3, 0x33, 0x61, 0x30, 0xd3, 0xa0, 0x31, 0x4d, 0x43, 0x56, 0x19, 0x5f, 0x9b,
-	0xa8, 0xcd, 0xef, 0x2e, 0xbb, 0xda, 0x90, 0xab, 0x0c, 0xb9, 0xc6, 0x90, 0xbb, 0xcf, 0xa3, 0x74,
-	0x6f, 0x5b, 0xf1, 0xfd, 0xf6, 0xf7, 0xad, 0x5a, 0x3b, 0x92, 0x9d, 0x6e, 0xc3, 0x0d, 0x79, 0xe2,
-	0x19, 0xf7, 0xfa, 0x67, 0x4b, 0x34, 0x1f, 0x79, 0xf2, 0x71, 0xc6, 0x04, 0x06, 0x08, 0xbf, 0xc8,
-	0xed, 0xfc, 0x67, 0x15, 0x65, 0xd0, 0xea, 0x7a, 0x7e, 0xa7, 0x32, 0xb5, 0x50, 0xb1, 0x90, 0xbb,
-	0x7a, 0x89, 0x5d, 0x63, 0xc0, 0x18, 0xd6, 0x21, 0x64, 0x1d, 0x16, 0x64, 0x94, 0xb0, 0x40, 0xf2,
-	0x20, 0x67, 0xad, 0x28, 0x8e, 0x2b, 0xe3, 0x6b, 0x56, 0x6d, 0xc2, 0xbf, 0xa6, 0x56, 0x3f, 0xe5,
-	0x3e, 0xae, 0x91, 0xb7, 0xc1, 0x66, 0x42, 0x46, 0x09, 0x95, 0xac, 0x19, 0x34, 0x62, 0x1e, 0x3e,
-	0x12, 0x7d, 0x11, 0x13, 0x18, 0x71, 0xa3, 0x87, 0xd8, 0x43, 0x40, 0x2f, 0xf8, 0x1d, 0x58, 0xa1,
-	0x71, 0xcc, 0x43, 0x6c, 0x9a, 0x40, 0xd1, 0x06, 0x09, 0x4f, 0x65, 0x47, 0x04, 0x31, 0x6b, 0xc9,
-	0xca, 0x24, 0x46, 0x57, 0xce, 0x20, 0x4a, 0xe8, 0x27, 0x08, 0xb8, 0xcf, 0x5a, 0xd2, 0x59, 0x86,
-	0x1b, 0xe8, 0x19, 0xb3, 0xfa, 0x68, 0xa6, 0x38, 0x97, 0x3a, 0x54, 0x2e, 0x6e, 0x99, 0xa2, 0xbc,
-	0x01, 0xd3, 0xda, 0xb9, 0x69, 0x82, 0x21, 0x27, 0x62, 0x3a, 0x40, 0xc3, 0x9d, 0x15, 0x58, 0xc6,
-	0xa4, 0xf5, 0x0e, 0x3f, 0x3e, 0x50, 0x2d, 0xfa, 0x01, 0x95, 0xb4, 0x60, 0xfc, 0xce, 0x02, 0xbb,
-	0x6c, 0xb7, 0x77, 0x12, 0xb3, 0x49, 0x94, 0x06, 0x21, 0x17, 0x72, 0x54, 0xda, 0x99, 0x24, 0x4a,
-	0xf7, 0xb9, 0x90, 0xc4, 0x83, 0x45, 0xbc, 0x11, 0x81, 0xe8, 0x36, 0x44, 0x98, 0x47, 0x19, 0x5e,
-	0x48, 0xec, 0xa7, 0x39, 0x9f, 0xe0, 0x56, 0xbd, 0x7f, 0xc7, 0xa9, 0x1b, 0x29, 0x0f, 0x72, 0x7e,
-	0x14, 0x35, 0x59, 0x3e, 0x50, 0x1b, 0xb2, 0x0c, 0xb3, 0x61, 0x87, 0x46, 0x69, 0x10, 0x35, 0x4d,
-	0xab, 0xce, 0xe0, 0xfb, 0x41, 0x93, 0xd8, 0x30, 0x9b, 0x99, 0x18, 0x3c, 0xed, 0x39, 0xbf, 0xf7,
-	0xee, 0x7c, 0x09, 0xa0, 0xf3, 0x60, 0xaf, 0xbf, 0x58, 0x12, 0x55, 0x7b, 0x9a, 0xf0, 0x6e, 0x2a,
-	0xb1, 0x35, 0x46, 0xa9, 0xbd, 0x86, 0x3b, 0x01, 0xac, 0x94, 0x5a, 0x32, 0xe5, 0xbd, 0x07, 0x33,
-	0xa6, 0x9b, 0x4d, 0xab, 0xaf, 0x95, 0xb7, 0xfa, 0x99, 0x83, 0xa2, 0xc8, 0x66, 0xc7, 0xf9, 0x18,
-	0xee, 0x20, 0x01, 0x1e, 0xdd, 0x20, 0xcb, 0x87, 0xba, 0x7d, 0x23, 0x9e, 0x16, 0x25, 0xec, 0xb7,
-	0x68, 0x9d, 0xab, 0xd3, 0x21, 0x6c, 0x8e, 0x92, 0xc8, 0x08, 0xdf, 0x07, 0x10, 0x19, 0x0b, 0x83,
-	0x56, 0x37, 0x6d, 0x5e, 0x71, 0x4d, 0xeb, 0x19, 0x0b, 0x15, 0xcc, 0x28, 0x9f, 0x53, 0x71, 0x1f,
-	0xa9, 0x30, 0x67, 0xc7, 0x14, 0x07, 0x29, 0x15, 0x6c, 0xf0, 0xc0, 0x09, 0x4c, 0x2a, 0x6c, 0x31,
-	0x97, 0xd4, 0xb3, 0xf3, 0x93, 0x05, 0xab, 0xe5, 0x31, 0x46, 0xd8, 0x7d, 0xb8, 0xae, 0x9b, 0x6e,
-	0xb0, 0xae, 0xb7, 0xcb, 0xb5, 0x61, 0x16, 0x9d, 0xc1, 0xc8, 0xbb, 0x16, 0x9d, 0x2d, 0x09, 0x52,
-	0x83, 0x97, 0xc3, 0x6e, 0x9e, 0xb3, 0x54, 0xea, 0x1b, 0xae, 0xda, 0x46, 0xf5, 0xc6, 0xa4, 0xbf,
-	0x60, 0xd6, 0xf1, 0x5e, 0x1f, 0x34, 0x77, 0xbf, 0x9f, 0x83, 0x29, 0x14, 0x46, 0xbe, 0xb1, 0x60,
-	0x5a, 0x4f, 0x62, 0x52, 0x2b, 0x67, 0xbd, 0x38, 0xf8, 0xed, 0x3b, 0x23, 0x20, 0xb5, 0x43, 0x67,
-	0xfd, 0xeb, 0x3f, 0xfe, 0xfd, 0x71, 0xbc, 0x4a, 0x56, 0xbd, 0x21, 0xff, 0x53, 0xe4, 0x2b, 0x98,
-	0xc2, 0x99, 0x4a, 0x36, 0x86, 0x65, 0xee, 0xfb, 0x4f, 0xb0, 0x6b, 0x57, 0x03, 0x8d, 0x82, 0x57,
-	0x51, 0xc1, 0x4d, 0xb2, 0x72, 0x89, 0x02, 0xe4, 0xfd, 0xd9, 0x82, 0xf9, 0xbe, 0x31, 0x46, 0xb6,
-	0x86, 0xa4, 0xbf, 0x38, 0x09, 0x6d, 0x77, 0x54, 0xb8, 0xd1, 0xb4, 0x89, 0x9a, 0xd6, 0x89, 0x53,
-	0xae, 0x09, 0x47, 0xbc, 0xe9, 0x09, 0xf2, 0x8b, 0x05, 0xd7, 0x07, 0xc6, 0x1d, 0xf1, 0x86, 0xb0,
-	0x95, 0x8d, 0x4d, 0x7b, 0x7b, 0xf4, 0x00, 0x23, 0x70, 0x0b, 0x05, 0x6e, 0x90, 0xd7, 0xca, 0x05,
-	0x8a, 0x0e, 0x3f, 0x0e, 0x74, 0xe7, 0x36, 0x95, 0xa2, 0x5f, 0x2d, 0x58, 0x18, 0xbc, 0x85, 0x64,
-	0x18, 0x67, 0xe9, 0xc8, 0xb4, 0x77, 0x9e, 0x23, 0x62, 0x34, 0x99, 0xc5, 0xb8, 0x28, 0x4a, 0x79,
-	0x62, 0xc1, 0xcd, 0xa1, 0x13, 0x83, 0xbc, 0x37, 0x44, 0xc3, 0x28, 0x43, 0xcb, 0xbe, 0xf7, 0xe2,
-	0x09, 0x8c, 0xa7, 0x77, 0xd1, 0xd3, 0x9b, 0xe4, 0xae, 0x77, 0xf9, 0x67, 0x5b, 0x70, 0xce, 0x99,
-	0xf7, 0x45, 0xb1, 0xf0, 0x84, 0xfc, 0x6e, 0xc1, 0x4b, 0xe7, 0xe6, 0x0d, 0xd9, 0xb9, 0x4a, 0xd5,
-	0x85, 0x79, 0x66, 0xef, 0x3e, 0x4f, 0x88, 0x91, 0x7e, 0x17, 0xa5, 0x6f, 0x13, 0x77, 0x98, 0x74,
-	0x9c, 0xc4, 0x85, 0x6c, 0xf5, 0xf2, 0x64, 0xef, 0xfd, 0xa7, 0x27, 0x55, 0xeb, 0xd9, 0x49, 0xd5,
-	0xfa, 0xe7, 0xa4, 0x6a, 0xfd, 0x70, 0x5a, 0x1d, 0x7b, 0x76, 0x5a, 0x1d, 0xfb, 0xf3, 0xb4, 0x3a,
-	0xf6, 0xd9, 0x46, 0xdf, 0x57, 0xda, 0x40, 0xce, 0xcf, 0x7b, 0x59, 0xf1, 0x53, 0xad, 0x31, 0x8d,
-	0x1f, 0xaa, 0xaf, 0xff, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xaf, 0x0e, 0xc7, 0x33, 0xa7, 0x0b, 0x00,
-	0x00,
+	// 1131 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0xf3, 0x3f, 0xaf, 0x6d, 0x80, 0x49, 0x44, 0x37, 0x4e, 0xba, 0xd9, 0x9a, 0xa0, 0x6c,
+	0x23, 0xc5, 0x4e, 0x02, 0x04, 0x44, 0xf9, 0x53, 0x12, 0x28, 0x8a, 0xd4, 0x4a, 0xed, 0x2e, 0x27,
+	0x2e, 0x96, 0xd7, 0x3b, 0xbb, 0x6b, 0xd5, 0xeb, 0x71, 0x3c, 0xb3, 0x09, 0x55, 0x89, 0x90, 0x40,
+	0x1c, 0x7a, 0x43, 0x42, 0x42, 0x5c, 0x91, 0x38, 0x71, 0xe2, 0x33, 0x70, 0xea, 0xb1, 0x88, 0x0b,
+	0x27, 0x40, 0x09, 0x9f, 0x80, 0x4f, 0x80, 0xe6, 0xcd, 0x78, 0xff, 0x24, 0x8e, 0xb3, 0xe9, 0x69,
+	0xed, 0x99, 0xdf, 0x7b, 0xef, 0xf7, 0x7b, 0xf3, 0xe6, 0x3d, 0x2f, 0x94, 0x42, 0xef, 0xc0, 0x8b,
+	0xa8, 0x70, 0xe4, 0xaf, 0x93, 0xd0, 0x43, 0x2f, 0xa9, 0x73, 0x67, 0xbf, 0x43, 0x93, 0xc7, 0x76,
+	0x9c, 0x30, 0xc1, 0xc8, 0xbc, 0x46, 0xd8, 0xf2, 0xd7, 0xd6, 0x08, 0x73, 0xbe, 0xc9, 0x9a, 0x0c,
+	0x01, 0x8e, 0x7c, 0x52, 0x58, 0x73, 0xa9, 0xc9, 0x58, 0x33, 0xa4, 0x8e, 0x17, 0x07, 0x8e, 0x17,
+	0x45, 0x4c, 0x78, 0x22, 0x60, 0x11, 0xd7, 0xbb, 0x6b, 0x3e, 0xe3, 0x6d, 0xc6, 0x9d, 0x9a, 0xc7,
+	0xa9, 0x0a, 0xe1, 0x1c, 0x6c, 0xd6, 0xa8, 0xf0, 0x36, 0x9d, 0xd8, 0x6b, 0x06, 0x11, 0x82, 0x35,
+	0xf6, 0x66, 0x26, 0xaf, 0xd8, 0x4b, 0xbc, 0x76, 0xea, 0x2e, 0x9b, 0x7a, 0x10, 0x27, 0xb1, 0xaf,
+	0x11, 0xc5, 0xfe, 0x80, 0x69, 0x28, 0x9f, 0x05, 0x3a, 0x88, 0x35, 0x0f, 0xe4, 0xa1, 0xa4, 0xf1,
+	0x00, 0xdd, 0x56, 0xe8, 0x7e, 0x87, 0x72, 0x61, 0x3d, 0x84, 0xb9, 0x81, 0x55, 0x1e, 0xb3, 0x88,
+	0x53, 0xf2, 0x2e, 0x4c, 0xaa, 0xf0, 0x05, 0xa3, 0x64, 0x94, 0xaf, 0x6c, 0x2d, 0xd9, 0x59, 0x89,
+	0xb1, 0x95, 0xd5, 0xce, 0xf8, 0xb3, 0xbf, 0x96, 0x47, 0x2a, 0xda, 0xc2, 0x9a, 0x83, 0x57, 0x94,
+	0x4b, 0xc6, 0xc2, 0x6e, 0x9c, 0x6f, 0x0d, 0x98, 0x96, 0x0b, 0x7b, 0x51, 0x83, 0x11, 0x02, 0xe3,
+	0x91, 0xd7, 0xa6, 0xe8, 0x7b, 0xa6, 0x82, 0xcf, 0x84, 0xc2, 0x54, 0xcd, 0x0b, 0xbd, 0xc8, 0xa7,
+	0x85, 0xd1, 0xd2, 0x58, 0xf9, 0xca, 0xd6, 0x82, 0xad, 0x04, 0xd9, 0x52, 0x90, 0xad, 0x05, 0xd9,
+	0xbb, 0x2c, 0x88, 0x76, 0x36, 0x64, 0xbc, 0x5f, 0xfe, 0x5e, 0x2e, 0x37, 0x03, 0xd1, 0xea, 0xd4,
+	0x6c, 0x9f, 0xb5, 0x1d, 0xad, 0x5e, 0xfd, 0xac, 0xf3, 0xfa, 0x23, 0x47, 0x3c, 0x8e, 0x29, 0x47,
+	0x03, 0x5e, 0x49, 0x7d, 0x5b, 0xff, 0x19, 0x40, 0xfa, 0xd9, 0x75, 0xf5, 0x4e, 0xc4, 0x72, 0xa1,
+	0x60, 0x60, 0xec, 0xe2, 0x39, 0x72, 0xb5, 0x00, 0x2d, 0x58, 0x99, 0x90, 0x15, 0x98, 0x15, 0x41,
+	0x9b, 0xba, 0x82, 0xb9, 0x09, 0x6d, 0x04, 0x61, 0x58, 0x18, 0x2d, 0x19, 0xe5, 0xb1, 0xca, 0x55,
+	0xb9, 0xfa, 0x19, 0xab, 0xe0, 0x1a, 0xb9, 0x0d, 0x26, 0xe5, 0x22, 0x68, 0x7b, 0x82, 0xd6, 0xdd,
+	0x5a, 0xc8, 0xfc, 0x47, 0xbc, 0xcf, 0x62, 0x0c, 0x2d, 0xae, 0x77, 0x11, 0x3b, 0x08, 0xe8, 0x1a,
+	0xbf, 0x0f, 0x8b, 0x5e, 0x18, 0x32, 0x1f, 0x8b, 0xc6, 0x95, 0x61, 0xdd, 0x36, 0x8b, 0x44, 0x8b,
+	0xbb, 0x21, 0x6d, 0x88, 0xc2, 0x38, 0x5a, 0x17, 0x7a, 0x10, 0x49, 0xf4, 0x3e, 0x02, 0xee, 0xd1,
+	0x86, 0xb0, 0x16, 0xe0, 0x3a, 0x6a, 0x46, 0xaf, 0x15, 0x14, 0x93, 0x9e, 0x4b, 0x15, 0x0a, 0x67,
+	0xb7, 0x74, 0x52, 0xde, 0x86, 0x49, 0xa5, 0x5c, 0x17, 0x41, 0xce, 0x89, 0xe8, 0x0a, 0x50, 0x70,
+	0x6b, 0x11, 0x16, 0xd0, 0x69, 0xb5, 0xc5, 0x0e, 0xf7, 0x64, 0x89, 0x7e, 0xec, 0x09, 0x2f, 0x8d,
+	0xf8, 0xd4, 0x00, 0x33, 0x6b, 0xb7, 0x7b, 0x12, 0xd3, 0xed, 0x20, 0x72, 0x7d, 0xc6, 0xc5, 0xb0,
+	0x61, 0xa7, 0xda, 0x41, 0xb4, 0xcb, 0xb8, 0x20, 0x0e, 0xcc, 0xe1, 0x8d, 0x70, 0x79, 0xa7, 0xc6,
+	0xfd, 0x24, 0x88, 0xf1, 0x42, 0x62, 0x3d, 0xcd, 0x54, 0x08, 0x6e, 0x55, 0xfb, 0x77, 0xac, 0xaa,
+	0xa6, 0xf2, 0x20, 0x61, 0x07, 0x41, 0x9d, 0x26, 0x03, 0xb9, 0x21, 0x0b, 0x30, 0xed, 0xb7, 0xbc,
+	0x20, 0x72, 0x83, 0xba, 0x2e, 0xd5, 0x29, 0x7c, 0xdf, 0xab, 0x13, 0x13, 0xa6, 0x63, 0x6d, 0x83,
+	0xa7, 0x3d, 0x53, 0xe9, 0xbe, 0x5b, 0x5f, 0x02, 0x28, 0x3f, 0x58, 0xeb, 0x2f, 0xe6, 0x44, 0xe6,
+	0xde, 0x6b, 0xb3, 0x4e, 0x24, 0x0a, 0x63, 0xc3, 0x25, 0x41, 0xc3, 0x2d, 0x17, 0x16, 0x33, 0x25,
+	0xe9, 0xf4, 0xde, 0x81, 0x29, 0x5d, 0xcd, 0xba, 0xd4, 0x4b, 0xd9, 0xa5, 0xde, 0x53, 0x90, 0x26,
+	0x59, 0xef, 0x58, 0x9f, 0xc2, 0x2d, 0x0c, 0x80, 0x47, 0x37, 0x18, 0xe5, 0x13, 0x55, 0xbe, 0x01,
+	0x8b, 0xd2, 0x14, 0xf6, 0x4b, 0x34, 0x4e, 0xe5, 0x69, 0x1f, 0xd6, 0x86, 0x71, 0xa4, 0x89, 0xef,
+	0x02, 0xf0, 0x98, 0xfa, 0x6e, 0xa3, 0x13, 0xd5, 0x2f, 0xb8, 0xa6, 0xd5, 0x98, 0xfa, 0x12, 0xa6,
+	0x99, 0xcf, 0x48, 0xbb, 0xbb, 0xd2, 0xcc, 0xda, 0x84, 0xc5, 0x5e, 0x48, 0x09, 0x1b, 0x3c, 0x70,
+	0x02, 0xe3, 0x12, 0x9b, 0xf6, 0x25, 0xf9, 0x6c, 0xfd, 0x60, 0xc0, 0x52, 0xb6, 0x8d, 0x26, 0x76,
+	0x0f, 0xae, 0xa9, 0xa2, 0x1b, 0xcc, 0xeb, 0xcd, 0x6c, 0x6e, 0xe8, 0x45, 0x79, 0xd0, 0xf4, 0xae,
+	0x06, 0xbd, 0x25, 0x4e, 0xca, 0xf0, 0xb2, 0xdf, 0x49, 0x12, 0x1a, 0x09, 0x75, 0xc3, 0x65, 0xd9,
+	0xc8, 0xda, 0x18, 0xaf, 0xcc, 0xea, 0x75, 0xbc, 0xd7, 0x7b, 0x75, 0xeb, 0x3e, 0x2c, 0xf7, 0x78,
+	0x49, 0x79, 0x34, 0xa9, 0xfa, 0x2d, 0x5a, 0xef, 0x84, 0x34, 0xd5, 0xf3, 0x2a, 0x4c, 0x36, 0x70,
+	0x43, 0x2b, 0xd2, 0x6f, 0x5d, 0x9d, 0xa3, 0x7d, 0x3a, 0x9f, 0x1a, 0x50, 0x3a, 0xdf, 0x9f, 0xd6,
+	0x7a, 0x1b, 0x26, 0xfa, 0xf3, 0xbf, 0x9c, 0xa3, 0xf1, 0x6e, 0xef, 0x00, 0x94, 0xcd, 0xf0, 0xd2,
+	0xb6, 0x7e, 0x07, 0x98, 0x40, 0x2e, 0xe4, 0x1b, 0x03, 0x26, 0xd5, 0x90, 0x21, 0xe5, 0xec, 0x60,
+	0x67, 0x67, 0x9a, 0x79, 0x6b, 0x08, 0xa4, 0x12, 0x64, 0xad, 0x7c, 0xfd, 0xc7, 0xbf, 0xdf, 0x8f,
+	0x16, 0xc9, 0x92, 0x93, 0x33, 0x82, 0xc9, 0x57, 0x30, 0x81, 0xe3, 0x82, 0xac, 0xe6, 0x79, 0xee,
+	0x1b, 0x77, 0x66, 0xf9, 0x62, 0xa0, 0x66, 0xf0, 0x1a, 0x32, 0xb8, 0x41, 0x16, 0xcf, 0x61, 0x80,
+	0x71, 0x7f, 0x34, 0xe0, 0x4a, 0x5f, 0x87, 0x26, 0xeb, 0x39, 0xee, 0xcf, 0x36, 0x79, 0xd3, 0x1e,
+	0x16, 0xae, 0x39, 0xad, 0x21, 0xa7, 0x15, 0x62, 0x65, 0x73, 0xc2, 0xe9, 0xa5, 0xcb, 0x9d, 0xfc,
+	0x64, 0xc0, 0xb5, 0x81, 0x4e, 0x4e, 0x9c, 0x9c, 0x68, 0x59, 0x13, 0xc1, 0xdc, 0x18, 0xde, 0x40,
+	0x13, 0x5c, 0x47, 0x82, 0xab, 0xe4, 0xf5, 0x6c, 0x82, 0xbc, 0xc5, 0x0e, 0x5d, 0x75, 0x29, 0xeb,
+	0x92, 0xd1, 0xcf, 0x06, 0xcc, 0x0e, 0x36, 0x18, 0x92, 0x17, 0x33, 0x73, 0x1a, 0x98, 0x9b, 0x97,
+	0xb0, 0x18, 0x8e, 0x66, 0xda, 0x09, 0xd3, 0x54, 0x1e, 0x1b, 0x70, 0x23, 0xb7, 0x19, 0x92, 0x0f,
+	0x73, 0x38, 0x0c, 0xd3, 0x8f, 0xcd, 0x3b, 0x2f, 0xee, 0x40, 0x6b, 0xfa, 0x00, 0x35, 0xbd, 0x43,
+	0xb6, 0x9d, 0xf3, 0xbf, 0x48, 0xdd, 0x53, 0xca, 0x9c, 0x27, 0xe9, 0xc2, 0x11, 0xf9, 0xd5, 0x80,
+	0x97, 0x4e, 0xb5, 0x52, 0xb2, 0x79, 0x11, 0xab, 0x33, 0xad, 0xda, 0xdc, 0xba, 0x8c, 0x89, 0xa6,
+	0xbe, 0x8d, 0xd4, 0x37, 0x88, 0x9d, 0x47, 0x1d, 0x87, 0x4c, 0x4a, 0x5b, 0xbe, 0x1c, 0x91, 0xdf,
+	0x0c, 0x98, 0xcb, 0xe8, 0x8a, 0xe4, 0xad, 0x8b, 0x38, 0x64, 0x76, 0x65, 0x73, 0xfb, 0xb2, 0x66,
+	0x9a, 0xfe, 0x7b, 0x48, 0x7f, 0x9b, 0xbc, 0x99, 0x47, 0x5f, 0x75, 0x78, 0x97, 0x6b, 0x63, 0xe7,
+	0x89, 0x5a, 0x38, 0xda, 0xf9, 0xe8, 0xd9, 0x71, 0xd1, 0x78, 0x7e, 0x5c, 0x34, 0xfe, 0x39, 0x2e,
+	0x1a, 0xdf, 0x9d, 0x14, 0x47, 0x9e, 0x9f, 0x14, 0x47, 0xfe, 0x3c, 0x29, 0x8e, 0x7c, 0xbe, 0xda,
+	0xf7, 0x15, 0x3d, 0xe0, 0xf9, 0x8b, 0xae, 0x6f, 0xfc, 0x94, 0xae, 0x4d, 0xe2, 0x1f, 0x89, 0x37,
+	0xfe, 0x1f, 0x00, 0x1a, 0x75, 0xbc, 0x1a, 0x47, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -917,6 +1030,8 @@ type QueryClient interface {
 	IprpcProviderRewardEstimation(ctx context.Context, in *QueryIprpcProviderRewardEstimationRequest, opts ...grpc.CallOption) (*QueryIprpcProviderRewardEstimationResponse, error)
 	// IprpcSpecReward queries for a spec's IPRPC reward
 	IprpcSpecReward(ctx context.Context, in *QueryIprpcSpecRewardRequest, opts ...grpc.CallOption) (*QueryIprpcSpecRewardResponse, error)
+	// IprpcFunderSchedule queries for a funder's remaining IPRPC fund schedule
+	IprpcFunderSchedule(ctx context.Context, in *QueryIprpcFunderScheduleRequest, opts ...grpc.CallOption) (*QueryIprpcFunderScheduleResponse, error)
 }
 
 type queryClient struct {
@@ -990,6 +1105,15 @@ func (c *queryClient) IprpcSpecReward(ctx context.Context, in *QueryIprpcSpecRew
 	return out, nil
 }
 
+func (c *queryClient) IprpcFunderSchedule(ctx context.Context, in *QueryIprpcFunderScheduleRequest, opts ...grpc.CallOption) (*QueryIprpcFunderScheduleResponse, error) {
+	out := new(QueryIprpcFunderScheduleResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.rewards.Query/IprpcFunderSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -1006,6 +1130,8 @@ type QueryServer interface {
 	IprpcProviderRewardEstimation(context.Context, *QueryIprpcProviderRewardEstimationRequest) (*QueryIprpcProviderRewardEstimationResponse, error)
 	// IprpcSpecReward queries for a spec's IPRPC reward
 	IprpcSpecReward(context.Context, *QueryIprpcSpecRewardRequest) (*QueryIprpcSpecRewardResponse, error)
+	// IprpcFunderSchedule queries for a funder's remaining IPRPC fund schedule
+	IprpcFunderSchedule(context.Context, *QueryIprpcFunderScheduleRequest) (*QueryIprpcFunderScheduleResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1033,6 +1159,9 @@ func (*UnimplementedQueryServer) IprpcProviderRewardEstimation(ctx context.Conte
 func (*UnimplementedQueryServer) IprpcSpecReward(ctx context.Context, req *QueryIprpcSpecRewardRequest) (*QueryIprpcSpecRewardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IprpcSpecReward not implemented")
 }
+func (*UnimplementedQueryServer) IprpcFunderSchedule(ctx context.Context, req *QueryIprpcFunderScheduleRequest) (*QueryIprpcFunderScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IprpcFunderSchedule not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1164,6 +1293,24 @@ func _Query_IprpcSpecReward_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_IprpcFunderSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIprpcFunderScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IprpcFunderSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.rewards.Query/IprpcFunderSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IprpcFunderSchedule(ctx, req.(*QueryIprpcFunderScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.rewards.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1196,6 +1343,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "IprpcSpecReward",
 			Handler:    _Query_IprpcSpecReward_Handler,
 		},
+		{
+			MethodName: "IprpcFunderSchedule",
+			Handler:    _Query_IprpcFunderSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/rewards/query.proto",
@@ -1757,6 +1908,85 @@ func (m *QueryIprpcSpecRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryIprpcFunderScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIprpcFunderScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIprpcFunderScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spec) > 0 {
+		i -= len(m.Spec)
+		copy(dAtA[i:], m.Spec)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Spec)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIprpcFunderScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIprpcFunderScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIprpcFunderScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentMonthId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentMonthId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Funds) > 0 {
+		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1996,6 +2226,41 @@ func (m *QueryIprpcSpecRewardResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryIprpcFunderScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Spec)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIprpcFunderScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CurrentMonthId != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentMonthId))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3436,6 +3701,223 @@ func (m *QueryIprpcSpecRewardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryIprpcFunderScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIprpcFunderScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIprpcFunderScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIprpcFunderScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIprpcFunderScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIprpcFunderScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funds = append(m.Funds, IprpcFund{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentMonthId", wireType)
+			}
+			m.CurrentMonthId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentMonthId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -230,45 +230,156 @@ func (m *MsgFundIprpcResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFundIprpcResponse proto.InternalMessageInfo
 
+type MsgWithdrawIprpcFund struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Spec    string `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+}
+
+func (m *MsgWithdrawIprpcFund) Reset()         { *m = MsgWithdrawIprpcFund{} }
+func (m *MsgWithdrawIprpcFund) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawIprpcFund) ProtoMessage()    {}
+func (*MsgWithdrawIprpcFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a4c66e189226d78, []int{4}
+}
+func (m *MsgWithdrawIprpcFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawIprpcFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawIprpcFund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawIprpcFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawIprpcFund.Merge(m, src)
+}
+func (m *MsgWithdrawIprpcFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawIprpcFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawIprpcFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawIprpcFund proto.InternalMessageInfo
+
+func (m *MsgWithdrawIprpcFund) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgWithdrawIprpcFund) GetSpec() string {
+	if m != nil {
+		return m.Spec
+	}
+	return ""
+}
+
+type MsgWithdrawIprpcFundResponse struct {
+	Refund  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=refund,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refund"`
+	Penalty github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=penalty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"penalty"`
+}
+
+func (m *MsgWithdrawIprpcFundResponse) Reset()         { *m = MsgWithdrawIprpcFundResponse{} }
+func (m *MsgWithdrawIprpcFundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawIprpcFundResponse) ProtoMessage()    {}
+func (*MsgWithdrawIprpcFundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a4c66e189226d78, []int{5}
+}
+func (m *MsgWithdrawIprpcFundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawIprpcFundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawIprpcFundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawIprpcFundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawIprpcFundResponse.Merge(m, src)
+}
+func (m *MsgWithdrawIprpcFundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawIprpcFundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawIprpcFundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawIprpcFundResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawIprpcFundResponse) GetRefund() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Refund
+	}
+	return nil
+}
+
+func (m *MsgWithdrawIprpcFundResponse) GetPenalty() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Penalty
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgSetIprpcData)(nil), "lavanet.lava.rewards.MsgSetIprpcData")
 	proto.RegisterType((*MsgSetIprpcDataResponse)(nil), "lavanet.lava.rewards.MsgSetIprpcDataResponse")
 	proto.RegisterType((*MsgFundIprpc)(nil), "lavanet.lava.rewards.MsgFundIprpc")
 	proto.RegisterType((*MsgFundIprpcResponse)(nil), "lavanet.lava.rewards.MsgFundIprpcResponse")
+	proto.RegisterType((*MsgWithdrawIprpcFund)(nil), "lavanet.lava.rewards.MsgWithdrawIprpcFund")
+	proto.RegisterType((*MsgWithdrawIprpcFundResponse)(nil), "lavanet.lava.rewards.MsgWithdrawIprpcFundResponse")
 }
 
 func init() { proto.RegisterFile("lavanet/lava/rewards/tx.proto", fileDescriptor_6a4c66e189226d78) }
 
 var fileDescriptor_6a4c66e189226d78 = []byte{
-	// 443 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcd, 0x6e, 0xd4, 0x30,
-	0x10, 0xc7, 0xd7, 0xec, 0x8a, 0xb2, 0x66, 0x05, 0x92, 0x59, 0x41, 0x1a, 0x41, 0xba, 0x8a, 0x84,
-	0x88, 0x90, 0x6a, 0xd3, 0xf2, 0x04, 0xb4, 0x80, 0xc4, 0x61, 0x2f, 0xe9, 0x0d, 0x0e, 0x95, 0x93,
-	0x58, 0xa9, 0x05, 0xb1, 0x23, 0x8f, 0x53, 0xda, 0xb7, 0xe0, 0x2d, 0x90, 0x78, 0x09, 0xae, 0x7b,
-	0xec, 0x91, 0x13, 0xa0, 0xdd, 0x17, 0x41, 0x76, 0x92, 0xee, 0xb6, 0xe2, 0xeb, 0x34, 0x63, 0xff,
-	0x67, 0xc6, 0xbf, 0x19, 0x0f, 0x7e, 0xf4, 0x81, 0x9f, 0x72, 0x25, 0x2c, 0x73, 0x96, 0x19, 0xf1,
-	0x91, 0x9b, 0x02, 0x98, 0x3d, 0xa3, 0xb5, 0xd1, 0x56, 0x93, 0x69, 0x27, 0x53, 0x67, 0x69, 0x27,
-	0x87, 0x51, 0xae, 0xa1, 0xd2, 0xc0, 0x32, 0x0e, 0x82, 0x9d, 0xee, 0x65, 0xc2, 0xf2, 0x3d, 0x96,
-	0x6b, 0xa9, 0xda, 0xac, 0x70, 0x5a, 0xea, 0x52, 0x7b, 0x97, 0x39, 0xaf, 0xbd, 0x8d, 0x3f, 0x23,
-	0x7c, 0x77, 0x0e, 0xe5, 0x91, 0xb0, 0x6f, 0x6a, 0x53, 0xe7, 0x2f, 0xb9, 0xe5, 0xe4, 0x21, 0x1e,
-	0xf3, 0xc6, 0x9e, 0x68, 0x23, 0xed, 0x79, 0x80, 0x66, 0x28, 0x19, 0xa7, 0xeb, 0x0b, 0xf2, 0x0a,
-	0xdf, 0xa9, 0xa4, 0x3a, 0x96, 0x2e, 0xfc, 0x38, 0xd7, 0x60, 0x83, 0x1b, 0x33, 0x94, 0xdc, 0xde,
-	0xdf, 0xa6, 0x2d, 0x00, 0x75, 0x00, 0xb4, 0x03, 0xa0, 0x87, 0x5a, 0xaa, 0x83, 0xd1, 0xe2, 0xfb,
-	0xce, 0x20, 0x9d, 0x54, 0x52, 0xf9, 0x47, 0x0e, 0x35, 0x58, 0xc2, 0xf0, 0xbd, 0xb6, 0x04, 0x34,
-	0x19, 0xe4, 0x46, 0xd6, 0x56, 0x6a, 0x05, 0xc1, 0x70, 0x36, 0x4c, 0xc6, 0x29, 0xf1, 0xd2, 0xd1,
-	0xa6, 0x12, 0x6f, 0xe3, 0x07, 0xd7, 0x40, 0x53, 0x01, 0xb5, 0x56, 0x20, 0xe2, 0xaf, 0x08, 0x4f,
-	0xe6, 0x50, 0xbe, 0x6e, 0x54, 0xe1, 0x45, 0x12, 0xe0, 0xad, 0xdc, 0x08, 0x6e, 0xb5, 0xe9, 0xf8,
-	0xfb, 0x23, 0x09, 0xf1, 0xad, 0xa2, 0x31, 0xdc, 0x95, 0xf4, 0xdc, 0xa3, 0xf4, 0xf2, 0x4c, 0x04,
-	0xde, 0xe2, 0x95, 0x6e, 0x94, 0x6d, 0x31, 0xfe, 0xda, 0xd2, 0x33, 0xd7, 0xd2, 0x97, 0x1f, 0x3b,
-	0x49, 0x29, 0xed, 0x49, 0x93, 0xd1, 0x5c, 0x57, 0xac, 0xfb, 0x80, 0xd6, 0xec, 0x42, 0xf1, 0x9e,
-	0xd9, 0xf3, 0x5a, 0x80, 0x4f, 0x80, 0xb4, 0xaf, 0x4d, 0x08, 0x1e, 0x41, 0x2d, 0xf2, 0x60, 0xe4,
-	0xc9, 0xbc, 0x1f, 0xdf, 0xc7, 0xd3, 0xcd, 0x06, 0xfa, 0xce, 0xf6, 0x17, 0x08, 0x0f, 0xe7, 0x50,
-	0x92, 0x02, 0x4f, 0xae, 0x7c, 0xd1, 0x63, 0xfa, 0xbb, 0x1d, 0xa0, 0xd7, 0x06, 0x14, 0xee, 0xfe,
-	0x57, 0x58, 0xff, 0x1a, 0x79, 0x87, 0xc7, 0xeb, 0x19, 0xc6, 0x7f, 0xcc, 0xbd, 0x8c, 0x09, 0x9f,
-	0xfe, 0x3b, 0xa6, 0x2f, 0x7e, 0xf0, 0x62, 0xb1, 0x8c, 0xd0, 0xc5, 0x32, 0x42, 0x3f, 0x97, 0x11,
-	0xfa, 0xb4, 0x8a, 0x06, 0x17, 0xab, 0x68, 0xf0, 0x6d, 0x15, 0x0d, 0xde, 0x3e, 0xd9, 0x98, 0xe1,
-	0x95, 0xcd, 0x3f, 0x5b, 0xef, 0xbe, 0x1b, 0x64, 0x76, 0xd3, 0xef, 0xec, 0xf3, 0x5f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x87, 0x10, 0x57, 0xff, 0x20, 0x03, 0x00, 0x00,
+	// 516 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4d, 0x6e, 0x13, 0x31,
+	0x18, 0x8d, 0x93, 0xa8, 0x25, 0x26, 0x02, 0x61, 0x22, 0x98, 0x8e, 0xca, 0x34, 0x8a, 0x84, 0x88,
+	0x90, 0x6a, 0xd3, 0x70, 0x02, 0xda, 0x82, 0xc4, 0x22, 0x9b, 0xe9, 0x02, 0x09, 0x16, 0x95, 0x33,
+	0x63, 0x26, 0x16, 0x8d, 0x3d, 0xb2, 0x3d, 0x6d, 0x73, 0x0b, 0x6e, 0x81, 0xc4, 0x1d, 0x10, 0xdb,
+	0x2e, 0xbb, 0x64, 0x05, 0x28, 0xd9, 0x71, 0x0a, 0x64, 0xcf, 0x4f, 0xd2, 0x5f, 0xba, 0xa0, 0x2b,
+	0x7b, 0xfc, 0x9e, 0x9f, 0xdf, 0xf3, 0xe7, 0xf9, 0xe0, 0x93, 0x03, 0x7a, 0x48, 0x05, 0x33, 0xc4,
+	0x8e, 0x44, 0xb1, 0x23, 0xaa, 0x62, 0x4d, 0xcc, 0x31, 0x4e, 0x95, 0x34, 0x12, 0x75, 0x0a, 0x18,
+	0xdb, 0x11, 0x17, 0xb0, 0x1f, 0x44, 0x52, 0x4f, 0xa4, 0x26, 0x23, 0xaa, 0x19, 0x39, 0xdc, 0x1a,
+	0x31, 0x43, 0xb7, 0x48, 0x24, 0xb9, 0xc8, 0x77, 0xf9, 0x9d, 0x44, 0x26, 0xd2, 0x4d, 0x89, 0x9d,
+	0xe5, 0xab, 0xbd, 0x2f, 0x00, 0xde, 0x1f, 0xea, 0x64, 0x8f, 0x99, 0xb7, 0xa9, 0x4a, 0xa3, 0x5d,
+	0x6a, 0x28, 0x5a, 0x87, 0x2d, 0x9a, 0x99, 0xb1, 0x54, 0xdc, 0x4c, 0x3d, 0xd0, 0x05, 0xfd, 0x56,
+	0xb8, 0x58, 0x40, 0xaf, 0xe1, 0xbd, 0x09, 0x17, 0xfb, 0xdc, 0xd2, 0xf7, 0x23, 0xa9, 0x8d, 0x57,
+	0xef, 0x82, 0xfe, 0xdd, 0xc1, 0x1a, 0xce, 0x0d, 0x60, 0x6b, 0x00, 0x17, 0x06, 0xf0, 0x8e, 0xe4,
+	0x62, 0xbb, 0x79, 0xf2, 0x73, 0xa3, 0x16, 0xb6, 0x27, 0x5c, 0xb8, 0x43, 0x76, 0xa4, 0x36, 0x88,
+	0xc0, 0x87, 0xb9, 0x84, 0xce, 0x46, 0x3a, 0x52, 0x3c, 0x35, 0x5c, 0x0a, 0xed, 0x35, 0xba, 0x8d,
+	0x7e, 0x2b, 0x44, 0x0e, 0xda, 0x5b, 0x46, 0x7a, 0x6b, 0xf0, 0xf1, 0x39, 0xa3, 0x21, 0xd3, 0xa9,
+	0x14, 0x9a, 0xf5, 0xbe, 0x03, 0xd8, 0x1e, 0xea, 0xe4, 0x4d, 0x26, 0x62, 0x07, 0x22, 0x0f, 0xae,
+	0x46, 0x8a, 0x51, 0x23, 0x55, 0xe1, 0xbf, 0xfc, 0x44, 0x3e, 0xbc, 0x13, 0x67, 0x8a, 0x5a, 0x49,
+	0xe7, 0xbb, 0x19, 0x56, 0xdf, 0x88, 0xc1, 0x55, 0x3a, 0x91, 0x99, 0x30, 0xb9, 0x8d, 0x6b, 0x23,
+	0xbd, 0xb0, 0x91, 0xbe, 0xfe, 0xda, 0xe8, 0x27, 0xdc, 0x8c, 0xb3, 0x11, 0x8e, 0xe4, 0x84, 0x14,
+	0x05, 0xc8, 0x87, 0x4d, 0x1d, 0x7f, 0x22, 0x66, 0x9a, 0x32, 0xed, 0x36, 0xe8, 0xb0, 0xd4, 0x46,
+	0x08, 0x36, 0x75, 0xca, 0x22, 0xaf, 0xe9, 0x9c, 0xb9, 0x79, 0xef, 0x11, 0xec, 0x2c, 0x07, 0xa8,
+	0x92, 0xed, 0xba, 0xf5, 0x77, 0xdc, 0x8c, 0x63, 0x45, 0x8f, 0x1c, 0x66, 0x49, 0xd7, 0x04, 0x2c,
+	0xd5, 0xeb, 0x4b, 0xea, 0x7f, 0x00, 0x5c, 0xbf, 0x4c, 0xa6, 0x3c, 0x06, 0x45, 0x70, 0x45, 0xb1,
+	0x8f, 0x99, 0x88, 0x3d, 0xf0, 0xff, 0x83, 0x17, 0xd2, 0xf6, 0x7a, 0x53, 0x26, 0xe8, 0x81, 0x99,
+	0x7a, 0xf5, 0x5b, 0xb8, 0xde, 0x42, 0x7b, 0xf0, 0xad, 0x0e, 0x1b, 0x43, 0x9d, 0xa0, 0x18, 0xb6,
+	0xcf, 0xbc, 0xea, 0xa7, 0xf8, 0xb2, 0xdf, 0x06, 0x9f, 0x7b, 0x53, 0xfe, 0xe6, 0x8d, 0x68, 0xd5,
+	0xcd, 0x7d, 0x80, 0xad, 0xc5, 0xb3, 0xeb, 0x5d, 0xb9, 0xb7, 0xe2, 0xf8, 0xcf, 0xff, 0xcd, 0xa9,
+	0xc4, 0x35, 0x7c, 0x70, 0xb1, 0xf4, 0x57, 0x0b, 0x5c, 0xe0, 0xfa, 0x83, 0x9b, 0x73, 0xcb, 0x43,
+	0xb7, 0x5f, 0x9d, 0xcc, 0x02, 0x70, 0x3a, 0x0b, 0xc0, 0xef, 0x59, 0x00, 0x3e, 0xcf, 0x83, 0xda,
+	0xe9, 0x3c, 0xa8, 0xfd, 0x98, 0x07, 0xb5, 0xf7, 0xcf, 0x96, 0x8a, 0x71, 0xa6, 0x43, 0x1d, 0x2f,
+	0x7a, 0x94, 0xad, 0xc8, 0x68, 0xc5, 0xf5, 0x96, 0x97, 0x7f, 0x07, 0x00, 0xc2, 0x30, 0x89, 0xdf,
+	0xc8, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -285,6 +396,7 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	SetIprpcData(ctx context.Context, in *MsgSetIprpcData, opts ...grpc.CallOption) (*MsgSetIprpcDataResponse, error)
 	FundIprpc(ctx context.Context, in *MsgFundIprpc, opts ...grpc.CallOption) (*MsgFundIprpcResponse, error)
+	WithdrawIprpcFund(ctx context.Context, in *MsgWithdrawIprpcFund, opts ...grpc.CallOption) (*MsgWithdrawIprpcFundResponse, error)
 }
 
 type msgClient struct {
@@ -313,10 +425,20 @@ func (c *msgClient) FundIprpc(ctx context.Context, in *MsgFundIprpc, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) WithdrawIprpcFund(ctx context.Context, in *MsgWithdrawIprpcFund, opts ...grpc.CallOption) (*MsgWithdrawIprpcFundResponse, error) {
+	out := new(MsgWithdrawIprpcFundResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.rewards.Msg/WithdrawIprpcFund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	SetIprpcData(context.Context, *MsgSetIprpcData) (*MsgSetIprpcDataResponse, error)
 	FundIprpc(context.Context, *MsgFundIprpc) (*MsgFundIprpcResponse, error)
+	WithdrawIprpcFund(context.Context, *MsgWithdrawIprpcFund) (*MsgWithdrawIprpcFundResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -329,6 +451,9 @@ func (*UnimplementedMsgServer) SetIprpcData(ctx context.Context, req *MsgSetIprp
 func (*UnimplementedMsgServer) FundIprpc(ctx context.Context, req *MsgFundIprpc) (*MsgFundIprpcResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FundIprpc not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawIprpcFund(ctx context.Context, req *MsgWithdrawIprpcFund) (*MsgWithdrawIprpcFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawIprpcFund not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -370,6 +495,24 @@ func _Msg_FundIprpc_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawIprpcFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawIprpcFund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawIprpcFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.rewards.Msg/WithdrawIprpcFund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawIprpcFund(ctx, req.(*MsgWithdrawIprpcFund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.rewards.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -382,6 +525,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FundIprpc",
 			Handler:    _Msg_FundIprpc_Handler,
 		},
+		{
+			MethodName: "WithdrawIprpcFund",
+			Handler:    _Msg_WithdrawIprpcFund_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/rewards/tx.proto",
@@ -538,6 +685,94 @@ func (m *MsgFundIprpcResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWithdrawIprpcFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawIprpcFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawIprpcFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spec) > 0 {
+		i -= len(m.Spec)
+		copy(dAtA[i:], m.Spec)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Spec)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawIprpcFundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawIprpcFundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawIprpcFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Penalty) > 0 {
+		for iNdEx := len(m.Penalty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Penalty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Refund) > 0 {
+		for iNdEx := len(m.Refund) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refund[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -614,6 +849,44 @@ func (m *MsgFundIprpcResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawIprpcFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Spec)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawIprpcFundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Refund) > 0 {
+		for _, e := range m.Refund {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Penalty) > 0 {
+		for _, e := range m.Penalty {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1034,6 +1307,238 @@ func (m *MsgFundIprpcResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgWithdrawIprpcFund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawIprpcFund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawIprpcFund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawIprpcFundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawIprpcFundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawIprpcFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refund = append(m.Refund, types.Coin{})
+			if err := m.Refund[len(m.Refund)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Penalty = append(m.Penalty, types.Coin{})
+			if err := m.Penalty[len(m.Penalty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -53,6 +53,7 @@ const (
 	SetIprpcDataEventName                          = "set-iprpc-data"
 	FundIprpcEventName                             = "fund-iprpc"
 	TransferIprpcRewardToNextMonthEventName        = "transfer-iprpc-reward-to-next-month"
+	WithdrawIprpcFundEventName                     = "withdraw-iprpc-fund"
 )
 
 // helper struct to track the serviced IPRPC CU for each spec+provider
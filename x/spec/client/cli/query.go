@@ -27,6 +27,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdListSpec())
 	cmd.AddCommand(CmdShowSpec())
+	cmd.AddCommand(CmdShowSpecAtBlock())
 
 	cmd.AddCommand(CmdShowAllChains())
 
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -97,3 +98,45 @@ func CmdShowSpec() *cobra.Command {
 
 	return cmd
 }
+
+func CmdShowSpecAtBlock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-spec-at-block [index] [block]",
+		Short: "shows the Spec version that was in effect at a specific block",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argIndex := args[0]
+			argBlock, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			raw, _ := cmd.Flags().GetBool(FlagRaw)
+
+			params := &types.QuerySpecAtBlockRequest{
+				ChainID: argIndex,
+				Block:   argBlock,
+				Raw:     raw,
+			}
+
+			res, err := queryClient.SpecAtBlock(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(FlagRaw, false, "Show the Spec in raw format (before imports)")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
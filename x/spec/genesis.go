@@ -9,8 +9,14 @@ import (
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
-	// Set all the spec
+	k.InitSpecs(ctx, genState.SpecsFS)
+
+	// Set all the spec of SpecList (used to add specs in a new genesis, the spec
+	// versions of SpecsFS take precedence)
 	for _, elem := range genState.SpecList {
+		if _, found := k.GetSpec(ctx, elem.Index); found {
+			continue
+		}
 		k.SetSpec(ctx, elem)
 	}
 
@@ -23,8 +29,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 
-	genesis.SpecList = k.GetAllSpec(ctx)
-	genesis.SpecCount = uint64(len(genesis.SpecList))
+	// the specs (with all their versions) are exported only in SpecsFS
+	genesis.SpecsFS = k.ExportSpecs(ctx)
 
 	// this line is used by starport scaffolding # genesis/module/export
 
@@ -2,8 +2,9 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
-	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/lavanet/lava/x/spec/types"
@@ -19,34 +20,70 @@ func (k Keeper) doSpecAll(c context.Context, req *types.QueryAllSpecRequest, raw
 	var specs []types.Spec
 	ctx := sdk.UnwrapSDKContext(c)
 
-	store := ctx.KVStore(k.storeKey)
-	specStore := prefix.NewStore(store, types.KeyPrefix(types.SpecKeyPrefix))
-
-	pageRes, err := query.Paginate(specStore, req.Pagination, func(key, value []byte) error {
-		var spec types.Spec
+	indices, pageRes, err := paginateSpecIndices(k.GetAllSpecIndices(ctx), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-		if err := k.cdc.Unmarshal(value, &spec); err != nil {
-			return err
+	for _, index := range indices {
+		spec, found := k.GetSpec(ctx, index)
+		if !found { // not yet in effect
+			continue
 		}
 
 		if !raw {
 			var err error
 			spec, err = k.ExpandSpec(ctx, spec)
 			if err != nil { // should not happen! (all specs on chain must be valid)
-				return status.Error(codes.Internal, err.Error())
+				return nil, status.Error(codes.Internal, err.Error())
 			}
 		}
 
 		specs = append(specs, spec)
-		return nil
-	})
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return &types.QueryAllSpecResponse{Spec: specs, Pagination: pageRes}, nil
 }
 
+// paginateSpecIndices applies a page request on the (sorted) spec indices, similarly to
+// query.Paginate. The pagination key is the index of the first spec of the next page.
+func paginateSpecIndices(indices []string, pageReq *query.PageRequest) ([]string, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	offset, limit := pageReq.Offset, pageReq.Limit
+	if offset > 0 && pageReq.Key != nil {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	start := offset
+	if pageReq.Key != nil {
+		start = uint64(sort.SearchStrings(indices, string(pageReq.Key)))
+	}
+	if start > uint64(len(indices)) {
+		start = uint64(len(indices))
+	}
+
+	end := start + limit
+	if end > uint64(len(indices)) {
+		end = uint64(len(indices))
+	}
+
+	pageRes := &query.PageResponse{}
+	if end < uint64(len(indices)) {
+		pageRes.NextKey = []byte(indices[end])
+	}
+	if pageReq.Key == nil && pageReq.CountTotal {
+		pageRes.Total = uint64(len(indices))
+	}
+
+	return indices[start:end], pageRes, nil
+}
+
 func (k Keeper) SpecAll(c context.Context, req *types.QueryAllSpecRequest) (*types.QueryAllSpecResponse, error) {
 	return k.doSpecAll(c, req, false)
 }
@@ -87,3 +124,25 @@ func (k Keeper) Spec(c context.Context, req *types.QueryGetSpecRequest) (*types.
 func (k Keeper) SpecRaw(c context.Context, req *types.QueryGetSpecRequest) (*types.QueryGetSpecResponse, error) {
 	return k.doSpec(c, req, true)
 }
+
+func (k Keeper) SpecAtBlock(c context.Context, req *types.QuerySpecAtBlockRequest) (*types.QuerySpecAtBlockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	spec, activationBlock, found := k.GetSpecVersion(ctx, req.ChainID, req.Block)
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, "not found")
+	}
+
+	if !req.Raw {
+		var err error
+		spec, err = k.ExpandSpecForBlock(ctx, spec, req.Block)
+		if err != nil { // should not happen! (all specs on chain must be valid)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &types.QuerySpecAtBlockResponse{Spec: spec, ActivationBlock: activationBlock}, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	fixationtypes "github.com/lavanet/lava/x/fixationstore/types"
 	"github.com/lavanet/lava/x/spec/types"
 )
 
@@ -21,6 +22,8 @@ type (
 		paramstore paramtypes.Subspace
 
 		stakingKeeper types.StakingKeeper
+
+		specsFS fixationtypes.FixationStore
 	}
 )
 
@@ -30,21 +33,35 @@ func NewKeeper(
 	memKey storetypes.StoreKey,
 	ps paramtypes.Subspace,
 	stakingKeeper types.StakingKeeper,
+	fixationStoreKeeper types.FixationStoreKeeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
 		ps = ps.WithKeyTable(types.ParamKeyTable())
 	}
 
+	fs := *fixationStoreKeeper.NewFixationStore(storeKey, types.SpecFixationStorePrefix)
+
 	return &Keeper{
 		cdc:           cdc,
 		storeKey:      storeKey,
 		memKey:        memKey,
 		paramstore:    ps,
 		stakingKeeper: stakingKeeper,
+		specsFS:       fs,
 	}
 }
 
+// Export all specs (including their versions) from the KVStore
+func (k Keeper) ExportSpecs(ctx sdk.Context) fixationtypes.GenesisState {
+	return k.specsFS.Export(ctx)
+}
+
+// Init all specs (including their versions) in the KVStore
+func (k Keeper) InitSpecs(ctx sdk.Context, gs fixationtypes.GenesisState) {
+	k.specsFS.Init(ctx, gs)
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -17,11 +17,34 @@ func NewMigrator(keeper Keeper) Migrator {
 	return Migrator{keeper: keeper}
 }
 
+// setLegacySpec sets a spec in the legacy spec store (used by migrations up to v5)
+func (m Migrator) setLegacySpec(ctx sdk.Context, spec types.Spec) {
+	store := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.KeyPrefix(types.SpecKeyPrefix))
+	b := m.keeper.cdc.MustMarshal(&spec)
+	store.Set(types.SpecKey(spec.Index), b)
+}
+
+// getAllLegacySpec returns all the specs of the legacy spec store (used by migrations up to v5)
+func (m Migrator) getAllLegacySpec(ctx sdk.Context) (list []types.Spec) {
+	store := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.KeyPrefix(types.SpecKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Spec
+		m.keeper.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
-	specs := m.keeper.GetAllSpec(ctx)
+	specs := m.getAllLegacySpec(ctx)
 	for _, spec := range specs {
 		spec.Name = strings.ToLower(spec.Name)
-		m.keeper.SetSpec(ctx, spec)
+		m.setLegacySpec(ctx, spec)
 	}
 	return nil
 }
@@ -45,7 +68,24 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 			}
 		}
 
-		m.keeper.SetSpec(ctx, spec)
+		m.setLegacySpec(ctx, spec)
+	}
+
+	return nil
+}
+
+// Migrate4to5 moves the specs from the legacy spec store to the specs fixation store.
+// The specs are stored at block 0 so the relays of the epochs before the upgrade are
+// still validated against them.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	specs := m.getAllLegacySpec(ctx)
+
+	store := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.KeyPrefix(types.SpecKeyPrefix))
+	for _, spec := range specs {
+		if err := m.keeper.SetSpecVersion(ctx, spec, 0); err != nil {
+			return err
+		}
+		store.Delete(types.SpecKey(spec.Index))
 	}
 
 	return nil
@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	"cosmossdk.io/math"
-	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/sigs"
@@ -15,31 +14,49 @@ import (
 	"github.com/lavanet/lava/x/spec/types"
 )
 
-// SetSpec set a specific Spec in the store from its index
+// SetSpec sets a Spec in the store, effective immediately
 func (k Keeper) SetSpec(ctx sdk.Context, spec types.Spec) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SpecKeyPrefix))
-	b := k.cdc.MustMarshal(&spec)
-	store.Set(types.SpecKey(
-		spec.Index,
-	), b)
+	err := k.SetSpecVersion(ctx, spec, uint64(ctx.BlockHeight()))
+	if err != nil {
+		utils.LavaFormatError("SetSpec failed", err,
+			utils.Attribute{Key: "index", Value: spec.Index},
+		)
+	}
+}
+
+// SetSpecVersion adds a version of a Spec that takes effect at the given block (which
+// may be in the future). Lookups for blocks before that keep returning the previous version.
+func (k Keeper) SetSpecVersion(ctx sdk.Context, spec types.Spec, block uint64) error {
+	return k.specsFS.AppendEntry(ctx, spec.Index, block, &spec)
 }
 
-// GetSpec returns a Spec from its index
+// GetSpec returns the Spec version that is in effect at the current block
 func (k Keeper) GetSpec(
 	ctx sdk.Context,
 	index string,
 ) (val types.Spec, found bool) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SpecKeyPrefix))
+	return k.GetSpecForBlock(ctx, index, uint64(ctx.BlockHeight()))
+}
 
-	b := store.Get(types.SpecKey(
-		index,
-	))
-	if b == nil {
-		return val, false
-	}
+// GetSpecForBlock returns the Spec version that was in effect at a specific block
+func (k Keeper) GetSpecForBlock(
+	ctx sdk.Context,
+	index string,
+	block uint64,
+) (val types.Spec, found bool) {
+	found = k.specsFS.FindEntry(ctx, index, block, &val)
+	return val, found
+}
 
-	k.cdc.MustUnmarshal(b, &val)
-	return val, true
+// GetSpecVersion returns the Spec version that was in effect at a specific block, and
+// the block in which that version took effect
+func (k Keeper) GetSpecVersion(
+	ctx sdk.Context,
+	index string,
+	block uint64,
+) (val types.Spec, activationBlock uint64, found bool) {
+	activationBlock, _, _, found = k.specsFS.FindEntryDetailed(ctx, index, block, &val)
+	return val, activationBlock, found
 }
 
 // RemoveSpec removes a Spec from the store
@@ -47,23 +64,28 @@ func (k Keeper) RemoveSpec(
 	ctx sdk.Context,
 	index string,
 ) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SpecKeyPrefix))
-	store.Delete(types.SpecKey(
-		index,
-	))
+	err := k.specsFS.DelEntry(ctx, index, uint64(ctx.BlockHeight()))
+	if err != nil {
+		utils.LavaFormatError("RemoveSpec failed", err,
+			utils.Attribute{Key: "index", Value: index},
+		)
+	}
 }
 
-// GetAllSpec returns all Spec
-func (k Keeper) GetAllSpec(ctx sdk.Context) (list []types.Spec) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SpecKeyPrefix))
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-
-	defer iterator.Close()
+// GetAllSpecIndices returns the indices of all the Specs (including ones that are not
+// yet in effect)
+func (k Keeper) GetAllSpecIndices(ctx sdk.Context) []string {
+	return k.specsFS.GetAllEntryIndices(ctx)
+}
 
-	for ; iterator.Valid(); iterator.Next() {
-		var val types.Spec
-		k.cdc.MustUnmarshal(iterator.Value(), &val)
-		list = append(list, val)
+// GetAllSpec returns all Spec (the versions in effect at the current block)
+func (k Keeper) GetAllSpec(ctx sdk.Context) (list []types.Spec) {
+	for _, index := range k.GetAllSpecIndices(ctx) {
+		spec, found := k.GetSpec(ctx, index)
+		if !found {
+			continue
+		}
+		list = append(list, spec)
 	}
 
 	return
@@ -94,6 +116,22 @@ func (k Keeper) ExpandSpec(ctx sdk.Context, spec types.Spec) (types.Spec, error)
 	return spec, nil
 }
 
+// ExpandSpecForBlock expands a (raw) Spec like ExpandSpec, using the versions of the
+// imported Specs that were in effect at a specific block.
+func (k Keeper) ExpandSpecForBlock(ctx sdk.Context, spec types.Spec, block uint64) (types.Spec, error) {
+	// spec lookups are done by the context's block, and the context is only read from
+	return k.ExpandSpec(ctx.WithBlockHeight(int64(block)), spec)
+}
+
+// GetExpandedSpecForBlock returns the expanded Spec version that was in effect at a specific block
+func (k Keeper) GetExpandedSpecForBlock(ctx sdk.Context, index string, block uint64) (types.Spec, error) {
+	spec, found := k.GetSpecForBlock(ctx, index, block)
+	if found {
+		return k.ExpandSpecForBlock(ctx, spec, block)
+	}
+	return types.Spec{}, fmt.Errorf("no matching spec %s at block %d", index, block)
+}
+
 // RefreshSpec checks which one Spec inherits from another (just recently
 // updated) Spec, and if so updates the the BlockLastUpdated of the former.
 func (k Keeper) RefreshSpec(ctx sdk.Context, spec types.Spec, ancestors []types.Spec) ([]string, error) {
@@ -245,7 +283,13 @@ func (k Keeper) ValidateSpec(ctx sdk.Context, spec types.Spec) (map[string]strin
 // It returns whether the spec is active (and found), whether it was found, and the
 // provider's type (e.g. dynamic/static).
 func (k Keeper) IsSpecFoundAndActive(ctx sdk.Context, chainID string) (foundAndActive, found bool, providersType types.Spec_ProvidersTypes) {
-	spec, found := k.GetSpec(ctx, chainID)
+	return k.IsSpecFoundAndActiveForBlock(ctx, chainID, uint64(ctx.BlockHeight()))
+}
+
+// IsSpecFoundAndActiveForBlock is like IsSpecFoundAndActive, but checks the spec
+// version that was in effect at a specific block.
+func (k Keeper) IsSpecFoundAndActiveForBlock(ctx sdk.Context, chainID string, block uint64) (foundAndActive, found bool, providersType types.Spec_ProvidersTypes) {
+	spec, found := k.GetSpecForBlock(ctx, chainID, block)
 	foundAndActive = false
 	if found {
 		foundAndActive = spec.Enabled
@@ -215,6 +215,8 @@ func TestSpecGet(t *testing.T) {
 func TestSpecRemove(t *testing.T) {
 	ts := newTester(t)
 	items := ts.createNSpec(10)
+	// a spec version can only be removed after the block in which it was added
+	ts.AdvanceBlock()
 	for _, item := range items {
 		ts.removeSpec(item.Index)
 		_, found := ts.getSpec(item.Index)
@@ -515,10 +517,10 @@ func TestSpecUpdateInherit(t *testing.T) {
 	}
 
 	// add a parent spec and a child spec
-	err := keepertest.SimulateSpecAddProposal(ts.Ctx, ts.Keepers.Spec, []types.Spec{parentSpec})
+	err := keepertest.SimulateSpecAddProposal(ts.Ctx, ts.Keepers.Spec, ts.Keepers.Epochstorage, []types.Spec{parentSpec})
 	require.NoError(t, err)
 
-	err = keepertest.SimulateSpecAddProposal(ts.Ctx, ts.Keepers.Spec, []types.Spec{childSpec})
+	err = keepertest.SimulateSpecAddProposal(ts.Ctx, ts.Keepers.Spec, ts.Keepers.Epochstorage, []types.Spec{childSpec})
 	require.NoError(t, err)
 
 	block1 := ts.BlockHeight()
@@ -533,22 +535,127 @@ func TestSpecUpdateInherit(t *testing.T) {
 	ts.AdvanceBlock()
 
 	// modify the parent spec and verify that the child is refreshed
+	// (the modification takes effect only at the next epoch)
 
 	parentSpec.ApiCollections[0].Apis[0].ComputeUnits = 20
-	err = keepertest.SimulateSpecAddProposal(ts.Ctx, ts.Keepers.Spec, []types.Spec{parentSpec})
+	err = keepertest.SimulateSpecAddProposal(ts.Ctx, ts.Keepers.Spec, ts.Keepers.Epochstorage, []types.Spec{parentSpec})
+	require.NoError(t, err)
+
+	nextEpoch := ts.GetNextEpoch()
+
+	sp, found = ts.getSpec("child")
+	require.True(t, found)
+	sp, err = ts.expandSpec(sp)
 	require.NoError(t, err)
+	require.Equal(t, uint64(10), sp.ApiCollections[0].Apis[0].ComputeUnits)
+	require.Equal(t, block1, sp.BlockLastUpdated)
+
+	ts.AdvanceEpoch()
+	require.Equal(t, nextEpoch, ts.BlockHeight())
 
 	sp, found = ts.getSpec("parent")
 	require.True(t, found)
 	require.Equal(t, uint64(20), sp.ApiCollections[0].Apis[0].ComputeUnits)
-	require.Equal(t, block1+1, sp.BlockLastUpdated)
+	require.Equal(t, nextEpoch, sp.BlockLastUpdated)
 
 	sp, found = ts.getSpec("child")
 	require.True(t, found)
 	sp, err = ts.expandSpec(sp)
 	require.NoError(t, err)
 	require.Equal(t, uint64(20), sp.ApiCollections[0].Apis[0].ComputeUnits)
-	require.Equal(t, block1+1, sp.BlockLastUpdated)
+	require.Equal(t, nextEpoch, sp.BlockLastUpdated)
+}
+
+func TestSpecVersionActivation(t *testing.T) {
+	ts := newTester(t)
+
+	api := types.Api{
+		Enabled:           true,
+		Name:              "eth_blockNumber",
+		ComputeUnits:      10,
+		ExtraComputeUnits: 0,
+		Category:          types.SpecCategory{Deterministic: true},
+		BlockParsing: types.BlockParser{
+			ParserFunc: types.PARSER_FUNC_EMPTY,
+		},
+	}
+
+	spec := types.Spec{
+		Index:                         "versioned",
+		Name:                          "versioned spec",
+		Enabled:                       true,
+		ReliabilityThreshold:          268435455,
+		DataReliabilityEnabled:        false,
+		BlockDistanceForFinalizedData: 64,
+		BlocksInFinalizationProof:     3,
+		AverageBlockTime:              13000,
+		AllowedBlockLagForQosSync:     2,
+		MinStakeProvider:              common.NewCoin(ts.TokenDenom(), 5000),
+		ApiCollections: []*types.ApiCollection{
+			{
+				Enabled:        true,
+				CollectionData: types.CollectionData{ApiInterface: "jsonrpc"},
+				Apis:           []*types.Api{&api},
+			},
+		},
+	}
+
+	// a new spec takes effect immediately
+	err := keepertest.SimulateSpecAddProposal(ts.Ctx, ts.Keepers.Spec, ts.Keepers.Epochstorage, []types.Spec{spec})
+	require.NoError(t, err)
+	block1 := ts.BlockHeight()
+
+	_, activation, found := ts.Keepers.Spec.GetSpecVersion(ts.Ctx, spec.Index, block1)
+	require.True(t, found)
+	require.Equal(t, block1, activation)
+
+	ts.AdvanceEpoch()
+	ts.AdvanceBlock()
+	block2 := ts.BlockHeight()
+
+	// a modified spec takes effect at the next epoch
+	spec.ApiCollections[0].Apis[0].ComputeUnits = 20
+	err = keepertest.SimulateSpecAddProposal(ts.Ctx, ts.Keepers.Spec, ts.Keepers.Epochstorage, []types.Spec{spec})
+	require.NoError(t, err)
+	nextEpoch := ts.GetNextEpoch()
+
+	sp, found := ts.getSpec(spec.Index)
+	require.True(t, found)
+	require.Equal(t, uint64(10), sp.ApiCollections[0].Apis[0].ComputeUnits)
+
+	sp, activation, found = ts.Keepers.Spec.GetSpecVersion(ts.Ctx, spec.Index, nextEpoch)
+	require.True(t, found)
+	require.Equal(t, nextEpoch, activation)
+	require.Equal(t, uint64(20), sp.ApiCollections[0].Apis[0].ComputeUnits)
+
+	// a second modification in the same epoch replaces the pending version
+	spec.ApiCollections[0].Apis[0].ComputeUnits = 30
+	err = keepertest.SimulateSpecAddProposal(ts.Ctx, ts.Keepers.Spec, ts.Keepers.Epochstorage, []types.Spec{spec})
+	require.NoError(t, err)
+
+	ts.AdvanceEpoch()
+	require.Equal(t, nextEpoch, ts.BlockHeight())
+
+	sp, found = ts.getSpec(spec.Index)
+	require.True(t, found)
+	require.Equal(t, uint64(30), sp.ApiCollections[0].Apis[0].ComputeUnits)
+
+	// the previous version is still available for earlier blocks
+	sp, activation, found = ts.Keepers.Spec.GetSpecVersion(ts.Ctx, spec.Index, block2)
+	require.True(t, found)
+	require.Equal(t, block1, activation)
+	require.Equal(t, uint64(10), sp.ApiCollections[0].Apis[0].ComputeUnits)
+
+	// and through the query
+	res, err := ts.Keepers.Spec.SpecAtBlock(ts.GoCtx, &types.QuerySpecAtBlockRequest{ChainID: spec.Index, Block: block2})
+	require.NoError(t, err)
+	require.Equal(t, block1, res.ActivationBlock)
+	require.Equal(t, uint64(10), res.Spec.ApiCollections[0].Apis[0].ComputeUnits)
+
+	res, err = ts.Keepers.Spec.SpecAtBlock(ts.GoCtx, &types.QuerySpecAtBlockRequest{ChainID: spec.Index, Block: nextEpoch})
+	require.NoError(t, err)
+	require.Equal(t, nextEpoch, res.ActivationBlock)
+	require.Equal(t, uint64(30), res.Spec.ApiCollections[0].Apis[0].ComputeUnits)
 }
 
 func TestApiCollectionsExpandAndInheritance(t *testing.T) {
@@ -888,3 +995,51 @@ func removeSetFromSet(set1, set2 []string) []string {
 
 	return resultSet
 }
+
+func TestSpecValidateParserArgs(t *testing.T) {
+	ts := newTester(t)
+
+	contents, err := os.ReadFile("../../.././cookbook/specs/spec_add_ethereum.json")
+	require.NoError(t, err)
+
+	playbook := []struct {
+		name        string
+		blockParser types.BlockParser
+		valid       bool
+	}{
+		{name: "jsonpath", blockParser: types.BlockParser{ParserFunc: types.PARSER_FUNC_PARSE_JSONPATH, ParserArg: []string{"$[0].block.height"}}, valid: true},
+		{name: "jsonpath without root", blockParser: types.BlockParser{ParserFunc: types.PARSER_FUNC_PARSE_JSONPATH, ParserArg: []string{"block.height"}}, valid: false},
+		{name: "jsonpath wildcard", blockParser: types.BlockParser{ParserFunc: types.PARSER_FUNC_PARSE_JSONPATH, ParserArg: []string{"$[*].height"}}, valid: false},
+		{name: "jsonpath args count", blockParser: types.BlockParser{ParserFunc: types.PARSER_FUNC_PARSE_JSONPATH, ParserArg: []string{"$.a", "$.b"}}, valid: false},
+		{name: "regex", blockParser: types.BlockParser{ParserFunc: types.PARSER_FUNC_PARSE_REGEX, ParserArg: []string{`block_(\d+)`}}, valid: true},
+		{name: "regex with jsonpath", blockParser: types.BlockParser{ParserFunc: types.PARSER_FUNC_PARSE_REGEX, ParserArg: []string{`block_(\d+)`, "$.cursor"}}, valid: true},
+		{name: "regex without capture group", blockParser: types.BlockParser{ParserFunc: types.PARSER_FUNC_PARSE_REGEX, ParserArg: []string{`block_\d+`}}, valid: false},
+		{name: "regex does not compile", blockParser: types.BlockParser{ParserFunc: types.PARSER_FUNC_PARSE_REGEX, ParserArg: []string{`block_(\d+`}}, valid: false},
+		{name: "regex invalid jsonpath", blockParser: types.BlockParser{ParserFunc: types.PARSER_FUNC_PARSE_REGEX, ParserArg: []string{`block_(\d+)`, "cursor"}}, valid: false},
+	}
+
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			// unmarshal the spec again on every run since the expanded spec shares collections with it
+			proposal := utils.SpecAddProposalJSON{}
+			require.NoError(t, json.Unmarshal(contents, &proposal))
+			fullspec, err := ts.expandSpec(proposal.Proposal.Specs[0])
+			require.NoError(t, err)
+			_, err = fullspec.ValidateSpec(10000000)
+			require.NoError(t, err)
+
+			// in the api block parsing
+			api := fullspec.ApiCollections[0].Apis[0]
+			original := api.BlockParsing
+			api.BlockParsing = play.blockParser
+			_, err = fullspec.ValidateSpec(10000000)
+			require.Equal(t, play.valid, err == nil, err)
+			api.BlockParsing = original
+
+			// in the result parsing of parse directives
+			fullspec.ApiCollections[0].ParseDirectives[0].ResultParsing = play.blockParser
+			_, err = fullspec.ValidateSpec(10000000)
+			require.Equal(t, play.valid, err == nil, err)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package spec
 import (
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 
 	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -74,11 +75,11 @@ func HandleParameterChangeProposal(ctx sdk.Context, k paramkeeper.Keeper, p *par
 }
 
 // NewSpecProposalsHandler creates a new governance Handler for a Spec
-func NewSpecProposalsHandler(k keeper.Keeper) v1beta1.Handler {
+func NewSpecProposalsHandler(k keeper.Keeper, epochstorageKeeper types.EpochstorageKeeper) v1beta1.Handler {
 	return func(ctx sdk.Context, content v1beta1.Content) error {
 		switch c := content.(type) {
 		case *types.SpecAddProposal:
-			return handleSpecProposal(ctx, k, c)
+			return handleSpecProposal(ctx, k, epochstorageKeeper, c)
 
 		default:
 			log.Println("unrecognized spec proposal content")
@@ -87,7 +88,12 @@ func NewSpecProposalsHandler(k keeper.Keeper) v1beta1.Handler {
 	}
 }
 
-func handleSpecProposal(ctx sdk.Context, k keeper.Keeper, p *types.SpecAddProposal) error {
+// handleSpecProposal applies the specs of a SpecAddProposal. Brand new specs
+// take effect immediately, but modifications of existing specs (and of the
+// specs that import them) only take effect at the start of the next epoch, so
+// that relays of the current epoch are still validated and paid according to
+// the spec version that was active when the epoch began.
+func handleSpecProposal(ctx sdk.Context, k keeper.Keeper, epochstorageKeeper types.EpochstorageKeeper, p *types.SpecAddProposal) error {
 	logger := k.Logger(ctx)
 
 	type event struct {
@@ -98,13 +104,31 @@ func handleSpecProposal(ctx sdk.Context, k keeper.Keeper, p *types.SpecAddPropos
 
 	var events []event
 
+	nextEpoch := epochstorageKeeper.GetCurrentNextEpoch(ctx)
+
+	// specs that are pending activation count as existing, so a pending
+	// version is never overtaken by a version that activates earlier
+	activationBlock := uint64(ctx.BlockHeight())
+	for _, spec := range p.Specs {
+		if _, found := k.GetSpecForBlock(ctx, spec.Index, nextEpoch); found {
+			activationBlock = nextEpoch
+			break
+		}
+	}
+
+	// apply and validate the changes as they will be seen at the activation
+	// block; the resulting spec versions are then stored with that block
+	activationCtx, _ := ctx.WithBlockHeight(int64(activationBlock)).CacheContext()
+
+	var changed []string
+
 	for _, spec := range p.Specs {
-		_, found := k.GetSpec(ctx, spec.Index)
+		_, found := k.GetSpec(activationCtx, spec.Index)
 
-		spec.BlockLastUpdated = uint64(ctx.BlockHeight())
-		k.SetSpec(ctx, spec)
+		spec.BlockLastUpdated = activationBlock
+		k.SetSpec(activationCtx, spec)
 
-		details, err := k.ValidateSpec(ctx, spec)
+		details, err := k.ValidateSpec(activationCtx, spec)
 		if err != nil {
 			attrs := utils.StringMapToAttributes(details)
 			return utils.LavaFormatWarning("invalid spec", err, attrs...)
@@ -116,12 +140,15 @@ func handleSpecProposal(ctx sdk.Context, k keeper.Keeper, p *types.SpecAddPropos
 			name = types.SpecModifyEventName
 		}
 
+		details["activationBlock"] = strconv.FormatUint(activationBlock, 10)
+
 		// collect the events first, and only log them after everything succeeded
 		events = append(events, event{
 			name:    name,
 			event:   "Gov Proposal Accepted Spec",
 			details: details,
 		})
+		changed = append(changed, spec.Index)
 
 		// TODO: add api types once its implemented to the event
 	}
@@ -129,15 +156,16 @@ func handleSpecProposal(ctx sdk.Context, k keeper.Keeper, p *types.SpecAddPropos
 	// re-validate all the specs, in case the modified spec is imported by
 	// other specs and the new version creates a conflict; also update the
 	// BlockLastUpdated of all specs that inherit from the modified spec.
-	for _, spec := range k.GetAllSpec(ctx) {
-		inherits, err := k.RefreshSpec(ctx, spec, p.Specs)
+	for _, spec := range k.GetAllSpec(activationCtx) {
+		inherits, err := k.RefreshSpec(activationCtx, spec, p.Specs)
 		if err != nil {
 			return utils.LavaFormatWarning("invalidated spec", err)
 		}
 		if len(inherits) > 0 {
 			details := map[string]string{
-				"name":   spec.Index,
-				"import": strings.Join(inherits, ","),
+				"name":            spec.Index,
+				"import":          strings.Join(inherits, ","),
+				"activationBlock": strconv.FormatUint(activationBlock, 10),
 			}
 			name := types.SpecRefreshEventName
 			events = append(events, event{
@@ -145,6 +173,22 @@ func handleSpecProposal(ctx sdk.Context, k keeper.Keeper, p *types.SpecAddPropos
 				event:   "Gov Proposal Refreshsed Spec",
 				details: details,
 			})
+			changed = append(changed, spec.Index)
+		}
+	}
+
+	for _, index := range changed {
+		spec, found := k.GetSpec(activationCtx, index)
+		if !found {
+			return utils.LavaFormatError("spec not found after update", fmt.Errorf("spec proposal failed"),
+				utils.Attribute{Key: "spec", Value: index},
+			)
+		}
+		if err := k.SetSpecVersion(ctx, spec, activationBlock); err != nil {
+			return utils.LavaFormatError("failed to store spec version", err,
+				utils.Attribute{Key: "spec", Value: index},
+				utils.Attribute{Key: "activationBlock", Value: activationBlock},
+			)
 		}
 	}
 
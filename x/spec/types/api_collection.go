@@ -2,6 +2,9 @@ package types
 
 import (
 	"fmt"
+	"regexp"
+
+	"github.com/lavanet/lava/utils/jsonpath"
 )
 
 // this means the current collection data can be expanded from other, i.e other is allowed to be in InheritanceApis
@@ -156,3 +159,32 @@ func (sc SpecCategory) Combine(other SpecCategory) SpecCategory {
 	}
 	return returnedCategory
 }
+
+// ValidateParserArgs validates the arguments of parser functions that take an expression, so a spec with
+// an expression the protocol can't evaluate is rejected instead of failing every relay that parses it
+func (bp BlockParser) ValidateParserArgs() error {
+	switch bp.ParserFunc {
+	case PARSER_FUNC_PARSE_JSONPATH:
+		if len(bp.ParserArg) != 1 {
+			return fmt.Errorf("PARSE_JSONPATH expects 1 argument [jsonpath], got %d", len(bp.ParserArg))
+		}
+		_, err := jsonpath.Compile(bp.ParserArg[0])
+		return err
+	case PARSER_FUNC_PARSE_REGEX:
+		if len(bp.ParserArg) != 1 && len(bp.ParserArg) != 2 {
+			return fmt.Errorf("PARSE_REGEX expects 1 or 2 arguments [regex,optional jsonpath], got %d", len(bp.ParserArg))
+		}
+		re, err := regexp.Compile(bp.ParserArg[0])
+		if err != nil {
+			return err
+		}
+		if re.NumSubexp() < 1 {
+			return fmt.Errorf("PARSE_REGEX regex %q must have a capture group for the block", bp.ParserArg[0])
+		}
+		if len(bp.ParserArg) == 2 && bp.ParserArg[1] != "" {
+			_, err = jsonpath.Compile(bp.ParserArg[1])
+			return err
+		}
+	}
+	return nil
+}
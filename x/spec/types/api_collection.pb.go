@@ -97,7 +97,9 @@ const (
 	PARSER_FUNC_PARSE_DICTIONARY            PARSER_FUNC = 3
 	PARSER_FUNC_PARSE_DICTIONARY_OR_ORDERED PARSER_FUNC = 4
 	// reserved
-	PARSER_FUNC_DEFAULT PARSER_FUNC = 6
+	PARSER_FUNC_DEFAULT        PARSER_FUNC = 6
+	PARSER_FUNC_PARSE_JSONPATH PARSER_FUNC = 7
+	PARSER_FUNC_PARSE_REGEX    PARSER_FUNC = 8
 )
 
 var PARSER_FUNC_name = map[int32]string{
@@ -107,6 +109,8 @@ var PARSER_FUNC_name = map[int32]string{
 	3: "PARSE_DICTIONARY",
 	4: "PARSE_DICTIONARY_OR_ORDERED",
 	6: "DEFAULT",
+	7: "PARSE_JSONPATH",
+	8: "PARSE_REGEX",
 }
 
 var PARSER_FUNC_value = map[string]int32{
@@ -116,6 +120,8 @@ var PARSER_FUNC_value = map[string]int32{
 	"PARSE_DICTIONARY":            3,
 	"PARSE_DICTIONARY_OR_ORDERED": 4,
 	"DEFAULT":                     6,
+	"PARSE_JSONPATH":              7,
+	"PARSE_REGEX":                 8,
 }
 
 func (x PARSER_FUNC) String() string {
@@ -182,6 +188,43 @@ func (Header_HeaderType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_c9f7567a181f534f, []int{6, 0}
 }
 
+type ResponseField_FieldType int32
+
+const (
+	ResponseField_Any    ResponseField_FieldType = 0
+	ResponseField_Object ResponseField_FieldType = 1
+	ResponseField_Array  ResponseField_FieldType = 2
+	ResponseField_String ResponseField_FieldType = 3
+	ResponseField_Number ResponseField_FieldType = 4
+	ResponseField_Bool   ResponseField_FieldType = 5
+)
+
+var ResponseField_FieldType_name = map[int32]string{
+	0: "Any",
+	1: "Object",
+	2: "Array",
+	3: "String",
+	4: "Number",
+	5: "Bool",
+}
+
+var ResponseField_FieldType_value = map[string]int32{
+	"Any":    0,
+	"Object": 1,
+	"Array":  2,
+	"String": 3,
+	"Number": 4,
+	"Bool":   5,
+}
+
+func (x ResponseField_FieldType) String() string {
+	return proto.EnumName(ResponseField_FieldType_name, int32(x))
+}
+
+func (ResponseField_FieldType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c9f7567a181f534f, []int{9, 0}
+}
+
 type ApiCollection struct {
 	Enabled         bool              `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	CollectionData  CollectionData    `protobuf:"bytes,2,opt,name=collection_data,json=collectionData,proto3" json:"collection_data"`
@@ -643,13 +686,14 @@ func (m *Header) GetFunctionTag() FUNCTION_TAG {
 }
 
 type Api struct {
-	Enabled           bool         `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Name              string       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ComputeUnits      uint64       `protobuf:"varint,3,opt,name=compute_units,json=computeUnits,proto3" json:"compute_units,omitempty"`
-	ExtraComputeUnits uint64       `protobuf:"varint,4,opt,name=extra_compute_units,json=extraComputeUnits,proto3" json:"extra_compute_units,omitempty"`
-	Category          SpecCategory `protobuf:"bytes,6,opt,name=category,proto3" json:"category"`
-	BlockParsing      BlockParser  `protobuf:"bytes,7,opt,name=block_parsing,json=blockParsing,proto3" json:"block_parsing"`
-	TimeoutMs         uint64       `protobuf:"varint,8,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	Enabled           bool            `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Name              string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ComputeUnits      uint64          `protobuf:"varint,3,opt,name=compute_units,json=computeUnits,proto3" json:"compute_units,omitempty"`
+	ExtraComputeUnits uint64          `protobuf:"varint,4,opt,name=extra_compute_units,json=extraComputeUnits,proto3" json:"extra_compute_units,omitempty"`
+	Category          SpecCategory    `protobuf:"bytes,6,opt,name=category,proto3" json:"category"`
+	BlockParsing      BlockParser     `protobuf:"bytes,7,opt,name=block_parsing,json=blockParsing,proto3" json:"block_parsing"`
+	TimeoutMs         uint64          `protobuf:"varint,8,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	ResponseSchema    *ResponseSchema `protobuf:"bytes,9,opt,name=response_schema,json=responseSchema,proto3" json:"response_schema,omitempty"`
 }
 
 func (m *Api) Reset()         { *m = Api{} }
@@ -734,6 +778,117 @@ func (m *Api) GetTimeoutMs() uint64 {
 	return 0
 }
 
+func (m *Api) GetResponseSchema() *ResponseSchema {
+	if m != nil {
+		return m.ResponseSchema
+	}
+	return nil
+}
+
+type ResponseSchema struct {
+	Fields []*ResponseField `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (m *ResponseSchema) Reset()         { *m = ResponseSchema{} }
+func (m *ResponseSchema) String() string { return proto.CompactTextString(m) }
+func (*ResponseSchema) ProtoMessage()    {}
+func (*ResponseSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9f7567a181f534f, []int{8}
+}
+func (m *ResponseSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseSchema.Merge(m, src)
+}
+func (m *ResponseSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseSchema proto.InternalMessageInfo
+
+func (m *ResponseSchema) GetFields() []*ResponseField {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type ResponseField struct {
+	Path       string                  `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Type       ResponseField_FieldType `protobuf:"varint,2,opt,name=type,proto3,enum=lavanet.lava.spec.ResponseField_FieldType" json:"type,omitempty"`
+	AllowEmpty bool                    `protobuf:"varint,3,opt,name=allow_empty,json=allowEmpty,proto3" json:"allow_empty,omitempty"`
+}
+
+func (m *ResponseField) Reset()         { *m = ResponseField{} }
+func (m *ResponseField) String() string { return proto.CompactTextString(m) }
+func (*ResponseField) ProtoMessage()    {}
+func (*ResponseField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9f7567a181f534f, []int{9}
+}
+func (m *ResponseField) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseField.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseField.Merge(m, src)
+}
+func (m *ResponseField) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseField) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseField proto.InternalMessageInfo
+
+func (m *ResponseField) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ResponseField) GetType() ResponseField_FieldType {
+	if m != nil {
+		return m.Type
+	}
+	return ResponseField_Any
+}
+
+func (m *ResponseField) GetAllowEmpty() bool {
+	if m != nil {
+		return m.AllowEmpty
+	}
+	return false
+}
+
 type ParseDirective struct {
 	FunctionTag      FUNCTION_TAG `protobuf:"varint,1,opt,name=function_tag,json=functionTag,proto3,enum=lavanet.lava.spec.FUNCTION_TAG" json:"function_tag,omitempty"`
 	FunctionTemplate string       `protobuf:"bytes,2,opt,name=function_template,json=functionTemplate,proto3" json:"function_template,omitempty"`
@@ -745,7 +900,7 @@ func (m *ParseDirective) Reset()         { *m = ParseDirective{} }
 func (m *ParseDirective) String() string { return proto.CompactTextString(m) }
 func (*ParseDirective) ProtoMessage()    {}
 func (*ParseDirective) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9f7567a181f534f, []int{8}
+	return fileDescriptor_c9f7567a181f534f, []int{10}
 }
 func (m *ParseDirective) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -813,7 +968,7 @@ func (m *BlockParser) Reset()         { *m = BlockParser{} }
 func (m *BlockParser) String() string { return proto.CompactTextString(m) }
 func (*BlockParser) ProtoMessage()    {}
 func (*BlockParser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9f7567a181f534f, []int{9}
+	return fileDescriptor_c9f7567a181f534f, []int{11}
 }
 func (m *BlockParser) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -882,7 +1037,7 @@ func (m *SpecCategory) Reset()         { *m = SpecCategory{} }
 func (m *SpecCategory) String() string { return proto.CompactTextString(m) }
 func (*SpecCategory) ProtoMessage()    {}
 func (*SpecCategory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9f7567a181f534f, []int{10}
+	return fileDescriptor_c9f7567a181f534f, []int{12}
 }
 func (m *SpecCategory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -952,6 +1107,7 @@ func init() {
 	proto.RegisterEnum("lavanet.lava.spec.PARSER_FUNC", PARSER_FUNC_name, PARSER_FUNC_value)
 	proto.RegisterEnum("lavanet.lava.spec.ParseValue_VerificationSeverity", ParseValue_VerificationSeverity_name, ParseValue_VerificationSeverity_value)
 	proto.RegisterEnum("lavanet.lava.spec.Header_HeaderType", Header_HeaderType_name, Header_HeaderType_value)
+	proto.RegisterEnum("lavanet.lava.spec.ResponseField_FieldType", ResponseField_FieldType_name, ResponseField_FieldType_value)
 	proto.RegisterType((*ApiCollection)(nil), "lavanet.lava.spec.ApiCollection")
 	proto.RegisterType((*Extension)(nil), "lavanet.lava.spec.Extension")
 	proto.RegisterType((*Rule)(nil), "lavanet.lava.spec.Rule")
@@ -960,6 +1116,8 @@ func init() {
 	proto.RegisterType((*CollectionData)(nil), "lavanet.lava.spec.CollectionData")
 	proto.RegisterType((*Header)(nil), "lavanet.lava.spec.Header")
 	proto.RegisterType((*Api)(nil), "lavanet.lava.spec.Api")
+	proto.RegisterType((*ResponseSchema)(nil), "lavanet.lava.spec.ResponseSchema")
+	proto.RegisterType((*ResponseField)(nil), "lavanet.lava.spec.ResponseField")
 	proto.RegisterType((*ParseDirective)(nil), "lavanet.lava.spec.ParseDirective")
 	proto.RegisterType((*BlockParser)(nil), "lavanet.lava.spec.BlockParser")
 	proto.RegisterType((*SpecCategory)(nil), "lavanet.lava.spec.SpecCategory")
@@ -970,96 +1128,107 @@ func init() {
 }
 
 var fileDescriptor_c9f7567a181f534f = []byte{
-	// 1424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4f, 0x6f, 0xdb, 0xc6,
-	0x12, 0x37, 0x25, 0x5a, 0x96, 0x46, 0x7f, 0xcc, 0x6c, 0xfc, 0xf2, 0x94, 0x3c, 0x47, 0xf2, 0x63,
-	0xf2, 0x5e, 0x0d, 0x07, 0xb5, 0x51, 0x07, 0x05, 0x8a, 0xa0, 0x40, 0x41, 0x49, 0x74, 0xa2, 0x44,
-	0x96, 0x8c, 0xb5, 0xec, 0xd6, 0xbd, 0x10, 0x6b, 0x6a, 0x2d, 0x2f, 0x42, 0x91, 0x04, 0xb9, 0x74,
-	0xed, 0x73, 0xbf, 0x40, 0x3f, 0x43, 0x4f, 0x05, 0x0a, 0x14, 0xe8, 0xa1, 0xdf, 0x21, 0xc7, 0x1c,
-	0x7b, 0x12, 0x0a, 0xe7, 0x50, 0x34, 0xc7, 0xdc, 0x7a, 0x28, 0x50, 0xec, 0x92, 0x92, 0x45, 0x47,
-	0x49, 0x91, 0x93, 0x34, 0xbf, 0xf9, 0xcd, 0x8f, 0xb3, 0x33, 0xb3, 0x43, 0xc2, 0xff, 0x1d, 0x72,
-	0x46, 0x5c, 0xca, 0xb7, 0xc4, 0xef, 0x56, 0xe8, 0x53, 0x7b, 0x8b, 0xf8, 0xcc, 0xb2, 0x3d, 0xc7,
-	0xa1, 0x36, 0x67, 0x9e, 0xbb, 0xe9, 0x07, 0x1e, 0xf7, 0xd0, 0x8d, 0x84, 0xb7, 0x29, 0x7e, 0x37,
-	0x05, 0xef, 0xce, 0xca, 0xd0, 0x1b, 0x7a, 0xd2, 0xbb, 0x25, 0xfe, 0xc5, 0x44, 0xfd, 0xaf, 0x2c,
-	0x94, 0x0d, 0x9f, 0x35, 0xa7, 0x02, 0xa8, 0x0a, 0x4b, 0xd4, 0x25, 0xc7, 0x0e, 0x1d, 0x54, 0x95,
-	0x35, 0x65, 0x3d, 0x8f, 0x27, 0x26, 0xda, 0x83, 0xe5, 0xab, 0x07, 0x59, 0x03, 0xc2, 0x49, 0x35,
-	0xb3, 0xa6, 0xac, 0x17, 0xb7, 0xff, 0xbb, 0xf9, 0xd6, 0xe3, 0x36, 0xaf, 0x14, 0x5b, 0x84, 0x93,
-	0x86, 0xfa, 0x62, 0x5c, 0x5f, 0xc0, 0x15, 0x3b, 0x85, 0xa2, 0x0d, 0x50, 0x89, 0xcf, 0xc2, 0x6a,
-	0x76, 0x2d, 0xbb, 0x5e, 0xdc, 0xbe, 0x35, 0x47, 0xc6, 0xf0, 0x19, 0x96, 0x1c, 0xf4, 0x10, 0x96,
-	0x4e, 0x29, 0x19, 0xd0, 0x20, 0xac, 0xaa, 0x92, 0x7e, 0x7b, 0x0e, 0xfd, 0x89, 0x64, 0xe0, 0x09,
-	0x13, 0x75, 0x40, 0x63, 0xee, 0x29, 0x0d, 0x18, 0x27, 0xae, 0x4d, 0x2d, 0xf9, 0xb0, 0x45, 0x19,
-	0xfd, 0xcf, 0x39, 0xe3, 0xe5, 0x99, 0x50, 0x43, 0xa4, 0xd0, 0x01, 0xcd, 0x27, 0x41, 0x48, 0xad,
-	0x01, 0x0b, 0x04, 0xef, 0x8c, 0x86, 0xd5, 0xdc, 0x3b, 0xd5, 0xf6, 0x04, 0xb5, 0x35, 0x61, 0xe2,
-	0x65, 0x3f, 0x65, 0x87, 0xe8, 0x73, 0x00, 0x7a, 0xce, 0xa9, 0x1b, 0x32, 0xcf, 0x0d, 0xab, 0x4b,
-	0x52, 0x67, 0x75, 0x8e, 0x8e, 0x39, 0x21, 0xe1, 0x19, 0x3e, 0x32, 0xa1, 0x7c, 0x46, 0x03, 0x76,
-	0xc2, 0x6c, 0xc2, 0xa5, 0x40, 0x5e, 0x0a, 0xd4, 0xe7, 0x08, 0x1c, 0xce, 0xf0, 0x70, 0x3a, 0x4a,
-	0xff, 0x06, 0x0a, 0x53, 0x7d, 0x84, 0x40, 0x75, 0xc9, 0x88, 0xca, 0xbe, 0x17, 0xb0, 0xfc, 0x8f,
-	0x1e, 0x80, 0x1a, 0x44, 0x0e, 0xad, 0x66, 0x65, 0xa7, 0xff, 0x3d, 0x47, 0x1e, 0x47, 0x0e, 0xc5,
-	0x92, 0x84, 0xee, 0x41, 0xd9, 0x8e, 0xac, 0x51, 0xe4, 0x70, 0xe6, 0x3b, 0x8c, 0x06, 0x55, 0x75,
-	0x4d, 0x59, 0x57, 0x71, 0xc9, 0x8e, 0x76, 0xa7, 0xd8, 0x53, 0x35, 0x9f, 0xd1, 0xb2, 0xfa, 0x2a,
-	0xa8, 0x22, 0x10, 0xad, 0xc0, 0xe2, 0xb1, 0xe3, 0xd9, 0xcf, 0xe5, 0x43, 0x55, 0x1c, 0x1b, 0xfa,
-	0x8f, 0x0a, 0x94, 0x66, 0xd3, 0x9e, 0x9b, 0xda, 0x53, 0x58, 0xbe, 0xd6, 0x8e, 0xf7, 0xcc, 0xe3,
-	0xb5, 0x6e, 0x54, 0xd2, 0xdd, 0x40, 0x9f, 0x42, 0xee, 0x8c, 0x38, 0x11, 0x9d, 0xcc, 0xe2, 0xdd,
-	0x77, 0x49, 0x1c, 0x0a, 0x16, 0x4e, 0xc8, 0x4f, 0xd5, 0xbc, 0xaa, 0x2d, 0xea, 0x7f, 0x2a, 0x00,
-	0x57, 0x4e, 0xb4, 0x0a, 0x85, 0x69, 0xa3, 0x92, 0x84, 0xaf, 0x00, 0xf4, 0x3f, 0xa8, 0xd0, 0x73,
-	0x9f, 0xda, 0x9c, 0x0e, 0x2c, 0xa9, 0x22, 0x93, 0x2e, 0xe0, 0xf2, 0x04, 0x8d, 0x45, 0x3e, 0x82,
-	0x65, 0x87, 0x70, 0x1a, 0x72, 0x6b, 0xc0, 0x42, 0x39, 0x82, 0xb2, 0x05, 0x2a, 0xae, 0xc4, 0x70,
-	0x2b, 0x41, 0x51, 0x17, 0xf2, 0x21, 0x15, 0x4d, 0xe5, 0x17, 0xb2, 0xdc, 0x95, 0xed, 0xed, 0xf7,
-	0xe6, 0x9e, 0x1a, 0x87, 0xfd, 0x24, 0x12, 0x4f, 0x35, 0xf4, 0x8f, 0x61, 0x65, 0x1e, 0x03, 0xe5,
-	0x41, 0xdd, 0x21, 0xcc, 0xd1, 0x16, 0x50, 0x11, 0x96, 0xbe, 0x24, 0x81, 0xcb, 0xdc, 0xa1, 0xa6,
-	0xe8, 0x3f, 0x67, 0xa0, 0x92, 0xbe, 0x37, 0xe8, 0x10, 0xca, 0x62, 0x29, 0x31, 0x97, 0xd3, 0xe0,
-	0x84, 0xd8, 0x49, 0xd3, 0x1a, 0x9f, 0xbc, 0x1e, 0xd7, 0xd3, 0x8e, 0x37, 0xe3, 0xfa, 0xea, 0x88,
-	0xf8, 0x21, 0x0f, 0x22, 0x9b, 0x47, 0x01, 0x7d, 0xa4, 0xa7, 0xdc, 0x3a, 0x2e, 0x11, 0x9f, 0xb5,
-	0x27, 0xa6, 0xd0, 0x95, 0x3e, 0x97, 0x38, 0x96, 0x4f, 0xf8, 0x69, 0x5c, 0xb8, 0x58, 0x37, 0xe5,
-	0x78, 0x5b, 0x37, 0xe5, 0xd6, 0x71, 0x69, 0x62, 0xef, 0x11, 0x7e, 0x8a, 0x1e, 0x82, 0xca, 0x2f,
-	0xfc, 0xb8, 0xbe, 0x85, 0x46, 0xfd, 0xf5, 0xb8, 0x2e, 0xed, 0x37, 0xe3, 0xfa, 0xcd, 0xb4, 0x8a,
-	0x40, 0x75, 0x2c, 0x9d, 0xe8, 0x11, 0xe4, 0xc8, 0x60, 0x60, 0x79, 0xae, 0x2c, 0x7a, 0xa1, 0x71,
-	0xef, 0xf5, 0xb8, 0x9e, 0x20, 0x6f, 0xc6, 0xf5, 0x7f, 0x5d, 0x3b, 0x96, 0xc4, 0x75, 0xbc, 0x48,
-	0x06, 0x83, 0x9e, 0xab, 0xff, 0xae, 0x40, 0x2e, 0xde, 0x54, 0x73, 0xe7, 0xfa, 0x33, 0x50, 0x9f,
-	0x33, 0x77, 0x20, 0x8f, 0x57, 0xd9, 0xbe, 0xff, 0xce, 0x35, 0x97, 0xfc, 0xf4, 0x2f, 0x7c, 0x8a,
-	0x65, 0x04, 0x6a, 0x40, 0xe9, 0x24, 0x72, 0xe3, 0xfd, 0xcc, 0xc9, 0x50, 0x9e, 0xa8, 0x32, 0x77,
-	0x27, 0xec, 0x1c, 0x74, 0x9b, 0xfd, 0x76, 0xaf, 0x6b, 0xf5, 0x8d, 0xc7, 0xb8, 0x38, 0x09, 0xea,
-	0x93, 0xa1, 0xfe, 0x0c, 0xe0, 0x4a, 0x17, 0x95, 0xa1, 0xe0, 0x93, 0x30, 0xb4, 0x42, 0xea, 0x0e,
-	0xb4, 0x05, 0x54, 0x01, 0x90, 0x66, 0x40, 0x7d, 0xe7, 0x42, 0x53, 0xa6, 0xee, 0x63, 0x8f, 0x9f,
-	0x6a, 0x19, 0xb4, 0x0c, 0x45, 0x69, 0xb2, 0xa1, 0xeb, 0x05, 0x54, 0xcb, 0xea, 0xbf, 0x64, 0x20,
-	0x6b, 0xf8, 0xec, 0x3d, 0x2f, 0x95, 0x49, 0x01, 0x32, 0x33, 0x05, 0x10, 0x6b, 0xc4, 0x1b, 0xf9,
-	0x11, 0xa7, 0x56, 0xe4, 0x32, 0x1e, 0x26, 0x93, 0x5f, 0x4a, 0xc0, 0x03, 0x81, 0xa1, 0x4d, 0xb8,
-	0x49, 0xcf, 0x79, 0x40, 0xac, 0x34, 0x35, 0xde, 0x38, 0x37, 0xa4, 0xab, 0x39, 0xcb, 0x37, 0x20,
-	0x6f, 0x13, 0x4e, 0x87, 0x5e, 0x70, 0x51, 0xcd, 0xc9, 0x35, 0x31, 0xaf, 0x2e, 0xfb, 0x3e, 0xb5,
-	0x9b, 0x09, 0x2d, 0x79, 0x69, 0x4d, 0xc3, 0x50, 0x1b, 0xca, 0x72, 0x3d, 0x59, 0x62, 0x79, 0x30,
-	0x77, 0x58, 0x5d, 0x92, 0x3a, 0xb5, 0x39, 0x3a, 0x0d, 0xc1, 0x93, 0x97, 0x2e, 0x48, 0x64, 0x4a,
-	0xc7, 0x13, 0x88, 0xb9, 0x43, 0x74, 0x17, 0x80, 0xb3, 0x11, 0xf5, 0x22, 0x6e, 0x8d, 0xc4, 0xee,
-	0x16, 0x49, 0x17, 0x12, 0x64, 0x37, 0xd4, 0xff, 0x50, 0xa0, 0x92, 0xde, 0x58, 0x6f, 0xf5, 0x56,
-	0xf9, 0xf0, 0xde, 0xa2, 0x07, 0x70, 0xe3, 0x4a, 0x83, 0x8e, 0x7c, 0xb1, 0x4a, 0x92, 0xca, 0x6b,
-	0x53, 0x5e, 0x82, 0xa3, 0x67, 0x50, 0x09, 0x68, 0x18, 0x39, 0x7c, 0x7a, 0xdc, 0xec, 0x07, 0x1c,
-	0xb7, 0x1c, 0xc7, 0x4e, 0xce, 0x7b, 0x1b, 0xf2, 0xe2, 0x6e, 0xcb, 0x56, 0xcb, 0x0b, 0x83, 0x97,
-	0x88, 0xcf, 0xba, 0x64, 0x44, 0xf5, 0x9f, 0x14, 0x28, 0xce, 0xc4, 0x8b, 0xd2, 0xc8, 0xe5, 0x1c,
-	0x58, 0x24, 0x10, 0xc7, 0xcc, 0x8a, 0xfd, 0x19, 0x23, 0x46, 0x30, 0x44, 0x5f, 0x88, 0x19, 0x93,
-	0x6e, 0x91, 0x71, 0x72, 0x49, 0xe6, 0xe5, 0xb4, 0x67, 0xe0, 0x7d, 0x13, 0x5b, 0xa2, 0x1a, 0x38,
-	0x51, 0xdc, 0x89, 0x5c, 0x5b, 0x4c, 0xd7, 0x80, 0x9e, 0x10, 0x71, 0xb0, 0x78, 0xff, 0xca, 0x7b,
-	0x8f, 0x4b, 0x09, 0x18, 0xaf, 0xdf, 0x3b, 0x90, 0xa7, 0xae, 0xed, 0x0d, 0xc4, 0xb1, 0xe3, 0x7c,
-	0xa7, 0xb6, 0x7c, 0x39, 0xcd, 0xce, 0x09, 0xba, 0x2f, 0x14, 0x39, 0x0d, 0x46, 0xcc, 0x65, 0x21,
-	0x67, 0x76, 0x32, 0xe3, 0x69, 0x50, 0xbc, 0xe9, 0x1c, 0xcf, 0x26, 0x8e, 0x4c, 0x39, 0x8f, 0x63,
-	0x03, 0xe9, 0x50, 0x0a, 0xa3, 0xe3, 0xd0, 0x0e, 0x98, 0x2f, 0xaa, 0x2f, 0x93, 0xc9, 0xe3, 0x14,
-	0x26, 0x92, 0x09, 0x39, 0xe1, 0xf4, 0x24, 0x72, 0x64, 0x32, 0x65, 0x3c, 0xb5, 0x51, 0x1d, 0x8a,
-	0xa7, 0xc4, 0x1d, 0x32, 0x77, 0x28, 0xbe, 0x6e, 0xaa, 0x8b, 0x32, 0x1c, 0x12, 0xc8, 0xf0, 0xd9,
-	0x86, 0x0e, 0x05, 0xf3, 0xab, 0xbe, 0xd9, 0xdd, 0x6f, 0xf7, 0xba, 0x62, 0x89, 0x77, 0x7b, 0x5d,
-	0x33, 0x5e, 0xe2, 0x06, 0x6e, 0x3e, 0x69, 0x1f, 0x9a, 0x9a, 0xb2, 0xf1, 0xbd, 0x02, 0xa5, 0xd9,
-	0xa9, 0x41, 0x25, 0xc8, 0xb7, 0xda, 0xfb, 0x46, 0xa3, 0x63, 0xb6, 0xb4, 0x05, 0xa4, 0x41, 0xe9,
-	0xb1, 0xd9, 0xb7, 0x1a, 0x9d, 0x5e, 0xf3, 0x59, 0xf7, 0x60, 0x57, 0x53, 0xd0, 0x0a, 0x68, 0x53,
-	0xc4, 0x6a, 0x1c, 0x59, 0x02, 0xcd, 0xa0, 0x3b, 0x70, 0x6b, 0xdf, 0xec, 0x5b, 0x1d, 0xa3, 0x6f,
-	0xee, 0xf7, 0xad, 0x76, 0xd7, 0xda, 0x35, 0xfb, 0x46, 0xcb, 0xe8, 0x1b, 0x5a, 0x16, 0xdd, 0x02,
-	0x94, 0xf6, 0x35, 0x7a, 0xad, 0x23, 0x4d, 0x15, 0xda, 0x87, 0x26, 0x6e, 0xef, 0xb4, 0x9b, 0x86,
-	0x78, 0xba, 0xb6, 0x28, 0x98, 0x42, 0xdb, 0x34, 0x70, 0xa7, 0x2d, 0xb8, 0xf2, 0x21, 0x5a, 0x6e,
-	0xe3, 0x5b, 0x05, 0x8a, 0x33, 0x3d, 0x45, 0x05, 0x58, 0x34, 0x77, 0xf7, 0xfa, 0x47, 0x71, 0x82,
-	0xd2, 0x23, 0x52, 0x31, 0xf0, 0x63, 0x4d, 0x41, 0x37, 0x61, 0x39, 0x46, 0x9a, 0x46, 0xb7, 0xd7,
-	0x6d, 0x37, 0x8d, 0x8e, 0x96, 0x11, 0x59, 0xc7, 0x60, 0xab, 0x2d, 0x8f, 0x6a, 0xe0, 0x23, 0x2d,
-	0x8b, 0xea, 0xf0, 0x9f, 0xeb, 0xa8, 0xd5, 0xc3, 0x56, 0x0f, 0xb7, 0x4c, 0x6c, 0xb6, 0x34, 0x55,
-	0x94, 0xaa, 0x65, 0xee, 0x18, 0x07, 0x9d, 0xbe, 0x96, 0x6b, 0x34, 0x7e, 0xb8, 0xac, 0x29, 0x2f,
-	0x2e, 0x6b, 0xca, 0xcb, 0xcb, 0x9a, 0xf2, 0xdb, 0x65, 0x4d, 0xf9, 0xee, 0x55, 0x6d, 0xe1, 0xe5,
-	0xab, 0xda, 0xc2, 0xaf, 0xaf, 0x6a, 0x0b, 0x5f, 0xdf, 0x1f, 0x32, 0x7e, 0x1a, 0x1d, 0x6f, 0xda,
-	0xde, 0x68, 0x2b, 0xf5, 0xa9, 0x7e, 0x1e, 0x7f, 0xac, 0x8b, 0x57, 0x47, 0x78, 0x9c, 0x93, 0xdf,
-	0xde, 0x0f, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x49, 0x68, 0xe8, 0x57, 0xce, 0x0b, 0x00, 0x00,
+	// 1599 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x4f, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0x25, 0x5a, 0x96, 0x9e, 0xfe, 0x78, 0x32, 0x49, 0x53, 0x6d, 0x9a, 0x95, 0x5c, 0x6e,
+	0xda, 0x06, 0x5e, 0x54, 0x46, 0x1d, 0x14, 0x58, 0x2c, 0x8a, 0x16, 0x94, 0x44, 0x27, 0x72, 0x64,
+	0xc9, 0x18, 0x29, 0xee, 0xa6, 0x17, 0x62, 0x44, 0x8d, 0xa5, 0xe9, 0x52, 0x24, 0x41, 0x0e, 0xb3,
+	0xd1, 0xb1, 0xdf, 0xa0, 0x9f, 0xa1, 0xa7, 0x05, 0x0a, 0x14, 0xe8, 0xb7, 0xd8, 0xe3, 0x02, 0xbd,
+	0xf4, 0x64, 0x14, 0xc9, 0xa1, 0x68, 0x8e, 0xb9, 0xf5, 0x50, 0xa0, 0x98, 0x21, 0x25, 0x8b, 0x89,
+	0xe2, 0x36, 0x17, 0x89, 0xef, 0xf7, 0x7e, 0xf3, 0x9b, 0x37, 0xf3, 0xde, 0xbc, 0x21, 0xe1, 0xa7,
+	0x2e, 0x7d, 0x41, 0x3d, 0x26, 0x8e, 0xe4, 0xff, 0x51, 0x14, 0x30, 0xe7, 0x88, 0x06, 0xdc, 0x76,
+	0x7c, 0xd7, 0x65, 0x8e, 0xe0, 0xbe, 0xd7, 0x0a, 0x42, 0x5f, 0xf8, 0xf8, 0x56, 0xca, 0x6b, 0xc9,
+	0xff, 0x96, 0xe4, 0xdd, 0xbb, 0x33, 0xf3, 0x67, 0xbe, 0xf2, 0x1e, 0xc9, 0xa7, 0x84, 0x68, 0xfc,
+	0x27, 0x0f, 0x55, 0x33, 0xe0, 0x9d, 0xb5, 0x00, 0xae, 0xc3, 0x1e, 0xf3, 0xe8, 0xc4, 0x65, 0xd3,
+	0xba, 0x76, 0xa0, 0x3d, 0x2c, 0x92, 0x95, 0x89, 0xcf, 0x61, 0xff, 0x7a, 0x22, 0x7b, 0x4a, 0x05,
+	0xad, 0xe7, 0x0e, 0xb4, 0x87, 0xe5, 0xe3, 0x1f, 0xb7, 0xde, 0x9b, 0xae, 0x75, 0xad, 0xd8, 0xa5,
+	0x82, 0xb6, 0xf5, 0xef, 0xae, 0x9a, 0x3b, 0xa4, 0xe6, 0x64, 0x50, 0x7c, 0x08, 0x3a, 0x0d, 0x78,
+	0x54, 0xcf, 0x1f, 0xe4, 0x1f, 0x96, 0x8f, 0xef, 0x6e, 0x91, 0x31, 0x03, 0x4e, 0x14, 0x07, 0x3f,
+	0x82, 0xbd, 0x39, 0xa3, 0x53, 0x16, 0x46, 0x75, 0x5d, 0xd1, 0x3f, 0xd9, 0x42, 0x7f, 0xa2, 0x18,
+	0x64, 0xc5, 0xc4, 0x7d, 0x40, 0xdc, 0x9b, 0xb3, 0x90, 0x0b, 0xea, 0x39, 0xcc, 0x56, 0x93, 0xed,
+	0x1e, 0xe4, 0xff, 0xaf, 0x98, 0xc9, 0xfe, 0xc6, 0x50, 0x53, 0x86, 0xd0, 0x07, 0x14, 0xd0, 0x30,
+	0x62, 0xf6, 0x94, 0x87, 0x92, 0xf7, 0x82, 0x45, 0xf5, 0xc2, 0x07, 0xd5, 0xce, 0x25, 0xb5, 0xbb,
+	0x62, 0x92, 0xfd, 0x20, 0x63, 0x47, 0xf8, 0x57, 0x00, 0xec, 0xa5, 0x60, 0x5e, 0xc4, 0x7d, 0x2f,
+	0xaa, 0xef, 0x29, 0x9d, 0xfb, 0x5b, 0x74, 0xac, 0x15, 0x89, 0x6c, 0xf0, 0xb1, 0x05, 0xd5, 0x17,
+	0x2c, 0xe4, 0x97, 0xdc, 0xa1, 0x42, 0x09, 0x14, 0x95, 0x40, 0x73, 0x8b, 0xc0, 0xc5, 0x06, 0x8f,
+	0x64, 0x47, 0x19, 0xdf, 0x40, 0x69, 0xad, 0x8f, 0x31, 0xe8, 0x1e, 0x5d, 0x30, 0x95, 0xf7, 0x12,
+	0x51, 0xcf, 0xf8, 0x73, 0xd0, 0xc3, 0xd8, 0x65, 0xf5, 0xbc, 0xca, 0xf4, 0x0f, 0xb7, 0xc8, 0x93,
+	0xd8, 0x65, 0x44, 0x91, 0xf0, 0x67, 0x50, 0x75, 0x62, 0x7b, 0x11, 0xbb, 0x82, 0x07, 0x2e, 0x67,
+	0x61, 0x5d, 0x3f, 0xd0, 0x1e, 0xea, 0xa4, 0xe2, 0xc4, 0x67, 0x6b, 0xec, 0x54, 0x2f, 0xe6, 0x50,
+	0xde, 0xb8, 0x0f, 0xba, 0x1c, 0x88, 0xef, 0xc0, 0xee, 0xc4, 0xf5, 0x9d, 0xaf, 0xd5, 0xa4, 0x3a,
+	0x49, 0x0c, 0xe3, 0xcf, 0x1a, 0x54, 0x36, 0xc3, 0xde, 0x1a, 0xda, 0x29, 0xec, 0xbf, 0x93, 0x8e,
+	0x1b, 0xea, 0xf1, 0x9d, 0x6c, 0xd4, 0xb2, 0xd9, 0xc0, 0xbf, 0x84, 0xc2, 0x0b, 0xea, 0xc6, 0x6c,
+	0x55, 0x8b, 0x9f, 0x7e, 0x48, 0xe2, 0x42, 0xb2, 0x48, 0x4a, 0x3e, 0xd5, 0x8b, 0x3a, 0xda, 0x35,
+	0xfe, 0xad, 0x01, 0x5c, 0x3b, 0xf1, 0x7d, 0x28, 0xad, 0x13, 0x95, 0x06, 0x7c, 0x0d, 0xe0, 0x9f,
+	0x40, 0x8d, 0xbd, 0x0c, 0x98, 0x23, 0xd8, 0xd4, 0x56, 0x2a, 0x2a, 0xe8, 0x12, 0xa9, 0xae, 0xd0,
+	0x44, 0xe4, 0x67, 0xb0, 0xef, 0x52, 0xc1, 0x22, 0x61, 0x4f, 0x79, 0xa4, 0x4a, 0x50, 0xa5, 0x40,
+	0x27, 0xb5, 0x04, 0xee, 0xa6, 0x28, 0x1e, 0x40, 0x31, 0x62, 0x32, 0xa9, 0x62, 0xa9, 0xb6, 0xbb,
+	0x76, 0x7c, 0x7c, 0x63, 0xec, 0x99, 0x72, 0x18, 0xa5, 0x23, 0xc9, 0x5a, 0xc3, 0xf8, 0x39, 0xdc,
+	0xd9, 0xc6, 0xc0, 0x45, 0xd0, 0x4f, 0x28, 0x77, 0xd1, 0x0e, 0x2e, 0xc3, 0xde, 0x6f, 0x69, 0xe8,
+	0x71, 0x6f, 0x86, 0x34, 0xe3, 0xaf, 0x39, 0xa8, 0x65, 0xcf, 0x0d, 0xbe, 0x80, 0xaa, 0x6c, 0x4a,
+	0xdc, 0x13, 0x2c, 0xbc, 0xa4, 0x4e, 0x9a, 0xb4, 0xf6, 0x2f, 0xde, 0x5c, 0x35, 0xb3, 0x8e, 0xb7,
+	0x57, 0xcd, 0xfb, 0x0b, 0x1a, 0x44, 0x22, 0x8c, 0x1d, 0x11, 0x87, 0xec, 0x4b, 0x23, 0xe3, 0x36,
+	0x48, 0x85, 0x06, 0xbc, 0xb7, 0x32, 0xa5, 0xae, 0xf2, 0x79, 0xd4, 0xb5, 0x03, 0x2a, 0xe6, 0xf5,
+	0xdc, 0xb5, 0x6e, 0xc6, 0xf1, 0xbe, 0x6e, 0xc6, 0x6d, 0x90, 0xca, 0xca, 0x3e, 0xa7, 0x62, 0x8e,
+	0x1f, 0x81, 0x2e, 0x96, 0x41, 0xb2, 0xbf, 0xa5, 0x76, 0xf3, 0xcd, 0x55, 0x53, 0xd9, 0x6f, 0xaf,
+	0x9a, 0xb7, 0xb3, 0x2a, 0x12, 0x35, 0x88, 0x72, 0xe2, 0x2f, 0xa1, 0x40, 0xa7, 0x53, 0xdb, 0xf7,
+	0xd4, 0xa6, 0x97, 0xda, 0x9f, 0xbd, 0xb9, 0x6a, 0xa6, 0xc8, 0xdb, 0xab, 0xe6, 0x0f, 0xde, 0x59,
+	0x96, 0xc2, 0x0d, 0xb2, 0x4b, 0xa7, 0xd3, 0xa1, 0x67, 0xfc, 0x53, 0x83, 0x42, 0xd2, 0xa9, 0xb6,
+	0xd6, 0xf5, 0x17, 0xa0, 0x7f, 0xcd, 0xbd, 0xa9, 0x5a, 0x5e, 0xed, 0xf8, 0xc1, 0x07, 0xdb, 0x5c,
+	0xfa, 0x37, 0x5e, 0x06, 0x8c, 0xa8, 0x11, 0xb8, 0x0d, 0x95, 0xcb, 0xd8, 0x4b, 0xfa, 0xb3, 0xa0,
+	0x33, 0xb5, 0xa2, 0xda, 0xd6, 0x9e, 0x70, 0xf2, 0x6c, 0xd0, 0x19, 0xf7, 0x86, 0x03, 0x7b, 0x6c,
+	0x3e, 0x26, 0xe5, 0xd5, 0xa0, 0x31, 0x9d, 0x19, 0x4f, 0x01, 0xae, 0x75, 0x71, 0x15, 0x4a, 0x01,
+	0x8d, 0x22, 0x3b, 0x62, 0xde, 0x14, 0xed, 0xe0, 0x1a, 0x80, 0x32, 0x43, 0x16, 0xb8, 0x4b, 0xa4,
+	0xad, 0xdd, 0x13, 0x5f, 0xcc, 0x51, 0x0e, 0xef, 0x43, 0x59, 0x99, 0x7c, 0xe6, 0xf9, 0x21, 0x43,
+	0x79, 0xe3, 0x0f, 0x79, 0xc8, 0x9b, 0x01, 0xbf, 0xe1, 0x52, 0x59, 0x6d, 0x40, 0x6e, 0x63, 0x03,
+	0x64, 0x1b, 0xf1, 0x17, 0x41, 0x2c, 0x98, 0x1d, 0x7b, 0x5c, 0x44, 0x69, 0xe5, 0x57, 0x52, 0xf0,
+	0x99, 0xc4, 0x70, 0x0b, 0x6e, 0xb3, 0x97, 0x22, 0xa4, 0x76, 0x96, 0x9a, 0x74, 0x9c, 0x5b, 0xca,
+	0xd5, 0xd9, 0xe4, 0x9b, 0x50, 0x74, 0xa8, 0x60, 0x33, 0x3f, 0x5c, 0xd6, 0x0b, 0xaa, 0x4d, 0x6c,
+	0xdb, 0x97, 0x51, 0xc0, 0x9c, 0x4e, 0x4a, 0x4b, 0x2f, 0xad, 0xf5, 0x30, 0xdc, 0x83, 0xaa, 0x6a,
+	0x4f, 0xb6, 0x6c, 0x1e, 0xdc, 0x9b, 0xd5, 0xf7, 0x94, 0x4e, 0x63, 0x8b, 0x4e, 0x5b, 0xf2, 0xd4,
+	0xa1, 0x0b, 0x53, 0x99, 0xca, 0x64, 0x05, 0x71, 0x6f, 0x86, 0x3f, 0x05, 0x10, 0x7c, 0xc1, 0xfc,
+	0x58, 0xd8, 0x0b, 0xd9, 0xbb, 0x65, 0xd0, 0xa5, 0x14, 0x39, 0x8b, 0x64, 0x6b, 0x0b, 0x59, 0x14,
+	0xf8, 0x5e, 0xc4, 0xec, 0xc8, 0x99, 0xb3, 0x05, 0xad, 0x97, 0x3e, 0xd8, 0xda, 0x48, 0xca, 0x1c,
+	0x29, 0x22, 0xa9, 0x85, 0x19, 0xdb, 0x38, 0x85, 0x5a, 0x96, 0x81, 0xbf, 0x80, 0xc2, 0x25, 0x67,
+	0xee, 0x34, 0xaa, 0x6b, 0xaa, 0xd9, 0x1d, 0xdc, 0x20, 0x7a, 0x22, 0x89, 0x24, 0xe5, 0x1b, 0x7f,
+	0xd3, 0xa0, 0x9a, 0xf1, 0xc8, 0xfc, 0xa9, 0xb3, 0x98, 0x16, 0xb0, 0x7c, 0xc6, 0xbf, 0x4e, 0x0f,
+	0x54, 0x52, 0xc0, 0x87, 0xff, 0x4b, 0xbd, 0xa5, 0x7e, 0x93, 0x32, 0x56, 0x67, 0xab, 0x09, 0x65,
+	0xea, 0xba, 0xfe, 0x37, 0x36, 0x5b, 0x04, 0x62, 0xa9, 0xb2, 0x5f, 0x24, 0xa0, 0x20, 0x4b, 0x22,
+	0xc6, 0x19, 0x94, 0xd6, 0x63, 0xf0, 0x1e, 0xe4, 0x4d, 0x6f, 0x89, 0x76, 0x30, 0x40, 0x61, 0x38,
+	0xf9, 0x3d, 0x73, 0x04, 0xd2, 0x70, 0x09, 0x76, 0xcd, 0x30, 0xa4, 0x4b, 0x94, 0x93, 0xf0, 0x48,
+	0x84, 0xb2, 0x5b, 0xe5, 0xe5, 0xf3, 0x20, 0x5e, 0x4c, 0x58, 0x88, 0x74, 0xd9, 0xd0, 0xda, 0xbe,
+	0xef, 0xa2, 0x5d, 0xe3, 0x5f, 0x1a, 0xd4, 0xb2, 0xf7, 0xc3, 0x7b, 0x27, 0x49, 0xfb, 0xf8, 0x93,
+	0x84, 0x3f, 0x87, 0x5b, 0xd7, 0x1a, 0x6c, 0x11, 0xc8, 0xc6, 0x9d, 0xd6, 0x39, 0x5a, 0xf3, 0x52,
+	0x1c, 0x3f, 0x05, 0x99, 0xb7, 0xd8, 0x15, 0xeb, 0xe2, 0xca, 0x7f, 0x44, 0x71, 0x55, 0x93, 0xb1,
+	0xab, 0xea, 0xfa, 0x04, 0x8a, 0xb2, 0x93, 0xaa, 0x83, 0xa5, 0xda, 0x13, 0xd9, 0xa3, 0x01, 0x1f,
+	0xd0, 0x05, 0x33, 0xfe, 0xa2, 0x41, 0x79, 0x63, 0xbc, 0x2c, 0xc4, 0x40, 0x3d, 0xd9, 0x34, 0x9c,
+	0xa9, 0x7a, 0x28, 0x91, 0x52, 0x82, 0x98, 0xe1, 0x0c, 0xff, 0x06, 0xca, 0x89, 0x61, 0xcb, 0x88,
+	0xd3, 0x8c, 0x6e, 0x8b, 0xe9, 0xdc, 0x24, 0x23, 0x8b, 0xd8, 0x72, 0x37, 0x48, 0xaa, 0x78, 0x12,
+	0x7b, 0x8e, 0x3c, 0xcb, 0x53, 0x76, 0x49, 0xe5, 0xc2, 0x92, 0xdb, 0x4e, 0x75, 0x59, 0x52, 0x49,
+	0xc1, 0xe4, 0xb2, 0xbb, 0x07, 0x45, 0xe6, 0x39, 0xfe, 0x54, 0x2e, 0x3b, 0x89, 0x77, 0x6d, 0xab,
+	0x57, 0x81, 0xcd, 0x53, 0x89, 0x1f, 0x48, 0x45, 0xc1, 0xc2, 0x05, 0xf7, 0x78, 0x24, 0xb8, 0x93,
+	0x76, 0x94, 0x2c, 0x28, 0xdf, 0x2b, 0x5c, 0xdf, 0xa1, 0xae, 0x0a, 0xb9, 0x48, 0x12, 0x03, 0x1b,
+	0x50, 0x89, 0xe2, 0x49, 0xe4, 0x84, 0x3c, 0x90, 0xbb, 0x9f, 0x96, 0x56, 0x06, 0x93, 0xc1, 0x44,
+	0x82, 0x0a, 0x76, 0x19, 0xbb, 0x2a, 0x98, 0x2a, 0x59, 0xdb, 0xb2, 0x32, 0xe7, 0xd4, 0x9b, 0x71,
+	0x6f, 0x26, 0xdf, 0x25, 0xeb, 0xbb, 0x49, 0x65, 0xa6, 0x90, 0x19, 0xf0, 0x43, 0x03, 0x4a, 0xd6,
+	0x57, 0x63, 0x6b, 0x30, 0xea, 0x0d, 0x07, 0xb2, 0xc2, 0x06, 0xc3, 0x81, 0x95, 0x5c, 0x99, 0x26,
+	0xe9, 0x3c, 0xe9, 0x5d, 0x58, 0x48, 0x3b, 0xfc, 0x93, 0x06, 0x95, 0xcd, 0xaa, 0xc1, 0x15, 0x28,
+	0x76, 0x7b, 0x23, 0xb3, 0xdd, 0xb7, 0xba, 0x68, 0x07, 0x23, 0xa8, 0x3c, 0xb6, 0xc6, 0x76, 0xbb,
+	0x3f, 0xec, 0x3c, 0x1d, 0x3c, 0x3b, 0x43, 0x1a, 0xbe, 0x03, 0x68, 0x8d, 0xd8, 0xed, 0xe7, 0xb6,
+	0x44, 0x73, 0xf8, 0x1e, 0xdc, 0x1d, 0x59, 0x63, 0xbb, 0x6f, 0x8e, 0xad, 0xd1, 0xd8, 0xee, 0x0d,
+	0xec, 0x33, 0x6b, 0x6c, 0x76, 0xcd, 0xb1, 0x89, 0xf2, 0xf8, 0x2e, 0xe0, 0xac, 0xaf, 0x3d, 0xec,
+	0x3e, 0x47, 0xba, 0xd4, 0xbe, 0xb0, 0x48, 0xef, 0xa4, 0xd7, 0x31, 0xe5, 0xec, 0x68, 0x57, 0x32,
+	0xa5, 0xb6, 0x65, 0x92, 0x7e, 0xcf, 0x1a, 0xa5, 0x93, 0xa0, 0xc2, 0xe1, 0xb7, 0x1a, 0x94, 0x37,
+	0x72, 0x2a, 0x0f, 0x94, 0x75, 0x76, 0x3e, 0x7e, 0x9e, 0x04, 0xa8, 0x3c, 0x32, 0x14, 0x93, 0x3c,
+	0x46, 0x1a, 0xbe, 0x0d, 0xfb, 0x09, 0xd2, 0x31, 0x07, 0xc3, 0x41, 0xaf, 0x63, 0xf6, 0x51, 0x4e,
+	0x46, 0x9d, 0x80, 0xdd, 0x9e, 0x5a, 0xaa, 0x49, 0x9e, 0xa3, 0x3c, 0x6e, 0xc2, 0x8f, 0xde, 0x45,
+	0xed, 0x21, 0xb1, 0x87, 0xa4, 0x6b, 0x11, 0xab, 0x8b, 0x74, 0xb9, 0x55, 0x5d, 0xeb, 0xc4, 0x7c,
+	0xd6, 0x1f, 0xa3, 0x02, 0xc6, 0x50, 0x4b, 0xd8, 0xa7, 0xa3, 0xe1, 0xe0, 0xdc, 0x1c, 0x3f, 0x41,
+	0x7b, 0xf2, 0x92, 0x49, 0x30, 0x62, 0x3d, 0xb6, 0xbe, 0x42, 0xc5, 0x76, 0xfb, 0xdb, 0x57, 0x0d,
+	0xed, 0xbb, 0x57, 0x0d, 0xed, 0xfb, 0x57, 0x0d, 0xed, 0x1f, 0xaf, 0x1a, 0xda, 0x1f, 0x5f, 0x37,
+	0x76, 0xbe, 0x7f, 0xdd, 0xd8, 0xf9, 0xfb, 0xeb, 0xc6, 0xce, 0xef, 0x1e, 0xcc, 0xb8, 0x98, 0xc7,
+	0x93, 0x96, 0xe3, 0x2f, 0x8e, 0x32, 0x5f, 0x4f, 0x2f, 0x93, 0xef, 0x27, 0xd9, 0x71, 0xa2, 0x49,
+	0x41, 0x7d, 0x0e, 0x3d, 0xfa, 0xef, 0x00, 0x31, 0x0f, 0x12, 0xd0, 0x61, 0x0d, 0x00, 0x00,
 }
 
 func (this *ApiCollection) Equal(that interface{}) bool {
@@ -1362,6 +1531,68 @@ func (this *Api) Equal(that interface{}) bool {
 	if this.TimeoutMs != that1.TimeoutMs {
 		return false
 	}
+	if !this.ResponseSchema.Equal(that1.ResponseSchema) {
+		return false
+	}
+	return true
+}
+func (this *ResponseSchema) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResponseSchema)
+	if !ok {
+		that2, ok := that.(ResponseSchema)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Fields) != len(that1.Fields) {
+		return false
+	}
+	for i := range this.Fields {
+		if !this.Fields[i].Equal(that1.Fields[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ResponseField) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResponseField)
+	if !ok {
+		that2, ok := that.(ResponseField)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Path != that1.Path {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.AllowEmpty != that1.AllowEmpty {
+		return false
+	}
 	return true
 }
 func (this *ParseDirective) Equal(that interface{}) bool {
@@ -1887,6 +2118,18 @@ func (m *Api) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResponseSchema != nil {
+		{
+			size, err := m.ResponseSchema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApiCollection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.TimeoutMs != 0 {
 		i = encodeVarintApiCollection(dAtA, i, uint64(m.TimeoutMs))
 		i--
@@ -1942,6 +2185,88 @@ func (m *Api) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResponseSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApiCollection(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseField) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseField) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseField) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllowEmpty {
+		i--
+		if m.AllowEmpty {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Type != 0 {
+		i = encodeVarintApiCollection(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintApiCollection(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ParseDirective) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2322,6 +2647,44 @@ func (m *Api) Size() (n int) {
 	if m.TimeoutMs != 0 {
 		n += 1 + sovApiCollection(uint64(m.TimeoutMs))
 	}
+	if m.ResponseSchema != nil {
+		l = m.ResponseSchema.Size()
+		n += 1 + l + sovApiCollection(uint64(l))
+	}
+	return n
+}
+
+func (m *ResponseSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovApiCollection(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ResponseField) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovApiCollection(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovApiCollection(uint64(m.Type))
+	}
+	if m.AllowEmpty {
+		n += 2
+	}
 	return n
 }
 
@@ -3722,6 +4085,247 @@ func (m *Api) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResponseSchema == nil {
+				m.ResponseSchema = &ResponseSchema{}
+			}
+			if err := m.ResponseSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApiCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApiCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, &ResponseField{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApiCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseField) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApiCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseField: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseField: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ResponseField_FieldType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowEmpty", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowEmpty = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApiCollection(dAtA[iNdEx:])
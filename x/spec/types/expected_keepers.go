@@ -1,8 +1,10 @@
 package types
 
 import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	fixationstoretypes "github.com/lavanet/lava/x/fixationstore/types"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -20,3 +22,11 @@ type BankKeeper interface {
 type StakingKeeper interface {
 	BondDenom(ctx sdk.Context) string
 }
+
+type FixationStoreKeeper interface {
+	NewFixationStore(storeKey storetypes.StoreKey, prefix string) *fixationstoretypes.FixationStore
+}
+
+type EpochstorageKeeper interface {
+	GetCurrentNextEpoch(ctx sdk.Context) (nextEpoch uint64)
+}
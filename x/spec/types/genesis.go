@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	fixationtypes "github.com/lavanet/lava/x/fixationstore/types"
 )
 
 // DefaultIndex is the default capability global index
@@ -12,6 +14,7 @@ func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		SpecList:  []Spec{},
 		SpecCount: 0,
+		SpecsFS:   *fixationtypes.DefaultGenesis(),
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/lavanet/lava/x/fixationstore/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -25,9 +26,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the spec module's genesis state.
 type GenesisState struct {
-	Params    Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	SpecList  []Spec `protobuf:"bytes,2,rep,name=specList,proto3" json:"specList"`
-	SpecCount uint64 `protobuf:"varint,3,opt,name=specCount,proto3" json:"specCount,omitempty"`
+	Params    Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	SpecList  []Spec             `protobuf:"bytes,2,rep,name=specList,proto3" json:"specList"`
+	SpecCount uint64             `protobuf:"varint,3,opt,name=specCount,proto3" json:"specCount,omitempty"`
+	SpecsFS   types.GenesisState `protobuf:"bytes,4,opt,name=specsFS,proto3" json:"specsFS"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +86,13 @@ func (m *GenesisState) GetSpecCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetSpecsFS() types.GenesisState {
+	if m != nil {
+		return m.SpecsFS
+	}
+	return types.GenesisState{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lavanet.lava.spec.GenesisState")
 }
@@ -91,23 +100,25 @@ func init() {
 func init() { proto.RegisterFile("lavanet/lava/spec/genesis.proto", fileDescriptor_012a82932c0e5e6a) }
 
 var fileDescriptor_012a82932c0e5e6a = []byte{
-	// 244 bytes of a gzipped FileDescriptorProto
+	// 287 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x49, 0x2c, 0x4b,
 	0xcc, 0x4b, 0x2d, 0xd1, 0x07, 0xd1, 0xfa, 0xc5, 0x05, 0xa9, 0xc9, 0xfa, 0xe9, 0xa9, 0x79, 0xa9,
 	0xc5, 0x99, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x82, 0x50, 0x05, 0x7a, 0x20, 0x5a,
 	0x0f, 0xa4, 0x40, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0x2c, 0xab, 0x0f, 0x62, 0x41, 0x14, 0x4a,
 	0xc9, 0x61, 0x9a, 0x54, 0x90, 0x58, 0x94, 0x98, 0x0b, 0x35, 0x48, 0x4a, 0x06, 0x53, 0x1e, 0x44,
-	0x40, 0x64, 0x95, 0x16, 0x30, 0x72, 0xf1, 0xb8, 0x43, 0x2c, 0x0e, 0x2e, 0x49, 0x2c, 0x49, 0x15,
-	0x32, 0xe7, 0x62, 0x83, 0x68, 0x97, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd4, 0xc3, 0x70,
-	0x88, 0x5e, 0x00, 0x58, 0x81, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0xe5, 0x42, 0x96,
-	0x5c, 0x1c, 0x20, 0x49, 0x9f, 0xcc, 0xe2, 0x12, 0x09, 0x26, 0x05, 0x66, 0x0d, 0x6e, 0x23, 0x71,
-	0x2c, 0x5a, 0x83, 0x0b, 0x52, 0x93, 0xa1, 0x1a, 0xe1, 0xca, 0x85, 0x64, 0xb8, 0x38, 0x41, 0x6c,
-	0xe7, 0xfc, 0xd2, 0xbc, 0x12, 0x09, 0x66, 0x05, 0x46, 0x0d, 0x96, 0x20, 0x84, 0x80, 0x93, 0xdd,
-	0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c,
-	0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xa9, 0xa4, 0x67, 0x96, 0x64, 0x94,
-	0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xa3, 0xf8, 0xb2, 0x02, 0xe2, 0xcf, 0x92, 0xca, 0x82, 0xd4,
-	0xe2, 0x24, 0x36, 0xb0, 0x4f, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3b, 0xb9, 0xed, 0xea,
-	0x73, 0x01, 0x00, 0x00,
+	0x40, 0x65, 0x35, 0x51, 0x64, 0xd3, 0x32, 0x2b, 0x12, 0x4b, 0x32, 0xf3, 0xf3, 0x8a, 0x4b, 0xf2,
+	0x8b, 0x52, 0xe1, 0x3c, 0x88, 0x52, 0xa5, 0x57, 0x8c, 0x5c, 0x3c, 0xee, 0x10, 0x37, 0x06, 0x97,
+	0x24, 0x96, 0xa4, 0x0a, 0x99, 0x73, 0xb1, 0x41, 0x6c, 0x92, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36,
+	0x92, 0xd4, 0xc3, 0x70, 0xb3, 0x5e, 0x00, 0x58, 0x81, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41,
+	0x50, 0xe5, 0x42, 0x96, 0x5c, 0x1c, 0x20, 0x49, 0x9f, 0xcc, 0xe2, 0x12, 0x09, 0x26, 0x05, 0x66,
+	0x0d, 0x6e, 0x23, 0x71, 0x2c, 0x5a, 0x83, 0x0b, 0x52, 0x93, 0xa1, 0x1a, 0xe1, 0xca, 0x85, 0x64,
+	0xb8, 0x38, 0x41, 0x6c, 0xe7, 0xfc, 0xd2, 0xbc, 0x12, 0x09, 0x66, 0x05, 0x46, 0x0d, 0x96, 0x20,
+	0x84, 0x80, 0x90, 0x07, 0x17, 0x3b, 0x88, 0x53, 0xec, 0x16, 0x2c, 0xc1, 0x02, 0x76, 0x92, 0x06,
+	0xaa, 0xb9, 0x28, 0xfe, 0xd3, 0x43, 0xf6, 0x0c, 0xd4, 0x22, 0x98, 0x76, 0x27, 0xbb, 0x13, 0x8f,
+	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
+	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x49, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
+	0x4b, 0xce, 0xcf, 0xd5, 0x47, 0x09, 0xbc, 0x0a, 0x48, 0xe0, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27,
+	0xb1, 0x81, 0xc3, 0xcc, 0x18, 0x30, 0x00, 0xa6, 0x8f, 0xed, 0x8c, 0xe8, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -130,6 +141,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.SpecsFS.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if m.SpecCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.SpecCount))
 		i--
@@ -190,6 +211,8 @@ func (m *GenesisState) Size() (n int) {
 	if m.SpecCount != 0 {
 		n += 1 + sovGenesis(uint64(m.SpecCount))
 	}
+	l = m.SpecsFS.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -314,6 +337,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecsFS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpecsFS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,8 +5,11 @@ import "encoding/binary"
 var _ binary.ByteOrder
 
 const (
-	// SpecKeyPrefix is the prefix to retrieve all Spec
+	// SpecKeyPrefix is the prefix of the legacy Spec store (before the specs fixation store)
 	SpecKeyPrefix = "Spec/value/"
+
+	// SpecFixationStorePrefix is the prefix of the specs fixation store (specs with their versions)
+	SpecFixationStorePrefix = "spec-fs"
 )
 
 // SpecKey returns the store key to retrieve a Spec from the index fields
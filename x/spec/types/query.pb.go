@@ -201,6 +201,118 @@ func (m *QueryGetSpecResponse) GetSpec() Spec {
 	return Spec{}
 }
 
+type QuerySpecAtBlockRequest struct {
+	ChainID string `protobuf:"bytes,1,opt,name=ChainID,proto3" json:"ChainID,omitempty"`
+	Block   uint64 `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty"`
+	Raw     bool   `protobuf:"varint,3,opt,name=raw,proto3" json:"raw,omitempty"`
+}
+
+func (m *QuerySpecAtBlockRequest) Reset()         { *m = QuerySpecAtBlockRequest{} }
+func (m *QuerySpecAtBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpecAtBlockRequest) ProtoMessage()    {}
+func (*QuerySpecAtBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fac9d1cad3c30379, []int{4}
+}
+func (m *QuerySpecAtBlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpecAtBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpecAtBlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpecAtBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpecAtBlockRequest.Merge(m, src)
+}
+func (m *QuerySpecAtBlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpecAtBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpecAtBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpecAtBlockRequest proto.InternalMessageInfo
+
+func (m *QuerySpecAtBlockRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *QuerySpecAtBlockRequest) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *QuerySpecAtBlockRequest) GetRaw() bool {
+	if m != nil {
+		return m.Raw
+	}
+	return false
+}
+
+type QuerySpecAtBlockResponse struct {
+	Spec            Spec   `protobuf:"bytes,1,opt,name=Spec,proto3" json:"Spec"`
+	ActivationBlock uint64 `protobuf:"varint,2,opt,name=activation_block,json=activationBlock,proto3" json:"activation_block,omitempty"`
+}
+
+func (m *QuerySpecAtBlockResponse) Reset()         { *m = QuerySpecAtBlockResponse{} }
+func (m *QuerySpecAtBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpecAtBlockResponse) ProtoMessage()    {}
+func (*QuerySpecAtBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fac9d1cad3c30379, []int{5}
+}
+func (m *QuerySpecAtBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpecAtBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpecAtBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpecAtBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpecAtBlockResponse.Merge(m, src)
+}
+func (m *QuerySpecAtBlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpecAtBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpecAtBlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpecAtBlockResponse proto.InternalMessageInfo
+
+func (m *QuerySpecAtBlockResponse) GetSpec() Spec {
+	if m != nil {
+		return m.Spec
+	}
+	return Spec{}
+}
+
+func (m *QuerySpecAtBlockResponse) GetActivationBlock() uint64 {
+	if m != nil {
+		return m.ActivationBlock
+	}
+	return 0
+}
+
 type QueryAllSpecRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
@@ -209,7 +321,7 @@ func (m *QueryAllSpecRequest) Reset()         { *m = QueryAllSpecRequest{} }
 func (m *QueryAllSpecRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllSpecRequest) ProtoMessage()    {}
 func (*QueryAllSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fac9d1cad3c30379, []int{4}
+	return fileDescriptor_fac9d1cad3c30379, []int{6}
 }
 func (m *QueryAllSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -254,7 +366,7 @@ func (m *QueryAllSpecResponse) Reset()         { *m = QueryAllSpecResponse{} }
 func (m *QueryAllSpecResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllSpecResponse) ProtoMessage()    {}
 func (*QueryAllSpecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fac9d1cad3c30379, []int{5}
+	return fileDescriptor_fac9d1cad3c30379, []int{7}
 }
 func (m *QueryAllSpecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -304,7 +416,7 @@ func (m *QueryShowAllChainsRequest) Reset()         { *m = QueryShowAllChainsReq
 func (m *QueryShowAllChainsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryShowAllChainsRequest) ProtoMessage()    {}
 func (*QueryShowAllChainsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fac9d1cad3c30379, []int{6}
+	return fileDescriptor_fac9d1cad3c30379, []int{8}
 }
 func (m *QueryShowAllChainsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,7 +453,7 @@ func (m *QueryShowAllChainsResponse) Reset()         { *m = QueryShowAllChainsRe
 func (m *QueryShowAllChainsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryShowAllChainsResponse) ProtoMessage()    {}
 func (*QueryShowAllChainsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fac9d1cad3c30379, []int{7}
+	return fileDescriptor_fac9d1cad3c30379, []int{9}
 }
 func (m *QueryShowAllChainsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -388,7 +500,7 @@ func (m *ShowAllChainsInfoStruct) Reset()         { *m = ShowAllChainsInfoStruct
 func (m *ShowAllChainsInfoStruct) String() string { return proto.CompactTextString(m) }
 func (*ShowAllChainsInfoStruct) ProtoMessage()    {}
 func (*ShowAllChainsInfoStruct) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fac9d1cad3c30379, []int{8}
+	return fileDescriptor_fac9d1cad3c30379, []int{10}
 }
 func (m *ShowAllChainsInfoStruct) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,7 +565,7 @@ func (m *QueryShowChainInfoRequest) Reset()         { *m = QueryShowChainInfoReq
 func (m *QueryShowChainInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryShowChainInfoRequest) ProtoMessage()    {}
 func (*QueryShowChainInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fac9d1cad3c30379, []int{9}
+	return fileDescriptor_fac9d1cad3c30379, []int{11}
 }
 func (m *QueryShowChainInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -499,7 +611,7 @@ func (m *ApiList) Reset()         { *m = ApiList{} }
 func (m *ApiList) String() string { return proto.CompactTextString(m) }
 func (*ApiList) ProtoMessage()    {}
 func (*ApiList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fac9d1cad3c30379, []int{10}
+	return fileDescriptor_fac9d1cad3c30379, []int{12}
 }
 func (m *ApiList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -560,7 +672,7 @@ func (m *QueryShowChainInfoResponse) Reset()         { *m = QueryShowChainInfoRe
 func (m *QueryShowChainInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryShowChainInfoResponse) ProtoMessage()    {}
 func (*QueryShowChainInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fac9d1cad3c30379, []int{11}
+	return fileDescriptor_fac9d1cad3c30379, []int{13}
 }
 func (m *QueryShowChainInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -622,6 +734,8 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.spec.QueryParamsResponse")
 	proto.RegisterType((*QueryGetSpecRequest)(nil), "lavanet.lava.spec.QueryGetSpecRequest")
 	proto.RegisterType((*QueryGetSpecResponse)(nil), "lavanet.lava.spec.QueryGetSpecResponse")
+	proto.RegisterType((*QuerySpecAtBlockRequest)(nil), "lavanet.lava.spec.QuerySpecAtBlockRequest")
+	proto.RegisterType((*QuerySpecAtBlockResponse)(nil), "lavanet.lava.spec.QuerySpecAtBlockResponse")
 	proto.RegisterType((*QueryAllSpecRequest)(nil), "lavanet.lava.spec.QueryAllSpecRequest")
 	proto.RegisterType((*QueryAllSpecResponse)(nil), "lavanet.lava.spec.QueryAllSpecResponse")
 	proto.RegisterType((*QueryShowAllChainsRequest)(nil), "lavanet.lava.spec.QueryShowAllChainsRequest")
@@ -635,61 +749,68 @@ func init() {
 func init() { proto.RegisterFile("lavanet/lava/spec/query.proto", fileDescriptor_fac9d1cad3c30379) }
 
 var fileDescriptor_fac9d1cad3c30379 = []byte{
-	// 857 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4d, 0x4f, 0xdb, 0x48,
-	0x18, 0xc7, 0xe3, 0x24, 0x24, 0x64, 0x56, 0x48, 0xbb, 0x43, 0x24, 0x12, 0x07, 0x0c, 0x98, 0xd7,
-	0x45, 0x8b, 0xbd, 0xb0, 0xd2, 0xae, 0xf6, 0xb2, 0x52, 0x60, 0xb5, 0x88, 0x55, 0x85, 0xa8, 0xb9,
-	0x21, 0x55, 0xd1, 0xc4, 0x0c, 0x89, 0x25, 0xc7, 0x63, 0xe2, 0x09, 0x29, 0x45, 0x5c, 0xb8, 0xf5,
-	0x56, 0xb5, 0x97, 0x9e, 0xab, 0x7e, 0x18, 0x8e, 0x48, 0xbd, 0x70, 0xaa, 0x2a, 0xd2, 0x0f, 0x52,
-	0xcd, 0x4b, 0x12, 0x9b, 0xd8, 0x01, 0x55, 0xbd, 0x60, 0x66, 0x9e, 0x97, 0xff, 0x6f, 0xfe, 0x33,
-	0x3c, 0x02, 0xcc, 0xb9, 0xe8, 0x1c, 0x79, 0x98, 0x9a, 0xec, 0x6b, 0x06, 0x3e, 0xb6, 0xcd, 0xb3,
-	0x0e, 0x6e, 0x5f, 0x18, 0x7e, 0x9b, 0x50, 0x02, 0x7f, 0x91, 0x61, 0x83, 0x7d, 0x0d, 0x16, 0x56,
-	0x8b, 0x0d, 0xd2, 0x20, 0x3c, 0x6a, 0xb2, 0xdf, 0x44, 0xa2, 0x3a, 0xdb, 0x20, 0xa4, 0xe1, 0x62,
-	0x13, 0xf9, 0x8e, 0x89, 0x3c, 0x8f, 0x50, 0x44, 0x1d, 0xe2, 0x05, 0x32, 0xba, 0x61, 0x93, 0xa0,
-	0x45, 0x02, 0xb3, 0x8e, 0x02, 0x2c, 0xfa, 0x9b, 0xe7, 0x5b, 0x75, 0x4c, 0xd1, 0x96, 0xe9, 0xa3,
-	0x86, 0xe3, 0xf1, 0x64, 0x99, 0xab, 0x8d, 0x12, 0xf9, 0xa8, 0x8d, 0x5a, 0xfd, 0x5e, 0xb3, 0xa3,
-	0x71, 0xf6, 0x43, 0x44, 0xf5, 0x22, 0x80, 0xcf, 0x59, 0xff, 0x43, 0x5e, 0x62, 0xe1, 0xb3, 0x0e,
-	0x0e, 0xa8, 0x7e, 0x00, 0xa6, 0x23, 0xbb, 0x81, 0x4f, 0xbc, 0x00, 0xc3, 0xbf, 0x40, 0x4e, 0xb4,
-	0x2e, 0x29, 0x0b, 0xca, 0xfa, 0x4f, 0xdb, 0x65, 0x63, 0xe4, 0xb8, 0x86, 0x28, 0xd9, 0xc9, 0xde,
-	0x7c, 0x9e, 0x4f, 0x59, 0x32, 0x5d, 0x37, 0x65, 0xbf, 0x3d, 0x4c, 0x8f, 0x7c, 0x6c, 0x4b, 0x19,
-	0x58, 0x02, 0xf9, 0xdd, 0x26, 0x72, 0xbc, 0xfd, 0x7f, 0x79, 0xc3, 0x82, 0xd5, 0x5f, 0xea, 0xfb,
-	0xa0, 0x18, 0x2d, 0x90, 0x04, 0x5b, 0x20, 0xcb, 0xd6, 0x52, 0x7f, 0x26, 0x46, 0x9f, 0x85, 0xa5,
-	0x3a, 0x4f, 0xd5, 0x5f, 0x48, 0xed, 0xaa, 0xeb, 0x86, 0xb5, 0xff, 0x03, 0x60, 0x68, 0xa5, 0xec,
-	0xb7, 0x6a, 0x08, 0xdf, 0x0d, 0xe6, 0xbb, 0x21, 0xee, 0x55, 0xfa, 0x6e, 0x1c, 0xa2, 0x06, 0x96,
-	0xb5, 0x56, 0xa8, 0x52, 0x7f, 0xab, 0x48, 0xd4, 0x41, 0xff, 0x11, 0xd4, 0xcc, 0x13, 0x51, 0xe1,
-	0x5e, 0x84, 0x29, 0xcd, 0x99, 0xd6, 0x1e, 0x65, 0x12, 0x7a, 0x11, 0xa8, 0x0a, 0x28, 0x73, 0xa6,
-	0xa3, 0x26, 0xe9, 0x56, 0x5d, 0x97, 0xbb, 0x3a, 0xb8, 0x5c, 0x0a, 0xd4, 0xb8, 0xa0, 0xc4, 0x3e,
-	0x04, 0x53, 0x36, 0xbf, 0x04, 0xef, 0x94, 0x3c, 0x73, 0x02, 0x5a, 0x4a, 0x73, 0xfe, 0x8d, 0x38,
-	0xfe, 0x70, 0x03, 0x96, 0x7f, 0x44, 0xdb, 0x1d, 0x9b, 0x5a, 0xd1, 0x06, 0xff, 0x67, 0x27, 0x95,
-	0x9f, 0xd3, 0xfa, 0x07, 0x05, 0xcc, 0x24, 0x14, 0xc0, 0x59, 0x50, 0xe0, 0x25, 0x07, 0xa8, 0x85,
-	0xe5, 0x4b, 0x18, 0x6e, 0xb0, 0x57, 0x62, 0xcb, 0x57, 0x92, 0x16, 0xaf, 0x44, 0x2e, 0xe1, 0x36,
-	0x28, 0x62, 0x0f, 0xd5, 0x5d, 0x7c, 0x52, 0xf5, 0x9d, 0x7d, 0x8f, 0xe2, 0xf6, 0x29, 0xb2, 0x71,
-	0x50, 0xca, 0x2c, 0x64, 0xd6, 0x0b, 0x56, 0x6c, 0x0c, 0x56, 0x40, 0x01, 0xf9, 0x4e, 0xcd, 0x26,
-	0x1d, 0x8f, 0x96, 0xb2, 0x0b, 0xca, 0x7a, 0xd6, 0x9a, 0x44, 0xbe, 0xb3, 0xcb, 0xd6, 0xfa, 0xdf,
-	0x21, 0xdf, 0x76, 0xfb, 0x87, 0xe8, 0xbf, 0x98, 0xb1, 0x94, 0xba, 0x0d, 0xf2, 0x55, 0xdf, 0x61,
-	0x07, 0x66, 0x89, 0x4e, 0x5f, 0x90, 0x4b, 0x14, 0xac, 0xe1, 0x06, 0x5c, 0x06, 0x53, 0x41, 0xc7,
-	0xf7, 0x49, 0x9b, 0x72, 0xb4, 0xa0, 0x34, 0xc1, 0x69, 0xa3, 0x9b, 0xb0, 0x08, 0x26, 0xd0, 0xc9,
-	0x09, 0xf1, 0x4a, 0x39, 0x5e, 0x2f, 0x16, 0x7a, 0x4f, 0x09, 0xdd, 0x5d, 0x08, 0x50, 0xde, 0x5d,
-	0xc8, 0x29, 0x25, 0xea, 0x94, 0x06, 0x80, 0x33, 0xf4, 0x27, 0xcd, 0x15, 0x43, 0x3b, 0xf0, 0x18,
-	0xa8, 0x11, 0xfd, 0x81, 0x61, 0xfc, 0x09, 0x64, 0xf8, 0x13, 0x50, 0x63, 0x9e, 0x80, 0x3c, 0xb2,
-	0x35, 0xa6, 0x1a, 0x9a, 0x60, 0x9a, 0xf8, 0xec, 0x59, 0x22, 0xb7, 0x16, 0x82, 0xc8, 0x72, 0x08,
-	0xd8, 0x0f, 0x0d, 0xaf, 0x68, 0xfb, 0x2e, 0x0f, 0x26, 0xf8, 0x29, 0xe1, 0x2b, 0x90, 0x13, 0xf3,
-	0x04, 0xae, 0xc4, 0x88, 0x8f, 0x0e, 0x2e, 0x75, 0xf5, 0xb1, 0x34, 0xe1, 0x94, 0xbe, 0x78, 0xfd,
-	0xe9, 0xeb, 0xbb, 0x74, 0x05, 0x96, 0xcd, 0xa4, 0xe9, 0x09, 0xaf, 0x15, 0xf1, 0x07, 0x0c, 0x13,
-	0x7b, 0x46, 0xa7, 0x99, 0xba, 0xf6, 0x68, 0x9e, 0x14, 0xff, 0x95, 0x8b, 0x2f, 0xc1, 0x45, 0x33,
-	0x7e, 0x34, 0x9b, 0x97, 0x72, 0x0c, 0x5e, 0xc1, 0x4b, 0x90, 0x67, 0xa5, 0x55, 0xd7, 0x4d, 0xc6,
-	0x88, 0x0e, 0xb6, 0x64, 0x8c, 0x07, 0x03, 0x4a, 0x9f, 0xe7, 0x18, 0x65, 0x38, 0x93, 0x80, 0x01,
-	0x5f, 0x2b, 0x42, 0xdd, 0x42, 0xdd, 0x1f, 0x6f, 0xc2, 0x26, 0x57, 0x5f, 0x83, 0x2b, 0x09, 0xea,
-	0xb5, 0x36, 0xea, 0x86, 0x8c, 0xb8, 0x56, 0x00, 0x90, 0x4e, 0x8c, 0xc5, 0xf9, 0x5e, 0x33, 0x96,
-	0x38, 0xce, 0x1c, 0xac, 0x8c, 0xc1, 0x81, 0xef, 0x15, 0x30, 0x15, 0x99, 0x61, 0xf0, 0xb7, 0xa4,
-	0xfe, 0x71, 0x93, 0x57, 0xdd, 0x7c, 0x62, 0xb6, 0x64, 0xda, 0xe0, 0x4c, 0xcb, 0x50, 0x8f, 0x63,
-	0x6a, 0x92, 0x6e, 0x0d, 0xb9, 0x6e, 0xcd, 0x16, 0x20, 0x1f, 0x25, 0xda, 0x60, 0x28, 0x8c, 0x47,
-	0x7b, 0x38, 0xdc, 0xc6, 0xa3, 0x8d, 0x4c, 0x1a, 0xfd, 0x4f, 0x8e, 0xf6, 0x3b, 0x34, 0x92, 0xd0,
-	0x38, 0x56, 0xcd, 0xf1, 0x4e, 0x89, 0x79, 0x39, 0x18, 0x92, 0x57, 0x3b, 0xff, 0xdc, 0xdc, 0x6b,
-	0xca, 0xed, 0xbd, 0xa6, 0x7c, 0xb9, 0xd7, 0x94, 0x37, 0x3d, 0x2d, 0x75, 0xdb, 0xd3, 0x52, 0x77,
-	0x3d, 0x2d, 0x75, 0xbc, 0xdc, 0x70, 0x68, 0xb3, 0x53, 0x37, 0x6c, 0xd2, 0x8a, 0xf6, 0x7c, 0x29,
-	0xba, 0xd2, 0x0b, 0x1f, 0x07, 0xf5, 0x1c, 0xff, 0xaf, 0xe5, 0x8f, 0x6f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x5d, 0x85, 0xca, 0x90, 0x87, 0x09, 0x00, 0x00,
+	// 961 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0xb6, 0xf3, 0xc3, 0xaf, 0x8a, 0x08, 0x53, 0x4b, 0xd9, 0x6c, 0x52, 0x37, 0xdd,
+	0xa6, 0x4d, 0x1a, 0xc8, 0x2e, 0x09, 0x08, 0x04, 0x07, 0x24, 0x27, 0x88, 0x2a, 0xa8, 0xaa, 0xc2,
+	0xf6, 0x56, 0x84, 0xac, 0xf1, 0x66, 0xe2, 0xac, 0xd8, 0xec, 0x6c, 0xbd, 0xe3, 0xb8, 0x25, 0xca,
+	0x25, 0x37, 0x6e, 0x08, 0x2e, 0x9c, 0x2b, 0xfe, 0x98, 0x1e, 0x2b, 0x71, 0xe1, 0x84, 0x50, 0xc2,
+	0xbf, 0x81, 0x84, 0xe6, 0xcd, 0x38, 0xde, 0xc5, 0xbb, 0xb6, 0x15, 0xf5, 0x62, 0x7b, 0xe6, 0xfd,
+	0xf8, 0x7e, 0xe6, 0xcd, 0xf3, 0xdb, 0x85, 0x3b, 0x21, 0x3d, 0xa5, 0x11, 0x13, 0xae, 0xfc, 0x76,
+	0x93, 0x98, 0xf9, 0xee, 0x8b, 0x2e, 0xeb, 0xbc, 0x72, 0xe2, 0x0e, 0x17, 0x9c, 0xbc, 0xaf, 0xcd,
+	0x8e, 0xfc, 0x76, 0xa4, 0xd9, 0xaa, 0xb5, 0x79, 0x9b, 0xa3, 0xd5, 0x95, 0xbf, 0x94, 0xa3, 0xb5,
+	0xd2, 0xe6, 0xbc, 0x1d, 0x32, 0x97, 0xc6, 0x81, 0x4b, 0xa3, 0x88, 0x0b, 0x2a, 0x02, 0x1e, 0x25,
+	0xda, 0xba, 0xe9, 0xf3, 0xe4, 0x84, 0x27, 0x6e, 0x8b, 0x26, 0x4c, 0xe5, 0x77, 0x4f, 0xb7, 0x5b,
+	0x4c, 0xd0, 0x6d, 0x37, 0xa6, 0xed, 0x20, 0x42, 0x67, 0xed, 0x5b, 0x1f, 0x26, 0x8a, 0x69, 0x87,
+	0x9e, 0xf4, 0x73, 0xad, 0x0c, 0xdb, 0xe5, 0x87, 0xb2, 0xda, 0x35, 0x20, 0xdf, 0xca, 0xfc, 0x07,
+	0x18, 0xe2, 0xb1, 0x17, 0x5d, 0x96, 0x08, 0xfb, 0x29, 0xdc, 0xce, 0xec, 0x26, 0x31, 0x8f, 0x12,
+	0x46, 0x3e, 0x83, 0x19, 0x95, 0xda, 0x34, 0x56, 0x8d, 0x8d, 0x5b, 0x3b, 0x4b, 0xce, 0xd0, 0x71,
+	0x1d, 0x15, 0xb2, 0x5b, 0x79, 0xf3, 0xd7, 0xdd, 0x29, 0x4f, 0xbb, 0xdb, 0xae, 0xce, 0xf7, 0x98,
+	0x89, 0x67, 0x31, 0xf3, 0xb5, 0x0c, 0x31, 0x61, 0x76, 0xef, 0x98, 0x06, 0xd1, 0xfe, 0x57, 0x98,
+	0xb0, 0xea, 0xf5, 0x97, 0xf6, 0x3e, 0xd4, 0xb2, 0x01, 0x9a, 0x60, 0x1b, 0x2a, 0x72, 0xad, 0xf5,
+	0x17, 0x73, 0xf4, 0xa5, 0x59, 0xab, 0xa3, 0xab, 0xfd, 0x1d, 0x2c, 0x62, 0x2a, 0xb9, 0x68, 0x88,
+	0xdd, 0x90, 0xfb, 0x3f, 0x8c, 0xd5, 0x27, 0x35, 0x98, 0x6e, 0x49, 0x4f, 0xb3, 0xb4, 0x6a, 0x6c,
+	0x54, 0x3c, 0xb5, 0x20, 0x0b, 0x50, 0xee, 0xd0, 0x9e, 0x59, 0x5e, 0x35, 0x36, 0xe6, 0x3c, 0xf9,
+	0xd3, 0x7e, 0x09, 0xe6, 0x70, 0xf2, 0x1b, 0xb3, 0x92, 0x47, 0xb0, 0x40, 0x7d, 0x11, 0x9c, 0xe2,
+	0xfd, 0x36, 0xd3, 0x04, 0xef, 0x0d, 0xf6, 0x51, 0xc5, 0xfe, 0x5e, 0x97, 0xb4, 0x11, 0x86, 0xe9,
+	0x92, 0x7e, 0x0d, 0x30, 0xe8, 0x10, 0x2d, 0xfd, 0xd0, 0x51, 0xed, 0xe4, 0xc8, 0x76, 0x72, 0x54,
+	0xbb, 0xea, 0x76, 0x72, 0x0e, 0x68, 0x9b, 0xe9, 0x58, 0x2f, 0x15, 0x69, 0xff, 0x62, 0x40, 0x2d,
+	0x9b, 0x7f, 0xe8, 0x54, 0xe5, 0x49, 0x4f, 0xf5, 0x38, 0xc3, 0x54, 0x42, 0xa6, 0xf5, 0xb1, 0x4c,
+	0x4a, 0x2f, 0x03, 0xb5, 0x0c, 0x4b, 0xaa, 0xda, 0xc7, 0xbc, 0xd7, 0x08, 0x43, 0xbc, 0xac, 0xeb,
+	0x9e, 0x15, 0x60, 0xe5, 0x19, 0x35, 0xf6, 0x01, 0xcc, 0xfb, 0x78, 0xb7, 0xd1, 0x11, 0x7f, 0x12,
+	0x24, 0xc2, 0x2c, 0x21, 0xff, 0x66, 0x1e, 0x7f, 0x3a, 0x81, 0xf4, 0x7f, 0x26, 0x3a, 0x5d, 0x5f,
+	0x78, 0xd9, 0x04, 0xdf, 0x54, 0xe6, 0x8c, 0x85, 0x92, 0xfd, 0xda, 0x80, 0xc5, 0x82, 0x00, 0xb2,
+	0x02, 0x55, 0x0c, 0x79, 0x4a, 0x4f, 0x98, 0x6e, 0xb0, 0xc1, 0x86, 0x6c, 0x3e, 0x5f, 0x37, 0x5f,
+	0x49, 0x35, 0x9f, 0x5e, 0x92, 0x1d, 0xa8, 0xb1, 0x88, 0xb6, 0x42, 0x76, 0xd8, 0x88, 0x83, 0xfd,
+	0x48, 0xb0, 0xce, 0x11, 0xf5, 0x59, 0x62, 0x96, 0x57, 0xcb, 0x1b, 0x55, 0x2f, 0xd7, 0x46, 0x96,
+	0xa1, 0x4a, 0xe3, 0xa0, 0xe9, 0xf3, 0x6e, 0x24, 0xcc, 0x0a, 0xb6, 0xcc, 0x1c, 0x8d, 0x83, 0x3d,
+	0xb9, 0xb6, 0x3f, 0x4f, 0xd5, 0x6d, 0xaf, 0x7f, 0x88, 0x7e, 0xc7, 0x8c, 0xa4, 0xb4, 0x7d, 0x98,
+	0x6d, 0xc4, 0xc1, 0x93, 0x40, 0x39, 0x06, 0x7d, 0x41, 0x94, 0xa8, 0x7a, 0x83, 0x0d, 0xb2, 0x06,
+	0xf3, 0x49, 0x37, 0x8e, 0x79, 0x47, 0x20, 0x5a, 0x62, 0x4e, 0x23, 0x6d, 0x76, 0x53, 0xfe, 0xaf,
+	0xe8, 0xe1, 0x21, 0x8f, 0xcc, 0x19, 0x8c, 0x57, 0x0b, 0xfb, 0xca, 0x00, 0x2b, 0x0f, 0x50, 0xdf,
+	0x5d, 0xaa, 0x52, 0x46, 0xb6, 0x52, 0x75, 0x80, 0x60, 0x50, 0x9f, 0x12, 0x2a, 0xa6, 0x76, 0xc8,
+	0x73, 0xb0, 0x32, 0xfa, 0xd7, 0x05, 0xc3, 0x16, 0x28, 0x63, 0x0b, 0x58, 0x39, 0x2d, 0xa0, 0x8f,
+	0xec, 0x8d, 0x88, 0x26, 0x2e, 0xdc, 0xe6, 0xb1, 0x6c, 0x4b, 0x1a, 0x36, 0x53, 0x10, 0x15, 0x84,
+	0x20, 0x7d, 0xd3, 0xe0, 0x8a, 0x76, 0xfe, 0x9d, 0x83, 0x69, 0x3c, 0x25, 0xf9, 0x11, 0x66, 0xd4,
+	0x98, 0x24, 0x0f, 0x72, 0xc4, 0x87, 0xe7, 0xb1, 0xf5, 0x70, 0x9c, 0x9b, 0xaa, 0x94, 0x7d, 0xef,
+	0xe2, 0x8f, 0x7f, 0x7e, 0x2d, 0x2d, 0x93, 0x25, 0xb7, 0xe8, 0xa1, 0x40, 0x2e, 0x0c, 0xf5, 0x07,
+	0x26, 0x85, 0x39, 0xb3, 0x43, 0xda, 0x5a, 0x1f, 0xeb, 0xa7, 0xc5, 0x1f, 0xa1, 0xf8, 0x7d, 0x72,
+	0xcf, 0xcd, 0x7f, 0xe2, 0xb8, 0x67, 0x7a, 0xba, 0x9e, 0x93, 0x33, 0x98, 0xc5, 0x89, 0x19, 0x86,
+	0xc5, 0x18, 0xd9, 0xc1, 0x66, 0xad, 0x8f, 0xf5, 0xd3, 0x18, 0x77, 0x11, 0x63, 0x89, 0x2c, 0x16,
+	0x60, 0x90, 0x9f, 0x0c, 0xa5, 0xee, 0xd1, 0xde, 0xbb, 0x2f, 0xc2, 0x16, 0xaa, 0xaf, 0x93, 0x07,
+	0x05, 0xea, 0xcd, 0x0e, 0xed, 0xa5, 0x0a, 0x71, 0x61, 0x00, 0xe8, 0x4a, 0x8c, 0xc4, 0xb9, 0x69,
+	0x31, 0xee, 0x23, 0xce, 0x1d, 0xb2, 0x3c, 0x02, 0x87, 0xfc, 0x66, 0xc0, 0x7c, 0x66, 0x86, 0x91,
+	0x0f, 0x8b, 0xf2, 0xe7, 0x4d, 0x5e, 0x6b, 0x6b, 0x42, 0x6f, 0xcd, 0xb4, 0x89, 0x4c, 0x6b, 0xc4,
+	0xce, 0x63, 0x3a, 0xe6, 0xbd, 0x26, 0x0d, 0xc3, 0xa6, 0xaf, 0x40, 0x7e, 0xd7, 0x68, 0xd7, 0x43,
+	0x61, 0x34, 0xda, 0xff, 0x87, 0x9b, 0xb5, 0x35, 0xa1, 0xb7, 0x46, 0xfb, 0x14, 0xd1, 0x3e, 0x22,
+	0x4e, 0x11, 0x1a, 0x62, 0x35, 0x83, 0xe8, 0x88, 0xbb, 0x67, 0xd7, 0x43, 0xf2, 0x9c, 0xbc, 0x36,
+	0xe0, 0x56, 0xea, 0x15, 0x80, 0x6c, 0x16, 0xca, 0x0e, 0xbd, 0x84, 0x58, 0x1f, 0x4c, 0xe4, 0xab,
+	0x01, 0xbf, 0x40, 0xc0, 0x4f, 0xc8, 0x4e, 0xd1, 0x7d, 0x52, 0xa1, 0x5e, 0x1b, 0x06, 0x3d, 0xe6,
+	0x9e, 0xe1, 0xc6, 0xf9, 0xee, 0x97, 0x6f, 0x2e, 0xeb, 0xc6, 0xdb, 0xcb, 0xba, 0xf1, 0xf7, 0x65,
+	0xdd, 0xf8, 0xf9, 0xaa, 0x3e, 0xf5, 0xf6, 0xaa, 0x3e, 0xf5, 0xe7, 0x55, 0x7d, 0xea, 0xf9, 0x5a,
+	0x3b, 0x10, 0xc7, 0xdd, 0x96, 0xe3, 0xf3, 0x93, 0x6c, 0xde, 0x97, 0x2a, 0xb3, 0x78, 0x15, 0xb3,
+	0xa4, 0x35, 0x83, 0x6f, 0x8c, 0x1f, 0xff, 0x37, 0x00, 0xda, 0xbd, 0x16, 0x51, 0x03, 0x0b, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -718,6 +839,8 @@ type QueryClient interface {
 	ShowAllChains(ctx context.Context, in *QueryShowAllChainsRequest, opts ...grpc.CallOption) (*QueryShowAllChainsResponse, error)
 	// Queries a list of ShowChainInfo items.
 	ShowChainInfo(ctx context.Context, in *QueryShowChainInfoRequest, opts ...grpc.CallOption) (*QueryShowChainInfoResponse, error)
+	// Queries the Spec version that was in effect at a specific block.
+	SpecAtBlock(ctx context.Context, in *QuerySpecAtBlockRequest, opts ...grpc.CallOption) (*QuerySpecAtBlockResponse, error)
 }
 
 type queryClient struct {
@@ -791,6 +914,15 @@ func (c *queryClient) ShowChainInfo(ctx context.Context, in *QueryShowChainInfoR
 	return out, nil
 }
 
+func (c *queryClient) SpecAtBlock(ctx context.Context, in *QuerySpecAtBlockRequest, opts ...grpc.CallOption) (*QuerySpecAtBlockResponse, error) {
+	out := new(QuerySpecAtBlockResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.spec.Query/SpecAtBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -807,6 +939,8 @@ type QueryServer interface {
 	ShowAllChains(context.Context, *QueryShowAllChainsRequest) (*QueryShowAllChainsResponse, error)
 	// Queries a list of ShowChainInfo items.
 	ShowChainInfo(context.Context, *QueryShowChainInfoRequest) (*QueryShowChainInfoResponse, error)
+	// Queries the Spec version that was in effect at a specific block.
+	SpecAtBlock(context.Context, *QuerySpecAtBlockRequest) (*QuerySpecAtBlockResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -834,6 +968,9 @@ func (*UnimplementedQueryServer) ShowAllChains(ctx context.Context, req *QuerySh
 func (*UnimplementedQueryServer) ShowChainInfo(ctx context.Context, req *QueryShowChainInfoRequest) (*QueryShowChainInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowChainInfo not implemented")
 }
+func (*UnimplementedQueryServer) SpecAtBlock(ctx context.Context, req *QuerySpecAtBlockRequest) (*QuerySpecAtBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpecAtBlock not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -965,6 +1102,24 @@ func _Query_ShowChainInfo_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SpecAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpecAtBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpecAtBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.spec.Query/SpecAtBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpecAtBlock(ctx, req.(*QuerySpecAtBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.spec.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -997,6 +1152,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ShowChainInfo",
 			Handler:    _Query_ShowChainInfo_Handler,
 		},
+		{
+			MethodName: "SpecAtBlock",
+			Handler:    _Query_SpecAtBlock_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/spec/query.proto",
@@ -1121,6 +1280,89 @@ func (m *QueryGetSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySpecAtBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpecAtBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpecAtBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Raw {
+		i--
+		if m.Raw {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Block != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpecAtBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpecAtBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpecAtBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActivationBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryAllSpecRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1509,6 +1751,39 @@ func (m *QueryGetSpecResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySpecAtBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Block != 0 {
+		n += 1 + sovQuery(uint64(m.Block))
+	}
+	if m.Raw {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySpecAtBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Spec.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ActivationBlock != 0 {
+		n += 1 + sovQuery(uint64(m.ActivationBlock))
+	}
+	return n
+}
+
 func (m *QueryAllSpecRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1962,6 +2237,229 @@ func (m *QueryGetSpecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySpecAtBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpecAtBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpecAtBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Raw = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpecAtBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpecAtBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpecAtBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationBlock", wireType)
+			}
+			m.ActivationBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryAllSpecRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/lavanet/lava/utils/jsonpath"
+)
+
+// CompiledResponseSchema is a ResponseSchema with the jsonpaths of its fields compiled,
+// it is compiled once when the spec is loaded so replies are checked without compiling the paths again
+type CompiledResponseSchema struct {
+	fields []compiledResponseField
+}
+
+type compiledResponseField struct {
+	*ResponseField
+	path jsonpath.Path
+}
+
+// Compile compiles the schema fields, a schema without fields compiles to nil which accepts any reply
+func (rs *ResponseSchema) Compile() (*CompiledResponseSchema, error) {
+	if rs == nil || len(rs.Fields) == 0 {
+		return nil, nil
+	}
+	compiled := &CompiledResponseSchema{fields: make([]compiledResponseField, 0, len(rs.Fields))}
+	for _, field := range rs.Fields {
+		path, err := jsonpath.Compile(field.Path)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := ResponseField_FieldType_name[int32(field.Type)]; !ok {
+			return nil, fmt.Errorf("response field %s has an unsupported type %d", field.Path, field.Type)
+		}
+		compiled.fields = append(compiled.fields, compiledResponseField{ResponseField: field, path: path})
+	}
+	return compiled, nil
+}
+
+// ValidateSchema makes sure every field of the schema can be evaluated
+func (rs *ResponseSchema) ValidateSchema() error {
+	_, err := rs.Compile()
+	return err
+}
+
+// CheckResponse returns an error if the reply data doesn't have one of the schema fields, or has it with a different type.
+// fields nested under an allowed empty field are skipped when it is empty (e.g. $.result.hash when $.result is null)
+func (crs *CompiledResponseSchema) CheckResponse(data []byte) error {
+	if crs == nil {
+		return nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var parsed interface{}
+	if err := decoder.Decode(&parsed); err != nil {
+		return fmt.Errorf("reply is not json: %w", err)
+	}
+	emptyPaths := []string{}
+	for _, field := range crs.fields {
+		if isNestedPath(field.Path, emptyPaths) {
+			continue
+		}
+		value, found := field.path.Get(parsed)
+		if !found {
+			return fmt.Errorf("reply is missing %s", field.Path)
+		}
+		if isEmptyJsonValue(value) {
+			if field.AllowEmpty {
+				emptyPaths = append(emptyPaths, field.Path)
+				continue
+			}
+			return fmt.Errorf("reply has an empty %s", field.Path)
+		}
+		if !field.Type.matches(value) {
+			return fmt.Errorf("reply has %s of type %T, expected %s", field.Path, value, field.Type)
+		}
+	}
+	return nil
+}
+
+func isNestedPath(path string, parents []string) bool {
+	for _, parent := range parents {
+		if strings.HasPrefix(path, parent+".") || strings.HasPrefix(path, parent+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+func (ft ResponseField_FieldType) matches(value interface{}) bool {
+	switch ft {
+	case ResponseField_Object:
+		_, ok := value.(map[string]interface{})
+		return ok
+	case ResponseField_Array:
+		_, ok := value.([]interface{})
+		return ok
+	case ResponseField_String:
+		_, ok := value.(string)
+		return ok
+	case ResponseField_Number:
+		_, ok := value.(json.Number)
+		return ok
+	case ResponseField_Bool:
+		_, ok := value.(bool)
+		return ok
+	}
+	return true
+}
+
+func isEmptyJsonValue(value interface{}) bool {
+	switch typed := value.(type) {
+	case nil:
+		return true
+	case string:
+		return typed == ""
+	case []interface{}:
+		return len(typed) == 0
+	case map[string]interface{}:
+		return len(typed) == 0
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseSchemaCheckResponse(t *testing.T) {
+	schema := &ResponseSchema{Fields: []*ResponseField{
+		{Path: "$.result", Type: ResponseField_Object},
+		{Path: "$.result.hash", Type: ResponseField_String},
+		{Path: "$.result.transactions", Type: ResponseField_Array, AllowEmpty: true},
+	}}
+	nullableSchema := &ResponseSchema{Fields: []*ResponseField{
+		{Path: "$.result", Type: ResponseField_Object, AllowEmpty: true},
+		{Path: "$.result.hash", Type: ResponseField_String},
+	}}
+	require.NoError(t, schema.ValidateSchema())
+	compiled, err := schema.Compile()
+	require.NoError(t, err)
+	compiledNullable, err := nullableSchema.Compile()
+	require.NoError(t, err)
+	playbook := []struct {
+		name  string
+		data  string
+		valid bool
+	}{
+		{name: "valid", data: `{"jsonrpc":"2.0","id":1,"result":{"hash":"0xab","transactions":["0x1"]}}`, valid: true},
+		{name: "allowed empty", data: `{"jsonrpc":"2.0","id":1,"result":{"hash":"0xab","transactions":[]}}`, valid: true},
+		{name: "null result", data: `{"jsonrpc":"2.0","id":1,"result":null}`, valid: false},
+		{name: "empty result", data: `{"jsonrpc":"2.0","id":1,"result":{}}`, valid: false},
+		{name: "missing field", data: `{"jsonrpc":"2.0","id":1,"result":{"hash":"0xab"}}`, valid: false},
+		{name: "wrong type", data: `{"jsonrpc":"2.0","id":1,"result":{"hash":12,"transactions":[]}}`, valid: false},
+		{name: "html", data: `<html><body>502 Bad Gateway</body></html>`, valid: false},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			err := compiled.CheckResponse([]byte(play.data))
+			if play.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	require.NoError(t, compiledNullable.CheckResponse([]byte(`{"jsonrpc":"2.0","id":1,"result":null}`)))
+	require.NoError(t, compiledNullable.CheckResponse([]byte(`{"jsonrpc":"2.0","id":1,"result":{"hash":"0xab"}}`)))
+	require.Error(t, compiledNullable.CheckResponse([]byte(`{"jsonrpc":"2.0","id":1,"result":{"number":"0x1"}}`)))
+
+	var noSchema *ResponseSchema
+	compiled, err = noSchema.Compile()
+	require.NoError(t, err)
+	require.NoError(t, compiled.CheckResponse([]byte("anything")))
+	require.Error(t, (&ResponseSchema{Fields: []*ResponseField{{Path: "result"}}}).ValidateSchema())
+}
@@ -102,6 +102,10 @@ func (spec Spec) ValidateSpec(maxCU uint64) (map[string]string, error) {
 					return details, fmt.Errorf("unsupported api encoding %s in apiCollection %v ", parsing.ResultParsing.Encoding, apiCollection.CollectionData)
 				}
 			}
+			if err := parsing.ResultParsing.ValidateParserArgs(); err != nil {
+				details["apiCollection"] = fmt.Sprintf("%v", apiCollection.CollectionData)
+				return details, fmt.Errorf("invalid result parsing for function tag %s: %w", parsing.FunctionTag, err)
+			}
 			if parsing.FunctionTag == FUNCTION_TAG_GET_BLOCK_BY_NUM {
 				if !strings.Contains(parsing.FunctionTemplate, "%") {
 					return details, fmt.Errorf("function tag FUNCTION_TAG_GET_BLOCK_BY_NUM does not contain %%d")
@@ -125,6 +129,14 @@ func (spec Spec) ValidateSpec(maxCU uint64) (map[string]string, error) {
 				details["api"] = api.Name
 				return details, fmt.Errorf("api name includes a space character %s", api.Name)
 			}
+			if err := api.BlockParsing.ValidateParserArgs(); err != nil {
+				details["api"] = api.Name
+				return details, fmt.Errorf("invalid block parsing for api %s: %w", api.Name, err)
+			}
+			if err := api.ResponseSchema.ValidateSchema(); err != nil {
+				details["api"] = api.Name
+				return details, fmt.Errorf("invalid response schema for api %s: %w", api.Name, err)
+			}
 		}
 		currentHeaders := map[string]struct{}{}
 		for _, header := range apiCollection.Headers {
@@ -145,6 +157,14 @@ func (spec Spec) ValidateSpec(maxCU uint64) (map[string]string, error) {
 		for _, extension := range apiCollection.Extensions {
 			extensionsNames[extension.Name] = struct{}{}
 		}
+		for _, verification := range apiCollection.Verifications {
+			if verification.ParseDirective == nil {
+				continue
+			}
+			if err := verification.ParseDirective.ResultParsing.ValidateParserArgs(); err != nil {
+				return details, fmt.Errorf("invalid result parsing for verification %s: %w", verification.Name, err)
+			}
+		}
 		if len(extensionsNames) > 0 {
 			// validate verifications
 			for _, verification := range apiCollection.Verifications {
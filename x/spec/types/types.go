@@ -117,6 +117,26 @@ func (s *ParseValue_VerificationSeverity) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// allows unmarshaling response field type
+func (s ResponseField_FieldType) MarshalJSON() ([]byte, error) {
+	buffer := bytes.NewBufferString(`"`)
+	buffer.WriteString(ResponseField_FieldType_name[int32(s)])
+	buffer.WriteString(`"`)
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalJSON unmarshals a quoted json string to the enum value
+func (s *ResponseField_FieldType) UnmarshalJSON(b []byte) error {
+	var j string
+	err := json.Unmarshal(b, &j)
+	if err != nil {
+		return err
+	}
+	// Note that if the string cannot be found then it will be set to the zero value, 'Any' in this case.
+	*s = ResponseField_FieldType(ResponseField_FieldType_value[j])
+	return nil
+}
+
 func IsFinalizedBlock(requestedBlock, latestBlock int64, finalizationCriteria uint32) bool {
 	switch requestedBlock {
 	case NOT_APPLICABLE:
@@ -31,6 +31,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdList())
 	cmd.AddCommand(CmdNextToMonthExpiry())
+	cmd.AddCommand(CmdUsageHistory())
+	cmd.AddCommand(CmdMonthUsage())
+	cmd.AddCommand(CmdTrialBudget())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
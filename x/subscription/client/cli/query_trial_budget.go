@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/subscription/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdTrialBudget() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trial-budget",
+		Short: "Query the remaining trial budget of the current month",
+		Long: `Shows the monthly budget for trial subscriptions, the amount spent this month,
+the remaining amount (bounded by the trial pool balance) and the trial pool balance.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TrialBudget(cmd.Context(), &types.QueryTrialBudgetRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
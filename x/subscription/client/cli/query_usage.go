@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/subscription/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdUsageHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usage-history [subscription]",
+		Short: "Query the subscription's usage per month",
+		Long: `Query the subscription's usage and credit spent in each of its latest months.
+Use the month block of a month to query its usage per project, developer key, chain or provider with month-usage`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryUsageHistoryRequest{
+				Subscription: args[0],
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.UsageHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdMonthUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "month-usage [subscription] [month-block] [project|developer_key|chain|provider]",
+		Short: "Query a subscription month's usage per project, developer key, chain or provider",
+		Example: `lavad q subscription month-usage <subscription> <month-block> project
+lavad q subscription month-usage <subscription> <month-block> provider --limit 10`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			monthBlock, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			entryType, err := types.ParseUsageEntryType(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryMonthUsageRequest{
+				Subscription: args[0],
+				MonthBlock:   monthBlock,
+				Type:         entryType,
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.MonthUsage(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -32,6 +32,7 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdAddProject())
 	cmd.AddCommand(CmdDelProject())
 	cmd.AddCommand(CmdAutoRenewal())
+	cmd.AddCommand(CmdFundTrialPool())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -16,6 +16,13 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	k.InitCuTrackers(ctx, genState.CuTrackerFS)
 	k.InitCuTrackerTimers(ctx, genState.CuTrackerTS)
 	k.SetAllAdjustment(ctx, genState.Adjustments)
+	k.InitUsageHistory(ctx, genState.UsageMonths, genState.UsageEntries)
+	for _, record := range genState.TrialRecords {
+		k.SetTrialRecord(ctx, record)
+	}
+	if genState.TrialBudget.PeriodStart != 0 {
+		k.SetTrialBudget(ctx, genState.TrialBudget)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -27,6 +34,12 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.CuTrackerFS = k.ExportCuTrackers(ctx)
 	genesis.CuTrackerTS = k.ExportCuTrackerTimers(ctx)
 	genesis.Adjustments = k.GetAllAdjustment(ctx)
+	genesis.UsageMonths = k.GetAllMonthUsage(ctx)
+	genesis.UsageEntries = k.GetAllUsageEntry(ctx)
+	genesis.TrialRecords = k.GetAllTrialRecord(ctx)
+	if budget, found := k.GetTrialBudget(ctx); found {
+		genesis.TrialBudget = budget
+	}
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
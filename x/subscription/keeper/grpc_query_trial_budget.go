@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/subscription/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) TrialBudget(goCtx context.Context, req *types.QueryTrialBudgetRequest) (*types.QueryTrialBudgetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	budget, remaining := k.RemainingTrialBudget(ctx)
+
+	// the month starts with the first trial bought after the previous month ended
+	periodEnd := uint64(0)
+	if _, found := k.GetTrialBudget(ctx); found {
+		periodEnd = uint64(utils.NextMonth(time.Unix(int64(budget.PeriodStart), 0).UTC()).Unix())
+	}
+
+	return &types.QueryTrialBudgetResponse{
+		MonthlyBudget: k.TrialMonthlyBudget(ctx),
+		Spent:         budget.Spent,
+		Remaining:     remaining,
+		PoolBalance:   k.TrialPoolBalance(ctx),
+		PeriodEnd:     periodEnd,
+	}, nil
+}
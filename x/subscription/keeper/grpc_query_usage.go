@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/lavanet/lava/x/subscription/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) UsageHistory(goCtx context.Context, req *types.QueryUsageHistoryRequest) (*types.QueryUsageHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(k.usageMonthStore(ctx), types.UsageSubscriptionPrefix(req.Subscription))
+
+	var months []types.MonthUsage
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var month types.MonthUsage
+		if err := k.cdc.Unmarshal(value, &month); err != nil {
+			return err
+		}
+
+		months = append(months, month)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUsageHistoryResponse{Months: months, Pagination: pageRes}, nil
+}
+
+func (k Keeper) MonthUsage(goCtx context.Context, req *types.QueryMonthUsageRequest) (*types.QueryMonthUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	month, found := k.GetMonthUsage(ctx, req.Subscription, req.MonthBlock)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no usage for subscription %s in month of block %d", req.Subscription, req.MonthBlock)
+	}
+
+	store := prefix.NewStore(k.usageEntryStore(ctx), types.UsageEntryTypePrefix(req.Subscription, req.MonthBlock, req.Type))
+
+	var entries []types.UsageEntry
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var entry types.UsageEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMonthUsageResponse{Month: month, Entries: entries, Pagination: pageRes}, nil
+}
@@ -236,3 +236,11 @@ func (m Migrator) Migrate7to8(ctx sdk.Context) error {
 
 	return nil
 }
+
+// Migrate8to9 implements store migration from v8 to v9:
+//   - Set the new trial params
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	utils.LavaFormatDebug("migrate 8->9: subscriptions")
+	m.keeper.SetParams(ctx, types.DefaultParams())
+	return nil
+}
@@ -65,6 +65,13 @@ func (k msgServer) AutoRenewal(goCtx context.Context, msg *types.MsgAutoRenewal)
 			)
 		}
 
+		if plan.Trial {
+			return nil, utils.LavaFormatWarning("cannot apply auto-renewal to subscription", types.ErrTrialNotEligible,
+				utils.LogAttr("reason", "trial plans can't be renewed"),
+				utils.LogAttr("plan", plan.Index),
+			)
+		}
+
 		if len(plan.AllowedBuyers) != 0 {
 			if !lavaslices.Contains(plan.AllowedBuyers, msg.Creator) {
 				allowedBuyers := strings.Join(plan.AllowedBuyers, ",")
@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/subscription/types"
+)
+
+func (k msgServer) FundTrialPool(goCtx context.Context, msg *types.MsgFundTrialPool) (*types.MsgFundTrialPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.FundTrialPoolFromAccount(ctx, msg.Creator, msg.Amount)
+	return &types.MsgFundTrialPoolResponse{}, err
+}
@@ -7,10 +7,18 @@ import (
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.TrialMonthlyBudget(ctx),
+	)
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// TrialMonthlyBudget returns the TrialMonthlyBudget param
+func (k Keeper) TrialMonthlyBudget(ctx sdk.Context) (res sdk.Coin) {
+	k.paramstore.Get(ctx, types.KeyTrialMonthlyBudget, &res)
+	return
+}
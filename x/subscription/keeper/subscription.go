@@ -63,6 +63,24 @@ func (k Keeper) CreateSubscription(
 	// Hence, in case of user making double upgrade in the same epoch, we take the next epoch.
 	found := k.subsFS.FindEntry(ctx, consumer, nextEpoch, &sub)
 
+	// a trial plan can only start a new subscription, and is paid by the trial pool
+	price := plan.GetPrice()
+	price.Amount = price.Amount.MulRaw(int64(duration))
+	k.applyPlanDiscountIfEligible(duration, &plan, &price)
+
+	if plan.Trial {
+		if found {
+			return utils.LavaFormatWarning("cannot buy trial subscription", types.ErrTrialNotEligible,
+				utils.LogAttr("reason", "consumer already has a subscription"),
+				utils.LogAttr("consumer", consumer),
+			)
+		}
+		err = k.verifyTrialEligibility(ctx, consumer, &plan, duration, autoRenewalFlag, price)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Subscription creation:
 	//   When: if not already exists for consumer address)
 	//   What: find plan, create default project, set duration, update credit,
@@ -86,6 +104,10 @@ func (k Keeper) CreateSubscription(
 		if err != nil {
 			return utils.LavaFormatWarning("failed to create subscription", err)
 		}
+		if plan.Trial {
+			sub.MonthCuTotal = trialMonthCu(&plan, plan.TrialCuLimit)
+			sub.MonthCuLeft = sub.MonthCuTotal
+		}
 	} else {
 		// Allow renewal with the same plan ("same" means both plan index);
 		// If the plan index is different - upgrade if the price is higher or equal
@@ -121,11 +143,7 @@ func (k Keeper) CreateSubscription(
 		return utils.LavaFormatWarning("create subscription failed", err)
 	}
 
-	// subscription looks good; let's create it and charge the creator
-	price := plan.GetPrice()
-	price.Amount = price.Amount.MulRaw(int64(duration))
-	k.applyPlanDiscountIfEligible(duration, &plan, &price)
-
+	// subscription looks good; let's create it and charge the creator (or the trial pool)
 	sub.Credit = sub.Credit.AddAmount(price.Amount)
 
 	if !found {
@@ -141,6 +159,10 @@ func (k Keeper) CreateSubscription(
 		k.subsFS.ModifyEntry(ctx, consumer, sub.Block, &sub)
 	}
 
+	if plan.Trial {
+		return k.chargeTrialPool(ctx, creator, &sub, &plan, price)
+	}
+
 	err = k.chargeFromCreatorAccountToModule(ctx, creatorAcct, price)
 	if err != nil {
 		return err
@@ -321,6 +343,13 @@ func (k Keeper) renewSubscription(ctx sdk.Context, sub *types.Subscription) erro
 		}
 	}
 
+	if plan.Trial {
+		return utils.LavaFormatWarning("cannot auto-renew subscription", types.ErrTrialNotEligible,
+			utils.LogAttr("reason", "trial plans can't be renewed"),
+			utils.LogAttr("plan", plan.Index),
+		)
+	}
+
 	sub.PlanIndex = plan.Index
 	sub.PlanBlock = plan.Block
 	sub.DurationBought += 1
@@ -391,6 +420,7 @@ func (k Keeper) advanceMonth(ctx sdk.Context, subkey []byte) {
 
 	if sub.DurationLeft > 0 {
 		sub.DurationTotal += 1
+		k.applyTrialCuLimit(ctx, &sub)
 		err := k.resetSubscriptionDetailsAndAppendEntry(ctx, &sub, block, false)
 		if err != nil {
 			utils.LavaFormatError("failed subscription reset in advance month", err,
@@ -594,6 +624,13 @@ func (k Keeper) CreateFutureSubscription(ctx sdk.Context,
 		return err
 	}
 
+	if plan.Trial {
+		return utils.LavaFormatWarning("cannot buy trial subscription", types.ErrTrialNotEligible,
+			utils.LogAttr("reason", "trial plans can't be bought in advance"),
+			utils.LogAttr("consumer", consumer),
+		)
+	}
+
 	if duration > types.MAX_SUBSCRIPTION_DURATION {
 		str := strconv.FormatInt(types.MAX_SUBSCRIPTION_DURATION, 10)
 		return utils.LavaFormatWarning("duration cannot exceed limit ("+str+" months)",
@@ -355,7 +355,7 @@ func TestMonthlyRechargeCU(t *testing.T) {
 			proj, err := ts.GetProjectForDeveloper(tt.developer, block1)
 			require.NoError(t, err)
 			err = ts.Keepers.Projects.ChargeComputeUnitsToProject(
-				ts.Ctx, proj, block1, tt.usedCuPerProject)
+				ts.Ctx, proj, tt.developer, block1, tt.usedCuPerProject)
 			require.NoError(t, err)
 
 			// verify that project used the CU
@@ -1322,7 +1322,7 @@ func TestSubscriptionUpgrade(t *testing.T) {
 	// Charge CU from project so we can differentiate the old project from the new one
 	projectCuUsed := uint64(100)
 	project := getProjectAndFailTestIfNotFound(t, ts, consumer, ts.BlockHeight())
-	err = ts.Keepers.Projects.ChargeComputeUnitsToProject(ts.Ctx, project, ts.BlockHeight(), projectCuUsed)
+	err = ts.Keepers.Projects.ChargeComputeUnitsToProject(ts.Ctx, project, consumer, ts.BlockHeight(), projectCuUsed)
 	require.NoError(t, err)
 
 	// Validate the charge of CU
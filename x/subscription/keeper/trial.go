@@ -0,0 +1,244 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/lavanet/lava/utils"
+	planstypes "github.com/lavanet/lava/x/plans/types"
+	"github.com/lavanet/lava/x/subscription/types"
+)
+
+// Trial plans are free for the consumer: the subscription is paid by the trial pool, which
+// is limited by a monthly budget (param). Each consumer can buy a trial plan only once, which
+// is marked by its trial record. The trial record also tracks the CU left for the whole trial.
+
+func (k Keeper) trialRecordStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TrialRecordKeyPrefix))
+}
+
+// SetTrialRecord set a consumer's trial record in the store
+func (k Keeper) SetTrialRecord(ctx sdk.Context, record types.TrialRecord) {
+	b := k.cdc.MustMarshal(&record)
+	k.trialRecordStore(ctx).Set([]byte(record.Consumer), b)
+}
+
+// GetTrialRecord returns a consumer's trial record
+func (k Keeper) GetTrialRecord(ctx sdk.Context, consumer string) (val types.TrialRecord, found bool) {
+	b := k.trialRecordStore(ctx).Get([]byte(consumer))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllTrialRecord returns all the trial records (for genesis)
+func (k Keeper) GetAllTrialRecord(ctx sdk.Context) (list []types.TrialRecord) {
+	store := k.trialRecordStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TrialRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetTrialBudget set the trial budget of the current month in the store
+func (k Keeper) SetTrialBudget(ctx sdk.Context, budget types.TrialBudget) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&budget)
+	store.Set(types.KeyPrefix(types.TrialBudgetKey), b)
+}
+
+// GetTrialBudget returns the stored trial budget (without starting a new month)
+func (k Keeper) GetTrialBudget(ctx sdk.Context) (val types.TrialBudget, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.KeyPrefix(types.TrialBudgetKey))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// currentTrialBudget returns the trial budget of the current month. if the stored budget's
+// month has ended (or there is none), a new month with no spending is returned
+func (k Keeper) currentTrialBudget(ctx sdk.Context) types.TrialBudget {
+	budget, found := k.GetTrialBudget(ctx)
+	if found {
+		periodEnd := utils.NextMonth(time.Unix(int64(budget.PeriodStart), 0).UTC())
+		if ctx.BlockTime().Before(periodEnd) {
+			return budget
+		}
+	}
+
+	return types.TrialBudget{
+		PeriodStart: uint64(ctx.BlockTime().UTC().Unix()),
+		Spent:       sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), math.ZeroInt()),
+	}
+}
+
+// TrialPoolBalance returns the balance of the trial pool
+func (k Keeper) TrialPoolBalance(ctx sdk.Context) sdk.Coin {
+	return k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.TrialPoolName), k.stakingKeeper.BondDenom(ctx))
+}
+
+// RemainingTrialBudget returns the current month's trial budget and the amount that can
+// still be spent on trials this month (bounded by the trial pool balance)
+func (k Keeper) RemainingTrialBudget(ctx sdk.Context) (types.TrialBudget, sdk.Coin) {
+	budget := k.currentTrialBudget(ctx)
+	remaining := sdk.NewCoin(budget.Spent.Denom, math.ZeroInt())
+
+	monthlyBudget := k.TrialMonthlyBudget(ctx)
+	if monthlyBudget.Amount.GT(budget.Spent.Amount) {
+		remaining.Amount = monthlyBudget.Amount.Sub(budget.Spent.Amount)
+	}
+
+	poolBalance := k.TrialPoolBalance(ctx)
+	if poolBalance.Amount.LT(remaining.Amount) {
+		remaining.Amount = poolBalance.Amount
+	}
+
+	return budget, remaining
+}
+
+// verifyTrialEligibility checks that a consumer can buy a trial plan for the given duration,
+// and that the price of the trial fits in this month's trial budget
+func (k Keeper) verifyTrialEligibility(ctx sdk.Context, consumer string, plan *planstypes.Plan, duration uint64, autoRenewalFlag bool, price sdk.Coin) error {
+	if _, found := k.GetTrialRecord(ctx, consumer); found {
+		return utils.LavaFormatWarning("cannot buy trial subscription", types.ErrTrialAlreadyUsed,
+			utils.LogAttr("consumer", consumer),
+			utils.LogAttr("plan", plan.Index),
+		)
+	}
+
+	if duration > plan.TrialDuration {
+		return utils.LavaFormatWarning("cannot buy trial subscription", types.ErrTrialNotEligible,
+			utils.LogAttr("reason", "duration exceeds the trial duration"),
+			utils.LogAttr("duration", duration),
+			utils.LogAttr("trial_duration", plan.TrialDuration),
+		)
+	}
+
+	if autoRenewalFlag {
+		return utils.LavaFormatWarning("cannot buy trial subscription", types.ErrTrialNotEligible,
+			utils.LogAttr("reason", "trial subscriptions can't be auto-renewed"),
+			utils.LogAttr("consumer", consumer),
+		)
+	}
+
+	_, remaining := k.RemainingTrialBudget(ctx)
+	if remaining.IsLT(price) {
+		return utils.LavaFormatWarning("cannot buy trial subscription", types.ErrTrialBudgetExhausted,
+			utils.LogAttr("price", price),
+			utils.LogAttr("remaining", remaining),
+		)
+	}
+
+	return nil
+}
+
+// chargeTrialPool pays a trial subscription from the trial pool, and marks that the consumer used its trial
+func (k Keeper) chargeTrialPool(ctx sdk.Context, creator string, sub *types.Subscription, plan *planstypes.Plan, price sdk.Coin) error {
+	budget, _ := k.RemainingTrialBudget(ctx)
+
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.TrialPoolName, types.ModuleName, []sdk.Coin{price})
+	if err != nil {
+		return utils.LavaFormatError("create trial subscription failed. funds transfer failed", err,
+			utils.LogAttr("consumer", sub.Consumer),
+			utils.LogAttr("price", price),
+		)
+	}
+
+	budget.Spent = budget.Spent.Add(price)
+	k.SetTrialBudget(ctx, budget)
+
+	k.SetTrialRecord(ctx, types.TrialRecord{
+		Consumer:  sub.Consumer,
+		Creator:   creator,
+		PlanIndex: plan.Index,
+		PlanBlock: plan.Block,
+		Block:     uint64(ctx.BlockHeight()),
+		Cost:      price,
+		CuLeft:    plan.TrialCuLimit,
+	})
+
+	details := map[string]string{
+		"creator":  creator,
+		"consumer": sub.Consumer,
+		"plan":     plan.Index,
+		"duration": strconv.FormatUint(sub.DurationBought, 10),
+		"cost":     price.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.BuyTrialSubscriptionEventName, details, "trial subscription bought")
+	return nil
+}
+
+// applyTrialCuLimit deducts the CU used in the past month from the consumer's trial and limits
+// the coming month's CU to what's left of the trial. subscriptions not on their trial plan are unaffected
+func (k Keeper) applyTrialCuLimit(ctx sdk.Context, sub *types.Subscription) {
+	record, found := k.GetTrialRecord(ctx, sub.Consumer)
+	if !found || record.PlanIndex != sub.PlanIndex || record.PlanBlock != sub.PlanBlock {
+		return
+	}
+
+	if sub.MonthCuTotal > sub.MonthCuLeft {
+		used := sub.MonthCuTotal - sub.MonthCuLeft
+		if used > record.CuLeft {
+			used = record.CuLeft
+		}
+		record.CuLeft -= used
+		k.SetTrialRecord(ctx, record)
+	}
+
+	plan, found := k.plansKeeper.FindPlan(ctx, sub.PlanIndex, sub.PlanBlock)
+	if !found {
+		return
+	}
+	sub.MonthCuTotal = trialMonthCu(&plan, record.CuLeft)
+}
+
+// trialMonthCu returns the CU for a trial subscription month
+func trialMonthCu(plan *planstypes.Plan, trialCuLeft uint64) uint64 {
+	if plan.PlanPolicy.TotalCuLimit < trialCuLeft {
+		return plan.PlanPolicy.TotalCuLimit
+	}
+	return trialCuLeft
+}
+
+// FundTrialPoolFromAccount transfers funds from an account to the trial pool
+func (k Keeper) FundTrialPoolFromAccount(ctx sdk.Context, creator string, amount sdk.Coin) error {
+	creatorAcct, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		return utils.LavaFormatWarning("invalid creator address", err,
+			utils.LogAttr("creator", creator),
+		)
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, creatorAcct, types.TrialPoolName, sdk.NewCoins(amount))
+	if err != nil {
+		return utils.LavaFormatWarning("fund trial pool failed", err,
+			utils.LogAttr("creator", creator),
+			utils.LogAttr("amount", amount),
+		)
+	}
+
+	details := map[string]string{
+		"creator": creator,
+		"amount":  amount.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.FundTrialPoolEventName, details, "trial pool funded")
+	return nil
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commonconsts "github.com/lavanet/lava/testutil/common/consts"
+	keepertest "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/x/subscription/types"
+	"github.com/stretchr/testify/require"
+)
+
+// setupTrialPlan adds a trial plan (price 100, 2 months, 150000 CU for the whole trial)
+// and sets the monthly trial budget
+func (ts *tester) setupTrialPlan(monthlyBudget int64) {
+	trialPlan := ts.Plan("free")
+	trialPlan.Index = "trial"
+	trialPlan.Trial = true
+	trialPlan.TrialDuration = 2
+	trialPlan.TrialCuLimit = 150000
+	ts.AddPlan(trialPlan.Index, trialPlan)
+
+	params := ts.Keepers.Subscription.GetParams(ts.Ctx)
+	params.TrialMonthlyBudget = sdk.NewCoin(commonconsts.TestTokenDenom, math.NewInt(monthlyBudget))
+	ts.Keepers.Subscription.SetParams(ts.Ctx, params)
+}
+
+func (ts *tester) trialPoolBalance() int64 {
+	return ts.GetBalance(keepertest.GetModuleAddress(types.TrialPoolName))
+}
+
+func TestTrialSubscription(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(2, 0, 0) // 2 sub, 0 adm, 0 dev
+	consumer, consumerAddr := ts.Account("sub1")
+	_, funderAddr := ts.Account("sub2")
+	ts.setupTrialPlan(300)
+
+	// the pool is empty: no budget even though the param is set
+	_, err := ts.TxSubscriptionBuy(consumerAddr, consumerAddr, "trial", 1, false, false)
+	require.ErrorIs(t, err, types.ErrTrialBudgetExhausted)
+
+	require.NoError(t, ts.TxSubscriptionFundTrialPool(funderAddr, sdk.NewCoin(commonconsts.TestTokenDenom, math.NewInt(1000))))
+	require.Equal(t, int64(1000), ts.trialPoolBalance())
+
+	// longer than the trial duration, or with auto-renewal
+	_, err = ts.TxSubscriptionBuy(consumerAddr, consumerAddr, "trial", 3, false, false)
+	require.ErrorIs(t, err, types.ErrTrialNotEligible)
+	_, err = ts.TxSubscriptionBuy(consumerAddr, consumerAddr, "trial", 1, true, false)
+	require.ErrorIs(t, err, types.ErrTrialNotEligible)
+
+	// the trial is paid by the pool
+	balance := ts.GetBalance(consumer.Addr)
+	_, err = ts.TxSubscriptionBuy(consumerAddr, consumerAddr, "trial", 2, false, false)
+	require.NoError(t, err)
+	require.Equal(t, balance, ts.GetBalance(consumer.Addr))
+	require.Equal(t, int64(800), ts.trialPoolBalance())
+
+	sub := getSubscriptionAndFailTestIfNotFound(t, ts, consumerAddr)
+	require.Equal(t, int64(200), sub.Credit.Amount.Int64())
+	require.Equal(t, uint64(100000), sub.MonthCuTotal)
+
+	res, err := ts.QuerySubscriptionTrialBudget()
+	require.NoError(t, err)
+	require.Equal(t, int64(200), res.Spent.Amount.Int64())
+	require.Equal(t, int64(100), res.Remaining.Amount.Int64())
+	require.Equal(t, int64(800), res.PoolBalance.Amount.Int64())
+
+	// trial subscriptions can't be extended or auto-renewed
+	_, err = ts.TxSubscriptionBuy(consumerAddr, consumerAddr, "trial", 1, false, false)
+	require.ErrorIs(t, err, types.ErrTrialNotEligible)
+	err = ts.TxSubscriptionAutoRenewal(consumerAddr, consumerAddr, "trial", true)
+	require.ErrorIs(t, err, types.ErrTrialNotEligible)
+
+	// the second month gets what's left of the trial CU
+	block := ts.BlockHeight()
+	ts.AdvanceEpoch()
+	_, err = ts.Keepers.Subscription.ChargeComputeUnitsToSubscription(ts.Ctx, consumerAddr, block, 80000)
+	require.NoError(t, err)
+	ts.AdvanceMonths(1).AdvanceEpoch()
+	sub = getSubscriptionAndFailTestIfNotFound(t, ts, consumerAddr)
+	require.Equal(t, uint64(70000), sub.MonthCuTotal)
+	require.Equal(t, uint64(70000), sub.MonthCuLeft)
+
+	// a new month has a new budget
+	res, err = ts.QuerySubscriptionTrialBudget()
+	require.NoError(t, err)
+	require.True(t, res.Spent.IsZero())
+	require.Equal(t, int64(300), res.Remaining.Amount.Int64())
+
+	// the consumer can't have another trial after the trial expired
+	ts.AdvanceMonths(1).AdvanceEpoch()
+	_, found := ts.getSubscription(consumerAddr)
+	require.False(t, found)
+	_, err = ts.TxSubscriptionBuy(consumerAddr, consumerAddr, "trial", 1, false, false)
+	require.ErrorIs(t, err, types.ErrTrialAlreadyUsed)
+
+	// but can buy a regular plan
+	_, err = ts.TxSubscriptionBuy(consumerAddr, consumerAddr, "free", 1, false, false)
+	require.NoError(t, err)
+}
+
+func TestTrialBudgetExhausted(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(3, 0, 0) // 3 sub, 0 adm, 0 dev
+	_, consumer1 := ts.Account("sub1")
+	_, consumer2 := ts.Account("sub2")
+	_, funderAddr := ts.Account("sub3")
+	ts.setupTrialPlan(300)
+	require.NoError(t, ts.TxSubscriptionFundTrialPool(funderAddr, sdk.NewCoin(commonconsts.TestTokenDenom, math.NewInt(1000))))
+
+	_, err := ts.TxSubscriptionBuy(consumer1, consumer1, "trial", 2, false, false)
+	require.NoError(t, err)
+
+	// only 100 left this month
+	_, err = ts.TxSubscriptionBuy(consumer2, consumer2, "trial", 2, false, false)
+	require.ErrorIs(t, err, types.ErrTrialBudgetExhausted)
+	_, err = ts.TxSubscriptionBuy(consumer2, consumer2, "trial", 1, false, false)
+	require.NoError(t, err)
+
+	// consumers with a subscription can't switch to a trial, and trials can't be bought in advance
+	ts.AddAccount("sub", 4, 20000)
+	_, consumer4 := ts.Account("sub4")
+	_, err = ts.TxSubscriptionBuy(consumer4, consumer4, "free", 1, false, false)
+	require.NoError(t, err)
+	_, err = ts.TxSubscriptionBuy(consumer4, consumer4, "trial", 1, false, false)
+	require.ErrorIs(t, err, types.ErrTrialNotEligible)
+	_, err = ts.TxSubscriptionBuy(consumer4, consumer4, "trial", 1, false, true)
+	require.ErrorIs(t, err, types.ErrTrialNotEligible)
+}
@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/subscription/types"
+)
+
+// The usage history keeps the usage of each subscription month per project, developer key,
+// chain and provider, and the credit paid for it. Unlike the CU tracker it is not reset when
+// the month's rewards are paid, only the latest UsageHistoryMonths months of a subscription are kept.
+
+func (k Keeper) usageMonthStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UsageMonthKeyPrefix))
+}
+
+func (k Keeper) usageEntryStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UsageEntryKeyPrefix))
+}
+
+// SetMonthUsage set a subscription month's usage summary in the store
+func (k Keeper) SetMonthUsage(ctx sdk.Context, month types.MonthUsage) {
+	b := k.cdc.MustMarshal(&month)
+	k.usageMonthStore(ctx).Set(types.UsageMonthKey(month.Subscription, month.MonthBlock), b)
+}
+
+// GetMonthUsage returns a subscription month's usage summary
+func (k Keeper) GetMonthUsage(ctx sdk.Context, sub string, monthBlock uint64) (val types.MonthUsage, found bool) {
+	b := k.usageMonthStore(ctx).Get(types.UsageMonthKey(sub, monthBlock))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// SetUsageEntry set a subscription month's usage entry in the store
+func (k Keeper) SetUsageEntry(ctx sdk.Context, entry types.UsageEntry) {
+	b := k.cdc.MustMarshal(&entry)
+	k.usageEntryStore(ctx).Set(types.UsageEntryKey(entry.Subscription, entry.MonthBlock, entry.Type, entry.Name), b)
+}
+
+// GetUsageEntry returns a subscription month's usage entry
+func (k Keeper) GetUsageEntry(ctx sdk.Context, sub string, monthBlock uint64, entryType types.UsageEntry_Type, name string) (val types.UsageEntry, found bool) {
+	b := k.usageEntryStore(ctx).Get(types.UsageEntryKey(sub, monthBlock, entryType, name))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RecordUsage adds CU to the usage history of the subscription's current month
+func (k Keeper) RecordUsage(ctx sdk.Context, sub types.Subscription, projectID string, developerKey string, provider string, chainID string, cu uint64) {
+	month, found := k.GetMonthUsage(ctx, sub.Consumer, sub.Block)
+	if !found {
+		month = types.MonthUsage{
+			Subscription:    sub.Consumer,
+			MonthBlock:      sub.Block,
+			MonthExpiryTime: sub.MonthExpiryTime,
+			PlanIndex:       sub.PlanIndex,
+			CreditSpent:     sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), math.ZeroInt()),
+		}
+	}
+	month.Cu += cu
+	k.SetMonthUsage(ctx, month)
+	if !found {
+		k.pruneUsageHistory(ctx, sub.Consumer)
+	}
+
+	k.addUsageEntry(ctx, month, types.UsageEntry_project, projectID, cu, math.ZeroInt())
+	k.addUsageEntry(ctx, month, types.UsageEntry_developer_key, developerKey, cu, math.ZeroInt())
+	k.addUsageEntry(ctx, month, types.UsageEntry_chain, chainID, cu, math.ZeroInt())
+	k.addUsageEntry(ctx, month, types.UsageEntry_provider, provider, cu, math.ZeroInt())
+}
+
+// recordProviderCredit adds the credit paid to a provider to the usage history of a month.
+// months that were not recorded (no usage) are skipped
+func (k Keeper) recordProviderCredit(ctx sdk.Context, sub string, monthBlock uint64, provider string, credit math.Int) {
+	month, found := k.GetMonthUsage(ctx, sub, monthBlock)
+	if !found {
+		return
+	}
+	month.CreditSpent = month.CreditSpent.AddAmount(credit)
+	k.SetMonthUsage(ctx, month)
+	k.addUsageEntry(ctx, month, types.UsageEntry_provider, provider, 0, credit)
+}
+
+func (k Keeper) addUsageEntry(ctx sdk.Context, month types.MonthUsage, entryType types.UsageEntry_Type, name string, cu uint64, credit math.Int) {
+	entry, found := k.GetUsageEntry(ctx, month.Subscription, month.MonthBlock, entryType, name)
+	if !found {
+		entry = types.UsageEntry{
+			Subscription: month.Subscription,
+			MonthBlock:   month.MonthBlock,
+			Type:         entryType,
+			Name:         name,
+			Credit:       sdk.NewCoin(month.CreditSpent.Denom, math.ZeroInt()),
+		}
+	}
+	entry.Cu += cu
+	entry.Credit = entry.Credit.AddAmount(credit)
+	k.SetUsageEntry(ctx, entry)
+}
+
+// pruneUsageHistory removes the oldest months of a subscription beyond UsageHistoryMonths
+func (k Keeper) pruneUsageHistory(ctx sdk.Context, sub string) {
+	store := prefix.NewStore(k.usageMonthStore(ctx), types.UsageSubscriptionPrefix(sub))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	var monthBlocks []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		var month types.MonthUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &month)
+		monthBlocks = append(monthBlocks, month.MonthBlock)
+	}
+	iterator.Close()
+
+	if len(monthBlocks) <= types.UsageHistoryMonths {
+		return
+	}
+	for _, monthBlock := range monthBlocks[:len(monthBlocks)-types.UsageHistoryMonths] {
+		k.removeMonthUsage(ctx, sub, monthBlock)
+	}
+}
+
+func (k Keeper) removeMonthUsage(ctx sdk.Context, sub string, monthBlock uint64) {
+	k.usageMonthStore(ctx).Delete(types.UsageMonthKey(sub, monthBlock))
+
+	store := prefix.NewStore(k.usageEntryStore(ctx), types.UsageMonthKey(sub, monthBlock))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAllMonthUsage returns the usage summaries of all the subscriptions (for genesis)
+func (k Keeper) GetAllMonthUsage(ctx sdk.Context) (list []types.MonthUsage) {
+	store := k.usageMonthStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MonthUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllUsageEntry returns the usage entries of all the subscriptions (for genesis)
+func (k Keeper) GetAllUsageEntry(ctx sdk.Context) (list []types.UsageEntry) {
+	store := k.usageEntryStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.UsageEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// InitUsageHistory imports the usage history (from genesis)
+func (k Keeper) InitUsageHistory(ctx sdk.Context, months []types.MonthUsage, entries []types.UsageEntry) {
+	for _, month := range months {
+		k.SetMonthUsage(ctx, month)
+	}
+	for _, entry := range entries {
+		k.SetUsageEntry(ctx, entry)
+	}
+}
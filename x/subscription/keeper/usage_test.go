@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/utils/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/lavanet/lava/x/subscription/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUsageHistory checks that the subscription's monthly usage history is kept after the
+// CU tracker is reset with the month's provider payment
+func TestUsageHistory(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(1, 0, 0) // 1 sub, 0 adm, 0 dev
+	consumerAcc, consumerAddr := ts.Account("sub1")
+
+	spec1 := ts.AddSpec("spec1", common.CreateMockSpec()).Spec("spec1")
+	spec2 := common.CreateMockSpec()
+	spec2.Index = "spec2"
+	spec2.Name = "spec2"
+	spec2 = ts.AddSpec(spec2.Index, spec2).Spec(spec2.Index)
+
+	testBalance := int64(1000000)
+	testStake := int64(100000)
+	validationAcc, _ := ts.AddAccount(common.VALIDATOR, 0, testBalance)
+	ts.TxCreateValidator(validationAcc, math.NewInt(testBalance))
+	_, provider1 := ts.AddAccount(common.PROVIDER, 0, testBalance)
+	_, provider2 := ts.AddAccount(common.PROVIDER, 1, testBalance)
+	require.NoError(t, ts.StakeProvider(provider1, spec1, testStake))
+	require.NoError(t, ts.StakeProvider(provider2, spec2, testStake))
+
+	_, err := ts.TxSubscriptionBuy(consumerAddr, consumerAddr, "free", 3, false, false)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+	sub := getSubscriptionAndFailTestIfNotFound(t, ts, consumerAddr)
+
+	relayCuSum := uint64(100)
+	sendRelayPayment := func(provider string, spec string, cu uint64) {
+		relaySession := &pairingtypes.RelaySession{
+			Provider:    provider,
+			ContentHash: []byte(spec1.ApiCollections[0].Apis[0].Name),
+			SessionId:   1,
+			SpecId:      spec,
+			CuSum:       cu,
+			Epoch:       int64(ts.EpochStart(ts.BlockHeight())),
+			RelayNum:    1,
+		}
+		sig, err := sigs.Sign(consumerAcc.SK, *relaySession)
+		require.NoError(t, err)
+		relaySession.Sig = sig
+		_, err = ts.TxPairingRelayPayment(provider, relaySession)
+		require.NoError(t, err)
+	}
+	sendRelayPayment(provider1, spec1.Index, relayCuSum)
+	sendRelayPayment(provider2, spec2.Index, 2*relayCuSum)
+
+	// advance month + epoch + blocksToSave + 1 to trigger the provider monthly payment (and reset the CU tracker)
+	ts.AdvanceMonths(1)
+	ts.AdvanceEpoch()
+	ts.AdvanceBlocks(ts.BlocksToSave() + 1)
+
+	history, err := ts.Keepers.Subscription.UsageHistory(ts.GoCtx, &types.QueryUsageHistoryRequest{Subscription: consumerAddr})
+	require.NoError(t, err)
+	require.Len(t, history.Months, 1)
+	month := history.Months[0]
+	require.Equal(t, sub.Block, month.MonthBlock)
+	require.Equal(t, sub.PlanIndex, month.PlanIndex)
+	require.Equal(t, 3*relayCuSum, month.Cu)
+	require.True(t, month.CreditSpent.IsPositive())
+
+	entries := func(entryType types.UsageEntry_Type) map[string]types.UsageEntry {
+		res, err := ts.Keepers.Subscription.MonthUsage(ts.GoCtx, &types.QueryMonthUsageRequest{
+			Subscription: consumerAddr,
+			MonthBlock:   month.MonthBlock,
+			Type:         entryType,
+		})
+		require.NoError(t, err)
+		byName := map[string]types.UsageEntry{}
+		for _, entry := range res.Entries {
+			byName[entry.Name] = entry
+		}
+		return byName
+	}
+
+	projects := entries(types.UsageEntry_project)
+	require.Len(t, projects, 1)
+	for _, project := range projects {
+		require.Equal(t, 3*relayCuSum, project.Cu)
+	}
+	require.Equal(t, 3*relayCuSum, entries(types.UsageEntry_developer_key)[consumerAddr].Cu)
+	chains := entries(types.UsageEntry_chain)
+	require.Equal(t, relayCuSum, chains[spec1.Index].Cu)
+	require.Equal(t, 2*relayCuSum, chains[spec2.Index].Cu)
+	providers := entries(types.UsageEntry_provider)
+	require.Equal(t, relayCuSum, providers[provider1].Cu)
+	require.Equal(t, 2*relayCuSum, providers[provider2].Cu)
+	require.True(t, providers[provider2].Credit.Amount.GT(providers[provider1].Credit.Amount))
+	require.Equal(t, month.CreditSpent, providers[provider1].Credit.Add(providers[provider2].Credit))
+}
+
+// TestUsageHistoryPruning checks that only the latest months of a subscription's usage history are kept
+func TestUsageHistoryPruning(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(1, 0, 0) // 1 sub, 0 adm, 0 dev
+	_, consumerAddr := ts.Account("sub1")
+
+	months := types.UsageHistoryMonths + 2
+	for i := 1; i <= months; i++ {
+		sub := types.Subscription{Consumer: consumerAddr, Block: uint64(i), PlanIndex: "free"}
+		ts.Keepers.Subscription.RecordUsage(ts.Ctx, sub, "project", consumerAddr, "provider", "spec", uint64(i))
+	}
+
+	history, err := ts.Keepers.Subscription.UsageHistory(ts.GoCtx, &types.QueryUsageHistoryRequest{Subscription: consumerAddr})
+	require.NoError(t, err)
+	require.Len(t, history.Months, types.UsageHistoryMonths)
+	require.Equal(t, uint64(3), history.Months[0].MonthBlock)
+	require.Equal(t, uint64(months), history.Months[len(history.Months)-1].MonthBlock)
+
+	_, err = ts.Keepers.Subscription.MonthUsage(ts.GoCtx, &types.QueryMonthUsageRequest{Subscription: consumerAddr, MonthBlock: 1})
+	require.Error(t, err)
+	_, found := ts.Keepers.Subscription.GetUsageEntry(ts.Ctx, consumerAddr, 1, types.UsageEntry_project, "project")
+	require.False(t, found)
+	entry, found := ts.Keepers.Subscription.GetUsageEntry(ts.Ctx, consumerAddr, 3, types.UsageEntry_project, "project")
+	require.True(t, found)
+	require.Equal(t, uint64(3), entry.Cu)
+}
@@ -14,6 +14,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddProject{}, "subscription/AddProject", nil)
 	cdc.RegisterConcrete(&MsgDelProject{}, "subscription/DelProject", nil)
 	cdc.RegisterConcrete(&MsgAutoRenewal{}, "subscription/AutoRenewal", nil)
+	cdc.RegisterConcrete(&MsgFundTrialPool{}, "subscription/FundTrialPool", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -30,6 +31,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgAutoRenewal{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgFundTrialPool{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -12,4 +12,7 @@ var (
 	ErrBlankParameter        = sdkerrors.New(ModuleName, 101, "required parameter is empty")
 	ErrInvalidParameter      = sdkerrors.New(ModuleName, 102, "required parameter is invalid")
 	ErrCuTrackerPayoutFailed = sdkerrors.New(ModuleName, 103, "critical: CU tracker providers reward failed")
+	ErrTrialAlreadyUsed      = sdkerrors.Register(ModuleName, 104, "consumer already used its trial")
+	ErrTrialNotEligible      = sdkerrors.Register(ModuleName, 105, "consumer is not eligible for a trial")
+	ErrTrialBudgetExhausted  = sdkerrors.Register(ModuleName, 106, "trial budget is exhausted")
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commontypes "github.com/lavanet/lava/common/types"
 	fixationstoretypes "github.com/lavanet/lava/x/fixationstore/types"
 	timerstoretypes "github.com/lavanet/lava/x/timerstore/types"
 )
@@ -14,12 +16,16 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		// this line is used by starport scaffolding # genesis/types/default
-		Params:      DefaultParams(),
-		SubsFS:      *fixationstoretypes.DefaultGenesis(),
-		SubsTS:      *timerstoretypes.DefaultGenesis(),
-		CuTrackerFS: *fixationstoretypes.DefaultGenesis(),
-		CuTrackerTS: *timerstoretypes.DefaultGenesis(),
-		Adjustments: []Adjustment{},
+		Params:       DefaultParams(),
+		SubsFS:       *fixationstoretypes.DefaultGenesis(),
+		SubsTS:       *timerstoretypes.DefaultGenesis(),
+		CuTrackerFS:  *fixationstoretypes.DefaultGenesis(),
+		CuTrackerTS:  *timerstoretypes.DefaultGenesis(),
+		Adjustments:  []Adjustment{},
+		UsageMonths:  []MonthUsage{},
+		UsageEntries: []UsageEntry{},
+		TrialRecords: []TrialRecord{},
+		TrialBudget:  TrialBudget{Spent: sdk.NewCoin(commontypes.TokenDenom, sdk.ZeroInt())},
 	}
 }
 
@@ -27,12 +27,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the subscription module's genesis state.
 type GenesisState struct {
-	Params      Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	SubsFS      types.GenesisState  `protobuf:"bytes,2,opt,name=subsFS,proto3" json:"subsFS"`
-	SubsTS      types1.GenesisState `protobuf:"bytes,3,opt,name=subsTS,proto3" json:"subsTS"`
-	CuTrackerFS types.GenesisState  `protobuf:"bytes,4,opt,name=cuTrackerFS,proto3" json:"cuTrackerFS"`
-	CuTrackerTS types1.GenesisState `protobuf:"bytes,5,opt,name=cuTrackerTS,proto3" json:"cuTrackerTS"`
-	Adjustments []Adjustment        `protobuf:"bytes,6,rep,name=adjustments,proto3" json:"adjustments"`
+	Params       Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	SubsFS       types.GenesisState  `protobuf:"bytes,2,opt,name=subsFS,proto3" json:"subsFS"`
+	SubsTS       types1.GenesisState `protobuf:"bytes,3,opt,name=subsTS,proto3" json:"subsTS"`
+	CuTrackerFS  types.GenesisState  `protobuf:"bytes,4,opt,name=cuTrackerFS,proto3" json:"cuTrackerFS"`
+	CuTrackerTS  types1.GenesisState `protobuf:"bytes,5,opt,name=cuTrackerTS,proto3" json:"cuTrackerTS"`
+	Adjustments  []Adjustment        `protobuf:"bytes,6,rep,name=adjustments,proto3" json:"adjustments"`
+	UsageMonths  []MonthUsage        `protobuf:"bytes,7,rep,name=usage_months,json=usageMonths,proto3" json:"usage_months"`
+	UsageEntries []UsageEntry        `protobuf:"bytes,8,rep,name=usage_entries,json=usageEntries,proto3" json:"usage_entries"`
+	TrialRecords []TrialRecord       `protobuf:"bytes,9,rep,name=trial_records,json=trialRecords,proto3" json:"trial_records"`
+	TrialBudget  TrialBudget         `protobuf:"bytes,10,opt,name=trial_budget,json=trialBudget,proto3" json:"trial_budget"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -110,6 +114,34 @@ func (m *GenesisState) GetAdjustments() []Adjustment {
 	return nil
 }
 
+func (m *GenesisState) GetUsageMonths() []MonthUsage {
+	if m != nil {
+		return m.UsageMonths
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUsageEntries() []UsageEntry {
+	if m != nil {
+		return m.UsageEntries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTrialRecords() []TrialRecord {
+	if m != nil {
+		return m.TrialRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTrialBudget() TrialBudget {
+	if m != nil {
+		return m.TrialBudget
+	}
+	return TrialBudget{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lavanet.lava.subscription.GenesisState")
 }
@@ -119,29 +151,36 @@ func init() {
 }
 
 var fileDescriptor_dc6c60f9c112fe52 = []byte{
-	// 344 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x31, 0x4e, 0xf3, 0x30,
-	0x18, 0x86, 0x93, 0xbf, 0xfd, 0x33, 0xa4, 0x4c, 0x11, 0x43, 0xe8, 0x10, 0x0a, 0x08, 0x28, 0x08,
-	0x39, 0x12, 0x1c, 0x00, 0x51, 0xa4, 0x30, 0x21, 0x55, 0x24, 0x13, 0x9b, 0x1b, 0x4c, 0x30, 0x90,
-	0x38, 0xb2, 0x1d, 0x54, 0x6e, 0xc1, 0xb1, 0x3a, 0x30, 0x74, 0x64, 0x42, 0xa8, 0xb9, 0x08, 0xb2,
-	0x9d, 0xb6, 0xb6, 0x50, 0x06, 0x98, 0xec, 0x48, 0xcf, 0xfb, 0xe4, 0xfd, 0x92, 0xcf, 0x3d, 0x7c,
-	0x86, 0x2f, 0xb0, 0x40, 0x3c, 0x14, 0x67, 0xc8, 0xaa, 0x09, 0x4b, 0x29, 0x2e, 0x39, 0x26, 0x45,
-	0x98, 0xa1, 0x02, 0x31, 0xcc, 0x40, 0x49, 0x09, 0x27, 0xde, 0x56, 0x03, 0x02, 0x71, 0x02, 0x1d,
-	0xec, 0x6f, 0x66, 0x24, 0x23, 0x92, 0x0a, 0xc5, 0x4d, 0x05, 0xfa, 0x07, 0xed, 0xe6, 0x12, 0x52,
-	0x98, 0x37, 0xe2, 0xfe, 0x71, 0x3b, 0x07, 0xef, 0x1e, 0x2b, 0xc6, 0x73, 0x54, 0xf0, 0x86, 0x3d,
-	0x32, 0xd8, 0x7b, 0x3c, 0x85, 0x82, 0x63, 0x9c, 0x50, 0xb4, 0x7a, 0x6a, 0xd0, 0x3d, 0x03, 0xe5,
-	0x38, 0x47, 0x54, 0x71, 0xf2, 0xaa, 0xa0, 0xdd, 0xf7, 0x8e, 0xbb, 0x71, 0xa5, 0xc6, 0x8c, 0x39,
-	0xe4, 0xc8, 0x3b, 0x77, 0x1d, 0x55, 0xce, 0xb7, 0x07, 0xf6, 0xb0, 0x77, 0xba, 0x03, 0x5a, 0xc7,
-	0x06, 0x63, 0x09, 0x8e, 0xba, 0xb3, 0xcf, 0x6d, 0xeb, 0xa6, 0x89, 0x79, 0x91, 0xeb, 0x08, 0x28,
-	0x8a, 0xfd, 0x7f, 0x52, 0x30, 0x34, 0x05, 0x46, 0x65, 0xa0, 0xbf, 0x7a, 0xe9, 0x51, 0x69, 0xef,
-	0x52, 0x79, 0x92, 0xd8, 0xef, 0x48, 0xcf, 0xbe, 0xe9, 0x59, 0xcf, 0xd3, 0x2a, 0x49, 0x62, 0x6f,
-	0xec, 0xf6, 0xd2, 0x2a, 0xa1, 0x30, 0x7d, 0x42, 0x34, 0x8a, 0xfd, 0xee, 0x9f, 0x1a, 0xe9, 0x0a,
-	0xef, 0x5a, 0x33, 0x26, 0xb1, 0xff, 0xff, 0xf7, 0xdd, 0xf4, 0xbc, 0xd0, 0xad, 0xff, 0x31, 0xf3,
-	0x9d, 0x41, 0xe7, 0xa7, 0xce, 0xf8, 0xe6, 0x17, 0x2b, 0x7a, 0xa9, 0xd3, 0xf2, 0xa3, 0x68, 0xb6,
-	0x08, 0xec, 0xf9, 0x22, 0xb0, 0xbf, 0x16, 0x81, 0xfd, 0x56, 0x07, 0xd6, 0xbc, 0x0e, 0xac, 0x8f,
-	0x3a, 0xb0, 0x6e, 0x4f, 0x32, 0xcc, 0x1f, 0xaa, 0x09, 0x48, 0x49, 0x1e, 0x1a, 0x8b, 0x31, 0x35,
-	0x37, 0x8e, 0xbf, 0x96, 0x88, 0x4d, 0x1c, 0xb9, 0x1d, 0x67, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xeb, 0x1a, 0x89, 0x93, 0x1d, 0x03, 0x00, 0x00,
+	// 459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x13, 0x36, 0x02, 0x78, 0xdd, 0xc5, 0xe2, 0x60, 0x7a, 0x08, 0x05, 0xb4, 0x31, 0x10,
+	0x4a, 0x24, 0x78, 0x00, 0xc4, 0x10, 0xe1, 0x34, 0x28, 0x4b, 0xb8, 0x70, 0xa9, 0x9c, 0xd4, 0x64,
+	0x86, 0x25, 0x8e, 0xec, 0x2f, 0x68, 0x7b, 0x0b, 0xc4, 0x53, 0xed, 0xb8, 0x23, 0x27, 0x84, 0xda,
+	0x17, 0x41, 0xb6, 0x93, 0x36, 0x01, 0x85, 0xc2, 0x4e, 0xb1, 0x3f, 0xfd, 0xfe, 0xbf, 0xd8, 0xd6,
+	0xf7, 0xa1, 0x87, 0xa7, 0xf4, 0x0b, 0x2d, 0x19, 0x84, 0xfa, 0x1b, 0xaa, 0x3a, 0x55, 0x99, 0xe4,
+	0x15, 0x70, 0x51, 0x86, 0x39, 0x2b, 0x99, 0xe2, 0x2a, 0xa8, 0xa4, 0x00, 0x81, 0xef, 0x34, 0x60,
+	0xa0, 0xbf, 0x41, 0x17, 0x1c, 0xdf, 0xce, 0x45, 0x2e, 0x0c, 0x15, 0xea, 0x95, 0x0d, 0x8c, 0xf7,
+	0x87, 0xcd, 0x15, 0x95, 0xb4, 0x68, 0xc4, 0xe3, 0xc7, 0xc3, 0x1c, 0x9d, 0x7f, 0xaa, 0x15, 0x14,
+	0xac, 0x84, 0x86, 0xdd, 0x1b, 0x66, 0x6b, 0x45, 0x73, 0xb6, 0x19, 0x03, 0xc9, 0xe9, 0x69, 0x83,
+	0x3d, 0xea, 0x61, 0x1f, 0xf9, 0x19, 0xd5, 0x88, 0x02, 0x21, 0xd9, 0x6a, 0xd7, 0xa0, 0x0f, 0x7a,
+	0x28, 0xf0, 0x82, 0x49, 0xcb, 0x99, 0xa5, 0x85, 0xee, 0x7f, 0xf3, 0xd0, 0xe8, 0xb5, 0x7d, 0xb4,
+	0x18, 0x28, 0x30, 0xfc, 0x1c, 0x79, 0xf6, 0xaa, 0xc4, 0x9d, 0xb8, 0x07, 0x3b, 0x4f, 0xef, 0x05,
+	0x83, 0x8f, 0x18, 0x4c, 0x0d, 0x78, 0xb8, 0x7d, 0xf1, 0xe3, 0xae, 0x73, 0xdc, 0xc4, 0x70, 0x84,
+	0x3c, 0x0d, 0x45, 0x31, 0xb9, 0x66, 0x04, 0x07, 0x7d, 0x41, 0xef, 0xc8, 0x41, 0xf7, 0xd7, 0xad,
+	0xc7, 0xa6, 0xf1, 0x4b, 0xeb, 0x49, 0x62, 0xb2, 0x65, 0x3c, 0x7b, 0x7d, 0xcf, 0xfa, 0x3e, 0x83,
+	0x92, 0x24, 0xc6, 0x53, 0xb4, 0x93, 0xd5, 0x89, 0xa4, 0xd9, 0x67, 0x26, 0xa3, 0x98, 0x6c, 0x5f,
+	0xe9, 0x44, 0x5d, 0x05, 0x3e, 0xea, 0x18, 0x93, 0x98, 0x5c, 0xff, 0xff, 0xb3, 0x75, 0xf3, 0x5a,
+	0xb7, 0xee, 0x18, 0x45, 0xbc, 0xc9, 0xd6, 0x9f, 0xba, 0xde, 0x9b, 0xbf, 0x58, 0xd1, 0xad, 0xae,
+	0x93, 0xc7, 0x6f, 0xd0, 0xc8, 0x34, 0xd5, 0xac, 0x10, 0x25, 0x9c, 0x28, 0x72, 0x63, 0xa3, 0xef,
+	0x48, 0x83, 0xef, 0x75, 0xa6, 0xf5, 0x19, 0x81, 0x29, 0x2b, 0x3c, 0x45, 0xbb, 0xd6, 0xc7, 0x4a,
+	0x90, 0x9c, 0x29, 0x72, 0x73, 0xa3, 0xd0, 0xb8, 0x5e, 0x95, 0x20, 0xcf, 0x1b, 0xe1, 0xa8, 0x6e,
+	0x2b, 0x9c, 0x29, 0xfc, 0x0e, 0xed, 0x9a, 0x7e, 0x9e, 0x49, 0x96, 0x09, 0x39, 0x57, 0xe4, 0x96,
+	0x31, 0xee, 0xff, 0xc5, 0x98, 0x68, 0xfe, 0xd8, 0xe0, 0xad, 0x12, 0xd6, 0x25, 0x85, 0xdf, 0x22,
+	0xbb, 0x9f, 0xa5, 0xf5, 0x3c, 0x67, 0x40, 0xd0, 0xc4, 0xfd, 0x17, 0xe3, 0xa1, 0xa1, 0xdb, 0x5b,
+	0x43, 0xa7, 0x14, 0x5d, 0x2c, 0x7c, 0xf7, 0x72, 0xe1, 0xbb, 0x3f, 0x17, 0xbe, 0xfb, 0x75, 0xe9,
+	0x3b, 0x97, 0x4b, 0xdf, 0xf9, 0xbe, 0xf4, 0x9d, 0x0f, 0x4f, 0x72, 0x0e, 0x27, 0x75, 0x1a, 0x64,
+	0xa2, 0x08, 0x7b, 0xe3, 0x75, 0xf6, 0xdb, 0xc8, 0x9e, 0x57, 0x4c, 0xa5, 0x9e, 0x99, 0xb1, 0x67,
+	0xbf, 0x06, 0x00, 0x23, 0xb9, 0x23, 0x24, 0xb1, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -164,6 +203,58 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.TrialBudget.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.TrialRecords) > 0 {
+		for iNdEx := len(m.TrialRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TrialRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.UsageEntries) > 0 {
+		for iNdEx := len(m.UsageEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsageEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.UsageMonths) > 0 {
+		for iNdEx := len(m.UsageMonths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsageMonths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Adjustments) > 0 {
 		for iNdEx := len(m.Adjustments) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -264,6 +355,26 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.UsageMonths) > 0 {
+		for _, e := range m.UsageMonths {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UsageEntries) > 0 {
+		for _, e := range m.UsageEntries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TrialRecords) > 0 {
+		for _, e := range m.TrialRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.TrialBudget.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -501,6 +612,141 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageMonths", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsageMonths = append(m.UsageMonths, MonthUsage{})
+			if err := m.UsageMonths[len(m.UsageMonths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsageEntries = append(m.UsageEntries, UsageEntry{})
+			if err := m.UsageEntries[len(m.UsageEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrialRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrialRecords = append(m.TrialRecords, TrialRecord{})
+			if err := m.TrialRecords[len(m.TrialRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrialBudget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrialBudget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
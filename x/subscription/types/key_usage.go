@@ -0,0 +1,46 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+const (
+	// UsageMonthKeyPrefix is the prefix to retrieve all MonthUsage
+	UsageMonthKeyPrefix = "UsageMonth/value/"
+
+	// UsageEntryKeyPrefix is the prefix to retrieve all UsageEntry
+	UsageEntryKeyPrefix = "UsageEntry/value/"
+
+	// UsageHistoryMonths is the number of months of usage history kept per subscription
+	UsageHistoryMonths = 12
+)
+
+// UsageSubscriptionPrefix is the key prefix of all the usage history of a subscription
+func UsageSubscriptionPrefix(sub string) []byte {
+	return []byte(sub + "/")
+}
+
+// UsageMonthKey encodes the key of a subscription month. the block is big endian so months iterate in order
+func UsageMonthKey(sub string, monthBlock uint64) []byte {
+	return binary.BigEndian.AppendUint64(UsageSubscriptionPrefix(sub), monthBlock)
+}
+
+// UsageEntryTypePrefix is the key prefix of a subscription month's entries of a single type
+func UsageEntryTypePrefix(sub string, monthBlock uint64, entryType UsageEntry_Type) []byte {
+	return append(UsageMonthKey(sub, monthBlock), byte(entryType))
+}
+
+func UsageEntryKey(sub string, monthBlock uint64, entryType UsageEntry_Type, name string) []byte {
+	return append(UsageEntryTypePrefix(sub, monthBlock, entryType), []byte(name)...)
+}
+
+// ParseUsageEntryType parses a usage entry type by its name
+func ParseUsageEntryType(name string) (UsageEntry_Type, error) {
+	entryType, ok := UsageEntry_Type_value[strings.ToLower(name)]
+	if !ok {
+		return 0, fmt.Errorf("invalid usage type %s, expected one of: project, developer_key, chain, provider", name)
+	}
+	return UsageEntry_Type(entryType), nil
+}
@@ -31,6 +31,15 @@ const (
 
 	// prefix for the CU tracker timer store
 	CuTrackerTimerPrefix = "cu-tracker-ts"
+
+	// TrialPoolName is the module account that pays for trial subscriptions
+	TrialPoolName = "trial_pool"
+
+	// TrialRecordKeyPrefix is the prefix to retrieve all TrialRecord
+	TrialRecordKeyPrefix = "TrialRecord/value/"
+
+	// TrialBudgetKey is the key of the trial budget of the current month
+	TrialBudgetKey = "TrialBudget/value/"
 )
 
 // CuTrackerKey encodes a keys using the subscription's consumer address, provider address and the relay's chain ID
@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	commontypes "github.com/lavanet/lava/common/types"
+)
+
+const TypeMsgFundTrialPool = "fund_trial_pool"
+
+var _ sdk.Msg = &MsgFundTrialPool{}
+
+func NewMsgFundTrialPool(creator string, amount sdk.Coin) *MsgFundTrialPool {
+	return &MsgFundTrialPool{
+		Creator: creator,
+		Amount:  amount,
+	}
+}
+
+func (msg *MsgFundTrialPool) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgFundTrialPool) Type() string {
+	return TypeMsgFundTrialPool
+}
+
+func (msg *MsgFundTrialPool) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgFundTrialPool) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgFundTrialPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() || msg.Amount.Denom != commontypes.TokenDenom {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidCoins, "invalid amount (%s)", msg.Amount)
+	}
+
+	return nil
+}
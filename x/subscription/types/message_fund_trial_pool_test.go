@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgFundTrialPool_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgFundTrialPool
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgFundTrialPool{
+				Creator: "invalid_address",
+				Amount:  sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(100)),
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "zero amount",
+			msg: MsgFundTrialPool{
+				Creator: sample.AccAddress(),
+				Amount:  sdk.NewCoin(commontypes.TokenDenom, sdk.ZeroInt()),
+			},
+			err: legacyerrors.ErrInvalidCoins,
+		}, {
+			name: "invalid denom",
+			msg: MsgFundTrialPool{
+				Creator: sample.AccAddress(),
+				Amount:  sdk.NewCoin("token", sdk.NewInt(100)),
+			},
+			err: legacyerrors.ErrInvalidCoins,
+		}, {
+			name: "valid",
+			msg: MsgFundTrialPool{
+				Creator: sample.AccAddress(),
+				Amount:  sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(100)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,34 +1,51 @@
 package types
 
 import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	commontypes "github.com/lavanet/lava/common/types"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyTrialMonthlyBudget              = []byte("TrialMonthlyBudget")
+	DefaultTrialMonthlyBudget sdk.Coin = sdk.NewCoin(commontypes.TokenDenom, sdk.ZeroInt()) // trials are disabled until governance sets a budget
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(trialMonthlyBudget sdk.Coin) Params {
+	return Params{
+		TrialMonthlyBudget: trialMonthlyBudget,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultTrialMonthlyBudget)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyTrialMonthlyBudget, &p.TrialMonthlyBudget, validateCoin),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateCoin(p.TrialMonthlyBudget); err != nil {
+		return fmt.Errorf("invalid TrialMonthlyBudget. Error: %s", err.Error())
+	}
+
 	return nil
 }
 
@@ -37,3 +54,20 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateCoin(v interface{}) error {
+	coin, ok := v.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if coin.Denom != commontypes.TokenDenom {
+		return fmt.Errorf("invalid coin denom %s", coin.Denom)
+	}
+
+	if coin.IsNil() || coin.IsNegative() {
+		return fmt.Errorf("invalid coin amount %s", coin.Amount)
+	}
+
+	return nil
+}
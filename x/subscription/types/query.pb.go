@@ -7,7 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -634,6 +634,350 @@ func (m *QueryNextToMonthExpiryResponse) GetSubscriptions() []TimerExpiryInfo {
 	return nil
 }
 
+type QueryUsageHistoryRequest struct {
+	Subscription string             `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUsageHistoryRequest) Reset()         { *m = QueryUsageHistoryRequest{} }
+func (m *QueryUsageHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUsageHistoryRequest) ProtoMessage()    {}
+func (*QueryUsageHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e870698c9d8ccc09, []int{12}
+}
+func (m *QueryUsageHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUsageHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUsageHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUsageHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUsageHistoryRequest.Merge(m, src)
+}
+func (m *QueryUsageHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUsageHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUsageHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUsageHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryUsageHistoryRequest) GetSubscription() string {
+	if m != nil {
+		return m.Subscription
+	}
+	return ""
+}
+
+func (m *QueryUsageHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryUsageHistoryResponse struct {
+	Months     []MonthUsage        `protobuf:"bytes,1,rep,name=months,proto3" json:"months"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUsageHistoryResponse) Reset()         { *m = QueryUsageHistoryResponse{} }
+func (m *QueryUsageHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUsageHistoryResponse) ProtoMessage()    {}
+func (*QueryUsageHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e870698c9d8ccc09, []int{13}
+}
+func (m *QueryUsageHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUsageHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUsageHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUsageHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUsageHistoryResponse.Merge(m, src)
+}
+func (m *QueryUsageHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUsageHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUsageHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUsageHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryUsageHistoryResponse) GetMonths() []MonthUsage {
+	if m != nil {
+		return m.Months
+	}
+	return nil
+}
+
+func (m *QueryUsageHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryMonthUsageRequest struct {
+	Subscription string             `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	MonthBlock   uint64             `protobuf:"varint,2,opt,name=month_block,json=monthBlock,proto3" json:"month_block,omitempty"`
+	Type         UsageEntry_Type    `protobuf:"varint,3,opt,name=type,proto3,enum=lavanet.lava.subscription.UsageEntry_Type" json:"type,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMonthUsageRequest) Reset()         { *m = QueryMonthUsageRequest{} }
+func (m *QueryMonthUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMonthUsageRequest) ProtoMessage()    {}
+func (*QueryMonthUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e870698c9d8ccc09, []int{14}
+}
+func (m *QueryMonthUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMonthUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMonthUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMonthUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMonthUsageRequest.Merge(m, src)
+}
+func (m *QueryMonthUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMonthUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMonthUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMonthUsageRequest proto.InternalMessageInfo
+
+func (m *QueryMonthUsageRequest) GetSubscription() string {
+	if m != nil {
+		return m.Subscription
+	}
+	return ""
+}
+
+func (m *QueryMonthUsageRequest) GetMonthBlock() uint64 {
+	if m != nil {
+		return m.MonthBlock
+	}
+	return 0
+}
+
+func (m *QueryMonthUsageRequest) GetType() UsageEntry_Type {
+	if m != nil {
+		return m.Type
+	}
+	return UsageEntry_project
+}
+
+func (m *QueryMonthUsageRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryMonthUsageResponse struct {
+	Month      MonthUsage          `protobuf:"bytes,1,opt,name=month,proto3" json:"month"`
+	Entries    []UsageEntry        `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMonthUsageResponse) Reset()         { *m = QueryMonthUsageResponse{} }
+func (m *QueryMonthUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMonthUsageResponse) ProtoMessage()    {}
+func (*QueryMonthUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e870698c9d8ccc09, []int{15}
+}
+func (m *QueryMonthUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMonthUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMonthUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMonthUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMonthUsageResponse.Merge(m, src)
+}
+func (m *QueryMonthUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMonthUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMonthUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMonthUsageResponse proto.InternalMessageInfo
+
+func (m *QueryMonthUsageResponse) GetMonth() MonthUsage {
+	if m != nil {
+		return m.Month
+	}
+	return MonthUsage{}
+}
+
+func (m *QueryMonthUsageResponse) GetEntries() []UsageEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryMonthUsageResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryTrialBudgetRequest struct {
+}
+
+func (m *QueryTrialBudgetRequest) Reset()         { *m = QueryTrialBudgetRequest{} }
+func (m *QueryTrialBudgetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTrialBudgetRequest) ProtoMessage()    {}
+func (*QueryTrialBudgetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e870698c9d8ccc09, []int{16}
+}
+func (m *QueryTrialBudgetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTrialBudgetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTrialBudgetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTrialBudgetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTrialBudgetRequest.Merge(m, src)
+}
+func (m *QueryTrialBudgetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTrialBudgetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTrialBudgetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTrialBudgetRequest proto.InternalMessageInfo
+
+type QueryTrialBudgetResponse struct {
+	MonthlyBudget types.Coin `protobuf:"bytes,1,opt,name=monthly_budget,json=monthlyBudget,proto3" json:"monthly_budget"`
+	Spent         types.Coin `protobuf:"bytes,2,opt,name=spent,proto3" json:"spent"`
+	Remaining     types.Coin `protobuf:"bytes,3,opt,name=remaining,proto3" json:"remaining"`
+	PoolBalance   types.Coin `protobuf:"bytes,4,opt,name=pool_balance,json=poolBalance,proto3" json:"pool_balance"`
+	PeriodEnd     uint64     `protobuf:"varint,5,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+}
+
+func (m *QueryTrialBudgetResponse) Reset()         { *m = QueryTrialBudgetResponse{} }
+func (m *QueryTrialBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTrialBudgetResponse) ProtoMessage()    {}
+func (*QueryTrialBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e870698c9d8ccc09, []int{17}
+}
+func (m *QueryTrialBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTrialBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTrialBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTrialBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTrialBudgetResponse.Merge(m, src)
+}
+func (m *QueryTrialBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTrialBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTrialBudgetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTrialBudgetResponse proto.InternalMessageInfo
+
+func (m *QueryTrialBudgetResponse) GetMonthlyBudget() types.Coin {
+	if m != nil {
+		return m.MonthlyBudget
+	}
+	return types.Coin{}
+}
+
+func (m *QueryTrialBudgetResponse) GetSpent() types.Coin {
+	if m != nil {
+		return m.Spent
+	}
+	return types.Coin{}
+}
+
+func (m *QueryTrialBudgetResponse) GetRemaining() types.Coin {
+	if m != nil {
+		return m.Remaining
+	}
+	return types.Coin{}
+}
+
+func (m *QueryTrialBudgetResponse) GetPoolBalance() types.Coin {
+	if m != nil {
+		return m.PoolBalance
+	}
+	return types.Coin{}
+}
+
+func (m *QueryTrialBudgetResponse) GetPeriodEnd() uint64 {
+	if m != nil {
+		return m.PeriodEnd
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "lavanet.lava.subscription.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.subscription.QueryParamsResponse")
@@ -647,6 +991,12 @@ func init() {
 	proto.RegisterType((*QueryNextToMonthExpiryRequest)(nil), "lavanet.lava.subscription.QueryNextToMonthExpiryRequest")
 	proto.RegisterType((*TimerExpiryInfo)(nil), "lavanet.lava.subscription.TimerExpiryInfo")
 	proto.RegisterType((*QueryNextToMonthExpiryResponse)(nil), "lavanet.lava.subscription.QueryNextToMonthExpiryResponse")
+	proto.RegisterType((*QueryUsageHistoryRequest)(nil), "lavanet.lava.subscription.QueryUsageHistoryRequest")
+	proto.RegisterType((*QueryUsageHistoryResponse)(nil), "lavanet.lava.subscription.QueryUsageHistoryResponse")
+	proto.RegisterType((*QueryMonthUsageRequest)(nil), "lavanet.lava.subscription.QueryMonthUsageRequest")
+	proto.RegisterType((*QueryMonthUsageResponse)(nil), "lavanet.lava.subscription.QueryMonthUsageResponse")
+	proto.RegisterType((*QueryTrialBudgetRequest)(nil), "lavanet.lava.subscription.QueryTrialBudgetRequest")
+	proto.RegisterType((*QueryTrialBudgetResponse)(nil), "lavanet.lava.subscription.QueryTrialBudgetResponse")
 }
 
 func init() {
@@ -654,64 +1004,90 @@ func init() {
 }
 
 var fileDescriptor_e870698c9d8ccc09 = []byte{
-	// 911 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x26, 0xae, 0xe3, 0x3c, 0x3b, 0x69, 0x3b, 0x89, 0xd0, 0xc6, 0x02, 0x27, 0xd9, 0x52,
-	0xd2, 0x96, 0x74, 0x57, 0x4e, 0x40, 0xa1, 0x17, 0x2a, 0x25, 0xa2, 0x12, 0x28, 0xa0, 0xe0, 0x46,
-	0x45, 0xe2, 0xc0, 0x6a, 0xbd, 0x1d, 0x3b, 0x8b, 0xec, 0x9d, 0xed, 0xfc, 0x28, 0x8e, 0xaa, 0x5e,
-	0x38, 0xf6, 0x84, 0xe0, 0x2f, 0xe0, 0xdf, 0xe0, 0xc6, 0xad, 0x27, 0x54, 0x89, 0x0b, 0x27, 0x84,
-	0x12, 0xfe, 0x10, 0x34, 0x6f, 0x67, 0xcd, 0x6e, 0x53, 0xaf, 0x0d, 0x27, 0xef, 0x7c, 0xf3, 0xbd,
-	0xef, 0x7d, 0xf3, 0xe6, 0xcd, 0x93, 0xe1, 0xe6, 0x20, 0x78, 0x1a, 0xc4, 0x54, 0x7a, 0xfa, 0xd7,
-	0x13, 0xaa, 0x2b, 0x42, 0x1e, 0x25, 0x32, 0x62, 0xb1, 0xf7, 0x44, 0x51, 0x7e, 0xe6, 0x26, 0x9c,
-	0x49, 0x46, 0xd6, 0x0d, 0xcd, 0xd5, 0xbf, 0x6e, 0x9e, 0xd6, 0x5c, 0xeb, 0xb3, 0x3e, 0x43, 0x96,
-	0xa7, 0xbf, 0xd2, 0x80, 0xe6, 0xdb, 0x7d, 0xc6, 0xfa, 0x03, 0xea, 0x05, 0x49, 0xe4, 0x05, 0x71,
-	0xcc, 0x64, 0xa0, 0xc9, 0xc2, 0xec, 0xde, 0x09, 0x99, 0x18, 0x32, 0xe1, 0x75, 0x03, 0x41, 0xd3,
-	0x3c, 0xde, 0xd3, 0x76, 0x97, 0xca, 0xa0, 0xed, 0x25, 0x41, 0x3f, 0x8a, 0x91, 0x6c, 0xb8, 0xef,
-	0x4d, 0x76, 0x98, 0x04, 0x3c, 0x18, 0x66, 0x9a, 0xad, 0xbc, 0x66, 0xa6, 0x16, 0xb2, 0x28, 0xd3,
-	0xd9, 0x99, 0xac, 0x93, 0x5f, 0xa4, 0x6c, 0x67, 0x0d, 0xc8, 0x97, 0xda, 0xd7, 0x31, 0xa6, 0xe8,
-	0xd0, 0x27, 0x8a, 0x0a, 0xe9, 0x3c, 0x82, 0xd5, 0x02, 0x2a, 0x12, 0x16, 0x0b, 0x4a, 0xee, 0x43,
-	0x35, 0xb5, 0x62, 0x5b, 0x9b, 0xd6, 0xad, 0xfa, 0xee, 0x96, 0x3b, 0xb1, 0x5c, 0x6e, 0x1a, 0x7a,
-	0x50, 0x79, 0xf9, 0xe7, 0xc6, 0x5c, 0xc7, 0x84, 0x39, 0x6d, 0xa3, 0x7b, 0xa8, 0x38, 0xa7, 0xb1,
-	0x34, 0xe9, 0x48, 0x13, 0x6a, 0x21, 0x8b, 0x85, 0x1a, 0x52, 0x8e, 0xca, 0x4b, 0x9d, 0xf1, 0xda,
-	0xf9, 0x0a, 0xd6, 0x8a, 0x21, 0x63, 0x2f, 0x0b, 0x42, 0x75, 0x8d, 0x91, 0xed, 0x12, 0x23, 0x0f,
-	0x73, 0x0b, 0xb4, 0x63, 0x75, 0x74, 0xa4, 0xf3, 0x31, 0xd8, 0x28, 0x7c, 0x14, 0x09, 0x79, 0xcc,
-	0xd9, 0xb7, 0x34, 0x94, 0xd9, 0xf9, 0x89, 0x03, 0x8d, 0xbc, 0x86, 0x31, 0x55, 0xc0, 0x9c, 0x7d,
-	0x58, 0x7f, 0x43, 0xbc, 0x71, 0xd7, 0x84, 0x5a, 0x62, 0x30, 0xdb, 0xda, 0x5c, 0xd0, 0x27, 0xca,
-	0xd6, 0x0e, 0x81, 0x6b, 0xe3, 0xc0, 0xac, 0xe0, 0x01, 0x5c, 0xcf, 0x61, 0x46, 0xe4, 0x08, 0x96,
-	0x74, 0x46, 0x3f, 0x8a, 0x7b, 0x0c, 0x55, 0xea, 0xbb, 0xb7, 0x4b, 0x0e, 0xaa, 0x63, 0x3f, 0x8d,
-	0x7b, 0xec, 0xa1, 0xe4, 0x2a, 0x94, 0xa6, 0xf2, 0x35, 0x4d, 0xd1, 0xa8, 0xf3, 0xa2, 0x02, 0x2b,
-	0x45, 0x4a, 0x59, 0xdd, 0x09, 0x81, 0x4a, 0x32, 0x08, 0x62, 0x7b, 0x1e, 0x71, 0xfc, 0x26, 0xdb,
-	0x70, 0xf5, 0xb1, 0xe2, 0xd8, 0xb4, 0x7e, 0x97, 0xa9, 0xfe, 0xa9, 0xb4, 0x17, 0x36, 0xad, 0x5b,
-	0x95, 0xce, 0x4a, 0x06, 0x1f, 0x20, 0x4a, 0x6e, 0xc0, 0xf2, 0x98, 0x38, 0xa0, 0x3d, 0x69, 0x57,
-	0x90, 0xd6, 0xc8, 0xc0, 0x23, 0xda, 0x93, 0x64, 0x0b, 0x1a, 0x43, 0x16, 0xcb, 0x53, 0x9f, 0x8e,
-	0x92, 0x88, 0x9f, 0xd9, 0x57, 0x90, 0x53, 0x47, 0xec, 0x13, 0x84, 0xc8, 0xbb, 0xb0, 0x92, 0x52,
-	0x42, 0xe5, 0x4b, 0x26, 0x83, 0x81, 0x5d, 0x4d, 0x85, 0x10, 0x3d, 0x54, 0x27, 0x1a, 0x23, 0x0e,
-	0x2c, 0x8f, 0x59, 0x98, 0x6d, 0x31, 0xa7, 0x74, 0xa8, 0x30, 0x99, 0x0d, 0x8b, 0xe1, 0x40, 0x09,
-	0x49, 0xb9, 0x5d, 0xc3, 0x13, 0x65, 0x4b, 0x72, 0x13, 0xc6, 0xee, 0x4d, 0x8e, 0x25, 0x0c, 0x1f,
-	0x9f, 0x20, 0x4d, 0xb2, 0x07, 0x6f, 0x05, 0x4a, 0x32, 0x9f, 0xd3, 0x98, 0x7e, 0x17, 0x0c, 0xfc,
-	0x98, 0x8e, 0xa4, 0x8f, 0x15, 0xaa, 0xa3, 0xde, 0xaa, 0xde, 0xed, 0xa4, 0x9b, 0x5f, 0xd0, 0x91,
-	0x3c, 0xd6, 0x05, 0xfb, 0x06, 0x56, 0x7b, 0x4a, 0x2a, 0x4e, 0xfd, 0x42, 0x3b, 0x35, 0xb0, 0x69,
-	0xef, 0x96, 0xdc, 0xe5, 0x03, 0x8c, 0xca, 0xb7, 0x6e, 0x87, 0xf4, 0x2e, 0x61, 0xa4, 0x0d, 0xd5,
-	0x90, 0xd3, 0xc7, 0x91, 0xb4, 0x97, 0x51, 0x72, 0xdd, 0x4d, 0x87, 0x83, 0xab, 0x87, 0x83, 0x6b,
-	0x86, 0x83, 0x7b, 0xc8, 0xa2, 0xb8, 0x63, 0x88, 0x9f, 0x55, 0x6a, 0x70, 0xad, 0xee, 0x6c, 0xc0,
-	0x3b, 0xd8, 0x6f, 0xda, 0xe9, 0x09, 0xfb, 0xfc, 0xdf, 0x92, 0x67, 0x0d, 0x79, 0x0c, 0x57, 0x4f,
-	0xa2, 0x21, 0xe5, 0x29, 0xaa, 0x7b, 0xa6, 0xb4, 0x5b, 0x5e, 0xbf, 0xcb, 0xf9, 0x4b, 0x77, 0xe9,
-	0x8c, 0xa0, 0x35, 0x29, 0xa5, 0xe9, 0xf7, 0x47, 0xb0, 0x9c, 0x2f, 0x82, 0x30, 0x3d, 0x7f, 0xa7,
-	0xa4, 0x4e, 0xaf, 0x79, 0x34, 0x4d, 0x5f, 0x94, 0xd9, 0xfd, 0xad, 0x0a, 0x57, 0x30, 0x35, 0xf9,
-	0xd1, 0x82, 0x6a, 0x3a, 0x98, 0x48, 0x59, 0xf5, 0x2f, 0x4f, 0xc4, 0xa6, 0x3b, 0x2b, 0x3d, 0x3d,
-	0x8b, 0x73, 0xfb, 0xfb, 0xdf, 0xff, 0xfe, 0x69, 0xfe, 0x06, 0xd9, 0xf2, 0xa6, 0x8d, 0x75, 0xf2,
-	0xb3, 0x05, 0x8b, 0x66, 0xba, 0x91, 0xa9, 0x69, 0x8a, 0x93, 0xb3, 0xe9, 0xcd, 0xcc, 0x37, 0xbe,
-	0x3e, 0x44, 0x5f, 0x1e, 0xb9, 0x5b, 0xe2, 0x2b, 0x4c, 0x63, 0xbc, 0x67, 0xd9, 0xf5, 0x3e, 0x27,
-	0xbf, 0x58, 0xd0, 0xc8, 0x0f, 0x3a, 0xb2, 0x37, 0x2d, 0xf1, 0x1b, 0xc6, 0x6a, 0xf3, 0x83, 0xff,
-	0x16, 0x64, 0x2c, 0xdf, 0x47, 0xcb, 0xf7, 0xc8, 0x7e, 0x89, 0xe5, 0x41, 0x24, 0xa4, 0x9f, 0x4d,
-	0x58, 0xef, 0x59, 0x7e, 0xef, 0x39, 0x79, 0x61, 0x41, 0x45, 0x2b, 0x93, 0xf7, 0x67, 0xc9, 0x9f,
-	0x99, 0xdd, 0x99, 0x8d, 0x6c, 0x4c, 0x6e, 0xa3, 0xc9, 0x2d, 0xb2, 0x31, 0xc5, 0x24, 0xf9, 0xd5,
-	0x82, 0xeb, 0x97, 0x9e, 0x00, 0xf9, 0x68, 0x5a, 0xb2, 0x49, 0x0f, 0xb5, 0x79, 0xef, 0x7f, 0x44,
-	0x1a, 0xcf, 0xfb, 0xe8, 0xb9, 0x4d, 0xbc, 0x12, 0xcf, 0x38, 0xe6, 0x24, 0xf3, 0xf3, 0xaf, 0xfb,
-	0xe0, 0xc1, 0xcb, 0xf3, 0x96, 0xf5, 0xea, 0xbc, 0x65, 0xfd, 0x75, 0xde, 0xb2, 0x7e, 0xb8, 0x68,
-	0xcd, 0xbd, 0xba, 0x68, 0xcd, 0xfd, 0x71, 0xd1, 0x9a, 0xfb, 0x7a, 0xa7, 0x1f, 0xc9, 0x53, 0xd5,
-	0x75, 0x43, 0x36, 0x2c, 0x8a, 0x8e, 0x8a, 0xb2, 0xf2, 0x2c, 0xa1, 0xa2, 0x5b, 0xc5, 0xff, 0x20,
-	0x7b, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x07, 0x82, 0xce, 0xbb, 0x9d, 0x09, 0x00, 0x00,
+	// 1316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcf, 0x73, 0xdb, 0xd4,
+	0x13, 0x8f, 0x12, 0xe7, 0xd7, 0x3a, 0x49, 0xdb, 0xd7, 0x4e, 0xbf, 0x8a, 0xe7, 0x5b, 0xa7, 0x51,
+	0x69, 0xd3, 0x96, 0x56, 0x1a, 0x27, 0xed, 0x94, 0x1e, 0x68, 0x07, 0x67, 0x12, 0xa0, 0x13, 0x98,
+	0xe0, 0x86, 0x32, 0xc3, 0x01, 0x8d, 0x2c, 0x3f, 0x3b, 0x02, 0x59, 0x4f, 0x95, 0x9e, 0x4a, 0x3c,
+	0x99, 0x5c, 0x38, 0x70, 0xe8, 0x89, 0x81, 0xbf, 0x80, 0x13, 0xc3, 0xbf, 0x00, 0x27, 0x4e, 0xf4,
+	0xd8, 0x19, 0x2e, 0x9c, 0x18, 0xa6, 0x85, 0x1b, 0xff, 0x03, 0x8c, 0xf6, 0x3d, 0xd9, 0xb2, 0x9d,
+	0x48, 0x76, 0x4e, 0xb6, 0xf6, 0xed, 0x67, 0xf7, 0xb3, 0x3f, 0xf4, 0x76, 0x05, 0x57, 0x5d, 0xeb,
+	0x99, 0xe5, 0x51, 0x6e, 0xc4, 0xbf, 0x46, 0x18, 0xd5, 0x43, 0x3b, 0x70, 0x7c, 0xee, 0x30, 0xcf,
+	0x78, 0x1a, 0xd1, 0xa0, 0xa3, 0xfb, 0x01, 0xe3, 0x8c, 0x2c, 0x4b, 0x35, 0x3d, 0xfe, 0xd5, 0xd3,
+	0x6a, 0xa5, 0x0b, 0x2d, 0xd6, 0x62, 0xa8, 0x65, 0xc4, 0xff, 0x04, 0xa0, 0xf4, 0xff, 0x16, 0x63,
+	0x2d, 0x97, 0x1a, 0x96, 0xef, 0x18, 0x96, 0xe7, 0x31, 0x6e, 0xc5, 0xca, 0xa1, 0x3c, 0xbd, 0x69,
+	0xb3, 0xb0, 0xcd, 0x42, 0xa3, 0x6e, 0x85, 0x54, 0xf8, 0x31, 0x9e, 0x55, 0xea, 0x94, 0x5b, 0x15,
+	0xc3, 0xb7, 0x5a, 0x8e, 0x87, 0xca, 0x52, 0xf7, 0xda, 0xc9, 0x0c, 0x7d, 0x2b, 0xb0, 0xda, 0x89,
+	0xcd, 0x72, 0xda, 0x66, 0x62, 0xcd, 0x66, 0x4e, 0x62, 0xe7, 0xd6, 0xc9, 0x76, 0xd2, 0x0f, 0x52,
+	0x3b, 0x23, 0x2f, 0x51, 0x68, 0xb5, 0x68, 0xbe, 0x1a, 0x0f, 0x1c, 0xcb, 0x15, 0x6a, 0xda, 0x05,
+	0x20, 0x1f, 0xc5, 0x51, 0xee, 0x22, 0xe1, 0x1a, 0x7d, 0x1a, 0xd1, 0x90, 0x6b, 0x4f, 0xe0, 0x7c,
+	0x9f, 0x34, 0xf4, 0x99, 0x17, 0x52, 0xf2, 0x10, 0x66, 0x44, 0x60, 0xaa, 0x72, 0x59, 0xb9, 0x5e,
+	0x5c, 0x5f, 0xd5, 0x4f, 0x4c, 0xbe, 0x2e, 0xa0, 0xd5, 0xc2, 0x8b, 0x3f, 0x56, 0x26, 0x6a, 0x12,
+	0xa6, 0x55, 0xa4, 0xdd, 0xcd, 0x28, 0x08, 0xa8, 0xc7, 0xa5, 0x3b, 0x52, 0x82, 0x39, 0x9b, 0x79,
+	0x61, 0xd4, 0xa6, 0x01, 0x5a, 0x9e, 0xaf, 0x75, 0x9f, 0xb5, 0x4f, 0xe0, 0x42, 0x3f, 0xa4, 0xcb,
+	0x65, 0x2a, 0x8c, 0xea, 0x92, 0xc8, 0x5a, 0x06, 0x91, 0xc7, 0xa9, 0x07, 0xa4, 0xa3, 0xd4, 0x62,
+	0xa4, 0xf6, 0x00, 0x54, 0x34, 0xbc, 0xe3, 0x84, 0x7c, 0x37, 0x60, 0x9f, 0x53, 0x9b, 0x27, 0xf1,
+	0x13, 0x0d, 0x16, 0xd2, 0x36, 0x24, 0xa9, 0x3e, 0x99, 0x76, 0x0f, 0x96, 0x8f, 0xc1, 0x4b, 0x76,
+	0x25, 0x98, 0xf3, 0xa5, 0x4c, 0x55, 0x2e, 0x4f, 0xc5, 0x11, 0x25, 0xcf, 0x1a, 0x81, 0xb3, 0x5d,
+	0x60, 0x92, 0x70, 0x0b, 0xce, 0xa5, 0x64, 0xd2, 0xc8, 0x0e, 0xcc, 0xc7, 0x1e, 0x4d, 0xc7, 0x6b,
+	0x32, 0xb4, 0x52, 0x5c, 0xbf, 0x91, 0x11, 0x68, 0x8c, 0x7d, 0xdf, 0x6b, 0xb2, 0xc7, 0x3c, 0x88,
+	0x6c, 0x2e, 0x33, 0x3f, 0x17, 0xab, 0xc4, 0x52, 0xed, 0x79, 0x01, 0x96, 0xfa, 0x55, 0xb2, 0xf2,
+	0x4e, 0x08, 0x14, 0x7c, 0xd7, 0xf2, 0xd4, 0x49, 0x94, 0xe3, 0x7f, 0xb2, 0x06, 0x67, 0x1a, 0x51,
+	0x80, 0xaf, 0x80, 0x59, 0x67, 0x51, 0x6b, 0x9f, 0xab, 0x53, 0x97, 0x95, 0xeb, 0x85, 0xda, 0x52,
+	0x22, 0xae, 0xa2, 0x94, 0x5c, 0x81, 0xc5, 0xae, 0xa2, 0x4b, 0x9b, 0x5c, 0x2d, 0xa0, 0xda, 0x42,
+	0x22, 0xdc, 0xa1, 0x4d, 0x4e, 0x56, 0x61, 0xa1, 0xcd, 0x3c, 0xbe, 0x6f, 0xd2, 0x03, 0xdf, 0x09,
+	0x3a, 0xea, 0x34, 0xea, 0x14, 0x51, 0xb6, 0x85, 0x22, 0xf2, 0x06, 0x2c, 0x09, 0x15, 0x3b, 0x32,
+	0x39, 0xe3, 0x96, 0xab, 0xce, 0x08, 0x43, 0x28, 0xdd, 0x8c, 0xf6, 0x62, 0x19, 0xd1, 0x60, 0xb1,
+	0xab, 0x85, 0xde, 0x66, 0x53, 0x96, 0x36, 0x23, 0x74, 0xa6, 0xc2, 0xac, 0xed, 0x46, 0x21, 0xa7,
+	0x81, 0x3a, 0x87, 0x11, 0x25, 0x8f, 0xe4, 0x2a, 0x74, 0xd9, 0x4b, 0x1f, 0xf3, 0x08, 0xef, 0x46,
+	0x20, 0x9c, 0x6c, 0xc0, 0x45, 0x2b, 0xe2, 0xcc, 0x0c, 0xa8, 0x47, 0xbf, 0xb4, 0x5c, 0xd3, 0xa3,
+	0x07, 0xdc, 0xc4, 0x0c, 0x15, 0xd1, 0xde, 0xf9, 0xf8, 0xb4, 0x26, 0x0e, 0x3f, 0xa4, 0x07, 0x7c,
+	0x37, 0x4e, 0xd8, 0x67, 0x70, 0xbe, 0x19, 0xf1, 0x28, 0xa0, 0x66, 0x5f, 0x3b, 0x2d, 0x60, 0xd3,
+	0xde, 0xce, 0xa8, 0xe5, 0x36, 0xa2, 0xd2, 0xad, 0x5b, 0x23, 0xcd, 0x21, 0x19, 0xa9, 0xc0, 0x8c,
+	0x1d, 0xd0, 0x86, 0xc3, 0xd5, 0x45, 0x34, 0xb9, 0xac, 0x8b, 0xab, 0x46, 0x8f, 0xaf, 0x1a, 0x5d,
+	0x5e, 0x35, 0xfa, 0x26, 0x73, 0xbc, 0x9a, 0x54, 0x7c, 0x54, 0x98, 0x83, 0xb3, 0x45, 0x6d, 0x05,
+	0x2e, 0x61, 0xbf, 0xc5, 0x4c, 0xf7, 0xd8, 0x07, 0xbd, 0x94, 0x27, 0x0d, 0xb9, 0x0b, 0x67, 0xf6,
+	0x9c, 0x36, 0x0d, 0x84, 0x34, 0xee, 0x99, 0xcc, 0x6e, 0x19, 0xac, 0xe5, 0xe4, 0x50, 0x2d, 0xb5,
+	0x03, 0x28, 0x9f, 0xe4, 0x52, 0xf6, 0xfb, 0x13, 0x58, 0x4c, 0x27, 0x21, 0x94, 0x3d, 0x7f, 0x33,
+	0x23, 0x4f, 0x03, 0x1c, 0x65, 0xd3, 0xf7, 0x9b, 0xd1, 0xbe, 0x56, 0xe4, 0xab, 0xfe, 0x71, 0x7c,
+	0x3f, 0xbe, 0xe7, 0x84, 0x9c, 0x05, 0x9d, 0x31, 0x5e, 0x75, 0xb2, 0x0d, 0xd0, 0xbb, 0xfc, 0x31,
+	0xb6, 0xe2, 0xfa, 0xb5, 0xbe, 0x54, 0x8b, 0x89, 0x94, 0x24, 0x7c, 0xd7, 0x6a, 0x51, 0x69, 0xbf,
+	0x96, 0x42, 0x6a, 0x3f, 0x2a, 0xb0, 0x7c, 0x0c, 0x11, 0x19, 0xfe, 0x26, 0xcc, 0x60, 0xbe, 0x92,
+	0xb8, 0xaf, 0x66, 0xc4, 0x8d, 0xe9, 0x43, 0x2b, 0xc9, 0x0d, 0x2b, 0xa0, 0xe4, 0xdd, 0x63, 0xa8,
+	0xae, 0xe5, 0x52, 0x15, 0x0c, 0xfa, 0xb8, 0xfe, 0xad, 0xc0, 0x45, 0xe4, 0xda, 0x73, 0x35, 0x4e,
+	0xca, 0x56, 0x40, 0x14, 0xdf, 0xac, 0xbb, 0xcc, 0xfe, 0x42, 0xf6, 0x03, 0xa0, 0xa8, 0x1a, 0x4b,
+	0xc8, 0x03, 0x28, 0xf0, 0x8e, 0x4f, 0xf1, 0x02, 0x59, 0xca, 0xac, 0x31, 0xfa, 0xde, 0xf2, 0x78,
+	0xd0, 0xd1, 0xf7, 0x3a, 0x3e, 0xad, 0x21, 0x6e, 0xa0, 0x26, 0x85, 0x53, 0xd7, 0xe4, 0x1f, 0x05,
+	0xfe, 0x37, 0x14, 0xa7, 0xac, 0xc8, 0x3b, 0x30, 0x8d, 0x8c, 0xe5, 0x94, 0x19, 0xab, 0x20, 0x02,
+	0x49, 0xb6, 0x60, 0x96, 0x7a, 0x3c, 0x70, 0x68, 0xa8, 0x4e, 0xe6, 0x56, 0xb5, 0x17, 0xa9, 0x34,
+	0x92, 0x60, 0x07, 0xca, 0x3a, 0x75, 0xfa, 0xb2, 0x2e, 0xcb, 0x68, 0xf7, 0xe2, 0x1d, 0xa0, 0x1a,
+	0x35, 0x5a, 0xb4, 0x3b, 0x83, 0x7e, 0x9e, 0x04, 0x75, 0xf8, 0x4c, 0xa6, 0x62, 0x5b, 0xde, 0xc4,
+	0x6e, 0xc7, 0xac, 0xe3, 0x89, 0xaa, 0xe4, 0xdc, 0x38, 0xc9, 0xbb, 0x28, 0x61, 0xc2, 0x1e, 0xb9,
+	0x0b, 0xd3, 0xa1, 0x4f, 0x3d, 0xae, 0x4e, 0x8e, 0x06, 0x17, 0xda, 0xe4, 0x6d, 0x98, 0x0f, 0x68,
+	0xdb, 0x72, 0x3c, 0xc7, 0x6b, 0xa9, 0x53, 0xa3, 0x41, 0x7b, 0x08, 0x52, 0x85, 0x05, 0x9f, 0x31,
+	0xd7, 0xac, 0x5b, 0xae, 0xe5, 0xd9, 0x54, 0x2d, 0x8c, 0x66, 0xa1, 0x18, 0x83, 0xaa, 0x02, 0x43,
+	0x2e, 0x01, 0xf8, 0x34, 0x70, 0x58, 0xc3, 0xa4, 0x5e, 0x43, 0x0e, 0xab, 0x79, 0x21, 0xd9, 0xf2,
+	0x1a, 0xeb, 0xff, 0x02, 0x4c, 0x63, 0xf6, 0xc8, 0xb7, 0x0a, 0xcc, 0x88, 0xed, 0x87, 0x64, 0x5d,
+	0xf1, 0xc3, 0x6b, 0x57, 0x49, 0x1f, 0x55, 0x5d, 0x14, 0x45, 0xbb, 0xf1, 0xd5, 0x6f, 0x7f, 0x7d,
+	0x37, 0x79, 0x85, 0xac, 0x1a, 0x79, 0x9b, 0x28, 0xf9, 0x5e, 0x81, 0x59, 0xb9, 0x42, 0x91, 0x5c,
+	0x37, 0xfd, 0xeb, 0x59, 0xc9, 0x18, 0x59, 0x5f, 0xf2, 0xba, 0x8b, 0xbc, 0x0c, 0x72, 0x3b, 0x83,
+	0x97, 0x2d, 0x30, 0xc6, 0x61, 0x32, 0x43, 0x8e, 0xc8, 0x4f, 0x0a, 0x2c, 0xa4, 0xb7, 0x29, 0xb2,
+	0x91, 0xe7, 0xf8, 0x98, 0xdd, 0xad, 0x74, 0x67, 0x3c, 0x90, 0xa4, 0xfc, 0x10, 0x29, 0xdf, 0x27,
+	0xf7, 0x32, 0x28, 0xbb, 0x4e, 0xc8, 0xcd, 0x64, 0x8d, 0x33, 0x0e, 0xd3, 0x67, 0x47, 0xe4, 0xb9,
+	0x02, 0x85, 0xd8, 0x32, 0x79, 0x73, 0x14, 0xff, 0x09, 0xd9, 0x5b, 0xa3, 0x29, 0x4b, 0x92, 0x6b,
+	0x48, 0x72, 0x95, 0xac, 0xe4, 0x90, 0x24, 0xbf, 0x28, 0x70, 0x6e, 0x68, 0xce, 0x92, 0xb7, 0xf2,
+	0x9c, 0x9d, 0xb4, 0x0d, 0x94, 0xee, 0x9f, 0x02, 0x29, 0x39, 0xdf, 0x43, 0xce, 0x15, 0x62, 0x64,
+	0x70, 0xc6, 0x5d, 0x8a, 0x33, 0x33, 0xbd, 0x42, 0x60, 0x37, 0xa4, 0xe7, 0x64, 0x7e, 0x37, 0x1c,
+	0x33, 0xde, 0x4b, 0x77, 0xc6, 0x03, 0x8d, 0xd1, 0x0d, 0xf8, 0xb1, 0x65, 0xee, 0x0b, 0xe4, 0x60,
+	0x37, 0xfc, 0xaa, 0x00, 0xf4, 0x46, 0x02, 0xa9, 0xe4, 0xb1, 0x18, 0x1a, 0xb2, 0xa5, 0xf5, 0x71,
+	0x20, 0x92, 0x76, 0x0d, 0x69, 0xef, 0x90, 0x47, 0x19, 0xb4, 0x45, 0x8e, 0x91, 0xfc, 0x00, 0x69,
+	0xe3, 0x30, 0x35, 0xb1, 0x8f, 0x8c, 0xc3, 0x78, 0xcc, 0x1e, 0x91, 0x1f, 0x14, 0x28, 0xa6, 0x06,
+	0x02, 0xc9, 0xe5, 0x35, 0x3c, 0x59, 0x4a, 0x1b, 0x63, 0x61, 0x64, 0x30, 0x06, 0x06, 0x73, 0x83,
+	0xac, 0x19, 0x39, 0x5f, 0xb2, 0x72, 0x20, 0x55, 0xb7, 0x5f, 0xbc, 0x2a, 0x2b, 0x2f, 0x5f, 0x95,
+	0x95, 0x3f, 0x5f, 0x95, 0x95, 0x6f, 0x5e, 0x97, 0x27, 0x5e, 0xbe, 0x2e, 0x4f, 0xfc, 0xfe, 0xba,
+	0x3c, 0xf1, 0xe9, 0xad, 0x96, 0xc3, 0xf7, 0xa3, 0xba, 0x6e, 0xb3, 0x76, 0xbf, 0xb1, 0x83, 0x01,
+	0x73, 0x1d, 0x9f, 0x86, 0xf5, 0x19, 0xfc, 0x32, 0xde, 0xf8, 0x6f, 0x00, 0xae, 0xe7, 0x7e, 0x95,
+	0x81, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -736,6 +1112,12 @@ type QueryClient interface {
 	List(ctx context.Context, in *QueryListRequest, opts ...grpc.CallOption) (*QueryListResponse, error)
 	// Queries the subscription with the closest month expiry
 	NextToMonthExpiry(ctx context.Context, in *QueryNextToMonthExpiryRequest, opts ...grpc.CallOption) (*QueryNextToMonthExpiryResponse, error)
+	// Queries the subscription's monthly usage history
+	UsageHistory(ctx context.Context, in *QueryUsageHistoryRequest, opts ...grpc.CallOption) (*QueryUsageHistoryResponse, error)
+	// Queries a month's usage of a subscription per project, developer key, chain or provider
+	MonthUsage(ctx context.Context, in *QueryMonthUsageRequest, opts ...grpc.CallOption) (*QueryMonthUsageResponse, error)
+	// Queries the remaining trial budget of the current month
+	TrialBudget(ctx context.Context, in *QueryTrialBudgetRequest, opts ...grpc.CallOption) (*QueryTrialBudgetResponse, error)
 }
 
 type queryClient struct {
@@ -791,6 +1173,33 @@ func (c *queryClient) NextToMonthExpiry(ctx context.Context, in *QueryNextToMont
 	return out, nil
 }
 
+func (c *queryClient) UsageHistory(ctx context.Context, in *QueryUsageHistoryRequest, opts ...grpc.CallOption) (*QueryUsageHistoryResponse, error) {
+	out := new(QueryUsageHistoryResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.subscription.Query/UsageHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MonthUsage(ctx context.Context, in *QueryMonthUsageRequest, opts ...grpc.CallOption) (*QueryMonthUsageResponse, error) {
+	out := new(QueryMonthUsageResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.subscription.Query/MonthUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TrialBudget(ctx context.Context, in *QueryTrialBudgetRequest, opts ...grpc.CallOption) (*QueryTrialBudgetResponse, error) {
+	out := new(QueryTrialBudgetResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.subscription.Query/TrialBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -803,6 +1212,12 @@ type QueryServer interface {
 	List(context.Context, *QueryListRequest) (*QueryListResponse, error)
 	// Queries the subscription with the closest month expiry
 	NextToMonthExpiry(context.Context, *QueryNextToMonthExpiryRequest) (*QueryNextToMonthExpiryResponse, error)
+	// Queries the subscription's monthly usage history
+	UsageHistory(context.Context, *QueryUsageHistoryRequest) (*QueryUsageHistoryResponse, error)
+	// Queries a month's usage of a subscription per project, developer key, chain or provider
+	MonthUsage(context.Context, *QueryMonthUsageRequest) (*QueryMonthUsageResponse, error)
+	// Queries the remaining trial budget of the current month
+	TrialBudget(context.Context, *QueryTrialBudgetRequest) (*QueryTrialBudgetResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -824,6 +1239,15 @@ func (*UnimplementedQueryServer) List(ctx context.Context, req *QueryListRequest
 func (*UnimplementedQueryServer) NextToMonthExpiry(ctx context.Context, req *QueryNextToMonthExpiryRequest) (*QueryNextToMonthExpiryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NextToMonthExpiry not implemented")
 }
+func (*UnimplementedQueryServer) UsageHistory(ctx context.Context, req *QueryUsageHistoryRequest) (*QueryUsageHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UsageHistory not implemented")
+}
+func (*UnimplementedQueryServer) MonthUsage(ctx context.Context, req *QueryMonthUsageRequest) (*QueryMonthUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MonthUsage not implemented")
+}
+func (*UnimplementedQueryServer) TrialBudget(ctx context.Context, req *QueryTrialBudgetRequest) (*QueryTrialBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrialBudget not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -919,39 +1343,105 @@ func _Query_NextToMonthExpiry_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lavanet.lava.subscription.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Current",
-			Handler:    _Query_Current_Handler,
-		},
-		{
-			MethodName: "ListProjects",
-			Handler:    _Query_ListProjects_Handler,
-		},
-		{
-			MethodName: "List",
-			Handler:    _Query_List_Handler,
-		},
-		{
-			MethodName: "NextToMonthExpiry",
-			Handler:    _Query_NextToMonthExpiry_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lavanet/lava/subscription/query.proto",
-}
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_UsageHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUsageHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UsageHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.subscription.Query/UsageHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UsageHistory(ctx, req.(*QueryUsageHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MonthUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMonthUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MonthUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.subscription.Query/MonthUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MonthUsage(ctx, req.(*QueryMonthUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TrialBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTrialBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TrialBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.subscription.Query/TrialBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TrialBudget(ctx, req.(*QueryTrialBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lavanet.lava.subscription.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Current",
+			Handler:    _Query_Current_Handler,
+		},
+		{
+			MethodName: "ListProjects",
+			Handler:    _Query_ListProjects_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Query_List_Handler,
+		},
+		{
+			MethodName: "NextToMonthExpiry",
+			Handler:    _Query_NextToMonthExpiry_Handler,
+		},
+		{
+			MethodName: "UsageHistory",
+			Handler:    _Query_UsageHistory_Handler,
+		},
+		{
+			MethodName: "MonthUsage",
+			Handler:    _Query_MonthUsage_Handler,
+		},
+		{
+			MethodName: "TrialBudget",
+			Handler:    _Query_TrialBudget_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lavanet/lava/subscription/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -1391,176 +1881,331 @@ func (m *QueryNextToMonthExpiryResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryUsageHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryUsageHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCurrentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryUsageHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Consumer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Subscription) > 0 {
+		i -= len(m.Subscription)
+		copy(dAtA[i:], m.Subscription)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subscription)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCurrentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sub != nil {
-		l = m.Sub.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryUsageHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryListProjectsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Subscription)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryUsageHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListProjectsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryUsageHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Projects) > 0 {
-		for _, s := range m.Projects {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Months) > 0 {
+		for iNdEx := len(m.Months) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Months[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMonthUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryListResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SubsInfo) > 0 {
-		for _, e := range m.SubsInfo {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryMonthUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListInfoStruct) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMonthUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Consumer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Plan)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.DurationBought != 0 {
-		n += 1 + sovQuery(uint64(m.DurationBought))
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.DurationLeft != 0 {
-		n += 1 + sovQuery(uint64(m.DurationLeft))
+	if m.MonthBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MonthBlock))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.MonthExpiry != 0 {
-		n += 1 + sovQuery(uint64(m.MonthExpiry))
+	if len(m.Subscription) > 0 {
+		i -= len(m.Subscription)
+		copy(dAtA[i:], m.Subscription)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subscription)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.MonthCuTotal != 0 {
-		n += 1 + sovQuery(uint64(m.MonthCuTotal))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMonthUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.MonthCuLeft != 0 {
-		n += 1 + sovQuery(uint64(m.MonthCuLeft))
+	return dAtA[:n], nil
+}
+
+func (m *QueryMonthUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMonthUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Cluster)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.DurationTotal != 0 {
-		n += 1 + sovQuery(uint64(m.DurationTotal))
+	{
+		size, err := m.Month.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.AutoRenewalNextPlan)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTrialBudgetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.FutureSubscription != nil {
-		l = m.FutureSubscription.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *QueryTrialBudgetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTrialBudgetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTrialBudgetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Credit != nil {
-		l = m.Credit.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *QueryTrialBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTrialBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PeriodEnd != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PeriodEnd))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.PoolBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Remaining.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spent.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.MonthlyBudget.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
 	return n
 }
 
-func (m *QueryNextToMonthExpiryRequest) Size() (n int) {
+func (m *QueryParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *TimerExpiryInfo) Size() (n int) {
+func (m *QueryCurrentRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1570,117 +2215,1123 @@ func (m *TimerExpiryInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.MonthExpiry != 0 {
-		n += 1 + sovQuery(uint64(m.MonthExpiry))
+	return n
+}
+
+func (m *QueryCurrentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sub != nil {
+		l = m.Sub.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryNextToMonthExpiryResponse) Size() (n int) {
+func (m *QueryListProjectsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Subscriptions) > 0 {
-		for _, e := range m.Subscriptions {
-			l = e.Size()
+	l = len(m.Subscription)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListProjectsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projects) > 0 {
+		for _, s := range m.Projects {
+			l = len(s)
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SubsInfo) > 0 {
+		for _, e := range m.SubsInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+
+func (m *ListInfoStruct) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Plan)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DurationBought != 0 {
+		n += 1 + sovQuery(uint64(m.DurationBought))
+	}
+	if m.DurationLeft != 0 {
+		n += 1 + sovQuery(uint64(m.DurationLeft))
+	}
+	if m.MonthExpiry != 0 {
+		n += 1 + sovQuery(uint64(m.MonthExpiry))
+	}
+	if m.MonthCuTotal != 0 {
+		n += 1 + sovQuery(uint64(m.MonthCuTotal))
+	}
+	if m.MonthCuLeft != 0 {
+		n += 1 + sovQuery(uint64(m.MonthCuLeft))
+	}
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DurationTotal != 0 {
+		n += 1 + sovQuery(uint64(m.DurationTotal))
+	}
+	l = len(m.AutoRenewalNextPlan)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FutureSubscription != nil {
+		l = m.FutureSubscription.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Credit != nil {
+		l = m.Credit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextToMonthExpiryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TimerExpiryInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MonthExpiry != 0 {
+		n += 1 + sovQuery(uint64(m.MonthExpiry))
+	}
+	return n
+}
+
+func (m *QueryNextToMonthExpiryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUsageHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subscription)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUsageHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Months) > 0 {
+		for _, e := range m.Months {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMonthUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subscription)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MonthBlock != 0 {
+		n += 1 + sovQuery(uint64(m.MonthBlock))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMonthUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Month.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTrialBudgetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTrialBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MonthlyBudget.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Spent.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Remaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PoolBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PeriodEnd != 0 {
+		n += 1 + sovQuery(uint64(m.PeriodEnd))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sub", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sub == nil {
+				m.Sub = &Subscription{}
+			}
+			if err := m.Sub.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListProjectsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListProjectsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListProjectsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscription", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListProjectsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListProjectsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListProjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projects = append(m.Projects, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubsInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubsInfo = append(m.SubsInfo, ListInfoStruct{})
+			if err := m.SubsInfo[len(m.SubsInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListInfoStruct) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListInfoStruct: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListInfoStruct: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plan = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationBought", wireType)
+			}
+			m.DurationBought = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationBought |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationLeft", wireType)
+			}
+			m.DurationLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationLeft |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthExpiry", wireType)
+			}
+			m.MonthExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthExpiry |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthCuTotal", wireType)
+			}
+			m.MonthCuTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthCuTotal |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthCuLeft", wireType)
+			}
+			m.MonthCuLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthCuLeft |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Cluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationTotal", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DurationTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationTotal |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenewalNextPlan", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1690,80 +3341,65 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AutoRenewalNextPlan = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureSubscription", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCurrentRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.FutureSubscription == nil {
+				m.FutureSubscription = &FutureSubscription{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.FutureSubscription.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Credit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1773,23 +3409,27 @@ func (m *QueryCurrentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Consumer = string(dAtA[iNdEx:postIndex])
+			if m.Credit == nil {
+				m.Credit = &types.Coin{}
+			}
+			if err := m.Credit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1812,7 +3452,7 @@ func (m *QueryCurrentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNextToMonthExpiryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1828,55 +3468,19 @@ func (m *QueryCurrentResponse) Unmarshal(dAtA []byte) error {
 			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sub", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Sub == nil {
-				m.Sub = &Subscription{}
-			}
-			if err := m.Sub.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextToMonthExpiryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextToMonthExpiryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1898,7 +3502,7 @@ func (m *QueryCurrentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListProjectsRequest) Unmarshal(dAtA []byte) error {
+func (m *TimerExpiryInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1921,15 +3525,15 @@ func (m *QueryListProjectsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListProjectsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimerExpiryInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListProjectsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimerExpiryInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subscription", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1957,8 +3561,27 @@ func (m *QueryListProjectsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subscription = string(dAtA[iNdEx:postIndex])
+			m.Consumer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthExpiry", wireType)
+			}
+			m.MonthExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthExpiry |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1980,7 +3603,7 @@ func (m *QueryListProjectsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListProjectsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNextToMonthExpiryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2003,17 +3626,17 @@ func (m *QueryListProjectsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListProjectsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextToMonthExpiryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListProjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextToMonthExpiryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2023,23 +3646,25 @@ func (m *QueryListProjectsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Projects = append(m.Projects, string(dAtA[iNdEx:postIndex]))
+			m.Subscriptions = append(m.Subscriptions, TimerExpiryInfo{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2062,7 +3687,7 @@ func (m *QueryListProjectsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUsageHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2085,65 +3710,47 @@ func (m *QueryListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUsageHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUsageHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscription", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryListResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Subscription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubsInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2170,8 +3777,10 @@ func (m *QueryListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubsInfo = append(m.SubsInfo, ListInfoStruct{})
-			if err := m.SubsInfo[len(m.SubsInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2196,7 +3805,7 @@ func (m *QueryListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListInfoStruct) Unmarshal(dAtA []byte) error {
+func (m *QueryUsageHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2219,17 +3828,17 @@ func (m *ListInfoStruct) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListInfoStruct: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUsageHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListInfoStruct: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUsageHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Months", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2239,29 +3848,31 @@ func (m *ListInfoStruct) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Consumer = string(dAtA[iNdEx:postIndex])
+			m.Months = append(m.Months, MonthUsage{})
+			if err := m.Months[len(m.Months)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2271,67 +3882,83 @@ func (m *ListInfoStruct) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Plan = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DurationBought", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.DurationBought = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DurationBought |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DurationLeft", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.DurationLeft = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DurationLeft |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMonthUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonthExpiry", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMonthUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMonthUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscription", wireType)
 			}
-			m.MonthExpiry = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2341,16 +3968,29 @@ func (m *ListInfoStruct) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MonthExpiry |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonthCuTotal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthBlock", wireType)
 			}
-			m.MonthCuTotal = 0
+			m.MonthBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2360,16 +4000,16 @@ func (m *ListInfoStruct) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MonthCuTotal |= uint64(b&0x7F) << shift
+				m.MonthBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonthCuLeft", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.MonthCuLeft = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2379,16 +4019,16 @@ func (m *ListInfoStruct) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MonthCuLeft |= uint64(b&0x7F) << shift
+				m.Type |= UsageEntry_Type(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2398,48 +4038,83 @@ func (m *ListInfoStruct) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cluster = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DurationTotal", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.DurationTotal = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DurationTotal |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 11:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMonthUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMonthUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMonthUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenewalNextPlan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Month", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2449,27 +4124,28 @@ func (m *ListInfoStruct) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AutoRenewalNextPlan = string(dAtA[iNdEx:postIndex])
+			if err := m.Month.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FutureSubscription", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2496,16 +4172,14 @@ func (m *ListInfoStruct) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FutureSubscription == nil {
-				m.FutureSubscription = &FutureSubscription{}
-			}
-			if err := m.FutureSubscription.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, UsageEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2532,10 +4206,10 @@ func (m *ListInfoStruct) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Credit == nil {
-				m.Credit = &types.Coin{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Credit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2560,7 +4234,7 @@ func (m *ListInfoStruct) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextToMonthExpiryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTrialBudgetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2583,10 +4257,10 @@ func (m *QueryNextToMonthExpiryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextToMonthExpiryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTrialBudgetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextToMonthExpiryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTrialBudgetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2610,7 +4284,7 @@ func (m *QueryNextToMonthExpiryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TimerExpiryInfo) Unmarshal(dAtA []byte) error {
+func (m *QueryTrialBudgetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2633,17 +4307,17 @@ func (m *TimerExpiryInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TimerExpiryInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTrialBudgetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TimerExpiryInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTrialBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthlyBudget", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2653,29 +4327,30 @@ func (m *TimerExpiryInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Consumer = string(dAtA[iNdEx:postIndex])
+			if err := m.MonthlyBudget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonthExpiry", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
 			}
-			m.MonthExpiry = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2685,64 +4360,61 @@ func (m *TimerExpiryInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MonthExpiry |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryNextToMonthExpiryResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.Spent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextToMonthExpiryResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextToMonthExpiryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolBalance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2769,11 +4441,29 @@ func (m *QueryNextToMonthExpiryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subscriptions = append(m.Subscriptions, TimerExpiryInfo{})
-			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PoolBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodEnd", wireType)
+			}
+			m.PeriodEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodEnd |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
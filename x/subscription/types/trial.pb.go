@@ -0,0 +1,800 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lavanet/lava/subscription/trial.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TrialRecord marks that a consumer used its trial and tracks the CU left for the trial subscription
+type TrialRecord struct {
+	Consumer  string     `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Creator   string     `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	PlanIndex string     `protobuf:"bytes,3,opt,name=plan_index,json=planIndex,proto3" json:"plan_index,omitempty"`
+	PlanBlock uint64     `protobuf:"varint,4,opt,name=plan_block,json=planBlock,proto3" json:"plan_block,omitempty"`
+	Block     uint64     `protobuf:"varint,5,opt,name=block,proto3" json:"block,omitempty"`
+	Cost      types.Coin `protobuf:"bytes,6,opt,name=cost,proto3" json:"cost"`
+	CuLeft    uint64     `protobuf:"varint,7,opt,name=cu_left,json=cuLeft,proto3" json:"cu_left,omitempty"`
+}
+
+func (m *TrialRecord) Reset()         { *m = TrialRecord{} }
+func (m *TrialRecord) String() string { return proto.CompactTextString(m) }
+func (*TrialRecord) ProtoMessage()    {}
+func (*TrialRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64aff50398a02389, []int{0}
+}
+func (m *TrialRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrialRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrialRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrialRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrialRecord.Merge(m, src)
+}
+func (m *TrialRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrialRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrialRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrialRecord proto.InternalMessageInfo
+
+func (m *TrialRecord) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *TrialRecord) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *TrialRecord) GetPlanIndex() string {
+	if m != nil {
+		return m.PlanIndex
+	}
+	return ""
+}
+
+func (m *TrialRecord) GetPlanBlock() uint64 {
+	if m != nil {
+		return m.PlanBlock
+	}
+	return 0
+}
+
+func (m *TrialRecord) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *TrialRecord) GetCost() types.Coin {
+	if m != nil {
+		return m.Cost
+	}
+	return types.Coin{}
+}
+
+func (m *TrialRecord) GetCuLeft() uint64 {
+	if m != nil {
+		return m.CuLeft
+	}
+	return 0
+}
+
+// TrialBudget tracks the trial pool spending in the current budget period (month)
+type TrialBudget struct {
+	PeriodStart uint64     `protobuf:"varint,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	Spent       types.Coin `protobuf:"bytes,2,opt,name=spent,proto3" json:"spent"`
+}
+
+func (m *TrialBudget) Reset()         { *m = TrialBudget{} }
+func (m *TrialBudget) String() string { return proto.CompactTextString(m) }
+func (*TrialBudget) ProtoMessage()    {}
+func (*TrialBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64aff50398a02389, []int{1}
+}
+func (m *TrialBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrialBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrialBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrialBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrialBudget.Merge(m, src)
+}
+func (m *TrialBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrialBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrialBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrialBudget proto.InternalMessageInfo
+
+func (m *TrialBudget) GetPeriodStart() uint64 {
+	if m != nil {
+		return m.PeriodStart
+	}
+	return 0
+}
+
+func (m *TrialBudget) GetSpent() types.Coin {
+	if m != nil {
+		return m.Spent
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*TrialRecord)(nil), "lavanet.lava.subscription.TrialRecord")
+	proto.RegisterType((*TrialBudget)(nil), "lavanet.lava.subscription.TrialBudget")
+}
+
+func init() {
+	proto.RegisterFile("lavanet/lava/subscription/trial.proto", fileDescriptor_64aff50398a02389)
+}
+
+var fileDescriptor_64aff50398a02389 = []byte{
+	// 361 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xcf, 0xaa, 0x13, 0x31,
+	0x14, 0xc6, 0x27, 0xd7, 0x69, 0xeb, 0x4d, 0x5d, 0x85, 0x0b, 0xe6, 0x16, 0x1c, 0xeb, 0x05, 0xa1,
+	0x0b, 0x49, 0xb8, 0x16, 0x5f, 0x60, 0x04, 0x41, 0x70, 0x35, 0xba, 0x72, 0x53, 0x32, 0x99, 0x74,
+	0x0c, 0x4e, 0x73, 0x86, 0x24, 0x53, 0xea, 0x5b, 0xf8, 0x58, 0x5d, 0x76, 0xe9, 0x4a, 0xa4, 0xc5,
+	0xf7, 0x90, 0x24, 0xb5, 0xa8, 0x2b, 0x57, 0xe7, 0x9c, 0xef, 0xf7, 0xe5, 0xcf, 0xc7, 0xc1, 0xcf,
+	0x3b, 0xb1, 0x15, 0x46, 0x79, 0x1e, 0x2a, 0x77, 0x43, 0xed, 0xa4, 0xd5, 0xbd, 0xd7, 0x60, 0xb8,
+	0xb7, 0x5a, 0x74, 0xac, 0xb7, 0xe0, 0x81, 0xdc, 0x9e, 0x6d, 0x2c, 0x54, 0xf6, 0xa7, 0x6d, 0x56,
+	0x48, 0x70, 0x1b, 0x70, 0xbc, 0x16, 0x4e, 0xf1, 0xed, 0x7d, 0xad, 0xbc, 0xb8, 0xe7, 0x12, 0xb4,
+	0x49, 0x47, 0x67, 0x37, 0x2d, 0xb4, 0x10, 0x5b, 0x1e, 0xba, 0xa4, 0xde, 0xfd, 0x44, 0x78, 0xfa,
+	0x21, 0x3c, 0x50, 0x29, 0x09, 0xb6, 0x21, 0x33, 0xfc, 0x50, 0x82, 0x71, 0xc3, 0x46, 0x59, 0x8a,
+	0xe6, 0x68, 0x71, 0x5d, 0x5d, 0x66, 0x42, 0xf1, 0x44, 0x5a, 0x25, 0x3c, 0x58, 0x7a, 0x15, 0xd1,
+	0xef, 0x91, 0x3c, 0xc1, 0xb8, 0xef, 0x84, 0x59, 0x69, 0xd3, 0xa8, 0x1d, 0x7d, 0x10, 0xe1, 0x75,
+	0x50, 0xde, 0x06, 0xe1, 0x82, 0xeb, 0x0e, 0xe4, 0x67, 0x9a, 0xcf, 0xd1, 0x22, 0x4f, 0xb8, 0x0c,
+	0x02, 0xb9, 0xc1, 0xa3, 0x44, 0x46, 0x91, 0xa4, 0x81, 0x2c, 0x71, 0x2e, 0xc1, 0x79, 0x3a, 0x9e,
+	0xa3, 0xc5, 0xf4, 0xe5, 0x2d, 0x4b, 0xf1, 0x58, 0x88, 0xc7, 0xce, 0xf1, 0xd8, 0x6b, 0xd0, 0xa6,
+	0xcc, 0xf7, 0xdf, 0x9f, 0x66, 0x55, 0x34, 0x93, 0xc7, 0x78, 0x22, 0x87, 0x55, 0xa7, 0xd6, 0x9e,
+	0x4e, 0xe2, 0x65, 0x63, 0x39, 0xbc, 0x53, 0x6b, 0x7f, 0xd7, 0x9e, 0x63, 0x96, 0x43, 0xd3, 0x2a,
+	0x4f, 0x9e, 0xe1, 0x47, 0xbd, 0xb2, 0x1a, 0x9a, 0x95, 0xf3, 0xc2, 0xfa, 0x18, 0x35, 0xaf, 0xa6,
+	0x49, 0x7b, 0x1f, 0x24, 0xf2, 0x0a, 0x8f, 0x5c, 0xaf, 0x8c, 0xa7, 0x57, 0xff, 0xf7, 0x81, 0xe4,
+	0x2e, 0xdf, 0xec, 0x8f, 0x05, 0x3a, 0x1c, 0x0b, 0xf4, 0xe3, 0x58, 0xa0, 0xaf, 0xa7, 0x22, 0x3b,
+	0x9c, 0x8a, 0xec, 0xdb, 0xa9, 0xc8, 0x3e, 0xbe, 0x68, 0xb5, 0xff, 0x34, 0xd4, 0x4c, 0xc2, 0x86,
+	0xff, 0xb5, 0xed, 0xdd, 0x3f, 0xfb, 0xfe, 0xd2, 0x2b, 0x57, 0x8f, 0xe3, 0x7e, 0x96, 0xbf, 0x06,
+	0x00, 0xd0, 0xe2, 0xa7, 0xa6, 0x19, 0x02, 0x00, 0x00,
+}
+
+func (m *TrialRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrialRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrialRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CuLeft != 0 {
+		i = encodeVarintTrial(dAtA, i, uint64(m.CuLeft))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Cost.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTrial(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Block != 0 {
+		i = encodeVarintTrial(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PlanBlock != 0 {
+		i = encodeVarintTrial(dAtA, i, uint64(m.PlanBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PlanIndex) > 0 {
+		i -= len(m.PlanIndex)
+		copy(dAtA[i:], m.PlanIndex)
+		i = encodeVarintTrial(dAtA, i, uint64(len(m.PlanIndex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTrial(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintTrial(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrialBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrialBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrialBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Spent.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTrial(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PeriodStart != 0 {
+		i = encodeVarintTrial(dAtA, i, uint64(m.PeriodStart))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTrial(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTrial(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TrialRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovTrial(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTrial(uint64(l))
+	}
+	l = len(m.PlanIndex)
+	if l > 0 {
+		n += 1 + l + sovTrial(uint64(l))
+	}
+	if m.PlanBlock != 0 {
+		n += 1 + sovTrial(uint64(m.PlanBlock))
+	}
+	if m.Block != 0 {
+		n += 1 + sovTrial(uint64(m.Block))
+	}
+	l = m.Cost.Size()
+	n += 1 + l + sovTrial(uint64(l))
+	if m.CuLeft != 0 {
+		n += 1 + sovTrial(uint64(m.CuLeft))
+	}
+	return n
+}
+
+func (m *TrialBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PeriodStart != 0 {
+		n += 1 + sovTrial(uint64(m.PeriodStart))
+	}
+	l = m.Spent.Size()
+	n += 1 + l + sovTrial(uint64(l))
+	return n
+}
+
+func sovTrial(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTrial(x uint64) (n int) {
+	return sovTrial(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TrialRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrial
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrialRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrialRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrial
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrial
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrial
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanBlock", wireType)
+			}
+			m.PlanBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrial
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrial
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CuLeft", wireType)
+			}
+			m.CuLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CuLeft |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrial(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrial
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrialBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrial
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrialBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrialBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStart", wireType)
+			}
+			m.PeriodStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodStart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrial
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrial
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrial(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrial
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTrial(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTrial
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTrial
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTrial
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTrial
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTrial
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTrial
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTrial        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTrial          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTrial = fmt.Errorf("proto: unexpected end of group")
+)
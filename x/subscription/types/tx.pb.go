@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -429,6 +430,94 @@ func (m *MsgAutoRenewalResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAutoRenewalResponse proto.InternalMessageInfo
 
+type MsgFundTrialPool struct {
+	Creator string      `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Amount  types1.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgFundTrialPool) Reset()         { *m = MsgFundTrialPool{} }
+func (m *MsgFundTrialPool) String() string { return proto.CompactTextString(m) }
+func (*MsgFundTrialPool) ProtoMessage()    {}
+func (*MsgFundTrialPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1bb075a6865b817, []int{8}
+}
+func (m *MsgFundTrialPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundTrialPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundTrialPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundTrialPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundTrialPool.Merge(m, src)
+}
+func (m *MsgFundTrialPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundTrialPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundTrialPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundTrialPool proto.InternalMessageInfo
+
+func (m *MsgFundTrialPool) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgFundTrialPool) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types1.Coin{}
+}
+
+type MsgFundTrialPoolResponse struct {
+}
+
+func (m *MsgFundTrialPoolResponse) Reset()         { *m = MsgFundTrialPoolResponse{} }
+func (m *MsgFundTrialPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundTrialPoolResponse) ProtoMessage()    {}
+func (*MsgFundTrialPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1bb075a6865b817, []int{9}
+}
+func (m *MsgFundTrialPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundTrialPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundTrialPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundTrialPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundTrialPoolResponse.Merge(m, src)
+}
+func (m *MsgFundTrialPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundTrialPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundTrialPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundTrialPoolResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgBuy)(nil), "lavanet.lava.subscription.MsgBuy")
 	proto.RegisterType((*MsgBuyResponse)(nil), "lavanet.lava.subscription.MsgBuyResponse")
@@ -438,6 +527,8 @@ func init() {
 	proto.RegisterType((*MsgDelProjectResponse)(nil), "lavanet.lava.subscription.MsgDelProjectResponse")
 	proto.RegisterType((*MsgAutoRenewal)(nil), "lavanet.lava.subscription.MsgAutoRenewal")
 	proto.RegisterType((*MsgAutoRenewalResponse)(nil), "lavanet.lava.subscription.MsgAutoRenewalResponse")
+	proto.RegisterType((*MsgFundTrialPool)(nil), "lavanet.lava.subscription.MsgFundTrialPool")
+	proto.RegisterType((*MsgFundTrialPoolResponse)(nil), "lavanet.lava.subscription.MsgFundTrialPoolResponse")
 }
 
 func init() {
@@ -445,39 +536,44 @@ func init() {
 }
 
 var fileDescriptor_b1bb075a6865b817 = []byte{
-	// 498 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0x8f, 0xb1, 0xeb, 0x86, 0x97, 0x02, 0xd1, 0xa9, 0x14, 0xe3, 0xc1, 0xa4, 0x66, 0x49, 0x25,
-	0x64, 0x43, 0x99, 0x19, 0x1a, 0x55, 0x0c, 0xa0, 0x48, 0x95, 0xd9, 0x58, 0xa2, 0x8b, 0x7d, 0x72,
-	0x02, 0xc9, 0x9d, 0x75, 0x77, 0x0e, 0xe9, 0xb7, 0x60, 0xe7, 0xeb, 0x30, 0x74, 0xec, 0xc8, 0x84,
-	0x50, 0xf2, 0x45, 0x90, 0xcf, 0x76, 0x6c, 0x83, 0x52, 0x77, 0xba, 0xf7, 0xde, 0xfd, 0xde, 0xbf,
-	0xdf, 0xef, 0x74, 0xe0, 0x2e, 0xf0, 0x0a, 0x53, 0x22, 0xfd, 0xec, 0xf4, 0x45, 0x3a, 0x15, 0x21,
-	0x9f, 0x27, 0x72, 0xce, 0xa8, 0x2f, 0xd7, 0x5e, 0xc2, 0x99, 0x64, 0xe8, 0x79, 0x81, 0xf1, 0xb2,
-	0xd3, 0xab, 0x63, 0xec, 0x97, 0x8d, 0xf4, 0x84, 0xb3, 0x2f, 0x24, 0x94, 0xa2, 0x34, 0xf2, 0x7c,
-	0xfb, 0x38, 0x66, 0x31, 0x53, 0xa6, 0x9f, 0x59, 0x79, 0xd4, 0xfd, 0xa9, 0x81, 0x39, 0x16, 0xf1,
-	0x28, 0xbd, 0x46, 0x16, 0x1c, 0x86, 0x9c, 0x60, 0xc9, 0xb8, 0xa5, 0x0d, 0xb4, 0xe1, 0xc3, 0xa0,
-	0x74, 0x91, 0x0d, 0xdd, 0x90, 0x51, 0x91, 0x2e, 0x09, 0xb7, 0x1e, 0xa8, 0xab, 0x9d, 0x8f, 0x8e,
-	0xe1, 0x60, 0x4e, 0x23, 0xb2, 0xb6, 0x74, 0x75, 0x91, 0x3b, 0x59, 0x46, 0x94, 0x72, 0x9c, 0x4d,
-	0x67, 0x19, 0x03, 0x6d, 0x68, 0x04, 0x3b, 0x1f, 0x9d, 0xc2, 0x11, 0x4e, 0x25, 0x9b, 0x70, 0x42,
-	0xc9, 0x37, 0xbc, 0xb0, 0xcc, 0x81, 0x36, 0xec, 0x06, 0xbd, 0x2c, 0x16, 0xe4, 0x21, 0x74, 0x06,
-	0x7d, 0x1c, 0xad, 0x30, 0x0d, 0xc9, 0x24, 0x49, 0x79, 0x38, 0xc3, 0x82, 0x58, 0x87, 0x0a, 0xf6,
-	0xa4, 0x88, 0x5f, 0x15, 0xe1, 0x0f, 0x46, 0xf7, 0xa0, 0x6f, 0xba, 0x7d, 0x78, 0x9c, 0x6f, 0x11,
-	0x10, 0x91, 0x30, 0x2a, 0x88, 0xbb, 0x82, 0x47, 0x63, 0x11, 0x5f, 0x44, 0xd1, 0x55, 0xce, 0xc2,
-	0x1d, 0xeb, 0x7d, 0x84, 0xa3, 0x82, 0xaa, 0x49, 0x84, 0x25, 0x56, 0x2b, 0xf6, 0xce, 0x5d, 0xaf,
-	0x41, 0x78, 0xc9, 0xaa, 0x57, 0xd4, 0xbb, 0xc4, 0x12, 0x8f, 0x8c, 0x9b, 0xdf, 0x2f, 0x3a, 0x41,
-	0x2f, 0xa9, 0x42, 0xee, 0x33, 0x78, 0xda, 0xe8, 0xbb, 0x1b, 0xe8, 0x9d, 0x1a, 0xe8, 0x92, 0x2c,
-	0xda, 0x07, 0x42, 0x60, 0x50, 0xbc, 0x24, 0x05, 0xd7, 0xca, 0x2e, 0xea, 0x56, 0xe9, 0xbb, 0xba,
-	0x52, 0xad, 0x7e, 0x51, 0x63, 0x6f, 0x7f, 0xe1, 0x13, 0x30, 0x09, 0xc5, 0xd3, 0x45, 0x5e, 0xba,
-	0x1b, 0x14, 0x5e, 0x43, 0x60, 0x7d, 0x9f, 0xc0, 0x46, 0x4d, 0x60, 0xd7, 0x82, 0x93, 0x66, 0xd7,
-	0x72, 0x9e, 0xf3, 0x1f, 0x3a, 0xe8, 0x63, 0x11, 0xa3, 0x4f, 0xa0, 0x67, 0xaf, 0xea, 0xd4, 0xdb,
-	0xfb, 0x6e, 0xbd, 0x5c, 0x32, 0xfb, 0xac, 0x15, 0x52, 0x16, 0x47, 0x33, 0x80, 0x9a, 0xa4, 0xc3,
-	0xbb, 0x13, 0x2b, 0xa4, 0xfd, 0xfa, 0xbe, 0xc8, 0x7a, 0xa7, 0x9a, 0x56, 0x2d, 0x9d, 0x2a, 0x64,
-	0x5b, 0xa7, 0xff, 0x05, 0x44, 0x5f, 0xa1, 0x57, 0x57, 0xaf, 0x85, 0x8d, 0x1a, 0xd4, 0x7e, 0x73,
-	0x6f, 0x68, 0xd9, 0x6c, 0xf4, 0xfe, 0x66, 0xe3, 0x68, 0xb7, 0x1b, 0x47, 0xfb, 0xb3, 0x71, 0xb4,
-	0xef, 0x5b, 0xa7, 0x73, 0xbb, 0x75, 0x3a, 0xbf, 0xb6, 0x4e, 0xe7, 0xf3, 0xab, 0x78, 0x2e, 0x67,
-	0xe9, 0xd4, 0x0b, 0xd9, 0xd2, 0x6f, 0xfc, 0x27, 0xeb, 0x7f, 0x3e, 0xa4, 0xeb, 0x84, 0x88, 0xa9,
-	0xa9, 0xbe, 0x8f, 0xb7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x95, 0xe6, 0xe6, 0xba, 0x04,
-	0x00, 0x00,
+	// 584 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0x89, 0xeb, 0x86, 0x49, 0x0b, 0x91, 0x55, 0x8a, 0xeb, 0x83, 0x69, 0xcd, 0x25, 0x15,
+	0x68, 0xdd, 0x8f, 0x03, 0x27, 0x0e, 0x2d, 0x55, 0x0f, 0xa0, 0x48, 0x95, 0xe1, 0xc4, 0xa5, 0x5a,
+	0xdb, 0x2b, 0xd7, 0x90, 0xec, 0x1a, 0xef, 0x3a, 0xa4, 0x7f, 0x02, 0xf1, 0xa3, 0x38, 0xf4, 0xd8,
+	0x23, 0x27, 0x84, 0x92, 0x3f, 0x82, 0x6c, 0xaf, 0x1d, 0xbb, 0x52, 0x3e, 0x38, 0x79, 0x67, 0xf6,
+	0xcd, 0xbc, 0xd9, 0xb7, 0xcf, 0x0b, 0xf6, 0x10, 0x8f, 0x31, 0x25, 0xc2, 0xc9, 0xbe, 0x0e, 0x4f,
+	0x3d, 0xee, 0x27, 0x51, 0x2c, 0x22, 0x46, 0x1d, 0x31, 0x41, 0x71, 0xc2, 0x04, 0xd3, 0xf7, 0x24,
+	0x06, 0x65, 0x5f, 0x54, 0xc7, 0x98, 0x2f, 0x1b, 0xe5, 0x71, 0xc2, 0xbe, 0x10, 0x5f, 0xf0, 0x72,
+	0x51, 0xd4, 0x9b, 0x3b, 0x21, 0x0b, 0x59, 0xbe, 0x74, 0xb2, 0x95, 0xcc, 0x5a, 0x3e, 0xe3, 0x23,
+	0xc6, 0x1d, 0x0f, 0x73, 0xe2, 0x8c, 0x8f, 0x3d, 0x22, 0xf0, 0xb1, 0xe3, 0xb3, 0x88, 0x16, 0xfb,
+	0xf6, 0x2f, 0x05, 0xb4, 0x01, 0x0f, 0xcf, 0xd3, 0x5b, 0xdd, 0x80, 0x4d, 0x3f, 0x21, 0x58, 0xb0,
+	0xc4, 0x50, 0xf6, 0x95, 0xfe, 0x63, 0xb7, 0x0c, 0x75, 0x13, 0x3a, 0x3e, 0xa3, 0x3c, 0x1d, 0x91,
+	0xc4, 0x78, 0x94, 0x6f, 0x55, 0xb1, 0xbe, 0x03, 0x1b, 0x11, 0x0d, 0xc8, 0xc4, 0x68, 0xe7, 0x1b,
+	0x45, 0x90, 0x55, 0x04, 0x69, 0x82, 0xb3, 0xe9, 0x0d, 0x75, 0x5f, 0xe9, 0xab, 0x6e, 0x15, 0xeb,
+	0x07, 0xb0, 0x85, 0x53, 0xc1, 0xae, 0x13, 0x42, 0xc9, 0x77, 0x3c, 0x34, 0xb4, 0x7d, 0xa5, 0xdf,
+	0x71, 0xbb, 0x59, 0xce, 0x2d, 0x52, 0xfa, 0x21, 0xf4, 0x70, 0x30, 0xc6, 0xd4, 0x27, 0xd7, 0x71,
+	0x9a, 0xf8, 0x37, 0x98, 0x13, 0x63, 0x33, 0x87, 0x3d, 0x95, 0xf9, 0x2b, 0x99, 0x7e, 0xaf, 0x76,
+	0x36, 0x7a, 0x9a, 0xdd, 0x83, 0x27, 0xc5, 0x29, 0x5c, 0xc2, 0x63, 0x46, 0x39, 0xb1, 0xc7, 0xb0,
+	0x3d, 0xe0, 0xe1, 0x59, 0x10, 0x5c, 0x15, 0x2a, 0x2d, 0x39, 0xde, 0x07, 0xd8, 0x92, 0x52, 0x5e,
+	0x07, 0x58, 0xe0, 0xfc, 0x88, 0xdd, 0x13, 0x1b, 0x35, 0x2e, 0xa4, 0x54, 0x1d, 0xc9, 0x7e, 0x17,
+	0x58, 0xe0, 0x73, 0xf5, 0xee, 0xcf, 0x8b, 0x96, 0xdb, 0x8d, 0xe7, 0x29, 0xfb, 0x39, 0x3c, 0x6b,
+	0xf0, 0x56, 0x03, 0xbd, 0xcd, 0x07, 0xba, 0x20, 0xc3, 0xd5, 0x03, 0xe9, 0xa0, 0x52, 0x3c, 0x22,
+	0x52, 0xeb, 0x7c, 0x2d, 0xfb, 0xce, 0xcb, 0xab, 0xbe, 0x22, 0x3f, 0xfa, 0x59, 0x4d, 0xbd, 0xc5,
+	0x8d, 0x77, 0x41, 0x23, 0x14, 0x7b, 0xc3, 0xa2, 0x75, 0xc7, 0x95, 0x51, 0xe3, 0x82, 0xdb, 0x8b,
+	0x2e, 0x58, 0xad, 0x5d, 0xb0, 0x6d, 0xc0, 0x6e, 0x93, 0xb5, 0x9a, 0x87, 0x40, 0x6f, 0xc0, 0xc3,
+	0xcb, 0x94, 0x06, 0x9f, 0x92, 0x08, 0x0f, 0xaf, 0x18, 0x5b, 0x36, 0xd1, 0x1b, 0xd0, 0xf0, 0x88,
+	0xa5, 0x54, 0x48, 0xd5, 0xf7, 0x50, 0x61, 0x58, 0x94, 0x19, 0x16, 0x49, 0xc3, 0xa2, 0x77, 0x2c,
+	0xa2, 0x52, 0x6c, 0x09, 0xb7, 0x4d, 0x30, 0x1e, 0xd2, 0x94, 0x23, 0x9c, 0xfc, 0x50, 0xa1, 0x3d,
+	0xe0, 0xa1, 0xfe, 0x11, 0xda, 0x99, 0xb1, 0x0f, 0xd0, 0xc2, 0x5f, 0x0b, 0x15, 0xae, 0x31, 0x0f,
+	0x57, 0x42, 0xca, 0xe6, 0xfa, 0x0d, 0x40, 0xcd, 0x55, 0xfd, 0xe5, 0x85, 0x73, 0xa4, 0x79, 0xb4,
+	0x2e, 0xb2, 0xce, 0x54, 0xb3, 0xcb, 0x0a, 0xa6, 0x39, 0xd2, 0x3c, 0x5a, 0x17, 0x59, 0x31, 0x7d,
+	0x85, 0x6e, 0xdd, 0x40, 0x2b, 0xd4, 0xa8, 0x41, 0xcd, 0xe3, 0xb5, 0xa1, 0x15, 0xd9, 0x37, 0xd8,
+	0x6e, 0xba, 0xe3, 0xd5, 0xf2, 0x1e, 0x0d, 0xb0, 0x79, 0xfa, 0x1f, 0xe0, 0x92, 0xf2, 0xfc, 0xf2,
+	0x6e, 0x6a, 0x29, 0xf7, 0x53, 0x4b, 0xf9, 0x3b, 0xb5, 0x94, 0x9f, 0x33, 0xab, 0x75, 0x3f, 0xb3,
+	0x5a, 0xbf, 0x67, 0x56, 0xeb, 0xf3, 0xeb, 0x30, 0x12, 0x37, 0xa9, 0x87, 0x7c, 0x36, 0x72, 0x1a,
+	0xaf, 0xec, 0xe4, 0xc1, 0x33, 0x7d, 0x1b, 0x13, 0xee, 0x69, 0xf9, 0xa3, 0x79, 0xfa, 0x6f, 0x00,
+	0x96, 0xf9, 0x28, 0xdf, 0xd0, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -496,6 +592,7 @@ type MsgClient interface {
 	AddProject(ctx context.Context, in *MsgAddProject, opts ...grpc.CallOption) (*MsgAddProjectResponse, error)
 	DelProject(ctx context.Context, in *MsgDelProject, opts ...grpc.CallOption) (*MsgDelProjectResponse, error)
 	AutoRenewal(ctx context.Context, in *MsgAutoRenewal, opts ...grpc.CallOption) (*MsgAutoRenewalResponse, error)
+	FundTrialPool(ctx context.Context, in *MsgFundTrialPool, opts ...grpc.CallOption) (*MsgFundTrialPoolResponse, error)
 }
 
 type msgClient struct {
@@ -542,12 +639,22 @@ func (c *msgClient) AutoRenewal(ctx context.Context, in *MsgAutoRenewal, opts ..
 	return out, nil
 }
 
+func (c *msgClient) FundTrialPool(ctx context.Context, in *MsgFundTrialPool, opts ...grpc.CallOption) (*MsgFundTrialPoolResponse, error) {
+	out := new(MsgFundTrialPoolResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.subscription.Msg/FundTrialPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	Buy(context.Context, *MsgBuy) (*MsgBuyResponse, error)
 	AddProject(context.Context, *MsgAddProject) (*MsgAddProjectResponse, error)
 	DelProject(context.Context, *MsgDelProject) (*MsgDelProjectResponse, error)
 	AutoRenewal(context.Context, *MsgAutoRenewal) (*MsgAutoRenewalResponse, error)
+	FundTrialPool(context.Context, *MsgFundTrialPool) (*MsgFundTrialPoolResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -566,6 +673,9 @@ func (*UnimplementedMsgServer) DelProject(ctx context.Context, req *MsgDelProjec
 func (*UnimplementedMsgServer) AutoRenewal(ctx context.Context, req *MsgAutoRenewal) (*MsgAutoRenewalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AutoRenewal not implemented")
 }
+func (*UnimplementedMsgServer) FundTrialPool(ctx context.Context, req *MsgFundTrialPool) (*MsgFundTrialPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundTrialPool not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -643,6 +753,24 @@ func _Msg_AutoRenewal_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FundTrialPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundTrialPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundTrialPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.subscription.Msg/FundTrialPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundTrialPool(ctx, req.(*MsgFundTrialPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.subscription.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -663,6 +791,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AutoRenewal",
 			Handler:    _Msg_AutoRenewal_Handler,
 		},
+		{
+			MethodName: "FundTrialPool",
+			Handler:    _Msg_FundTrialPool_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/subscription/tx.proto",
@@ -960,6 +1092,69 @@ func (m *MsgAutoRenewalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgFundTrialPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundTrialPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundTrialPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundTrialPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundTrialPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundTrialPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1093,6 +1288,30 @@ func (m *MsgAutoRenewalResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgFundTrialPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFundTrialPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1899,6 +2118,171 @@ func (m *MsgAutoRenewalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgFundTrialPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundTrialPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundTrialPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundTrialPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundTrialPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundTrialPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
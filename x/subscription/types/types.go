@@ -12,4 +12,6 @@ const (
 	AddTrackedCuEventName                   = "add_tracked_cu_event"
 	MonthlyCuTrackerProviderRewardEventName = "monthly_cu_tracker_provider_reward"
 	RemainingCreditEventName                = "subscription_remaining_credit"
+	BuyTrialSubscriptionEventName           = "buy_trial_subscription_event"
+	FundTrialPoolEventName                  = "fund_trial_pool_event"
 )
@@ -0,0 +1,958 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lavanet/lava/subscription/usage.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type UsageEntry_Type int32
+
+const (
+	UsageEntry_project       UsageEntry_Type = 0
+	UsageEntry_developer_key UsageEntry_Type = 1
+	UsageEntry_chain         UsageEntry_Type = 2
+	UsageEntry_provider      UsageEntry_Type = 3
+)
+
+var UsageEntry_Type_name = map[int32]string{
+	0: "project",
+	1: "developer_key",
+	2: "chain",
+	3: "provider",
+}
+
+var UsageEntry_Type_value = map[string]int32{
+	"project":       0,
+	"developer_key": 1,
+	"chain":         2,
+	"provider":      3,
+}
+
+func (x UsageEntry_Type) String() string {
+	return proto.EnumName(UsageEntry_Type_name, int32(x))
+}
+
+func (UsageEntry_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d613cd52f48ae2bb, []int{1, 0}
+}
+
+// MonthUsage summarizes a subscription's usage in a month. a month is identified by the subscription's block during it
+type MonthUsage struct {
+	Subscription    string     `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	MonthBlock      uint64     `protobuf:"varint,2,opt,name=month_block,json=monthBlock,proto3" json:"month_block,omitempty"`
+	MonthExpiryTime uint64     `protobuf:"varint,3,opt,name=month_expiry_time,json=monthExpiryTime,proto3" json:"month_expiry_time,omitempty"`
+	PlanIndex       string     `protobuf:"bytes,4,opt,name=plan_index,json=planIndex,proto3" json:"plan_index,omitempty"`
+	Cu              uint64     `protobuf:"varint,5,opt,name=cu,proto3" json:"cu,omitempty"`
+	CreditSpent     types.Coin `protobuf:"bytes,6,opt,name=credit_spent,json=creditSpent,proto3" json:"credit_spent"`
+}
+
+func (m *MonthUsage) Reset()         { *m = MonthUsage{} }
+func (m *MonthUsage) String() string { return proto.CompactTextString(m) }
+func (*MonthUsage) ProtoMessage()    {}
+func (*MonthUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d613cd52f48ae2bb, []int{0}
+}
+func (m *MonthUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MonthUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MonthUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MonthUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonthUsage.Merge(m, src)
+}
+func (m *MonthUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MonthUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonthUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonthUsage proto.InternalMessageInfo
+
+func (m *MonthUsage) GetSubscription() string {
+	if m != nil {
+		return m.Subscription
+	}
+	return ""
+}
+
+func (m *MonthUsage) GetMonthBlock() uint64 {
+	if m != nil {
+		return m.MonthBlock
+	}
+	return 0
+}
+
+func (m *MonthUsage) GetMonthExpiryTime() uint64 {
+	if m != nil {
+		return m.MonthExpiryTime
+	}
+	return 0
+}
+
+func (m *MonthUsage) GetPlanIndex() string {
+	if m != nil {
+		return m.PlanIndex
+	}
+	return ""
+}
+
+func (m *MonthUsage) GetCu() uint64 {
+	if m != nil {
+		return m.Cu
+	}
+	return 0
+}
+
+func (m *MonthUsage) GetCreditSpent() types.Coin {
+	if m != nil {
+		return m.CreditSpent
+	}
+	return types.Coin{}
+}
+
+// UsageEntry is the usage of a single project, developer key, chain or provider in a subscription month
+type UsageEntry struct {
+	Subscription string          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	MonthBlock   uint64          `protobuf:"varint,2,opt,name=month_block,json=monthBlock,proto3" json:"month_block,omitempty"`
+	Type         UsageEntry_Type `protobuf:"varint,3,opt,name=type,proto3,enum=lavanet.lava.subscription.UsageEntry_Type" json:"type,omitempty"`
+	Name         string          `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Cu           uint64          `protobuf:"varint,5,opt,name=cu,proto3" json:"cu,omitempty"`
+	Credit       types.Coin      `protobuf:"bytes,6,opt,name=credit,proto3" json:"credit"`
+}
+
+func (m *UsageEntry) Reset()         { *m = UsageEntry{} }
+func (m *UsageEntry) String() string { return proto.CompactTextString(m) }
+func (*UsageEntry) ProtoMessage()    {}
+func (*UsageEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d613cd52f48ae2bb, []int{1}
+}
+func (m *UsageEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsageEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsageEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UsageEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsageEntry.Merge(m, src)
+}
+func (m *UsageEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsageEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsageEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsageEntry proto.InternalMessageInfo
+
+func (m *UsageEntry) GetSubscription() string {
+	if m != nil {
+		return m.Subscription
+	}
+	return ""
+}
+
+func (m *UsageEntry) GetMonthBlock() uint64 {
+	if m != nil {
+		return m.MonthBlock
+	}
+	return 0
+}
+
+func (m *UsageEntry) GetType() UsageEntry_Type {
+	if m != nil {
+		return m.Type
+	}
+	return UsageEntry_project
+}
+
+func (m *UsageEntry) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UsageEntry) GetCu() uint64 {
+	if m != nil {
+		return m.Cu
+	}
+	return 0
+}
+
+func (m *UsageEntry) GetCredit() types.Coin {
+	if m != nil {
+		return m.Credit
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterEnum("lavanet.lava.subscription.UsageEntry_Type", UsageEntry_Type_name, UsageEntry_Type_value)
+	proto.RegisterType((*MonthUsage)(nil), "lavanet.lava.subscription.MonthUsage")
+	proto.RegisterType((*UsageEntry)(nil), "lavanet.lava.subscription.UsageEntry")
+}
+
+func init() {
+	proto.RegisterFile("lavanet/lava/subscription/usage.proto", fileDescriptor_d613cd52f48ae2bb)
+}
+
+var fileDescriptor_d613cd52f48ae2bb = []byte{
+	// 441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xc1, 0x8a, 0xd3, 0x40,
+	0x18, 0xc7, 0x33, 0xd9, 0x6c, 0xb5, 0x5f, 0xeb, 0xda, 0x1d, 0x3c, 0x64, 0x17, 0xcc, 0x96, 0x82,
+	0x50, 0x16, 0x99, 0xb0, 0xeb, 0xc1, 0x9b, 0x42, 0x65, 0x05, 0x0f, 0x5e, 0xea, 0x7a, 0xf1, 0x12,
+	0x92, 0xe9, 0x47, 0x3b, 0x6e, 0x33, 0x33, 0x4c, 0x26, 0xa5, 0x79, 0x0b, 0xdf, 0xc4, 0xd7, 0xd8,
+	0xe3, 0x1e, 0x3d, 0x89, 0xb4, 0x2f, 0xe0, 0x23, 0xc8, 0x4c, 0x0a, 0x6e, 0x11, 0xc1, 0x83, 0xa7,
+	0xef, 0xe3, 0x9f, 0xff, 0x3f, 0x7f, 0x7e, 0xc9, 0x07, 0xcf, 0x96, 0xf9, 0x2a, 0x97, 0x68, 0x53,
+	0x37, 0xd3, 0xaa, 0x2e, 0x2a, 0x6e, 0x84, 0xb6, 0x42, 0xc9, 0xb4, 0xae, 0xf2, 0x39, 0x32, 0x6d,
+	0x94, 0x55, 0xf4, 0x64, 0x67, 0x63, 0x6e, 0xb2, 0xfb, 0xb6, 0xd3, 0x84, 0xab, 0xaa, 0x54, 0x55,
+	0x5a, 0xe4, 0x15, 0xa6, 0xab, 0x8b, 0x02, 0x6d, 0x7e, 0x91, 0x72, 0x25, 0x64, 0x1b, 0x3d, 0x7d,
+	0x32, 0x57, 0x73, 0xe5, 0xd7, 0xd4, 0x6d, 0xad, 0x3a, 0xfa, 0x49, 0x00, 0xde, 0x2b, 0x69, 0x17,
+	0x1f, 0x5d, 0x0b, 0x1d, 0x41, 0xff, 0xfe, 0x4b, 0x63, 0x32, 0x24, 0xe3, 0xee, 0x74, 0x4f, 0xa3,
+	0x67, 0xd0, 0x2b, 0x5d, 0x22, 0x2b, 0x96, 0x8a, 0xdf, 0xc4, 0xe1, 0x90, 0x8c, 0xa3, 0x29, 0x78,
+	0x69, 0xe2, 0x14, 0x7a, 0x0e, 0xc7, 0xad, 0x01, 0xd7, 0x5a, 0x98, 0x26, 0xb3, 0xa2, 0xc4, 0xf8,
+	0xc0, 0xdb, 0x1e, 0xfb, 0x07, 0x57, 0x5e, 0xbf, 0x16, 0x25, 0xd2, 0xa7, 0x00, 0x7a, 0x99, 0xcb,
+	0x4c, 0xc8, 0x19, 0xae, 0xe3, 0xc8, 0xd7, 0x75, 0x9d, 0xf2, 0xce, 0x09, 0xf4, 0x08, 0x42, 0x5e,
+	0xc7, 0x87, 0x3e, 0x1b, 0xf2, 0x9a, 0x4e, 0xa0, 0xcf, 0x0d, 0xce, 0x84, 0xcd, 0x2a, 0x8d, 0xd2,
+	0xc6, 0x9d, 0x21, 0x19, 0xf7, 0x2e, 0x4f, 0x58, 0xcb, 0xce, 0x1c, 0x3b, 0xdb, 0xb1, 0xb3, 0x37,
+	0x4a, 0xc8, 0x49, 0x74, 0xfb, 0xfd, 0x2c, 0x98, 0xf6, 0xda, 0xd0, 0x07, 0x97, 0x19, 0x7d, 0x0d,
+	0x01, 0x3c, 0xed, 0x95, 0xb4, 0xa6, 0xf9, 0x3f, 0xc8, 0xaf, 0x20, 0xb2, 0x8d, 0x6e, 0x29, 0x8f,
+	0x2e, 0xcf, 0xd9, 0x5f, 0x7f, 0x13, 0xfb, 0xdd, 0xcc, 0xae, 0x1b, 0x8d, 0x53, 0x9f, 0xa3, 0x14,
+	0x22, 0x99, 0x97, 0xb8, 0xfb, 0x00, 0x7e, 0xff, 0x83, 0xfd, 0x25, 0x74, 0x5a, 0x8c, 0x7f, 0xa5,
+	0xde, 0xd9, 0x47, 0xaf, 0x21, 0x72, 0x55, 0xb4, 0x07, 0x0f, 0xb4, 0x51, 0x9f, 0x91, 0xdb, 0x41,
+	0x40, 0x8f, 0xe1, 0xd1, 0x0c, 0x57, 0xb8, 0x54, 0x1a, 0x4d, 0x76, 0x83, 0xcd, 0x80, 0xd0, 0x2e,
+	0x1c, 0xf2, 0x45, 0x2e, 0xe4, 0x20, 0xa4, 0x7d, 0x78, 0xa8, 0x8d, 0x5a, 0x89, 0x19, 0x9a, 0xc1,
+	0xc1, 0xe4, 0xed, 0xed, 0x26, 0x21, 0x77, 0x9b, 0x84, 0xfc, 0xd8, 0x24, 0xe4, 0xcb, 0x36, 0x09,
+	0xee, 0xb6, 0x49, 0xf0, 0x6d, 0x9b, 0x04, 0x9f, 0x9e, 0xcf, 0x85, 0x5d, 0xd4, 0x05, 0xe3, 0xaa,
+	0x4c, 0xf7, 0x2e, 0x78, 0xbd, 0x7f, 0xc3, 0x0e, 0xb2, 0x2a, 0x3a, 0xfe, 0xe6, 0x5e, 0xfc, 0x1a,
+	0x00, 0x87, 0xcf, 0x83, 0x27, 0xed, 0x02, 0x00, 0x00,
+}
+
+func (m *MonthUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MonthUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MonthUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CreditSpent.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUsage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Cu != 0 {
+		i = encodeVarintUsage(dAtA, i, uint64(m.Cu))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PlanIndex) > 0 {
+		i -= len(m.PlanIndex)
+		copy(dAtA[i:], m.PlanIndex)
+		i = encodeVarintUsage(dAtA, i, uint64(len(m.PlanIndex)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MonthExpiryTime != 0 {
+		i = encodeVarintUsage(dAtA, i, uint64(m.MonthExpiryTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MonthBlock != 0 {
+		i = encodeVarintUsage(dAtA, i, uint64(m.MonthBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Subscription) > 0 {
+		i -= len(m.Subscription)
+		copy(dAtA[i:], m.Subscription)
+		i = encodeVarintUsage(dAtA, i, uint64(len(m.Subscription)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UsageEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsageEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsageEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Credit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUsage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Cu != 0 {
+		i = encodeVarintUsage(dAtA, i, uint64(m.Cu))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintUsage(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintUsage(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MonthBlock != 0 {
+		i = encodeVarintUsage(dAtA, i, uint64(m.MonthBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Subscription) > 0 {
+		i -= len(m.Subscription)
+		copy(dAtA[i:], m.Subscription)
+		i = encodeVarintUsage(dAtA, i, uint64(len(m.Subscription)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUsage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUsage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MonthUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subscription)
+	if l > 0 {
+		n += 1 + l + sovUsage(uint64(l))
+	}
+	if m.MonthBlock != 0 {
+		n += 1 + sovUsage(uint64(m.MonthBlock))
+	}
+	if m.MonthExpiryTime != 0 {
+		n += 1 + sovUsage(uint64(m.MonthExpiryTime))
+	}
+	l = len(m.PlanIndex)
+	if l > 0 {
+		n += 1 + l + sovUsage(uint64(l))
+	}
+	if m.Cu != 0 {
+		n += 1 + sovUsage(uint64(m.Cu))
+	}
+	l = m.CreditSpent.Size()
+	n += 1 + l + sovUsage(uint64(l))
+	return n
+}
+
+func (m *UsageEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subscription)
+	if l > 0 {
+		n += 1 + l + sovUsage(uint64(l))
+	}
+	if m.MonthBlock != 0 {
+		n += 1 + sovUsage(uint64(m.MonthBlock))
+	}
+	if m.Type != 0 {
+		n += 1 + sovUsage(uint64(m.Type))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovUsage(uint64(l))
+	}
+	if m.Cu != 0 {
+		n += 1 + sovUsage(uint64(m.Cu))
+	}
+	l = m.Credit.Size()
+	n += 1 + l + sovUsage(uint64(l))
+	return n
+}
+
+func sovUsage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUsage(x uint64) (n int) {
+	return sovUsage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MonthUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUsage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MonthUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MonthUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscription", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthBlock", wireType)
+			}
+			m.MonthBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthExpiryTime", wireType)
+			}
+			m.MonthExpiryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthExpiryTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cu", wireType)
+			}
+			m.Cu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditSpent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUsage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreditSpent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUsage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUsage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsageEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUsage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsageEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsageEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscription", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthBlock", wireType)
+			}
+			m.MonthBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= UsageEntry_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cu", wireType)
+			}
+			m.Cu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUsage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Credit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUsage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUsage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipUsage(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowUsage
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthUsage
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupUsage
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthUsage
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthUsage        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowUsage          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupUsage = fmt.Errorf("proto: unexpected end of group")
+)